@@ -4,8 +4,10 @@
 package amqp
 
 import (
+	"crypto/tls"
 	"fmt"
 	"io"
+	"strings"
 	"sync"
 	"time"
 
@@ -25,11 +27,12 @@ type Client interface {
 
 // DefaultClient is the default AMQP client for The Things Network
 type DefaultClient struct {
-	url      string
-	ctx      log.Interface
-	conn     *AMQP.Connection
-	mutex    sync.Mutex
-	channels map[*DefaultChannelClient]*AMQP.Channel
+	url       string
+	tlsConfig *tls.Config
+	ctx       log.Interface
+	conn      *AMQP.Connection
+	mutex     sync.Mutex
+	channels  map[*DefaultChannelClient]*AMQP.Channel
 }
 
 // ChannelClient represents an AMQP channel client
@@ -83,11 +86,24 @@ func NewClient(ctx log.Interface, username, password, host string) Client {
 	}
 }
 
+// NewTLSClient creates a new DefaultClient that connects with TLS. The TLS config can contain a client certificate,
+// for example one that is issued by the CA of the network.
+func NewTLSClient(ctx log.Interface, username, password, host string, tlsConfig *tls.Config) Client {
+	client := NewClient(ctx, username, password, host).(*DefaultClient)
+	client.url = "amqps" + strings.TrimPrefix(client.url, "amqp")
+	client.tlsConfig = tlsConfig
+	return client
+}
+
 func (c *DefaultClient) connect(reconnect bool) (chan *AMQP.Error, error) {
 	var err error
 	var conn *AMQP.Connection
 	for retries := 0; reconnect || retries < ConnectRetries; retries++ {
-		conn, err = AMQP.Dial(c.url)
+		if c.tlsConfig != nil {
+			conn, err = AMQP.DialTLS(c.url, c.tlsConfig)
+		} else {
+			conn, err = AMQP.Dial(c.url)
+		}
 		if err == nil {
 			break
 		}
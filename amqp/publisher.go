@@ -15,6 +15,7 @@ type Publisher interface {
 	ChannelClient
 
 	PublishUplink(dataUp types.UplinkMessage) error
+	PublishRawUplink(dataUp types.UplinkMessage) error
 	PublishDownlink(dataDown types.DownlinkMessage) error
 }
 
@@ -35,9 +36,20 @@ func (c *DefaultClient) NewPublisher(exchange string) Publisher {
 	}
 }
 
+// Content types of published messages
+const (
+	JSONContentType = "application/json"
+	RawContentType  = "application/octet-stream"
+)
+
 func (p *DefaultPublisher) publish(key string, msg []byte, timestamp time.Time) error {
+	return p.publishWithHeaders(key, JSONContentType, nil, msg, timestamp)
+}
+
+func (p *DefaultPublisher) publishWithHeaders(key string, contentType string, headers AMQP.Table, msg []byte, timestamp time.Time) error {
 	return p.channel.Publish(p.exchange, key, false, false, AMQP.Publishing{
-		ContentType:  "application/json",
+		Headers:      headers,
+		ContentType:  contentType,
 		DeliveryMode: AMQP.Persistent,
 		Timestamp:    timestamp,
 		Body:         msg,
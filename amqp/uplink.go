@@ -26,10 +26,59 @@ func (c *DefaultPublisher) PublishUplink(dataUp types.UplinkMessage) error {
 	return c.publish(key.String(), msg, time.Time(dataUp.Metadata.Time))
 }
 
+// PublishRawUplink publishes the binary payload of an uplink message to the AMQP broker. The other fields of the
+// uplink message are sent in the headers, with the metadata encoded as JSON.
+func (c *DefaultPublisher) PublishRawUplink(dataUp types.UplinkMessage) error {
+	key := DeviceKey{dataUp.AppID, dataUp.DevID, DeviceUplink, ""}
+	metadata, err := json.Marshal(dataUp.Metadata)
+	if err != nil {
+		return fmt.Errorf("Unable to marshal the message metadata")
+	}
+	headers := AMQP.Table{
+		"app_id":          dataUp.AppID,
+		"dev_id":          dataUp.DevID,
+		"hardware_serial": dataUp.HardwareSerial,
+		"port":            int32(dataUp.FPort),
+		"counter":         int64(dataUp.FCnt),
+		"confirmed":       dataUp.Confirmed,
+		"is_retry":        dataUp.IsRetry,
+		"is_replay":       dataUp.IsReplay,
+		"metadata":        string(metadata),
+	}
+	return c.publishWithHeaders(key.String(), RawContentType, headers, dataUp.PayloadRaw, time.Time(dataUp.Metadata.Time))
+}
+
+// unmarshalRawUplink builds an uplink message from the binary payload and the headers of a delivery
+func unmarshalRawUplink(delivery AMQP.Delivery, dataUp *types.UplinkMessage) error {
+	dataUp.PayloadRaw = delivery.Body
+	dataUp.AppID, _ = delivery.Headers["app_id"].(string)
+	dataUp.DevID, _ = delivery.Headers["dev_id"].(string)
+	dataUp.HardwareSerial, _ = delivery.Headers["hardware_serial"].(string)
+	if port, ok := delivery.Headers["port"].(int32); ok {
+		dataUp.FPort = uint8(port)
+	}
+	if counter, ok := delivery.Headers["counter"].(int64); ok {
+		dataUp.FCnt = uint32(counter)
+	}
+	dataUp.Confirmed, _ = delivery.Headers["confirmed"].(bool)
+	dataUp.IsRetry, _ = delivery.Headers["is_retry"].(bool)
+	dataUp.IsReplay, _ = delivery.Headers["is_replay"].(bool)
+	if metadata, ok := delivery.Headers["metadata"].(string); ok {
+		return json.Unmarshal([]byte(metadata), &dataUp.Metadata)
+	}
+	return nil
+}
+
 func (s *DefaultSubscriber) handleUplink(messages <-chan AMQP.Delivery, handler UplinkHandler) {
 	for delivery := range messages {
 		dataUp := &types.UplinkMessage{}
-		if err := json.Unmarshal(delivery.Body, dataUp); err != nil {
+		var err error
+		if delivery.ContentType == RawContentType {
+			err = unmarshalRawUplink(delivery, dataUp)
+		} else {
+			err = json.Unmarshal(delivery.Body, dataUp)
+		}
+		if err != nil {
 			s.ctx.Warnf("Could not unmarshal uplink (%s)", err)
 			continue
 		}
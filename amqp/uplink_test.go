@@ -67,3 +67,46 @@ func TestSubscribeUplink(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestSubscribeRawUplink(t *testing.T) {
+	a := New(t)
+	c := NewClient(getLogger(t, "TestSubscribeRawUplink"), "guest", "guest", host)
+	err := c.Connect()
+	a.So(err, ShouldBeNil)
+	defer c.Disconnect()
+
+	p := c.NewPublisher("amq.topic")
+	err = p.Open()
+	a.So(err, ShouldBeNil)
+	defer p.Close()
+
+	s := c.NewSubscriber("amq.topic", "", false, true)
+	err = s.Open()
+	a.So(err, ShouldBeNil)
+	defer s.Close()
+
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	err = s.SubscribeDeviceUplink("app", "raw", func(_ Subscriber, appID, devID string, req types.UplinkMessage) {
+		a.So(appID, ShouldEqual, "app")
+		a.So(devID, ShouldEqual, "raw")
+		a.So(req.PayloadRaw, ShouldResemble, []byte{0x01, 0x08})
+		a.So(req.FPort, ShouldEqual, 1)
+		a.So(req.FCnt, ShouldEqual, 42)
+		a.So(req.Metadata.DataRate, ShouldEqual, "SF7BW125")
+		wg.Done()
+	})
+	a.So(err, ShouldBeNil)
+
+	err = p.PublishRawUplink(types.UplinkMessage{
+		AppID:      "app",
+		DevID:      "raw",
+		FPort:      1,
+		FCnt:       42,
+		PayloadRaw: []byte{0x01, 0x08},
+		Metadata:   types.Metadata{DataRate: "SF7BW125"},
+	})
+	a.So(err, ShouldBeNil)
+
+	wg.Wait()
+}
@@ -13,6 +13,7 @@
 		Rates
 		SystemStats
 		ComponentStats
+		MaintenanceWindow
 */
 package api
 
@@ -393,6 +394,51 @@ func (m *ComponentStats_MemoryStats) GetStack() uint64 {
 	return 0
 }
 
+// MaintenanceWindow is a (recurring) period of planned maintenance, during which alerts are suppressed
+type MaintenanceWindow struct {
+	// Start of the (first) window (Unix nanoseconds)
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// Duration of the window (seconds)
+	Duration uint32 `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// How the window recurs: daily, weekly, monthly or empty for a single window
+	Recurrence string `protobuf:"bytes,3,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// Time after which the window no longer recurs (Unix nanoseconds, 0 to recur indefinitely)
+	Until int64 `protobuf:"varint,4,opt,name=until,proto3" json:"until,omitempty"`
+}
+
+func (m *MaintenanceWindow) Reset()                    { *m = MaintenanceWindow{} }
+func (m *MaintenanceWindow) String() string            { return proto.CompactTextString(m) }
+func (*MaintenanceWindow) ProtoMessage()               {}
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{4} }
+
+func (m *MaintenanceWindow) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *MaintenanceWindow) GetDuration() uint32 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *MaintenanceWindow) GetRecurrence() string {
+	if m != nil {
+		return m.Recurrence
+	}
+	return ""
+}
+
+func (m *MaintenanceWindow) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Percentiles)(nil), "api.Percentiles")
 	proto.RegisterType((*Rates)(nil), "api.Rates")
@@ -403,6 +449,7 @@ func init() {
 	proto.RegisterType((*ComponentStats)(nil), "api.ComponentStats")
 	proto.RegisterType((*ComponentStats_CPUStats)(nil), "api.ComponentStats.CPUStats")
 	proto.RegisterType((*ComponentStats_MemoryStats)(nil), "api.ComponentStats.MemoryStats")
+	proto.RegisterType((*MaintenanceWindow)(nil), "api.MaintenanceWindow")
 }
 func (m *Percentiles) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -771,6 +818,45 @@ func (m *ComponentStats_MemoryStats) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *MaintenanceWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceWindow) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Start != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Start))
+	}
+	if m.Duration != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Duration))
+	}
+	if len(m.Recurrence) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Recurrence)))
+		i += copy(dAtA[i:], m.Recurrence)
+	}
+	if m.Until != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Until))
+	}
+	return i, nil
+}
+
 func encodeFixed64Api(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	dAtA[offset+1] = uint8(v >> 8)
@@ -965,6 +1051,25 @@ func (m *ComponentStats_MemoryStats) Size() (n int) {
 	return n
 }
 
+func (m *MaintenanceWindow) Size() (n int) {
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovApi(uint64(m.Start))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovApi(uint64(m.Duration))
+	}
+	l = len(m.Recurrence)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Until != 0 {
+		n += 1 + sovApi(uint64(m.Until))
+	}
+	return n
+}
+
 func sovApi(x uint64) (n int) {
 	for {
 		n++
@@ -2072,6 +2177,143 @@ func (m *ComponentStats_MemoryStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MaintenanceWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurrence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recurrence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			m.Until = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Until |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipApi(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2180,40 +2422,44 @@ var (
 func init() { proto.RegisterFile("github.com/TheThingsNetwork/ttn/api/api.proto", fileDescriptorApi) }
 
 var fileDescriptorApi = []byte{
-	// 558 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcb, 0x6a, 0xdb, 0x40,
-	0x14, 0xc5, 0xb1, 0xe2, 0x26, 0x57, 0x7d, 0xc0, 0x50, 0xcc, 0xd4, 0x04, 0x37, 0xb8, 0x50, 0x0a,
-	0xa5, 0xb2, 0xe3, 0x56, 0x18, 0x6f, 0x6b, 0xe8, 0xa2, 0xcf, 0xa0, 0x24, 0x9b, 0x6e, 0xc2, 0x58,
-	0x9e, 0xda, 0x22, 0x92, 0x66, 0xd0, 0x8c, 0x1a, 0xf2, 0x0b, 0x5d, 0xf7, 0xa3, 0xba, 0x29, 0xf4,
-	0x13, 0x8a, 0xbf, 0xa4, 0xcc, 0xd5, 0x58, 0x95, 0xc7, 0x59, 0x74, 0xd1, 0x85, 0xe0, 0x9e, 0x33,
-	0xe7, 0x9e, 0xb9, 0x0f, 0x34, 0xf0, 0x62, 0x99, 0xe8, 0x55, 0x39, 0x0f, 0x62, 0x91, 0x0d, 0xcf,
-	0x57, 0xfc, 0x7c, 0x95, 0xe4, 0x4b, 0xf5, 0x91, 0xeb, 0x6b, 0x51, 0x5c, 0x0d, 0xb5, 0xce, 0x87,
-	0x4c, 0x26, 0xe6, 0x0b, 0x64, 0x21, 0xb4, 0x20, 0x6d, 0x26, 0x93, 0xc1, 0xcf, 0x3d, 0xf0, 0x4f,
-	0x79, 0x11, 0xf3, 0x5c, 0x27, 0x29, 0x57, 0xe4, 0x18, 0x7c, 0x59, 0xc3, 0x13, 0xda, 0x3a, 0x6e,
-	0x3d, 0xdb, 0x8b, 0x9a, 0xd4, 0xb6, 0x22, 0xa4, 0x7b, 0xae, 0x22, 0x24, 0x03, 0xb8, 0xdb, 0x48,
-	0x18, 0xd1, 0x36, 0x4a, 0xb6, 0xb8, 0x6d, 0xcd, 0x38, 0xa4, 0x9e, 0xab, 0x19, 0x3b, 0x3e, 0xe1,
-	0x88, 0xee, 0xbb, 0x9a, 0xd0, 0xf1, 0x99, 0x84, 0xb4, 0xe3, 0x6a, 0x26, 0x8e, 0xcf, 0x74, 0x44,
-	0xef, 0xb8, 0x9a, 0xa9, 0xe3, 0x33, 0x0d, 0xe9, 0xc1, 0x8e, 0xc6, 0xf5, 0x99, 0xd2, 0xc3, 0x1d,
-	0xcd, 0x74, 0xf0, 0x0e, 0xf6, 0x23, 0xa6, 0xb9, 0x22, 0x0f, 0x61, 0xbf, 0x60, 0xba, 0x1e, 0x61,
-	0x05, 0x36, 0xec, 0x66, 0x6c, 0x15, 0x20, 0x5d, 0xe8, 0xe0, 0x71, 0x68, 0x47, 0x65, 0xd1, 0xe0,
-	0x7b, 0x1b, 0xfc, 0xb3, 0x1b, 0xa5, 0x79, 0x76, 0xa6, 0x99, 0x56, 0x24, 0x00, 0x2f, 0x15, 0x6c,
-	0x81, 0x96, 0xfe, 0xb8, 0x17, 0x98, 0x5d, 0x36, 0xce, 0x83, 0xf7, 0x82, 0x2d, 0x94, 0x89, 0x22,
-	0xd4, 0x91, 0xe7, 0xd0, 0x8e, 0x65, 0x89, 0x77, 0xf9, 0xe3, 0x47, 0x3b, 0xf2, 0xd9, 0xe9, 0x05,
-	0x06, 0x91, 0x51, 0x91, 0x57, 0xd0, 0xc9, 0x78, 0x26, 0x8a, 0x1b, 0x2c, 0xc2, 0x1f, 0x1f, 0xed,
-	0xe8, 0x3f, 0xe0, 0x71, 0x95, 0x62, 0xb5, 0xbd, 0x4f, 0x70, 0x58, 0xdf, 0x6a, 0xba, 0x33, 0xf7,
-	0xd6, 0x3d, 0x23, 0xd8, 0xb0, 0x75, 0xcf, 0x08, 0x4c, 0xcf, 0x78, 0x5c, 0xf7, 0x5c, 0xa1, 0xde,
-	0x5b, 0x38, 0xd8, 0xd4, 0x45, 0x08, 0x78, 0xa5, 0xe2, 0x85, 0xb5, 0xc3, 0xd8, 0xe4, 0x29, 0xac,
-	0xc9, 0xda, 0x59, 0x64, 0xb4, 0xc9, 0x22, 0xe5, 0xd6, 0x0d, 0xe3, 0xde, 0x05, 0xf8, 0x8d, 0x9a,
-	0x4d, 0x21, 0x5a, 0x68, 0x96, 0xa2, 0x9f, 0x17, 0x55, 0x80, 0x1c, 0xc1, 0x21, 0xfb, 0xca, 0x92,
-	0x94, 0xcd, 0x53, 0x8e, 0x9e, 0x5e, 0xf4, 0x97, 0xb0, 0x25, 0x2c, 0xd0, 0xd6, 0xc3, 0x12, 0x16,
-	0x83, 0x6f, 0x6d, 0xb8, 0x3f, 0x13, 0x99, 0x14, 0x39, 0xcf, 0x75, 0x65, 0xdd, 0x85, 0x4e, 0x29,
-	0x75, 0x92, 0x71, 0xeb, 0x6d, 0x11, 0x09, 0x9a, 0x1b, 0xa8, 0x26, 0xba, 0x9d, 0xe9, 0x2c, 0x61,
-	0xe2, 0x2c, 0xe1, 0xf1, 0x6d, 0x29, 0xb7, 0xec, 0x81, 0xf4, 0x01, 0x96, 0xa2, 0x10, 0xa5, 0x4e,
-	0x72, 0xae, 0xf0, 0x6f, 0xf2, 0xa2, 0x06, 0x43, 0x9e, 0xc2, 0x83, 0x65, 0x7c, 0x19, 0xcb, 0xf2,
-	0xf2, 0x4b, 0xc1, 0x62, 0x9d, 0x88, 0xdc, 0xfe, 0x4e, 0xf7, 0x96, 0xf1, 0x4c, 0x96, 0x6f, 0x2c,
-	0xf9, 0x5f, 0xc7, 0x1f, 0x6f, 0x8f, 0xbf, 0x5b, 0xf7, 0x66, 0x67, 0x64, 0x4b, 0x27, 0xe0, 0xa9,
-	0x6b, 0x26, 0xed, 0xec, 0x31, 0x36, 0xdc, 0x8a, 0x33, 0x49, 0xa1, 0xe2, 0x4c, 0x6c, 0xd6, 0xa7,
-	0x34, 0x8b, 0xaf, 0xa8, 0x5f, 0xad, 0x0f, 0xc1, 0xeb, 0x93, 0x1f, 0xeb, 0x7e, 0xeb, 0xd7, 0xba,
-	0xdf, 0xfa, 0xbd, 0xee, 0xb7, 0x3e, 0x3f, 0xf9, 0x87, 0x27, 0x70, 0xde, 0xc1, 0xf7, 0xef, 0xe5,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x37, 0x62, 0x5e, 0x7e, 0x30, 0x05, 0x00, 0x00,
+	// 613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xad, 0x54, 0xdd, 0x8a, 0xd3, 0x40,
+	0x14, 0xa6, 0x4d, 0x5a, 0xdb, 0x89, 0xab, 0x38, 0xc8, 0x12, 0xcb, 0xb2, 0x2e, 0x11, 0x44, 0x10,
+	0xdb, 0x6e, 0x35, 0x94, 0xde, 0x5a, 0xf0, 0x42, 0x5d, 0x5d, 0xb2, 0xbb, 0x08, 0xde, 0x2c, 0xd3,
+	0x64, 0x6c, 0xc3, 0x36, 0x99, 0x30, 0x99, 0x58, 0x16, 0xdf, 0xc0, 0x6b, 0x1f, 0xca, 0x1b, 0xc1,
+	0x47, 0x10, 0x9f, 0xc4, 0x99, 0x93, 0x49, 0x4c, 0xa7, 0x7b, 0xe1, 0x85, 0x17, 0x03, 0xe7, 0x3b,
+	0xf3, 0x9d, 0xef, 0xfc, 0x65, 0x82, 0x9e, 0x2d, 0x63, 0xb1, 0x2a, 0x16, 0xc3, 0x90, 0x25, 0xa3,
+	0xf3, 0x15, 0x3d, 0x5f, 0xc5, 0xe9, 0x32, 0x7f, 0x47, 0xc5, 0x86, 0xf1, 0xab, 0x91, 0x10, 0xe9,
+	0x88, 0x64, 0xb1, 0x3a, 0xc3, 0x8c, 0x33, 0xc1, 0xb0, 0x25, 0x4d, 0xef, 0x47, 0x1b, 0x39, 0xa7,
+	0x94, 0x87, 0x34, 0x15, 0xf1, 0x9a, 0xe6, 0xf8, 0x08, 0x39, 0x59, 0x0d, 0x8f, 0xdd, 0xd6, 0x51,
+	0xeb, 0x49, 0x3b, 0x68, 0xba, 0xb6, 0x19, 0xbe, 0xdb, 0x36, 0x19, 0x3e, 0xf6, 0xd0, 0xed, 0x46,
+	0xc0, 0xd8, 0xb5, 0x80, 0xb2, 0xe5, 0xdb, 0xe6, 0x4c, 0x7c, 0xd7, 0x36, 0x39, 0x13, 0x43, 0xc7,
+	0x1f, 0xbb, 0x1d, 0x93, 0xe3, 0x1b, 0x3a, 0x53, 0xdf, 0xed, 0x9a, 0x9c, 0xa9, 0xa1, 0x33, 0x1b,
+	0xbb, 0xb7, 0x4c, 0xce, 0xcc, 0xd0, 0x99, 0xf9, 0x6e, 0x6f, 0x87, 0x63, 0xea, 0xcc, 0xdc, 0xfe,
+	0x0e, 0x67, 0xe6, 0xbd, 0x41, 0x9d, 0x80, 0x08, 0x39, 0xc8, 0xfb, 0xa8, 0xc3, 0xa5, 0x51, 0x8d,
+	0xb0, 0x04, 0x95, 0xb7, 0x1a, 0x5b, 0x09, 0xf0, 0x3e, 0xea, 0xc2, 0xb5, 0xaf, 0x47, 0xa5, 0x91,
+	0xf7, 0xcd, 0x42, 0xce, 0xd9, 0x75, 0x2e, 0x68, 0x72, 0x26, 0x88, 0xc8, 0xf1, 0x10, 0xd9, 0x6b,
+	0x46, 0x22, 0x90, 0x74, 0x26, 0x83, 0xa1, 0xda, 0x65, 0xe3, 0x7e, 0xf8, 0x56, 0x5e, 0xe6, 0xca,
+	0x0a, 0x80, 0x87, 0x9f, 0x22, 0x2b, 0xcc, 0x0a, 0xc8, 0xe5, 0x4c, 0x1e, 0xec, 0xd0, 0xe7, 0xa7,
+	0x17, 0x60, 0x04, 0x8a, 0x85, 0x5f, 0xa0, 0x6e, 0x42, 0x13, 0xc6, 0xaf, 0xa1, 0x08, 0x67, 0x72,
+	0xb0, 0xc3, 0x3f, 0x81, 0xeb, 0x32, 0x44, 0x73, 0x07, 0xef, 0x51, 0xbf, 0xce, 0xaa, 0xba, 0x53,
+	0x79, 0xeb, 0x9e, 0x01, 0x54, 0xde, 0xba, 0x67, 0x00, 0xaa, 0x67, 0xb8, 0xae, 0x7b, 0x2e, 0xd1,
+	0xe0, 0x35, 0xea, 0x55, 0x75, 0x61, 0x8c, 0xec, 0x22, 0xa7, 0x5c, 0xcb, 0x81, 0xad, 0xe2, 0x72,
+	0xa8, 0x49, 0xcb, 0x69, 0xa4, 0xb8, 0x71, 0xb4, 0xa6, 0x5a, 0x0d, 0xec, 0xc1, 0x05, 0x72, 0x1a,
+	0x35, 0xab, 0x42, 0x04, 0x13, 0x64, 0x0d, 0x7a, 0x76, 0x50, 0x02, 0x7c, 0x80, 0xfa, 0xe4, 0x33,
+	0x89, 0xd7, 0x64, 0x21, 0xa3, 0xdb, 0x70, 0xf3, 0xd7, 0xa1, 0x4b, 0x88, 0x40, 0xd6, 0x86, 0x12,
+	0x22, 0xef, 0xab, 0x85, 0xee, 0xcc, 0x59, 0x92, 0xb1, 0x54, 0xae, 0xbd, 0x94, 0x96, 0x55, 0x15,
+	0x99, 0x88, 0x13, 0xaa, 0xb5, 0x35, 0x92, 0x1b, 0x6b, 0x6c, 0xa0, 0x9c, 0xe8, 0x76, 0xa4, 0xb1,
+	0x84, 0xa9, 0xb1, 0x84, 0x87, 0x37, 0x85, 0xdc, 0xb0, 0x07, 0x7c, 0x88, 0xd0, 0x92, 0x71, 0x56,
+	0x88, 0x38, 0xa5, 0x39, 0xbc, 0x26, 0x3b, 0x68, 0x78, 0xf0, 0x63, 0x74, 0x77, 0x19, 0x5e, 0xca,
+	0x14, 0x97, 0x9f, 0x38, 0x09, 0x45, 0xcc, 0x52, 0xfd, 0x9c, 0xf6, 0x96, 0xe1, 0x3c, 0x2b, 0x5e,
+	0x69, 0xe7, 0x7f, 0x1d, 0x7f, 0xb8, 0x3d, 0xfe, 0xfd, 0xba, 0x37, 0x3d, 0x23, 0x5d, 0xba, 0x0c,
+	0xcd, 0x37, 0x24, 0xd3, 0xb3, 0x07, 0x5b, 0xf9, 0x56, 0x54, 0xfa, 0x50, 0xe9, 0x53, 0xb6, 0x5a,
+	0x9f, 0xfc, 0xcc, 0xc2, 0x2b, 0xd7, 0x29, 0xd7, 0x07, 0xc0, 0xfb, 0x82, 0xee, 0x9d, 0x90, 0x38,
+	0x15, 0x34, 0x25, 0x69, 0x48, 0x3f, 0xc4, 0x69, 0xc4, 0x36, 0x9a, 0xca, 0x05, 0x64, 0xb2, 0x82,
+	0x12, 0xe0, 0x01, 0xea, 0x45, 0x85, 0x7c, 0x5a, 0xaa, 0x79, 0x95, 0x6c, 0x2f, 0xa8, 0xb1, 0x9a,
+	0x1f, 0xa7, 0x61, 0xc1, 0x39, 0x95, 0x2a, 0xd0, 0x45, 0x3f, 0x68, 0x78, 0x94, 0x62, 0xa1, 0xde,
+	0x38, 0x8c, 0x56, 0x2a, 0x02, 0x78, 0x79, 0xfc, 0xfd, 0xf7, 0x61, 0xeb, 0xa7, 0x3c, 0xbf, 0xe4,
+	0xf9, 0xf8, 0xe8, 0x1f, 0xfe, 0xbf, 0x8b, 0x2e, 0xfc, 0x7c, 0x9f, 0xff, 0x01, 0xa1, 0x82, 0x47,
+	0x9e, 0xad, 0x05, 0x00, 0x00,
 }
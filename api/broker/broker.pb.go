@@ -32,6 +32,7 @@ import google_protobuf "github.com/golang/protobuf/ptypes/empty"
 import _ "github.com/gogo/protobuf/gogoproto"
 import api "github.com/TheThingsNetwork/ttn/api"
 import protocol "github.com/TheThingsNetwork/ttn/api/protocol"
+import lorawan1 "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 import gateway "github.com/TheThingsNetwork/ttn/api/gateway"
 import trace "github.com/TheThingsNetwork/ttn/api/trace"
 
@@ -66,6 +67,8 @@ type DownlinkOption struct {
 	Deadline       int64                     `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
 	ProtocolConfig *protocol.TxConfiguration `protobuf:"bytes,5,opt,name=protocol_config,json=protocolConfig" json:"protocol_config,omitempty"`
 	GatewayConfig  *gateway.TxConfiguration  `protobuf:"bytes,6,opt,name=gateway_config,json=gatewayConfig" json:"gateway_config,omitempty"`
+	// The LoRaWAN receive window of this downlink option
+	RxWindow lorawan1.RxWindow `protobuf:"varint,7,opt,name=rx_window,json=rxWindow,proto3,enum=lorawan.RxWindow" json:"rx_window,omitempty"`
 }
 
 func (m *DownlinkOption) Reset()                    { *m = DownlinkOption{} }
@@ -115,6 +118,13 @@ func (m *DownlinkOption) GetGatewayConfig() *gateway.TxConfiguration {
 	return nil
 }
 
+func (m *DownlinkOption) GetRxWindow() lorawan1.RxWindow {
+	if m != nil {
+		return m.RxWindow
+	}
+	return lorawan1.RxWindow_RX_AUTO
+}
+
 // received from the Router
 type UplinkMessage struct {
 	Payload          []byte                                             `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
@@ -199,7 +209,10 @@ type DownlinkMessage struct {
 	AppId          string                                             `protobuf:"bytes,13,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
 	DevId          string                                             `protobuf:"bytes,14,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
 	DownlinkOption *DownlinkOption                                    `protobuf:"bytes,21,opt,name=downlink_option,json=downlinkOption" json:"downlink_option,omitempty"`
-	Trace          *trace.Trace                                       `protobuf:"bytes,31,opt,name=trace" json:"trace,omitempty"`
+	// DownlinkOption on another gateway, in the other receive window than downlink_option.
+	// It is only used for critical downlinks, that are transmitted on both gateways.
+	DiversityDownlinkOption *DownlinkOption `protobuf:"bytes,22,opt,name=diversity_downlink_option,json=diversityDownlinkOption" json:"diversity_downlink_option,omitempty"`
+	Trace                   *trace.Trace    `protobuf:"bytes,31,opt,name=trace" json:"trace,omitempty"`
 }
 
 func (m *DownlinkMessage) Reset()                    { *m = DownlinkMessage{} }
@@ -242,6 +255,13 @@ func (m *DownlinkMessage) GetDownlinkOption() *DownlinkOption {
 	return nil
 }
 
+func (m *DownlinkMessage) GetDiversityDownlinkOption() *DownlinkOption {
+	if m != nil {
+		return m.DiversityDownlinkOption
+	}
+	return nil
+}
+
 func (m *DownlinkMessage) GetTrace() *trace.Trace {
 	if m != nil {
 		return m.Trace
@@ -302,7 +322,11 @@ type DeduplicatedUplinkMessage struct {
 	GatewayMetadata  []*gateway.RxMetadata                              `protobuf:"bytes,22,rep,name=gateway_metadata,json=gatewayMetadata" json:"gateway_metadata,omitempty"`
 	ServerTime       int64                                              `protobuf:"varint,23,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
 	ResponseTemplate *DownlinkMessage                                   `protobuf:"bytes,31,opt,name=response_template,json=responseTemplate" json:"response_template,omitempty"`
-	Trace            *trace.Trace                                       `protobuf:"bytes,41,opt,name=trace" json:"trace,omitempty"`
+	// The change of the ADR settings that was sent to the device in the last downlink (LoRaWAN only)
+	AdrChange *lorawan1.ADRChange `protobuf:"bytes,32,opt,name=adr_change,json=adrChange" json:"adr_change,omitempty"`
+	Trace     *trace.Trace        `protobuf:"bytes,41,opt,name=trace" json:"trace,omitempty"`
+	// Attributes that the uplink filters of the Broker added to the message (for example the gateway group or region)
+	Attributes map[string]string `protobuf:"bytes,42,rep,name=attributes" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *DeduplicatedUplinkMessage) Reset()                    { *m = DeduplicatedUplinkMessage{} }
@@ -366,6 +390,13 @@ func (m *DeduplicatedUplinkMessage) GetResponseTemplate() *DownlinkMessage {
 	return nil
 }
 
+func (m *DeduplicatedUplinkMessage) GetAdrChange() *lorawan1.ADRChange {
+	if m != nil {
+		return m.AdrChange
+	}
+	return nil
+}
+
 func (m *DeduplicatedUplinkMessage) GetTrace() *trace.Trace {
 	if m != nil {
 		return m.Trace
@@ -373,6 +404,13 @@ func (m *DeduplicatedUplinkMessage) GetTrace() *trace.Trace {
 	return nil
 }
 
+func (m *DeduplicatedUplinkMessage) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
 // received from the Router
 type DeviceActivationRequest struct {
 	Payload            []byte                                             `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
@@ -542,10 +580,12 @@ type ActivationChallengeRequest struct {
 	DevId   string                                             `protobuf:"bytes,14,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
 }
 
-func (m *ActivationChallengeRequest) Reset()                    { *m = ActivationChallengeRequest{} }
-func (m *ActivationChallengeRequest) String() string            { return proto.CompactTextString(m) }
-func (*ActivationChallengeRequest) ProtoMessage()               {}
-func (*ActivationChallengeRequest) Descriptor() ([]byte, []int) { return fileDescriptorBroker, []int{7} }
+func (m *ActivationChallengeRequest) Reset()         { *m = ActivationChallengeRequest{} }
+func (m *ActivationChallengeRequest) String() string { return proto.CompactTextString(m) }
+func (*ActivationChallengeRequest) ProtoMessage()    {}
+func (*ActivationChallengeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorBroker, []int{7}
+}
 
 func (m *ActivationChallengeRequest) GetPayload() []byte {
 	if m != nil {
@@ -1183,6 +1223,11 @@ func (m *DownlinkOption) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n2
 	}
+	if m.RxWindow != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintBroker(dAtA, i, uint64(m.RxWindow))
+	}
 	return i, nil
 }
 
@@ -1377,17 +1422,29 @@ func (m *DownlinkMessage) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n12
 	}
+	if m.DiversityDownlinkOption != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintBroker(dAtA, i, uint64(m.DiversityDownlinkOption.Size()))
+		n13, err := m.DiversityDownlinkOption.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
 	if m.Trace != nil {
 		dAtA[i] = 0xfa
 		i++
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.Trace.Size()))
-		n13, err := m.Trace.MarshalTo(dAtA[i:])
+		n14, err := m.Trace.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n14
 	}
 	return i, nil
 }
@@ -1417,21 +1474,21 @@ func (m *DeviceActivationResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.Message.Size()))
-		n14, err := m.Message.MarshalTo(dAtA[i:])
+		n15, err := m.Message.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n15
 	}
 	if m.DownlinkOption != nil {
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.DownlinkOption.Size()))
-		n15, err := m.DownlinkOption.MarshalTo(dAtA[i:])
+		n16, err := m.DownlinkOption.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n16
 	}
 	if m.Trace != nil {
 		dAtA[i] = 0xaa
@@ -1439,11 +1496,11 @@ func (m *DeviceActivationResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.Trace.Size()))
-		n16, err := m.Trace.MarshalTo(dAtA[i:])
+		n17, err := m.Trace.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n17
 	}
 	return i, nil
 }
@@ -1473,31 +1530,31 @@ func (m *DeduplicatedUplinkMessage) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.Message.Size()))
-		n17, err := m.Message.MarshalTo(dAtA[i:])
+		n18, err := m.Message.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n18
 	}
 	if m.DevEui != nil {
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.DevEui.Size()))
-		n18, err := m.DevEui.MarshalTo(dAtA[i:])
+		n19, err := m.DevEui.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n19
 	}
 	if m.AppEui != nil {
 		dAtA[i] = 0x62
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.AppEui.Size()))
-		n19, err := m.AppEui.MarshalTo(dAtA[i:])
+		n20, err := m.AppEui.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n20
 	}
 	if len(m.AppId) > 0 {
 		dAtA[i] = 0x6a
@@ -1517,11 +1574,11 @@ func (m *DeduplicatedUplinkMessage) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.ProtocolMetadata.Size()))
-		n20, err := m.ProtocolMetadata.MarshalTo(dAtA[i:])
+		n21, err := m.ProtocolMetadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n21
 	}
 	if len(m.GatewayMetadata) > 0 {
 		for _, msg := range m.GatewayMetadata {
@@ -1550,11 +1607,23 @@ func (m *DeduplicatedUplinkMessage) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.ResponseTemplate.Size()))
-		n21, err := m.ResponseTemplate.MarshalTo(dAtA[i:])
+		n22, err := m.ResponseTemplate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n22
+	}
+	if m.AdrChange != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintBroker(dAtA, i, uint64(m.AdrChange.Size()))
+		n23, err := m.AdrChange.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
 	}
 	if m.Trace != nil {
 		dAtA[i] = 0xca
@@ -1562,11 +1631,30 @@ func (m *DeduplicatedUplinkMessage) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.Trace.Size()))
-		n22, err := m.Trace.MarshalTo(dAtA[i:])
+		n24, err := m.Trace.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n24
+	}
+	if len(m.Attributes) > 0 {
+		for k, _ := range m.Attributes {
+			dAtA[i] = 0xd2
+			i++
+			dAtA[i] = 0x2
+			i++
+			v := m.Attributes[k]
+			mapSize := 1 + len(k) + sovBroker(uint64(len(k))) + 1 + len(v) + sovBroker(uint64(len(v)))
+			i = encodeVarintBroker(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBroker(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBroker(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
 	}
 	return i, nil
 }
@@ -1596,31 +1684,31 @@ func (m *DeviceActivationRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.Message.Size()))
-		n23, err := m.Message.MarshalTo(dAtA[i:])
+		n25, err := m.Message.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n25
 	}
 	if m.DevEui != nil {
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.DevEui.Size()))
-		n24, err := m.DevEui.MarshalTo(dAtA[i:])
+		n26, err := m.DevEui.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n26
 	}
 	if m.AppEui != nil {
 		dAtA[i] = 0x62
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.AppEui.Size()))
-		n25, err := m.AppEui.MarshalTo(dAtA[i:])
+		n27, err := m.AppEui.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n27
 	}
 	if m.ProtocolMetadata != nil {
 		dAtA[i] = 0xaa
@@ -1628,11 +1716,11 @@ func (m *DeviceActivationRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.ProtocolMetadata.Size()))
-		n26, err := m.ProtocolMetadata.MarshalTo(dAtA[i:])
+		n28, err := m.ProtocolMetadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n28
 	}
 	if m.GatewayMetadata != nil {
 		dAtA[i] = 0xb2
@@ -1640,11 +1728,11 @@ func (m *DeviceActivationRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.GatewayMetadata.Size()))
-		n27, err := m.GatewayMetadata.MarshalTo(dAtA[i:])
+		n29, err := m.GatewayMetadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n29
 	}
 	if m.ActivationMetadata != nil {
 		dAtA[i] = 0xba
@@ -1652,11 +1740,11 @@ func (m *DeviceActivationRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.ActivationMetadata.Size()))
-		n28, err := m.ActivationMetadata.MarshalTo(dAtA[i:])
+		n30, err := m.ActivationMetadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n30
 	}
 	if len(m.DownlinkOptions) > 0 {
 		for _, msg := range m.DownlinkOptions {
@@ -1678,11 +1766,11 @@ func (m *DeviceActivationRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.Trace.Size()))
-		n29, err := m.Trace.MarshalTo(dAtA[i:])
+		n31, err := m.Trace.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n31
 	}
 	return i, nil
 }
@@ -1712,31 +1800,31 @@ func (m *DeduplicatedDeviceActivationRequest) MarshalTo(dAtA []byte) (int, error
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.Message.Size()))
-		n30, err := m.Message.MarshalTo(dAtA[i:])
+		n32, err := m.Message.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n32
 	}
 	if m.DevEui != nil {
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.DevEui.Size()))
-		n31, err := m.DevEui.MarshalTo(dAtA[i:])
+		n33, err := m.DevEui.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n33
 	}
 	if m.AppEui != nil {
 		dAtA[i] = 0x62
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.AppEui.Size()))
-		n32, err := m.AppEui.MarshalTo(dAtA[i:])
+		n34, err := m.AppEui.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n34
 	}
 	if len(m.AppId) > 0 {
 		dAtA[i] = 0x6a
@@ -1756,11 +1844,11 @@ func (m *DeduplicatedDeviceActivationRequest) MarshalTo(dAtA []byte) (int, error
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.ProtocolMetadata.Size()))
-		n33, err := m.ProtocolMetadata.MarshalTo(dAtA[i:])
+		n35, err := m.ProtocolMetadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n35
 	}
 	if len(m.GatewayMetadata) > 0 {
 		for _, msg := range m.GatewayMetadata {
@@ -1782,11 +1870,11 @@ func (m *DeduplicatedDeviceActivationRequest) MarshalTo(dAtA []byte) (int, error
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.ActivationMetadata.Size()))
-		n34, err := m.ActivationMetadata.MarshalTo(dAtA[i:])
+		n36, err := m.ActivationMetadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n36
 	}
 	if m.ServerTime != 0 {
 		dAtA[i] = 0xc0
@@ -1801,11 +1889,11 @@ func (m *DeduplicatedDeviceActivationRequest) MarshalTo(dAtA []byte) (int, error
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.ResponseTemplate.Size()))
-		n35, err := m.ResponseTemplate.MarshalTo(dAtA[i:])
+		n37, err := m.ResponseTemplate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n37
 	}
 	if m.Trace != nil {
 		dAtA[i] = 0xca
@@ -1813,11 +1901,11 @@ func (m *DeduplicatedDeviceActivationRequest) MarshalTo(dAtA []byte) (int, error
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.Trace.Size()))
-		n36, err := m.Trace.MarshalTo(dAtA[i:])
+		n38, err := m.Trace.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n38
 	}
 	return i, nil
 }
@@ -1847,31 +1935,31 @@ func (m *ActivationChallengeRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.Message.Size()))
-		n37, err := m.Message.MarshalTo(dAtA[i:])
+		n39, err := m.Message.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n39
 	}
 	if m.DevEui != nil {
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.DevEui.Size()))
-		n38, err := m.DevEui.MarshalTo(dAtA[i:])
+		n40, err := m.DevEui.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n40
 	}
 	if m.AppEui != nil {
 		dAtA[i] = 0x62
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.AppEui.Size()))
-		n39, err := m.AppEui.MarshalTo(dAtA[i:])
+		n41, err := m.AppEui.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n41
 	}
 	if len(m.AppId) > 0 {
 		dAtA[i] = 0x6a
@@ -1913,11 +2001,11 @@ func (m *ActivationChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.Message.Size()))
-		n40, err := m.Message.MarshalTo(dAtA[i:])
+		n42, err := m.Message.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n42
 	}
 	return i, nil
 }
@@ -1977,71 +2065,71 @@ func (m *Status) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.System.Size()))
-		n41, err := m.System.MarshalTo(dAtA[i:])
+		n43, err := m.System.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n43
 	}
 	if m.Component != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.Component.Size()))
-		n42, err := m.Component.MarshalTo(dAtA[i:])
+		n44, err := m.Component.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n44
 	}
 	if m.Uplink != nil {
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.Uplink.Size()))
-		n43, err := m.Uplink.MarshalTo(dAtA[i:])
+		n45, err := m.Uplink.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n45
 	}
 	if m.UplinkUnique != nil {
 		dAtA[i] = 0x62
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.UplinkUnique.Size()))
-		n44, err := m.UplinkUnique.MarshalTo(dAtA[i:])
+		n46, err := m.UplinkUnique.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n46
 	}
 	if m.Downlink != nil {
 		dAtA[i] = 0x6a
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.Downlink.Size()))
-		n45, err := m.Downlink.MarshalTo(dAtA[i:])
+		n47, err := m.Downlink.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n47
 	}
 	if m.Activations != nil {
 		dAtA[i] = 0x72
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.Activations.Size()))
-		n46, err := m.Activations.MarshalTo(dAtA[i:])
+		n48, err := m.Activations.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n48
 	}
 	if m.ActivationsUnique != nil {
 		dAtA[i] = 0x7a
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.ActivationsUnique.Size()))
-		n47, err := m.ActivationsUnique.MarshalTo(dAtA[i:])
+		n49, err := m.ActivationsUnique.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n49
 	}
 	if m.Deduplication != nil {
 		dAtA[i] = 0x82
@@ -2049,11 +2137,11 @@ func (m *Status) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintBroker(dAtA, i, uint64(m.Deduplication.Size()))
-		n48, err := m.Deduplication.MarshalTo(dAtA[i:])
+		n50, err := m.Deduplication.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n50
 	}
 	if m.ConnectedRouters != 0 {
 		dAtA[i] = 0xa8
@@ -2154,6 +2242,9 @@ func (m *DownlinkOption) Size() (n int) {
 		l = m.GatewayConfig.Size()
 		n += 1 + l + sovBroker(uint64(l))
 	}
+	if m.RxWindow != 0 {
+		n += 1 + sovBroker(uint64(m.RxWindow))
+	}
 	return n
 }
 
@@ -2236,6 +2327,10 @@ func (m *DownlinkMessage) Size() (n int) {
 		l = m.DownlinkOption.Size()
 		n += 2 + l + sovBroker(uint64(l))
 	}
+	if m.DiversityDownlinkOption != nil {
+		l = m.DiversityDownlinkOption.Size()
+		n += 2 + l + sovBroker(uint64(l))
+	}
 	if m.Trace != nil {
 		l = m.Trace.Size()
 		n += 2 + l + sovBroker(uint64(l))
@@ -2309,10 +2404,22 @@ func (m *DeduplicatedUplinkMessage) Size() (n int) {
 		l = m.ResponseTemplate.Size()
 		n += 2 + l + sovBroker(uint64(l))
 	}
+	if m.AdrChange != nil {
+		l = m.AdrChange.Size()
+		n += 2 + l + sovBroker(uint64(l))
+	}
 	if m.Trace != nil {
 		l = m.Trace.Size()
 		n += 2 + l + sovBroker(uint64(l))
 	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovBroker(uint64(len(k))) + 1 + len(v) + sovBroker(uint64(len(v)))
+			n += mapEntrySize + 2 + sovBroker(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -2733,6 +2840,25 @@ func (m *DownlinkOption) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxWindow", wireType)
+			}
+			m.RxWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBroker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RxWindow |= (lorawan1.RxWindow(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBroker(dAtA[iNdEx:])
@@ -3368,6 +3494,39 @@ func (m *DownlinkMessage) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiversityDownlinkOption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBroker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBroker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DiversityDownlinkOption == nil {
+				m.DiversityDownlinkOption = &DownlinkOption{}
+			}
+			if err := m.DiversityDownlinkOption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 31:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
@@ -3933,6 +4092,39 @@ func (m *DeduplicatedUplinkMessage) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdrChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBroker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBroker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AdrChange == nil {
+				m.AdrChange = &lorawan1.ADRChange{}
+			}
+			if err := m.AdrChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 41:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
@@ -3966,6 +4158,122 @@ func (m *DeduplicatedUplinkMessage) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBroker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBroker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBroker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBroker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthBroker
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBroker
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBroker
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthBroker
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Attributes[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Attributes[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBroker(dAtA[iNdEx:])
@@ -5766,81 +6074,90 @@ func init() {
 }
 
 var fileDescriptorBroker = []byte{
-	// 1208 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4d, 0x8f, 0xdb, 0x44,
-	0x18, 0x96, 0xf7, 0x23, 0xdb, 0x7d, 0xb3, 0xd9, 0x24, 0xd3, 0xee, 0xae, 0x9b, 0xd2, 0x4d, 0x08,
-	0x52, 0x15, 0x28, 0x4d, 0xda, 0x20, 0xbe, 0x24, 0x44, 0x95, 0xed, 0x56, 0xb0, 0x48, 0x29, 0x95,
-	0x9b, 0x72, 0x40, 0x48, 0xd1, 0xc4, 0x7e, 0xeb, 0x8c, 0xea, 0xd8, 0xae, 0x67, 0x9c, 0x36, 0x7f,
-	0x80, 0x0b, 0x12, 0xbf, 0x01, 0xf8, 0x07, 0x1c, 0xb9, 0x70, 0x44, 0x1c, 0x39, 0x73, 0x00, 0xd4,
-	0x5f, 0x82, 0x3c, 0x9e, 0xb1, 0x93, 0xdd, 0xa6, 0xad, 0xaa, 0x8a, 0x0f, 0x75, 0x2f, 0x89, 0xe7,
-	0x79, 0x1f, 0x3f, 0x33, 0xf3, 0x7e, 0x79, 0x6c, 0x78, 0xdf, 0x65, 0x62, 0x1c, 0x8f, 0xda, 0x76,
-	0x30, 0xe9, 0x0c, 0xc6, 0x38, 0x18, 0x33, 0xdf, 0xe5, 0xb7, 0x50, 0x3c, 0x0c, 0xa2, 0xfb, 0x1d,
-	0x21, 0xfc, 0x0e, 0x0d, 0x59, 0x67, 0x14, 0x05, 0xf7, 0x31, 0x52, 0x7f, 0xed, 0x30, 0x0a, 0x44,
-	0x40, 0x0a, 0xe9, 0xa8, 0x76, 0xc1, 0x0d, 0x02, 0xd7, 0xc3, 0x8e, 0x44, 0x47, 0xf1, 0xbd, 0x0e,
-	0x4e, 0x42, 0x31, 0x4b, 0x49, 0xb5, 0x2b, 0x73, 0xea, 0x6e, 0xe0, 0x06, 0x39, 0x2b, 0x19, 0xc9,
-	0x81, 0xbc, 0x52, 0xf4, 0xaa, 0x9e, 0x90, 0x86, 0x4c, 0x41, 0x75, 0x0d, 0xc9, 0xa1, 0x1d, 0x78,
-	0xd9, 0x85, 0x22, 0x5c, 0xd4, 0x04, 0x97, 0x0a, 0x7c, 0x48, 0x67, 0xfa, 0x5f, 0x99, 0xcf, 0x6b,
-	0xb3, 0x88, 0xa8, 0x8d, 0xe9, 0x6f, 0x6a, 0x6a, 0x7e, 0xbd, 0x02, 0xdb, 0x87, 0xc1, 0x43, 0xdf,
-	0x63, 0xfe, 0xfd, 0xcf, 0x43, 0xc1, 0x02, 0x9f, 0xec, 0x03, 0x30, 0x07, 0x7d, 0xc1, 0xee, 0x31,
-	0x8c, 0x4c, 0xa3, 0x61, 0xb4, 0x36, 0xad, 0x39, 0x84, 0x5c, 0x04, 0x50, 0xf2, 0x43, 0xe6, 0x98,
-	0x2b, 0xd2, 0xbe, 0xa9, 0x90, 0x23, 0x87, 0x9c, 0x83, 0x75, 0x6e, 0x07, 0x11, 0x9a, 0xab, 0x0d,
-	0xa3, 0x55, 0xb2, 0xd2, 0x01, 0xa9, 0xc1, 0x19, 0x07, 0xa9, 0xe3, 0x31, 0x1f, 0xcd, 0xb5, 0x86,
-	0xd1, 0x5a, 0xb5, 0xb2, 0x31, 0x39, 0x80, 0xb2, 0xde, 0xcf, 0xd0, 0x0e, 0xfc, 0x7b, 0xcc, 0x35,
-	0xd7, 0x1b, 0x46, 0xab, 0xd8, 0x3d, 0xdf, 0xce, 0xf6, 0x39, 0x78, 0x74, 0x43, 0x5a, 0xe2, 0x88,
-	0x26, 0x8b, 0xb4, 0xb6, 0xb5, 0x25, 0x85, 0xc9, 0x75, 0xd8, 0xd6, 0x8b, 0x52, 0x12, 0x05, 0x29,
-	0x61, 0xb6, 0xb5, 0x2b, 0x8e, 0x2b, 0x94, 0x94, 0x21, 0x45, 0x9b, 0xdf, 0xae, 0x41, 0xe9, 0x6e,
-	0x98, 0xb8, 0xa1, 0x8f, 0x9c, 0x53, 0x17, 0x89, 0x09, 0x1b, 0x21, 0x9d, 0x79, 0x01, 0x75, 0xa4,
-	0x13, 0xb6, 0x2c, 0x3d, 0x24, 0x97, 0x61, 0x63, 0x92, 0x92, 0xe4, 0xf6, 0x8b, 0xdd, 0x6a, 0xbe,
-	0x50, 0x75, 0xb7, 0xa5, 0x19, 0xe4, 0x16, 0x6c, 0x38, 0x38, 0x1d, 0x62, 0xcc, 0xcc, 0x62, 0x22,
-	0x73, 0xf0, 0xee, 0xef, 0x7f, 0xd4, 0xaf, 0x3d, 0x2b, 0xe3, 0x12, 0xa7, 0x75, 0xc4, 0x2c, 0x44,
-	0xde, 0x3e, 0xc4, 0xe9, 0xcd, 0xbb, 0x47, 0x56, 0xc1, 0xc1, 0xe9, 0xcd, 0x98, 0x25, 0x7a, 0x34,
-	0x0c, 0xa5, 0xde, 0xd6, 0x0b, 0xe9, 0xf5, 0xc2, 0x50, 0xea, 0xd1, 0x30, 0x4c, 0xf4, 0x76, 0x20,
-	0xb9, 0x4a, 0x42, 0x59, 0x92, 0xa1, 0x5c, 0xa7, 0x61, 0x78, 0xe4, 0x24, 0x70, 0xb2, 0x6c, 0xe6,
-	0x98, 0xdb, 0x29, 0xec, 0xe0, 0xf4, 0xc8, 0x21, 0x3d, 0xa8, 0x66, 0xb1, 0x9a, 0xa0, 0xa0, 0x0e,
-	0x15, 0xd4, 0xdc, 0x91, 0x4e, 0x38, 0x97, 0x3b, 0xc1, 0x7a, 0xd4, 0x57, 0x36, 0xab, 0xa2, 0x41,
-	0x8d, 0x90, 0x8f, 0xa1, 0xa2, 0x43, 0x95, 0x29, 0xec, 0x4a, 0x85, 0xb3, 0x59, 0xb0, 0xe6, 0x04,
-	0xca, 0x0a, 0xcb, 0xee, 0xef, 0x41, 0xc5, 0x51, 0x19, 0x3b, 0x0c, 0x64, 0xca, 0x72, 0xb3, 0xde,
-	0x58, 0x6d, 0x15, 0xbb, 0xbb, 0x6d, 0x55, 0x9d, 0x8b, 0x19, 0x6d, 0x95, 0x9d, 0x85, 0x31, 0x27,
-	0x4d, 0x58, 0x97, 0x45, 0x60, 0xbe, 0x29, 0xe7, 0xdd, 0x6a, 0xa7, 0x25, 0x31, 0x48, 0x7e, 0xad,
-	0xd4, 0xd4, 0xfc, 0x66, 0x15, 0xca, 0x5a, 0xe7, 0x34, 0x25, 0x9e, 0x92, 0x12, 0xd7, 0xa1, 0x7c,
-	0x2c, 0x1e, 0x2a, 0x21, 0x96, 0x85, 0x63, 0x7b, 0x31, 0x1c, 0x79, 0x34, 0xea, 0xcb, 0xa3, 0xf1,
-	0x8b, 0x01, 0xe6, 0x21, 0x4e, 0x99, 0x8d, 0x3d, 0x5b, 0xb0, 0x69, 0x5a, 0xc2, 0xc8, 0xc3, 0xc0,
-	0xe7, 0x2f, 0x2d, 0x2c, 0x4f, 0xd8, 0x48, 0xf1, 0xc5, 0x36, 0xb2, 0xb3, 0x7c, 0x23, 0x3f, 0xaf,
-	0xc1, 0xf9, 0x43, 0x74, 0xe2, 0xd0, 0x63, 0x36, 0x15, 0xe8, 0x9c, 0xf6, 0x9c, 0x7f, 0xaf, 0xe7,
-	0xac, 0x3e, 0x77, 0xcf, 0xa9, 0x43, 0x91, 0x63, 0x34, 0xc5, 0x68, 0x28, 0xd8, 0x04, 0xcd, 0x3d,
-	0xf9, 0x04, 0x83, 0x14, 0x1a, 0xb0, 0x09, 0x92, 0x43, 0xa8, 0x46, 0x2a, 0x1d, 0x87, 0x02, 0x27,
-	0xa1, 0x47, 0x85, 0xce, 0xe7, 0xbd, 0xe3, 0xd9, 0xa3, 0xc3, 0x55, 0xd1, 0x77, 0x0c, 0xd4, 0x0d,
-	0xcf, 0xd5, 0x97, 0x7e, 0x5a, 0x83, 0xbd, 0x93, 0x95, 0xf0, 0x20, 0x46, 0x2e, 0x5e, 0x95, 0xf4,
-	0xf9, 0x0f, 0x3c, 0x84, 0xfa, 0x70, 0x96, 0x66, 0xee, 0xcf, 0x25, 0xf6, 0xa4, 0xc4, 0x6b, 0xf9,
-	0x22, 0xf2, 0x18, 0x65, 0x5a, 0x84, 0x9e, 0xc0, 0xfe, 0xa9, 0x67, 0xda, 0x77, 0xeb, 0xf0, 0xc6,
-	0x7c, 0xf3, 0x79, 0xc5, 0xf3, 0xe8, 0x7f, 0xd7, 0x86, 0x5e, 0x72, 0xd6, 0x1d, 0xeb, 0x6a, 0xe6,
-	0x89, 0xae, 0xd6, 0x5f, 0xde, 0xd5, 0x1a, 0x59, 0x5e, 0x2e, 0x79, 0x2a, 0xbf, 0x60, 0x7b, 0xfb,
-	0x71, 0x05, 0x6a, 0xb9, 0xd8, 0x8d, 0x31, 0xf5, 0x3c, 0xf4, 0x5d, 0x3c, 0xcd, 0xcc, 0xe5, 0x99,
-	0xd9, 0x74, 0xe0, 0xc2, 0x13, 0x5d, 0xf6, 0x52, 0x8f, 0x47, 0x4d, 0x02, 0x95, 0x3b, 0xf1, 0x88,
-	0xdb, 0x11, 0x1b, 0xe9, 0x70, 0x34, 0xcb, 0x50, 0xba, 0x23, 0xa8, 0x88, 0xb9, 0x06, 0xfe, 0x5c,
-	0x85, 0x42, 0x8a, 0x90, 0x16, 0x14, 0xf8, 0x8c, 0x0b, 0x9c, 0xc8, 0x59, 0x8b, 0xdd, 0x4a, 0x3b,
-	0x79, 0xa3, 0xbd, 0x23, 0xa1, 0x84, 0xc2, 0x2d, 0x65, 0x27, 0xd7, 0x60, 0xd3, 0x0e, 0x26, 0x61,
-	0xe0, 0xa3, 0x2f, 0xd4, 0x42, 0xce, 0x4a, 0xf2, 0x0d, 0x8d, 0xa6, 0xfc, 0x9c, 0x45, 0x9a, 0x50,
-	0x88, 0xe5, 0xc9, 0x49, 0x1d, 0xd1, 0x40, 0xf2, 0x2d, 0x2a, 0x90, 0x5b, 0xca, 0x42, 0x3a, 0x50,
-	0x4a, 0xaf, 0x86, 0xb1, 0xcf, 0x1e, 0xc4, 0x28, 0x43, 0xb3, 0x48, 0xdd, 0x4a, 0x09, 0x77, 0xa5,
-	0x9d, 0x5c, 0x82, 0x33, 0xba, 0xab, 0x4a, 0xbf, 0x2f, 0x72, 0x33, 0x1b, 0x79, 0x1b, 0x8a, 0x79,
-	0x35, 0x71, 0x19, 0x8b, 0x45, 0xea, 0xbc, 0x99, 0x7c, 0x08, 0x73, 0xb5, 0xc7, 0xf5, 0x5a, 0xca,
-	0x27, 0x6e, 0xaa, 0xce, 0xb1, 0xd4, 0x82, 0xde, 0x83, 0x92, 0x93, 0xb5, 0xeb, 0xe4, 0x3c, 0x5a,
-	0x99, 0xf3, 0xe4, 0x6d, 0x8c, 0xec, 0xe4, 0xa5, 0xdc, 0x43, 0x6e, 0x2d, 0xd2, 0xc8, 0x65, 0xa8,
-	0xda, 0x81, 0xef, 0xa3, 0x2d, 0xd0, 0x19, 0x46, 0x41, 0x2c, 0x30, 0xe2, 0xb2, 0x55, 0x95, 0xac,
-	0x4a, 0x66, 0xb0, 0x52, 0x9c, 0x5c, 0x01, 0x92, 0x93, 0xc7, 0xd4, 0x77, 0xbc, 0x84, 0xbd, 0x2b,
-	0xd9, 0xb9, 0xcc, 0xa7, 0xca, 0xd0, 0xfc, 0x02, 0xf6, 0x7b, 0x61, 0x36, 0x95, 0x82, 0x2d, 0x74,
-	0x19, 0x17, 0xe9, 0x9b, 0xf5, 0x5c, 0xf2, 0x1a, 0xf3, 0xc9, 0x7b, 0x11, 0x40, 0xa9, 0xcf, 0x7d,
-	0x37, 0x50, 0xc8, 0x91, 0xd3, 0xfd, 0x61, 0x05, 0x0a, 0x07, 0xb2, 0xa5, 0x90, 0xeb, 0xb0, 0xd9,
-	0xe3, 0x3c, 0xb0, 0x59, 0xd2, 0x34, 0x76, 0x74, 0xa3, 0x59, 0x38, 0x29, 0xd7, 0x96, 0x9d, 0xaa,
-	0x5a, 0xc6, 0x55, 0x83, 0x7c, 0x06, 0x9b, 0x59, 0xaa, 0x12, 0x53, 0x33, 0x8f, 0x67, 0x6f, 0xed,
-	0xf5, 0xbc, 0x87, 0x2d, 0x39, 0x90, 0x5f, 0x35, 0xc8, 0x47, 0xb0, 0x71, 0x3b, 0x1e, 0x79, 0x8c,
-	0x8f, 0xc9, 0xb2, 0x39, 0x6b, 0xbb, 0xed, 0xf4, 0x03, 0x50, 0x5b, 0x7f, 0xda, 0x69, 0xdf, 0x9c,
-	0x84, 0x62, 0xd6, 0x32, 0x48, 0x1f, 0xce, 0xa8, 0xd2, 0x44, 0x52, 0x5f, 0xde, 0x32, 0xd3, 0xf5,
-	0x3c, 0xb3, 0xa7, 0x76, 0xbf, 0x37, 0xa0, 0x94, 0x3a, 0xa9, 0x4f, 0x7d, 0xea, 0x62, 0x44, 0xbe,
-	0x82, 0x5a, 0xea, 0x7c, 0x8c, 0x4e, 0x86, 0x85, 0x5c, 0xd2, 0x8a, 0x4f, 0x0f, 0xd9, 0xb2, 0x0d,
-	0x90, 0x2e, 0x6c, 0x7e, 0x82, 0x42, 0x15, 0x74, 0x16, 0x89, 0x85, 0x92, 0xaf, 0x6d, 0x2f, 0xc2,
-	0x07, 0x1f, 0xfc, 0xfa, 0x78, 0xdf, 0xf8, 0xed, 0xf1, 0xbe, 0xf1, 0xd7, 0xe3, 0x7d, 0xe3, 0xcb,
-	0xb7, 0x9e, 0xff, 0xdb, 0xda, 0xa8, 0x20, 0x67, 0x7f, 0xe7, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x4b, 0x13, 0x77, 0x15, 0x90, 0x13, 0x00, 0x00,
+	// 1347 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xed, 0x58, 0x5b, 0x6f, 0xe3, 0x44,
+	0x14, 0x56, 0x7a, 0x49, 0x9b, 0x93, 0xe6, 0xd2, 0xe9, 0xb6, 0x75, 0xb3, 0xf4, 0x42, 0x10, 0xab,
+	0xb2, 0xb0, 0x0e, 0x2d, 0x02, 0x16, 0x04, 0xac, 0xd2, 0x8b, 0x96, 0x22, 0x75, 0x59, 0xdc, 0x14,
+	0x24, 0x84, 0x14, 0x39, 0xf6, 0x34, 0xb5, 0xea, 0xda, 0xc1, 0x1e, 0x37, 0xcd, 0x33, 0xef, 0xfc,
+	0x06, 0xe0, 0x1f, 0xf0, 0x82, 0xc4, 0x1f, 0x40, 0x3c, 0xf2, 0xcc, 0x03, 0x20, 0x7e, 0x09, 0xc7,
+	0x73, 0x71, 0x6e, 0x4d, 0x5b, 0x56, 0x15, 0x17, 0x6d, 0x1f, 0x9c, 0xcc, 0x9c, 0xf3, 0xcd, 0x37,
+	0xe3, 0x73, 0xce, 0x9c, 0x39, 0x1e, 0x78, 0xbb, 0xe9, 0xb0, 0xe3, 0xa8, 0xa1, 0x5b, 0xfe, 0x69,
+	0xa5, 0x76, 0x4c, 0x6b, 0xc7, 0x8e, 0xd7, 0x0c, 0x9f, 0x50, 0xd6, 0xf6, 0x83, 0x93, 0x0a, 0x63,
+	0x5e, 0xc5, 0x6c, 0x39, 0x95, 0x46, 0xe0, 0x9f, 0xd0, 0x40, 0xfe, 0xe9, 0xad, 0xc0, 0x67, 0x3e,
+	0x49, 0x8b, 0x5e, 0xe9, 0x6e, 0xd3, 0xf7, 0x9b, 0x2e, 0xad, 0x70, 0x69, 0x23, 0x3a, 0xaa, 0xd0,
+	0xd3, 0x16, 0xeb, 0x08, 0x50, 0xe9, 0x41, 0x0f, 0x7b, 0xd3, 0x6f, 0xfa, 0x5d, 0x54, 0xdc, 0xe3,
+	0x1d, 0xde, 0x92, 0xf0, 0x59, 0x35, 0x21, 0x3e, 0x52, 0xb4, 0xaa, 0x44, 0xbc, 0x6b, 0xf9, 0x6e,
+	0xd2, 0x90, 0x80, 0x97, 0x87, 0x00, 0xae, 0x1f, 0x98, 0x6d, 0xd3, 0xab, 0xd8, 0xf4, 0xcc, 0xb1,
+	0xa8, 0x84, 0x2d, 0x2b, 0x58, 0xd3, 0x64, 0xb4, 0x6d, 0x76, 0xd4, 0xbf, 0x54, 0x2f, 0x29, 0x35,
+	0x0b, 0x4c, 0x8b, 0x8a, 0x5f, 0xa1, 0x2a, 0xff, 0x30, 0x06, 0xf9, 0x1d, 0xbf, 0xed, 0xb9, 0x8e,
+	0x77, 0xf2, 0x71, 0x8b, 0x39, 0xbe, 0x47, 0x56, 0x00, 0x1c, 0x9b, 0x7a, 0xcc, 0x39, 0x72, 0x68,
+	0xa0, 0xa5, 0xd6, 0x52, 0xeb, 0x19, 0xa3, 0x47, 0x42, 0x96, 0x01, 0x24, 0x7d, 0xdd, 0xb1, 0xb5,
+	0x31, 0xae, 0xcf, 0x48, 0xc9, 0x9e, 0x4d, 0xee, 0xc0, 0x64, 0x68, 0xf9, 0x01, 0xd5, 0xc6, 0x51,
+	0x93, 0x33, 0x44, 0x87, 0x94, 0x60, 0xda, 0xa6, 0xa6, 0x8d, 0xd3, 0x50, 0x6d, 0x02, 0x15, 0xe3,
+	0x46, 0xd2, 0x27, 0x5b, 0x50, 0x50, 0xaf, 0x57, 0xb7, 0x7c, 0xef, 0xc8, 0x69, 0x6a, 0x93, 0x08,
+	0xc9, 0x6e, 0x2e, 0xe9, 0x89, 0x39, 0x6a, 0xe7, 0xdb, 0x5c, 0x13, 0x05, 0x66, 0xbc, 0x48, 0x23,
+	0xaf, 0x34, 0x42, 0x4c, 0x1e, 0x41, 0x5e, 0x2d, 0x4a, 0x52, 0xa4, 0x39, 0x85, 0xa6, 0x2b, 0x53,
+	0x0c, 0x32, 0xe4, 0xa4, 0x42, 0x12, 0xe8, 0x90, 0x09, 0xce, 0xeb, 0x6d, 0xc7, 0xb3, 0xfd, 0xb6,
+	0x36, 0x85, 0x63, 0xf3, 0x9b, 0xb3, 0xba, 0x34, 0xb6, 0x6e, 0x9c, 0x7f, 0xc6, 0x15, 0xc6, 0x74,
+	0x20, 0x5b, 0xe5, 0xaf, 0x27, 0x20, 0x77, 0xd8, 0x8a, 0xcd, 0xb6, 0x4f, 0xc3, 0xd0, 0x6c, 0x52,
+	0xa2, 0xc1, 0x54, 0xcb, 0xec, 0xb8, 0xbe, 0x69, 0x73, 0xa3, 0xcd, 0x18, 0xaa, 0x4b, 0x5e, 0x85,
+	0xa9, 0x53, 0x01, 0xe2, 0xe6, 0xca, 0x22, 0x73, 0xf2, 0x62, 0x72, 0xb4, 0xa1, 0x10, 0xe4, 0x09,
+	0x4c, 0xa1, 0x6f, 0xeb, 0x34, 0x72, 0xb4, 0x6c, 0x4c, 0xb3, 0xf5, 0xe6, 0xaf, 0xbf, 0xad, 0x6e,
+	0x5c, 0x15, 0xc8, 0xb1, 0x91, 0x2b, 0xac, 0xd3, 0xa2, 0xa1, 0xbe, 0x43, 0xcf, 0x76, 0x0f, 0xf7,
+	0x8c, 0x34, 0xb2, 0xec, 0x46, 0x4e, 0xcc, 0x67, 0xb6, 0x5a, 0x9c, 0x6f, 0xe6, 0x99, 0xf8, 0xaa,
+	0xad, 0x16, 0xe7, 0x43, 0x96, 0x98, 0x6f, 0x1e, 0xe2, 0x56, 0xec, 0xfa, 0x1c, 0x77, 0xfd, 0x24,
+	0xf6, 0xd0, 0xed, 0x28, 0x8e, 0x97, 0x8d, 0xe2, 0xbc, 0x10, 0x63, 0x0f, 0xc5, 0x55, 0x98, 0x4d,
+	0x7c, 0x7b, 0x4a, 0x99, 0x69, 0x9b, 0xcc, 0xd4, 0xe6, 0xb9, 0x11, 0xee, 0x74, 0x8d, 0x60, 0x9c,
+	0xef, 0x4b, 0x9d, 0x51, 0x54, 0x42, 0x25, 0x21, 0x1f, 0x40, 0x51, 0xb9, 0x36, 0x61, 0x58, 0xe0,
+	0x0c, 0x73, 0x89, 0x73, 0x7b, 0x08, 0x0a, 0x52, 0x96, 0x8c, 0xaf, 0x42, 0xd1, 0x96, 0x11, 0x5e,
+	0xf7, 0x79, 0x88, 0x87, 0xda, 0xea, 0xda, 0x38, 0x8e, 0x5f, 0xd0, 0xe5, 0xa6, 0xef, 0xdf, 0x01,
+	0x46, 0xc1, 0xee, 0xeb, 0x87, 0xa4, 0x0c, 0x93, 0x7c, 0xd3, 0x68, 0xaf, 0xf0, 0x79, 0x67, 0x74,
+	0xb1, 0x85, 0x6a, 0xf1, 0xaf, 0x21, 0x54, 0xe5, 0xdf, 0xc7, 0xa1, 0xa0, 0x78, 0x6e, 0x43, 0xe2,
+	0x92, 0x90, 0x78, 0x04, 0x85, 0x01, 0x7f, 0xc8, 0x80, 0x18, 0xe5, 0x8e, 0x7c, 0xbf, 0x3b, 0x88,
+	0x01, 0x4b, 0xb6, 0x73, 0x46, 0x83, 0xd0, 0x61, 0x9d, 0xfa, 0x20, 0xd5, 0xc2, 0xa5, 0x54, 0x8b,
+	0xc9, 0xc0, 0x81, 0xa4, 0x97, 0x78, 0x78, 0x75, 0xb4, 0x87, 0x7f, 0x4a, 0x81, 0xb6, 0xc3, 0xd3,
+	0x6e, 0xd5, 0x62, 0xce, 0x99, 0x48, 0x23, 0x34, 0x6c, 0x61, 0x84, 0xdc, 0x98, 0xab, 0x2f, 0x30,
+	0x4e, 0xf6, 0x6f, 0x19, 0x27, 0x79, 0x91, 0xf9, 0xd1, 0x2f, 0xf2, 0x55, 0x1a, 0x96, 0x76, 0xa8,
+	0x1d, 0x61, 0xfa, 0xb2, 0x70, 0xb3, 0xd8, 0xb7, 0x79, 0xec, 0xdf, 0xcb, 0x63, 0xe3, 0xd7, 0xce,
+	0x63, 0xab, 0x90, 0x0d, 0x69, 0x80, 0xe1, 0x5b, 0x67, 0xce, 0x29, 0xd5, 0x16, 0xf9, 0x29, 0x0a,
+	0x42, 0x54, 0x43, 0x09, 0xd9, 0x81, 0xd9, 0x40, 0x86, 0x63, 0x9d, 0x61, 0xa1, 0xe2, 0x22, 0x81,
+	0x8c, 0xe7, 0xc5, 0xc1, 0xe8, 0x51, 0xee, 0x2a, 0xaa, 0x11, 0x35, 0x39, 0xe0, 0x3a, 0xb9, 0x8e,
+	0x6c, 0x00, 0x98, 0x76, 0x50, 0xb7, 0x8e, 0x4d, 0x0f, 0x63, 0x61, 0x8d, 0x03, 0x49, 0x72, 0x5a,
+	0x56, 0x77, 0x8c, 0x6d, 0xae, 0x31, 0x32, 0x88, 0x12, 0x4d, 0xf2, 0x09, 0x0e, 0x61, 0x2c, 0x70,
+	0x1a, 0x11, 0xa3, 0xa1, 0x76, 0x9f, 0xbf, 0xf7, 0x46, 0xb2, 0xaa, 0x51, 0xc1, 0xa8, 0x57, 0x93,
+	0x31, 0xbb, 0x1e, 0x0b, 0x3a, 0x46, 0x0f, 0x49, 0xe9, 0x7d, 0x28, 0x0c, 0xa8, 0x49, 0x11, 0xc6,
+	0x4f, 0x68, 0x47, 0x16, 0x2d, 0x71, 0x33, 0x2e, 0x47, 0xce, 0x4c, 0x37, 0xa2, 0xb2, 0x50, 0x11,
+	0x9d, 0x77, 0xc7, 0x1e, 0xa6, 0xca, 0x3f, 0x4e, 0xc0, 0xe2, 0xf0, 0x76, 0xfe, 0x32, 0xa2, 0x21,
+	0x7b, 0x5e, 0xf6, 0xc0, 0x7f, 0xe0, 0x74, 0xde, 0x87, 0x39, 0x33, 0x31, 0x7f, 0x97, 0x62, 0x91,
+	0x53, 0xbc, 0xd0, 0x5d, 0x44, 0xd7, 0x47, 0x09, 0x17, 0x31, 0x87, 0x64, 0xff, 0xd4, 0x61, 0xff,
+	0xcd, 0x24, 0xbc, 0xd4, 0x1b, 0xb4, 0xcf, 0x79, 0x1c, 0xfd, 0xef, 0x72, 0xe9, 0x0d, 0x47, 0xdd,
+	0x40, 0x6a, 0xd6, 0x86, 0x52, 0xf3, 0xfe, 0xe8, 0xd4, 0xbc, 0xd6, 0x4d, 0x82, 0x17, 0x97, 0x16,
+	0xcf, 0x96, 0xa3, 0xcb, 0xdf, 0x8f, 0x41, 0xa9, 0x4b, 0x86, 0x59, 0xd8, 0x75, 0x69, 0x9c, 0x93,
+	0x6f, 0x23, 0x73, 0x64, 0x64, 0x96, 0x6d, 0xb8, 0x7b, 0xa1, 0xc9, 0x6e, 0xb4, 0xc6, 0x2b, 0x13,
+	0x28, 0x1e, 0x44, 0x8d, 0xd0, 0xc2, 0x93, 0x4b, 0xb9, 0xa3, 0x5c, 0x80, 0xdc, 0x01, 0x33, 0x59,
+	0x14, 0x2a, 0x01, 0x7e, 0x4e, 0xa4, 0x85, 0x84, 0xac, 0x43, 0x3a, 0xec, 0x84, 0x18, 0x36, 0x7c,
+	0xd6, 0xec, 0x66, 0x51, 0x8f, 0x6f, 0x10, 0x0e, 0xb8, 0x28, 0x86, 0x84, 0x86, 0xd4, 0xe3, 0xb9,
+	0x9c, 0x41, 0x1b, 0xe1, 0x62, 0xf1, 0x63, 0x5d, 0x2e, 0x64, 0x8e, 0x83, 0xb7, 0x95, 0x54, 0xe0,
+	0xbb, 0x28, 0x0c, 0xa5, 0x74, 0xc4, 0x4f, 0x5c, 0x59, 0x67, 0x02, 0xc7, 0x1b, 0x18, 0x65, 0x48,
+	0x2b, 0x34, 0xa4, 0x02, 0x39, 0xd1, 0xaa, 0x47, 0x9e, 0x83, 0xcb, 0xe3, 0xae, 0xe9, 0x87, 0xce,
+	0x08, 0xc0, 0x21, 0xd7, 0x93, 0x7b, 0xf8, 0xb5, 0x2f, 0xb3, 0x2a, 0xb7, 0x7b, 0x3f, 0x36, 0xd1,
+	0x91, 0xd7, 0x20, 0xdb, 0xdd, 0x4d, 0x21, 0xf7, 0x45, 0x3f, 0xb4, 0x57, 0x4d, 0xde, 0x81, 0x9e,
+	0xbd, 0x17, 0xaa, 0xb5, 0x14, 0x86, 0x06, 0xcd, 0xf6, 0xa0, 0xe4, 0x82, 0xde, 0x82, 0x9c, 0x9d,
+	0xa4, 0xeb, 0xb8, 0xa8, 0x2e, 0xf6, 0x58, 0xf2, 0x29, 0x0d, 0xac, 0xf8, 0x76, 0xc3, 0xc5, 0xb1,
+	0xfd, 0x30, 0xf4, 0xeb, 0xac, 0xe5, 0x7b, 0x1e, 0xb5, 0x30, 0xc7, 0xd7, 0x03, 0x1f, 0x0b, 0x8d,
+	0x20, 0xe4, 0xa9, 0x2a, 0x67, 0x14, 0x13, 0x85, 0x21, 0xe4, 0xe4, 0x01, 0x90, 0x2e, 0x18, 0xab,
+	0x1e, 0xdb, 0x8d, 0xd1, 0x0b, 0x1c, 0xdd, 0xa5, 0xf9, 0x50, 0x2a, 0xca, 0x9f, 0xc2, 0x0a, 0x06,
+	0xab, 0x9a, 0x4a, 0x8a, 0x0d, 0xda, 0x74, 0x42, 0x26, 0xae, 0x28, 0x7a, 0x82, 0x37, 0xd5, 0x1b,
+	0xbc, 0xcb, 0x00, 0x92, 0xbd, 0xe7, 0x02, 0x46, 0x4a, 0xf6, 0xec, 0xcd, 0xef, 0xc6, 0x20, 0xbd,
+	0xc5, 0x53, 0x0a, 0x7e, 0x4d, 0x64, 0xaa, 0x61, 0xe8, 0x5b, 0x4e, 0x9c, 0x34, 0xe6, 0x55, 0xa2,
+	0xe9, 0xab, 0xb0, 0x4a, 0xa3, 0x4a, 0xc3, 0xf5, 0xd4, 0xeb, 0x29, 0xf2, 0x11, 0x64, 0x92, 0x50,
+	0x25, 0x9a, 0x42, 0x0e, 0x46, 0x6f, 0xe9, 0xc5, 0x2b, 0x0b, 0x39, 0xe4, 0x7a, 0x0f, 0xa6, 0x9e,
+	0x46, 0x0d, 0xd7, 0x09, 0x8f, 0xc9, 0xa8, 0x39, 0x4b, 0x0b, 0xba, 0xb8, 0x70, 0xd3, 0xd5, 0x55,
+	0x9a, 0xbe, 0x1b, 0x5f, 0xb8, 0xad, 0xa7, 0x30, 0x83, 0x4e, 0xcb, 0xad, 0x49, 0xc9, 0xea, 0xe8,
+	0x94, 0x29, 0xd6, 0x73, 0x65, 0x4e, 0xdd, 0xfc, 0x36, 0x05, 0x39, 0x61, 0xa4, 0x7d, 0xd3, 0xc3,
+	0x99, 0x03, 0xf2, 0x05, 0x94, 0x84, 0xf1, 0x69, 0x30, 0xec, 0x16, 0x72, 0x4f, 0x31, 0x5e, 0xee,
+	0xb2, 0x51, 0x2f, 0x40, 0x36, 0x21, 0xf3, 0x98, 0x32, 0xb9, 0xa1, 0x13, 0x4f, 0xf4, 0x6d, 0xf9,
+	0x52, 0xbe, 0x5f, 0xbc, 0xf5, 0xf0, 0xe7, 0x3f, 0x57, 0x52, 0xbf, 0xe0, 0xf3, 0x07, 0x3e, 0x9f,
+	0xdf, 0xbf, 0xfe, 0x5d, 0x66, 0x23, 0xcd, 0x67, 0x7f, 0xe3, 0x2f, 0x24, 0x45, 0x88, 0x72, 0x00,
+	0x15, 0x00, 0x00,
 }
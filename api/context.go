@@ -4,11 +4,15 @@
 package api
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 // MetadataFromContext gets the metadata from the given context
@@ -158,9 +162,76 @@ func ContextWithLimitAndOffset(ctx context.Context, limit, offset uint64) contex
 	return contextWithMergedMetadata(ctx, pairs...)
 }
 
+// CursorFromMetadata gets the cursor of the page to list from the metadata
+func CursorFromMetadata(md metadata.MD) string {
+	cursor, ok := md["cursor"]
+	if !ok || len(cursor) == 0 {
+		return ""
+	}
+	return cursor[0]
+}
+
+// SortFromMetadata gets the field to sort lists by from the metadata. A field with a - prefix indicates descending order
+func SortFromMetadata(md metadata.MD) (field string, descending bool) {
+	sort, ok := md["sort"]
+	if !ok || len(sort) == 0 {
+		return "", false
+	}
+	if strings.HasPrefix(sort[0], "-") {
+		return strings.TrimPrefix(sort[0], "-"), true
+	}
+	return sort[0], false
+}
+
+// FiltersFromMetadata gets the filters for lists from the metadata. Filters have the format field=value
+func FiltersFromMetadata(md metadata.MD) (map[string]string, error) {
+	filters := make(map[string]string)
+	for _, filter := range md["filter"] {
+		parts := strings.SplitN(filter, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.NewErrInvalidArgument("Filter", fmt.Sprintf(`"%s" does not have the format field=value`, filter))
+		}
+		filters[parts[0]] = parts[1]
+	}
+	return filters, nil
+}
+
+// ContextWithListOptions returns a context with the cursor, sort field and filters for lists
+func ContextWithListOptions(ctx context.Context, cursor, sort string, filters map[string]string) context.Context {
+	var pairs []string
+	if cursor != "" {
+		pairs = append(pairs, "cursor", cursor)
+	}
+	if sort != "" {
+		pairs = append(pairs, "sort", sort)
+	}
+	for field, value := range filters {
+		pairs = append(pairs, "filter", field+"="+value)
+	}
+	if len(pairs) == 0 {
+		return ctx
+	}
+	return contextWithMergedMetadata(ctx, pairs...)
+}
+
+// ClientCertificateFromContext gets the common name of the verified client certificate of the peer, which is the ID
+// of the gateway or integration that the certificate was issued to, or returns ErrNoClientCertificate
+func ClientCertificateFromContext(ctx context.Context) (string, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", ErrNoClientCertificate
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return "", ErrNoClientCertificate
+	}
+	return tlsInfo.State.VerifiedChains[0][0].Subject.CommonName, nil
+}
+
 // Errors that are returned when an item could not be retrieved
 var (
-	ErrNoToken = errors.NewErrInvalidArgument("Metadata", "token missing")
-	ErrNoKey   = errors.NewErrInvalidArgument("Metadata", "key missing")
-	ErrNoID    = errors.NewErrInvalidArgument("Metadata", "id missing")
+	ErrNoToken             = errors.NewErrInvalidArgument("Metadata", "token missing")
+	ErrNoKey               = errors.NewErrInvalidArgument("Metadata", "key missing")
+	ErrNoID                = errors.NewErrInvalidArgument("Metadata", "id missing")
+	ErrNoClientCertificate = errors.NewErrInvalidArgument("TLS", "client certificate missing")
 )
@@ -5,9 +5,14 @@ package api
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"testing"
 
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 
 	. "github.com/smartystreets/assertions"
 )
@@ -93,6 +98,19 @@ func TestContext(t *testing.T) {
 		a.So(limit, ShouldEqual, 2)
 		a.So(offset, ShouldEqual, 4)
 
+		ctx = ContextWithListOptions(ctx, "cursor", "-last_seen", map[string]string{"dev_eui": "0102030405060708"})
+		md := MetadataFromContext(ctx)
+		a.So(CursorFromMetadata(md), ShouldEqual, "cursor")
+		sort, descending := SortFromMetadata(md)
+		a.So(sort, ShouldEqual, "last_seen")
+		a.So(descending, ShouldBeTrue)
+		filters, err := FiltersFromMetadata(md)
+		a.So(err, ShouldBeNil)
+		a.So(filters, ShouldResemble, map[string]string{"dev_eui": "0102030405060708"})
+
+		_, err = FiltersFromMetadata(metadata.Pairs("filter", "dev_eui"))
+		a.So(err, ShouldNotBeNil)
+
 		// Try the token again
 		token, err = TokenFromContext(ctx)
 		a.So(err, ShouldBeNil)
@@ -107,4 +125,22 @@ func TestContext(t *testing.T) {
 		a.So(offset, ShouldEqual, 0)
 	}
 
+	// Client certificates
+	{
+		_, err := ClientCertificateFromContext(context.Background())
+		a.So(err, ShouldNotBeNil)
+
+		ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{}})
+		_, err = ClientCertificateFromContext(ctx)
+		a.So(err, ShouldNotBeNil)
+
+		cert := &x509.Certificate{Subject: pkix.Name{CommonName: "eui-0102030405060708"}}
+		ctx = peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{cert}},
+		}}})
+		commonName, err := ClientCertificateFromContext(ctx)
+		a.So(err, ShouldBeNil)
+		a.So(commonName, ShouldEqual, "eui-0102030405060708")
+	}
+
 }
@@ -13,6 +13,7 @@
 		RxMetadata
 		TxConfiguration
 		Status
+		AntennaConfiguration
 */
 package gateway
 
@@ -193,6 +194,10 @@ type RxMetadata_Antenna struct {
 	Rssi float32 `protobuf:"fixed32,3,opt,name=rssi,proto3" json:"rssi,omitempty"`
 	// Signal-to-noise-ratio in dB
 	Snr float32 `protobuf:"fixed32,4,opt,name=snr,proto3" json:"snr,omitempty"`
+	// The value of Gain is set by the Router from the antenna configuration of the gateway (dBi)
+	Gain float32 `protobuf:"fixed32,5,opt,name=gain,proto3" json:"gain,omitempty"`
+	// The value of Gps is set by the Router from the antenna configuration of the gateway
+	Gps *GPSMetadata `protobuf:"bytes,6,opt,name=gps" json:"gps,omitempty"`
 	// Encrypted time from the Gateway FPGA
 	EncryptedTime []byte `protobuf:"bytes,10,opt,name=encrypted_time,json=encryptedTime,proto3" json:"encrypted_time,omitempty"`
 }
@@ -230,6 +235,20 @@ func (m *RxMetadata_Antenna) GetSnr() float32 {
 	return 0
 }
 
+func (m *RxMetadata_Antenna) GetGain() float32 {
+	if m != nil {
+		return m.Gain
+	}
+	return 0
+}
+
+func (m *RxMetadata_Antenna) GetGps() *GPSMetadata {
+	if m != nil {
+		return m.Gps
+	}
+	return nil
+}
+
 func (m *RxMetadata_Antenna) GetEncryptedTime() []byte {
 	if m != nil {
 		return m.EncryptedTime
@@ -312,7 +331,7 @@ type Status struct {
 	Platform     string   `protobuf:"bytes,12,opt,name=platform,proto3" json:"platform,omitempty"`
 	ContactEmail string   `protobuf:"bytes,13,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
 	Description  string   `protobuf:"bytes,14,opt,name=description,proto3" json:"description,omitempty"`
-	// The gateway's frequency plan: one of EU_863_870, US_902_928, CN_779_787, EU_433, AU_915_928, CN_470_510, AS_923, AS_920_923, AS_923_925, KR_920_923
+	// The gateway's frequency plan: one of EU_863_870, US_902_928, CN_779_787, EU_433, AU_915_928, CN_470_510, AS_923, AS_920_923, AS_923_925, KR_920_923, ISM_2400
 	FrequencyPlan string `protobuf:"bytes,15,opt,name=frequency_plan,json=frequencyPlan,proto3" json:"frequency_plan,omitempty"`
 	// The value of Bridge is set by the Bridge
 	Bridge string `protobuf:"bytes,16,opt,name=bridge,proto3" json:"bridge,omitempty"`
@@ -325,6 +344,10 @@ type Status struct {
 	// Version of gateway driver (in X.X.X format)
 	Hal string       `protobuf:"bytes,20,opt,name=hal,proto3" json:"hal,omitempty"`
 	Gps *GPSMetadata `protobuf:"bytes,21,opt,name=gps" json:"gps,omitempty"`
+	// Name and version of the packet forwarder (in "name X.X.X" format)
+	PacketForwarder string `protobuf:"bytes,22,opt,name=packet_forwarder,json=packetForwarder,proto3" json:"packet_forwarder,omitempty"`
+	// Configuration of the antennas of the gateway
+	Antennas []*AntennaConfiguration `protobuf:"bytes,23,rep,name=antennas" json:"antennas,omitempty"`
 	// Round-trip time to the server in milliseconds
 	Rtt uint32 `protobuf:"varint,31,opt,name=rtt,proto3" json:"rtt,omitempty"`
 	// Total number of received uplink packets since boot
@@ -456,6 +479,20 @@ func (m *Status) GetGps() *GPSMetadata {
 	return nil
 }
 
+func (m *Status) GetPacketForwarder() string {
+	if m != nil {
+		return m.PacketForwarder
+	}
+	return ""
+}
+
+func (m *Status) GetAntennas() []*AntennaConfiguration {
+	if m != nil {
+		return m.Antennas
+	}
+	return nil
+}
+
 func (m *Status) GetRtt() uint32 {
 	if m != nil {
 		return m.Rtt
@@ -583,6 +620,42 @@ func (m *Status_OSMetrics) GetTemperature() float32 {
 	return 0
 }
 
+// message AntennaConfiguration represents the configuration of an antenna of a gateway
+type AntennaConfiguration struct {
+	// Index of the antenna, as in the RxMetadata of uplink messages
+	Antenna uint32 `protobuf:"varint,1,opt,name=antenna,proto3" json:"antenna,omitempty"`
+	// Gain of the antenna in dBi
+	Gain float32 `protobuf:"fixed32,2,opt,name=gain,proto3" json:"gain,omitempty"`
+	// Location of the antenna
+	Gps *GPSMetadata `protobuf:"bytes,3,opt,name=gps" json:"gps,omitempty"`
+}
+
+func (m *AntennaConfiguration) Reset()                    { *m = AntennaConfiguration{} }
+func (m *AntennaConfiguration) String() string            { return proto.CompactTextString(m) }
+func (*AntennaConfiguration) ProtoMessage()               {}
+func (*AntennaConfiguration) Descriptor() ([]byte, []int) { return fileDescriptorGateway, []int{4} }
+
+func (m *AntennaConfiguration) GetAntenna() uint32 {
+	if m != nil {
+		return m.Antenna
+	}
+	return 0
+}
+
+func (m *AntennaConfiguration) GetGain() float32 {
+	if m != nil {
+		return m.Gain
+	}
+	return 0
+}
+
+func (m *AntennaConfiguration) GetGps() *GPSMetadata {
+	if m != nil {
+		return m.Gps
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GPSMetadata)(nil), "gateway.GPSMetadata")
 	proto.RegisterType((*RxMetadata)(nil), "gateway.RxMetadata")
@@ -590,6 +663,7 @@ func init() {
 	proto.RegisterType((*TxConfiguration)(nil), "gateway.TxConfiguration")
 	proto.RegisterType((*Status)(nil), "gateway.Status")
 	proto.RegisterType((*Status_OSMetrics)(nil), "gateway.Status.OSMetrics")
+	proto.RegisterType((*AntennaConfiguration)(nil), "gateway.AntennaConfiguration")
 }
 func (m *GPSMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -781,6 +855,21 @@ func (m *RxMetadata_Antenna) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintGateway(dAtA, i, uint64(len(m.EncryptedTime)))
 		i += copy(dAtA[i:], m.EncryptedTime)
 	}
+	if m.Gain != 0 {
+		dAtA[i] = 0x2d
+		i++
+		i = encodeFixed32Gateway(dAtA, i, uint32(math.Float32bits(float32(m.Gain))))
+	}
+	if m.Gps != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintGateway(dAtA, i, uint64(m.Gps.Size()))
+		n101, err := m.Gps.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n101
+	}
 	return i, nil
 }
 
@@ -976,6 +1065,14 @@ func (m *Status) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n2
 	}
+	if len(m.PacketForwarder) > 0 {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.PacketForwarder)))
+		i += copy(dAtA[i:], m.PacketForwarder)
+	}
 	if m.Rtt != 0 {
 		dAtA[i] = 0xf8
 		i++
@@ -1051,6 +1148,20 @@ func (m *Status) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n3
 	}
+	if len(m.Antennas) > 0 {
+		for _, msg := range m.Antennas {
+			dAtA[i] = 0xba
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintGateway(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -1106,6 +1217,44 @@ func (m *Status_OSMetrics) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *AntennaConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AntennaConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Antenna != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintGateway(dAtA, i, uint64(m.Antenna))
+	}
+	if m.Gain != 0 {
+		dAtA[i] = 0x15
+		i++
+		i = encodeFixed32Gateway(dAtA, i, uint32(math.Float32bits(float32(m.Gain))))
+	}
+	if m.Gps != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGateway(dAtA, i, uint64(m.Gps.Size()))
+		n102, err := m.Gps.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n102
+	}
+	return i, nil
+}
+
 func encodeFixed64Gateway(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	dAtA[offset+1] = uint8(v >> 8)
@@ -1218,6 +1367,13 @@ func (m *RxMetadata_Antenna) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGateway(uint64(l))
 	}
+	if m.Gain != 0 {
+		n += 5
+	}
+	if m.Gps != nil {
+		l = m.Gps.Size()
+		n += 1 + l + sovGateway(uint64(l))
+	}
 	return n
 }
 
@@ -1304,6 +1460,10 @@ func (m *Status) Size() (n int) {
 		l = m.Gps.Size()
 		n += 2 + l + sovGateway(uint64(l))
 	}
+	l = len(m.PacketForwarder)
+	if l > 0 {
+		n += 2 + l + sovGateway(uint64(l))
+	}
 	if m.Rtt != 0 {
 		n += 2 + sovGateway(uint64(m.Rtt))
 	}
@@ -1335,6 +1495,12 @@ func (m *Status) Size() (n int) {
 		l = m.Os.Size()
 		n += 2 + l + sovGateway(uint64(l))
 	}
+	if len(m.Antennas) > 0 {
+		for _, e := range m.Antennas {
+			l = e.Size()
+			n += 2 + l + sovGateway(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1362,6 +1528,22 @@ func (m *Status_OSMetrics) Size() (n int) {
 	return n
 }
 
+func (m *AntennaConfiguration) Size() (n int) {
+	var l int
+	_ = l
+	if m.Antenna != 0 {
+		n += 1 + sovGateway(uint64(m.Antenna))
+	}
+	if m.Gain != 0 {
+		n += 5
+	}
+	if m.Gps != nil {
+		l = m.Gps.Size()
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	return n
+}
+
 func sovGateway(x uint64) (n int) {
 	for {
 		n++
@@ -1934,6 +2116,53 @@ func (m *RxMetadata_Antenna) Unmarshal(dAtA []byte) error {
 				m.EncryptedTime = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gain", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.Gain = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gps == nil {
+				m.Gps = &GPSMetadata{}
+			}
+			if err := m.Gps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -2529,6 +2758,35 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketForwarder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketForwarder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 31:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rtt", wireType)
@@ -2733,6 +2991,37 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Antennas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Antennas = append(m.Antennas, &AntennaConfiguration{})
+			if err := m.Antennas[len(m.Antennas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -2888,6 +3177,123 @@ func (m *Status_OSMetrics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AntennaConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AntennaConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AntennaConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Antenna", wireType)
+			}
+			m.Antenna = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Antenna |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gain", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.Gain = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gps == nil {
+				m.Gps = &GPSMetadata{}
+			}
+			if err := m.Gps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipGateway(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2998,63 +3404,66 @@ func init() {
 }
 
 var fileDescriptorGateway = []byte{
-	// 914 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x4f, 0x73, 0xdc, 0x34,
-	0x14, 0xc0, 0xc7, 0xde, 0x4d, 0xb2, 0xab, 0x8d, 0x93, 0x54, 0xf9, 0x53, 0x35, 0x85, 0x60, 0xc2,
-	0x00, 0x5b, 0x42, 0x77, 0x49, 0x3b, 0x19, 0xa6, 0x47, 0x28, 0x0c, 0x93, 0x03, 0x6d, 0x46, 0xc9,
-	0x89, 0x8b, 0x47, 0xb1, 0xb5, 0x5e, 0x4d, 0x6c, 0x49, 0xc8, 0x72, 0x37, 0xe1, 0x23, 0x70, 0xe6,
-	0x43, 0xf5, 0xc8, 0x99, 0x13, 0x93, 0x03, 0x9f, 0x83, 0xd1, 0xf3, 0x9f, 0x75, 0x68, 0xa0, 0xc3,
-	0x69, 0xdf, 0xfb, 0xbd, 0x27, 0xe9, 0xfd, 0x5d, 0xa3, 0x17, 0xa9, 0xb0, 0xf3, 0xf2, 0x72, 0x12,
-	0xab, 0x7c, 0x7a, 0x31, 0xe7, 0x17, 0x73, 0x21, 0xd3, 0xe2, 0x15, 0xb7, 0x0b, 0x65, 0xae, 0xa6,
-	0xd6, 0xca, 0x29, 0xd3, 0x62, 0x9a, 0x32, 0xcb, 0x17, 0xec, 0xa6, 0xf9, 0x9d, 0x68, 0xa3, 0xac,
-	0xc2, 0x6b, 0xb5, 0xba, 0xff, 0xb4, 0x73, 0x47, 0xaa, 0x52, 0x35, 0x05, 0xfb, 0x65, 0x39, 0x03,
-	0x0d, 0x14, 0x90, 0xaa, 0x73, 0x87, 0x0b, 0x34, 0xfa, 0xe1, 0xec, 0xfc, 0x47, 0x6e, 0x59, 0xc2,
-	0x2c, 0xc3, 0x18, 0xf5, 0xad, 0xc8, 0x39, 0xf1, 0x42, 0x6f, 0xdc, 0xa3, 0x20, 0xe3, 0x7d, 0x34,
-	0xc8, 0x98, 0x15, 0xb6, 0x4c, 0x38, 0xf1, 0x43, 0x6f, 0xec, 0xd3, 0x56, 0xc7, 0x1f, 0xa0, 0x61,
-	0xa6, 0x64, 0x5a, 0x19, 0x7b, 0x60, 0x5c, 0x02, 0x77, 0x92, 0x65, 0xf5, 0xc9, 0x7e, 0xe8, 0x8d,
-	0x57, 0x68, 0xab, 0x1f, 0xfe, 0xd6, 0x47, 0x88, 0x5e, 0xb7, 0x0f, 0x7f, 0x88, 0x50, 0x9d, 0x41,
-	0x24, 0x12, 0x78, 0x7e, 0x48, 0x87, 0x35, 0x39, 0x4d, 0xf0, 0xe7, 0x68, 0xb3, 0x31, 0x5b, 0x53,
-	0x16, 0x96, 0x27, 0x10, 0xca, 0x80, 0x6e, 0xd4, 0xf8, 0xa2, 0xa2, 0x2e, 0x20, 0x17, 0x74, 0x61,
-	0x59, 0xae, 0xc9, 0x28, 0xf4, 0xc6, 0x01, 0x5d, 0x82, 0x36, 0xbd, 0xf5, 0x4e, 0x7a, 0x9f, 0xa2,
-	0x0d, 0x2e, 0x63, 0x73, 0xa3, 0x2d, 0x4f, 0x22, 0xb0, 0x06, 0xa1, 0x37, 0x5e, 0xa7, 0x41, 0x4b,
-	0x2f, 0x9c, 0xdb, 0x23, 0x34, 0x30, 0xb3, 0x28, 0x9e, 0x33, 0x21, 0xc9, 0x2e, 0xdc, 0xbb, 0x66,
-	0x66, 0x2f, 0x9d, 0x8a, 0x09, 0x5a, 0x8b, 0xe7, 0x4c, 0x4a, 0x9e, 0x91, 0xbd, 0xca, 0x52, 0xab,
-	0xf8, 0x6b, 0x34, 0x60, 0xd2, 0x72, 0x29, 0x59, 0x41, 0x0e, 0xc2, 0xde, 0x78, 0xf4, 0xec, 0xf1,
-	0xa4, 0xe9, 0xdb, 0x32, 0xf9, 0xc9, 0x37, 0x95, 0x0f, 0x6d, 0x9d, 0x5d, 0x1a, 0x33, 0xc3, 0x7f,
-	0x2e, 0xb9, 0x8c, 0x6f, 0xc8, 0x47, 0xa1, 0x37, 0xee, 0xd3, 0x25, 0x70, 0x69, 0x98, 0xa2, 0x10,
-	0x24, 0x84, 0x82, 0x83, 0x8c, 0xb7, 0x50, 0xaf, 0x90, 0x86, 0x7c, 0x0c, 0xc8, 0x89, 0xf8, 0x33,
-	0xd4, 0x4b, 0x75, 0x41, 0x9e, 0x84, 0xde, 0x78, 0xf4, 0x6c, 0xa7, 0x7d, 0xb7, 0xd3, 0x6e, 0xea,
-	0x1c, 0xf6, 0x7f, 0xf5, 0xd0, 0x5a, 0x1d, 0x81, 0x4b, 0xa5, 0x8e, 0x01, 0x7a, 0x10, 0xd0, 0x46,
-	0xed, 0x26, 0xe9, 0xdf, 0x4d, 0xb2, 0x89, 0xa6, 0xf7, 0x6e, 0x34, 0xfd, 0x65, 0x34, 0xef, 0x96,
-	0x19, 0xdd, 0x53, 0xe6, 0xc3, 0xbf, 0x3c, 0xb4, 0x79, 0x71, 0xfd, 0x52, 0xc9, 0x99, 0x48, 0x4b,
-	0xc3, 0xac, 0x50, 0xf2, 0x3d, 0x3d, 0xfd, 0x8f, 0xc6, 0xdc, 0xa9, 0xe2, 0xde, 0x3f, 0xab, 0xb8,
-	0x83, 0x56, 0xb4, 0x5a, 0x70, 0x43, 0x1e, 0xc2, 0x68, 0x56, 0x0a, 0x3e, 0x41, 0x7b, 0x5a, 0x65,
-	0xcc, 0x88, 0x5f, 0xe0, 0xf1, 0x48, 0xc8, 0x37, 0xdc, 0x14, 0x42, 0x49, 0x68, 0xc3, 0x80, 0xee,
-	0x76, 0xad, 0xa7, 0x8d, 0x11, 0x4f, 0xd1, 0x76, 0x7b, 0x73, 0x94, 0xf0, 0x37, 0x02, 0xec, 0xd0,
-	0xa1, 0x80, 0xe2, 0xd6, 0xf4, 0x5d, 0x63, 0x39, 0xfc, 0x63, 0x15, 0xad, 0x9e, 0x5b, 0x66, 0xcb,
-	0xe2, 0x6e, 0x7e, 0xde, 0xbf, 0xcd, 0xac, 0xdf, 0x99, 0xd9, 0x7b, 0xd6, 0xa1, 0x77, 0xef, 0x3a,
-	0x3c, 0x46, 0xc3, 0x4b, 0xa5, 0x6c, 0x55, 0xf0, 0x3e, 0xdc, 0x30, 0x70, 0x00, 0x46, 0x7a, 0x03,
-	0xf9, 0xc2, 0x15, 0xb4, 0x37, 0x1e, 0x52, 0x5f, 0x68, 0xb7, 0xae, 0x3a, 0x63, 0x76, 0xa6, 0x4c,
-	0x0e, 0x1b, 0x32, 0xa4, 0xad, 0x8e, 0x3f, 0x41, 0x41, 0xac, 0xa4, 0x65, 0xb1, 0x8d, 0x78, 0xce,
-	0x44, 0x06, 0x4b, 0x32, 0xa4, 0xeb, 0x35, 0xfc, 0xde, 0x31, 0x1c, 0xa2, 0x51, 0xc2, 0x8b, 0xd8,
-	0x08, 0x0d, 0xc9, 0x6f, 0x80, 0x4b, 0x17, 0xb9, 0x29, 0x58, 0x96, 0x49, 0x67, 0x4c, 0x92, 0x4d,
-	0x70, 0x0a, 0x5a, 0x7a, 0x96, 0x31, 0x89, 0xf7, 0xd0, 0xea, 0xa5, 0x11, 0x49, 0xca, 0xc9, 0x16,
-	0x98, 0x6b, 0xcd, 0x71, 0xa3, 0x4a, 0xcb, 0x0d, 0x79, 0x50, 0xf1, 0x4a, 0x73, 0x35, 0x9a, 0xe9,
-	0x94, 0x11, 0x0c, 0xc5, 0x03, 0xd9, 0x8d, 0x60, 0x52, 0x68, 0xb2, 0x0d, 0xc8, 0x89, 0x8e, 0xcc,
-	0x59, 0x46, 0x76, 0xe0, 0xa8, 0x13, 0x9b, 0x15, 0xd9, 0x7d, 0xcf, 0x8a, 0xb8, 0x93, 0xc6, 0x5a,
-	0x98, 0x80, 0x80, 0x3a, 0x11, 0x6f, 0xa3, 0x15, 0x73, 0x1d, 0x09, 0x09, 0xeb, 0x15, 0xd0, 0xbe,
-	0xb9, 0x3e, 0x95, 0x35, 0x54, 0x57, 0xe4, 0x8b, 0x06, 0xbe, 0xbe, 0x72, 0xd0, 0x82, 0xe7, 0x51,
-	0x05, 0x6d, 0xed, 0x69, 0xc1, 0xf3, 0xcb, 0x06, 0x56, 0x9e, 0x59, 0xee, 0xe0, 0xd3, 0x0a, 0x66,
-	0x79, 0x0b, 0x0b, 0x4b, 0x26, 0x0d, 0x3c, 0xb7, 0x35, 0x94, 0x0b, 0x32, 0x6d, 0xe0, 0xab, 0x05,
-	0xc0, 0x48, 0xeb, 0x82, 0x7c, 0x55, 0xc3, 0x33, 0x5d, 0xe0, 0x27, 0xc8, 0x57, 0x05, 0x79, 0x0e,
-	0x09, 0x3e, 0x6a, 0x13, 0xac, 0x06, 0x6f, 0xf2, 0xda, 0xa5, 0x69, 0x44, 0x5c, 0x50, 0x5f, 0x15,
-	0xfb, 0x6f, 0x3d, 0x34, 0x6c, 0x09, 0xde, 0x45, 0xab, 0x99, 0x62, 0x49, 0x74, 0x0c, 0x13, 0xe9,
-	0xd3, 0x15, 0xa7, 0x1d, 0xb7, 0xf8, 0xa4, 0xfe, 0x14, 0x00, 0x3e, 0xc1, 0x0f, 0xd1, 0x5a, 0xe5,
-	0x7d, 0x52, 0xff, 0x0d, 0x80, 0xd7, 0xf1, 0x89, 0x6b, 0x78, 0xac, 0xcb, 0x48, 0x73, 0x13, 0x73,
-	0x69, 0x59, 0xca, 0x61, 0x81, 0x7d, 0x1a, 0xc4, 0xba, 0x3c, 0x6b, 0x21, 0x3e, 0x42, 0x0f, 0x72,
-	0x9e, 0x2b, 0x73, 0xd3, 0xf5, 0xdc, 0x05, 0xcf, 0xad, 0xca, 0xd0, 0x71, 0x0e, 0xd1, 0xc8, 0xf2,
-	0x5c, 0x73, 0xc3, 0x6c, 0x69, 0x38, 0x74, 0xc5, 0xa7, 0x5d, 0xf4, 0xed, 0x8b, 0xb7, 0xb7, 0x07,
-	0xde, 0xef, 0xb7, 0x07, 0xde, 0x9f, 0xb7, 0x07, 0xde, 0x4f, 0x47, 0xff, 0xe3, 0xb3, 0x7a, 0xb9,
-	0x0a, 0xdf, 0xc5, 0xe7, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x0a, 0x52, 0xe3, 0x8c, 0x07,
-	0x00, 0x00,
+	// 975 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x95, 0x96, 0x4b, 0x73, 0xdb, 0x36,
+	0x10, 0x80, 0x47, 0x6f, 0x09, 0xb2, 0x6c, 0x07, 0xb6, 0x64, 0xc4, 0x69, 0x52, 0x57, 0x9d, 0xb6,
+	0x49, 0xdd, 0x48, 0x75, 0x32, 0x9a, 0x8e, 0x8f, 0x6d, 0xfa, 0x18, 0x1f, 0x9a, 0x78, 0x68, 0x9d,
+	0x7a, 0xe1, 0x40, 0x24, 0x44, 0x71, 0x4c, 0x12, 0x2c, 0x08, 0x46, 0x76, 0x7f, 0x5d, 0x4f, 0x9d,
+	0x1c, 0xf3, 0x13, 0x3a, 0x3d, 0xf4, 0x77, 0x74, 0xb1, 0x7c, 0x88, 0xae, 0xdd, 0x78, 0x7a, 0xd0,
+	0x68, 0xf7, 0xdb, 0xc5, 0x02, 0xfb, 0x00, 0x24, 0x72, 0xea, 0xf9, 0x7a, 0x95, 0x2e, 0x26, 0x8e,
+	0x0c, 0xa7, 0xf3, 0x95, 0x98, 0xaf, 0xfc, 0xc8, 0x4b, 0x5e, 0x0b, 0xbd, 0x96, 0xea, 0x72, 0xaa,
+	0x75, 0x34, 0xe5, 0xb1, 0x3f, 0xf5, 0xb8, 0x16, 0x6b, 0x7e, 0x5d, 0x7c, 0x4f, 0x62, 0x25, 0xb5,
+	0xa4, 0x9d, 0x5c, 0x3d, 0x7c, 0x5e, 0x89, 0xe1, 0x49, 0x4f, 0x4e, 0xd1, 0xbe, 0x48, 0x97, 0xa8,
+	0xa1, 0x82, 0x52, 0xb6, 0x6e, 0xbc, 0x26, 0xfd, 0x9f, 0xce, 0x2f, 0x7e, 0x16, 0x9a, 0xbb, 0x5c,
+	0x73, 0x4a, 0x49, 0x53, 0xfb, 0xa1, 0x60, 0xb5, 0xa3, 0xda, 0xd3, 0x86, 0x85, 0x32, 0x3d, 0x24,
+	0xdd, 0x80, 0x6b, 0x5f, 0xa7, 0xae, 0x60, 0x75, 0xe0, 0x75, 0xab, 0xd4, 0xe9, 0x47, 0xa4, 0x17,
+	0xc8, 0xc8, 0xcb, 0x8c, 0x0d, 0x34, 0x6e, 0x80, 0x59, 0xc9, 0x83, 0x7c, 0x65, 0x13, 0x8c, 0x2d,
+	0xab, 0xd4, 0xc7, 0xef, 0x9b, 0x84, 0x58, 0x57, 0xe5, 0xc6, 0x8f, 0x09, 0xc9, 0x33, 0xb0, 0x7d,
+	0x17, 0xb7, 0xef, 0x59, 0xbd, 0x9c, 0x9c, 0xb9, 0xf4, 0x0b, 0xb2, 0x53, 0x98, 0xb5, 0x4a, 0x13,
+	0x2d, 0x5c, 0x3c, 0x4a, 0xd7, 0xda, 0xce, 0xf1, 0x3c, 0xa3, 0xe6, 0x40, 0xe6, 0xd0, 0x89, 0xe6,
+	0x61, 0xcc, 0xfa, 0xe0, 0x32, 0xb0, 0x36, 0xa0, 0x4c, 0x6f, 0xab, 0x92, 0xde, 0x67, 0x64, 0x5b,
+	0x44, 0x8e, 0xba, 0x8e, 0x61, 0xb9, 0x8d, 0xd6, 0x01, 0x58, 0xb7, 0xac, 0x41, 0x49, 0xe7, 0xc6,
+	0xed, 0x21, 0xe9, 0xaa, 0xa5, 0xed, 0xac, 0xb8, 0x1f, 0xb1, 0x21, 0xc6, 0xed, 0xa8, 0xe5, 0x2b,
+	0xa3, 0x52, 0x46, 0x3a, 0xc0, 0xa3, 0x48, 0x04, 0x6c, 0x94, 0x59, 0x72, 0x95, 0x7e, 0x03, 0x05,
+	0x88, 0xb4, 0x88, 0x22, 0x9e, 0xb0, 0x27, 0x47, 0x8d, 0xa7, 0xfd, 0x17, 0x8f, 0x26, 0x45, 0xdf,
+	0x36, 0xc9, 0x4f, 0xbe, 0xcd, 0x7c, 0xac, 0xd2, 0xd9, 0xa4, 0xb1, 0x54, 0xe2, 0xd7, 0x14, 0xce,
+	0x70, 0xcd, 0x3e, 0x86, 0xa0, 0x4d, 0x6b, 0x03, 0x4c, 0x1a, 0x2a, 0x49, 0x7c, 0x76, 0x84, 0x05,
+	0x47, 0x99, 0xee, 0x92, 0x46, 0x12, 0x29, 0xf6, 0x09, 0x22, 0x23, 0xd2, 0xcf, 0x49, 0xc3, 0x8b,
+	0x13, 0xf6, 0x0c, 0x48, 0xff, 0xc5, 0x7e, 0xb9, 0x6f, 0xa5, 0xdd, 0x96, 0x71, 0x38, 0xfc, 0xa3,
+	0x46, 0x3a, 0xf9, 0x09, 0x4c, 0x2a, 0xf9, 0x19, 0xb0, 0x07, 0x90, 0x0a, 0xdf, 0x58, 0x8a, 0x24,
+	0xeb, 0x37, 0x93, 0x2c, 0x4e, 0xd3, 0xb8, 0x7d, 0x9a, 0xe6, 0xe6, 0x34, 0xb7, 0xcb, 0x4c, 0xee,
+	0x2a, 0x33, 0x04, 0xf3, 0x4c, 0x89, 0x5b, 0x59, 0x30, 0x23, 0x17, 0x89, 0xb4, 0xef, 0x49, 0x64,
+	0xfc, 0x77, 0x8d, 0xec, 0xcc, 0xaf, 0x5e, 0xc9, 0x68, 0xe9, 0x7b, 0xa9, 0x82, 0x11, 0x95, 0xd1,
+	0x3d, 0xf3, 0xf0, 0x81, 0xa6, 0xde, 0xe8, 0xc0, 0xe8, 0xdf, 0x1d, 0xd8, 0x27, 0xad, 0x58, 0xae,
+	0x85, 0x62, 0x07, 0x38, 0xd6, 0x99, 0x42, 0x67, 0x64, 0x14, 0xcb, 0x80, 0x2b, 0xff, 0x37, 0xdc,
+	0xdc, 0xf6, 0xa3, 0xb7, 0x42, 0x25, 0x20, 0x61, 0x0b, 0xbb, 0xd6, 0xb0, 0x6a, 0x3d, 0x2b, 0x8c,
+	0x74, 0x4a, 0xf6, 0xca, 0xc8, 0xb6, 0x2b, 0xde, 0xfa, 0x68, 0xc7, 0xee, 0x0e, 0x2c, 0x5a, 0x9a,
+	0xbe, 0x2f, 0x2c, 0xe3, 0xdf, 0x3b, 0xa4, 0x7d, 0xa1, 0xb9, 0x4e, 0x93, 0x9b, 0xf9, 0xd5, 0xfe,
+	0x6b, 0xde, 0xeb, 0x95, 0x79, 0xbf, 0xe3, 0x2a, 0x35, 0xee, 0xbc, 0x4a, 0x8f, 0x48, 0x6f, 0x21,
+	0xa5, 0xce, 0x9a, 0xd5, 0xc4, 0x08, 0x5d, 0x03, 0xb0, 0x4f, 0xdb, 0xa4, 0xee, 0x9b, 0x82, 0x36,
+	0xe0, 0x9e, 0x82, 0x64, 0xae, 0x7a, 0x0c, 0xaf, 0xc2, 0x52, 0xaa, 0x10, 0x6f, 0x57, 0xcf, 0x2a,
+	0x75, 0xfa, 0x29, 0x19, 0x38, 0x32, 0xd2, 0xdc, 0xd1, 0xb6, 0x08, 0xb9, 0x1f, 0xe0, 0x05, 0xeb,
+	0x59, 0x5b, 0x39, 0xfc, 0xc1, 0x30, 0x7a, 0x44, 0xfa, 0xae, 0x48, 0x1c, 0xe5, 0xc7, 0x98, 0xfc,
+	0x36, 0xba, 0x54, 0x91, 0x99, 0xa0, 0x4d, 0x99, 0x20, 0x78, 0xc4, 0x76, 0xd0, 0x69, 0x50, 0xd2,
+	0x73, 0x80, 0x74, 0x44, 0xda, 0x0b, 0xe5, 0xbb, 0x9e, 0x60, 0xbb, 0x68, 0xce, 0x35, 0xc3, 0x95,
+	0x4c, 0x35, 0xf4, 0xec, 0x41, 0xc6, 0x33, 0xcd, 0xd4, 0x68, 0x19, 0x7b, 0x9c, 0x51, 0x2c, 0x1e,
+	0xca, 0x66, 0x7c, 0xdd, 0x24, 0x66, 0x7b, 0x88, 0x8c, 0x68, 0xc8, 0x8a, 0x07, 0x6c, 0x1f, 0x97,
+	0x1a, 0xb1, 0x98, 0xca, 0xe1, 0x3d, 0x53, 0x69, 0x56, 0x2a, 0xad, 0x71, 0x02, 0x20, 0x16, 0x88,
+	0x74, 0x8f, 0xb4, 0xd4, 0x15, 0x0c, 0x07, 0x5e, 0x4d, 0xd8, 0x52, 0x5d, 0x9d, 0x45, 0x39, 0x94,
+	0x97, 0xec, 0xcb, 0x02, 0xbe, 0xb9, 0x34, 0x50, 0xa3, 0xe7, 0x71, 0x06, 0x75, 0xee, 0xa9, 0xd1,
+	0xf3, 0xab, 0x02, 0x66, 0x9e, 0x41, 0x68, 0xe0, 0xf3, 0x0c, 0x06, 0x61, 0x09, 0x13, 0xcd, 0x26,
+	0x05, 0xbc, 0xd0, 0x39, 0x8c, 0xd6, 0x6c, 0x5a, 0xc0, 0xd7, 0x6b, 0x84, 0x76, 0x0c, 0xe9, 0x7c,
+	0x9d, 0xc3, 0x73, 0x38, 0xf9, 0x33, 0x52, 0x97, 0x09, 0x7b, 0x89, 0x09, 0x3e, 0x2c, 0x13, 0xcc,
+	0x06, 0x6f, 0xf2, 0xc6, 0xa4, 0xa9, 0x7c, 0x27, 0xb1, 0xc0, 0x09, 0x5c, 0x77, 0x63, 0xee, 0x5c,
+	0x0a, 0x6d, 0x43, 0xc7, 0xd7, 0x5c, 0xb9, 0x50, 0xe6, 0x11, 0xd6, 0x6a, 0x27, 0xe3, 0x3f, 0x16,
+	0x98, 0x9e, 0x56, 0xde, 0xc4, 0x03, 0x7c, 0x13, 0x1f, 0x97, 0xb1, 0xf3, 0x67, 0xe8, 0xc6, 0x15,
+	0xde, 0xbc, 0x8a, 0x87, 0xef, 0x6a, 0xa4, 0x57, 0xee, 0x4b, 0x87, 0xa4, 0x1d, 0x48, 0xee, 0xda,
+	0x27, 0x38, 0xf7, 0x75, 0xab, 0x65, 0xb4, 0x93, 0x12, 0xcf, 0xf2, 0x1f, 0x2b, 0xc4, 0x33, 0x7a,
+	0x40, 0x3a, 0x99, 0xf7, 0x2c, 0x7f, 0xa8, 0xd0, 0xeb, 0x64, 0x66, 0xc6, 0xca, 0x89, 0x53, 0x3b,
+	0x16, 0xca, 0x11, 0x30, 0x8f, 0x30, 0x37, 0x7d, 0xb4, 0x0f, 0x80, 0x9e, 0x97, 0x90, 0x1e, 0x93,
+	0x07, 0xa1, 0x08, 0xa5, 0xba, 0xae, 0x7a, 0x0e, 0xd1, 0x73, 0x37, 0x33, 0x54, 0x9c, 0x61, 0x98,
+	0xb5, 0x08, 0xc1, 0x11, 0x4a, 0xa5, 0x04, 0xf6, 0xbe, 0x6e, 0x55, 0xd1, 0x38, 0x20, 0xfb, 0x77,
+	0x25, 0xfb, 0x81, 0x07, 0xb8, 0x78, 0x19, 0xeb, 0xb7, 0x5f, 0xc6, 0xc6, 0x3d, 0x33, 0xf8, 0xdd,
+	0xe9, 0xbb, 0xbf, 0x9e, 0xd4, 0xde, 0xc3, 0xe7, 0x4f, 0xf8, 0xfc, 0x72, 0xfc, 0x3f, 0xfe, 0x66,
+	0x2c, 0xda, 0xf8, 0x3f, 0xe1, 0xe5, 0x3f, 0xea, 0x4a, 0x23, 0x97, 0x9c, 0x08, 0x00, 0x00,
 }
@@ -23,6 +23,64 @@
 		LogEntry
 		DryUplinkResult
 		DryDownlinkResult
+		DeviceUplink
+		DeviceUplinkList
+		ReplayUplinksRequest
+		DownlinkPreviewRequest
+		DownlinkPreview
+		ProvisioningDownlink
+		BulkPayloadFunctionsRequest
+		BulkPayloadFunctionsResult
+		BulkPayloadFunctionsResponse
+		AnomalyDetection
+		ComputedField
+		OutputPolicy
+		Aggregation
+		MQTTCredentials
+		MQTTCredentialsList
+		ErasureReport
+		MICCheckRequest
+		MICCheckResult
+		MutationResult
+		WatchDevicesRequest
+		DeviceChange
+		DeviceDebugModeRequest
+		DebugTraceEntry
+		DeviceDebugTrace
+		DeviceStateRequest
+		DeviceState
+		DeviceTwinRequest
+		DeviceTwin
+		CommandRequest
+		CommandResponse
+		PortFunctions
+		TestUplinkRequest
+		DeviceProfile
+		ComplianceGroup
+		ComplianceReport
+		DownlinkDelivery
+		PayloadFunctionsRevision
+		PayloadFunctionsRevisionList
+		PayloadFunctionsRollbackRequest
+		Codec
+		CodecIdentifier
+		CodecList
+		ReplayArchiveRequest
+		ReplayArchiveResult
+		BinaryField
+		InjectedUplinkMessage
+		RawUplink
+		RedecodeUplinksRequest
+		RedecodedUplink
+		RedecodeUplinksResult
+		ExportApplicationRequest
+		DeviceTransfer
+		ApplicationTransfer
+		Webhook
+		WebhookIdentifier
+		WebhookList
+		DeletedDevice
+		DeletedDeviceList
 */
 package handler
 
@@ -33,6 +91,7 @@ import google_protobuf "github.com/golang/protobuf/ptypes/empty"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
 import api "github.com/TheThingsNetwork/ttn/api"
 import broker "github.com/TheThingsNetwork/ttn/api/broker"
+import gateway "github.com/TheThingsNetwork/ttn/api/gateway"
 import protocol "github.com/TheThingsNetwork/ttn/api/protocol"
 import lorawan1 "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 import trace "github.com/TheThingsNetwork/ttn/api/trace"
@@ -192,6 +251,100 @@ type Application struct {
 	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
 	// The encoder is a JavaScript function that encodes an object to a byte array.
 	Encoder string `protobuf:"bytes,5,opt,name=encoder,proto3" json:"encoder,omitempty"`
+	// The join hook is a JavaScript function that is executed when a device joins.
+	// It can set initial attributes of the device and queue a downlink message.
+	JoinHook string `protobuf:"bytes,6,opt,name=join_hook,json=joinHook,proto3" json:"join_hook,omitempty"`
+	// The provisioning downlink is sent to devices after their first uplink message after joining.
+	ProvisioningDownlink *ProvisioningDownlink `protobuf:"bytes,7,opt,name=provisioning_downlink,json=provisioningDownlink" json:"provisioning_downlink,omitempty"`
+	// The version of the payload functions, if they were set with SetPayloadFunctions.
+	// This is cleared when the payload functions are changed with SetApplication.
+	PayloadFunctionsVersion string `protobuf:"bytes,8,opt,name=payload_functions_version,json=payloadFunctionsVersion,proto3" json:"payload_functions_version,omitempty"`
+	// Anomaly detection flags outliers in the numeric payload fields of uplink messages. It is disabled if not set.
+	AnomalyDetection *AnomalyDetection `protobuf:"bytes,9,opt,name=anomaly_detection,json=anomalyDetection" json:"anomaly_detection,omitempty"`
+	// Computed fields are evaluated after the converter and added to the payload fields of uplink messages.
+	ComputedFields []*ComputedField `protobuf:"bytes,10,rep,name=computed_fields,json=computedFields" json:"computed_fields,omitempty"`
+	// The output policy controls the representation of numeric payload fields in uplink messages.
+	OutputPolicy *OutputPolicy `protobuf:"bytes,11,opt,name=output_policy,json=outputPolicy" json:"output_policy,omitempty"`
+	// If aggregation is set, the handler publishes the average of the uplink messages of each device per window,
+	// instead of every uplink message.
+	Aggregation *Aggregation `protobuf:"bytes,12,opt,name=aggregation" json:"aggregation,omitempty"`
+	// The format of uplink messages that are delivered to integrations (AMQP): json (default) or raw. In the raw format,
+	// the message body is the binary payload and the metadata is sent in headers.
+	IntegrationFormat string `protobuf:"bytes,13,opt,name=integration_format,json=integrationFormat,proto3" json:"integration_format,omitempty"`
+	// The number of days that stored uplink messages are kept (0 to keep them until the uplink history is full)
+	RetentionDays uint32 `protobuf:"varint,14,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"`
+	// Payload fields that contain personal data. These fields are redacted from logs and events, but are still
+	// delivered to integrations. Nested fields are separated by a dot (for example location.lat).
+	SensitiveFields []string `protobuf:"bytes,15,rep,name=sensitive_fields,json=sensitiveFields,proto3" json:"sensitive_fields,omitempty"`
+	// Validate the request and return the changes without persisting them
+	DryRun bool `protobuf:"varint,16,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// The revision of the application settings. Updates must supply the current revision; updates with an outdated
+	// revision are rejected.
+	Revision uint64 `protobuf:"varint,17,opt,name=revision,proto3" json:"revision,omitempty"`
+	// The fields to update (for example decoder or output_policy). If empty, all fields are updated.
+	UpdateMask []string `protobuf:"bytes,18,rep,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// The format of the payload of uplink and downlink messages: custom (default) to decode and encode the payload with
+	// the decoder and encoder, cayennelpp to decode and encode Cayenne Low Power Payload without payload functions,
+	// wasm to decode and encode the payload with the WebAssembly module, binary to decode and encode the payload with
+	// the layout in binary_fields, protobuf to decode and encode the payload as the protobuf_message, cbor to decode and
+	// encode the payload as CBOR, or go to decode and encode the payload with the go_codec.
+	PayloadFormat string `protobuf:"bytes,19,opt,name=payload_format,json=payloadFormat,proto3" json:"payload_format,omitempty"`
+	// The maximum time that each payload function is allowed to run (milliseconds, 0 for the default of 100ms). It can
+	// not be longer than the maximum that is configured on the Handler.
+	FunctionTimeout uint32 `protobuf:"varint,20,opt,name=function_timeout,json=functionTimeout,proto3" json:"function_timeout,omitempty"`
+	// The compiled WebAssembly module that decodes and encodes the payload if the payload format is wasm. The module
+	// exports its memory, an alloc(size) function and decode(ptr, len, port) and encode(ptr, len, port) functions.
+	WasmModule []byte `protobuf:"bytes,21,opt,name=wasm_module,json=wasmModule,proto3" json:"wasm_module,omitempty"`
+	// Payload functions that are used instead of the decoder, converter, validator and encoder of the application for
+	// messages on a range of ports. The first range that contains the port is used.
+	PortFunctions []*PortFunctions `protobuf:"bytes,22,rep,name=port_functions,json=portFunctions" json:"port_functions,omitempty"`
+	// The downlink decoder is a JavaScript function that decodes the byte array of a scheduled downlink message to an
+	// object. The object is added to the down/scheduled and down/sent events of downlink messages that were scheduled
+	// without fields.
+	DownlinkDecoder string `protobuf:"bytes,23,opt,name=downlink_decoder,json=downlinkDecoder,proto3" json:"downlink_decoder,omitempty"`
+	// The DevAddr allocation strategy for the devices of the application: random, sequential or sticky (re-use the
+	// previous address on rejoin). If empty, the default of the Handler is used.
+	DevAddrAllocation string `protobuf:"bytes,24,opt,name=dev_addr_allocation,json=devAddrAllocation,proto3" json:"dev_addr_allocation,omitempty"`
+	// The revision of the active payload functions (see ListPayloadFunctionsRevisions). This field is read-only.
+	FunctionsRevision uint64 `protobuf:"varint,25,opt,name=functions_revision,json=functionsRevision,proto3" json:"functions_revision,omitempty"`
+	// The ID of a codec in the codec library of the Handler. If set, the decoder, converter, validator and encoder of
+	// // the codec are used instead of the ones of the application.
+	Codec string `protobuf:"bytes,26,opt,name=codec,proto3" json:"codec,omitempty"`
+	// The layout of the payload if the payload format is binary. The Handler decodes and encodes these fields without
+	// // payload functions.
+	BinaryFields []*BinaryField `protobuf:"bytes,27,rep,name=binary_fields,json=binaryFields" json:"binary_fields,omitempty"`
+	// The language of the decoder, converter, validator and encoder: javascript (default) or lua. The downlink decoder
+	// is always JavaScript.
+	FunctionsLanguage string `protobuf:"bytes,28,opt,name=functions_language,json=functionsLanguage,proto3" json:"functions_language,omitempty"`
+	// The serialized FileDescriptorSet that contains the protobuf_message and its dependencies (for example from
+	// protoc --include_imports --descriptor_set_out), if the payload format is protobuf.
+	ProtobufDescriptor []byte `protobuf:"bytes,29,opt,name=protobuf_descriptor,json=protobufDescriptor,proto3" json:"protobuf_descriptor,omitempty"`
+	// The full name of the message in the protobuf_descriptor (for example sensors.Reading) that the payload is decoded
+	// as and encoded from, if the payload format is protobuf.
+	ProtobufMessage string `protobuf:"bytes,30,opt,name=protobuf_message,json=protobufMessage,proto3" json:"protobuf_message,omitempty"`
+	// The name of the compiled Go codec on the Handler that decodes and encodes the payload if the payload format is go.
+	GoCodec string `protobuf:"bytes,31,opt,name=go_codec,json=goCodec,proto3" json:"go_codec,omitempty"`
+	// The region that the data of the application must stay in (for example eu). Handlers that are deployed in another
+	// region refuse the application and drop its traffic. Leave empty to allow all regions.
+	DataResidency string `protobuf:"bytes,32,opt,name=data_residency,json=dataResidency,proto3" json:"data_residency,omitempty"`
+	// Pass the decoded fields and the frame counter of the previous uplink message of the device to the decoder as
+	// metadata.previous, so that the decoder can reconstruct absolute values from delta-encoded payloads.
+	StatefulDecoding bool `protobuf:"varint,33,opt,name=stateful_decoding,json=statefulDecoding,proto3" json:"stateful_decoding,omitempty"`
+	// The normalizer is a JavaScript function that maps the payload fields to a standard schema (temperature, humidity,
+	// battery and location), which is published in the normalized_payload of uplink messages.
+	Normalizer string `protobuf:"bytes,34,opt,name=normalizer,proto3" json:"normalizer,omitempty"`
+	// During maintenance windows, the Handler does not publish anomaly and down/missed events for the devices of the
+	// application and does not POST messages to its webhooks.
+	MaintenanceWindows []*api.MaintenanceWindow `protobuf:"bytes,35,rep,name=maintenance_windows,json=maintenanceWindows" json:"maintenance_windows,omitempty"`
+	// The maximum number of fields, including nested fields, that the decoder, converter and downlink decoder may
+	// return (0 for the limit of the Handler). It can not be higher than the limit that is configured on the Handler.
+	MaxOutputFields uint32 `protobuf:"varint,36,opt,name=max_output_fields,json=maxOutputFields,proto3" json:"max_output_fields,omitempty"`
+	// The maximum nesting depth of the fields that the decoder, converter and downlink decoder may return (0 for the
+	// limit of the Handler). It can not be higher than the limit that is configured on the Handler.
+	MaxOutputDepth uint32 `protobuf:"varint,37,opt,name=max_output_depth,json=maxOutputDepth,proto3" json:"max_output_depth,omitempty"`
+	// The maximum size in bytes of the fields that the decoder, converter and downlink decoder may return as JSON (0 for
+	// the limit of the Handler). It can not be higher than the limit that is configured on the Handler.
+	MaxOutputSize uint32 `protobuf:"varint,38,opt,name=max_output_size,json=maxOutputSize,proto3" json:"max_output_size,omitempty"`
 }
 
 func (m *Application) Reset()                    { *m = Application{} }
@@ -234,6 +387,237 @@ func (m *Application) GetEncoder() string {
 	return ""
 }
 
+func (m *Application) GetJoinHook() string {
+	if m != nil {
+		return m.JoinHook
+	}
+	return ""
+}
+
+func (m *Application) GetProvisioningDownlink() *ProvisioningDownlink {
+	if m != nil {
+		return m.ProvisioningDownlink
+	}
+	return nil
+}
+
+func (m *Application) GetPayloadFunctionsVersion() string {
+	if m != nil {
+		return m.PayloadFunctionsVersion
+	}
+	return ""
+}
+
+func (m *Application) GetAnomalyDetection() *AnomalyDetection {
+	if m != nil {
+		return m.AnomalyDetection
+	}
+	return nil
+}
+
+func (m *Application) GetComputedFields() []*ComputedField {
+	if m != nil {
+		return m.ComputedFields
+	}
+	return nil
+}
+
+func (m *Application) GetOutputPolicy() *OutputPolicy {
+	if m != nil {
+		return m.OutputPolicy
+	}
+	return nil
+}
+
+func (m *Application) GetAggregation() *Aggregation {
+	if m != nil {
+		return m.Aggregation
+	}
+	return nil
+}
+
+func (m *Application) GetIntegrationFormat() string {
+	if m != nil {
+		return m.IntegrationFormat
+	}
+	return ""
+}
+
+func (m *Application) GetRetentionDays() uint32 {
+	if m != nil {
+		return m.RetentionDays
+	}
+	return 0
+}
+
+func (m *Application) GetSensitiveFields() []string {
+	if m != nil {
+		return m.SensitiveFields
+	}
+	return nil
+}
+
+func (m *Application) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *Application) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *Application) GetUpdateMask() []string {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+func (m *Application) GetPayloadFormat() string {
+	if m != nil {
+		return m.PayloadFormat
+	}
+	return ""
+}
+
+func (m *Application) GetFunctionTimeout() uint32 {
+	if m != nil {
+		return m.FunctionTimeout
+	}
+	return 0
+}
+
+func (m *Application) GetWasmModule() []byte {
+	if m != nil {
+		return m.WasmModule
+	}
+	return nil
+}
+
+func (m *Application) GetPortFunctions() []*PortFunctions {
+	if m != nil {
+		return m.PortFunctions
+	}
+	return nil
+}
+
+func (m *Application) GetDownlinkDecoder() string {
+	if m != nil {
+		return m.DownlinkDecoder
+	}
+	return ""
+}
+
+func (m *Application) GetDevAddrAllocation() string {
+	if m != nil {
+		return m.DevAddrAllocation
+	}
+	return ""
+}
+
+func (m *Application) GetFunctionsRevision() uint64 {
+	if m != nil {
+		return m.FunctionsRevision
+	}
+	return 0
+}
+
+func (m *Application) GetCodec() string {
+	if m != nil {
+		return m.Codec
+	}
+	return ""
+}
+
+func (m *Application) GetBinaryFields() []*BinaryField {
+	if m != nil {
+		return m.BinaryFields
+	}
+	return nil
+}
+
+func (m *Application) GetFunctionsLanguage() string {
+	if m != nil {
+		return m.FunctionsLanguage
+	}
+	return ""
+}
+
+func (m *Application) GetProtobufDescriptor() []byte {
+	if m != nil {
+		return m.ProtobufDescriptor
+	}
+	return nil
+}
+
+func (m *Application) GetProtobufMessage() string {
+	if m != nil {
+		return m.ProtobufMessage
+	}
+	return ""
+}
+
+func (m *Application) GetGoCodec() string {
+	if m != nil {
+		return m.GoCodec
+	}
+	return ""
+}
+
+func (m *Application) GetDataResidency() string {
+	if m != nil {
+		return m.DataResidency
+	}
+	return ""
+}
+
+func (m *Application) GetStatefulDecoding() bool {
+	if m != nil {
+		return m.StatefulDecoding
+	}
+	return false
+}
+
+func (m *Application) GetNormalizer() string {
+	if m != nil {
+		return m.Normalizer
+	}
+	return ""
+}
+
+func (m *Application) GetMaintenanceWindows() []*api.MaintenanceWindow {
+	if m != nil {
+		return m.MaintenanceWindows
+	}
+	return nil
+}
+
+func (m *Application) GetMaxOutputFields() uint32 {
+	if m != nil {
+		return m.MaxOutputFields
+	}
+	return 0
+}
+
+func (m *Application) GetMaxOutputDepth() uint32 {
+	if m != nil {
+		return m.MaxOutputDepth
+	}
+	return 0
+}
+
+func (m *Application) GetMaxOutputSize() uint32 {
+	if m != nil {
+		return m.MaxOutputSize
+	}
+	return 0
+}
+
 type DeviceIdentifier struct {
 	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
 	DevId string `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
@@ -271,6 +655,33 @@ type Device struct {
 	Longitude   float32         `protobuf:"fixed32,11,opt,name=longitude,proto3" json:"longitude,omitempty"`
 	Altitude    int32           `protobuf:"varint,12,opt,name=altitude,proto3" json:"altitude,omitempty"`
 	Description string          `protobuf:"bytes,20,opt,name=description,proto3" json:"description,omitempty"`
+	// Attributes of the device as key-value pairs
+	Attributes map[string]string `protobuf:"bytes,21,rep,name=attributes" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The maximum size of the application payload of downlink messages, based on the last uplink message (read-only)
+	MaxDownlinkPayloadSize uint32 `protobuf:"varint,22,opt,name=max_downlink_payload_size,json=maxDownlinkPayloadSize,proto3" json:"max_downlink_payload_size,omitempty"`
+	// The data rate that the maximum downlink payload size applies to (read-only)
+	DownlinkDataRate string `protobuf:"bytes,23,opt,name=downlink_data_rate,json=downlinkDataRate,proto3" json:"downlink_data_rate,omitempty"`
+	// Validate the request and return the changes without persisting them
+	DryRun bool `protobuf:"varint,24,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// The revision of the device settings. Updates must supply the current revision (0 when creating a device);
+	// updates with an outdated revision are rejected.
+	Revision uint64 `protobuf:"varint,25,opt,name=revision,proto3" json:"revision,omitempty"`
+	// The fields to update (for example description, attributes.key or lorawan_device.app_key). If empty, all fields
+	// are updated.
+	UpdateMask []string `protobuf:"bytes,26,rep,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// The profile of the device, as certified by its vendor
+	Profile *DeviceProfile `protobuf:"bytes,27,opt,name=profile" json:"profile,omitempty"`
+	// The delivery of confirmed downlink messages to the device (read-only)
+	DownlinkDelivery *DownlinkDelivery `protobuf:"bytes,28,opt,name=downlink_delivery,json=downlinkDelivery" json:"downlink_delivery,omitempty"`
+	// The payload format of the device, which overrides the payload format of the application (for devices with another
+	// frame format than the other devices of the application). The configuration of the format, such as the binary
+	// fields, is taken from the application. Leave empty to use the payload format of the application.
+	PayloadFormat string `protobuf:"bytes,29,opt,name=payload_format,json=payloadFormat,proto3" json:"payload_format,omitempty"`
+	// The ID of a codec in the codec library of the Handler. If set, the decoder, converter, validator and encoder of the
+	// codec are used instead of the payload functions of the application.
+	Codec string `protobuf:"bytes,30,opt,name=codec,proto3" json:"codec,omitempty"`
+	// The name of the compiled Go codec of the Handler, if the payload format of the device is go
+	GoCodec string `protobuf:"bytes,31,opt,name=go_codec,json=goCodec,proto3" json:"go_codec,omitempty"`
 }
 
 func (m *Device) Reset()                    { *m = Device{} }
@@ -346,6 +757,83 @@ func (m *Device) GetDescription() string {
 	return ""
 }
 
+func (m *Device) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *Device) GetMaxDownlinkPayloadSize() uint32 {
+	if m != nil {
+		return m.MaxDownlinkPayloadSize
+	}
+	return 0
+}
+
+func (m *Device) GetDownlinkDataRate() string {
+	if m != nil {
+		return m.DownlinkDataRate
+	}
+	return ""
+}
+
+func (m *Device) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *Device) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *Device) GetUpdateMask() []string {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+func (m *Device) GetProfile() *DeviceProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+func (m *Device) GetDownlinkDelivery() *DownlinkDelivery {
+	if m != nil {
+		return m.DownlinkDelivery
+	}
+	return nil
+}
+
+func (m *Device) GetPayloadFormat() string {
+	if m != nil {
+		return m.PayloadFormat
+	}
+	return ""
+}
+
+func (m *Device) GetCodec() string {
+	if m != nil {
+		return m.Codec
+	}
+	return ""
+}
+
+func (m *Device) GetGoCodec() string {
+	if m != nil {
+		return m.GoCodec
+	}
+	return ""
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Device) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Device_OneofMarshaler, _Device_OneofUnmarshaler, _Device_OneofSizer, []interface{}{
@@ -577,6 +1065,8 @@ type DryUplinkResult struct {
 	Valid bool `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
 	// Logs that have been generated while processing
 	Logs []*LogEntry `protobuf:"bytes,4,rep,name=logs" json:"logs,omitempty"`
+	// The error of the payload functions, if processing failed
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 }
 
 func (m *DryUplinkResult) Reset()                    { *m = DryUplinkResult{} }
@@ -612,6 +1102,13 @@ func (m *DryUplinkResult) GetLogs() []*LogEntry {
 	return nil
 }
 
+func (m *DryUplinkResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // DryDownlinkResult is the result from a downlink simulation
 type DryDownlinkResult struct {
 	// The payload that was encoded
@@ -639,1534 +1136,21555 @@ func (m *DryDownlinkResult) GetLogs() []*LogEntry {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*DeviceActivationResponse)(nil), "handler.DeviceActivationResponse")
-	proto.RegisterType((*StatusRequest)(nil), "handler.StatusRequest")
-	proto.RegisterType((*Status)(nil), "handler.Status")
-	proto.RegisterType((*ApplicationIdentifier)(nil), "handler.ApplicationIdentifier")
-	proto.RegisterType((*Application)(nil), "handler.Application")
-	proto.RegisterType((*DeviceIdentifier)(nil), "handler.DeviceIdentifier")
-	proto.RegisterType((*Device)(nil), "handler.Device")
-	proto.RegisterType((*DeviceList)(nil), "handler.DeviceList")
-	proto.RegisterType((*DryDownlinkMessage)(nil), "handler.DryDownlinkMessage")
-	proto.RegisterType((*DryUplinkMessage)(nil), "handler.DryUplinkMessage")
-	proto.RegisterType((*SimulatedUplinkMessage)(nil), "handler.SimulatedUplinkMessage")
-	proto.RegisterType((*LogEntry)(nil), "handler.LogEntry")
-	proto.RegisterType((*DryUplinkResult)(nil), "handler.DryUplinkResult")
-	proto.RegisterType((*DryDownlinkResult)(nil), "handler.DryDownlinkResult")
+// DeviceUplink is an uplink message of a device that is stored by the Handler
+type DeviceUplink struct {
+	// Time when the server received the message in Unix nanoseconds
+	ServerTime int64  `protobuf:"varint,1,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	Port       uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Counter    uint32 `protobuf:"varint,3,opt,name=counter,proto3" json:"counter,omitempty"`
+	Confirmed  bool   `protobuf:"varint,4,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// The decrypted binary payload
+	PayloadRaw       []byte               `protobuf:"bytes,5,opt,name=payload_raw,json=payloadRaw,proto3" json:"payload_raw,omitempty"`
+	ProtocolMetadata *protocol.RxMetadata `protobuf:"bytes,11,opt,name=protocol_metadata,json=protocolMetadata" json:"protocol_metadata,omitempty"`
+	// Metadata of all gateways that received the message
+	GatewayMetadata []*gateway.RxMetadata `protobuf:"bytes,12,rep,name=gateway_metadata,json=gatewayMetadata" json:"gateway_metadata,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// Client API for Handler service
+func (m *DeviceUplink) Reset()                    { *m = DeviceUplink{} }
+func (m *DeviceUplink) String() string            { return proto.CompactTextString(m) }
+func (*DeviceUplink) ProtoMessage()               {}
+func (*DeviceUplink) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{14} }
 
-type HandlerClient interface {
-	ActivationChallenge(ctx context.Context, in *broker.ActivationChallengeRequest, opts ...grpc.CallOption) (*broker.ActivationChallengeResponse, error)
-	Activate(ctx context.Context, in *broker.DeduplicatedDeviceActivationRequest, opts ...grpc.CallOption) (*DeviceActivationResponse, error)
+func (m *DeviceUplink) GetServerTime() int64 {
+	if m != nil {
+		return m.ServerTime
+	}
+	return 0
 }
 
-type handlerClient struct {
-	cc *grpc.ClientConn
+func (m *DeviceUplink) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
 }
 
-func NewHandlerClient(cc *grpc.ClientConn) HandlerClient {
-	return &handlerClient{cc}
+func (m *DeviceUplink) GetCounter() uint32 {
+	if m != nil {
+		return m.Counter
+	}
+	return 0
 }
 
-func (c *handlerClient) ActivationChallenge(ctx context.Context, in *broker.ActivationChallengeRequest, opts ...grpc.CallOption) (*broker.ActivationChallengeResponse, error) {
-	out := new(broker.ActivationChallengeResponse)
-	err := grpc.Invoke(ctx, "/handler.Handler/ActivationChallenge", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeviceUplink) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
 	}
-	return out, nil
+	return false
 }
 
-func (c *handlerClient) Activate(ctx context.Context, in *broker.DeduplicatedDeviceActivationRequest, opts ...grpc.CallOption) (*DeviceActivationResponse, error) {
-	out := new(DeviceActivationResponse)
-	err := grpc.Invoke(ctx, "/handler.Handler/Activate", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeviceUplink) GetPayloadRaw() []byte {
+	if m != nil {
+		return m.PayloadRaw
 	}
-	return out, nil
+	return nil
 }
 
-// Server API for Handler service
+func (m *DeviceUplink) GetProtocolMetadata() *protocol.RxMetadata {
+	if m != nil {
+		return m.ProtocolMetadata
+	}
+	return nil
+}
 
-type HandlerServer interface {
-	ActivationChallenge(context.Context, *broker.ActivationChallengeRequest) (*broker.ActivationChallengeResponse, error)
-	Activate(context.Context, *broker.DeduplicatedDeviceActivationRequest) (*DeviceActivationResponse, error)
+func (m *DeviceUplink) GetGatewayMetadata() []*gateway.RxMetadata {
+	if m != nil {
+		return m.GatewayMetadata
+	}
+	return nil
 }
 
-func RegisterHandlerServer(s *grpc.Server, srv HandlerServer) {
-	s.RegisterService(&_Handler_serviceDesc, srv)
+// DeviceUplinkList contains the uplink messages of a device, newest first
+type DeviceUplinkList struct {
+	Uplinks []*DeviceUplink `protobuf:"bytes,1,rep,name=uplinks" json:"uplinks,omitempty"`
 }
 
-func _Handler_ActivationChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(broker.ActivationChallengeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(HandlerServer).ActivationChallenge(ctx, in)
+func (m *DeviceUplinkList) Reset()                    { *m = DeviceUplinkList{} }
+func (m *DeviceUplinkList) String() string            { return proto.CompactTextString(m) }
+func (*DeviceUplinkList) ProtoMessage()               {}
+func (*DeviceUplinkList) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{15} }
+
+func (m *DeviceUplinkList) GetUplinks() []*DeviceUplink {
+	if m != nil {
+		return m.Uplinks
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/handler.Handler/ActivationChallenge",
+	return nil
+}
+
+// ReplayUplinksRequest is used to replay the stored uplink messages of a device
+type ReplayUplinksRequest struct {
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DevId string `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// Only replay messages that were received at or after this time in Unix nanoseconds (optional)
+	Since int64 `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`
+	// Only replay messages that were received before this time in Unix nanoseconds (optional)
+	Until int64 `protobuf:"varint,4,opt,name=until,proto3" json:"until,omitempty"`
+}
+
+func (m *ReplayUplinksRequest) Reset()                    { *m = ReplayUplinksRequest{} }
+func (m *ReplayUplinksRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReplayUplinksRequest) ProtoMessage()               {}
+func (*ReplayUplinksRequest) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{16} }
+
+func (m *ReplayUplinksRequest) GetAppId() string {
+	if m != nil {
+		return m.AppId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HandlerServer).ActivationChallenge(ctx, req.(*broker.ActivationChallengeRequest))
+	return ""
+}
+
+func (m *ReplayUplinksRequest) GetDevId() string {
+	if m != nil {
+		return m.DevId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Handler_Activate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(broker.DeduplicatedDeviceActivationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ReplayUplinksRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
 	}
-	if interceptor == nil {
-		return srv.(HandlerServer).Activate(ctx, in)
+	return 0
+}
+
+func (m *ReplayUplinksRequest) GetUntil() int64 {
+	if m != nil {
+		return m.Until
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/handler.Handler/Activate",
+	return 0
+}
+
+// DownlinkPreviewRequest is used to preview the transmission of a downlink message to a device
+type DownlinkPreviewRequest struct {
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DevId string `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// The binary payload to use
+	Payload []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	// JSON-encoded object with fields to encode
+	Fields string `protobuf:"bytes,4,opt,name=fields,proto3" json:"fields,omitempty"`
+	// The port number that should be passed to the payload function
+	Port uint32 `protobuf:"varint,5,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (m *DownlinkPreviewRequest) Reset()                    { *m = DownlinkPreviewRequest{} }
+func (m *DownlinkPreviewRequest) String() string            { return proto.CompactTextString(m) }
+func (*DownlinkPreviewRequest) ProtoMessage()               {}
+func (*DownlinkPreviewRequest) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{17} }
+
+func (m *DownlinkPreviewRequest) GetAppId() string {
+	if m != nil {
+		return m.AppId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HandlerServer).Activate(ctx, req.(*broker.DeduplicatedDeviceActivationRequest))
+	return ""
+}
+
+func (m *DownlinkPreviewRequest) GetDevId() string {
+	if m != nil {
+		return m.DevId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Handler_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "handler.Handler",
-	HandlerType: (*HandlerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ActivationChallenge",
-			Handler:    _Handler_ActivationChallenge_Handler,
-		},
-		{
-			MethodName: "Activate",
-			Handler:    _Handler_Activate_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "github.com/TheThingsNetwork/ttn/api/handler/handler.proto",
+func (m *DownlinkPreviewRequest) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
 }
 
-// Client API for ApplicationManager service
+func (m *DownlinkPreviewRequest) GetFields() string {
+	if m != nil {
+		return m.Fields
+	}
+	return ""
+}
 
-type ApplicationManagerClient interface {
-	// Applications should first be registered to the Handler with the `RegisterApplication` method
-	RegisterApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
-	// GetApplication returns the application with the given identifier (app_id)
-	GetApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*Application, error)
-	// SetApplication updates the settings for the application. All fields must be supplied.
-	SetApplication(ctx context.Context, in *Application, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
-	// DeleteApplication deletes the application with the given identifier (app_id)
-	DeleteApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
-	// GetDevice returns the device with the given identifier (app_id and dev_id)
-	GetDevice(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*Device, error)
-	// SetDevice creates or updates a device. All fields must be supplied.
-	SetDevice(ctx context.Context, in *Device, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
-	// DeleteDevice deletes the device with the given identifier (app_id and dev_id)
-	DeleteDevice(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
-	// GetDevicesForApplication returns all devices that belong to the application with the given identifier (app_id)
-	GetDevicesForApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*DeviceList, error)
-	// DryUplink simulates processing a downlink message and returns the result
-	DryDownlink(ctx context.Context, in *DryDownlinkMessage, opts ...grpc.CallOption) (*DryDownlinkResult, error)
-	// DryUplink simulates processing an uplink message and returns the result
-	DryUplink(ctx context.Context, in *DryUplinkMessage, opts ...grpc.CallOption) (*DryUplinkResult, error)
-	// SimulateUplink simulates an uplink message
-	SimulateUplink(ctx context.Context, in *SimulatedUplinkMessage, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+func (m *DownlinkPreviewRequest) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
 }
 
-type applicationManagerClient struct {
-	cc *grpc.ClientConn
+// DownlinkPreview is the estimated transmission of a downlink message to a device
+type DownlinkPreview struct {
+	// The binary payload
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// The gateway that would most likely transmit the message, based on the last uplink of the device
+	GatewayId string            `protobuf:"bytes,2,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	RxWindow  lorawan1.RxWindow `protobuf:"varint,3,opt,name=rx_window,json=rxWindow,proto3,enum=lorawan.RxWindow" json:"rx_window,omitempty"`
+	// Frequency in Hz
+	Frequency uint64 `protobuf:"varint,4,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	DataRate  string `protobuf:"bytes,5,opt,name=data_rate,json=dataRate,proto3" json:"data_rate,omitempty"`
+	// Estimated time on air in nanoseconds
+	Airtime int64 `protobuf:"varint,6,opt,name=airtime,proto3" json:"airtime,omitempty"`
+	// Maximum duty cycle on the frequency (1 if there is no limit)
+	DutyCycle float32 `protobuf:"fixed32,7,opt,name=duty_cycle,json=dutyCycle,proto3" json:"duty_cycle,omitempty"`
+	// Indicates whether the duty cycle allows the gateway to transmit the message now.
+	// This only takes the downlink messages of this Handler into account.
+	Allowed bool `protobuf:"varint,8,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// Time in nanoseconds until the duty cycle allows the gateway to transmit the message
+	Wait int64 `protobuf:"varint,9,opt,name=wait,proto3" json:"wait,omitempty"`
 }
 
-func NewApplicationManagerClient(cc *grpc.ClientConn) ApplicationManagerClient {
-	return &applicationManagerClient{cc}
+func (m *DownlinkPreview) Reset()                    { *m = DownlinkPreview{} }
+func (m *DownlinkPreview) String() string            { return proto.CompactTextString(m) }
+func (*DownlinkPreview) ProtoMessage()               {}
+func (*DownlinkPreview) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{18} }
+
+func (m *DownlinkPreview) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
 }
 
-func (c *applicationManagerClient) RegisterApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	err := grpc.Invoke(ctx, "/handler.ApplicationManager/RegisterApplication", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *DownlinkPreview) GetGatewayId() string {
+	if m != nil {
+		return m.GatewayId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationManagerClient) GetApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*Application, error) {
-	out := new(Application)
-	err := grpc.Invoke(ctx, "/handler.ApplicationManager/GetApplication", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *DownlinkPreview) GetRxWindow() lorawan1.RxWindow {
+	if m != nil {
+		return m.RxWindow
 	}
-	return out, nil
+	return lorawan1.RxWindow_RX_AUTO
 }
 
-func (c *applicationManagerClient) SetApplication(ctx context.Context, in *Application, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	err := grpc.Invoke(ctx, "/handler.ApplicationManager/SetApplication", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *DownlinkPreview) GetFrequency() uint64 {
+	if m != nil {
+		return m.Frequency
 	}
-	return out, nil
+	return 0
 }
 
-func (c *applicationManagerClient) DeleteApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	err := grpc.Invoke(ctx, "/handler.ApplicationManager/DeleteApplication", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *DownlinkPreview) GetDataRate() string {
+	if m != nil {
+		return m.DataRate
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationManagerClient) GetDevice(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*Device, error) {
-	out := new(Device)
-	err := grpc.Invoke(ctx, "/handler.ApplicationManager/GetDevice", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *DownlinkPreview) GetAirtime() int64 {
+	if m != nil {
+		return m.Airtime
 	}
-	return out, nil
+	return 0
 }
 
-func (c *applicationManagerClient) SetDevice(ctx context.Context, in *Device, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	err := grpc.Invoke(ctx, "/handler.ApplicationManager/SetDevice", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *DownlinkPreview) GetDutyCycle() float32 {
+	if m != nil {
+		return m.DutyCycle
 	}
-	return out, nil
+	return 0
 }
 
-func (c *applicationManagerClient) DeleteDevice(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	err := grpc.Invoke(ctx, "/handler.ApplicationManager/DeleteDevice", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *DownlinkPreview) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
 	}
-	return out, nil
+	return false
 }
 
-func (c *applicationManagerClient) GetDevicesForApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*DeviceList, error) {
-	out := new(DeviceList)
-	err := grpc.Invoke(ctx, "/handler.ApplicationManager/GetDevicesForApplication", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *DownlinkPreview) GetWait() int64 {
+	if m != nil {
+		return m.Wait
 	}
-	return out, nil
+	return 0
 }
 
-func (c *applicationManagerClient) DryDownlink(ctx context.Context, in *DryDownlinkMessage, opts ...grpc.CallOption) (*DryDownlinkResult, error) {
-	out := new(DryDownlinkResult)
-	err := grpc.Invoke(ctx, "/handler.ApplicationManager/DryDownlink", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+// ProvisioningDownlink is a downlink message that is sent to devices after their first uplink message after joining
+type ProvisioningDownlink struct {
+	// The port number of the downlink message
+	Port      uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	Confirmed bool   `protobuf:"varint,2,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// The binary payload to send
+	Payload []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	// JSON-encoded object with fields to encode. String values can refer to attributes of the device as {{.key}}
+	Fields string `protobuf:"bytes,4,opt,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (m *ProvisioningDownlink) Reset()                    { *m = ProvisioningDownlink{} }
+func (m *ProvisioningDownlink) String() string            { return proto.CompactTextString(m) }
+func (*ProvisioningDownlink) ProtoMessage()               {}
+func (*ProvisioningDownlink) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{19} }
+
+func (m *ProvisioningDownlink) GetPort() uint32 {
+	if m != nil {
+		return m.Port
 	}
-	return out, nil
+	return 0
 }
 
-func (c *applicationManagerClient) DryUplink(ctx context.Context, in *DryUplinkMessage, opts ...grpc.CallOption) (*DryUplinkResult, error) {
-	out := new(DryUplinkResult)
-	err := grpc.Invoke(ctx, "/handler.ApplicationManager/DryUplink", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ProvisioningDownlink) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
 	}
-	return out, nil
+	return false
 }
 
-func (c *applicationManagerClient) SimulateUplink(ctx context.Context, in *SimulatedUplinkMessage, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	err := grpc.Invoke(ctx, "/handler.ApplicationManager/SimulateUplink", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *ProvisioningDownlink) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
 	}
-	return out, nil
+	return nil
 }
 
-// Server API for ApplicationManager service
+func (m *ProvisioningDownlink) GetFields() string {
+	if m != nil {
+		return m.Fields
+	}
+	return ""
+}
 
-type ApplicationManagerServer interface {
-	// Applications should first be registered to the Handler with the `RegisterApplication` method
-	RegisterApplication(context.Context, *ApplicationIdentifier) (*google_protobuf.Empty, error)
-	// GetApplication returns the application with the given identifier (app_id)
-	GetApplication(context.Context, *ApplicationIdentifier) (*Application, error)
-	// SetApplication updates the settings for the application. All fields must be supplied.
-	SetApplication(context.Context, *Application) (*google_protobuf.Empty, error)
-	// DeleteApplication deletes the application with the given identifier (app_id)
-	DeleteApplication(context.Context, *ApplicationIdentifier) (*google_protobuf.Empty, error)
-	// GetDevice returns the device with the given identifier (app_id and dev_id)
-	GetDevice(context.Context, *DeviceIdentifier) (*Device, error)
-	// SetDevice creates or updates a device. All fields must be supplied.
-	SetDevice(context.Context, *Device) (*google_protobuf.Empty, error)
-	// DeleteDevice deletes the device with the given identifier (app_id and dev_id)
-	DeleteDevice(context.Context, *DeviceIdentifier) (*google_protobuf.Empty, error)
-	// GetDevicesForApplication returns all devices that belong to the application with the given identifier (app_id)
-	GetDevicesForApplication(context.Context, *ApplicationIdentifier) (*DeviceList, error)
-	// DryUplink simulates processing a downlink message and returns the result
-	DryDownlink(context.Context, *DryDownlinkMessage) (*DryDownlinkResult, error)
-	// DryUplink simulates processing an uplink message and returns the result
-	DryUplink(context.Context, *DryUplinkMessage) (*DryUplinkResult, error)
-	// SimulateUplink simulates an uplink message
-	SimulateUplink(context.Context, *SimulatedUplinkMessage) (*google_protobuf.Empty, error)
+// BulkPayloadFunctionsRequest is used to set the payload functions of multiple applications at once
+type BulkPayloadFunctionsRequest struct {
+	// The applications to update
+	AppIds []string `protobuf:"bytes,1,rep,name=app_ids,json=appIds,proto3" json:"app_ids,omitempty"`
+	// The payload functions to set. Functions that are left empty are not changed.
+	Decoder   string `protobuf:"bytes,2,opt,name=decoder,proto3" json:"decoder,omitempty"`
+	Converter string `protobuf:"bytes,3,opt,name=converter,proto3" json:"converter,omitempty"`
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+	Encoder   string `protobuf:"bytes,5,opt,name=encoder,proto3" json:"encoder,omitempty"`
+	// The version of the payload functions
+	Version string `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	// Only update applications that currently have this version of the payload functions (optional)
+	IfVersion string `protobuf:"bytes,7,opt,name=if_version,json=ifVersion,proto3" json:"if_version,omitempty"`
+	// Validate the payload functions and return the changes without persisting them
+	DryRun bool `protobuf:"varint,8,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func RegisterApplicationManagerServer(s *grpc.Server, srv ApplicationManagerServer) {
-	s.RegisterService(&_ApplicationManager_serviceDesc, srv)
+func (m *BulkPayloadFunctionsRequest) Reset()         { *m = BulkPayloadFunctionsRequest{} }
+func (m *BulkPayloadFunctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*BulkPayloadFunctionsRequest) ProtoMessage()    {}
+func (*BulkPayloadFunctionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorHandler, []int{20}
 }
 
-func _ApplicationManager_RegisterApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationIdentifier)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationManagerServer).RegisterApplication(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/handler.ApplicationManager/RegisterApplication",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationManagerServer).RegisterApplication(ctx, req.(*ApplicationIdentifier))
+func (m *BulkPayloadFunctionsRequest) GetAppIds() []string {
+	if m != nil {
+		return m.AppIds
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _ApplicationManager_GetApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationIdentifier)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BulkPayloadFunctionsRequest) GetDecoder() string {
+	if m != nil {
+		return m.Decoder
 	}
-	if interceptor == nil {
-		return srv.(ApplicationManagerServer).GetApplication(ctx, in)
+	return ""
+}
+
+func (m *BulkPayloadFunctionsRequest) GetConverter() string {
+	if m != nil {
+		return m.Converter
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/handler.ApplicationManager/GetApplication",
+	return ""
+}
+
+func (m *BulkPayloadFunctionsRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationManagerServer).GetApplication(ctx, req.(*ApplicationIdentifier))
+	return ""
+}
+
+func (m *BulkPayloadFunctionsRequest) GetEncoder() string {
+	if m != nil {
+		return m.Encoder
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ApplicationManager_SetApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Application)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BulkPayloadFunctionsRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	if interceptor == nil {
-		return srv.(ApplicationManagerServer).SetApplication(ctx, in)
+	return ""
+}
+
+func (m *BulkPayloadFunctionsRequest) GetIfVersion() string {
+	if m != nil {
+		return m.IfVersion
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/handler.ApplicationManager/SetApplication",
+	return ""
+}
+
+func (m *BulkPayloadFunctionsRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationManagerServer).SetApplication(ctx, req.(*Application))
+	return false
+}
+
+// BulkPayloadFunctionsResult is the result of setting the payload functions of one application
+type BulkPayloadFunctionsResult struct {
+	AppId   string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Success bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// The version of the payload functions before the update
+	PreviousVersion string `protobuf:"bytes,3,opt,name=previous_version,json=previousVersion,proto3" json:"previous_version,omitempty"`
+	// The reason why the application could not be updated
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// The fields of the application that are (or would be) changed
+	Changed []string `protobuf:"bytes,5,rep,name=changed,proto3" json:"changed,omitempty"`
+}
+
+func (m *BulkPayloadFunctionsResult) Reset()         { *m = BulkPayloadFunctionsResult{} }
+func (m *BulkPayloadFunctionsResult) String() string { return proto.CompactTextString(m) }
+func (*BulkPayloadFunctionsResult) ProtoMessage()    {}
+func (*BulkPayloadFunctionsResult) Descriptor() ([]byte, []int) {
+	return fileDescriptorHandler, []int{21}
+}
+
+func (m *BulkPayloadFunctionsResult) GetAppId() string {
+	if m != nil {
+		return m.AppId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ApplicationManager_DeleteApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationIdentifier)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BulkPayloadFunctionsResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	if interceptor == nil {
-		return srv.(ApplicationManagerServer).DeleteApplication(ctx, in)
+	return false
+}
+
+func (m *BulkPayloadFunctionsResult) GetPreviousVersion() string {
+	if m != nil {
+		return m.PreviousVersion
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/handler.ApplicationManager/DeleteApplication",
+	return ""
+}
+
+func (m *BulkPayloadFunctionsResult) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationManagerServer).DeleteApplication(ctx, req.(*ApplicationIdentifier))
+	return ""
+}
+
+func (m *BulkPayloadFunctionsResult) GetChanged() []string {
+	if m != nil {
+		return m.Changed
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _ApplicationManager_GetDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeviceIdentifier)
-	if err := dec(in); err != nil {
-		return nil, err
+// BulkPayloadFunctionsResponse contains the results for each application of a BulkPayloadFunctionsRequest
+type BulkPayloadFunctionsResponse struct {
+	Results []*BulkPayloadFunctionsResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *BulkPayloadFunctionsResponse) Reset()         { *m = BulkPayloadFunctionsResponse{} }
+func (m *BulkPayloadFunctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*BulkPayloadFunctionsResponse) ProtoMessage()    {}
+func (*BulkPayloadFunctionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorHandler, []int{22}
+}
+
+func (m *BulkPayloadFunctionsResponse) GetResults() []*BulkPayloadFunctionsResult {
+	if m != nil {
+		return m.Results
 	}
-	if interceptor == nil {
-		return srv.(ApplicationManagerServer).GetDevice(ctx, in)
+	return nil
+}
+
+// AnomalyDetection contains the settings for detecting outliers in the numeric payload fields of uplink messages
+type AnomalyDetection struct {
+	// The number of standard deviations that a value must differ from the moving average to be an anomaly (default 3)
+	Sensitivity float32 `protobuf:"fixed32,1,opt,name=sensitivity,proto3" json:"sensitivity,omitempty"`
+}
+
+func (m *AnomalyDetection) Reset()                    { *m = AnomalyDetection{} }
+func (m *AnomalyDetection) String() string            { return proto.CompactTextString(m) }
+func (*AnomalyDetection) ProtoMessage()               {}
+func (*AnomalyDetection) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{23} }
+
+func (m *AnomalyDetection) GetSensitivity() float32 {
+	if m != nil {
+		return m.Sensitivity
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/handler.ApplicationManager/GetDevice",
+	return 0
+}
+
+// ComputedField is a payload field that is computed from the other payload fields
+type ComputedField struct {
+	// The name of the field
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// JavaScript expression that computes the value of the field. The payload fields are available as variables,
+	// and the value of the field in the previous uplink message is available as previous.
+	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+}
+
+func (m *ComputedField) Reset()                    { *m = ComputedField{} }
+func (m *ComputedField) String() string            { return proto.CompactTextString(m) }
+func (*ComputedField) ProtoMessage()               {}
+func (*ComputedField) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{24} }
+
+func (m *ComputedField) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationManagerServer).GetDevice(ctx, req.(*DeviceIdentifier))
+	return ""
+}
+
+func (m *ComputedField) GetExpression() string {
+	if m != nil {
+		return m.Expression
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ApplicationManager_SetDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Device)
-	if err := dec(in); err != nil {
-		return nil, err
+// OutputPolicy controls the representation of numeric payload fields in uplink messages
+type OutputPolicy struct {
+	// Round numeric payload fields to the given number of decimals
+	Round    bool   `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Decimals uint32 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// The rounding mode: half-up (default), half-even or truncate
+	RoundingMode string `protobuf:"bytes,3,opt,name=rounding_mode,json=roundingMode,proto3" json:"rounding_mode,omitempty"`
+	// The representation of NaN and infinite values: null (default), string or omit
+	SpecialValues string `protobuf:"bytes,4,opt,name=special_values,json=specialValues,proto3" json:"special_values,omitempty"`
+	// The casing of the names of payload fields: keep (default), snake or camel
+	FieldCasing string `protobuf:"bytes,5,opt,name=field_casing,json=fieldCasing,proto3" json:"field_casing,omitempty"`
+	// Replace characters other than letters, digits and underscores in the names of payload fields with this string.
+	// Leave empty to keep the characters
+	Replacement string `protobuf:"bytes,6,opt,name=replacement,proto3" json:"replacement,omitempty"`
+	// Prefix payload fields that have the name of a field of the uplink message (such as dev_id or metadata) with
+	// payload_, so that they do not overwrite the metadata in integrations that merge the payload fields
+	ProtectReserved bool `protobuf:"varint,7,opt,name=protect_reserved,json=protectReserved,proto3" json:"protect_reserved,omitempty"`
+}
+
+func (m *OutputPolicy) Reset()                    { *m = OutputPolicy{} }
+func (m *OutputPolicy) String() string            { return proto.CompactTextString(m) }
+func (*OutputPolicy) ProtoMessage()               {}
+func (*OutputPolicy) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{25} }
+
+func (m *OutputPolicy) GetRound() bool {
+	if m != nil {
+		return m.Round
 	}
-	if interceptor == nil {
-		return srv.(ApplicationManagerServer).SetDevice(ctx, in)
+	return false
+}
+
+func (m *OutputPolicy) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/handler.ApplicationManager/SetDevice",
+	return 0
+}
+
+func (m *OutputPolicy) GetRoundingMode() string {
+	if m != nil {
+		return m.RoundingMode
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationManagerServer).SetDevice(ctx, req.(*Device))
+	return ""
+}
+
+func (m *OutputPolicy) GetSpecialValues() string {
+	if m != nil {
+		return m.SpecialValues
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ApplicationManager_DeleteDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeviceIdentifier)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *OutputPolicy) GetFieldCasing() string {
+	if m != nil {
+		return m.FieldCasing
 	}
-	if interceptor == nil {
-		return srv.(ApplicationManagerServer).DeleteDevice(ctx, in)
+	return ""
+}
+
+func (m *OutputPolicy) GetReplacement() string {
+	if m != nil {
+		return m.Replacement
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/handler.ApplicationManager/DeleteDevice",
+	return ""
+}
+
+func (m *OutputPolicy) GetProtectReserved() bool {
+	if m != nil {
+		return m.ProtectReserved
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationManagerServer).DeleteDevice(ctx, req.(*DeviceIdentifier))
+	return false
+}
+
+// Aggregation contains the settings for publishing aggregated uplink messages
+type Aggregation struct {
+	// The length of the aggregation window in seconds
+	Window uint32 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *Aggregation) Reset()                    { *m = Aggregation{} }
+func (m *Aggregation) String() string            { return proto.CompactTextString(m) }
+func (*Aggregation) ProtoMessage()               {}
+func (*Aggregation) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{26} }
+
+func (m *Aggregation) GetWindow() uint32 {
+	if m != nil {
+		return m.Window
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _ApplicationManager_GetDevicesForApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationIdentifier)
-	if err := dec(in); err != nil {
-		return nil, err
+// MQTTCredentials are the credentials of a collaborator of the application on the MQTT broker
+type MQTTCredentials struct {
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// The password is only returned when the credentials are created
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// The collaborator that the credentials belong to
+	Collaborator string `protobuf:"bytes,3,opt,name=collaborator,proto3" json:"collaborator,omitempty"`
+	// Topics that the collaborator can subscribe to
+	Read []string `protobuf:"bytes,4,rep,name=read,proto3" json:"read,omitempty"`
+	// Topics that the collaborator can publish to
+	Write []string `protobuf:"bytes,5,rep,name=write,proto3" json:"write,omitempty"`
+}
+
+func (m *MQTTCredentials) Reset()                    { *m = MQTTCredentials{} }
+func (m *MQTTCredentials) String() string            { return proto.CompactTextString(m) }
+func (*MQTTCredentials) ProtoMessage()               {}
+func (*MQTTCredentials) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{27} }
+
+func (m *MQTTCredentials) GetUsername() string {
+	if m != nil {
+		return m.Username
 	}
-	if interceptor == nil {
-		return srv.(ApplicationManagerServer).GetDevicesForApplication(ctx, in)
+	return ""
+}
+
+func (m *MQTTCredentials) GetPassword() string {
+	if m != nil {
+		return m.Password
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/handler.ApplicationManager/GetDevicesForApplication",
+	return ""
+}
+
+func (m *MQTTCredentials) GetCollaborator() string {
+	if m != nil {
+		return m.Collaborator
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationManagerServer).GetDevicesForApplication(ctx, req.(*ApplicationIdentifier))
+	return ""
+}
+
+func (m *MQTTCredentials) GetRead() []string {
+	if m != nil {
+		return m.Read
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _ApplicationManager_DryDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DryDownlinkMessage)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationManagerServer).DryDownlink(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/handler.ApplicationManager/DryDownlink",
+func (m *MQTTCredentials) GetWrite() []string {
+	if m != nil {
+		return m.Write
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationManagerServer).DryDownlink(ctx, req.(*DryDownlinkMessage))
+	return nil
+}
+
+type MQTTCredentialsList struct {
+	Credentials []*MQTTCredentials `protobuf:"bytes,1,rep,name=credentials" json:"credentials,omitempty"`
+}
+
+func (m *MQTTCredentialsList) Reset()                    { *m = MQTTCredentialsList{} }
+func (m *MQTTCredentialsList) String() string            { return proto.CompactTextString(m) }
+func (*MQTTCredentialsList) ProtoMessage()               {}
+func (*MQTTCredentialsList) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{28} }
+
+func (m *MQTTCredentialsList) GetCredentials() []*MQTTCredentials {
+	if m != nil {
+		return m.Credentials
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _ApplicationManager_DryUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DryUplinkMessage)
-	if err := dec(in); err != nil {
-		return nil, err
+// ErasureReport lists the data that was erased when forgetting a device
+type ErasureReport struct {
+	AppId  string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DevId  string `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	DevEui string `protobuf:"bytes,3,opt,name=dev_eui,json=devEui,proto3" json:"dev_eui,omitempty"`
+	// Time when the data was erased (Unix nanoseconds)
+	ErasedAt int64 `protobuf:"varint,4,opt,name=erased_at,json=erasedAt,proto3" json:"erased_at,omitempty"`
+	// The number of stored uplink messages that were erased
+	Uplinks uint32 `protobuf:"varint,5,opt,name=uplinks,proto3" json:"uplinks,omitempty"`
+	// The number of queued downlink messages that were erased
+	Downlinks uint32 `protobuf:"varint,6,opt,name=downlinks,proto3" json:"downlinks,omitempty"`
+	// The kinds of stored data that were erased
+	Erased []string `protobuf:"bytes,7,rep,name=erased,proto3" json:"erased,omitempty"`
+}
+
+func (m *ErasureReport) Reset()                    { *m = ErasureReport{} }
+func (m *ErasureReport) String() string            { return proto.CompactTextString(m) }
+func (*ErasureReport) ProtoMessage()               {}
+func (*ErasureReport) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{29} }
+
+func (m *ErasureReport) GetAppId() string {
+	if m != nil {
+		return m.AppId
 	}
-	if interceptor == nil {
-		return srv.(ApplicationManagerServer).DryUplink(ctx, in)
+	return ""
+}
+
+func (m *ErasureReport) GetDevId() string {
+	if m != nil {
+		return m.DevId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/handler.ApplicationManager/DryUplink",
+	return ""
+}
+
+func (m *ErasureReport) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationManagerServer).DryUplink(ctx, req.(*DryUplinkMessage))
+	return ""
+}
+
+func (m *ErasureReport) GetErasedAt() int64 {
+	if m != nil {
+		return m.ErasedAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _ApplicationManager_SimulateUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SimulatedUplinkMessage)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ErasureReport) GetUplinks() uint32 {
+	if m != nil {
+		return m.Uplinks
 	}
-	if interceptor == nil {
-		return srv.(ApplicationManagerServer).SimulateUplink(ctx, in)
+	return 0
+}
+
+func (m *ErasureReport) GetDownlinks() uint32 {
+	if m != nil {
+		return m.Downlinks
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/handler.ApplicationManager/SimulateUplink",
+	return 0
+}
+
+func (m *ErasureReport) GetErased() []string {
+	if m != nil {
+		return m.Erased
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationManagerServer).SimulateUplink(ctx, req.(*SimulatedUplinkMessage))
+	return nil
+}
+
+// MutationResult describes the changes that were made by a SetApplication or SetDevice request, or the changes that
+// would be made if the request was a dry run
+type MutationResult struct {
+	// True if the request was a dry run, and the changes were not persisted
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// True if the device is (or would be) created
+	Created bool `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	// The fields that are (or would be) changed
+	Changed []string `protobuf:"bytes,3,rep,name=changed,proto3" json:"changed,omitempty"`
+	// The revision after the changes
+	Revision uint64 `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (m *MutationResult) Reset()                    { *m = MutationResult{} }
+func (m *MutationResult) String() string            { return proto.CompactTextString(m) }
+func (*MutationResult) ProtoMessage()               {}
+func (*MutationResult) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{30} }
+
+func (m *MutationResult) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var _ApplicationManager_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "handler.ApplicationManager",
-	HandlerType: (*ApplicationManagerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterApplication",
-			Handler:    _ApplicationManager_RegisterApplication_Handler,
-		},
-		{
-			MethodName: "GetApplication",
-			Handler:    _ApplicationManager_GetApplication_Handler,
-		},
-		{
-			MethodName: "SetApplication",
-			Handler:    _ApplicationManager_SetApplication_Handler,
-		},
-		{
-			MethodName: "DeleteApplication",
-			Handler:    _ApplicationManager_DeleteApplication_Handler,
-		},
-		{
-			MethodName: "GetDevice",
-			Handler:    _ApplicationManager_GetDevice_Handler,
-		},
-		{
-			MethodName: "SetDevice",
-			Handler:    _ApplicationManager_SetDevice_Handler,
-		},
-		{
-			MethodName: "DeleteDevice",
-			Handler:    _ApplicationManager_DeleteDevice_Handler,
-		},
-		{
-			MethodName: "GetDevicesForApplication",
-			Handler:    _ApplicationManager_GetDevicesForApplication_Handler,
-		},
-		{
-			MethodName: "DryDownlink",
-			Handler:    _ApplicationManager_DryDownlink_Handler,
-		},
-		{
-			MethodName: "DryUplink",
-			Handler:    _ApplicationManager_DryUplink_Handler,
-		},
-		{
-			MethodName: "SimulateUplink",
-			Handler:    _ApplicationManager_SimulateUplink_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "github.com/TheThingsNetwork/ttn/api/handler/handler.proto",
+func (m *MutationResult) GetCreated() bool {
+	if m != nil {
+		return m.Created
+	}
+	return false
 }
 
-// Client API for HandlerManager service
+func (m *MutationResult) GetChanged() []string {
+	if m != nil {
+		return m.Changed
+	}
+	return nil
+}
 
-type HandlerManagerClient interface {
-	GetStatus(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*Status, error)
+func (m *MutationResult) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
 }
 
-type handlerManagerClient struct {
-	cc *grpc.ClientConn
+// MICCheckRequest contains a raw LoRaWAN frame that is checked against the keys and frame counters of a device
+type MICCheckRequest struct {
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DevId string `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// The raw LoRaWAN frame (PHYPayload) of an uplink, downlink, join request or join accept message
+	Payload []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
 }
 
-func NewHandlerManagerClient(cc *grpc.ClientConn) HandlerManagerClient {
-	return &handlerManagerClient{cc}
+func (m *MICCheckRequest) Reset()                    { *m = MICCheckRequest{} }
+func (m *MICCheckRequest) String() string            { return proto.CompactTextString(m) }
+func (*MICCheckRequest) ProtoMessage()               {}
+func (*MICCheckRequest) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{31} }
+
+func (m *MICCheckRequest) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
 }
 
-func (c *handlerManagerClient) GetStatus(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*Status, error) {
-	out := new(Status)
-	err := grpc.Invoke(ctx, "/handler.HandlerManager/GetStatus", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *MICCheckRequest) GetDevId() string {
+	if m != nil {
+		return m.DevId
 	}
-	return out, nil
+	return ""
 }
 
-// Server API for HandlerManager service
+func (m *MICCheckRequest) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
 
-type HandlerManagerServer interface {
-	GetStatus(context.Context, *StatusRequest) (*Status, error)
+// MICCheckResult reports if the MIC of a frame is valid, and which key or frame counter caused a mismatch
+type MICCheckResult struct {
+	// The LoRaWAN message type of the frame
+	MessageType string `protobuf:"bytes,1,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"`
+	// The DevAddr in the frame (data messages only)
+	DevAddr string `protobuf:"bytes,2,opt,name=dev_addr,json=devAddr,proto3" json:"dev_addr,omitempty"`
+	// The frame counter that was used to compute the expected MIC (data messages only)
+	FCnt uint32 `protobuf:"varint,3,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	// The last uplink frame counter or the next downlink frame counter of the device (data messages only)
+	DeviceFCnt uint32 `protobuf:"varint,4,opt,name=device_f_cnt,json=deviceFCnt,proto3" json:"device_f_cnt,omitempty"`
+	// The MIC in the frame (hex)
+	ReceivedMic string `protobuf:"bytes,5,opt,name=received_mic,json=receivedMic,proto3" json:"received_mic,omitempty"`
+	// The MIC that was computed with the stored keys of the device (hex)
+	ExpectedMic string `protobuf:"bytes,6,opt,name=expected_mic,json=expectedMic,proto3" json:"expected_mic,omitempty"`
+	// True if the MIC is valid and the frame would be accepted
+	Valid bool `protobuf:"varint,7,opt,name=valid,proto3" json:"valid,omitempty"`
+	// The cause of the failure: dev_addr, nwk_s_key, keys_swapped, f_cnt_width, f_cnt_rollover, f_cnt_too_low,
+	// f_cnt_too_high, app_key, app_eui, dev_eui or no_session
+	Mismatch string `protobuf:"bytes,8,opt,name=mismatch,proto3" json:"mismatch,omitempty"`
+	// A description of the result
+	Description string `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-func RegisterHandlerManagerServer(s *grpc.Server, srv HandlerManagerServer) {
-	s.RegisterService(&_HandlerManager_serviceDesc, srv)
+func (m *MICCheckResult) Reset()                    { *m = MICCheckResult{} }
+func (m *MICCheckResult) String() string            { return proto.CompactTextString(m) }
+func (*MICCheckResult) ProtoMessage()               {}
+func (*MICCheckResult) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{32} }
+
+func (m *MICCheckResult) GetMessageType() string {
+	if m != nil {
+		return m.MessageType
+	}
+	return ""
 }
 
-func _HandlerManager_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MICCheckResult) GetDevAddr() string {
+	if m != nil {
+		return m.DevAddr
 	}
-	if interceptor == nil {
-		return srv.(HandlerManagerServer).GetStatus(ctx, in)
+	return ""
+}
+
+func (m *MICCheckResult) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/handler.HandlerManager/GetStatus",
+	return 0
+}
+
+func (m *MICCheckResult) GetDeviceFCnt() uint32 {
+	if m != nil {
+		return m.DeviceFCnt
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HandlerManagerServer).GetStatus(ctx, req.(*StatusRequest))
+	return 0
+}
+
+func (m *MICCheckResult) GetReceivedMic() string {
+	if m != nil {
+		return m.ReceivedMic
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _HandlerManager_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "handler.HandlerManager",
-	HandlerType: (*HandlerManagerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetStatus",
-			Handler:    _HandlerManager_GetStatus_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "github.com/TheThingsNetwork/ttn/api/handler/handler.proto",
+func (m *MICCheckResult) GetExpectedMic() string {
+	if m != nil {
+		return m.ExpectedMic
+	}
+	return ""
 }
 
-func (m *DeviceActivationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *MICCheckResult) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *DeviceActivationResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Payload) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.Payload)))
-		i += copy(dAtA[i:], m.Payload)
+func (m *MICCheckResult) GetMismatch() string {
+	if m != nil {
+		return m.Mismatch
 	}
-	if m.Message != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(m.Message.Size()))
-		n1, err := m.Message.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
+	return ""
+}
+
+func (m *MICCheckResult) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if m.DownlinkOption != nil {
-		dAtA[i] = 0x5a
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(m.DownlinkOption.Size()))
-		n2, err := m.DownlinkOption.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
-	}
-	if m.ActivationMetadata != nil {
-		dAtA[i] = 0xba
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(m.ActivationMetadata.Size()))
-		n3, err := m.ActivationMetadata.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
-	}
-	if m.Trace != nil {
-		dAtA[i] = 0xfa
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(m.Trace.Size()))
-		n4, err := m.Trace.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+	return ""
+}
+
+// WatchDevicesRequest selects the device registry changes that are streamed by WatchDevices
+type WatchDevicesRequest struct {
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// Stream the changes after the change with this resume token (empty to only stream new changes)
+	ResumeToken string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (m *WatchDevicesRequest) Reset()                    { *m = WatchDevicesRequest{} }
+func (m *WatchDevicesRequest) String() string            { return proto.CompactTextString(m) }
+func (*WatchDevicesRequest) ProtoMessage()               {}
+func (*WatchDevicesRequest) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{33} }
+
+func (m *WatchDevicesRequest) GetAppId() string {
+	if m != nil {
+		return m.AppId
 	}
-	return i, nil
+	return ""
 }
 
-func (m *StatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *WatchDevicesRequest) GetResumeToken() string {
+	if m != nil {
+		return m.ResumeToken
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *StatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+// DeviceChange is a change in the device registry of an application
+type DeviceChange struct {
+	// The type of change: create, update or delete
+	Type  string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	AppId string `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DevId string `protobuf:"bytes,3,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// Time of the change (Unix nanoseconds)
+	Time int64 `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	// The device after the change (not set if the device was deleted)
+	Device *Device `protobuf:"bytes,5,opt,name=device" json:"device,omitempty"`
+	// The token to resume watching after this change
+	ResumeToken string `protobuf:"bytes,6,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
 }
 
-func (m *Status) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DeviceChange) Reset()                    { *m = DeviceChange{} }
+func (m *DeviceChange) String() string            { return proto.CompactTextString(m) }
+func (*DeviceChange) ProtoMessage()               {}
+func (*DeviceChange) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{34} }
+
+func (m *DeviceChange) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Status) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.System != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(m.System.Size()))
-		n5, err := m.System.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
-	}
-	if m.Component != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(m.Component.Size()))
-		n6, err := m.Component.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
-	}
-	if m.Uplink != nil {
-		dAtA[i] = 0x5a
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(m.Uplink.Size()))
-		n7, err := m.Uplink.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
-	}
-	if m.Downlink != nil {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(m.Downlink.Size()))
-		n8, err := m.Downlink.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n8
-	}
-	if m.Activations != nil {
-		dAtA[i] = 0x6a
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(m.Activations.Size()))
-		n9, err := m.Activations.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n9
+func (m *DeviceChange) GetAppId() string {
+	if m != nil {
+		return m.AppId
 	}
-	return i, nil
+	return ""
 }
 
-func (m *ApplicationIdentifier) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DeviceChange) GetDevId() string {
+	if m != nil {
+		return m.DevId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ApplicationIdentifier) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.AppId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
-		i += copy(dAtA[i:], m.AppId)
+func (m *DeviceChange) GetTime() int64 {
+	if m != nil {
+		return m.Time
 	}
-	return i, nil
+	return 0
 }
 
-func (m *Application) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DeviceChange) GetDevice() *Device {
+	if m != nil {
+		return m.Device
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Application) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.AppId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
-		i += copy(dAtA[i:], m.AppId)
-	}
-	if len(m.Decoder) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.Decoder)))
-		i += copy(dAtA[i:], m.Decoder)
-	}
-	if len(m.Converter) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.Converter)))
-		i += copy(dAtA[i:], m.Converter)
+func (m *DeviceChange) GetResumeToken() string {
+	if m != nil {
+		return m.ResumeToken
 	}
-	if len(m.Validator) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.Validator)))
-		i += copy(dAtA[i:], m.Validator)
+	return ""
+}
+
+// DeviceDebugModeRequest enables or disables the debug mode of a device
+type DeviceDebugModeRequest struct {
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DevId string `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// How long the debug mode stays enabled (seconds, 0 to disable the debug mode)
+	Duration uint32 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *DeviceDebugModeRequest) Reset()                    { *m = DeviceDebugModeRequest{} }
+func (m *DeviceDebugModeRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeviceDebugModeRequest) ProtoMessage()               {}
+func (*DeviceDebugModeRequest) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{35} }
+
+func (m *DeviceDebugModeRequest) GetAppId() string {
+	if m != nil {
+		return m.AppId
 	}
-	if len(m.Encoder) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.Encoder)))
-		i += copy(dAtA[i:], m.Encoder)
+	return ""
+}
+
+func (m *DeviceDebugModeRequest) GetDevId() string {
+	if m != nil {
+		return m.DevId
 	}
-	return i, nil
+	return ""
 }
 
-func (m *DeviceIdentifier) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DeviceDebugModeRequest) GetDuration() uint32 {
+	if m != nil {
+		return m.Duration
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DeviceIdentifier) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.AppId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
-		i += copy(dAtA[i:], m.AppId)
+// DebugTraceEntry is an entry in the debug trace of a device
+type DebugTraceEntry struct {
+	// Time of the entry (Unix nanoseconds)
+	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// The type of the entry: trace, scheduling, function_log or integration
+	Type     string            `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Message  string            `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Metadata map[string]string `protobuf:"bytes,4,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *DebugTraceEntry) Reset()                    { *m = DebugTraceEntry{} }
+func (m *DebugTraceEntry) String() string            { return proto.CompactTextString(m) }
+func (*DebugTraceEntry) ProtoMessage()               {}
+func (*DebugTraceEntry) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{36} }
+
+func (m *DebugTraceEntry) GetTime() int64 {
+	if m != nil {
+		return m.Time
 	}
-	if len(m.DevId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevId)))
-		i += copy(dAtA[i:], m.DevId)
+	return 0
+}
+
+func (m *DebugTraceEntry) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return i, nil
+	return ""
 }
 
-func (m *Device) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DebugTraceEntry) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Device) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.AppId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
-		i += copy(dAtA[i:], m.AppId)
+func (m *DebugTraceEntry) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
 	}
-	if len(m.DevId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevId)))
-		i += copy(dAtA[i:], m.DevId)
+	return nil
+}
+
+// DeviceDebugTrace contains the verbose traces that were captured while the device was in debug mode
+type DeviceDebugTrace struct {
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DevId string `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// Time until which the debug mode is enabled (Unix nanoseconds, 0 if it is disabled)
+	DebugUntil int64 `protobuf:"varint,3,opt,name=debug_until,json=debugUntil,proto3" json:"debug_until,omitempty"`
+	// The captured entries, newest first
+	Entries []*DebugTraceEntry `protobuf:"bytes,4,rep,name=entries" json:"entries,omitempty"`
+}
+
+func (m *DeviceDebugTrace) Reset()                    { *m = DeviceDebugTrace{} }
+func (m *DeviceDebugTrace) String() string            { return proto.CompactTextString(m) }
+func (*DeviceDebugTrace) ProtoMessage()               {}
+func (*DeviceDebugTrace) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{37} }
+
+func (m *DeviceDebugTrace) GetAppId() string {
+	if m != nil {
+		return m.AppId
 	}
-	if m.Device != nil {
-		nn10, err := m.Device.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += nn10
+	return ""
+}
+
+func (m *DeviceDebugTrace) GetDevId() string {
+	if m != nil {
+		return m.DevId
 	}
-	if m.Latitude != 0 {
-		dAtA[i] = 0x55
-		i++
-		i = encodeFixed32Handler(dAtA, i, uint32(math.Float32bits(float32(m.Latitude))))
+	return ""
+}
+
+func (m *DeviceDebugTrace) GetDebugUntil() int64 {
+	if m != nil {
+		return m.DebugUntil
 	}
-	if m.Longitude != 0 {
-		dAtA[i] = 0x5d
-		i++
-		i = encodeFixed32Handler(dAtA, i, uint32(math.Float32bits(float32(m.Longitude))))
+	return 0
+}
+
+func (m *DeviceDebugTrace) GetEntries() []*DebugTraceEntry {
+	if m != nil {
+		return m.Entries
 	}
-	if m.Altitude != 0 {
-		dAtA[i] = 0x60
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(m.Altitude))
+	return nil
+}
+
+// DeviceStateRequest requests the state of a device at a point in time
+type DeviceStateRequest struct {
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DevId string `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// The point in time (Unix nanoseconds)
+	Time int64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (m *DeviceStateRequest) Reset()                    { *m = DeviceStateRequest{} }
+func (m *DeviceStateRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeviceStateRequest) ProtoMessage()               {}
+func (*DeviceStateRequest) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{38} }
+
+func (m *DeviceStateRequest) GetAppId() string {
+	if m != nil {
+		return m.AppId
 	}
-	if len(m.Description) > 0 {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.Description)))
-		i += copy(dAtA[i:], m.Description)
+	return ""
+}
+
+func (m *DeviceStateRequest) GetDevId() string {
+	if m != nil {
+		return m.DevId
 	}
-	return i, nil
+	return ""
 }
 
-func (m *Device_LorawanDevice) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.LorawanDevice != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(m.LorawanDevice.Size()))
-		n11, err := m.LorawanDevice.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
+func (m *DeviceStateRequest) GetTime() int64 {
+	if m != nil {
+		return m.Time
 	}
-	return i, nil
+	return 0
 }
-func (m *DeviceList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+
+// DeviceState is the state of a device at a point in time, reconstructed from its history
+type DeviceState struct {
+	// Time of the last change before the requested point in time (Unix nanoseconds)
+	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// The fields that were changed at that time
+	Changed []string `protobuf:"bytes,2,rep,name=changed,proto3" json:"changed,omitempty"`
+	// The settings, session and uplink frame counter of the device
+	Device *Device `protobuf:"bytes,3,opt,name=device" json:"device,omitempty"`
+}
+
+func (m *DeviceState) Reset()                    { *m = DeviceState{} }
+func (m *DeviceState) String() string            { return proto.CompactTextString(m) }
+func (*DeviceState) ProtoMessage()               {}
+func (*DeviceState) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{39} }
+
+func (m *DeviceState) GetTime() int64 {
+	if m != nil {
+		return m.Time
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DeviceList) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Devices) > 0 {
-		for _, msg := range m.Devices {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (m *DeviceState) GetChanged() []string {
+	if m != nil {
+		return m.Changed
 	}
-	return i, nil
+	return nil
 }
 
-func (m *DryDownlinkMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DeviceState) GetDevice() *Device {
+	if m != nil {
+		return m.Device
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DryDownlinkMessage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Payload) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.Payload)))
-		i += copy(dAtA[i:], m.Payload)
+// DeviceTwinRequest sets the desired state in the twin of a device
+type DeviceTwinRequest struct {
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DevId string `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// The desired fields (JSON object). String values can refer to attributes of the device ({{.key}})
+	DesiredFields string `protobuf:"bytes,3,opt,name=desired_fields,json=desiredFields,proto3" json:"desired_fields,omitempty"`
+	// The port on which the desired fields are sent to the device
+	FPort uint32 `protobuf:"varint,4,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+}
+
+func (m *DeviceTwinRequest) Reset()                    { *m = DeviceTwinRequest{} }
+func (m *DeviceTwinRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeviceTwinRequest) ProtoMessage()               {}
+func (*DeviceTwinRequest) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{40} }
+
+func (m *DeviceTwinRequest) GetAppId() string {
+	if m != nil {
+		return m.AppId
 	}
-	if len(m.Fields) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.Fields)))
-		i += copy(dAtA[i:], m.Fields)
+	return ""
+}
+
+func (m *DeviceTwinRequest) GetDevId() string {
+	if m != nil {
+		return m.DevId
 	}
-	if m.App != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(m.App.Size()))
-		n12, err := m.App.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n12
+	return ""
+}
+
+func (m *DeviceTwinRequest) GetDesiredFields() string {
+	if m != nil {
+		return m.DesiredFields
 	}
-	if m.Port != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(m.Port))
+	return ""
+}
+
+func (m *DeviceTwinRequest) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
 	}
-	return i, nil
+	return 0
 }
 
-func (m *DryUplinkMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+// DeviceTwin contains the desired and reported state of a device
+type DeviceTwin struct {
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DevId string `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// The port on which the desired fields are sent to the device
+	FPort uint32 `protobuf:"varint,3,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// The desired fields (JSON object)
+	DesiredFields string `protobuf:"bytes,4,opt,name=desired_fields,json=desiredFields,proto3" json:"desired_fields,omitempty"`
+	// The reported fields (JSON object), taken from the uplink messages of the device
+	ReportedFields string `protobuf:"bytes,5,opt,name=reported_fields,json=reportedFields,proto3" json:"reported_fields,omitempty"`
+	// Whether the reported fields match the desired fields
+	InSync bool `protobuf:"varint,6,opt,name=in_sync,json=inSync,proto3" json:"in_sync,omitempty"`
+	// The desired fields that do not match the reported fields
+	Pending []string `protobuf:"bytes,7,rep,name=pending,proto3" json:"pending,omitempty"`
+	// Time of the last change to the desired fields (Unix nanoseconds)
+	DesiredAt int64 `protobuf:"varint,8,opt,name=desired_at,json=desiredAt,proto3" json:"desired_at,omitempty"`
+	// Time of the last uplink message that reported fields (Unix nanoseconds)
+	ReportedAt int64 `protobuf:"varint,9,opt,name=reported_at,json=reportedAt,proto3" json:"reported_at,omitempty"`
+	// Time of the last downlink message with the pending fields (Unix nanoseconds)
+	PushedAt int64 `protobuf:"varint,10,opt,name=pushed_at,json=pushedAt,proto3" json:"pushed_at,omitempty"`
+}
+
+func (m *DeviceTwin) Reset()                    { *m = DeviceTwin{} }
+func (m *DeviceTwin) String() string            { return proto.CompactTextString(m) }
+func (*DeviceTwin) ProtoMessage()               {}
+func (*DeviceTwin) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{41} }
+
+func (m *DeviceTwin) GetAppId() string {
+	if m != nil {
+		return m.AppId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DryUplinkMessage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Payload) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.Payload)))
-		i += copy(dAtA[i:], m.Payload)
+func (m *DeviceTwin) GetDevId() string {
+	if m != nil {
+		return m.DevId
 	}
-	if m.App != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(m.App.Size()))
-		n13, err := m.App.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n13
+	return ""
+}
+
+func (m *DeviceTwin) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
 	}
-	if m.Port != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(m.Port))
+	return 0
+}
+
+func (m *DeviceTwin) GetDesiredFields() string {
+	if m != nil {
+		return m.DesiredFields
 	}
-	return i, nil
+	return ""
 }
 
-func (m *SimulatedUplinkMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DeviceTwin) GetReportedFields() string {
+	if m != nil {
+		return m.ReportedFields
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SimulatedUplinkMessage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.AppId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
-		i += copy(dAtA[i:], m.AppId)
+func (m *DeviceTwin) GetInSync() bool {
+	if m != nil {
+		return m.InSync
 	}
-	if len(m.DevId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevId)))
-		i += copy(dAtA[i:], m.DevId)
-	}
-	if len(m.Payload) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.Payload)))
-		i += copy(dAtA[i:], m.Payload)
-	}
-	if m.Port != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(m.Port))
+	return false
+}
+
+func (m *DeviceTwin) GetPending() []string {
+	if m != nil {
+		return m.Pending
 	}
-	return i, nil
+	return nil
 }
 
-func (m *LogEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DeviceTwin) GetDesiredAt() int64 {
+	if m != nil {
+		return m.DesiredAt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *LogEntry) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Function) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.Function)))
-		i += copy(dAtA[i:], m.Function)
+func (m *DeviceTwin) GetReportedAt() int64 {
+	if m != nil {
+		return m.ReportedAt
 	}
-	if len(m.Fields) > 0 {
-		for _, s := range m.Fields {
-			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	return 0
+}
+
+func (m *DeviceTwin) GetPushedAt() int64 {
+	if m != nil {
+		return m.PushedAt
 	}
-	return i, nil
+	return 0
 }
 
-func (m *DryUplinkResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+// CommandRequest is a downlink message for which the Handler waits for a response from the device
+type CommandRequest struct {
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DevId string `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// The port number of the downlink
+	Port      uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	Confirmed bool   `protobuf:"varint,4,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// The binary payload of the downlink
+	Payload []byte `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	// JSON-encoded object with fields to encode
+	Fields string `protobuf:"bytes,6,opt,name=fields,proto3" json:"fields,omitempty"`
+	// The port number of the response (0 for any port)
+	ResponsePort uint32 `protobuf:"varint,7,opt,name=response_port,json=responsePort,proto3" json:"response_port,omitempty"`
+	// The decoded field that correlates the response with the command. A random correlation ID is added to the
+	// fields of the downlink under this name, and the response must contain the same value in this field
+	CorrelationField string `protobuf:"bytes,8,opt,name=correlation_field,json=correlationField,proto3" json:"correlation_field,omitempty"`
+	// How long to wait for the response (seconds, 0 for the default of 5 minutes)
+	Timeout uint32 `protobuf:"varint,9,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (m *CommandRequest) Reset()                    { *m = CommandRequest{} }
+func (m *CommandRequest) String() string            { return proto.CompactTextString(m) }
+func (*CommandRequest) ProtoMessage()               {}
+func (*CommandRequest) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{42} }
+
+func (m *CommandRequest) GetAppId() string {
+	if m != nil {
+		return m.AppId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DryUplinkResult) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Payload) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.Payload)))
-		i += copy(dAtA[i:], m.Payload)
+func (m *CommandRequest) GetDevId() string {
+	if m != nil {
+		return m.DevId
 	}
-	if len(m.Fields) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.Fields)))
-		i += copy(dAtA[i:], m.Fields)
+	return ""
+}
+
+func (m *CommandRequest) GetPort() uint32 {
+	if m != nil {
+		return m.Port
 	}
-	if m.Valid {
-		dAtA[i] = 0x18
-		i++
-		if m.Valid {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return 0
+}
+
+func (m *CommandRequest) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
 	}
-	if len(m.Logs) > 0 {
-		for _, msg := range m.Logs {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return false
+}
+
+func (m *CommandRequest) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
 	}
-	return i, nil
+	return nil
 }
 
-func (m *DryDownlinkResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CommandRequest) GetFields() string {
+	if m != nil {
+		return m.Fields
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DryDownlinkResult) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Payload) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintHandler(dAtA, i, uint64(len(m.Payload)))
-		i += copy(dAtA[i:], m.Payload)
+func (m *CommandRequest) GetResponsePort() uint32 {
+	if m != nil {
+		return m.ResponsePort
 	}
-	if len(m.Logs) > 0 {
-		for _, msg := range m.Logs {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return 0
+}
+
+func (m *CommandRequest) GetCorrelationField() string {
+	if m != nil {
+		return m.CorrelationField
 	}
-	return i, nil
+	return ""
 }
 
-func encodeFixed64Handler(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
+func (m *CommandRequest) GetTimeout() uint32 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
 }
-func encodeFixed32Handler(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
+
+// CommandResponse is the uplink message that the device sent in response to a command
+type CommandResponse struct {
+	// The correlation ID that was added to the fields of the downlink
+	CorrelationId string `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	// Time when the server received the response in Unix nanoseconds
+	ServerTime int64  `protobuf:"varint,2,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	Port       uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	Counter    uint32 `protobuf:"varint,4,opt,name=counter,proto3" json:"counter,omitempty"`
+	// The decrypted binary payload
+	PayloadRaw []byte `protobuf:"bytes,5,opt,name=payload_raw,json=payloadRaw,proto3" json:"payload_raw,omitempty"`
+	// JSON-encoded object with the decoded fields
+	Fields string `protobuf:"bytes,6,opt,name=fields,proto3" json:"fields,omitempty"`
 }
-func encodeVarintHandler(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+
+func (m *CommandResponse) Reset()                    { *m = CommandResponse{} }
+func (m *CommandResponse) String() string            { return proto.CompactTextString(m) }
+func (*CommandResponse) ProtoMessage()               {}
+func (*CommandResponse) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{43} }
+
+func (m *CommandResponse) GetCorrelationId() string {
+	if m != nil {
+		return m.CorrelationId
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return ""
 }
-func (m *DeviceActivationResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
+
+func (m *CommandResponse) GetServerTime() int64 {
+	if m != nil {
+		return m.ServerTime
 	}
-	if m.Message != nil {
-		l = m.Message.Size()
-		n += 1 + l + sovHandler(uint64(l))
+	return 0
+}
+
+func (m *CommandResponse) GetPort() uint32 {
+	if m != nil {
+		return m.Port
 	}
-	if m.DownlinkOption != nil {
-		l = m.DownlinkOption.Size()
-		n += 1 + l + sovHandler(uint64(l))
+	return 0
+}
+
+func (m *CommandResponse) GetCounter() uint32 {
+	if m != nil {
+		return m.Counter
 	}
-	if m.ActivationMetadata != nil {
-		l = m.ActivationMetadata.Size()
-		n += 2 + l + sovHandler(uint64(l))
+	return 0
+}
+
+func (m *CommandResponse) GetPayloadRaw() []byte {
+	if m != nil {
+		return m.PayloadRaw
 	}
-	if m.Trace != nil {
-		l = m.Trace.Size()
-		n += 2 + l + sovHandler(uint64(l))
+	return nil
+}
+
+func (m *CommandResponse) GetFields() string {
+	if m != nil {
+		return m.Fields
 	}
-	return n
+	return ""
 }
 
-func (m *StatusRequest) Size() (n int) {
-	var l int
-	_ = l
-	return n
+// PortFunctions are the payload functions for messages on a range of ports
+type PortFunctions struct {
+	// The first port of the range
+	MinPort uint32 `protobuf:"varint,1,opt,name=min_port,json=minPort,proto3" json:"min_port,omitempty"`
+	// The last port of the range (the same as min_port if not set)
+	MaxPort   uint32 `protobuf:"varint,2,opt,name=max_port,json=maxPort,proto3" json:"max_port,omitempty"`
+	Decoder   string `protobuf:"bytes,3,opt,name=decoder,proto3" json:"decoder,omitempty"`
+	Converter string `protobuf:"bytes,4,opt,name=converter,proto3" json:"converter,omitempty"`
+	Validator string `protobuf:"bytes,5,opt,name=validator,proto3" json:"validator,omitempty"`
+	Encoder   string `protobuf:"bytes,6,opt,name=encoder,proto3" json:"encoder,omitempty"`
 }
 
-func (m *Status) Size() (n int) {
-	var l int
-	_ = l
-	if m.System != nil {
-		l = m.System.Size()
-		n += 1 + l + sovHandler(uint64(l))
-	}
-	if m.Component != nil {
-		l = m.Component.Size()
-		n += 1 + l + sovHandler(uint64(l))
+func (m *PortFunctions) Reset()                    { *m = PortFunctions{} }
+func (m *PortFunctions) String() string            { return proto.CompactTextString(m) }
+func (*PortFunctions) ProtoMessage()               {}
+func (*PortFunctions) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{44} }
+
+func (m *PortFunctions) GetMinPort() uint32 {
+	if m != nil {
+		return m.MinPort
 	}
-	if m.Uplink != nil {
-		l = m.Uplink.Size()
-		n += 1 + l + sovHandler(uint64(l))
-	}
-	if m.Downlink != nil {
-		l = m.Downlink.Size()
-		n += 1 + l + sovHandler(uint64(l))
-	}
-	if m.Activations != nil {
-		l = m.Activations.Size()
-		n += 1 + l + sovHandler(uint64(l))
-	}
-	return n
+	return 0
 }
 
-func (m *ApplicationIdentifier) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.AppId)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
+func (m *PortFunctions) GetMaxPort() uint32 {
+	if m != nil {
+		return m.MaxPort
 	}
-	return n
+	return 0
 }
 
-func (m *Application) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.AppId)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
-	}
-	l = len(m.Decoder)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
-	}
-	l = len(m.Converter)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
-	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
-	}
-	l = len(m.Encoder)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
+func (m *PortFunctions) GetDecoder() string {
+	if m != nil {
+		return m.Decoder
 	}
-	return n
+	return ""
 }
 
-func (m *DeviceIdentifier) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.AppId)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
-	}
-	l = len(m.DevId)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
+func (m *PortFunctions) GetConverter() string {
+	if m != nil {
+		return m.Converter
 	}
-	return n
+	return ""
 }
 
-func (m *Device) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.AppId)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
+func (m *PortFunctions) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	l = len(m.DevId)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
+	return ""
+}
+
+func (m *PortFunctions) GetEncoder() string {
+	if m != nil {
+		return m.Encoder
 	}
-	if m.Device != nil {
-		n += m.Device.Size()
+	return ""
+}
+
+// TestUplinkRequest is a payload to test the stored payload functions of an application with
+type TestUplinkRequest struct {
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// The binary payload to use
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// The port number that should be passed to the payload functions
+	Port uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (m *TestUplinkRequest) Reset()                    { *m = TestUplinkRequest{} }
+func (m *TestUplinkRequest) String() string            { return proto.CompactTextString(m) }
+func (*TestUplinkRequest) ProtoMessage()               {}
+func (*TestUplinkRequest) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{45} }
+
+func (m *TestUplinkRequest) GetAppId() string {
+	if m != nil {
+		return m.AppId
 	}
-	if m.Latitude != 0 {
-		n += 5
+	return ""
+}
+
+func (m *TestUplinkRequest) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
 	}
-	if m.Longitude != 0 {
-		n += 5
+	return nil
+}
+
+func (m *TestUplinkRequest) GetPort() uint32 {
+	if m != nil {
+		return m.Port
 	}
-	if m.Altitude != 0 {
-		n += 1 + sovHandler(uint64(m.Altitude))
+	return 0
+}
+
+// DeviceProfile contains the certification metadata of a device
+type DeviceProfile struct {
+	// The version of the LoRaWAN specification that the device implements (1.0, 1.0.1, 1.0.2, 1.0.3, 1.0.4 or 1.1)
+	LorawanVersion string `protobuf:"bytes,1,opt,name=lorawan_version,json=lorawanVersion,proto3" json:"lorawan_version,omitempty"`
+	// The vendor of the device
+	Vendor string `protobuf:"bytes,2,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	// The ID of the LoRaWAN certification of the device
+	CertificationId string `protobuf:"bytes,3,opt,name=certification_id,json=certificationId,proto3" json:"certification_id,omitempty"`
+	// The ID of the brand of the device in the device repository
+	BrandId string `protobuf:"bytes,4,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	// The ID of the model of the device in the device repository
+	ModelId string `protobuf:"bytes,5,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	// The version of the firmware of the device (optional, the latest version in the device repository is used if empty)
+	FirmwareVersion string `protobuf:"bytes,6,opt,name=firmware_version,json=firmwareVersion,proto3" json:"firmware_version,omitempty"`
+}
+
+func (m *DeviceProfile) Reset()                    { *m = DeviceProfile{} }
+func (m *DeviceProfile) String() string            { return proto.CompactTextString(m) }
+func (*DeviceProfile) ProtoMessage()               {}
+func (*DeviceProfile) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{46} }
+
+func (m *DeviceProfile) GetLorawanVersion() string {
+	if m != nil {
+		return m.LorawanVersion
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 2 + l + sovHandler(uint64(l))
+	return ""
+}
+
+func (m *DeviceProfile) GetVendor() string {
+	if m != nil {
+		return m.Vendor
 	}
-	return n
+	return ""
 }
 
-func (m *Device_LorawanDevice) Size() (n int) {
-	var l int
-	_ = l
-	if m.LorawanDevice != nil {
-		l = m.LorawanDevice.Size()
-		n += 1 + l + sovHandler(uint64(l))
+func (m *DeviceProfile) GetCertificationId() string {
+	if m != nil {
+		return m.CertificationId
 	}
-	return n
+	return ""
 }
-func (m *DeviceList) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Devices) > 0 {
-		for _, e := range m.Devices {
-			l = e.Size()
-			n += 1 + l + sovHandler(uint64(l))
-		}
+
+func (m *DeviceProfile) GetBrandId() string {
+	if m != nil {
+		return m.BrandId
 	}
-	return n
+	return ""
 }
 
-func (m *DryDownlinkMessage) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
+func (m *DeviceProfile) GetModelId() string {
+	if m != nil {
+		return m.ModelId
 	}
-	l = len(m.Fields)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
+	return ""
+}
+
+func (m *DeviceProfile) GetFirmwareVersion() string {
+	if m != nil {
+		return m.FirmwareVersion
 	}
-	if m.App != nil {
-		l = m.App.Size()
-		n += 1 + l + sovHandler(uint64(l))
+	return ""
+}
+
+// ComplianceGroup is a group of devices with the same LoRaWAN version, Regional Parameters revision and frequency plan
+type ComplianceGroup struct {
+	LorawanVersion     string `protobuf:"bytes,1,opt,name=lorawan_version,json=lorawanVersion,proto3" json:"lorawan_version,omitempty"`
+	RegionalParameters string `protobuf:"bytes,2,opt,name=regional_parameters,json=regionalParameters,proto3" json:"regional_parameters,omitempty"`
+	// The frequency plan of the last uplink message of the devices (empty if unknown)
+	FrequencyPlan string `protobuf:"bytes,3,opt,name=frequency_plan,json=frequencyPlan,proto3" json:"frequency_plan,omitempty"`
+	// The number of devices in the group
+	Devices uint32 `protobuf:"varint,4,opt,name=devices,proto3" json:"devices,omitempty"`
+	// The number of devices in the group that have a certification ID
+	Certified uint32 `protobuf:"varint,5,opt,name=certified,proto3" json:"certified,omitempty"`
+}
+
+func (m *ComplianceGroup) Reset()                    { *m = ComplianceGroup{} }
+func (m *ComplianceGroup) String() string            { return proto.CompactTextString(m) }
+func (*ComplianceGroup) ProtoMessage()               {}
+func (*ComplianceGroup) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{47} }
+
+func (m *ComplianceGroup) GetLorawanVersion() string {
+	if m != nil {
+		return m.LorawanVersion
 	}
-	if m.Port != 0 {
-		n += 1 + sovHandler(uint64(m.Port))
+	return ""
+}
+
+func (m *ComplianceGroup) GetRegionalParameters() string {
+	if m != nil {
+		return m.RegionalParameters
 	}
-	return n
+	return ""
 }
 
-func (m *DryUplinkMessage) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
+func (m *ComplianceGroup) GetFrequencyPlan() string {
+	if m != nil {
+		return m.FrequencyPlan
 	}
-	if m.App != nil {
-		l = m.App.Size()
-		n += 1 + l + sovHandler(uint64(l))
+	return ""
+}
+
+func (m *ComplianceGroup) GetDevices() uint32 {
+	if m != nil {
+		return m.Devices
 	}
-	if m.Port != 0 {
-		n += 1 + sovHandler(uint64(m.Port))
+	return 0
+}
+
+func (m *ComplianceGroup) GetCertified() uint32 {
+	if m != nil {
+		return m.Certified
 	}
-	return n
+	return 0
 }
 
-func (m *SimulatedUplinkMessage) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.AppId)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
+// ComplianceReport summarizes the devices of an application by LoRaWAN version, Regional Parameters revision and
+// frequency plan
+type ComplianceReport struct {
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// The number of devices of the application
+	Devices uint32             `protobuf:"varint,2,opt,name=devices,proto3" json:"devices,omitempty"`
+	Groups  []*ComplianceGroup `protobuf:"bytes,3,rep,name=groups" json:"groups,omitempty"`
+}
+
+func (m *ComplianceReport) Reset()                    { *m = ComplianceReport{} }
+func (m *ComplianceReport) String() string            { return proto.CompactTextString(m) }
+func (*ComplianceReport) ProtoMessage()               {}
+func (*ComplianceReport) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{48} }
+
+func (m *ComplianceReport) GetAppId() string {
+	if m != nil {
+		return m.AppId
 	}
-	l = len(m.DevId)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
+	return ""
+}
+
+func (m *ComplianceReport) GetDevices() uint32 {
+	if m != nil {
+		return m.Devices
 	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
+	return 0
+}
+
+func (m *ComplianceReport) GetGroups() []*ComplianceGroup {
+	if m != nil {
+		return m.Groups
 	}
-	if m.Port != 0 {
-		n += 1 + sovHandler(uint64(m.Port))
+	return nil
+}
+
+// DownlinkDelivery counts the uplink messages of a device that did or did not acknowledge a pending confirmed downlink
+type DownlinkDelivery struct {
+	// The number of uplink messages that acknowledged a confirmed downlink
+	Acked uint32 `protobuf:"varint,1,opt,name=acked,proto3" json:"acked,omitempty"`
+	// The number of uplink messages that did not acknowledge a pending confirmed downlink
+	Missed uint32 `protobuf:"varint,2,opt,name=missed,proto3" json:"missed,omitempty"`
+	// The number of uplink messages since the last acknowledgement that did not acknowledge a pending confirmed downlink
+	ConsecutiveMissed uint32 `protobuf:"varint,3,opt,name=consecutive_missed,json=consecutiveMissed,proto3" json:"consecutive_missed,omitempty"`
+}
+
+func (m *DownlinkDelivery) Reset()                    { *m = DownlinkDelivery{} }
+func (m *DownlinkDelivery) String() string            { return proto.CompactTextString(m) }
+func (*DownlinkDelivery) ProtoMessage()               {}
+func (*DownlinkDelivery) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{49} }
+
+func (m *DownlinkDelivery) GetAcked() uint32 {
+	if m != nil {
+		return m.Acked
 	}
-	return n
+	return 0
 }
 
-func (m *LogEntry) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Function)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
+func (m *DownlinkDelivery) GetMissed() uint32 {
+	if m != nil {
+		return m.Missed
 	}
-	if len(m.Fields) > 0 {
-		for _, s := range m.Fields {
-			l = len(s)
-			n += 1 + l + sovHandler(uint64(l))
-		}
+	return 0
+}
+
+func (m *DownlinkDelivery) GetConsecutiveMissed() uint32 {
+	if m != nil {
+		return m.ConsecutiveMissed
 	}
-	return n
+	return 0
 }
 
-func (m *DryUplinkResult) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
+// PayloadFunctionsRevision is a stored revision of the payload functions of an application
+type PayloadFunctionsRevision struct {
+	// The revision of the application in which the payload functions were set
+	Revision uint64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Time when the payload functions were set (Unix nanoseconds)
+	Time            int64            `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	Decoder         string           `protobuf:"bytes,3,opt,name=decoder,proto3" json:"decoder,omitempty"`
+	Converter       string           `protobuf:"bytes,4,opt,name=converter,proto3" json:"converter,omitempty"`
+	Validator       string           `protobuf:"bytes,5,opt,name=validator,proto3" json:"validator,omitempty"`
+	Encoder         string           `protobuf:"bytes,6,opt,name=encoder,proto3" json:"encoder,omitempty"`
+	PortFunctions   []*PortFunctions `protobuf:"bytes,7,rep,name=port_functions,json=portFunctions" json:"port_functions,omitempty"`
+	DownlinkDecoder string           `protobuf:"bytes,8,opt,name=downlink_decoder,json=downlinkDecoder,proto3" json:"downlink_decoder,omitempty"`
+	PayloadFormat   string           `protobuf:"bytes,9,opt,name=payload_format,json=payloadFormat,proto3" json:"payload_format,omitempty"`
+	WasmModule      []byte           `protobuf:"bytes,10,opt,name=wasm_module,json=wasmModule,proto3" json:"wasm_module,omitempty"`
+	// The version of the payload functions if they were set in bulk
+	PayloadFunctionsVersion string `protobuf:"bytes,11,opt,name=payload_functions_version,json=payloadFunctionsVersion,proto3" json:"payload_functions_version,omitempty"`
+	// The ID of the codec in the codec library that the application used
+	Codec              string         `protobuf:"bytes,12,opt,name=codec,proto3" json:"codec,omitempty"`
+	BinaryFields       []*BinaryField `protobuf:"bytes,13,rep,name=binary_fields,json=binaryFields" json:"binary_fields,omitempty"`
+	FunctionsLanguage  string         `protobuf:"bytes,14,opt,name=functions_language,json=functionsLanguage,proto3" json:"functions_language,omitempty"`
+	ProtobufDescriptor []byte         `protobuf:"bytes,15,opt,name=protobuf_descriptor,json=protobufDescriptor,proto3" json:"protobuf_descriptor,omitempty"`
+	ProtobufMessage    string         `protobuf:"bytes,16,opt,name=protobuf_message,json=protobufMessage,proto3" json:"protobuf_message,omitempty"`
+	GoCodec            string         `protobuf:"bytes,17,opt,name=go_codec,json=goCodec,proto3" json:"go_codec,omitempty"`
+	Normalizer         string         `protobuf:"bytes,18,opt,name=normalizer,proto3" json:"normalizer,omitempty"`
+}
+
+func (m *PayloadFunctionsRevision) Reset()         { *m = PayloadFunctionsRevision{} }
+func (m *PayloadFunctionsRevision) String() string { return proto.CompactTextString(m) }
+func (*PayloadFunctionsRevision) ProtoMessage()    {}
+func (*PayloadFunctionsRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptorHandler, []int{50}
+}
+
+func (m *PayloadFunctionsRevision) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
 	}
-	l = len(m.Fields)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
+	return 0
+}
+
+func (m *PayloadFunctionsRevision) GetTime() int64 {
+	if m != nil {
+		return m.Time
 	}
-	if m.Valid {
-		n += 2
+	return 0
+}
+
+func (m *PayloadFunctionsRevision) GetDecoder() string {
+	if m != nil {
+		return m.Decoder
 	}
-	if len(m.Logs) > 0 {
-		for _, e := range m.Logs {
-			l = e.Size()
-			n += 1 + l + sovHandler(uint64(l))
+	return ""
+}
+
+func (m *PayloadFunctionsRevision) GetConverter() string {
+	if m != nil {
+		return m.Converter
+	}
+	return ""
+}
+
+func (m *PayloadFunctionsRevision) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *PayloadFunctionsRevision) GetEncoder() string {
+	if m != nil {
+		return m.Encoder
+	}
+	return ""
+}
+
+func (m *PayloadFunctionsRevision) GetPortFunctions() []*PortFunctions {
+	if m != nil {
+		return m.PortFunctions
+	}
+	return nil
+}
+
+func (m *PayloadFunctionsRevision) GetDownlinkDecoder() string {
+	if m != nil {
+		return m.DownlinkDecoder
+	}
+	return ""
+}
+
+func (m *PayloadFunctionsRevision) GetPayloadFormat() string {
+	if m != nil {
+		return m.PayloadFormat
+	}
+	return ""
+}
+
+func (m *PayloadFunctionsRevision) GetWasmModule() []byte {
+	if m != nil {
+		return m.WasmModule
+	}
+	return nil
+}
+
+func (m *PayloadFunctionsRevision) GetPayloadFunctionsVersion() string {
+	if m != nil {
+		return m.PayloadFunctionsVersion
+	}
+	return ""
+}
+
+func (m *PayloadFunctionsRevision) GetCodec() string {
+	if m != nil {
+		return m.Codec
+	}
+	return ""
+}
+
+func (m *PayloadFunctionsRevision) GetBinaryFields() []*BinaryField {
+	if m != nil {
+		return m.BinaryFields
+	}
+	return nil
+}
+
+func (m *PayloadFunctionsRevision) GetFunctionsLanguage() string {
+	if m != nil {
+		return m.FunctionsLanguage
+	}
+	return ""
+}
+
+func (m *PayloadFunctionsRevision) GetProtobufDescriptor() []byte {
+	if m != nil {
+		return m.ProtobufDescriptor
+	}
+	return nil
+}
+
+func (m *PayloadFunctionsRevision) GetProtobufMessage() string {
+	if m != nil {
+		return m.ProtobufMessage
+	}
+	return ""
+}
+
+func (m *PayloadFunctionsRevision) GetGoCodec() string {
+	if m != nil {
+		return m.GoCodec
+	}
+	return ""
+}
+
+func (m *PayloadFunctionsRevision) GetNormalizer() string {
+	if m != nil {
+		return m.Normalizer
+	}
+	return ""
+}
+
+// PayloadFunctionsRevisionList contains the stored revisions of the payload functions of an application
+type PayloadFunctionsRevisionList struct {
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// The revision of the active payload functions
+	ActiveRevision uint64 `protobuf:"varint,2,opt,name=active_revision,json=activeRevision,proto3" json:"active_revision,omitempty"`
+	// The stored revisions, newest first
+	Revisions []*PayloadFunctionsRevision `protobuf:"bytes,3,rep,name=revisions" json:"revisions,omitempty"`
+}
+
+func (m *PayloadFunctionsRevisionList) Reset()         { *m = PayloadFunctionsRevisionList{} }
+func (m *PayloadFunctionsRevisionList) String() string { return proto.CompactTextString(m) }
+func (*PayloadFunctionsRevisionList) ProtoMessage()    {}
+func (*PayloadFunctionsRevisionList) Descriptor() ([]byte, []int) {
+	return fileDescriptorHandler, []int{51}
+}
+
+func (m *PayloadFunctionsRevisionList) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
+}
+
+func (m *PayloadFunctionsRevisionList) GetActiveRevision() uint64 {
+	if m != nil {
+		return m.ActiveRevision
+	}
+	return 0
+}
+
+func (m *PayloadFunctionsRevisionList) GetRevisions() []*PayloadFunctionsRevision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
+// PayloadFunctionsRollbackRequest restores the payload functions of a stored revision
+type PayloadFunctionsRollbackRequest struct {
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// The revision to restore
+	Revision uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	DryRun   bool   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (m *PayloadFunctionsRollbackRequest) Reset()         { *m = PayloadFunctionsRollbackRequest{} }
+func (m *PayloadFunctionsRollbackRequest) String() string { return proto.CompactTextString(m) }
+func (*PayloadFunctionsRollbackRequest) ProtoMessage()    {}
+func (*PayloadFunctionsRollbackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorHandler, []int{52}
+}
+
+func (m *PayloadFunctionsRollbackRequest) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
+}
+
+func (m *PayloadFunctionsRollbackRequest) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *PayloadFunctionsRollbackRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+// Codec is a named set of payload functions in the codec library of the Handler, that multiple applications can use
+// instead of their own payload functions
+type Codec struct {
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Decoder     string `protobuf:"bytes,3,opt,name=decoder,proto3" json:"decoder,omitempty"`
+	Converter   string `protobuf:"bytes,4,opt,name=converter,proto3" json:"converter,omitempty"`
+	Validator   string `protobuf:"bytes,5,opt,name=validator,proto3" json:"validator,omitempty"`
+	Encoder     string `protobuf:"bytes,6,opt,name=encoder,proto3" json:"encoder,omitempty"`
+	// The version of the codec, for example the version of the firmware of the devices that it decodes
+	Version string `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+	// The revision of the codec, that is incremented on every update. This field is read-only.
+	Revision uint64 `protobuf:"varint,8,opt,name=revision,proto3" json:"revision,omitempty"`
+	// The applications that use the codec. This field is read-only.
+	AppIds []string `protobuf:"bytes,9,rep,name=app_ids,json=appIds,proto3" json:"app_ids,omitempty"`
+}
+
+func (m *Codec) Reset()                    { *m = Codec{} }
+func (m *Codec) String() string            { return proto.CompactTextString(m) }
+func (*Codec) ProtoMessage()               {}
+func (*Codec) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{53} }
+
+func (m *Codec) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Codec) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Codec) GetDecoder() string {
+	if m != nil {
+		return m.Decoder
+	}
+	return ""
+}
+
+func (m *Codec) GetConverter() string {
+	if m != nil {
+		return m.Converter
+	}
+	return ""
+}
+
+func (m *Codec) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *Codec) GetEncoder() string {
+	if m != nil {
+		return m.Encoder
+	}
+	return ""
+}
+
+func (m *Codec) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *Codec) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *Codec) GetAppIds() []string {
+	if m != nil {
+		return m.AppIds
+	}
+	return nil
+}
+
+type CodecIdentifier struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *CodecIdentifier) Reset()                    { *m = CodecIdentifier{} }
+func (m *CodecIdentifier) String() string            { return proto.CompactTextString(m) }
+func (*CodecIdentifier) ProtoMessage()               {}
+func (*CodecIdentifier) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{54} }
+
+func (m *CodecIdentifier) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type CodecList struct {
+	Codecs []*Codec `protobuf:"bytes,1,rep,name=codecs" json:"codecs,omitempty"`
+}
+
+func (m *CodecList) Reset()                    { *m = CodecList{} }
+func (m *CodecList) String() string            { return proto.CompactTextString(m) }
+func (*CodecList) ProtoMessage()               {}
+func (*CodecList) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{55} }
+
+func (m *CodecList) GetCodecs() []*Codec {
+	if m != nil {
+		return m.Codecs
+	}
+	return nil
+}
+
+// ReplayArchiveRequest is used to replay the uplink messages in the archive of the Handler
+type ReplayArchiveRequest struct {
+	// Replay messages that were received at or after this time in Unix nanoseconds
+	Since int64 `protobuf:"varint,1,opt,name=since,proto3" json:"since,omitempty"`
+	// Only replay messages that were received before this time in Unix nanoseconds (optional, default now)
+	Until int64 `protobuf:"varint,2,opt,name=until,proto3" json:"until,omitempty"`
+	// Only replay messages of these applications (optional)
+	AppIds []string `protobuf:"bytes,3,rep,name=app_ids,json=appIds,proto3" json:"app_ids,omitempty"`
+}
+
+func (m *ReplayArchiveRequest) Reset()                    { *m = ReplayArchiveRequest{} }
+func (m *ReplayArchiveRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReplayArchiveRequest) ProtoMessage()               {}
+func (*ReplayArchiveRequest) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{56} }
+
+func (m *ReplayArchiveRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+func (m *ReplayArchiveRequest) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+func (m *ReplayArchiveRequest) GetAppIds() []string {
+	if m != nil {
+		return m.AppIds
+	}
+	return nil
+}
+
+type ReplayArchiveResult struct {
+	// The number of archive files that were read
+	NumFiles uint32 `protobuf:"varint,1,opt,name=num_files,json=numFiles,proto3" json:"num_files,omitempty"`
+	// The number of uplink messages in the time range
+	NumUplinks uint32 `protobuf:"varint,2,opt,name=num_uplinks,json=numUplinks,proto3" json:"num_uplinks,omitempty"`
+	// The number of uplink messages that were replayed
+	NumReplayed uint32 `protobuf:"varint,3,opt,name=num_replayed,json=numReplayed,proto3" json:"num_replayed,omitempty"`
+}
+
+func (m *ReplayArchiveResult) Reset()                    { *m = ReplayArchiveResult{} }
+func (m *ReplayArchiveResult) String() string            { return proto.CompactTextString(m) }
+func (*ReplayArchiveResult) ProtoMessage()               {}
+func (*ReplayArchiveResult) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{57} }
+
+func (m *ReplayArchiveResult) GetNumFiles() uint32 {
+	if m != nil {
+		return m.NumFiles
+	}
+	return 0
+}
+
+func (m *ReplayArchiveResult) GetNumUplinks() uint32 {
+	if m != nil {
+		return m.NumUplinks
+	}
+	return 0
+}
+
+func (m *ReplayArchiveResult) GetNumReplayed() uint32 {
+	if m != nil {
+		return m.NumReplayed
+	}
+	return 0
+}
+
+// BinaryField is a field at a fixed position in the payload, that the Handler decodes and encodes if the payload
+// format of the application is binary
+type BinaryField struct {
+	// The name of the payload field
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The position of the first byte of the field in the payload
+	Offset uint32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The type of the field: uint8, int8, uint16, int16, uint24, int24, uint32, int32, float32, float64 or bool
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// Read and write the field as little-endian (default big-endian)
+	LittleEndian bool `protobuf:"varint,4,opt,name=little_endian,json=littleEndian,proto3" json:"little_endian,omitempty"`
+	// The factor by which the stored number is multiplied to get the value of the field (optional, for example 0.01)
+	Scale float64 `protobuf:"fixed64,5,opt,name=scale,proto3" json:"scale,omitempty"`
+}
+
+func (m *BinaryField) Reset()                    { *m = BinaryField{} }
+func (m *BinaryField) String() string            { return proto.CompactTextString(m) }
+func (*BinaryField) ProtoMessage()               {}
+func (*BinaryField) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{58} }
+
+func (m *BinaryField) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BinaryField) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *BinaryField) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *BinaryField) GetLittleEndian() bool {
+	if m != nil {
+		return m.LittleEndian
+	}
+	return false
+}
+
+func (m *BinaryField) GetScale() float64 {
+	if m != nil {
+		return m.Scale
+	}
+	return 0
+}
+
+// InjectedUplinkMessage is a synthetic uplink message with decoded fields, that is published to the integrations of
+// the application without being decoded. The metadata of the message is flagged as simulated.
+type InjectedUplinkMessage struct {
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DevId string `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// The port number
+	Port uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// JSON-encoded object with the decoded fields
+	Fields string `protobuf:"bytes,4,opt,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (m *InjectedUplinkMessage) Reset()                    { *m = InjectedUplinkMessage{} }
+func (m *InjectedUplinkMessage) String() string            { return proto.CompactTextString(m) }
+func (*InjectedUplinkMessage) ProtoMessage()               {}
+func (*InjectedUplinkMessage) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{59} }
+
+func (m *InjectedUplinkMessage) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
+}
+
+func (m *InjectedUplinkMessage) GetDevId() string {
+	if m != nil {
+		return m.DevId
+	}
+	return ""
+}
+
+func (m *InjectedUplinkMessage) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *InjectedUplinkMessage) GetFields() string {
+	if m != nil {
+		return m.Fields
+	}
+	return ""
+}
+
+// RawUplink is the raw payload of a historical uplink message
+type RawUplink struct {
+	// The port number
+	Port uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	// The binary payload
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Time when the message was received (Unix nanoseconds)
+	Time int64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (m *RawUplink) Reset()                    { *m = RawUplink{} }
+func (m *RawUplink) String() string            { return proto.CompactTextString(m) }
+func (*RawUplink) ProtoMessage()               {}
+func (*RawUplink) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{60} }
+
+func (m *RawUplink) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *RawUplink) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *RawUplink) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// RedecodeUplinksRequest is used to decode a batch of historical uplink messages with the current payload functions
+type RedecodeUplinksRequest struct {
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// The device that sent the messages (optional). If set, the payload format of the device is used.
+	DevId string `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// The messages to decode
+	Uplinks []*RawUplink `protobuf:"bytes,3,rep,name=uplinks" json:"uplinks,omitempty"`
+}
+
+func (m *RedecodeUplinksRequest) Reset()                    { *m = RedecodeUplinksRequest{} }
+func (m *RedecodeUplinksRequest) String() string            { return proto.CompactTextString(m) }
+func (*RedecodeUplinksRequest) ProtoMessage()               {}
+func (*RedecodeUplinksRequest) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{61} }
+
+func (m *RedecodeUplinksRequest) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
+}
+
+func (m *RedecodeUplinksRequest) GetDevId() string {
+	if m != nil {
+		return m.DevId
+	}
+	return ""
+}
+
+func (m *RedecodeUplinksRequest) GetUplinks() []*RawUplink {
+	if m != nil {
+		return m.Uplinks
+	}
+	return nil
+}
+
+// RedecodedUplink is the result of decoding a historical uplink message
+type RedecodedUplink struct {
+	// The port number
+	Port uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	// Time when the message was received (Unix nanoseconds)
+	Time int64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	// JSON-encoded object with the decoded fields
+	Fields string `protobuf:"bytes,3,opt,name=fields,proto3" json:"fields,omitempty"`
+	// Was validation of the message successful
+	Valid bool `protobuf:"varint,4,opt,name=valid,proto3" json:"valid,omitempty"`
+	// The error of the payload functions, if processing failed
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *RedecodedUplink) Reset()                    { *m = RedecodedUplink{} }
+func (m *RedecodedUplink) String() string            { return proto.CompactTextString(m) }
+func (*RedecodedUplink) ProtoMessage()               {}
+func (*RedecodedUplink) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{62} }
+
+func (m *RedecodedUplink) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *RedecodedUplink) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *RedecodedUplink) GetFields() string {
+	if m != nil {
+		return m.Fields
+	}
+	return ""
+}
+
+func (m *RedecodedUplink) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *RedecodedUplink) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// RedecodeUplinksResult contains the decoded messages, in the order of the request
+type RedecodeUplinksResult struct {
+	Uplinks []*RedecodedUplink `protobuf:"bytes,1,rep,name=uplinks" json:"uplinks,omitempty"`
+}
+
+func (m *RedecodeUplinksResult) Reset()                    { *m = RedecodeUplinksResult{} }
+func (m *RedecodeUplinksResult) String() string            { return proto.CompactTextString(m) }
+func (*RedecodeUplinksResult) ProtoMessage()               {}
+func (*RedecodeUplinksResult) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{63} }
+
+func (m *RedecodeUplinksResult) GetUplinks() []*RedecodedUplink {
+	if m != nil {
+		return m.Uplinks
+	}
+	return nil
+}
+
+// ExportApplicationRequest is used to export an application for a transfer to another Handler
+type ExportApplicationRequest struct {
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// Stop processing the traffic of the application on this Handler, so that the Handler that imports the export takes over
+	Cutover bool `protobuf:"varint,2,opt,name=cutover,proto3" json:"cutover,omitempty"`
+}
+
+func (m *ExportApplicationRequest) Reset()         { *m = ExportApplicationRequest{} }
+func (m *ExportApplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportApplicationRequest) ProtoMessage()    {}
+func (*ExportApplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorHandler, []int{64}
+}
+
+func (m *ExportApplicationRequest) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
+}
+
+func (m *ExportApplicationRequest) GetCutover() bool {
+	if m != nil {
+		return m.Cutover
+	}
+	return false
+}
+
+// DeviceTransfer contains the state of a device that is transferred to another Handler
+type DeviceTransfer struct {
+	DevId string `protobuf:"bytes,1,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// JSON-encoded state of the device, including its session
+	Device []byte `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	// JSON-encoded array of the downlink messages in the queue of the device
+	DownlinkQueue []byte `protobuf:"bytes,3,opt,name=downlink_queue,json=downlinkQueue,proto3" json:"downlink_queue,omitempty"`
+}
+
+func (m *DeviceTransfer) Reset()                    { *m = DeviceTransfer{} }
+func (m *DeviceTransfer) String() string            { return proto.CompactTextString(m) }
+func (*DeviceTransfer) ProtoMessage()               {}
+func (*DeviceTransfer) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{65} }
+
+func (m *DeviceTransfer) GetDevId() string {
+	if m != nil {
+		return m.DevId
+	}
+	return ""
+}
+
+func (m *DeviceTransfer) GetDevice() []byte {
+	if m != nil {
+		return m.Device
+	}
+	return nil
+}
+
+func (m *DeviceTransfer) GetDownlinkQueue() []byte {
+	if m != nil {
+		return m.DownlinkQueue
+	}
+	return nil
+}
+
+// ApplicationTransfer contains the state of an application that is transferred to another Handler
+type ApplicationTransfer struct {
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// JSON-encoded settings and payload functions of the application
+	Application []byte            `protobuf:"bytes,2,opt,name=application,proto3" json:"application,omitempty"`
+	Devices     []*DeviceTransfer `protobuf:"bytes,3,rep,name=devices" json:"devices,omitempty"`
+	// Time of the last uplink message that was processed by the exporting Handler before the cutover (Unix nanoseconds)
+	Cutover int64 `protobuf:"varint,4,opt,name=cutover,proto3" json:"cutover,omitempty"`
+	// Start processing the traffic of the application on the importing Handler
+	Activate bool `protobuf:"varint,5,opt,name=activate,proto3" json:"activate,omitempty"`
+}
+
+func (m *ApplicationTransfer) Reset()                    { *m = ApplicationTransfer{} }
+func (m *ApplicationTransfer) String() string            { return proto.CompactTextString(m) }
+func (*ApplicationTransfer) ProtoMessage()               {}
+func (*ApplicationTransfer) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{66} }
+
+func (m *ApplicationTransfer) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
+}
+
+func (m *ApplicationTransfer) GetApplication() []byte {
+	if m != nil {
+		return m.Application
+	}
+	return nil
+}
+
+func (m *ApplicationTransfer) GetDevices() []*DeviceTransfer {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+func (m *ApplicationTransfer) GetCutover() int64 {
+	if m != nil {
+		return m.Cutover
+	}
+	return 0
+}
+
+func (m *ApplicationTransfer) GetActivate() bool {
+	if m != nil {
+		return m.Activate
+	}
+	return false
+}
+
+// Webhook POSTs the messages of an application as JSON to a URL
+type Webhook struct {
+	AppId     string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	WebhookId string `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	// The URL that the messages are POSTed to (http or https)
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// Headers that are added to the requests (for example for authentication). Values can reference a secret of the
+	// Handler as secret:<name>. The values are returned as <redacted>, except for references to secrets; setting a header
+	// to <redacted> keeps its value.
+	Headers map[string]string `protobuf:"bytes,4,rep,name=headers" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The types of messages that are POSTed: uplink, activation and downlink (the downlink events). Empty for all types.
+	MessageTypes []string `protobuf:"bytes,5,rep,name=message_types,json=messageTypes,proto3" json:"message_types,omitempty"`
+}
+
+func (m *Webhook) Reset()                    { *m = Webhook{} }
+func (m *Webhook) String() string            { return proto.CompactTextString(m) }
+func (*Webhook) ProtoMessage()               {}
+func (*Webhook) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{67} }
+
+func (m *Webhook) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
+}
+
+func (m *Webhook) GetWebhookId() string {
+	if m != nil {
+		return m.WebhookId
+	}
+	return ""
+}
+
+func (m *Webhook) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Webhook) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *Webhook) GetMessageTypes() []string {
+	if m != nil {
+		return m.MessageTypes
+	}
+	return nil
+}
+
+type WebhookIdentifier struct {
+	AppId     string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	WebhookId string `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+}
+
+func (m *WebhookIdentifier) Reset()                    { *m = WebhookIdentifier{} }
+func (m *WebhookIdentifier) String() string            { return proto.CompactTextString(m) }
+func (*WebhookIdentifier) ProtoMessage()               {}
+func (*WebhookIdentifier) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{68} }
+
+func (m *WebhookIdentifier) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
+}
+
+func (m *WebhookIdentifier) GetWebhookId() string {
+	if m != nil {
+		return m.WebhookId
+	}
+	return ""
+}
+
+type WebhookList struct {
+	Webhooks []*Webhook `protobuf:"bytes,1,rep,name=webhooks" json:"webhooks,omitempty"`
+}
+
+func (m *WebhookList) Reset()                    { *m = WebhookList{} }
+func (m *WebhookList) String() string            { return proto.CompactTextString(m) }
+func (*WebhookList) ProtoMessage()               {}
+func (*WebhookList) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{69} }
+
+func (m *WebhookList) GetWebhooks() []*Webhook {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
+}
+
+// DeletedDevice is a device in the recycle bin of the Handler
+type DeletedDevice struct {
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DevId string `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// Time when the device was deleted (Unix nanoseconds)
+	DeletedAt int64 `protobuf:"varint,3,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Time when the device will be purged from the recycle bin (Unix nanoseconds)
+	PurgeAt int64 `protobuf:"varint,4,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+}
+
+func (m *DeletedDevice) Reset()                    { *m = DeletedDevice{} }
+func (m *DeletedDevice) String() string            { return proto.CompactTextString(m) }
+func (*DeletedDevice) ProtoMessage()               {}
+func (*DeletedDevice) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{70} }
+
+func (m *DeletedDevice) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
+}
+
+func (m *DeletedDevice) GetDevId() string {
+	if m != nil {
+		return m.DevId
+	}
+	return ""
+}
+
+func (m *DeletedDevice) GetDeletedAt() int64 {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return 0
+}
+
+func (m *DeletedDevice) GetPurgeAt() int64 {
+	if m != nil {
+		return m.PurgeAt
+	}
+	return 0
+}
+
+type DeletedDeviceList struct {
+	Devices []*DeletedDevice `protobuf:"bytes,1,rep,name=devices" json:"devices,omitempty"`
+}
+
+func (m *DeletedDeviceList) Reset()                    { *m = DeletedDeviceList{} }
+func (m *DeletedDeviceList) String() string            { return proto.CompactTextString(m) }
+func (*DeletedDeviceList) ProtoMessage()               {}
+func (*DeletedDeviceList) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{71} }
+
+func (m *DeletedDeviceList) GetDevices() []*DeletedDevice {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DeviceActivationResponse)(nil), "handler.DeviceActivationResponse")
+	proto.RegisterType((*StatusRequest)(nil), "handler.StatusRequest")
+	proto.RegisterType((*Status)(nil), "handler.Status")
+	proto.RegisterType((*ApplicationIdentifier)(nil), "handler.ApplicationIdentifier")
+	proto.RegisterType((*Application)(nil), "handler.Application")
+	proto.RegisterType((*DeviceIdentifier)(nil), "handler.DeviceIdentifier")
+	proto.RegisterType((*Device)(nil), "handler.Device")
+	proto.RegisterType((*DeviceList)(nil), "handler.DeviceList")
+	proto.RegisterType((*DryDownlinkMessage)(nil), "handler.DryDownlinkMessage")
+	proto.RegisterType((*DryUplinkMessage)(nil), "handler.DryUplinkMessage")
+	proto.RegisterType((*SimulatedUplinkMessage)(nil), "handler.SimulatedUplinkMessage")
+	proto.RegisterType((*LogEntry)(nil), "handler.LogEntry")
+	proto.RegisterType((*DryUplinkResult)(nil), "handler.DryUplinkResult")
+	proto.RegisterType((*DryDownlinkResult)(nil), "handler.DryDownlinkResult")
+	proto.RegisterType((*DeviceUplink)(nil), "handler.DeviceUplink")
+	proto.RegisterType((*DeviceUplinkList)(nil), "handler.DeviceUplinkList")
+	proto.RegisterType((*ReplayUplinksRequest)(nil), "handler.ReplayUplinksRequest")
+	proto.RegisterType((*DownlinkPreviewRequest)(nil), "handler.DownlinkPreviewRequest")
+	proto.RegisterType((*DownlinkPreview)(nil), "handler.DownlinkPreview")
+	proto.RegisterType((*ProvisioningDownlink)(nil), "handler.ProvisioningDownlink")
+	proto.RegisterType((*BulkPayloadFunctionsRequest)(nil), "handler.BulkPayloadFunctionsRequest")
+	proto.RegisterType((*BulkPayloadFunctionsResult)(nil), "handler.BulkPayloadFunctionsResult")
+	proto.RegisterType((*BulkPayloadFunctionsResponse)(nil), "handler.BulkPayloadFunctionsResponse")
+	proto.RegisterType((*AnomalyDetection)(nil), "handler.AnomalyDetection")
+	proto.RegisterType((*ComputedField)(nil), "handler.ComputedField")
+	proto.RegisterType((*OutputPolicy)(nil), "handler.OutputPolicy")
+	proto.RegisterType((*Aggregation)(nil), "handler.Aggregation")
+	proto.RegisterType((*MQTTCredentials)(nil), "handler.MQTTCredentials")
+	proto.RegisterType((*MQTTCredentialsList)(nil), "handler.MQTTCredentialsList")
+	proto.RegisterType((*ErasureReport)(nil), "handler.ErasureReport")
+	proto.RegisterType((*MICCheckRequest)(nil), "handler.MICCheckRequest")
+	proto.RegisterType((*MICCheckResult)(nil), "handler.MICCheckResult")
+	proto.RegisterType((*MutationResult)(nil), "handler.MutationResult")
+	proto.RegisterType((*WatchDevicesRequest)(nil), "handler.WatchDevicesRequest")
+	proto.RegisterType((*DeviceChange)(nil), "handler.DeviceChange")
+	proto.RegisterType((*DeviceDebugModeRequest)(nil), "handler.DeviceDebugModeRequest")
+	proto.RegisterType((*DebugTraceEntry)(nil), "handler.DebugTraceEntry")
+	proto.RegisterType((*DeviceDebugTrace)(nil), "handler.DeviceDebugTrace")
+	proto.RegisterType((*DeviceStateRequest)(nil), "handler.DeviceStateRequest")
+	proto.RegisterType((*DeviceState)(nil), "handler.DeviceState")
+	proto.RegisterType((*DeviceTwinRequest)(nil), "handler.DeviceTwinRequest")
+	proto.RegisterType((*DeviceTwin)(nil), "handler.DeviceTwin")
+	proto.RegisterType((*CommandRequest)(nil), "handler.CommandRequest")
+	proto.RegisterType((*CommandResponse)(nil), "handler.CommandResponse")
+	proto.RegisterType((*PortFunctions)(nil), "handler.PortFunctions")
+	proto.RegisterType((*TestUplinkRequest)(nil), "handler.TestUplinkRequest")
+	proto.RegisterType((*DeviceProfile)(nil), "handler.DeviceProfile")
+	proto.RegisterType((*ComplianceGroup)(nil), "handler.ComplianceGroup")
+	proto.RegisterType((*ComplianceReport)(nil), "handler.ComplianceReport")
+	proto.RegisterType((*DownlinkDelivery)(nil), "handler.DownlinkDelivery")
+	proto.RegisterType((*PayloadFunctionsRevision)(nil), "handler.PayloadFunctionsRevision")
+	proto.RegisterType((*PayloadFunctionsRevisionList)(nil), "handler.PayloadFunctionsRevisionList")
+	proto.RegisterType((*PayloadFunctionsRollbackRequest)(nil), "handler.PayloadFunctionsRollbackRequest")
+	proto.RegisterType((*Codec)(nil), "handler.Codec")
+	proto.RegisterType((*CodecIdentifier)(nil), "handler.CodecIdentifier")
+	proto.RegisterType((*CodecList)(nil), "handler.CodecList")
+	proto.RegisterType((*ReplayArchiveRequest)(nil), "handler.ReplayArchiveRequest")
+	proto.RegisterType((*ReplayArchiveResult)(nil), "handler.ReplayArchiveResult")
+	proto.RegisterType((*BinaryField)(nil), "handler.BinaryField")
+	proto.RegisterType((*InjectedUplinkMessage)(nil), "handler.InjectedUplinkMessage")
+	proto.RegisterType((*RawUplink)(nil), "handler.RawUplink")
+	proto.RegisterType((*RedecodeUplinksRequest)(nil), "handler.RedecodeUplinksRequest")
+	proto.RegisterType((*RedecodedUplink)(nil), "handler.RedecodedUplink")
+	proto.RegisterType((*RedecodeUplinksResult)(nil), "handler.RedecodeUplinksResult")
+	proto.RegisterType((*ExportApplicationRequest)(nil), "handler.ExportApplicationRequest")
+	proto.RegisterType((*DeviceTransfer)(nil), "handler.DeviceTransfer")
+	proto.RegisterType((*ApplicationTransfer)(nil), "handler.ApplicationTransfer")
+	proto.RegisterType((*Webhook)(nil), "handler.Webhook")
+	proto.RegisterType((*WebhookIdentifier)(nil), "handler.WebhookIdentifier")
+	proto.RegisterType((*WebhookList)(nil), "handler.WebhookList")
+	proto.RegisterType((*DeletedDevice)(nil), "handler.DeletedDevice")
+	proto.RegisterType((*DeletedDeviceList)(nil), "handler.DeletedDeviceList")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Handler service
+
+type HandlerClient interface {
+	ActivationChallenge(ctx context.Context, in *broker.ActivationChallengeRequest, opts ...grpc.CallOption) (*broker.ActivationChallengeResponse, error)
+	Activate(ctx context.Context, in *broker.DeduplicatedDeviceActivationRequest, opts ...grpc.CallOption) (*DeviceActivationResponse, error)
+}
+
+type handlerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewHandlerClient(cc *grpc.ClientConn) HandlerClient {
+	return &handlerClient{cc}
+}
+
+func (c *handlerClient) ActivationChallenge(ctx context.Context, in *broker.ActivationChallengeRequest, opts ...grpc.CallOption) (*broker.ActivationChallengeResponse, error) {
+	out := new(broker.ActivationChallengeResponse)
+	err := grpc.Invoke(ctx, "/handler.Handler/ActivationChallenge", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *handlerClient) Activate(ctx context.Context, in *broker.DeduplicatedDeviceActivationRequest, opts ...grpc.CallOption) (*DeviceActivationResponse, error) {
+	out := new(DeviceActivationResponse)
+	err := grpc.Invoke(ctx, "/handler.Handler/Activate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Handler service
+
+type HandlerServer interface {
+	ActivationChallenge(context.Context, *broker.ActivationChallengeRequest) (*broker.ActivationChallengeResponse, error)
+	Activate(context.Context, *broker.DeduplicatedDeviceActivationRequest) (*DeviceActivationResponse, error)
+}
+
+func RegisterHandlerServer(s *grpc.Server, srv HandlerServer) {
+	s.RegisterService(&_Handler_serviceDesc, srv)
+}
+
+func _Handler_ActivationChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(broker.ActivationChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HandlerServer).ActivationChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.Handler/ActivationChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HandlerServer).ActivationChallenge(ctx, req.(*broker.ActivationChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Handler_Activate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(broker.DeduplicatedDeviceActivationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HandlerServer).Activate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.Handler/Activate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HandlerServer).Activate(ctx, req.(*broker.DeduplicatedDeviceActivationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Handler_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "handler.Handler",
+	HandlerType: (*HandlerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ActivationChallenge",
+			Handler:    _Handler_ActivationChallenge_Handler,
+		},
+		{
+			MethodName: "Activate",
+			Handler:    _Handler_Activate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/TheThingsNetwork/ttn/api/handler/handler.proto",
+}
+
+// Client API for ApplicationManager service
+
+type ApplicationManagerClient interface {
+	// Applications should first be registered to the Handler with the `RegisterApplication` method
+	RegisterApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetApplication returns the application with the given identifier (app_id)
+	GetApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*Application, error)
+	// SetApplication updates the settings for the application. All fields must be supplied, unless update_mask is set. If
+	// dry_run is set, the request is validated and the changes are returned without persisting them.
+	SetApplication(ctx context.Context, in *Application, opts ...grpc.CallOption) (*MutationResult, error)
+	// DeleteApplication deletes the application with the given identifier (app_id)
+	// If the Handler has a recycle bin, the application and its devices can be restored until they are purged.
+	DeleteApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetDevice returns the device with the given identifier (app_id and dev_id)
+	GetDevice(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*Device, error)
+	// SetDevice creates or updates a device. All fields must be supplied, unless update_mask is set to update an existing
+	// device. If dry_run is set, the request is validated and the changes are returned without persisting them.
+	SetDevice(ctx context.Context, in *Device, opts ...grpc.CallOption) (*MutationResult, error)
+	// DeleteDevice deletes the device with the given identifier (app_id and dev_id)
+	// If the Handler has a recycle bin, the device can be restored until it is purged.
+	DeleteDevice(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// ForceRejoin invalidates the session of the OTAA device with the given identifier (app_id and dev_id).
+	// The device will have to join again before it can send or receive messages.
+	ForceRejoin(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetDevicesForApplication returns all devices that belong to the application with the given identifier (app_id).
+	// The devices can be paged with the limit and cursor metadata (the cursor of the next page is returned in the
+	// next-cursor header), sorted with the sort metadata (dev_id, created_at or last_seen, with a - prefix for descending
+	// order) and filtered with filter metadata (app_eui, dev_eui or dev_addr, as field=value).
+	GetDevicesForApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*DeviceList, error)
+	// DryUplink simulates processing a downlink message and returns the result
+	DryDownlink(ctx context.Context, in *DryDownlinkMessage, opts ...grpc.CallOption) (*DryDownlinkResult, error)
+	// DryUplink simulates processing an uplink message and returns the result
+	DryUplink(ctx context.Context, in *DryUplinkMessage, opts ...grpc.CallOption) (*DryUplinkResult, error)
+	// SimulateUplink simulates an uplink message
+	SimulateUplink(ctx context.Context, in *SimulatedUplinkMessage, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetDeviceUplinks returns the last uplink messages of the device with the given identifier (app_id and dev_id),
+	// including the metadata of all gateways that received them
+	GetDeviceUplinks(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*DeviceUplinkList, error)
+	// ReplayUplinks processes the stored uplink messages of the device with the given identifier (app_id and dev_id)
+	// again with the current payload functions, and publishes them to the application, marked as replay
+	ReplayUplinks(ctx context.Context, in *ReplayUplinksRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// PreviewDownlink estimates the time on air of a downlink message to the device with the given identifier (app_id and dev_id),
+	// the gateway that would transmit it, and whether the duty cycle allows it to be transmitted now
+	PreviewDownlink(ctx context.Context, in *DownlinkPreviewRequest, opts ...grpc.CallOption) (*DownlinkPreview, error)
+	// SyncMQTTCredentials generates MQTT credentials for the collaborators of the application, with access to the topics
+	// that their rights allow. Credentials of collaborators that were removed are deleted.
+	SyncMQTTCredentials(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*MQTTCredentialsList, error)
+	// ForgetDevice erases all stored payloads and payload fields of the device with the given identifier (app_id and
+	// dev_id), and returns a report of the erased data. The registration of the device is not deleted.
+	ForgetDevice(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*ErasureReport, error)
+	// CheckMIC recomputes the MIC of a raw LoRaWAN frame with the stored keys of the device, and reports which key or
+	// frame counter caused a mismatch
+	CheckMIC(ctx context.Context, in *MICCheckRequest, opts ...grpc.CallOption) (*MICCheckResult, error)
+	// WatchDevices streams the changes in the device registry of the application with the given identifier (app_id),
+	// starting after the given resume token. This allows mirroring the registry without periodically listing all devices
+	WatchDevices(ctx context.Context, in *WatchDevicesRequest, opts ...grpc.CallOption) (ApplicationManager_WatchDevicesClient, error)
+	// SetDeviceDebugMode enables the debug mode of the device with the given identifier (app_id and dev_id) for the
+	// given duration, or disables it. While the debug mode is enabled, verbose traces of the device are captured.
+	SetDeviceDebugMode(ctx context.Context, in *DeviceDebugModeRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetDeviceDebugTrace returns the verbose traces that were captured while the device with the given identifier
+	// (app_id and dev_id) was in debug mode
+	GetDeviceDebugTrace(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*DeviceDebugTrace, error)
+	// GetDeviceState reconstructs the state of the device with the given identifier (app_id and dev_id) at the given
+	// point in time from the history of changes to its settings, session and uplink frame counter
+	GetDeviceState(ctx context.Context, in *DeviceStateRequest, opts ...grpc.CallOption) (*DeviceState, error)
+	// SetDeviceTwin sets the desired fields in the twin of the device with the given identifier (app_id and dev_id).
+	// The Handler sends the desired fields to the device until the device reports them in its uplink messages.
+	SetDeviceTwin(ctx context.Context, in *DeviceTwinRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetDeviceTwin returns the desired and reported fields in the twin of the device with the given identifier
+	// (app_id and dev_id)
+	GetDeviceTwin(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*DeviceTwin, error)
+	// SendCommand sends a downlink message to the device with the given identifier (app_id and dev_id) and waits for
+	// the uplink message that the device sends in response
+	SendCommand(ctx context.Context, in *CommandRequest, opts ...grpc.CallOption) (*CommandResponse, error)
+	// TestUplink runs the stored decoder, converter and validator of the application with the given identifier (app_id)
+	// on the payload, and returns the result and the logs. Nothing is published.
+	TestUplink(ctx context.Context, in *TestUplinkRequest, opts ...grpc.CallOption) (*DryUplinkResult, error)
+	// GetComplianceReport summarizes the devices of the application with the given identifier (app_id) by LoRaWAN
+	// version, Regional Parameters revision and frequency plan.
+	GetComplianceReport(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*ComplianceReport, error)
+	// ListPayloadFunctionsRevisions returns the stored revisions of the payload functions of the application with the
+	// given identifier (app_id), newest first
+	ListPayloadFunctionsRevisions(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*PayloadFunctionsRevisionList, error)
+	// RollbackPayloadFunctions restores the payload functions of a stored revision in the application with the given
+	// identifier (app_id). The restored payload functions are stored as a new revision.
+	RollbackPayloadFunctions(ctx context.Context, in *PayloadFunctionsRollbackRequest, opts ...grpc.CallOption) (*MutationResult, error)
+	// RedecodeUplinks runs the current decoder, converter and validator of the application with the given identifier
+	// (app_id) on a batch of historical uplink messages, and returns the decoded fields. Nothing is stored or published.
+	RedecodeUplinks(ctx context.Context, in *RedecodeUplinksRequest, opts ...grpc.CallOption) (*RedecodeUplinksResult, error)
+	// ExportApplication exports the application with the given identifier (app_id), including its devices, sessions and
+	// downlink queues, so that it can be imported by another Handler. With cutover, this Handler stops processing the
+	// traffic of the application.
+	ExportApplication(ctx context.Context, in *ExportApplicationRequest, opts ...grpc.CallOption) (*ApplicationTransfer, error)
+	// ImportApplication imports an application that was exported by another Handler. The traffic of the application is
+	// not processed until the application is imported with activate.
+	ImportApplication(ctx context.Context, in *ApplicationTransfer, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// FinishApplicationTransfer removes the application with the given identifier (app_id) from this Handler after a
+	// cutover, without deleting its devices from the network.
+	FinishApplicationTransfer(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetWebhooks returns the webhooks of the application with the given identifier (app_id)
+	GetWebhooks(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*WebhookList, error)
+	// SetWebhook creates or updates the webhook with the given identifier (app_id and webhook_id)
+	SetWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// DeleteWebhook deletes the webhook with the given identifier (app_id and webhook_id)
+	DeleteWebhook(ctx context.Context, in *WebhookIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetDeletedDevices returns the devices in the recycle bin of the application with the given identifier (app_id)
+	GetDeletedDevices(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*DeletedDeviceList, error)
+	// RestoreDevice restores the deleted device with the given identifier (app_id and dev_id) from the recycle bin
+	RestoreDevice(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// RestoreApplication restores the deleted application with the given identifier (app_id) from the recycle bin,
+	// together with the devices that were deleted with it
+	RestoreApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+}
+
+type applicationManagerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApplicationManagerClient(cc *grpc.ClientConn) ApplicationManagerClient {
+	return &applicationManagerClient{cc}
+}
+
+func (c *applicationManagerClient) RegisterApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/RegisterApplication", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*Application, error) {
+	out := new(Application)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/GetApplication", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) SetApplication(ctx context.Context, in *Application, opts ...grpc.CallOption) (*MutationResult, error) {
+	out := new(MutationResult)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/SetApplication", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) DeleteApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/DeleteApplication", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetDevice(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*Device, error) {
+	out := new(Device)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/GetDevice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) SetDevice(ctx context.Context, in *Device, opts ...grpc.CallOption) (*MutationResult, error) {
+	out := new(MutationResult)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/SetDevice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) DeleteDevice(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/DeleteDevice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) ForceRejoin(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/ForceRejoin", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetDevicesForApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*DeviceList, error) {
+	out := new(DeviceList)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/GetDevicesForApplication", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) DryDownlink(ctx context.Context, in *DryDownlinkMessage, opts ...grpc.CallOption) (*DryDownlinkResult, error) {
+	out := new(DryDownlinkResult)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/DryDownlink", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) DryUplink(ctx context.Context, in *DryUplinkMessage, opts ...grpc.CallOption) (*DryUplinkResult, error) {
+	out := new(DryUplinkResult)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/DryUplink", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) SimulateUplink(ctx context.Context, in *SimulatedUplinkMessage, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/SimulateUplink", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetDeviceUplinks(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*DeviceUplinkList, error) {
+	out := new(DeviceUplinkList)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/GetDeviceUplinks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) ReplayUplinks(ctx context.Context, in *ReplayUplinksRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/ReplayUplinks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) PreviewDownlink(ctx context.Context, in *DownlinkPreviewRequest, opts ...grpc.CallOption) (*DownlinkPreview, error) {
+	out := new(DownlinkPreview)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/PreviewDownlink", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) SyncMQTTCredentials(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*MQTTCredentialsList, error) {
+	out := new(MQTTCredentialsList)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/SyncMQTTCredentials", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) ForgetDevice(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*ErasureReport, error) {
+	out := new(ErasureReport)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/ForgetDevice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) CheckMIC(ctx context.Context, in *MICCheckRequest, opts ...grpc.CallOption) (*MICCheckResult, error) {
+	out := new(MICCheckResult)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/CheckMIC", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) WatchDevices(ctx context.Context, in *WatchDevicesRequest, opts ...grpc.CallOption) (ApplicationManager_WatchDevicesClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ApplicationManager_serviceDesc.Streams[0], c.cc, "/handler.ApplicationManager/WatchDevices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &applicationManagerWatchDevicesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApplicationManager_WatchDevicesClient interface {
+	Recv() (*DeviceChange, error)
+	grpc.ClientStream
+}
+
+type applicationManagerWatchDevicesClient struct {
+	grpc.ClientStream
+}
+
+func (x *applicationManagerWatchDevicesClient) Recv() (*DeviceChange, error) {
+	m := new(DeviceChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *applicationManagerClient) SetDeviceDebugMode(ctx context.Context, in *DeviceDebugModeRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/SetDeviceDebugMode", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetDeviceDebugTrace(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*DeviceDebugTrace, error) {
+	out := new(DeviceDebugTrace)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/GetDeviceDebugTrace", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetDeviceState(ctx context.Context, in *DeviceStateRequest, opts ...grpc.CallOption) (*DeviceState, error) {
+	out := new(DeviceState)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/GetDeviceState", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) SetDeviceTwin(ctx context.Context, in *DeviceTwinRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/SetDeviceTwin", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetDeviceTwin(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*DeviceTwin, error) {
+	out := new(DeviceTwin)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/GetDeviceTwin", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) SendCommand(ctx context.Context, in *CommandRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/SendCommand", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) TestUplink(ctx context.Context, in *TestUplinkRequest, opts ...grpc.CallOption) (*DryUplinkResult, error) {
+	out := new(DryUplinkResult)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/TestUplink", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetComplianceReport(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*ComplianceReport, error) {
+	out := new(ComplianceReport)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/GetComplianceReport", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) ListPayloadFunctionsRevisions(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*PayloadFunctionsRevisionList, error) {
+	out := new(PayloadFunctionsRevisionList)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/ListPayloadFunctionsRevisions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) RollbackPayloadFunctions(ctx context.Context, in *PayloadFunctionsRollbackRequest, opts ...grpc.CallOption) (*MutationResult, error) {
+	out := new(MutationResult)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/RollbackPayloadFunctions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) RedecodeUplinks(ctx context.Context, in *RedecodeUplinksRequest, opts ...grpc.CallOption) (*RedecodeUplinksResult, error) {
+	out := new(RedecodeUplinksResult)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/RedecodeUplinks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) ExportApplication(ctx context.Context, in *ExportApplicationRequest, opts ...grpc.CallOption) (*ApplicationTransfer, error) {
+	out := new(ApplicationTransfer)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/ExportApplication", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) ImportApplication(ctx context.Context, in *ApplicationTransfer, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/ImportApplication", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) FinishApplicationTransfer(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/FinishApplicationTransfer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetWebhooks(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*WebhookList, error) {
+	out := new(WebhookList)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/GetWebhooks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) SetWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/SetWebhook", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) DeleteWebhook(ctx context.Context, in *WebhookIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/DeleteWebhook", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetDeletedDevices(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*DeletedDeviceList, error) {
+	out := new(DeletedDeviceList)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/GetDeletedDevices", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) RestoreDevice(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/RestoreDevice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) RestoreApplication(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/handler.ApplicationManager/RestoreApplication", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for ApplicationManager service
+
+type ApplicationManagerServer interface {
+	// Applications should first be registered to the Handler with the `RegisterApplication` method
+	RegisterApplication(context.Context, *ApplicationIdentifier) (*google_protobuf.Empty, error)
+	// GetApplication returns the application with the given identifier (app_id)
+	GetApplication(context.Context, *ApplicationIdentifier) (*Application, error)
+	// SetApplication updates the settings for the application. All fields must be supplied, unless update_mask is set. If
+	// dry_run is set, the request is validated and the changes are returned without persisting them.
+	SetApplication(context.Context, *Application) (*MutationResult, error)
+	// DeleteApplication deletes the application with the given identifier (app_id)
+	// If the Handler has a recycle bin, the application and its devices can be restored until they are purged.
+	DeleteApplication(context.Context, *ApplicationIdentifier) (*google_protobuf.Empty, error)
+	// GetDevice returns the device with the given identifier (app_id and dev_id)
+	GetDevice(context.Context, *DeviceIdentifier) (*Device, error)
+	// SetDevice creates or updates a device. All fields must be supplied, unless update_mask is set to update an existing
+	// device. If dry_run is set, the request is validated and the changes are returned without persisting them.
+	SetDevice(context.Context, *Device) (*MutationResult, error)
+	// DeleteDevice deletes the device with the given identifier (app_id and dev_id)
+	// If the Handler has a recycle bin, the device can be restored until it is purged.
+	DeleteDevice(context.Context, *DeviceIdentifier) (*google_protobuf.Empty, error)
+	// ForceRejoin invalidates the session of the OTAA device with the given identifier (app_id and dev_id).
+	// The device will have to join again before it can send or receive messages.
+	ForceRejoin(context.Context, *DeviceIdentifier) (*google_protobuf.Empty, error)
+	// GetDevicesForApplication returns all devices that belong to the application with the given identifier (app_id).
+	// The devices can be paged with the limit and cursor metadata (the cursor of the next page is returned in the
+	// next-cursor header), sorted with the sort metadata (dev_id, created_at or last_seen, with a - prefix for descending
+	// order) and filtered with filter metadata (app_eui, dev_eui or dev_addr, as field=value).
+	GetDevicesForApplication(context.Context, *ApplicationIdentifier) (*DeviceList, error)
+	// DryUplink simulates processing a downlink message and returns the result
+	DryDownlink(context.Context, *DryDownlinkMessage) (*DryDownlinkResult, error)
+	// DryUplink simulates processing an uplink message and returns the result
+	DryUplink(context.Context, *DryUplinkMessage) (*DryUplinkResult, error)
+	// SimulateUplink simulates an uplink message
+	SimulateUplink(context.Context, *SimulatedUplinkMessage) (*google_protobuf.Empty, error)
+	// GetDeviceUplinks returns the last uplink messages of the device with the given identifier (app_id and dev_id),
+	// including the metadata of all gateways that received them
+	GetDeviceUplinks(context.Context, *DeviceIdentifier) (*DeviceUplinkList, error)
+	// ReplayUplinks processes the stored uplink messages of the device with the given identifier (app_id and dev_id)
+	// again with the current payload functions, and publishes them to the application, marked as replay
+	ReplayUplinks(context.Context, *ReplayUplinksRequest) (*google_protobuf.Empty, error)
+	// PreviewDownlink estimates the time on air of a downlink message to the device with the given identifier (app_id and dev_id),
+	// the gateway that would transmit it, and whether the duty cycle allows it to be transmitted now
+	PreviewDownlink(context.Context, *DownlinkPreviewRequest) (*DownlinkPreview, error)
+	// SyncMQTTCredentials generates MQTT credentials for the collaborators of the application, with access to the topics
+	// that their rights allow. Credentials of collaborators that were removed are deleted.
+	SyncMQTTCredentials(context.Context, *ApplicationIdentifier) (*MQTTCredentialsList, error)
+	// ForgetDevice erases all stored payloads and payload fields of the device with the given identifier (app_id and
+	// dev_id), and returns a report of the erased data. The registration of the device is not deleted.
+	ForgetDevice(context.Context, *DeviceIdentifier) (*ErasureReport, error)
+	// CheckMIC recomputes the MIC of a raw LoRaWAN frame with the stored keys of the device, and reports which key or
+	// frame counter caused a mismatch
+	CheckMIC(context.Context, *MICCheckRequest) (*MICCheckResult, error)
+	// WatchDevices streams the changes in the device registry of the application with the given identifier (app_id),
+	// starting after the given resume token. This allows mirroring the registry without periodically listing all devices
+	WatchDevices(*WatchDevicesRequest, ApplicationManager_WatchDevicesServer) error
+	// SetDeviceDebugMode enables the debug mode of the device with the given identifier (app_id and dev_id) for the
+	// given duration, or disables it. While the debug mode is enabled, verbose traces of the device are captured.
+	SetDeviceDebugMode(context.Context, *DeviceDebugModeRequest) (*google_protobuf.Empty, error)
+	// GetDeviceDebugTrace returns the verbose traces that were captured while the device with the given identifier
+	// (app_id and dev_id) was in debug mode
+	GetDeviceDebugTrace(context.Context, *DeviceIdentifier) (*DeviceDebugTrace, error)
+	// GetDeviceState reconstructs the state of the device with the given identifier (app_id and dev_id) at the given
+	// point in time from the history of changes to its settings, session and uplink frame counter
+	GetDeviceState(context.Context, *DeviceStateRequest) (*DeviceState, error)
+	// SetDeviceTwin sets the desired fields in the twin of the device with the given identifier (app_id and dev_id).
+	// The Handler sends the desired fields to the device until the device reports them in its uplink messages.
+	SetDeviceTwin(context.Context, *DeviceTwinRequest) (*google_protobuf.Empty, error)
+	// GetDeviceTwin returns the desired and reported fields in the twin of the device with the given identifier
+	// (app_id and dev_id)
+	GetDeviceTwin(context.Context, *DeviceIdentifier) (*DeviceTwin, error)
+	// SendCommand sends a downlink message to the device with the given identifier (app_id and dev_id) and waits for
+	// the uplink message that the device sends in response
+	SendCommand(context.Context, *CommandRequest) (*CommandResponse, error)
+	// TestUplink runs the stored decoder, converter and validator of the application with the given identifier (app_id)
+	// on the payload, and returns the result and the logs. Nothing is published.
+	TestUplink(context.Context, *TestUplinkRequest) (*DryUplinkResult, error)
+	// GetComplianceReport summarizes the devices of the application with the given identifier (app_id) by LoRaWAN
+	// version, Regional Parameters revision and frequency plan.
+	GetComplianceReport(context.Context, *ApplicationIdentifier) (*ComplianceReport, error)
+	// ListPayloadFunctionsRevisions returns the stored revisions of the payload functions of the application with the
+	// given identifier (app_id), newest first
+	ListPayloadFunctionsRevisions(context.Context, *ApplicationIdentifier) (*PayloadFunctionsRevisionList, error)
+	// RollbackPayloadFunctions restores the payload functions of a stored revision in the application with the given
+	// identifier (app_id). The restored payload functions are stored as a new revision.
+	RollbackPayloadFunctions(context.Context, *PayloadFunctionsRollbackRequest) (*MutationResult, error)
+	// RedecodeUplinks runs the current decoder, converter and validator of the application with the given identifier
+	// (app_id) on a batch of historical uplink messages, and returns the decoded fields. Nothing is stored or published.
+	RedecodeUplinks(context.Context, *RedecodeUplinksRequest) (*RedecodeUplinksResult, error)
+	// ExportApplication exports the application with the given identifier (app_id), including its devices, sessions and
+	// downlink queues, so that it can be imported by another Handler. With cutover, this Handler stops processing the
+	// traffic of the application.
+	ExportApplication(context.Context, *ExportApplicationRequest) (*ApplicationTransfer, error)
+	// ImportApplication imports an application that was exported by another Handler. The traffic of the application is
+	// not processed until the application is imported with activate.
+	ImportApplication(context.Context, *ApplicationTransfer) (*google_protobuf.Empty, error)
+	// FinishApplicationTransfer removes the application with the given identifier (app_id) from this Handler after a
+	// cutover, without deleting its devices from the network.
+	FinishApplicationTransfer(context.Context, *ApplicationIdentifier) (*google_protobuf.Empty, error)
+	// GetWebhooks returns the webhooks of the application with the given identifier (app_id)
+	GetWebhooks(context.Context, *ApplicationIdentifier) (*WebhookList, error)
+	// SetWebhook creates or updates the webhook with the given identifier (app_id and webhook_id)
+	SetWebhook(context.Context, *Webhook) (*google_protobuf.Empty, error)
+	// DeleteWebhook deletes the webhook with the given identifier (app_id and webhook_id)
+	DeleteWebhook(context.Context, *WebhookIdentifier) (*google_protobuf.Empty, error)
+	// GetDeletedDevices returns the devices in the recycle bin of the application with the given identifier (app_id)
+	GetDeletedDevices(context.Context, *ApplicationIdentifier) (*DeletedDeviceList, error)
+	// RestoreDevice restores the deleted device with the given identifier (app_id and dev_id) from the recycle bin
+	RestoreDevice(context.Context, *DeviceIdentifier) (*google_protobuf.Empty, error)
+	// RestoreApplication restores the deleted application with the given identifier (app_id) from the recycle bin,
+	// together with the devices that were deleted with it
+	RestoreApplication(context.Context, *ApplicationIdentifier) (*google_protobuf.Empty, error)
+}
+
+func RegisterApplicationManagerServer(s *grpc.Server, srv ApplicationManagerServer) {
+	s.RegisterService(&_ApplicationManager_serviceDesc, srv)
+}
+
+func _ApplicationManager_RegisterApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).RegisterApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/RegisterApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).RegisterApplication(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/GetApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetApplication(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_SetApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Application)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).SetApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/SetApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).SetApplication(ctx, req.(*Application))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_DeleteApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).DeleteApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/DeleteApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).DeleteApplication(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/GetDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetDevice(ctx, req.(*DeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_SetDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Device)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).SetDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/SetDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).SetDevice(ctx, req.(*Device))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_DeleteDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).DeleteDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/DeleteDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).DeleteDevice(ctx, req.(*DeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_ForceRejoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).ForceRejoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/ForceRejoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).ForceRejoin(ctx, req.(*DeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetDevicesForApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetDevicesForApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/GetDevicesForApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetDevicesForApplication(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_DryDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryDownlinkMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).DryDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/DryDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).DryDownlink(ctx, req.(*DryDownlinkMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_DryUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryUplinkMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).DryUplink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/DryUplink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).DryUplink(ctx, req.(*DryUplinkMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_SimulateUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulatedUplinkMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).SimulateUplink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/SimulateUplink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).SimulateUplink(ctx, req.(*SimulatedUplinkMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetDeviceUplinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetDeviceUplinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/GetDeviceUplinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetDeviceUplinks(ctx, req.(*DeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_ReplayUplinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayUplinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).ReplayUplinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/ReplayUplinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).ReplayUplinks(ctx, req.(*ReplayUplinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_PreviewDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownlinkPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).PreviewDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/PreviewDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).PreviewDownlink(ctx, req.(*DownlinkPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_SyncMQTTCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).SyncMQTTCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/SyncMQTTCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).SyncMQTTCredentials(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_ForgetDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).ForgetDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/ForgetDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).ForgetDevice(ctx, req.(*DeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_CheckMIC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MICCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).CheckMIC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/CheckMIC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).CheckMIC(ctx, req.(*MICCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_WatchDevices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDevicesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApplicationManagerServer).WatchDevices(m, &applicationManagerWatchDevicesServer{stream})
+}
+
+type ApplicationManager_WatchDevicesServer interface {
+	Send(*DeviceChange) error
+	grpc.ServerStream
+}
+
+type applicationManagerWatchDevicesServer struct {
+	grpc.ServerStream
+}
+
+func (x *applicationManagerWatchDevicesServer) Send(m *DeviceChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApplicationManager_SetDeviceDebugMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceDebugModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).SetDeviceDebugMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/SetDeviceDebugMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).SetDeviceDebugMode(ctx, req.(*DeviceDebugModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetDeviceDebugTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetDeviceDebugTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/GetDeviceDebugTrace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetDeviceDebugTrace(ctx, req.(*DeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetDeviceState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetDeviceState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/GetDeviceState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetDeviceState(ctx, req.(*DeviceStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_SetDeviceTwin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceTwinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).SetDeviceTwin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/SetDeviceTwin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).SetDeviceTwin(ctx, req.(*DeviceTwinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetDeviceTwin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetDeviceTwin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/GetDeviceTwin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetDeviceTwin(ctx, req.(*DeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_SendCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).SendCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/SendCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).SendCommand(ctx, req.(*CommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_TestUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestUplinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).TestUplink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/TestUplink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).TestUplink(ctx, req.(*TestUplinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetComplianceReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetComplianceReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/GetComplianceReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetComplianceReport(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_ListPayloadFunctionsRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).ListPayloadFunctionsRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/ListPayloadFunctionsRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).ListPayloadFunctionsRevisions(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_RollbackPayloadFunctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayloadFunctionsRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).RollbackPayloadFunctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/RollbackPayloadFunctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).RollbackPayloadFunctions(ctx, req.(*PayloadFunctionsRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_RedecodeUplinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedecodeUplinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).RedecodeUplinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/RedecodeUplinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).RedecodeUplinks(ctx, req.(*RedecodeUplinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_ExportApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).ExportApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/ExportApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).ExportApplication(ctx, req.(*ExportApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_ImportApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).ImportApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/ImportApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).ImportApplication(ctx, req.(*ApplicationTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_FinishApplicationTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).FinishApplicationTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/FinishApplicationTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).FinishApplicationTransfer(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/GetWebhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetWebhooks(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_SetWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Webhook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).SetWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/SetWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).SetWebhook(ctx, req.(*Webhook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/DeleteWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).DeleteWebhook(ctx, req.(*WebhookIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetDeletedDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetDeletedDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/GetDeletedDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetDeletedDevices(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_RestoreDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).RestoreDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/RestoreDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).RestoreDevice(ctx, req.(*DeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_RestoreApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).RestoreApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.ApplicationManager/RestoreApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).RestoreApplication(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ApplicationManager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "handler.ApplicationManager",
+	HandlerType: (*ApplicationManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterApplication",
+			Handler:    _ApplicationManager_RegisterApplication_Handler,
+		},
+		{
+			MethodName: "GetApplication",
+			Handler:    _ApplicationManager_GetApplication_Handler,
+		},
+		{
+			MethodName: "SetApplication",
+			Handler:    _ApplicationManager_SetApplication_Handler,
+		},
+		{
+			MethodName: "DeleteApplication",
+			Handler:    _ApplicationManager_DeleteApplication_Handler,
+		},
+		{
+			MethodName: "GetDevice",
+			Handler:    _ApplicationManager_GetDevice_Handler,
+		},
+		{
+			MethodName: "SetDevice",
+			Handler:    _ApplicationManager_SetDevice_Handler,
+		},
+		{
+			MethodName: "DeleteDevice",
+			Handler:    _ApplicationManager_DeleteDevice_Handler,
+		},
+		{
+			MethodName: "ForceRejoin",
+			Handler:    _ApplicationManager_ForceRejoin_Handler,
+		},
+		{
+			MethodName: "GetDevicesForApplication",
+			Handler:    _ApplicationManager_GetDevicesForApplication_Handler,
+		},
+		{
+			MethodName: "DryDownlink",
+			Handler:    _ApplicationManager_DryDownlink_Handler,
+		},
+		{
+			MethodName: "DryUplink",
+			Handler:    _ApplicationManager_DryUplink_Handler,
+		},
+		{
+			MethodName: "SimulateUplink",
+			Handler:    _ApplicationManager_SimulateUplink_Handler,
+		},
+		{
+			MethodName: "GetDeviceUplinks",
+			Handler:    _ApplicationManager_GetDeviceUplinks_Handler,
+		},
+		{
+			MethodName: "ReplayUplinks",
+			Handler:    _ApplicationManager_ReplayUplinks_Handler,
+		},
+		{
+			MethodName: "PreviewDownlink",
+			Handler:    _ApplicationManager_PreviewDownlink_Handler,
+		},
+		{
+			MethodName: "SyncMQTTCredentials",
+			Handler:    _ApplicationManager_SyncMQTTCredentials_Handler,
+		},
+		{
+			MethodName: "ForgetDevice",
+			Handler:    _ApplicationManager_ForgetDevice_Handler,
+		},
+		{
+			MethodName: "CheckMIC",
+			Handler:    _ApplicationManager_CheckMIC_Handler,
+		},
+		{
+			MethodName: "SetDeviceDebugMode",
+			Handler:    _ApplicationManager_SetDeviceDebugMode_Handler,
+		},
+		{
+			MethodName: "GetDeviceDebugTrace",
+			Handler:    _ApplicationManager_GetDeviceDebugTrace_Handler,
+		},
+		{
+			MethodName: "GetDeviceState",
+			Handler:    _ApplicationManager_GetDeviceState_Handler,
+		},
+		{
+			MethodName: "SetDeviceTwin",
+			Handler:    _ApplicationManager_SetDeviceTwin_Handler,
+		},
+		{
+			MethodName: "GetDeviceTwin",
+			Handler:    _ApplicationManager_GetDeviceTwin_Handler,
+		},
+		{
+			MethodName: "SendCommand",
+			Handler:    _ApplicationManager_SendCommand_Handler,
+		},
+		{
+			MethodName: "TestUplink",
+			Handler:    _ApplicationManager_TestUplink_Handler,
+		},
+		{
+			MethodName: "GetComplianceReport",
+			Handler:    _ApplicationManager_GetComplianceReport_Handler,
+		},
+		{
+			MethodName: "ListPayloadFunctionsRevisions",
+			Handler:    _ApplicationManager_ListPayloadFunctionsRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackPayloadFunctions",
+			Handler:    _ApplicationManager_RollbackPayloadFunctions_Handler,
+		},
+		{
+			MethodName: "RedecodeUplinks",
+			Handler:    _ApplicationManager_RedecodeUplinks_Handler,
+		},
+		{
+			MethodName: "ExportApplication",
+			Handler:    _ApplicationManager_ExportApplication_Handler,
+		},
+		{
+			MethodName: "ImportApplication",
+			Handler:    _ApplicationManager_ImportApplication_Handler,
+		},
+		{
+			MethodName: "FinishApplicationTransfer",
+			Handler:    _ApplicationManager_FinishApplicationTransfer_Handler,
+		},
+		{
+			MethodName: "GetWebhooks",
+			Handler:    _ApplicationManager_GetWebhooks_Handler,
+		},
+		{
+			MethodName: "SetWebhook",
+			Handler:    _ApplicationManager_SetWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _ApplicationManager_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "GetDeletedDevices",
+			Handler:    _ApplicationManager_GetDeletedDevices_Handler,
+		},
+		{
+			MethodName: "RestoreDevice",
+			Handler:    _ApplicationManager_RestoreDevice_Handler,
+		},
+		{
+			MethodName: "RestoreApplication",
+			Handler:    _ApplicationManager_RestoreApplication_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchDevices",
+			Handler:       _ApplicationManager_WatchDevices_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "github.com/TheThingsNetwork/ttn/api/handler/handler.proto",
+}
+
+// Client API for HandlerManager service
+
+type HandlerManagerClient interface {
+	GetStatus(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*Status, error)
+	// SetPayloadFunctions sets the payload functions of multiple applications at once
+	SetPayloadFunctions(ctx context.Context, in *BulkPayloadFunctionsRequest, opts ...grpc.CallOption) (*BulkPayloadFunctionsResponse, error)
+	// ListCodecs returns the codecs in the codec library
+	ListCodecs(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*CodecList, error)
+	// GetCodec returns the codec with the given identifier
+	GetCodec(ctx context.Context, in *CodecIdentifier, opts ...grpc.CallOption) (*Codec, error)
+	// SetCodec creates or updates a codec in the codec library. Updates are used by all applications that use the codec.
+	SetCodec(ctx context.Context, in *Codec, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// DeleteCodec deletes a codec from the codec library. Codecs that are used by applications can not be deleted.
+	DeleteCodec(ctx context.Context, in *CodecIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// ReplayArchive pushes the uplink messages in the archive of the Handler through the uplink processing again and
+	// publishes them to the applications
+	ReplayArchive(ctx context.Context, in *ReplayArchiveRequest, opts ...grpc.CallOption) (*ReplayArchiveResult, error)
+	// InjectUplink publishes a synthetic uplink message with decoded fields to the integrations of the application, to
+	// test downstream systems without devices
+	InjectUplink(ctx context.Context, in *InjectedUplinkMessage, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+}
+
+type handlerManagerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewHandlerManagerClient(cc *grpc.ClientConn) HandlerManagerClient {
+	return &handlerManagerClient{cc}
+}
+
+func (c *handlerManagerClient) GetStatus(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := grpc.Invoke(ctx, "/handler.HandlerManager/GetStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *handlerManagerClient) SetPayloadFunctions(ctx context.Context, in *BulkPayloadFunctionsRequest, opts ...grpc.CallOption) (*BulkPayloadFunctionsResponse, error) {
+	out := new(BulkPayloadFunctionsResponse)
+	err := grpc.Invoke(ctx, "/handler.HandlerManager/SetPayloadFunctions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *handlerManagerClient) ListCodecs(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*CodecList, error) {
+	out := new(CodecList)
+	err := grpc.Invoke(ctx, "/handler.HandlerManager/ListCodecs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *handlerManagerClient) GetCodec(ctx context.Context, in *CodecIdentifier, opts ...grpc.CallOption) (*Codec, error) {
+	out := new(Codec)
+	err := grpc.Invoke(ctx, "/handler.HandlerManager/GetCodec", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *handlerManagerClient) SetCodec(ctx context.Context, in *Codec, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/handler.HandlerManager/SetCodec", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *handlerManagerClient) DeleteCodec(ctx context.Context, in *CodecIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/handler.HandlerManager/DeleteCodec", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *handlerManagerClient) ReplayArchive(ctx context.Context, in *ReplayArchiveRequest, opts ...grpc.CallOption) (*ReplayArchiveResult, error) {
+	out := new(ReplayArchiveResult)
+	err := grpc.Invoke(ctx, "/handler.HandlerManager/ReplayArchive", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *handlerManagerClient) InjectUplink(ctx context.Context, in *InjectedUplinkMessage, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/handler.HandlerManager/InjectUplink", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for HandlerManager service
+
+type HandlerManagerServer interface {
+	GetStatus(context.Context, *StatusRequest) (*Status, error)
+	// SetPayloadFunctions sets the payload functions of multiple applications at once
+	SetPayloadFunctions(context.Context, *BulkPayloadFunctionsRequest) (*BulkPayloadFunctionsResponse, error)
+	// ListCodecs returns the codecs in the codec library
+	ListCodecs(context.Context, *google_protobuf.Empty) (*CodecList, error)
+	// GetCodec returns the codec with the given identifier
+	GetCodec(context.Context, *CodecIdentifier) (*Codec, error)
+	// SetCodec creates or updates a codec in the codec library. Updates are used by all applications that use the codec.
+	SetCodec(context.Context, *Codec) (*google_protobuf.Empty, error)
+	// DeleteCodec deletes a codec from the codec library. Codecs that are used by applications can not be deleted.
+	DeleteCodec(context.Context, *CodecIdentifier) (*google_protobuf.Empty, error)
+	// ReplayArchive pushes the uplink messages in the archive of the Handler through the uplink processing again and
+	// publishes them to the applications
+	ReplayArchive(context.Context, *ReplayArchiveRequest) (*ReplayArchiveResult, error)
+	// InjectUplink publishes a synthetic uplink message with decoded fields to the integrations of the application, to
+	// test downstream systems without devices
+	InjectUplink(context.Context, *InjectedUplinkMessage) (*google_protobuf.Empty, error)
+}
+
+func RegisterHandlerManagerServer(s *grpc.Server, srv HandlerManagerServer) {
+	s.RegisterService(&_HandlerManager_serviceDesc, srv)
+}
+
+func _HandlerManager_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HandlerManagerServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.HandlerManager/GetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HandlerManagerServer).GetStatus(ctx, req.(*StatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HandlerManager_SetPayloadFunctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkPayloadFunctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HandlerManagerServer).SetPayloadFunctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.HandlerManager/SetPayloadFunctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HandlerManagerServer).SetPayloadFunctions(ctx, req.(*BulkPayloadFunctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HandlerManager_ListCodecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HandlerManagerServer).ListCodecs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.HandlerManager/ListCodecs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HandlerManagerServer).ListCodecs(ctx, req.(*google_protobuf.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HandlerManager_GetCodec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CodecIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HandlerManagerServer).GetCodec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.HandlerManager/GetCodec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HandlerManagerServer).GetCodec(ctx, req.(*CodecIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HandlerManager_SetCodec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Codec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HandlerManagerServer).SetCodec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.HandlerManager/SetCodec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HandlerManagerServer).SetCodec(ctx, req.(*Codec))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HandlerManager_DeleteCodec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CodecIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HandlerManagerServer).DeleteCodec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.HandlerManager/DeleteCodec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HandlerManagerServer).DeleteCodec(ctx, req.(*CodecIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HandlerManager_ReplayArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HandlerManagerServer).ReplayArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.HandlerManager/ReplayArchive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HandlerManagerServer).ReplayArchive(ctx, req.(*ReplayArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HandlerManager_InjectUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InjectedUplinkMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HandlerManagerServer).InjectUplink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/handler.HandlerManager/InjectUplink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HandlerManagerServer).InjectUplink(ctx, req.(*InjectedUplinkMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _HandlerManager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "handler.HandlerManager",
+	HandlerType: (*HandlerManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStatus",
+			Handler:    _HandlerManager_GetStatus_Handler,
+		},
+		{
+			MethodName: "SetPayloadFunctions",
+			Handler:    _HandlerManager_SetPayloadFunctions_Handler,
+		},
+		{
+			MethodName: "ListCodecs",
+			Handler:    _HandlerManager_ListCodecs_Handler,
+		},
+		{
+			MethodName: "GetCodec",
+			Handler:    _HandlerManager_GetCodec_Handler,
+		},
+		{
+			MethodName: "SetCodec",
+			Handler:    _HandlerManager_SetCodec_Handler,
+		},
+		{
+			MethodName: "DeleteCodec",
+			Handler:    _HandlerManager_DeleteCodec_Handler,
+		},
+		{
+			MethodName: "ReplayArchive",
+			Handler:    _HandlerManager_ReplayArchive_Handler,
+		},
+		{
+			MethodName: "InjectUplink",
+			Handler:    _HandlerManager_InjectUplink_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/TheThingsNetwork/ttn/api/handler/handler.proto",
+}
+
+func (m *DeviceActivationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceActivationResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	if m.Message != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Message.Size()))
+		n1, err := m.Message.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.DownlinkOption != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.DownlinkOption.Size()))
+		n2, err := m.DownlinkOption.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.ActivationMetadata != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.ActivationMetadata.Size()))
+		n3, err := m.ActivationMetadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.Trace != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Trace.Size()))
+		n4, err := m.Trace.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
+}
+
+func (m *StatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *Status) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Status) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.System != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.System.Size()))
+		n5, err := m.System.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.Component != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Component.Size()))
+		n6, err := m.Component.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.Uplink != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Uplink.Size()))
+		n7, err := m.Uplink.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.Downlink != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Downlink.Size()))
+		n8, err := m.Downlink.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.Activations != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Activations.Size()))
+		n9, err := m.Activations.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+
+func (m *ApplicationIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	return i, nil
+}
+
+func (m *Application) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Application) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.Decoder) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Decoder)))
+		i += copy(dAtA[i:], m.Decoder)
+	}
+	if len(m.Converter) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Converter)))
+		i += copy(dAtA[i:], m.Converter)
+	}
+	if len(m.Validator) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Validator)))
+		i += copy(dAtA[i:], m.Validator)
+	}
+	if len(m.Encoder) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Encoder)))
+		i += copy(dAtA[i:], m.Encoder)
+	}
+	if len(m.JoinHook) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.JoinHook)))
+		i += copy(dAtA[i:], m.JoinHook)
+	}
+	if m.ProvisioningDownlink != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.ProvisioningDownlink.Size()))
+		n14, err := m.ProvisioningDownlink.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if len(m.PayloadFunctionsVersion) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.PayloadFunctionsVersion)))
+		i += copy(dAtA[i:], m.PayloadFunctionsVersion)
+	}
+	if m.AnomalyDetection != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.AnomalyDetection.Size()))
+		n15, err := m.AnomalyDetection.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if len(m.ComputedFields) > 0 {
+		for _, msg := range m.ComputedFields {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.OutputPolicy != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.OutputPolicy.Size()))
+		n16, err := m.OutputPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if m.Aggregation != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Aggregation.Size()))
+		n17, err := m.Aggregation.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if len(m.IntegrationFormat) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.IntegrationFormat)))
+		i += copy(dAtA[i:], m.IntegrationFormat)
+	}
+	if m.RetentionDays != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.RetentionDays))
+	}
+	if len(m.SensitiveFields) > 0 {
+		for _, s := range m.SensitiveFields {
+			dAtA[i] = 0x7a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.DryRun {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Revision != 0 {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Revision))
+	}
+	if len(m.UpdateMask) > 0 {
+		for _, s := range m.UpdateMask {
+			dAtA[i] = 0x92
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.PayloadFormat) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.PayloadFormat)))
+		i += copy(dAtA[i:], m.PayloadFormat)
+	}
+	if m.FunctionTimeout != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.FunctionTimeout))
+	}
+	if len(m.WasmModule) > 0 {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.WasmModule)))
+		i += copy(dAtA[i:], m.WasmModule)
+	}
+	if len(m.PortFunctions) > 0 {
+		for _, msg := range m.PortFunctions {
+			dAtA[i] = 0xb2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.DownlinkDecoder) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DownlinkDecoder)))
+		i += copy(dAtA[i:], m.DownlinkDecoder)
+	}
+	if len(m.DevAddrAllocation) > 0 {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevAddrAllocation)))
+		i += copy(dAtA[i:], m.DevAddrAllocation)
+	}
+	if m.FunctionsRevision != 0 {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.FunctionsRevision))
+	}
+	if len(m.Codec) > 0 {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Codec)))
+		i += copy(dAtA[i:], m.Codec)
+	}
+	if len(m.BinaryFields) > 0 {
+		for _, msg := range m.BinaryFields {
+			dAtA[i] = 0xda
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.FunctionsLanguage) > 0 {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.FunctionsLanguage)))
+		i += copy(dAtA[i:], m.FunctionsLanguage)
+	}
+	if len(m.ProtobufDescriptor) > 0 {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.ProtobufDescriptor)))
+		i += copy(dAtA[i:], m.ProtobufDescriptor)
+	}
+	if len(m.ProtobufMessage) > 0 {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.ProtobufMessage)))
+		i += copy(dAtA[i:], m.ProtobufMessage)
+	}
+	if len(m.GoCodec) > 0 {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.GoCodec)))
+		i += copy(dAtA[i:], m.GoCodec)
+	}
+	if len(m.DataResidency) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DataResidency)))
+		i += copy(dAtA[i:], m.DataResidency)
+	}
+	if m.StatefulDecoding {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.StatefulDecoding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Normalizer) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Normalizer)))
+		i += copy(dAtA[i:], m.Normalizer)
+	}
+	if len(m.MaintenanceWindows) > 0 {
+		for _, msg := range m.MaintenanceWindows {
+			dAtA[i] = 0x9a
+			i++
+			dAtA[i] = 0x2
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.MaxOutputFields != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.MaxOutputFields))
+	}
+	if m.MaxOutputDepth != 0 {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.MaxOutputDepth))
+	}
+	if m.MaxOutputSize != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.MaxOutputSize))
+	}
+	return i, nil
+}
+
+func (m *DeviceIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.DevId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevId)))
+		i += copy(dAtA[i:], m.DevId)
+	}
+	return i, nil
+}
+
+func (m *Device) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Device) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.DevId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevId)))
+		i += copy(dAtA[i:], m.DevId)
+	}
+	if m.Device != nil {
+		nn10, err := m.Device.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn10
+	}
+	if m.Latitude != 0 {
+		dAtA[i] = 0x55
+		i++
+		i = encodeFixed32Handler(dAtA, i, uint32(math.Float32bits(float32(m.Latitude))))
+	}
+	if m.Longitude != 0 {
+		dAtA[i] = 0x5d
+		i++
+		i = encodeFixed32Handler(dAtA, i, uint32(math.Float32bits(float32(m.Longitude))))
+	}
+	if m.Altitude != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Altitude))
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if len(m.Attributes) > 0 {
+		for k, _ := range m.Attributes {
+			dAtA[i] = 0xaa
+			i++
+			dAtA[i] = 0x1
+			i++
+			v := m.Attributes[k]
+			mapSize := 1 + len(k) + sovHandler(uint64(len(k))) + 1 + len(v) + sovHandler(uint64(len(v)))
+			i = encodeVarintHandler(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.MaxDownlinkPayloadSize != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.MaxDownlinkPayloadSize))
+	}
+	if len(m.DownlinkDataRate) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DownlinkDataRate)))
+		i += copy(dAtA[i:], m.DownlinkDataRate)
+	}
+	if m.DryRun {
+		dAtA[i] = 0xc0
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Revision != 0 {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Revision))
+	}
+	if len(m.UpdateMask) > 0 {
+		for _, s := range m.UpdateMask {
+			dAtA[i] = 0xd2
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Profile != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Profile.Size()))
+		n101, err := m.Profile.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n101
+	}
+	if m.DownlinkDelivery != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.DownlinkDelivery.Size()))
+		n101, err := m.DownlinkDelivery.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n101
+	}
+	if len(m.PayloadFormat) > 0 {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.PayloadFormat)))
+		i += copy(dAtA[i:], m.PayloadFormat)
+	}
+	if len(m.Codec) > 0 {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Codec)))
+		i += copy(dAtA[i:], m.Codec)
+	}
+	if len(m.GoCodec) > 0 {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.GoCodec)))
+		i += copy(dAtA[i:], m.GoCodec)
+	}
+	return i, nil
+}
+
+func (m *Device_LorawanDevice) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.LorawanDevice != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.LorawanDevice.Size()))
+		n11, err := m.LorawanDevice.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	return i, nil
+}
+func (m *DeviceList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Devices) > 0 {
+		for _, msg := range m.Devices {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DryDownlinkMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DryDownlinkMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	if len(m.Fields) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Fields)))
+		i += copy(dAtA[i:], m.Fields)
+	}
+	if m.App != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.App.Size()))
+		n12, err := m.App.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.Port != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Port))
+	}
+	return i, nil
+}
+
+func (m *DryUplinkMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DryUplinkMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	if m.App != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.App.Size()))
+		n13, err := m.App.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if m.Port != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Port))
+	}
+	return i, nil
+}
+
+func (m *SimulatedUplinkMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulatedUplinkMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.DevId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevId)))
+		i += copy(dAtA[i:], m.DevId)
+	}
+	if len(m.Payload) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	if m.Port != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Port))
+	}
+	return i, nil
+}
+
+func (m *LogEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogEntry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Function) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Function)))
+		i += copy(dAtA[i:], m.Function)
+	}
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *DryUplinkResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DryUplinkResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	if len(m.Fields) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Fields)))
+		i += copy(dAtA[i:], m.Fields)
+	}
+	if m.Valid {
+		dAtA[i] = 0x18
+		i++
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Logs) > 0 {
+		for _, msg := range m.Logs {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *DryDownlinkResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DryDownlinkResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	if len(m.Logs) > 0 {
+		for _, msg := range m.Logs {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DeviceUplink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceUplink) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ServerTime != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.ServerTime))
+	}
+	if m.Port != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Port))
+	}
+	if m.Counter != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Counter))
+	}
+	if m.Confirmed {
+		dAtA[i] = 0x20
+		i++
+		if m.Confirmed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.PayloadRaw) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.PayloadRaw)))
+		i += copy(dAtA[i:], m.PayloadRaw)
+	}
+	if m.ProtocolMetadata != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.ProtocolMetadata.Size()))
+		n101, err := m.ProtocolMetadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n101
+	}
+	if len(m.GatewayMetadata) > 0 {
+		for _, msg := range m.GatewayMetadata {
+			dAtA[i] = 0x62
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DeviceUplinkList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceUplinkList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Uplinks) > 0 {
+		for _, msg := range m.Uplinks {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ReplayUplinksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayUplinksRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.DevId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevId)))
+		i += copy(dAtA[i:], m.DevId)
+	}
+	if m.Since != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Since))
+	}
+	if m.Until != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Until))
+	}
+	return i, nil
+}
+
+func (m *DownlinkPreviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DownlinkPreviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.DevId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevId)))
+		i += copy(dAtA[i:], m.DevId)
+	}
+	if len(m.Payload) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	if len(m.Fields) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Fields)))
+		i += copy(dAtA[i:], m.Fields)
+	}
+	if m.Port != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Port))
+	}
+	return i, nil
+}
+
+func (m *DownlinkPreview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DownlinkPreview) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	if len(m.GatewayId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.GatewayId)))
+		i += copy(dAtA[i:], m.GatewayId)
+	}
+	if m.RxWindow != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.RxWindow))
+	}
+	if m.Frequency != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Frequency))
+	}
+	if len(m.DataRate) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DataRate)))
+		i += copy(dAtA[i:], m.DataRate)
+	}
+	if m.Airtime != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Airtime))
+	}
+	if m.DutyCycle != 0 {
+		dAtA[i] = 0x3d
+		i++
+		i = encodeFixed32Handler(dAtA, i, uint32(math.Float32bits(float32(m.DutyCycle))))
+	}
+	if m.Allowed {
+		dAtA[i] = 0x40
+		i++
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Wait != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Wait))
+	}
+	return i, nil
+}
+
+func (m *ProvisioningDownlink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProvisioningDownlink) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Port != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Port))
+	}
+	if m.Confirmed {
+		dAtA[i] = 0x10
+		i++
+		if m.Confirmed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Payload) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	if len(m.Fields) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Fields)))
+		i += copy(dAtA[i:], m.Fields)
+	}
+	return i, nil
+}
+
+func (m *BulkPayloadFunctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkPayloadFunctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppIds) > 0 {
+		for _, s := range m.AppIds {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Decoder) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Decoder)))
+		i += copy(dAtA[i:], m.Decoder)
+	}
+	if len(m.Converter) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Converter)))
+		i += copy(dAtA[i:], m.Converter)
+	}
+	if len(m.Validator) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Validator)))
+		i += copy(dAtA[i:], m.Validator)
+	}
+	if len(m.Encoder) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Encoder)))
+		i += copy(dAtA[i:], m.Encoder)
+	}
+	if len(m.Version) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if len(m.IfVersion) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.IfVersion)))
+		i += copy(dAtA[i:], m.IfVersion)
+	}
+	if m.DryRun {
+		dAtA[i] = 0x40
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *BulkPayloadFunctionsResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkPayloadFunctionsResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if m.Success {
+		dAtA[i] = 0x10
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.PreviousVersion) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.PreviousVersion)))
+		i += copy(dAtA[i:], m.PreviousVersion)
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if len(m.Changed) > 0 {
+		for _, s := range m.Changed {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *BulkPayloadFunctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkPayloadFunctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, msg := range m.Results {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *AnomalyDetection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnomalyDetection) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sensitivity != 0 {
+		dAtA[i] = 0xd
+		i++
+		i = encodeFixed32Handler(dAtA, i, uint32(math.Float32bits(float32(m.Sensitivity))))
+	}
+	return i, nil
+}
+
+func (m *ComputedField) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ComputedField) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Expression) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Expression)))
+		i += copy(dAtA[i:], m.Expression)
+	}
+	return i, nil
+}
+
+func (m *OutputPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutputPolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Round {
+		dAtA[i] = 0x8
+		i++
+		if m.Round {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Decimals != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Decimals))
+	}
+	if len(m.RoundingMode) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.RoundingMode)))
+		i += copy(dAtA[i:], m.RoundingMode)
+	}
+	if len(m.SpecialValues) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.SpecialValues)))
+		i += copy(dAtA[i:], m.SpecialValues)
+	}
+	if len(m.FieldCasing) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.FieldCasing)))
+		i += copy(dAtA[i:], m.FieldCasing)
+	}
+	if len(m.Replacement) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Replacement)))
+		i += copy(dAtA[i:], m.Replacement)
+	}
+	if m.ProtectReserved {
+		dAtA[i] = 0x38
+		i++
+		if m.ProtectReserved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Aggregation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Aggregation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Window))
+	}
+	return i, nil
+}
+
+func (m *MQTTCredentials) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MQTTCredentials) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Username) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Username)))
+		i += copy(dAtA[i:], m.Username)
+	}
+	if len(m.Password) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Password)))
+		i += copy(dAtA[i:], m.Password)
+	}
+	if len(m.Collaborator) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Collaborator)))
+		i += copy(dAtA[i:], m.Collaborator)
+	}
+	if len(m.Read) > 0 {
+		for _, s := range m.Read {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Write) > 0 {
+		for _, s := range m.Write {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *MQTTCredentialsList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MQTTCredentialsList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Credentials) > 0 {
+		for _, msg := range m.Credentials {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ErasureReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErasureReport) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.DevId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevId)))
+		i += copy(dAtA[i:], m.DevId)
+	}
+	if len(m.DevEui) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevEui)))
+		i += copy(dAtA[i:], m.DevEui)
+	}
+	if m.ErasedAt != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.ErasedAt))
+	}
+	if m.Uplinks != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Uplinks))
+	}
+	if m.Downlinks != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Downlinks))
+	}
+	if len(m.Erased) > 0 {
+		for _, s := range m.Erased {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *MutationResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MutationResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		dAtA[i] = 0x8
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Created {
+		dAtA[i] = 0x10
+		i++
+		if m.Created {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Changed) > 0 {
+		for _, s := range m.Changed {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Revision != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Revision))
+	}
+	return i, nil
+}
+
+func (m *MICCheckRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MICCheckRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.DevId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevId)))
+		i += copy(dAtA[i:], m.DevId)
+	}
+	if len(m.Payload) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	return i, nil
+}
+
+func (m *MICCheckResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MICCheckResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageType) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.MessageType)))
+		i += copy(dAtA[i:], m.MessageType)
+	}
+	if len(m.DevAddr) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevAddr)))
+		i += copy(dAtA[i:], m.DevAddr)
+	}
+	if m.FCnt != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.FCnt))
+	}
+	if m.DeviceFCnt != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.DeviceFCnt))
+	}
+	if len(m.ReceivedMic) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.ReceivedMic)))
+		i += copy(dAtA[i:], m.ReceivedMic)
+	}
+	if len(m.ExpectedMic) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.ExpectedMic)))
+		i += copy(dAtA[i:], m.ExpectedMic)
+	}
+	if m.Valid {
+		dAtA[i] = 0x38
+		i++
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Mismatch) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Mismatch)))
+		i += copy(dAtA[i:], m.Mismatch)
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	return i, nil
+}
+
+func (m *WatchDevicesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchDevicesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.ResumeToken) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.ResumeToken)))
+		i += copy(dAtA[i:], m.ResumeToken)
+	}
+	return i, nil
+}
+
+func (m *DeviceChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceChange) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.DevId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevId)))
+		i += copy(dAtA[i:], m.DevId)
+	}
+	if m.Time != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Time))
+	}
+	if m.Device != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Device.Size()))
+		n101, err := m.Device.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n101
+	}
+	if len(m.ResumeToken) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.ResumeToken)))
+		i += copy(dAtA[i:], m.ResumeToken)
+	}
+	return i, nil
+}
+
+func (m *DeviceDebugModeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceDebugModeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.DevId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevId)))
+		i += copy(dAtA[i:], m.DevId)
+	}
+	if m.Duration != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Duration))
+	}
+	return i, nil
+}
+
+func (m *DebugTraceEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DebugTraceEntry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Time != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Time))
+	}
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x22
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovHandler(uint64(len(k))) + 1 + len(v) + sovHandler(uint64(len(v)))
+			i = encodeVarintHandler(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *DeviceDebugTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceDebugTrace) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.DevId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevId)))
+		i += copy(dAtA[i:], m.DevId)
+	}
+	if m.DebugUntil != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.DebugUntil))
+	}
+	if len(m.Entries) > 0 {
+		for _, msg := range m.Entries {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DeviceStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.DevId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevId)))
+		i += copy(dAtA[i:], m.DevId)
+	}
+	if m.Time != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Time))
+	}
+	return i, nil
+}
+
+func (m *DeviceState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceState) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Time != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Time))
+	}
+	if len(m.Changed) > 0 {
+		for _, s := range m.Changed {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Device != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Device.Size()))
+		n101, err := m.Device.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n101
+	}
+	return i, nil
+}
+
+func (m *DeviceTwinRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceTwinRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.DevId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevId)))
+		i += copy(dAtA[i:], m.DevId)
+	}
+	if len(m.DesiredFields) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DesiredFields)))
+		i += copy(dAtA[i:], m.DesiredFields)
+	}
+	if m.FPort != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.FPort))
+	}
+	return i, nil
+}
+
+func (m *DeviceTwin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceTwin) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.DevId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevId)))
+		i += copy(dAtA[i:], m.DevId)
+	}
+	if m.FPort != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.FPort))
+	}
+	if len(m.DesiredFields) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DesiredFields)))
+		i += copy(dAtA[i:], m.DesiredFields)
+	}
+	if len(m.ReportedFields) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.ReportedFields)))
+		i += copy(dAtA[i:], m.ReportedFields)
+	}
+	if m.InSync {
+		dAtA[i] = 0x30
+		i++
+		if m.InSync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Pending) > 0 {
+		for _, s := range m.Pending {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.DesiredAt != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.DesiredAt))
+	}
+	if m.ReportedAt != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.ReportedAt))
+	}
+	if m.PushedAt != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.PushedAt))
+	}
+	return i, nil
+}
+
+func (m *CommandRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommandRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.DevId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevId)))
+		i += copy(dAtA[i:], m.DevId)
+	}
+	if m.Port != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Port))
+	}
+	if m.Confirmed {
+		dAtA[i] = 0x20
+		i++
+		if m.Confirmed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Payload) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	if len(m.Fields) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Fields)))
+		i += copy(dAtA[i:], m.Fields)
+	}
+	if m.ResponsePort != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.ResponsePort))
+	}
+	if len(m.CorrelationField) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.CorrelationField)))
+		i += copy(dAtA[i:], m.CorrelationField)
+	}
+	if m.Timeout != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Timeout))
+	}
+	return i, nil
+}
+
+func (m *CommandResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommandResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.CorrelationId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.CorrelationId)))
+		i += copy(dAtA[i:], m.CorrelationId)
+	}
+	if m.ServerTime != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.ServerTime))
+	}
+	if m.Port != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Port))
+	}
+	if m.Counter != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Counter))
+	}
+	if len(m.PayloadRaw) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.PayloadRaw)))
+		i += copy(dAtA[i:], m.PayloadRaw)
+	}
+	if len(m.Fields) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Fields)))
+		i += copy(dAtA[i:], m.Fields)
+	}
+	return i, nil
+}
+
+func (m *PortFunctions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PortFunctions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MinPort != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.MinPort))
+	}
+	if m.MaxPort != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.MaxPort))
+	}
+	if len(m.Decoder) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Decoder)))
+		i += copy(dAtA[i:], m.Decoder)
+	}
+	if len(m.Converter) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Converter)))
+		i += copy(dAtA[i:], m.Converter)
+	}
+	if len(m.Validator) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Validator)))
+		i += copy(dAtA[i:], m.Validator)
+	}
+	if len(m.Encoder) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Encoder)))
+		i += copy(dAtA[i:], m.Encoder)
+	}
+	return i, nil
+}
+
+func (m *TestUplinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TestUplinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.Payload) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	if m.Port != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Port))
+	}
+	return i, nil
+}
+
+func (m *DeviceProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceProfile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.LorawanVersion) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.LorawanVersion)))
+		i += copy(dAtA[i:], m.LorawanVersion)
+	}
+	if len(m.Vendor) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Vendor)))
+		i += copy(dAtA[i:], m.Vendor)
+	}
+	if len(m.CertificationId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.CertificationId)))
+		i += copy(dAtA[i:], m.CertificationId)
+	}
+	if len(m.BrandId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.BrandId)))
+		i += copy(dAtA[i:], m.BrandId)
+	}
+	if len(m.ModelId) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.ModelId)))
+		i += copy(dAtA[i:], m.ModelId)
+	}
+	if len(m.FirmwareVersion) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.FirmwareVersion)))
+		i += copy(dAtA[i:], m.FirmwareVersion)
+	}
+	return i, nil
+}
+
+func (m *ComplianceGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ComplianceGroup) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.LorawanVersion) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.LorawanVersion)))
+		i += copy(dAtA[i:], m.LorawanVersion)
+	}
+	if len(m.RegionalParameters) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.RegionalParameters)))
+		i += copy(dAtA[i:], m.RegionalParameters)
+	}
+	if len(m.FrequencyPlan) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.FrequencyPlan)))
+		i += copy(dAtA[i:], m.FrequencyPlan)
+	}
+	if m.Devices != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Devices))
+	}
+	if m.Certified != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Certified))
+	}
+	return i, nil
+}
+
+func (m *ComplianceReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ComplianceReport) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if m.Devices != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Devices))
+	}
+	if len(m.Groups) > 0 {
+		for _, msg := range m.Groups {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DownlinkDelivery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DownlinkDelivery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Acked != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Acked))
+	}
+	if m.Missed != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Missed))
+	}
+	if m.ConsecutiveMissed != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.ConsecutiveMissed))
+	}
+	return i, nil
+}
+
+func (m *PayloadFunctionsRevision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadFunctionsRevision) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Revision))
+	}
+	if m.Time != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Time))
+	}
+	if len(m.Decoder) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Decoder)))
+		i += copy(dAtA[i:], m.Decoder)
+	}
+	if len(m.Converter) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Converter)))
+		i += copy(dAtA[i:], m.Converter)
+	}
+	if len(m.Validator) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Validator)))
+		i += copy(dAtA[i:], m.Validator)
+	}
+	if len(m.Encoder) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Encoder)))
+		i += copy(dAtA[i:], m.Encoder)
+	}
+	if len(m.PortFunctions) > 0 {
+		for _, msg := range m.PortFunctions {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.DownlinkDecoder) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DownlinkDecoder)))
+		i += copy(dAtA[i:], m.DownlinkDecoder)
+	}
+	if len(m.PayloadFormat) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.PayloadFormat)))
+		i += copy(dAtA[i:], m.PayloadFormat)
+	}
+	if len(m.WasmModule) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.WasmModule)))
+		i += copy(dAtA[i:], m.WasmModule)
+	}
+	if len(m.PayloadFunctionsVersion) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.PayloadFunctionsVersion)))
+		i += copy(dAtA[i:], m.PayloadFunctionsVersion)
+	}
+	if len(m.Codec) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Codec)))
+		i += copy(dAtA[i:], m.Codec)
+	}
+	if len(m.BinaryFields) > 0 {
+		for _, msg := range m.BinaryFields {
+			dAtA[i] = 0x6a
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.FunctionsLanguage) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.FunctionsLanguage)))
+		i += copy(dAtA[i:], m.FunctionsLanguage)
+	}
+	if len(m.ProtobufDescriptor) > 0 {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.ProtobufDescriptor)))
+		i += copy(dAtA[i:], m.ProtobufDescriptor)
+	}
+	if len(m.ProtobufMessage) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.ProtobufMessage)))
+		i += copy(dAtA[i:], m.ProtobufMessage)
+	}
+	if len(m.GoCodec) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.GoCodec)))
+		i += copy(dAtA[i:], m.GoCodec)
+	}
+	if len(m.Normalizer) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Normalizer)))
+		i += copy(dAtA[i:], m.Normalizer)
+	}
+	return i, nil
+}
+
+func (m *PayloadFunctionsRevisionList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadFunctionsRevisionList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if m.ActiveRevision != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.ActiveRevision))
+	}
+	if len(m.Revisions) > 0 {
+		for _, msg := range m.Revisions {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *PayloadFunctionsRollbackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadFunctionsRollbackRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if m.Revision != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Revision))
+	}
+	if m.DryRun {
+		dAtA[i] = 0x18
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Codec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Codec) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if len(m.Decoder) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Decoder)))
+		i += copy(dAtA[i:], m.Decoder)
+	}
+	if len(m.Converter) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Converter)))
+		i += copy(dAtA[i:], m.Converter)
+	}
+	if len(m.Validator) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Validator)))
+		i += copy(dAtA[i:], m.Validator)
+	}
+	if len(m.Encoder) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Encoder)))
+		i += copy(dAtA[i:], m.Encoder)
+	}
+	if len(m.Version) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if m.Revision != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Revision))
+	}
+	if len(m.AppIds) > 0 {
+		for _, s := range m.AppIds {
+			dAtA[i] = 0x4a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *CodecIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodecIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *CodecList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodecList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Codecs) > 0 {
+		for _, msg := range m.Codecs {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ReplayArchiveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayArchiveRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Since != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Since))
+	}
+	if m.Until != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Until))
+	}
+	if len(m.AppIds) > 0 {
+		for _, s := range m.AppIds {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *ReplayArchiveResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayArchiveResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NumFiles != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.NumFiles))
+	}
+	if m.NumUplinks != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.NumUplinks))
+	}
+	if m.NumReplayed != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.NumReplayed))
+	}
+	return i, nil
+}
+
+func (m *BinaryField) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BinaryField) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Offset))
+	}
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if m.LittleEndian {
+		dAtA[i] = 0x20
+		i++
+		if m.LittleEndian {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Scale != 0 {
+		dAtA[i] = 0x29
+		i++
+		i = encodeFixed64Handler(dAtA, i, uint64(math.Float64bits(float64(m.Scale))))
+	}
+	return i, nil
+}
+
+func (m *InjectedUplinkMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InjectedUplinkMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.DevId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevId)))
+		i += copy(dAtA[i:], m.DevId)
+	}
+	if m.Port != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Port))
+	}
+	if len(m.Fields) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Fields)))
+		i += copy(dAtA[i:], m.Fields)
+	}
+	return i, nil
+}
+
+func (m *RawUplink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RawUplink) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Port != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Port))
+	}
+	if len(m.Payload) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	if m.Time != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Time))
+	}
+	return i, nil
+}
+
+func (m *RedecodeUplinksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedecodeUplinksRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.DevId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevId)))
+		i += copy(dAtA[i:], m.DevId)
+	}
+	if len(m.Uplinks) > 0 {
+		for _, msg := range m.Uplinks {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *RedecodedUplink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedecodedUplink) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Port != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Port))
+	}
+	if m.Time != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Time))
+	}
+	if len(m.Fields) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Fields)))
+		i += copy(dAtA[i:], m.Fields)
+	}
+	if m.Valid {
+		dAtA[i] = 0x20
+		i++
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *RedecodeUplinksResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedecodeUplinksResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Uplinks) > 0 {
+		for _, msg := range m.Uplinks {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ExportApplicationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportApplicationRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if m.Cutover {
+		dAtA[i] = 0x10
+		i++
+		if m.Cutover {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *DeviceTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceTransfer) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.DevId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevId)))
+		i += copy(dAtA[i:], m.DevId)
+	}
+	if len(m.Device) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Device)))
+		i += copy(dAtA[i:], m.Device)
+	}
+	if len(m.DownlinkQueue) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DownlinkQueue)))
+		i += copy(dAtA[i:], m.DownlinkQueue)
+	}
+	return i, nil
+}
+
+func (m *ApplicationTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationTransfer) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.Application) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Application)))
+		i += copy(dAtA[i:], m.Application)
+	}
+	if len(m.Devices) > 0 {
+		for _, msg := range m.Devices {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Cutover != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Cutover))
+	}
+	if m.Activate {
+		dAtA[i] = 0x28
+		i++
+		if m.Activate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Webhook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Webhook) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.WebhookId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.WebhookId)))
+		i += copy(dAtA[i:], m.WebhookId)
+	}
+	if len(m.Url) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Url)))
+		i += copy(dAtA[i:], m.Url)
+	}
+	if len(m.Headers) > 0 {
+		for k, _ := range m.Headers {
+			dAtA[i] = 0x22
+			i++
+			v := m.Headers[k]
+			mapSize := 1 + len(k) + sovHandler(uint64(len(k))) + 1 + len(v) + sovHandler(uint64(len(v)))
+			i = encodeVarintHandler(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.MessageTypes) > 0 {
+		for _, s := range m.MessageTypes {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *WebhookIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.WebhookId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.WebhookId)))
+		i += copy(dAtA[i:], m.WebhookId)
+	}
+	return i, nil
+}
+
+func (m *WebhookList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Webhooks) > 0 {
+		for _, msg := range m.Webhooks {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DeletedDevice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletedDevice) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.AppId)))
+		i += copy(dAtA[i:], m.AppId)
+	}
+	if len(m.DevId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.DevId)))
+		i += copy(dAtA[i:], m.DevId)
+	}
+	if m.DeletedAt != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.DeletedAt))
+	}
+	if m.PurgeAt != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.PurgeAt))
+	}
+	return i, nil
+}
+
+func (m *DeletedDeviceList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletedDeviceList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Devices) > 0 {
+		for _, msg := range m.Devices {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func encodeFixed64Handler(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Handler(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintHandler(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *DeviceActivationResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.DownlinkOption != nil {
+		l = m.DownlinkOption.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.ActivationMetadata != nil {
+		l = m.ActivationMetadata.Size()
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	if m.Trace != nil {
+		l = m.Trace.Size()
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *StatusRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Status) Size() (n int) {
+	var l int
+	_ = l
+	if m.System != nil {
+		l = m.System.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Component != nil {
+		l = m.Component.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Uplink != nil {
+		l = m.Uplink.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Downlink != nil {
+		l = m.Downlink.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Activations != nil {
+		l = m.Activations.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationIdentifier) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *Application) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Decoder)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Converter)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Encoder)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.JoinHook)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.ProvisioningDownlink != nil {
+		l = m.ProvisioningDownlink.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.PayloadFunctionsVersion)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.AnomalyDetection != nil {
+		l = m.AnomalyDetection.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if len(m.ComputedFields) > 0 {
+		for _, e := range m.ComputedFields {
+			l = e.Size()
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	if m.OutputPolicy != nil {
+		l = m.OutputPolicy.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Aggregation != nil {
+		l = m.Aggregation.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.IntegrationFormat)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.RetentionDays != 0 {
+		n += 1 + sovHandler(uint64(m.RetentionDays))
+	}
+	if len(m.SensitiveFields) > 0 {
+		for _, s := range m.SensitiveFields {
+			l = len(s)
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	if m.DryRun {
+		n += 3
+	}
+	if m.Revision != 0 {
+		n += 2 + sovHandler(uint64(m.Revision))
+	}
+	if len(m.UpdateMask) > 0 {
+		for _, s := range m.UpdateMask {
+			l = len(s)
+			n += 2 + l + sovHandler(uint64(l))
+		}
+	}
+	l = len(m.PayloadFormat)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	if m.FunctionTimeout != 0 {
+		n += 2 + sovHandler(uint64(m.FunctionTimeout))
+	}
+	l = len(m.WasmModule)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	if len(m.PortFunctions) > 0 {
+		for _, e := range m.PortFunctions {
+			l = e.Size()
+			n += 2 + l + sovHandler(uint64(l))
+		}
+	}
+	l = len(m.DownlinkDecoder)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DevAddrAllocation)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	if m.FunctionsRevision != 0 {
+		n += 2 + sovHandler(uint64(m.FunctionsRevision))
+	}
+	l = len(m.Codec)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	if len(m.BinaryFields) > 0 {
+		for _, e := range m.BinaryFields {
+			l = e.Size()
+			n += 2 + l + sovHandler(uint64(l))
+		}
+	}
+	l = len(m.FunctionsLanguage)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	l = len(m.ProtobufDescriptor)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	l = len(m.ProtobufMessage)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	l = len(m.GoCodec)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DataResidency)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	if m.StatefulDecoding {
+		n += 3
+	}
+	l = len(m.Normalizer)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	if len(m.MaintenanceWindows) > 0 {
+		for _, e := range m.MaintenanceWindows {
+			l = e.Size()
+			n += 2 + l + sovHandler(uint64(l))
+		}
+	}
+	if m.MaxOutputFields != 0 {
+		n += 2 + sovHandler(uint64(m.MaxOutputFields))
+	}
+	if m.MaxOutputDepth != 0 {
+		n += 2 + sovHandler(uint64(m.MaxOutputDepth))
+	}
+	if m.MaxOutputSize != 0 {
+		n += 2 + sovHandler(uint64(m.MaxOutputSize))
+	}
+	return n
+}
+
+func (m *DeviceIdentifier) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DevId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *Device) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DevId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Device != nil {
+		n += m.Device.Size()
+	}
+	if m.Latitude != 0 {
+		n += 5
+	}
+	if m.Longitude != 0 {
+		n += 5
+	}
+	if m.Altitude != 0 {
+		n += 1 + sovHandler(uint64(m.Altitude))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovHandler(uint64(len(k))) + 1 + len(v) + sovHandler(uint64(len(v)))
+			n += mapEntrySize + 2 + sovHandler(uint64(mapEntrySize))
+		}
+	}
+	if m.MaxDownlinkPayloadSize != 0 {
+		n += 2 + sovHandler(uint64(m.MaxDownlinkPayloadSize))
+	}
+	l = len(m.DownlinkDataRate)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	if m.DryRun {
+		n += 3
+	}
+	if m.Revision != 0 {
+		n += 2 + sovHandler(uint64(m.Revision))
+	}
+	if len(m.UpdateMask) > 0 {
+		for _, s := range m.UpdateMask {
+			l = len(s)
+			n += 2 + l + sovHandler(uint64(l))
+		}
+	}
+	if m.Profile != nil {
+		l = m.Profile.Size()
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	if m.DownlinkDelivery != nil {
+		l = m.DownlinkDelivery.Size()
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	l = len(m.PayloadFormat)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Codec)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	l = len(m.GoCodec)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *Device_LorawanDevice) Size() (n int) {
+	var l int
+	_ = l
+	if m.LorawanDevice != nil {
+		l = m.LorawanDevice.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+func (m *DeviceList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Devices) > 0 {
+		for _, e := range m.Devices {
+			l = e.Size()
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DryDownlinkMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Fields)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.App != nil {
+		l = m.App.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovHandler(uint64(m.Port))
+	}
+	return n
+}
+
+func (m *DryUplinkMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.App != nil {
+		l = m.App.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovHandler(uint64(m.Port))
+	}
+	return n
+}
+
+func (m *SimulatedUplinkMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DevId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovHandler(uint64(m.Port))
+	}
+	return n
+}
+
+func (m *LogEntry) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Function)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DryUplinkResult) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Fields)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Valid {
+		n += 2
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *DryDownlinkResult) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeviceUplink) Size() (n int) {
+	var l int
+	_ = l
+	if m.ServerTime != 0 {
+		n += 1 + sovHandler(uint64(m.ServerTime))
+	}
+	if m.Port != 0 {
+		n += 1 + sovHandler(uint64(m.Port))
+	}
+	if m.Counter != 0 {
+		n += 1 + sovHandler(uint64(m.Counter))
+	}
+	if m.Confirmed {
+		n += 2
+	}
+	l = len(m.PayloadRaw)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.ProtocolMetadata != nil {
+		l = m.ProtocolMetadata.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if len(m.GatewayMetadata) > 0 {
+		for _, e := range m.GatewayMetadata {
+			l = e.Size()
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeviceUplinkList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Uplinks) > 0 {
+		for _, e := range m.Uplinks {
+			l = e.Size()
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReplayUplinksRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DevId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Since != 0 {
+		n += 1 + sovHandler(uint64(m.Since))
+	}
+	if m.Until != 0 {
+		n += 1 + sovHandler(uint64(m.Until))
+	}
+	return n
+}
+
+func (m *DownlinkPreviewRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DevId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Fields)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovHandler(uint64(m.Port))
+	}
+	return n
+}
+
+func (m *DownlinkPreview) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.GatewayId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.RxWindow != 0 {
+		n += 1 + sovHandler(uint64(m.RxWindow))
+	}
+	if m.Frequency != 0 {
+		n += 1 + sovHandler(uint64(m.Frequency))
+	}
+	l = len(m.DataRate)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Airtime != 0 {
+		n += 1 + sovHandler(uint64(m.Airtime))
+	}
+	if m.DutyCycle != 0 {
+		n += 5
+	}
+	if m.Allowed {
+		n += 2
+	}
+	if m.Wait != 0 {
+		n += 1 + sovHandler(uint64(m.Wait))
+	}
+	return n
+}
+
+func (m *ProvisioningDownlink) Size() (n int) {
+	var l int
+	_ = l
+	if m.Port != 0 {
+		n += 1 + sovHandler(uint64(m.Port))
+	}
+	if m.Confirmed {
+		n += 2
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Fields)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *BulkPayloadFunctionsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.AppIds) > 0 {
+		for _, s := range m.AppIds {
+			l = len(s)
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	l = len(m.Decoder)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Converter)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Encoder)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.IfVersion)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *BulkPayloadFunctionsResult) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.PreviousVersion)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if len(m.Changed) > 0 {
+		for _, s := range m.Changed {
+			l = len(s)
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BulkPayloadFunctionsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AnomalyDetection) Size() (n int) {
+	var l int
+	_ = l
+	if m.Sensitivity != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *ComputedField) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Expression)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *OutputPolicy) Size() (n int) {
+	var l int
+	_ = l
+	if m.Round {
+		n += 2
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovHandler(uint64(m.Decimals))
+	}
+	l = len(m.RoundingMode)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.SpecialValues)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.FieldCasing)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Replacement)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.ProtectReserved {
+		n += 2
+	}
+	return n
+}
+
+func (m *Aggregation) Size() (n int) {
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovHandler(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *MQTTCredentials) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Collaborator)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if len(m.Read) > 0 {
+		for _, s := range m.Read {
+			l = len(s)
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	if len(m.Write) > 0 {
+		for _, s := range m.Write {
+			l = len(s)
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MQTTCredentialsList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Credentials) > 0 {
+		for _, e := range m.Credentials {
+			l = e.Size()
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ErasureReport) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DevId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DevEui)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.ErasedAt != 0 {
+		n += 1 + sovHandler(uint64(m.ErasedAt))
+	}
+	if m.Uplinks != 0 {
+		n += 1 + sovHandler(uint64(m.Uplinks))
+	}
+	if m.Downlinks != 0 {
+		n += 1 + sovHandler(uint64(m.Downlinks))
+	}
+	if len(m.Erased) > 0 {
+		for _, s := range m.Erased {
+			l = len(s)
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MutationResult) Size() (n int) {
+	var l int
+	_ = l
+	if m.DryRun {
+		n += 2
+	}
+	if m.Created {
+		n += 2
+	}
+	if len(m.Changed) > 0 {
+		for _, s := range m.Changed {
+			l = len(s)
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	if m.Revision != 0 {
+		n += 1 + sovHandler(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *MICCheckRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DevId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *MICCheckResult) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.MessageType)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DevAddr)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.FCnt != 0 {
+		n += 1 + sovHandler(uint64(m.FCnt))
+	}
+	if m.DeviceFCnt != 0 {
+		n += 1 + sovHandler(uint64(m.DeviceFCnt))
+	}
+	l = len(m.ReceivedMic)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.ExpectedMic)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.Mismatch)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *WatchDevicesRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.ResumeToken)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *DeviceChange) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DevId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovHandler(uint64(m.Time))
+	}
+	if m.Device != nil {
+		l = m.Device.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.ResumeToken)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *DeviceDebugModeRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DevId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovHandler(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *DebugTraceEntry) Size() (n int) {
+	var l int
+	_ = l
+	if m.Time != 0 {
+		n += 1 + sovHandler(uint64(m.Time))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovHandler(uint64(len(k))) + 1 + len(v) + sovHandler(uint64(len(v)))
+			n += mapEntrySize + 1 + sovHandler(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *DeviceDebugTrace) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DevId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.DebugUntil != 0 {
+		n += 1 + sovHandler(uint64(m.DebugUntil))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeviceStateRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DevId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovHandler(uint64(m.Time))
+	}
+	return n
+}
+
+func (m *DeviceState) Size() (n int) {
+	var l int
+	_ = l
+	if m.Time != 0 {
+		n += 1 + sovHandler(uint64(m.Time))
+	}
+	if len(m.Changed) > 0 {
+		for _, s := range m.Changed {
+			l = len(s)
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	if m.Device != nil {
+		l = m.Device.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *DeviceTwinRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DevId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DesiredFields)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.FPort != 0 {
+		n += 1 + sovHandler(uint64(m.FPort))
+	}
+	return n
+}
+
+func (m *DeviceTwin) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DevId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.FPort != 0 {
+		n += 1 + sovHandler(uint64(m.FPort))
+	}
+	l = len(m.DesiredFields)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.ReportedFields)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.InSync {
+		n += 2
+	}
+	if len(m.Pending) > 0 {
+		for _, s := range m.Pending {
+			l = len(s)
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	if m.DesiredAt != 0 {
+		n += 1 + sovHandler(uint64(m.DesiredAt))
+	}
+	if m.ReportedAt != 0 {
+		n += 1 + sovHandler(uint64(m.ReportedAt))
+	}
+	if m.PushedAt != 0 {
+		n += 1 + sovHandler(uint64(m.PushedAt))
+	}
+	return n
+}
+
+func (m *CommandRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DevId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovHandler(uint64(m.Port))
+	}
+	if m.Confirmed {
+		n += 2
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Fields)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.ResponsePort != 0 {
+		n += 1 + sovHandler(uint64(m.ResponsePort))
+	}
+	l = len(m.CorrelationField)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovHandler(uint64(m.Timeout))
+	}
+	return n
+}
+
+func (m *CommandResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.CorrelationId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.ServerTime != 0 {
+		n += 1 + sovHandler(uint64(m.ServerTime))
+	}
+	if m.Port != 0 {
+		n += 1 + sovHandler(uint64(m.Port))
+	}
+	if m.Counter != 0 {
+		n += 1 + sovHandler(uint64(m.Counter))
+	}
+	l = len(m.PayloadRaw)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Fields)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *PortFunctions) Size() (n int) {
+	var l int
+	_ = l
+	if m.MinPort != 0 {
+		n += 1 + sovHandler(uint64(m.MinPort))
+	}
+	if m.MaxPort != 0 {
+		n += 1 + sovHandler(uint64(m.MaxPort))
+	}
+	l = len(m.Decoder)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Converter)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Encoder)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *TestUplinkRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovHandler(uint64(m.Port))
+	}
+	return n
+}
+
+func (m *DeviceProfile) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.LorawanVersion)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Vendor)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.CertificationId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.BrandId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.ModelId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.FirmwareVersion)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *ComplianceGroup) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.LorawanVersion)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.RegionalParameters)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.FrequencyPlan)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Devices != 0 {
+		n += 1 + sovHandler(uint64(m.Devices))
+	}
+	if m.Certified != 0 {
+		n += 1 + sovHandler(uint64(m.Certified))
+	}
+	return n
+}
+
+func (m *ComplianceReport) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Devices != 0 {
+		n += 1 + sovHandler(uint64(m.Devices))
+	}
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DownlinkDelivery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Acked != 0 {
+		n += 1 + sovHandler(uint64(m.Acked))
+	}
+	if m.Missed != 0 {
+		n += 1 + sovHandler(uint64(m.Missed))
+	}
+	if m.ConsecutiveMissed != 0 {
+		n += 1 + sovHandler(uint64(m.ConsecutiveMissed))
+	}
+	return n
+}
+
+func (m *PayloadFunctionsRevision) Size() (n int) {
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		n += 1 + sovHandler(uint64(m.Revision))
+	}
+	if m.Time != 0 {
+		n += 1 + sovHandler(uint64(m.Time))
+	}
+	l = len(m.Decoder)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Converter)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Encoder)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if len(m.PortFunctions) > 0 {
+		for _, e := range m.PortFunctions {
+			l = e.Size()
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	l = len(m.DownlinkDecoder)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.PayloadFormat)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.WasmModule)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.PayloadFunctionsVersion)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Codec)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if len(m.BinaryFields) > 0 {
+		for _, e := range m.BinaryFields {
+			l = e.Size()
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	l = len(m.FunctionsLanguage)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.ProtobufDescriptor)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.ProtobufMessage)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	l = len(m.GoCodec)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Normalizer)
+	if l > 0 {
+		n += 2 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *PayloadFunctionsRevisionList) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.ActiveRevision != 0 {
+		n += 1 + sovHandler(uint64(m.ActiveRevision))
+	}
+	if len(m.Revisions) > 0 {
+		for _, e := range m.Revisions {
+			l = e.Size()
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PayloadFunctionsRollbackRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovHandler(uint64(m.Revision))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *Codec) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Decoder)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Converter)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Encoder)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovHandler(uint64(m.Revision))
+	}
+	if len(m.AppIds) > 0 {
+		for _, s := range m.AppIds {
+			l = len(s)
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CodecIdentifier) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *CodecList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Codecs) > 0 {
+		for _, e := range m.Codecs {
+			l = e.Size()
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReplayArchiveRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Since != 0 {
+		n += 1 + sovHandler(uint64(m.Since))
+	}
+	if m.Until != 0 {
+		n += 1 + sovHandler(uint64(m.Until))
+	}
+	if len(m.AppIds) > 0 {
+		for _, s := range m.AppIds {
+			l = len(s)
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReplayArchiveResult) Size() (n int) {
+	var l int
+	_ = l
+	if m.NumFiles != 0 {
+		n += 1 + sovHandler(uint64(m.NumFiles))
+	}
+	if m.NumUplinks != 0 {
+		n += 1 + sovHandler(uint64(m.NumUplinks))
+	}
+	if m.NumReplayed != 0 {
+		n += 1 + sovHandler(uint64(m.NumReplayed))
+	}
+	return n
+}
+
+func (m *BinaryField) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovHandler(uint64(m.Offset))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.LittleEndian {
+		n += 2
+	}
+	if m.Scale != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *InjectedUplinkMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DevId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovHandler(uint64(m.Port))
+	}
+	l = len(m.Fields)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *RawUplink) Size() (n int) {
+	var l int
+	_ = l
+	if m.Port != 0 {
+		n += 1 + sovHandler(uint64(m.Port))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovHandler(uint64(m.Time))
+	}
+	return n
+}
+
+func (m *RedecodeUplinksRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DevId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if len(m.Uplinks) > 0 {
+		for _, e := range m.Uplinks {
+			l = e.Size()
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RedecodedUplink) Size() (n int) {
+	var l int
+	_ = l
+	if m.Port != 0 {
+		n += 1 + sovHandler(uint64(m.Port))
+	}
+	if m.Time != 0 {
+		n += 1 + sovHandler(uint64(m.Time))
+	}
+	l = len(m.Fields)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *RedecodeUplinksResult) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Uplinks) > 0 {
+		for _, e := range m.Uplinks {
+			l = e.Size()
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExportApplicationRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Cutover {
+		n += 2
+	}
+	return n
+}
+
+func (m *DeviceTransfer) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.DevId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Device)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DownlinkQueue)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationTransfer) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Application)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if len(m.Devices) > 0 {
+		for _, e := range m.Devices {
+			l = e.Size()
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	if m.Cutover != 0 {
+		n += 1 + sovHandler(uint64(m.Cutover))
+	}
+	if m.Activate {
+		n += 2
+	}
+	return n
+}
+
+func (m *Webhook) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.WebhookId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovHandler(uint64(len(k))) + 1 + len(v) + sovHandler(uint64(len(v)))
+			n += mapEntrySize + 1 + sovHandler(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MessageTypes) > 0 {
+		for _, s := range m.MessageTypes {
+			l = len(s)
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WebhookIdentifier) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.WebhookId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *WebhookList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Webhooks) > 0 {
+		for _, e := range m.Webhooks {
+			l = e.Size()
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeletedDevice) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.DevId)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.DeletedAt != 0 {
+		n += 1 + sovHandler(uint64(m.DeletedAt))
+	}
+	if m.PurgeAt != 0 {
+		n += 1 + sovHandler(uint64(m.PurgeAt))
+	}
+	return n
+}
+
+func (m *DeletedDeviceList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Devices) > 0 {
+		for _, e := range m.Devices {
+			l = e.Size()
+			n += 1 + l + sovHandler(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovHandler(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozHandler(x uint64) (n int) {
+	return sovHandler(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DeviceActivationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceActivationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceActivationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &protocol.Message{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkOption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkOption == nil {
+				m.DownlinkOption = &broker.DownlinkOption{}
+			}
+			if err := m.DownlinkOption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivationMetadata == nil {
+				m.ActivationMetadata = &protocol.ActivationMetadata{}
+			}
+			if err := m.ActivationMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Trace == nil {
+				m.Trace = &trace.Trace{}
+			}
+			if err := m.Trace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Status) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Status: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field System", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.System == nil {
+				m.System = &api.SystemStats{}
+			}
+			if err := m.System.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Component == nil {
+				m.Component = &api.ComponentStats{}
+			}
+			if err := m.Component.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uplink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Uplink == nil {
+				m.Uplink = &api.Rates{}
+			}
+			if err := m.Uplink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Downlink == nil {
+				m.Downlink = &api.Rates{}
+			}
+			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Activations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Activations == nil {
+				m.Activations = &api.Rates{}
+			}
+			if err := m.Activations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Application) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Application: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Application: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decoder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decoder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Converter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Converter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinHook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JoinHook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisioningDownlink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProvisioningDownlink == nil {
+				m.ProvisioningDownlink = &ProvisioningDownlink{}
+			}
+			if err := m.ProvisioningDownlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadFunctionsVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadFunctionsVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnomalyDetection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AnomalyDetection == nil {
+				m.AnomalyDetection = &AnomalyDetection{}
+			}
+			if err := m.AnomalyDetection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputedFields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComputedFields = append(m.ComputedFields, &ComputedField{})
+			if err := m.ComputedFields[len(m.ComputedFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutputPolicy == nil {
+				m.OutputPolicy = &OutputPolicy{}
+			}
+			if err := m.OutputPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Aggregation == nil {
+				m.Aggregation = &Aggregation{}
+			}
+			if err := m.Aggregation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegrationFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntegrationFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionDays", wireType)
+			}
+			m.RetentionDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetentionDays |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SensitiveFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SensitiveFields = append(m.SensitiveFields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMask", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateMask = append(m.UpdateMask, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionTimeout", wireType)
+			}
+			m.FunctionTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FunctionTimeout |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmModule", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WasmModule = append(m.WasmModule[:0], dAtA[iNdEx:postIndex]...)
+			if m.WasmModule == nil {
+				m.WasmModule = []byte{}
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortFunctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortFunctions = append(m.PortFunctions, &PortFunctions{})
+			if err := m.PortFunctions[len(m.PortFunctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkDecoder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DownlinkDecoder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddrAllocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevAddrAllocation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionsRevision", wireType)
+			}
+			m.FunctionsRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FunctionsRevision |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryFields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryFields = append(m.BinaryFields, &BinaryField{})
+			if err := m.BinaryFields[len(m.BinaryFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionsLanguage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunctionsLanguage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtobufDescriptor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtobufDescriptor = append(m.ProtobufDescriptor[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProtobufDescriptor == nil {
+				m.ProtobufDescriptor = []byte{}
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtobufMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtobufMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoCodec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoCodec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataResidency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataResidency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatefulDecoding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StatefulDecoding = bool(v != 0)
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Normalizer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Normalizer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaintenanceWindows = append(m.MaintenanceWindows, &api.MaintenanceWindow{})
+			if err := m.MaintenanceWindows[len(m.MaintenanceWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutputFields", wireType)
+			}
+			m.MaxOutputFields = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOutputFields |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutputDepth", wireType)
+			}
+			m.MaxOutputDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOutputDepth |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutputSize", wireType)
+			}
+			m.MaxOutputSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOutputSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Device) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Device: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Device: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LorawanDevice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &lorawan1.Device{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Device = &Device_LorawanDevice{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latitude", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.Latitude = float32(math.Float32frombits(v))
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Longitude", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.Longitude = float32(math.Float32frombits(v))
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Altitude", wireType)
+			}
+			m.Altitude = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Altitude |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHandler
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHandler
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthHandler
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Attributes[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Attributes[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDownlinkPayloadSize", wireType)
+			}
+			m.MaxDownlinkPayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDownlinkPayloadSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkDataRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DownlinkDataRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMask", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateMask = append(m.UpdateMask, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Profile == nil {
+				m.Profile = &DeviceProfile{}
+			}
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkDelivery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkDelivery == nil {
+				m.DownlinkDelivery = &DownlinkDelivery{}
+			}
+			if err := m.DownlinkDelivery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoCodec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoCodec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Devices = append(m.Devices, &Device{})
+			if err := m.Devices[len(m.Devices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DryDownlinkMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DryDownlinkMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DryDownlinkMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.App == nil {
+				m.App = &Application{}
+			}
+			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DryUplinkMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DryUplinkMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DryUplinkMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.App == nil {
+				m.App = &Application{}
+			}
+			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulatedUplinkMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulatedUplinkMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulatedUplinkMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Function = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DryUplinkResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DryUplinkResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DryUplinkResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &LogEntry{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DryDownlinkResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DryDownlinkResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DryDownlinkResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &LogEntry{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceUplink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceUplink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceUplink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerTime", wireType)
+			}
+			m.ServerTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+			}
+			m.Counter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Counter |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Confirmed = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadRaw", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadRaw = append(m.PayloadRaw[:0], dAtA[iNdEx:postIndex]...)
+			if m.PayloadRaw == nil {
+				m.PayloadRaw = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProtocolMetadata == nil {
+				m.ProtocolMetadata = &protocol.RxMetadata{}
+			}
+			if err := m.ProtocolMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayMetadata = append(m.GatewayMetadata, &gateway.RxMetadata{})
+			if err := m.GatewayMetadata[len(m.GatewayMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DeviceUplinkList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceUplinkList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceUplinkList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uplinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uplinks = append(m.Uplinks, &DeviceUplink{})
+			if err := m.Uplinks[len(m.Uplinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ReplayUplinksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplayUplinksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplayUplinksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			m.Since = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Since |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			m.Until = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Until |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DownlinkPreviewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DownlinkPreviewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DownlinkPreviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DownlinkPreview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DownlinkPreview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DownlinkPreview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxWindow", wireType)
+			}
+			m.RxWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RxWindow |= (lorawan1.RxWindow(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+			}
+			m.Frequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Frequency |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Airtime", wireType)
+			}
+			m.Airtime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Airtime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutyCycle", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.DutyCycle = float32(math.Float32frombits(v))
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+			}
+			m.Wait = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Wait |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ProvisioningDownlink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProvisioningDownlink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProvisioningDownlink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Confirmed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *BulkPayloadFunctionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BulkPayloadFunctionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BulkPayloadFunctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppIds = append(m.AppIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decoder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decoder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Converter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Converter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IfVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *BulkPayloadFunctionsResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BulkPayloadFunctionsResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BulkPayloadFunctionsResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changed = append(m.Changed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *BulkPayloadFunctionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BulkPayloadFunctionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BulkPayloadFunctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &BulkPayloadFunctionsResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AnomalyDetection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnomalyDetection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnomalyDetection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sensitivity", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.Sensitivity = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ComputedField) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComputedField: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComputedField: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *OutputPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutputPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutputPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Round = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundingMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoundingMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecialValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecialValues = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldCasing", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FieldCasing = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replacement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replacement = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtectReserved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProtectReserved = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Aggregation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Aggregation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Aggregation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MQTTCredentials) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MQTTCredentials: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MQTTCredentials: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collaborator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collaborator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Read", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Read = append(m.Read, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Write", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Write = append(m.Write, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MQTTCredentialsList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MQTTCredentialsList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MQTTCredentialsList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credentials = append(m.Credentials, &MQTTCredentials{})
+			if err := m.Credentials[len(m.Credentials)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ErasureReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErasureReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErasureReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEui", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevEui = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErasedAt", wireType)
+			}
+			m.ErasedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErasedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uplinks", wireType)
+			}
+			m.Uplinks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uplinks |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlinks", wireType)
+			}
+			m.Downlinks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Downlinks |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erased", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erased = append(m.Erased, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MutationResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MutationResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MutationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Created = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changed = append(m.Changed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MICCheckRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MICCheckRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MICCheckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MICCheckResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MICCheckResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MICCheckResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FCnt", wireType)
+			}
+			m.FCnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FCnt |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceFCnt", wireType)
+			}
+			m.DeviceFCnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviceFCnt |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedMic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceivedMic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedMic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedMic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mismatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mismatch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *WatchDevicesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchDevicesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchDevicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResumeToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DeviceChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Device == nil {
+				m.Device = &Device{}
+			}
+			if err := m.Device.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResumeToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DeviceDebugModeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceDebugModeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceDebugModeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DebugTraceEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DebugTraceEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DebugTraceEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHandler
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHandler
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthHandler
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Metadata[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Metadata[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DeviceDebugTrace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceDebugTrace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceDebugTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebugUntil", wireType)
+			}
+			m.DebugUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DebugUntil |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &DebugTraceEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DeviceStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DeviceState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changed = append(m.Changed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Device == nil {
+				m.Device = &Device{}
+			}
+			if err := m.Device.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DeviceTwinRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceTwinRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceTwinRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DesiredFields = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FPort", wireType)
+			}
+			m.FPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FPort |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DeviceTwin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceTwin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceTwin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FPort", wireType)
+			}
+			m.FPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FPort |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DesiredFields = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportedFields = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InSync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InSync = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredAt", wireType)
+			}
+			m.DesiredAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DesiredAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedAt", wireType)
+			}
+			m.ReportedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PushedAt", wireType)
+			}
+			m.PushedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PushedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CommandRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommandRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommandRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Confirmed = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponsePort", wireType)
+			}
+			m.ResponsePort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponsePort |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorrelationField = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CommandResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommandResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommandResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorrelationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerTime", wireType)
+			}
+			m.ServerTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+			}
+			m.Counter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Counter |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadRaw", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadRaw = append(m.PayloadRaw[:0], dAtA[iNdEx:postIndex]...)
+			if m.PayloadRaw == nil {
+				m.PayloadRaw = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PortFunctions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortFunctions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortFunctions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPort", wireType)
+			}
+			m.MinPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPort |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPort", wireType)
+			}
+			m.MaxPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPort |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decoder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decoder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Converter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Converter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *TestUplinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TestUplinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TestUplinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DeviceProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LorawanVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LorawanVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vendor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vendor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BrandId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BrandId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirmwareVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirmwareVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ComplianceGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComplianceGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComplianceGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LorawanVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LorawanVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionalParameters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegionalParameters = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlan", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrequencyPlan = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+			}
+			m.Devices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Devices |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certified", wireType)
+			}
+			m.Certified = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Certified |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ComplianceReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComplianceReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComplianceReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+			}
+			m.Devices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Devices |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, &ComplianceGroup{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DownlinkDelivery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DownlinkDelivery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DownlinkDelivery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acked", wireType)
+			}
+			m.Acked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Acked |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missed", wireType)
+			}
+			m.Missed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Missed |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveMissed", wireType)
+			}
+			m.ConsecutiveMissed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveMissed |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PayloadFunctionsRevision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadFunctionsRevision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadFunctionsRevision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decoder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decoder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Converter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Converter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortFunctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortFunctions = append(m.PortFunctions, &PortFunctions{})
+			if err := m.PortFunctions[len(m.PortFunctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkDecoder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DownlinkDecoder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmModule", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WasmModule = append(m.WasmModule[:0], dAtA[iNdEx:postIndex]...)
+			if m.WasmModule == nil {
+				m.WasmModule = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadFunctionsVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadFunctionsVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryFields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryFields = append(m.BinaryFields, &BinaryField{})
+			if err := m.BinaryFields[len(m.BinaryFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionsLanguage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunctionsLanguage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtobufDescriptor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtobufDescriptor = append(m.ProtobufDescriptor[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProtobufDescriptor == nil {
+				m.ProtobufDescriptor = []byte{}
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtobufMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtobufMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoCodec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoCodec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Normalizer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Normalizer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PayloadFunctionsRevisionList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadFunctionsRevisionList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadFunctionsRevisionList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveRevision", wireType)
+			}
+			m.ActiveRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveRevision |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, &PayloadFunctionsRevision{})
+			if err := m.Revisions[len(m.Revisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PayloadFunctionsRollbackRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadFunctionsRollbackRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadFunctionsRollbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Codec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Codec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Codec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decoder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decoder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Converter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Converter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppIds = append(m.AppIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CodecIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodecIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodecIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CodecList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodecList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodecList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codecs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codecs = append(m.Codecs, &Codec{})
+			if err := m.Codecs[len(m.Codecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ReplayArchiveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplayArchiveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplayArchiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			m.Since = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Since |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			m.Until = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Until |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppIds = append(m.AppIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ReplayArchiveResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplayArchiveResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplayArchiveResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumFiles", wireType)
+			}
+			m.NumFiles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumFiles |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumUplinks", wireType)
+			}
+			m.NumUplinks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumUplinks |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumReplayed", wireType)
+			}
+			m.NumReplayed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumReplayed |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *BinaryField) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BinaryField: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BinaryField: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LittleEndian", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LittleEndian = bool(v != 0)
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Scale = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *InjectedUplinkMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InjectedUplinkMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InjectedUplinkMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *DryDownlinkResult) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovHandler(uint64(l))
-	}
-	if len(m.Logs) > 0 {
-		for _, e := range m.Logs {
-			l = e.Size()
-			n += 1 + l + sovHandler(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
 
-func sovHandler(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
-	}
-	return n
-}
-func sozHandler(x uint64) (n int) {
-	return sovHandler(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *DeviceActivationResponse) Unmarshal(dAtA []byte) error {
+func (m *RawUplink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2189,13 +22707,32 @@ func (m *DeviceActivationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeviceActivationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RawUplink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeviceActivationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RawUplink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
@@ -2226,11 +22763,11 @@ func (m *DeviceActivationResponse) Unmarshal(dAtA []byte) error {
 				m.Payload = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var msglen int
+			m.Time = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -2240,30 +22777,67 @@ func (m *DeviceActivationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Time |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthHandler
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Message == nil {
-				m.Message = &protocol.Message{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RedecodeUplinksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
 			}
-			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 11:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedecodeUplinksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedecodeUplinksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkOption", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -2273,30 +22847,26 @@ func (m *DeviceActivationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHandler
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkOption == nil {
-				m.DownlinkOption = &broker.DownlinkOption{}
-			}
-			if err := m.DownlinkOption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 23:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivationMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -2306,28 +22876,24 @@ func (m *DeviceActivationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHandler
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActivationMetadata == nil {
-				m.ActivationMetadata = &protocol.ActivationMetadata{}
-			}
-			if err := m.ActivationMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DevId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 31:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uplinks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2351,10 +22917,8 @@ func (m *DeviceActivationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Trace == nil {
-				m.Trace = &trace.Trace{}
-			}
-			if err := m.Trace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Uplinks = append(m.Uplinks, &RawUplink{})
+			if err := m.Uplinks[len(m.Uplinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2379,57 +22943,8 @@ func (m *DeviceActivationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatusRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowHandler
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StatusRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipHandler(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthHandler
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Status) Unmarshal(dAtA []byte) error {
+func (m *RedecodedUplink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2452,50 +22967,17 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Status: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedecodedUplink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedecodedUplink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field System", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHandler
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthHandler
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.System == nil {
-				m.System = &api.SystemStats{}
-			}
-			if err := m.System.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
-			var msglen int
+			m.Port = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -2505,30 +22987,35 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Port |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthHandler
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Component == nil {
-				m.Component = &api.ComponentStats{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			if err := m.Component.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uplink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -2538,30 +23025,26 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHandler
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Uplink == nil {
-				m.Uplink = &api.Rates{}
-			}
-			if err := m.Uplink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Fields = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -2571,30 +23054,17 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthHandler
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Downlink == nil {
-				m.Downlink = &api.Rates{}
-			}
-			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
+			m.Valid = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Activations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -2604,24 +23074,20 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHandler
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Activations == nil {
-				m.Activations = &api.Rates{}
-			}
-			if err := m.Activations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2644,7 +23110,8 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationIdentifier) Unmarshal(dAtA []byte) error {
+
+func (m *RedecodeUplinksResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2667,17 +23134,17 @@ func (m *ApplicationIdentifier) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationIdentifier: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedecodeUplinksResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedecodeUplinksResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uplinks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -2687,20 +23154,22 @@ func (m *ApplicationIdentifier) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthHandler
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppId = string(dAtA[iNdEx:postIndex])
+			m.Uplinks = append(m.Uplinks, &RedecodedUplink{})
+			if err := m.Uplinks[len(m.Uplinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2723,7 +23192,8 @@ func (m *ApplicationIdentifier) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Application) Unmarshal(dAtA []byte) error {
+
+func (m *ExportApplicationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2746,10 +23216,10 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Application: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportApplicationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Application: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportApplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2782,97 +23252,10 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 			m.AppId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decoder", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHandler
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthHandler
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Decoder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Converter", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHandler
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthHandler
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Converter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHandler
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthHandler
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cutover", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -2882,21 +23265,12 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthHandler
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Encoder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Cutover = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHandler(dAtA[iNdEx:])
@@ -2918,7 +23292,8 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeviceIdentifier) Unmarshal(dAtA []byte) error {
+
+func (m *DeviceTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2941,15 +23316,15 @@ func (m *DeviceIdentifier) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeviceIdentifier: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeviceTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeviceIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeviceTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2974,13 +23349,44 @@ func (m *DeviceIdentifier) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppId = string(dAtA[iNdEx:postIndex])
+			m.DevId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Device = append(m.Device[:0], dAtA[iNdEx:postIndex]...)
+			if m.Device == nil {
+				m.Device = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkQueue", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -2990,20 +23396,22 @@ func (m *DeviceIdentifier) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthHandler
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DevId = string(dAtA[iNdEx:postIndex])
+			m.DownlinkQueue = append(m.DownlinkQueue[:0], dAtA[iNdEx:postIndex]...)
+			if m.DownlinkQueue == nil {
+				m.DownlinkQueue = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3026,7 +23434,8 @@ func (m *DeviceIdentifier) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Device) Unmarshal(dAtA []byte) error {
+
+func (m *ApplicationTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3049,10 +23458,10 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Device: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Device: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3086,9 +23495,9 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -3098,24 +23507,26 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthHandler
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DevId = string(dAtA[iNdEx:postIndex])
+			m.Application = append(m.Application[:0], dAtA[iNdEx:postIndex]...)
+			if m.Application == nil {
+				m.Application = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LorawanDevice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3139,45 +23550,16 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &lorawan1.Device{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Devices = append(m.Devices, &DeviceTransfer{})
+			if err := m.Devices[len(m.Devices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Device = &Device_LorawanDevice{v}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Latitude", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(dAtA[iNdEx-4])
-			v |= uint32(dAtA[iNdEx-3]) << 8
-			v |= uint32(dAtA[iNdEx-2]) << 16
-			v |= uint32(dAtA[iNdEx-1]) << 24
-			m.Latitude = float32(math.Float32frombits(v))
-		case 11:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Longitude", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(dAtA[iNdEx-4])
-			v |= uint32(dAtA[iNdEx-3]) << 8
-			v |= uint32(dAtA[iNdEx-2]) << 16
-			v |= uint32(dAtA[iNdEx-1]) << 24
-			m.Longitude = float32(math.Float32frombits(v))
-		case 12:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Altitude", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cutover", wireType)
 			}
-			m.Altitude = 0
+			m.Cutover = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -3187,16 +23569,16 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Altitude |= (int32(b) & 0x7F) << shift
+				m.Cutover |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Activate", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -3206,21 +23588,12 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthHandler
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Activate = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHandler(dAtA[iNdEx:])
@@ -3242,7 +23615,8 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeviceList) Unmarshal(dAtA []byte) error {
+
+func (m *Webhook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3265,17 +23639,17 @@ func (m *DeviceList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeviceList: wiretype end group for non-group")
+			return fmt.Errorf("proto: Webhook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeviceList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Webhook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -3285,78 +23659,26 @@ func (m *DeviceList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHandler
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Devices = append(m.Devices, &Device{})
-			if err := m.Devices[len(m.Devices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipHandler(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthHandler
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DryDownlinkMessage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowHandler
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DryDownlinkMessage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DryDownlinkMessage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -3366,26 +23688,24 @@ func (m *DryDownlinkMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHandler
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
+			m.WebhookId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3410,11 +23730,11 @@ func (m *DryDownlinkMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fields = string(dAtA[iNdEx:postIndex])
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3438,87 +23758,7 @@ func (m *DryDownlinkMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.App == nil {
-				m.App = &Application{}
-			}
-			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
-			}
-			m.Port = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHandler
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Port |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipHandler(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthHandler
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DryUplinkMessage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowHandler
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DryUplinkMessage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DryUplinkMessage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
-			}
-			var byteLen int
+			var keykey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -3528,28 +23768,12 @@ func (m *DryUplinkMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				keykey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthHandler
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
-			}
-			var msglen int
+			var stringLenmapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -3559,30 +23783,76 @@ func (m *DryUplinkMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
 				return ErrInvalidLengthHandler
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.App == nil {
-				m.App = &Application{}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
 			}
-			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHandler
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHandler
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthHandler
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Headers[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Headers[mapkey] = mapvalue
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageTypes", wireType)
 			}
-			m.Port = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -3592,11 +23862,21 @@ func (m *DryUplinkMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Port |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageTypes = append(m.MessageTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHandler(dAtA[iNdEx:])
@@ -3618,7 +23898,8 @@ func (m *DryUplinkMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SimulatedUplinkMessage) Unmarshal(dAtA []byte) error {
+
+func (m *WebhookIdentifier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3641,10 +23922,10 @@ func (m *SimulatedUplinkMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SimulatedUplinkMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: WebhookIdentifier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SimulatedUplinkMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WebhookIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3678,7 +23959,7 @@ func (m *SimulatedUplinkMessage) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3703,58 +23984,8 @@ func (m *SimulatedUplinkMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DevId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHandler
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthHandler
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
+			m.WebhookId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
-			}
-			m.Port = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHandler
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Port |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHandler(dAtA[iNdEx:])
@@ -3776,7 +24007,8 @@ func (m *SimulatedUplinkMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogEntry) Unmarshal(dAtA []byte) error {
+
+func (m *WebhookList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3799,17 +24031,17 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: WebhookList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WebhookList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhooks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -3819,49 +24051,22 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthHandler
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Function = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHandler
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthHandler
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Webhooks = append(m.Webhooks, &Webhook{})
+			if err := m.Webhooks[len(m.Webhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3884,7 +24089,8 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DryUplinkResult) Unmarshal(dAtA []byte) error {
+
+func (m *DeletedDevice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3907,17 +24113,17 @@ func (m *DryUplinkResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DryUplinkResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeletedDevice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DryUplinkResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeletedDevice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -3927,26 +24133,24 @@ func (m *DryUplinkResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHandler
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
+			m.AppId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DevId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3971,13 +24175,13 @@ func (m *DryUplinkResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fields = string(dAtA[iNdEx:postIndex])
+			m.DevId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
 			}
-			var v int
+			m.DeletedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -3987,17 +24191,16 @@ func (m *DryUplinkResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.DeletedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Valid = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurgeAt", wireType)
 			}
-			var msglen int
+			m.PurgeAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -4007,23 +24210,11 @@ func (m *DryUplinkResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.PurgeAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthHandler
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Logs = append(m.Logs, &LogEntry{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHandler(dAtA[iNdEx:])
@@ -4045,7 +24236,8 @@ func (m *DryUplinkResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DryDownlinkResult) Unmarshal(dAtA []byte) error {
+
+func (m *DeletedDeviceList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4068,46 +24260,15 @@ func (m *DryDownlinkResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DryDownlinkResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeletedDeviceList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DryDownlinkResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeletedDeviceList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHandler
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthHandler
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4131,8 +24292,8 @@ func (m *DryDownlinkResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = append(m.Logs, &LogEntry{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Devices = append(m.Devices, &DeletedDevice{})
+			if err := m.Devices[len(m.Devices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4157,6 +24318,7 @@ func (m *DryDownlinkResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
 func skipHandler(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4267,83 +24429,350 @@ func init() {
 }
 
 var fileDescriptorHandler = []byte{
-	// 1248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdb, 0x6e, 0xdb, 0x46,
-	0x13, 0xfe, 0xe9, 0x83, 0x2c, 0x8d, 0x6c, 0x39, 0x5e, 0x27, 0xfa, 0x19, 0x39, 0x50, 0x54, 0x06,
-	0x49, 0x1d, 0x27, 0xa0, 0x50, 0xb5, 0x40, 0x93, 0x5c, 0xa4, 0x39, 0x38, 0x07, 0x03, 0x71, 0x0b,
-	0xd0, 0xee, 0x8d, 0x2f, 0x6a, 0xac, 0xc5, 0x31, 0x45, 0x98, 0xe2, 0xb2, 0xe4, 0x4a, 0x86, 0x10,
-	0xa4, 0x28, 0xf2, 0x0a, 0x6d, 0xdf, 0xa0, 0x77, 0x7d, 0x8e, 0x02, 0xbd, 0x2c, 0xd0, 0x07, 0x68,
-	0xe1, 0xf6, 0x09, 0xfa, 0x04, 0x05, 0x77, 0x97, 0x07, 0x9d, 0x6c, 0xab, 0xe8, 0x8d, 0xa4, 0x99,
-	0xef, 0xe3, 0x9c, 0x76, 0x66, 0x96, 0x82, 0x87, 0x8e, 0xcb, 0x3b, 0xbd, 0x23, 0xb3, 0xcd, 0xba,
-	0xcd, 0xfd, 0x0e, 0xee, 0x77, 0x5c, 0xdf, 0x89, 0x3e, 0x47, 0x7e, 0xca, 0xc2, 0x93, 0x26, 0xe7,
-	0x7e, 0x93, 0x06, 0x6e, 0xb3, 0x43, 0x7d, 0xdb, 0xc3, 0x30, 0xf9, 0x36, 0x83, 0x90, 0x71, 0x46,
-	0x96, 0x94, 0x58, 0xdb, 0x70, 0x18, 0x73, 0x3c, 0x6c, 0x0a, 0xf5, 0x51, 0xef, 0xb8, 0x89, 0xdd,
-	0x80, 0x0f, 0x24, 0xab, 0x76, 0x43, 0x81, 0xb1, 0x1d, 0xea, 0xfb, 0x8c, 0x53, 0xee, 0x32, 0x3f,
-	0x52, 0xe8, 0x5a, 0xe2, 0x82, 0x06, 0xae, 0x52, 0x6d, 0x24, 0xaa, 0xa3, 0x90, 0x9d, 0x60, 0xa8,
-	0xbe, 0x14, 0x78, 0x33, 0x01, 0x85, 0xd8, 0x66, 0x5e, 0xfa, 0x43, 0x11, 0x6e, 0x8f, 0x11, 0x3c,
-	0x16, 0xd2, 0x53, 0xea, 0x37, 0x6d, 0xec, 0xbb, 0x6d, 0x54, 0xb4, 0xeb, 0x09, 0x8d, 0x87, 0xb4,
-	0x8d, 0xf2, 0x53, 0x42, 0xc6, 0x0f, 0x73, 0xa0, 0x6f, 0x0b, 0xee, 0xd3, 0x36, 0x77, 0xfb, 0x22,
-	0x5c, 0x0b, 0xa3, 0x80, 0xf9, 0x11, 0x12, 0x1d, 0x96, 0x02, 0x3a, 0xf0, 0x18, 0xb5, 0x75, 0xad,
-	0xa1, 0x6d, 0x2e, 0x5b, 0x89, 0x48, 0xee, 0xc1, 0x52, 0x17, 0xa3, 0x88, 0x3a, 0xa8, 0xcf, 0x35,
-	0xb4, 0xcd, 0x72, 0x6b, 0xcd, 0x4c, 0x43, 0xdb, 0x95, 0x80, 0x95, 0x30, 0xc8, 0x67, 0xb0, 0x6a,
-	0xb3, 0x53, 0xdf, 0x73, 0xfd, 0x93, 0x43, 0x16, 0xc4, 0x1e, 0xf4, 0xb2, 0x78, 0xa8, 0x6a, 0xaa,
-	0x74, 0xb7, 0x15, 0xfc, 0x85, 0x40, 0xad, 0x8a, 0x3d, 0x24, 0x93, 0x5d, 0x58, 0xa7, 0x69, 0x74,
-	0x87, 0x5d, 0xe4, 0xd4, 0xa6, 0x9c, 0xea, 0xff, 0x17, 0x46, 0x6e, 0x64, 0x9e, 0xb3, 0x14, 0x76,
-	0x15, 0xc7, 0x22, 0x74, 0x4c, 0x47, 0x0c, 0x58, 0x14, 0x25, 0xd0, 0x6f, 0x0a, 0x03, 0xcb, 0xa6,
-	0x2c, 0xc8, 0x7e, 0xfc, 0x69, 0x49, 0xc8, 0x58, 0x85, 0x95, 0x3d, 0x4e, 0x79, 0x2f, 0xb2, 0xf0,
-	0xeb, 0x1e, 0x46, 0xdc, 0xf8, 0x5d, 0x83, 0x82, 0xd4, 0x90, 0x4d, 0x28, 0x44, 0x83, 0x88, 0x63,
-	0x57, 0x54, 0xa5, 0xdc, 0xba, 0x62, 0xc6, 0xe7, 0xb9, 0x27, 0x54, 0x31, 0x25, 0xb2, 0x14, 0x4e,
-	0x3e, 0x82, 0x52, 0x9b, 0x75, 0x03, 0xe6, 0xa3, 0xcf, 0x55, 0xa1, 0xd6, 0x05, 0xf9, 0x79, 0xa2,
-	0x95, 0xfc, 0x8c, 0x45, 0x0c, 0x28, 0xf4, 0x82, 0x38, 0x77, 0x55, 0x23, 0x10, 0x7c, 0x8b, 0x72,
-	0x8c, 0x2c, 0x85, 0x90, 0x3b, 0x50, 0x4c, 0x2a, 0xa4, 0x2f, 0x8f, 0xb1, 0x52, 0x8c, 0xdc, 0x87,
-	0x72, 0x96, 0x7e, 0xa4, 0xaf, 0x8c, 0x51, 0xf3, 0xb0, 0x61, 0xc2, 0xb5, 0xa7, 0x41, 0xe0, 0xb9,
-	0x6d, 0x21, 0xef, 0xd8, 0xe8, 0x73, 0xf7, 0xd8, 0xc5, 0x90, 0x5c, 0x83, 0x02, 0x0d, 0x82, 0x43,
-	0x57, 0x76, 0x41, 0xc9, 0x5a, 0xa4, 0x41, 0xb0, 0x63, 0x1b, 0xdf, 0x6b, 0x50, 0xce, 0x3d, 0x30,
-	0x85, 0x16, 0x37, 0x91, 0x8d, 0x6d, 0x66, 0x63, 0x28, 0x2a, 0x50, 0xb2, 0x12, 0x91, 0xdc, 0x88,
-	0xab, 0xe3, 0xf7, 0x31, 0xe4, 0x18, 0xea, 0xf3, 0x02, 0xcb, 0x14, 0x31, 0xda, 0xa7, 0x9e, 0x6b,
-	0x53, 0xce, 0x42, 0x7d, 0x41, 0xa2, 0xa9, 0x22, 0xb6, 0x8a, 0xbe, 0xb4, 0xba, 0x28, 0xad, 0x2a,
-	0xd1, 0x78, 0x02, 0x57, 0x64, 0x43, 0x5f, 0x98, 0x41, 0xac, 0xb6, 0xb1, 0x1f, 0xab, 0x65, 0x64,
-	0x8b, 0x36, 0xf6, 0x77, 0x6c, 0xe3, 0x6f, 0x0d, 0x0a, 0xd2, 0xc4, 0x6c, 0x0f, 0x92, 0x07, 0x50,
-	0x51, 0xf3, 0x77, 0x28, 0xe7, 0x4f, 0x64, 0x55, 0x6e, 0xad, 0x9a, 0x4a, 0x6d, 0x4a, 0xb3, 0xaf,
-	0xff, 0x67, 0xad, 0x28, 0x8d, 0xf2, 0x53, 0x83, 0xa2, 0x47, 0xb9, 0xcb, 0x7b, 0x36, 0xea, 0xd0,
-	0xd0, 0x36, 0xe7, 0xac, 0x54, 0x8e, 0x0b, 0xe1, 0x31, 0xdf, 0x91, 0x60, 0x59, 0x80, 0x99, 0x22,
-	0x7e, 0x92, 0x7a, 0xea, 0xc9, 0xb8, 0x17, 0x16, 0xad, 0x54, 0x26, 0x0d, 0x28, 0xdb, 0x18, 0xb5,
-	0x43, 0x57, 0x0e, 0xdd, 0x55, 0x11, 0x6b, 0x5e, 0xf5, 0xac, 0x28, 0x12, 0x71, 0xdb, 0x68, 0x7c,
-	0x0a, 0x20, 0x63, 0x79, 0xe3, 0x46, 0x9c, 0xdc, 0x8d, 0x0f, 0x2d, 0x96, 0x22, 0x5d, 0x6b, 0xcc,
-	0x8b, 0x14, 0x92, 0x75, 0x28, 0x59, 0x56, 0x82, 0x1b, 0xef, 0x35, 0x20, 0xdb, 0xe1, 0x20, 0x19,
-	0x61, 0x35, 0xfd, 0xe7, 0xec, 0x8e, 0x2a, 0x14, 0x8e, 0x5d, 0xf4, 0xec, 0x48, 0x15, 0x4f, 0x49,
-	0xe4, 0x0e, 0xcc, 0xd3, 0x20, 0x50, 0x25, 0xbb, 0x9a, 0xfa, 0xcb, 0xb5, 0x98, 0x15, 0x13, 0x08,
-	0x81, 0x85, 0x80, 0x85, 0x5c, 0xf4, 0xc4, 0x8a, 0x25, 0x7e, 0x1b, 0x1d, 0xb8, 0xb2, 0x1d, 0x0e,
-	0xbe, 0x0c, 0x2e, 0x17, 0x81, 0xf2, 0x34, 0x77, 0x59, 0x4f, 0xf3, 0x39, 0x4f, 0x1c, 0xaa, 0x7b,
-	0x6e, 0xb7, 0xe7, 0x51, 0x8e, 0xf6, 0xb0, 0xbf, 0xd9, 0x7a, 0x25, 0x17, 0xdd, 0xfc, 0x70, 0x74,
-	0x93, 0xf2, 0x7b, 0x0c, 0xc5, 0x37, 0xcc, 0x79, 0xe1, 0xf3, 0x70, 0x10, 0x9f, 0xf8, 0x71, 0xcf,
-	0x6f, 0x8b, 0x23, 0x95, 0x9e, 0x52, 0x79, 0xa8, 0xb6, 0xf3, 0x59, 0x6d, 0x8d, 0x6f, 0x35, 0x58,
-	0x4d, 0x0b, 0x64, 0x61, 0xd4, 0xf3, 0xf8, 0xbf, 0x38, 0xa1, 0xab, 0xb0, 0x28, 0x26, 0x50, 0x44,
-	0x5c, 0xb4, 0xa4, 0x40, 0x6e, 0xc3, 0x82, 0xc7, 0x9c, 0x48, 0x5f, 0x10, 0x8d, 0xb2, 0x96, 0x96,
-	0x33, 0x09, 0xd8, 0x12, 0xb0, 0xb1, 0x0f, 0x6b, 0xb9, 0x36, 0xb9, 0x30, 0x86, 0xc4, 0xea, 0xdc,
-	0xb9, 0x56, 0x5b, 0x3f, 0x6b, 0xb0, 0xf4, 0x5a, 0x42, 0xe4, 0x2b, 0x58, 0xcf, 0x6e, 0x80, 0xe7,
-	0x1d, 0xea, 0x79, 0xe8, 0x3b, 0x48, 0x8c, 0xe4, 0x96, 0x99, 0x00, 0xaa, 0xed, 0x5e, 0xbb, 0x75,
-	0x2e, 0x47, 0x5d, 0x87, 0x07, 0x50, 0x54, 0x30, 0x92, 0x7b, 0xe9, 0xd5, 0x85, 0x76, 0x4f, 0xb6,
-	0x0d, 0xda, 0xe3, 0x17, 0xa9, 0xb4, 0xfe, 0xc1, 0xc8, 0xf0, 0x8c, 0x5f, 0xb5, 0xad, 0x3f, 0x4b,
-	0x40, 0x72, 0xfd, 0xb7, 0x4b, 0x7d, 0xea, 0x60, 0x48, 0x1c, 0x58, 0xb7, 0xd0, 0x71, 0x23, 0x8e,
-	0x61, 0x7e, 0xd5, 0xd6, 0x27, 0xf5, 0x6c, 0xb6, 0xef, 0x6a, 0x55, 0x53, 0xbe, 0x87, 0x98, 0xc9,
-	0x4b, 0x8a, 0xf9, 0x22, 0x7e, 0x49, 0x31, 0xf4, 0xf7, 0xbf, 0xfd, 0xf5, 0xdd, 0x1c, 0x31, 0x56,
-	0x9a, 0x34, 0x7b, 0x2e, 0x7a, 0xa4, 0x6d, 0x91, 0x63, 0xa8, 0xbc, 0x42, 0x3e, 0x8b, 0x8f, 0x89,
-	0x73, 0x63, 0xd4, 0x85, 0x07, 0x9d, 0x54, 0x87, 0x3c, 0x34, 0xdf, 0xca, 0xc9, 0x78, 0x47, 0xbe,
-	0x81, 0xca, 0xde, 0xb0, 0x9f, 0x89, 0x76, 0xa6, 0x66, 0xf0, 0x58, 0xd8, 0x7f, 0x60, 0x4c, 0xb1,
-	0xff, 0x48, 0xdb, 0x3a, 0xd8, 0xa8, 0x4d, 0x07, 0xc9, 0x09, 0xac, 0x6d, 0xa3, 0x87, 0x1c, 0xff,
-	0x8b, 0x72, 0xaa, 0x64, 0xb7, 0xa6, 0x25, 0xdb, 0x81, 0xd2, 0x2b, 0xe4, 0x6a, 0xc5, 0x5f, 0x1f,
-	0x69, 0x82, 0x9c, 0xfd, 0xd1, 0xe5, 0x6a, 0x34, 0x85, 0xe1, 0xbb, 0xe4, 0xc3, 0xc9, 0x86, 0xd5,
-	0xdb, 0x5d, 0xd4, 0x7c, 0x2b, 0x37, 0xcb, 0x3b, 0x72, 0xa6, 0x41, 0x69, 0x2f, 0x75, 0x35, 0x6a,
-	0x6f, 0x6a, 0x02, 0x3f, 0x69, 0xc2, 0xd1, 0x8f, 0x9a, 0x71, 0x59, 0x4f, 0x71, 0x81, 0xef, 0xd7,
-	0x66, 0x61, 0xdf, 0x32, 0xea, 0xe7, 0xb3, 0x05, 0xa9, 0x76, 0x31, 0x89, 0x84, 0xb0, 0x2c, 0xcf,
-	0xee, 0xe2, 0x8a, 0x4e, 0x4b, 0x58, 0x15, 0x76, 0xeb, 0xd2, 0x85, 0x3d, 0x05, 0x3d, 0x3d, 0xc2,
-	0xe8, 0x25, 0x9b, 0x69, 0x0a, 0xd7, 0x47, 0xe2, 0x8b, 0x6f, 0x56, 0xe3, 0x8e, 0x88, 0xa0, 0x41,
-	0x2e, 0xc8, 0x97, 0xbc, 0x84, 0x72, 0x6e, 0x5d, 0x92, 0x8d, 0xcc, 0xd6, 0xd8, 0x5d, 0x5b, 0xab,
-	0x4d, 0x02, 0xd5, 0x86, 0x7d, 0x02, 0xa5, 0x74, 0xf1, 0xe7, 0x2b, 0x36, 0x72, 0x5b, 0xd6, 0xf4,
-	0x71, 0x48, 0x59, 0xd8, 0x81, 0x4a, 0x72, 0xe3, 0x29, 0x33, 0x37, 0x53, 0xee, 0xe4, 0xab, 0x70,
-	0x5a, 0xf9, 0x5b, 0x2f, 0xa1, 0xa2, 0x96, 0x75, 0xb2, 0xe0, 0x3e, 0x11, 0x23, 0xa2, 0x5e, 0xac,
-	0xab, 0x99, 0xdd, 0xfc, 0xbb, 0x77, 0x6e, 0x3e, 0xa4, 0xfe, 0xd9, 0xc3, 0x5f, 0xce, 0xea, 0xda,
-	0xaf, 0x67, 0x75, 0xed, 0x8f, 0xb3, 0xba, 0x76, 0x70, 0x6f, 0x86, 0x7f, 0x75, 0x47, 0x05, 0x11,
-	0xd2, 0xc7, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xdc, 0xb0, 0x50, 0xc3, 0x0b, 0x0e, 0x00, 0x00,
+	// 5505 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbd, 0x3c, 0x4d, 0x8f, 0x1b, 0x47,
+	0x76, 0x21, 0x39, 0x1f, 0x9c, 0xe2, 0x70, 0x3e, 0x6a, 0xa4, 0x11, 0x87, 0xfa, 0x2e, 0x59, 0xb2,
+	0x2c, 0x4b, 0xa4, 0x3c, 0xeb, 0xb5, 0x65, 0x3b, 0xb6, 0x77, 0x34, 0x23, 0xd9, 0xc2, 0x7a, 0x62,
+	0xb9, 0x35, 0x6b, 0x27, 0x0e, 0x12, 0xa2, 0x87, 0xec, 0x99, 0xe9, 0x15, 0xc9, 0xa6, 0xbb, 0x9b,
+	0x1a, 0xcd, 0x6a, 0x8d, 0x45, 0xbc, 0x40, 0xb2, 0x01, 0x82, 0x00, 0xc1, 0x62, 0x13, 0x20, 0x08,
+	0xb0, 0x97, 0x04, 0x08, 0x90, 0x4b, 0x72, 0x08, 0x72, 0x4c, 0x80, 0x20, 0x40, 0x90, 0x53, 0x80,
+	0xe4, 0x96, 0x00, 0x09, 0x92, 0xdc, 0xf2, 0x07, 0xf6, 0x90, 0x43, 0xde, 0x7b, 0xf5, 0xd1, 0xd5,
+	0x24, 0x7b, 0x38, 0x94, 0x0c, 0x1f, 0x6c, 0xb3, 0xde, 0xab, 0xae, 0x7a, 0xf5, 0xea, 0x7d, 0xbf,
+	0x1a, 0xb3, 0xb7, 0xf6, 0xfd, 0xf8, 0xa0, 0xbf, 0x5b, 0x6b, 0x06, 0x9d, 0xfa, 0xce, 0x81, 0xb7,
+	0x73, 0xe0, 0x77, 0xf7, 0xa3, 0x5f, 0xf1, 0xe2, 0xc3, 0x20, 0x7c, 0x5c, 0x8f, 0xe3, 0x6e, 0xdd,
+	0xed, 0xf9, 0xf5, 0x03, 0xb7, 0xdb, 0x6a, 0x7b, 0xa1, 0xfe, 0x6f, 0xad, 0x17, 0x06, 0x71, 0xc0,
+	0x67, 0xd5, 0xb0, 0x7a, 0x76, 0x3f, 0x08, 0xf6, 0xdb, 0x5e, 0x9d, 0xc0, 0xbb, 0xfd, 0xbd, 0xba,
+	0xd7, 0xe9, 0xc5, 0x47, 0x72, 0x56, 0xf5, 0x9c, 0x42, 0xe2, 0x3a, 0x6e, 0xb7, 0x1b, 0xc4, 0x6e,
+	0xec, 0x07, 0xdd, 0x48, 0x61, 0x97, 0xf5, 0x16, 0xf0, 0x8f, 0x02, 0x9d, 0xd5, 0xa0, 0xdd, 0x30,
+	0x78, 0x0c, 0x9b, 0xca, 0xff, 0x28, 0xe4, 0x79, 0x8d, 0xdc, 0x77, 0x63, 0xef, 0xd0, 0x3d, 0xd2,
+	0xff, 0x55, 0xe8, 0x8b, 0x1a, 0x4d, 0xc3, 0x66, 0xd0, 0x36, 0x3f, 0xd4, 0x84, 0xab, 0x43, 0x13,
+	0xda, 0x41, 0xe8, 0x1e, 0xba, 0xdd, 0x7a, 0xcb, 0x7b, 0xe2, 0x37, 0x3d, 0x35, 0x6d, 0x4d, 0x4f,
+	0x8b, 0x43, 0xb7, 0xe9, 0xc9, 0x7f, 0x4b, 0x94, 0xf8, 0x59, 0x9e, 0x55, 0xb6, 0x68, 0xee, 0x46,
+	0x33, 0xf6, 0x9f, 0xd0, 0x69, 0x1c, 0x2f, 0xea, 0xc1, 0x99, 0x3c, 0x5e, 0x61, 0xb3, 0x3d, 0xf7,
+	0xa8, 0x1d, 0xb8, 0xad, 0x4a, 0xee, 0x52, 0xee, 0xfa, 0xbc, 0xa3, 0x87, 0xfc, 0x55, 0x36, 0xdb,
+	0xf1, 0xa2, 0xc8, 0xdd, 0xf7, 0x2a, 0x79, 0xc0, 0x94, 0xd6, 0x97, 0x6b, 0x86, 0xb4, 0x6d, 0x89,
+	0x70, 0xf4, 0x0c, 0xfe, 0x3e, 0x5b, 0x6c, 0x05, 0x87, 0xdd, 0xb6, 0xdf, 0x7d, 0xdc, 0x08, 0x7a,
+	0xb8, 0x43, 0xa5, 0x44, 0x1f, 0xad, 0xd6, 0x14, 0x37, 0xb6, 0x14, 0xfa, 0x63, 0xc2, 0x3a, 0x0b,
+	0xad, 0xd4, 0x98, 0x6f, 0xb3, 0x15, 0xd7, 0x50, 0xd7, 0xe8, 0x78, 0xb1, 0xdb, 0x72, 0x63, 0xb7,
+	0x72, 0x86, 0x16, 0x39, 0x97, 0xec, 0x9c, 0x1c, 0x61, 0x5b, 0xcd, 0x71, 0xb8, 0x3b, 0x04, 0xe3,
+	0x82, 0x4d, 0x13, 0x0b, 0x2a, 0x17, 0x69, 0x81, 0xf9, 0x9a, 0x64, 0xc8, 0x0e, 0xfe, 0xdb, 0x91,
+	0x28, 0xb1, 0xc8, 0xca, 0x8f, 0xe0, 0x6e, 0xfb, 0x91, 0xe3, 0x7d, 0xd1, 0xf7, 0xa2, 0x58, 0xfc,
+	0x47, 0x8e, 0xcd, 0x48, 0x08, 0xbf, 0xce, 0x66, 0xa2, 0xa3, 0x28, 0xf6, 0x3a, 0xc4, 0x95, 0xd2,
+	0xfa, 0x52, 0x0d, 0xaf, 0xfb, 0x11, 0x81, 0x70, 0x4a, 0xe4, 0x28, 0x3c, 0x7f, 0x8d, 0xcd, 0x81,
+	0x24, 0x02, 0x33, 0xbd, 0x6e, 0xac, 0x18, 0xb5, 0x42, 0x93, 0x37, 0x35, 0x54, 0xce, 0x4f, 0x66,
+	0x01, 0x71, 0x33, 0xfd, 0x1e, 0x9e, 0x5d, 0xf1, 0x88, 0xd1, 0x7c, 0x07, 0xe4, 0x02, 0x96, 0x95,
+	0x18, 0x7e, 0x8d, 0x15, 0x35, 0x87, 0x2a, 0xf3, 0x43, 0xb3, 0x0c, 0x8e, 0xdf, 0x64, 0xa5, 0xe4,
+	0xf8, 0x51, 0xa5, 0x3c, 0x34, 0xd5, 0x46, 0x8b, 0x1a, 0x3b, 0xbd, 0xd1, 0x83, 0x0d, 0x9a, 0x34,
+	0x7e, 0xd0, 0x02, 0x6a, 0xfc, 0x3d, 0xdf, 0x0b, 0xf9, 0x69, 0x36, 0xe3, 0xf6, 0x7a, 0x0d, 0x5f,
+	0x4a, 0xc1, 0x9c, 0x33, 0x0d, 0xa3, 0x07, 0x2d, 0xf1, 0xe3, 0x32, 0x2b, 0x59, 0x1f, 0x64, 0x4c,
+	0x43, 0x21, 0x6a, 0x79, 0xcd, 0xa0, 0xe5, 0x85, 0xc4, 0x81, 0x39, 0x47, 0x0f, 0xf9, 0x39, 0xe4,
+	0x4e, 0xf7, 0x89, 0x17, 0xc6, 0x80, 0x2b, 0x10, 0x2e, 0x01, 0x20, 0xf6, 0x89, 0xdb, 0xf6, 0xe1,
+	0xc6, 0x82, 0xb0, 0x32, 0x25, 0xb1, 0x06, 0x80, 0xab, 0x7a, 0x5d, 0xb9, 0xea, 0xb4, 0x5c, 0x55,
+	0x0d, 0xf9, 0x59, 0x36, 0xf7, 0xfd, 0xc0, 0xef, 0x36, 0x0e, 0x82, 0xe0, 0x71, 0x65, 0x86, 0x70,
+	0x45, 0x04, 0x7c, 0x08, 0x63, 0xee, 0xb0, 0xd3, 0x20, 0x2d, 0x4f, 0xfc, 0x08, 0x08, 0x06, 0xd3,
+	0xd0, 0x30, 0x6c, 0x9c, 0x25, 0xde, 0x9c, 0xaf, 0x69, 0x9b, 0xf0, 0xd0, 0x9a, 0xa5, 0xa5, 0xd3,
+	0x39, 0xd5, 0x1b, 0x01, 0xe5, 0x6f, 0xb3, 0x35, 0xa5, 0x16, 0x8d, 0xbd, 0x7e, 0xb7, 0x49, 0xcc,
+	0x6c, 0xc0, 0x21, 0x70, 0x5e, 0xa5, 0x48, 0x04, 0x9c, 0x51, 0x13, 0xee, 0x6b, 0xfc, 0xa7, 0x12,
+	0xcd, 0xef, 0xb3, 0x65, 0xb7, 0x1b, 0x74, 0xdc, 0xf6, 0x51, 0xa3, 0xe5, 0xc5, 0x1e, 0x21, 0x2b,
+	0x73, 0x44, 0xcb, 0x9a, 0xa1, 0x65, 0x43, 0xce, 0xd8, 0xd2, 0x13, 0x9c, 0x25, 0x77, 0x00, 0x82,
+	0x2a, 0x86, 0x22, 0xd4, 0x8f, 0x3d, 0x20, 0xc2, 0xf7, 0xda, 0xad, 0xa8, 0xc2, 0x2e, 0x15, 0x48,
+	0xc5, 0xf4, 0x2a, 0x9b, 0x0a, 0x7f, 0x1f, 0xd1, 0xce, 0x42, 0xd3, 0x1e, 0x46, 0x70, 0x88, 0x72,
+	0xd0, 0x8f, 0x01, 0xd2, 0xe8, 0x05, 0x70, 0xa3, 0x47, 0x4a, 0xfa, 0x4e, 0x9b, 0xcf, 0x3f, 0x26,
+	0xec, 0x43, 0x42, 0x3a, 0xf3, 0x81, 0x35, 0xe2, 0x6f, 0x80, 0x98, 0xed, 0xef, 0x87, 0xde, 0x3e,
+	0xc9, 0x81, 0x92, 0xc8, 0x53, 0x09, 0xf9, 0x09, 0xce, 0xb1, 0x27, 0xf2, 0x5b, 0x8c, 0xfb, 0xdd,
+	0xd8, 0xdb, 0x0f, 0xa5, 0x5e, 0xef, 0x05, 0x61, 0xc7, 0x8d, 0x49, 0x4a, 0xe7, 0x9c, 0x65, 0x0b,
+	0x73, 0x9f, 0x10, 0xfc, 0x2a, 0x5b, 0x08, 0xe1, 0xc0, 0x5d, 0x9a, 0xdc, 0x72, 0x8f, 0xa2, 0xca,
+	0x02, 0x4c, 0x2d, 0x3b, 0x65, 0x03, 0xdd, 0x02, 0x20, 0x7f, 0x85, 0x2d, 0x45, 0x5e, 0x37, 0xf2,
+	0x41, 0xb0, 0x3d, 0xcd, 0x8b, 0x45, 0xe0, 0xc5, 0x9c, 0xb3, 0x68, 0xe0, 0xea, 0xd0, 0x67, 0x40,
+	0x34, 0xc3, 0xa3, 0x46, 0xd8, 0xef, 0x56, 0x96, 0x60, 0xa9, 0xa2, 0x33, 0x03, 0x43, 0xa7, 0xdf,
+	0xe5, 0x55, 0x56, 0x0c, 0x3d, 0x79, 0xd3, 0x95, 0x65, 0xc0, 0x4c, 0x39, 0x66, 0xcc, 0x2f, 0xb2,
+	0x52, 0xbf, 0x07, 0x42, 0xe8, 0x35, 0x3a, 0x6e, 0xf4, 0xb8, 0xc2, 0x69, 0x69, 0x26, 0x41, 0xdb,
+	0x00, 0x41, 0x3a, 0x8d, 0x3c, 0xc8, 0x23, 0xad, 0xd0, 0x91, 0xca, 0x5a, 0x08, 0xe4, 0x71, 0x80,
+	0x4e, 0x2d, 0x2e, 0x8d, 0xd8, 0xef, 0x78, 0xc0, 0xd2, 0xca, 0x29, 0x3a, 0xd0, 0xa2, 0x86, 0xef,
+	0x48, 0x30, 0x6e, 0x79, 0xe8, 0x46, 0x9d, 0x46, 0x27, 0x68, 0xf5, 0xdb, 0x5e, 0xe5, 0x34, 0xd9,
+	0x62, 0x86, 0xa0, 0x6d, 0x82, 0xf0, 0x77, 0x61, 0xcb, 0x20, 0x8c, 0x13, 0xf9, 0xab, 0xac, 0x0e,
+	0xdc, 0xfe, 0x43, 0x40, 0x1b, 0xe9, 0x03, 0x52, 0xec, 0x21, 0x92, 0x62, 0x0c, 0xb4, 0xd6, 0xd5,
+	0x33, 0x44, 0xb3, 0x31, 0xdc, 0x5b, 0x4a, 0x67, 0x6b, 0x6c, 0x05, 0x5c, 0x4b, 0xc3, 0x6d, 0xb5,
+	0xc2, 0x86, 0xdb, 0x6e, 0x07, 0x52, 0xf7, 0x2b, 0x15, 0x79, 0x69, 0x80, 0xda, 0x00, 0xcc, 0x86,
+	0x41, 0xe0, 0x1d, 0x27, 0x4a, 0x61, 0x78, 0xba, 0x46, 0x3c, 0x5d, 0x36, 0x18, 0x47, 0x33, 0xf7,
+	0x14, 0x9b, 0xc6, 0x7d, 0x9a, 0x95, 0xaa, 0x34, 0x21, 0x34, 0xe0, 0x6f, 0xb1, 0xf2, 0xae, 0xdf,
+	0x75, 0xe1, 0xaa, 0xd4, 0x7d, 0x9e, 0xa5, 0xd3, 0x25, 0x22, 0x76, 0x97, 0xb0, 0x52, 0xb2, 0xe7,
+	0x77, 0x93, 0x41, 0x94, 0xde, 0xbf, 0xed, 0x76, 0xf7, 0xfb, 0xe8, 0xb3, 0xce, 0x49, 0x72, 0x0d,
+	0xe6, 0x23, 0x85, 0xe0, 0x75, 0xb6, 0xa2, 0xdd, 0x3e, 0x70, 0x22, 0x6a, 0x86, 0x7e, 0x0f, 0xcd,
+	0xcf, 0x79, 0xe2, 0x38, 0xd7, 0xa8, 0x2d, 0x83, 0x41, 0xd6, 0x99, 0x0f, 0xb4, 0x47, 0xbc, 0x20,
+	0x59, 0xa7, 0xe1, 0xca, 0x1f, 0xf2, 0x35, 0x56, 0xdc, 0x0f, 0x1a, 0xf2, 0x78, 0x17, 0xa5, 0xcd,
+	0xda, 0x0f, 0x36, 0xe9, 0x80, 0x20, 0x32, 0xe8, 0x99, 0x80, 0x41, 0x91, 0x0f, 0x76, 0x17, 0xd4,
+	0xef, 0x92, 0x14, 0x19, 0xf2, 0x61, 0x1a, 0x08, 0x5e, 0x77, 0x39, 0x02, 0x7f, 0xe1, 0xed, 0xf5,
+	0xdb, 0xf2, 0x9e, 0xc0, 0x0c, 0x55, 0x2e, 0x93, 0xe4, 0x2e, 0x69, 0xc4, 0x96, 0x82, 0xf3, 0x0b,
+	0x8c, 0x75, 0x51, 0xd2, 0xda, 0xfe, 0x0f, 0xe0, 0x3a, 0x05, 0xad, 0x67, 0x41, 0xf8, 0x07, 0x6c,
+	0xa5, 0xe3, 0xa2, 0x96, 0x75, 0xdd, 0x6e, 0xd3, 0x6b, 0x1c, 0xfa, 0x5d, 0xb8, 0xeb, 0xa8, 0x72,
+	0x45, 0x09, 0x0e, 0x3a, 0x89, 0xed, 0x04, 0xff, 0x19, 0xa1, 0x1d, 0xde, 0x19, 0x04, 0x45, 0xfc,
+	0x06, 0x5b, 0xee, 0xb8, 0x4f, 0x1b, 0xca, 0x7c, 0xa8, 0x1b, 0x7a, 0x49, 0x4a, 0x32, 0x20, 0xa4,
+	0xe1, 0x50, 0xd7, 0x71, 0x9d, 0x2d, 0x59, 0x73, 0x5b, 0x5e, 0x2f, 0x3e, 0xa8, 0x5c, 0xa5, 0xa9,
+	0x0b, 0x66, 0xea, 0x16, 0x42, 0xc1, 0xc7, 0x2d, 0x5a, 0x33, 0x23, 0x20, 0xb9, 0x72, 0x4d, 0xaa,
+	0xbb, 0x99, 0xf8, 0x08, 0x80, 0xe2, 0x3b, 0x6c, 0x49, 0xc6, 0x2f, 0x63, 0x1d, 0x16, 0x82, 0x51,
+	0x76, 0x01, 0x2c, 0x1d, 0xd1, 0x34, 0x8c, 0xd0, 0x8f, 0xcd, 0xb0, 0x19, 0xb9, 0xc4, 0x64, 0x1f,
+	0xf2, 0x3b, 0x6c, 0x41, 0x85, 0x5b, 0x0d, 0x19, 0x6e, 0x91, 0x13, 0x2b, 0xad, 0x2f, 0xd6, 0x14,
+	0xb8, 0x26, 0x97, 0xfd, 0xf0, 0x97, 0x9c, 0xb2, 0x82, 0xa8, 0x7d, 0xc0, 0xbe, 0xb4, 0x41, 0x3f,
+	0xe2, 0x7e, 0xcb, 0x03, 0x3b, 0x9d, 0xbb, 0x9e, 0x77, 0xcc, 0x18, 0xfd, 0x5e, 0x3b, 0xe8, 0xee,
+	0x4b, 0x64, 0x89, 0x90, 0x09, 0x00, 0xbf, 0x74, 0xdb, 0xea, 0x4b, 0x34, 0xb4, 0xd3, 0x8e, 0x19,
+	0xf3, 0x4b, 0xac, 0xa4, 0x65, 0x16, 0x95, 0xec, 0x14, 0xd1, 0x6a, 0x83, 0xc0, 0x4d, 0x30, 0x37,
+	0x8e, 0x43, 0x7f, 0x17, 0x2c, 0x7f, 0x04, 0x76, 0x04, 0xaf, 0xfa, 0xa2, 0xd1, 0x22, 0x49, 0x5c,
+	0x6d, 0xc3, 0xcc, 0xb8, 0xd7, 0x8d, 0xc1, 0x1e, 0x5a, 0x9f, 0x80, 0x26, 0xae, 0xe1, 0xad, 0x18,
+	0x6b, 0xa1, 0x0d, 0x1d, 0xdd, 0xcf, 0x2a, 0xdd, 0xcf, 0x2a, 0x4c, 0xd0, 0xbe, 0xf1, 0xa1, 0x44,
+	0xe3, 0x45, 0x41, 0x30, 0xc2, 0x13, 0x23, 0x43, 0xc2, 0x0e, 0x02, 0xab, 0xcc, 0x8c, 0x31, 0x3f,
+	0x5b, 0x28, 0xef, 0x00, 0xb7, 0x4d, 0x73, 0x25, 0xd3, 0x34, 0xaf, 0x1d, 0x6f, 0x9a, 0xab, 0x43,
+	0xa6, 0xf9, 0x36, 0x04, 0xb4, 0x61, 0xb0, 0xe7, 0x83, 0x11, 0x3d, 0xab, 0x22, 0xd0, 0xf4, 0xe1,
+	0x1f, 0x4a, 0xac, 0xa3, 0xa7, 0xa1, 0x83, 0xb6, 0x4c, 0x63, 0x1b, 0x7c, 0x47, 0x78, 0x44, 0xe6,
+	0xc3, 0x76, 0xd0, 0x5b, 0xc6, 0x48, 0xca, 0x09, 0xd6, 0x79, 0x14, 0x64, 0x84, 0x53, 0x38, 0x3f,
+	0xca, 0x29, 0x18, 0xfb, 0x77, 0xc1, 0xb6, 0x7f, 0xd9, 0x96, 0xa3, 0xfa, 0x2e, 0x5b, 0x1c, 0xb8,
+	0x2f, 0xbe, 0xc4, 0x0a, 0x8f, 0xbd, 0x23, 0x25, 0xc1, 0xf8, 0x13, 0x57, 0x85, 0xc8, 0xa9, 0xef,
+	0x69, 0xf1, 0xa5, 0xc1, 0xdb, 0xf9, 0x3b, 0xb9, 0xbb, 0x45, 0x92, 0x6c, 0x38, 0xb8, 0x78, 0x93,
+	0x31, 0xc9, 0x82, 0x8f, 0xfc, 0x08, 0x9d, 0xd3, 0xac, 0x84, 0x47, 0xb0, 0x4e, 0x81, 0x64, 0x3a,
+	0xcd, 0x28, 0x47, 0xe3, 0xc5, 0x57, 0x39, 0xc6, 0xb7, 0xc2, 0x23, 0xcd, 0x03, 0x6d, 0xed, 0xb2,
+	0x73, 0x87, 0x55, 0x36, 0xa3, 0x8c, 0x84, 0x24, 0x47, 0x8d, 0x40, 0xe3, 0x0b, 0xa0, 0x6e, 0x4a,
+	0x87, 0xac, 0xf0, 0x21, 0x09, 0x31, 0x1d, 0x9c, 0xc0, 0x39, 0x9b, 0x42, 0xf7, 0x45, 0x31, 0x61,
+	0xd9, 0xa1, 0xdf, 0xe2, 0x00, 0xac, 0x40, 0x78, 0xf4, 0xbd, 0xde, 0xc9, 0x28, 0x50, 0x3b, 0xe5,
+	0x4f, 0xba, 0x53, 0xc1, 0xda, 0x29, 0x66, 0xab, 0x8f, 0xfc, 0x4e, 0x1f, 0xd4, 0xd5, 0x6b, 0xa5,
+	0xf7, 0x9b, 0xcc, 0x78, 0x58, 0xd4, 0x15, 0xd2, 0xd4, 0x8d, 0x3a, 0xdf, 0x7b, 0xac, 0xf8, 0x51,
+	0xb0, 0x2f, 0xef, 0x17, 0x34, 0x40, 0x3b, 0x2e, 0xb5, 0x93, 0x19, 0xa7, 0x78, 0x5b, 0x48, 0x78,
+	0x2b, 0xfe, 0x30, 0xc7, 0x16, 0x0d, 0x83, 0xc0, 0xa1, 0xf4, 0xdb, 0xf1, 0x73, 0xdc, 0x90, 0x94,
+	0x23, 0x5f, 0x52, 0x5c, 0x74, 0xe4, 0x00, 0x44, 0x7b, 0xaa, 0x1d, 0xec, 0x47, 0x40, 0x6f, 0x81,
+	0x12, 0x41, 0xcd, 0x4e, 0x4d, 0xb0, 0x43, 0x68, 0xfc, 0xd8, 0x0b, 0xc3, 0x40, 0xc7, 0xeb, 0x72,
+	0x20, 0x76, 0xd8, 0xb2, 0x25, 0x3c, 0x63, 0x29, 0xd3, 0x7b, 0xe5, 0x8f, 0xdd, 0x4b, 0xfc, 0x3c,
+	0xcf, 0xe6, 0xa5, 0x9c, 0xca, 0x13, 0xa3, 0x65, 0x88, 0xbc, 0x10, 0x34, 0x91, 0x42, 0x2d, 0x5a,
+	0xb5, 0xe0, 0x30, 0x09, 0xc2, 0x28, 0xcb, 0x30, 0x3d, 0x9f, 0x30, 0x1d, 0xc9, 0x68, 0x06, 0xfd,
+	0xae, 0xce, 0x4e, 0xca, 0x8e, 0x1e, 0xaa, 0xcc, 0x65, 0xcf, 0x0f, 0x3b, 0x5e, 0x8b, 0xee, 0xa9,
+	0xe8, 0x24, 0x00, 0xdc, 0x4c, 0xeb, 0x3a, 0x18, 0x7d, 0x3a, 0x2f, 0x84, 0x6b, 0x0a, 0xe4, 0xb8,
+	0x87, 0x7c, 0x83, 0x2d, 0xeb, 0x9c, 0x35, 0xc9, 0x66, 0x4b, 0x4a, 0x1a, 0x4d, 0x36, 0xeb, 0x3c,
+	0x35, 0x59, 0xec, 0x92, 0x06, 0x9a, 0x1c, 0xf6, 0x3d, 0xb6, 0xa4, 0x6a, 0x05, 0xc9, 0x0a, 0xf3,
+	0xc4, 0x94, 0x95, 0x9a, 0x2e, 0x22, 0x58, 0x0b, 0x2c, 0x2a, 0x98, 0x06, 0x88, 0x4d, 0xed, 0x36,
+	0x25, 0x83, 0x48, 0xe9, 0xeb, 0x6c, 0x56, 0x26, 0x98, 0x5a, 0xe9, 0x4f, 0x0f, 0x28, 0xbd, 0x12,
+	0x1f, 0x3d, 0x4b, 0xf4, 0xd8, 0x29, 0xc7, 0xeb, 0xb5, 0x5d, 0x25, 0x57, 0x3a, 0x57, 0x9e, 0x50,
+	0x13, 0x40, 0x30, 0x22, 0xbf, 0xab, 0xbc, 0x67, 0xc1, 0x91, 0x03, 0x84, 0x02, 0xaf, 0xfd, 0x36,
+	0xb1, 0x17, 0xa0, 0x34, 0x10, 0xbf, 0x97, 0x63, 0xab, 0xc6, 0xb9, 0xa0, 0xdd, 0xf7, 0x0e, 0x9f,
+	0x6f, 0xd3, 0x6c, 0xf5, 0x4b, 0x84, 0x7f, 0x2a, 0x25, 0xfc, 0x5a, 0x42, 0xa6, 0x2d, 0xb5, 0xfc,
+	0x93, 0x3c, 0xa8, 0x55, 0x9a, 0x9c, 0x63, 0x84, 0xf7, 0x3c, 0x63, 0xfa, 0xce, 0x0c, 0x39, 0x73,
+	0x0a, 0x02, 0x24, 0xd5, 0xd8, 0x5c, 0xf8, 0x54, 0xc5, 0x61, 0x44, 0xd4, 0x02, 0x08, 0xb8, 0x8e,
+	0x24, 0x9c, 0xa7, 0x2a, 0x02, 0x2b, 0x86, 0xea, 0x17, 0x0a, 0xe1, 0x5e, 0x88, 0x87, 0xc7, 0x78,
+	0x71, 0x8a, 0x5c, 0x61, 0x02, 0xc0, 0x34, 0x38, 0xf1, 0xb2, 0x52, 0xe5, 0x8a, 0x2d, 0xed, 0x5d,
+	0x81, 0x46, 0xd7, 0x0f, 0x49, 0x15, 0x66, 0x88, 0xbd, 0x7a, 0x88, 0x34, 0xb6, 0xfa, 0xf1, 0x51,
+	0xa3, 0x79, 0xd4, 0x04, 0x27, 0x39, 0x2b, 0xc3, 0x0f, 0x84, 0x6c, 0x22, 0x80, 0x3e, 0x84, 0xe0,
+	0xfe, 0x10, 0xc4, 0xbe, 0x48, 0x62, 0xaf, 0x87, 0xc8, 0x9e, 0x43, 0xd7, 0x8f, 0x29, 0x79, 0x2d,
+	0x38, 0xf4, 0x5b, 0xfc, 0x80, 0x9d, 0x1a, 0x95, 0x47, 0x1b, 0x56, 0xe6, 0x2c, 0x65, 0x4b, 0xa9,
+	0x54, 0x7e, 0x50, 0xa5, 0x26, 0xbe, 0x2e, 0xf1, 0x8b, 0x1c, 0x3b, 0x7b, 0xb7, 0xdf, 0xd6, 0x21,
+	0x48, 0x92, 0xfb, 0x28, 0x71, 0x81, 0x00, 0x43, 0x8a, 0x8b, 0x14, 0x76, 0xf8, 0x90, 0xe4, 0x25,
+	0xfa, 0xc6, 0xeb, 0x15, 0x80, 0xd1, 0xc5, 0x02, 0x59, 0xad, 0xd0, 0x43, 0xbc, 0x0b, 0x7f, 0xcf,
+	0x54, 0x12, 0x66, 0xe5, 0x92, 0xfe, 0x9e, 0xae, 0x1d, 0x58, 0x21, 0x52, 0xd1, 0x0e, 0x91, 0xc4,
+	0x9f, 0xe7, 0x58, 0x75, 0xf4, 0xd1, 0xc9, 0xba, 0x66, 0xd7, 0x69, 0xa2, 0x7e, 0x13, 0x3c, 0x7a,
+	0xa4, 0xd8, 0xaf, 0x87, 0x32, 0xc7, 0x01, 0xe1, 0x0e, 0xfa, 0x49, 0x5d, 0xa3, 0xa0, 0x73, 0x1c,
+	0x09, 0xd7, 0x34, 0x19, 0x23, 0x3f, 0x65, 0x19, 0x79, 0x32, 0xa4, 0x60, 0x49, 0xf6, 0xe1, 0x66,
+	0xa7, 0x89, 0xd7, 0x7a, 0x28, 0x7e, 0x83, 0x9d, 0xcb, 0xa0, 0x54, 0x56, 0x20, 0xdf, 0x65, 0xb3,
+	0x21, 0x51, 0xad, 0x4d, 0xd2, 0x95, 0x24, 0xe7, 0xcb, 0x3c, 0xa1, 0xa3, 0xbf, 0x11, 0xaf, 0xb3,
+	0xa5, 0xc1, 0xe2, 0x09, 0x46, 0xc9, 0xba, 0x0e, 0xe0, 0xc7, 0x32, 0x4c, 0xca, 0x3b, 0x36, 0x08,
+	0x6c, 0x63, 0x39, 0x55, 0x2c, 0x41, 0x79, 0xed, 0xba, 0xca, 0x6d, 0xcc, 0x39, 0xf4, 0x1b, 0xd3,
+	0x2b, 0xef, 0x29, 0x1c, 0x3f, 0x22, 0x76, 0x48, 0x49, 0xb1, 0x20, 0xe2, 0xff, 0x72, 0x6c, 0xde,
+	0xae, 0x99, 0x20, 0x6b, 0x42, 0x70, 0x1f, 0x92, 0xeb, 0xe0, 0x3c, 0x69, 0x80, 0xce, 0x1c, 0xc4,
+	0xcb, 0x07, 0x12, 0x23, 0xe5, 0x7b, 0xcc, 0x98, 0x5f, 0x61, 0x65, 0x9a, 0x84, 0x85, 0x2a, 0x48,
+	0xfd, 0x3d, 0xc5, 0xf4, 0x79, 0x0d, 0x84, 0xe4, 0xdf, 0xc3, 0xc0, 0x32, 0xea, 0xc1, 0x17, 0x6e,
+	0xbb, 0x41, 0x61, 0x9d, 0xd6, 0x83, 0xb2, 0x82, 0x7e, 0x4a, 0x40, 0x7e, 0x99, 0xcd, 0x93, 0x62,
+	0x34, 0x9a, 0x6e, 0x84, 0x59, 0xa3, 0x14, 0xc2, 0x12, 0xc1, 0x36, 0x09, 0x84, 0x8c, 0x09, 0xd1,
+	0x9a, 0x37, 0xbd, 0x0e, 0x96, 0x2b, 0xa5, 0x30, 0xda, 0x20, 0x9d, 0xec, 0x02, 0x23, 0x31, 0x53,
+	0x45, 0xe7, 0xd9, 0x22, 0xb1, 0x2c, 0xca, 0x64, 0x17, 0xe0, 0x8e, 0x02, 0x8b, 0xab, 0xac, 0x64,
+	0xd5, 0x7d, 0x50, 0x4b, 0x95, 0x61, 0x93, 0x3a, 0xaf, 0x46, 0xe2, 0x8f, 0x20, 0x2e, 0xd9, 0xfe,
+	0x64, 0x67, 0x67, 0x33, 0xf4, 0x28, 0x7d, 0xc3, 0x63, 0x03, 0x4b, 0xfa, 0xb0, 0x8a, 0xc5, 0x71,
+	0x33, 0x46, 0x5c, 0xcf, 0x8d, 0xa2, 0xc3, 0x20, 0xd4, 0x06, 0xd4, 0x8c, 0xb9, 0x60, 0xf3, 0xe0,
+	0x21, 0xdb, 0xee, 0x2e, 0x98, 0x4c, 0xd4, 0x41, 0xc5, 0x2d, 0x1b, 0x86, 0x37, 0x19, 0x7a, 0x6e,
+	0x8b, 0x62, 0x15, 0xb8, 0x49, 0xfc, 0x8d, 0x17, 0x73, 0x18, 0xfa, 0x64, 0x25, 0x11, 0x28, 0x07,
+	0xe2, 0x13, 0xb6, 0x32, 0x40, 0x18, 0xf9, 0xc8, 0xb7, 0x59, 0xa9, 0x99, 0x80, 0x94, 0x50, 0x56,
+	0x8c, 0x50, 0x0e, 0x7c, 0xe2, 0xd8, 0x93, 0xc5, 0xdf, 0xe7, 0x58, 0xf9, 0x5e, 0xe8, 0x46, 0xfd,
+	0xd0, 0x03, 0xb7, 0x89, 0x46, 0x6f, 0x32, 0x9f, 0x75, 0x86, 0x82, 0xf2, 0x86, 0xd7, 0xf7, 0xd5,
+	0xd9, 0x70, 0xd6, 0xbd, 0xbe, 0x8f, 0xb6, 0xde, 0x83, 0x75, 0xbd, 0x56, 0xc3, 0x8d, 0x95, 0xbf,
+	0x2c, 0x4a, 0xc0, 0x06, 0x45, 0x31, 0xda, 0xab, 0x4b, 0xd7, 0xa5, 0x87, 0x68, 0xb1, 0x74, 0x9e,
+	0x12, 0xd1, 0x75, 0x97, 0x9d, 0x04, 0x80, 0x57, 0x26, 0xd7, 0x80, 0x2b, 0x26, 0xfb, 0x28, 0x47,
+	0xe2, 0x88, 0x2d, 0x6c, 0xf7, 0x63, 0xdd, 0x28, 0x40, 0x83, 0x62, 0x19, 0xa2, 0x5c, 0x2a, 0x57,
+	0x43, 0xbd, 0x07, 0x16, 0xc7, 0xc6, 0xa2, 0xeb, 0xa1, 0x6d, 0x11, 0x0a, 0x29, 0x8b, 0x90, 0xca,
+	0xef, 0xa6, 0xd2, 0xf9, 0x9d, 0xf8, 0x35, 0x10, 0x96, 0x07, 0x9b, 0x9b, 0x07, 0x5e, 0xf3, 0xf1,
+	0xd7, 0xec, 0xf5, 0x31, 0x62, 0x5c, 0x48, 0xd6, 0xa6, 0x63, 0x81, 0xca, 0xa8, 0x8a, 0x4e, 0x23,
+	0x3e, 0xea, 0x69, 0x59, 0x2c, 0x29, 0xd8, 0x0e, 0x80, 0x30, 0x31, 0xd3, 0xd5, 0xb0, 0xc4, 0x59,
+	0x50, 0x09, 0x8c, 0xaf, 0xb0, 0xe9, 0xbd, 0x46, 0xb3, 0x6b, 0x92, 0x87, 0xbd, 0x4d, 0x50, 0xa0,
+	0x4b, 0x6c, 0x5e, 0xa6, 0x4d, 0x0d, 0x89, 0x93, 0x21, 0x3e, 0x93, 0xb0, 0xfb, 0x38, 0x03, 0x36,
+	0x0d, 0xbd, 0xa6, 0x07, 0x49, 0x63, 0xab, 0xd1, 0xf1, 0x9b, 0x5a, 0x4f, 0x35, 0x6c, 0xdb, 0x6f,
+	0xe2, 0x14, 0xb0, 0x33, 0xa0, 0x6c, 0x6a, 0x8a, 0x52, 0x54, 0x0d, 0xc3, 0x29, 0x26, 0x50, 0x9f,
+	0xb5, 0x03, 0x75, 0x60, 0x6d, 0xc7, 0x8f, 0x20, 0xcd, 0x6c, 0x1e, 0xa8, 0xba, 0xb4, 0x19, 0x0f,
+	0xd6, 0x0e, 0xe6, 0x86, 0x6a, 0x07, 0xe2, 0x63, 0xb6, 0xf2, 0x19, 0x4e, 0x95, 0xa1, 0xe0, 0xb8,
+	0x58, 0x8f, 0xce, 0x11, 0xf5, 0x3b, 0xc0, 0xbb, 0xe0, 0xb1, 0xa7, 0x0d, 0x64, 0x49, 0xc2, 0x76,
+	0x10, 0x24, 0xfe, 0x32, 0xa7, 0x83, 0xf4, 0x4d, 0xba, 0x7b, 0x54, 0x4e, 0x8b, 0xd1, 0xf4, 0xdb,
+	0x5a, 0x3e, 0x3f, 0xfa, 0x7e, 0x0b, 0xf6, 0xfd, 0xe2, 0x0a, 0x18, 0xd4, 0x48, 0x1d, 0xa0, 0xdf,
+	0xfc, 0x65, 0x9d, 0xe2, 0x12, 0x2f, 0x47, 0x64, 0xb2, 0x0a, 0x3d, 0x44, 0xf2, 0xcc, 0x30, 0xc9,
+	0xbb, 0x10, 0x7d, 0xd2, 0xe4, 0x2d, 0x6f, 0xb7, 0x4f, 0xf6, 0xf7, 0xf9, 0xe4, 0x10, 0xad, 0x7e,
+	0x5f, 0x16, 0xb7, 0x95, 0x7c, 0x98, 0xb1, 0xf8, 0x57, 0x4c, 0xd5, 0x70, 0x79, 0xea, 0x47, 0xc9,
+	0x94, 0x4f, 0x9f, 0x2b, 0x67, 0x9d, 0x4b, 0x73, 0x2b, 0x6f, 0x71, 0xab, 0x92, 0xb4, 0xe5, 0x24,
+	0x5f, 0x4c, 0x0f, 0xee, 0x2e, 0xdc, 0xbd, 0xce, 0x13, 0x64, 0xa2, 0x76, 0xcd, 0xe2, 0x43, 0x6a,
+	0xb7, 0x9a, 0x4e, 0x12, 0x64, 0x46, 0x65, 0xbe, 0xab, 0xbe, 0xc3, 0xca, 0x29, 0xd4, 0x24, 0x95,
+	0x06, 0xf1, 0xb3, 0x9c, 0xce, 0x38, 0x92, 0xed, 0x26, 0xe4, 0xda, 0x45, 0x94, 0x51, 0xf8, 0xb6,
+	0x21, 0x13, 0x03, 0x99, 0x2e, 0x30, 0x02, 0x7d, 0x0f, 0x21, 0x7c, 0x1d, 0x83, 0xac, 0x38, 0xf4,
+	0x3d, 0x9d, 0x8c, 0x56, 0xb2, 0xce, 0xe8, 0xe8, 0x89, 0xe2, 0x53, 0xc6, 0x25, 0x59, 0xd8, 0x89,
+	0x7b, 0xce, 0xeb, 0xd4, 0xd7, 0x53, 0x48, 0xae, 0x47, 0xb4, 0x58, 0xc9, 0x5a, 0x77, 0xe4, 0x0d,
+	0x5a, 0x46, 0x30, 0x9f, 0x36, 0x82, 0x89, 0xcc, 0x16, 0x8e, 0x95, 0x59, 0xf1, 0x23, 0x48, 0x9f,
+	0xe9, 0xd7, 0x0e, 0x38, 0xd4, 0xe7, 0x23, 0x1e, 0x6b, 0xcf, 0x5e, 0xe4, 0x87, 0x49, 0xe7, 0xa8,
+	0xa0, 0x6a, 0xcf, 0x12, 0xaa, 0x2a, 0xb7, 0xf0, 0xf5, 0x5e, 0xc3, 0xaa, 0x4b, 0x4c, 0xef, 0x61,
+	0x4b, 0x41, 0xfc, 0x55, 0x5e, 0xd7, 0x8d, 0x90, 0x82, 0x09, 0xb7, 0x4e, 0xd6, 0x2c, 0x58, 0x6b,
+	0x8e, 0xa0, 0x68, 0x6a, 0x14, 0x45, 0x2f, 0xb3, 0xc5, 0x90, 0xdc, 0x68, 0x32, 0x4f, 0x5a, 0xcb,
+	0x05, 0x0d, 0x4e, 0xda, 0x3c, 0x7e, 0xb7, 0x11, 0x1d, 0x75, 0xa5, 0xad, 0x04, 0xff, 0xe4, 0x77,
+	0x1f, 0xc1, 0x88, 0xdc, 0x81, 0x47, 0xa1, 0x94, 0xf2, 0x71, 0x7a, 0x48, 0x69, 0x90, 0x22, 0x01,
+	0x5c, 0x6a, 0x91, 0x2e, 0x6d, 0x4e, 0x41, 0x36, 0xa8, 0x21, 0x63, 0xb6, 0x76, 0x75, 0xce, 0xc3,
+	0x34, 0x08, 0x26, 0x80, 0x47, 0xee, 0xf5, 0xa3, 0x03, 0x89, 0x66, 0xd2, 0x23, 0x4b, 0xc0, 0x46,
+	0x2c, 0x7e, 0x1f, 0x7c, 0x0d, 0x04, 0x98, 0x1d, 0xb8, 0xd2, 0xe7, 0x96, 0xb7, 0xc1, 0xba, 0xd4,
+	0x98, 0x92, 0x84, 0xe5, 0xf8, 0xa6, 0xb3, 0xf2, 0xa7, 0x99, 0x54, 0xba, 0x8b, 0xc1, 0xa7, 0x8a,
+	0xc2, 0xe5, 0x15, 0xcd, 0xd2, 0x66, 0xf3, 0x1a, 0x48, 0x37, 0xf5, 0x2a, 0x5b, 0x6e, 0x06, 0x61,
+	0xe8, 0xb5, 0x55, 0x07, 0x0f, 0x3f, 0x55, 0xae, 0x65, 0xc9, 0x42, 0xc8, 0x28, 0x1a, 0x68, 0xd0,
+	0x7d, 0xae, 0x39, 0x19, 0x88, 0xa8, 0xa1, 0xf8, 0x3b, 0x30, 0x79, 0x86, 0x21, 0x2a, 0xf2, 0x07,
+	0x21, 0xb0, 0x97, 0x36, 0x9c, 0x29, 0x5b, 0x50, 0x69, 0x13, 0xec, 0xc2, 0x4e, 0x3e, 0xb3, 0xb0,
+	0x53, 0x18, 0x5d, 0xd8, 0x99, 0x4a, 0x17, 0x76, 0xc6, 0x96, 0x6e, 0x32, 0xd8, 0x25, 0xfe, 0x1a,
+	0x62, 0xbb, 0x54, 0x8f, 0x0d, 0x63, 0x83, 0x0e, 0x88, 0x9d, 0x95, 0xe8, 0xce, 0xc2, 0x98, 0xd8,
+	0x86, 0x28, 0xf7, 0x69, 0xc3, 0x2a, 0x38, 0xcd, 0xc2, 0xf8, 0xa1, 0x22, 0x4d, 0x67, 0x9f, 0x85,
+	0x63, 0xb2, 0xcf, 0xa9, 0x63, 0xb3, 0xcf, 0xe9, 0x63, 0xb2, 0xcf, 0x99, 0x54, 0xf6, 0x29, 0x7e,
+	0x95, 0x2d, 0xef, 0x80, 0x00, 0xea, 0xc2, 0xe0, 0xb1, 0xd2, 0x68, 0x09, 0x51, 0x7e, 0x74, 0xc9,
+	0xd2, 0x2e, 0x94, 0xfe, 0x3b, 0x70, 0x24, 0x55, 0x54, 0x47, 0x85, 0xd5, 0xfd, 0x12, 0x9d, 0x46,
+	0xca, 0xf5, 0x75, 0x1b, 0x45, 0x67, 0x91, 0xc0, 0xe4, 0x27, 0xa0, 0x88, 0x81, 0x0e, 0xaa, 0xd4,
+	0x08, 0xf3, 0x8f, 0x26, 0x1c, 0xd7, 0xdf, 0x53, 0x55, 0xda, 0xc4, 0xff, 0x2f, 0xa6, 0xe0, 0x40,
+	0x2b, 0xb0, 0x78, 0x37, 0x04, 0x79, 0xc2, 0x29, 0x92, 0x59, 0xb3, 0x34, 0x96, 0x28, 0xcc, 0xa6,
+	0xda, 0x88, 0x52, 0xb9, 0x38, 0x8d, 0x01, 0x85, 0x3d, 0x59, 0x50, 0x98, 0x43, 0x37, 0xf4, 0x1a,
+	0xe9, 0xa4, 0x7c, 0x51, 0xc3, 0x15, 0x8d, 0xe2, 0x9f, 0xa4, 0xcc, 0x02, 0xdf, 0xb0, 0x17, 0xf6,
+	0x01, 0xe4, 0x64, 0xbd, 0x93, 0x1f, 0xb0, 0xce, 0x56, 0x20, 0x35, 0x82, 0x5f, 0x90, 0xb5, 0xf5,
+	0xdc, 0x10, 0x32, 0x1b, 0xb8, 0x43, 0x5d, 0x6d, 0xe5, 0x1a, 0xf5, 0xd0, 0x60, 0x50, 0x1b, 0x4c,
+	0x69, 0xa7, 0x01, 0x09, 0x99, 0x4e, 0xc0, 0xcb, 0x06, 0xfa, 0x10, 0x80, 0x52, 0x7a, 0x64, 0xd9,
+	0x5e, 0x09, 0xb6, 0x1a, 0x92, 0xf4, 0x48, 0x16, 0x79, 0x2d, 0x95, 0x07, 0x24, 0x00, 0xd1, 0x67,
+	0x4b, 0xc9, 0x59, 0x8e, 0xcf, 0x4d, 0xac, 0x2d, 0xf2, 0xe9, 0x2d, 0x6e, 0xb3, 0x99, 0x7d, 0x64,
+	0x43, 0x44, 0x21, 0xbd, 0xed, 0x7c, 0x07, 0xf8, 0xe4, 0xa8, 0x79, 0x22, 0x80, 0x90, 0x60, 0xb0,
+	0x51, 0x02, 0x11, 0x84, 0xdb, 0x7c, 0xec, 0xb5, 0x94, 0xce, 0xc8, 0x01, 0x4a, 0x04, 0x84, 0xaa,
+	0x91, 0x4a, 0x24, 0x20, 0x7f, 0x94, 0x23, 0x6c, 0xef, 0x36, 0xd1, 0x5c, 0x34, 0xfb, 0xd4, 0xee,
+	0x57, 0x73, 0xa4, 0x18, 0x2e, 0x5b, 0x98, 0x6d, 0x42, 0x88, 0xbf, 0x9d, 0x66, 0x95, 0xe1, 0x9a,
+	0x81, 0xea, 0x1e, 0xd9, 0x99, 0x47, 0x6e, 0xa0, 0xb3, 0xa4, 0xdd, 0x77, 0x3e, 0xed, 0xbe, 0xbf,
+	0x49, 0x55, 0x1d, 0xd1, 0xe4, 0x9f, 0x7d, 0xd1, 0x26, 0x7f, 0x71, 0x74, 0x93, 0x7f, 0xb8, 0x59,
+	0x35, 0x37, 0xaa, 0x59, 0x35, 0xf0, 0x2c, 0x81, 0x0d, 0x3d, 0x4b, 0x38, 0xf6, 0x65, 0x4c, 0xe9,
+	0xf8, 0x97, 0x31, 0xa6, 0x13, 0x36, 0x7f, 0xec, 0x4b, 0x80, 0xf2, 0x0b, 0xbe, 0x04, 0x58, 0x98,
+	0xf0, 0x25, 0xc0, 0xe2, 0x44, 0x2f, 0x01, 0x96, 0xc6, 0xbf, 0x04, 0x58, 0x4e, 0xbf, 0x04, 0x48,
+	0x77, 0xed, 0xf9, 0x60, 0xd7, 0x5e, 0xfc, 0x3c, 0xc7, 0xce, 0x65, 0x49, 0x30, 0x15, 0x28, 0x32,
+	0xd4, 0x16, 0x4c, 0x13, 0xbd, 0xf5, 0xf2, 0x92, 0x47, 0x18, 0x79, 0x92, 0xf1, 0x05, 0x09, 0x36,
+	0x5a, 0xf0, 0x3e, 0x9b, 0xd3, 0x33, 0xb4, 0x22, 0x5f, 0x4e, 0x04, 0x2c, 0x63, 0x67, 0x27, 0xf9,
+	0x46, 0x74, 0xd8, 0xc5, 0xa1, 0x69, 0x41, 0xbb, 0xbd, 0xeb, 0x8e, 0x4d, 0xda, 0x6d, 0x05, 0xcc,
+	0x0f, 0x28, 0xa0, 0x55, 0x63, 0x28, 0xa4, 0x8a, 0x9d, 0xbf, 0xc8, 0xb1, 0x69, 0xc9, 0xba, 0x05,
+	0x96, 0x37, 0x2b, 0xc2, 0xaf, 0xc1, 0x94, 0x36, 0x3f, 0xdc, 0x0e, 0xff, 0xa6, 0x35, 0xd8, 0x2a,
+	0xf5, 0xce, 0xa6, 0x4b, 0xbd, 0xf6, 0xd1, 0x8b, 0xc3, 0x47, 0xd7, 0x95, 0xea, 0x39, 0xbb, 0x52,
+	0x2d, 0x2e, 0xa3, 0x07, 0x02, 0x8a, 0xad, 0x97, 0x0f, 0x03, 0x3c, 0x10, 0xdf, 0x62, 0x73, 0x34,
+	0x85, 0x44, 0xe3, 0x1a, 0x9b, 0x21, 0x99, 0xd3, 0x65, 0xab, 0x05, 0xcb, 0x40, 0x03, 0xd8, 0x51,
+	0x58, 0xf1, 0xeb, 0xba, 0xad, 0xb3, 0x11, 0x36, 0x0f, 0x48, 0x36, 0xe4, 0xb5, 0x99, 0x46, 0x4d,
+	0x6e, 0x64, 0xa3, 0x26, 0x6f, 0x35, 0x6a, 0x6c, 0xa2, 0x0b, 0x29, 0xa2, 0x9f, 0xb0, 0x95, 0x81,
+	0xc5, 0xa9, 0xd8, 0x02, 0x01, 0x73, 0xb7, 0xdf, 0x69, 0x60, 0x9c, 0x10, 0x29, 0xd3, 0x5f, 0x04,
+	0xc0, 0x7d, 0x1c, 0xa3, 0xa1, 0x41, 0xa4, 0x2e, 0x63, 0x49, 0x17, 0xc0, 0x00, 0xa4, 0xfa, 0x4e,
+	0x98, 0xba, 0xe3, 0x04, 0xaa, 0x55, 0x1e, 0x19, 0x07, 0x80, 0x1f, 0x39, 0x0a, 0x24, 0x7e, 0x92,
+	0x63, 0x25, 0xcb, 0x38, 0x8c, 0xac, 0xe9, 0x82, 0x97, 0x09, 0xf6, 0xf6, 0x22, 0x4f, 0x47, 0x65,
+	0x6a, 0x64, 0x52, 0xed, 0x82, 0x95, 0x6a, 0x43, 0x7c, 0xdc, 0xf6, 0xe3, 0xb8, 0xed, 0x35, 0x30,
+	0x65, 0x70, 0xbb, 0x2a, 0xe6, 0x9e, 0x97, 0xc0, 0x7b, 0x04, 0x23, 0x8e, 0x35, 0xdd, 0xb6, 0x2c,
+	0x3d, 0xe4, 0x1c, 0x39, 0x10, 0x5f, 0xb0, 0xd3, 0x0f, 0xba, 0xdf, 0xa7, 0x62, 0xcd, 0x8b, 0x74,
+	0x90, 0x47, 0x45, 0xb6, 0x59, 0xdd, 0x90, 0x6d, 0x36, 0x07, 0xd1, 0xab, 0x6a, 0x86, 0x8e, 0x6a,
+	0xbf, 0x1c, 0x1b, 0xdb, 0x0d, 0x25, 0xb7, 0x31, 0x5b, 0x75, 0x3c, 0xa9, 0x2b, 0x2f, 0xd4, 0xfa,
+	0xbb, 0x99, 0xd4, 0x26, 0xa5, 0xa9, 0xe1, 0x46, 0x24, 0x0d, 0xb9, 0x49, 0xbb, 0xf1, 0x4b, 0xb6,
+	0xa8, 0x77, 0x6d, 0x1d, 0x73, 0x94, 0x51, 0xbe, 0x3a, 0xe1, 0x4b, 0x61, 0x74, 0x47, 0x7b, 0xca,
+	0x2e, 0x94, 0x8d, 0x6e, 0x55, 0x7f, 0x97, 0x9d, 0x1e, 0x3a, 0x34, 0xc9, 0xee, 0xfa, 0x60, 0xdf,
+	0x34, 0x89, 0x7c, 0x06, 0xe8, 0x4d, 0xce, 0xf2, 0x5d, 0x56, 0xb9, 0xf7, 0x14, 0xc9, 0xb5, 0x1f,
+	0x1d, 0x8c, 0x0d, 0xbf, 0x21, 0x98, 0x09, 0x9e, 0xa8, 0xc6, 0x14, 0x56, 0x53, 0xe5, 0x50, 0xec,
+	0xb1, 0x05, 0x95, 0x83, 0x43, 0x88, 0x1b, 0xed, 0xc9, 0x17, 0x50, 0x8a, 0xdf, 0x39, 0x9b, 0xdf,
+	0xab, 0xa6, 0xae, 0x20, 0x2f, 0x59, 0x97, 0xbe, 0x30, 0xe3, 0xd6, 0xb1, 0x01, 0xd0, 0xd0, 0xf7,
+	0x54, 0x79, 0xb4, 0xac, 0xa1, 0x9f, 0x20, 0x50, 0xfc, 0x4d, 0x8e, 0xad, 0x58, 0xf4, 0xda, 0xbb,
+	0x8d, 0x22, 0x18, 0x0c, 0xb0, 0x9b, 0xcc, 0x56, 0x5b, 0xda, 0x20, 0xfe, 0x5a, 0x12, 0x4c, 0xca,
+	0xfb, 0x3f, 0x33, 0x50, 0xe8, 0xd0, 0x5b, 0x24, 0x51, 0xa6, 0xc5, 0x05, 0x59, 0xe5, 0xd3, 0x43,
+	0x7a, 0x1a, 0x25, 0x9f, 0x33, 0x4b, 0x7d, 0x2b, 0x3a, 0x66, 0x2c, 0xfe, 0x37, 0xc7, 0x66, 0x3f,
+	0xf3, 0x76, 0xf1, 0x4d, 0x70, 0x16, 0xb5, 0x90, 0xf2, 0x1f, 0xca, 0x19, 0x56, 0x77, 0x56, 0x41,
+	0x00, 0xbd, 0xc4, 0x0a, 0xfd, 0xb0, 0xad, 0xc4, 0x07, 0x7f, 0xf2, 0x37, 0xd9, 0xec, 0x81, 0xe7,
+	0xb6, 0x30, 0x70, 0x97, 0xd5, 0xa6, 0xe4, 0xf5, 0xb0, 0xda, 0xaa, 0xf6, 0xa1, 0xc4, 0xab, 0x92,
+	0x93, 0x9a, 0x8d, 0xa6, 0xc3, 0x2e, 0x2c, 0x47, 0xaa, 0xf1, 0x30, 0x6f, 0x55, 0x96, 0xa3, 0xea,
+	0xdb, 0x6c, 0xde, 0xfe, 0x7a, 0xa2, 0x5a, 0xdb, 0x03, 0xb6, 0xfc, 0x99, 0x26, 0x7c, 0xdc, 0xa3,
+	0xb8, 0xe3, 0x8f, 0x2d, 0xde, 0x61, 0x25, 0xb5, 0x14, 0xb9, 0x90, 0x9b, 0xac, 0xa8, 0x70, 0x5a,
+	0xd6, 0x97, 0x06, 0x0f, 0xed, 0x98, 0x19, 0xe2, 0x10, 0x33, 0xc0, 0x36, 0x64, 0x30, 0xad, 0xe7,
+	0x7a, 0x5f, 0x47, 0x45, 0x18, 0xfa, 0x1c, 0xab, 0x28, 0x05, 0x5d, 0x84, 0x21, 0xc8, 0x06, 0x65,
+	0xd1, 0xbd, 0x7e, 0x08, 0x4c, 0x34, 0x4d, 0x8f, 0x59, 0x1a, 0x6f, 0xc4, 0xe2, 0x1e, 0x96, 0xc5,
+	0xac, 0x8d, 0x89, 0xf6, 0xdb, 0x83, 0x6f, 0x9a, 0xec, 0xc7, 0x5f, 0xd6, 0x64, 0x23, 0x6b, 0xeb,
+	0xff, 0x00, 0x52, 0xf3, 0xa1, 0x9c, 0xc2, 0x7f, 0x13, 0x44, 0xdf, 0x3c, 0x96, 0xdf, 0x3c, 0x70,
+	0xdb, 0x6d, 0x0f, 0x6b, 0xd6, 0x42, 0xff, 0x09, 0xc3, 0x08, 0xa4, 0xd2, 0xe7, 0xea, 0x95, 0x63,
+	0xe7, 0xa8, 0x7a, 0xc7, 0xe7, 0xac, 0xa8, 0xd0, 0x1e, 0x7f, 0xd5, 0xfc, 0x5d, 0x84, 0xd7, 0xea,
+	0x4b, 0x6d, 0xd1, 0xc4, 0xd9, 0x7f, 0xa5, 0x21, 0x57, 0xbf, 0x3c, 0xa0, 0x32, 0xc3, 0x7f, 0xc7,
+	0xb1, 0xfe, 0x6f, 0x57, 0x19, 0xb7, 0xf4, 0x76, 0xdb, 0xed, 0x82, 0x98, 0x85, 0x7c, 0x1f, 0x5d,
+	0xf1, 0x3e, 0xb0, 0xc6, 0x0b, 0xed, 0x77, 0xfc, 0x17, 0x46, 0x3d, 0x88, 0x4a, 0x04, 0xa9, 0xba,
+	0x5a, 0x93, 0x7f, 0x03, 0x53, 0xd3, 0x61, 0x6d, 0xed, 0x1e, 0xfe, 0x81, 0x8c, 0xa8, 0x7c, 0xf5,
+	0x2f, 0xff, 0xf3, 0xd3, 0x3c, 0x17, 0xe5, 0xba, 0xa5, 0xe2, 0xd1, 0xdb, 0xb9, 0x1b, 0x1c, 0xec,
+	0xd3, 0x07, 0x5e, 0x3c, 0xc9, 0x1e, 0x23, 0x1f, 0x65, 0x89, 0x0b, 0xb4, 0x43, 0x85, 0xaf, 0xa6,
+	0x76, 0xa8, 0x3f, 0x93, 0x32, 0xf5, 0x25, 0xff, 0x11, 0x5b, 0x78, 0x94, 0xde, 0x67, 0xe4, 0x3a,
+	0xd5, 0xc4, 0xca, 0xa4, 0x3b, 0x59, 0xe2, 0x3d, 0xda, 0xe0, 0x8e, 0xc8, 0xd8, 0x00, 0xce, 0xf2,
+	0xf9, 0xd9, 0x6a, 0x36, 0x92, 0x3f, 0xd6, 0x72, 0xf7, 0x75, 0xf0, 0x53, 0x9d, 0xf6, 0x46, 0xd6,
+	0x69, 0x0f, 0xd8, 0x1c, 0x70, 0x55, 0x69, 0xd6, 0xda, 0x80, 0x14, 0x58, 0xeb, 0x0f, 0x16, 0x8f,
+	0x45, 0x9d, 0x16, 0x7e, 0x85, 0xbf, 0x3c, 0x7a, 0x61, 0xf5, 0xb7, 0x43, 0x00, 0x90, 0x4a, 0xf9,
+	0x25, 0xff, 0xef, 0x1c, 0x9b, 0x7b, 0x64, 0xb6, 0x1a, 0x5c, 0x2f, 0x9b, 0x9d, 0x7f, 0x91, 0xa3,
+	0x9d, 0xfe, 0x34, 0x27, 0x4e, 0xba, 0x15, 0x72, 0xf8, 0x66, 0x75, 0x92, 0xd9, 0x57, 0xc4, 0x85,
+	0xe3, 0x67, 0xd3, 0xa4, 0xea, 0xf8, 0x49, 0x3c, 0xc4, 0x76, 0x14, 0x5e, 0xde, 0x78, 0x96, 0x66,
+	0x5d, 0x99, 0xe2, 0xec, 0x8d, 0x13, 0x73, 0xf6, 0x29, 0x2b, 0x41, 0x32, 0x8d, 0x35, 0x17, 0xfc,
+	0x13, 0x95, 0xe7, 0xd9, 0xf2, 0x0d, 0xda, 0xf2, 0xb6, 0xa8, 0x9d, 0x70, 0xcb, 0x7a, 0x28, 0xb7,
+	0x3a, 0x64, 0x15, 0x23, 0x3d, 0x11, 0xd0, 0x30, 0x89, 0xc4, 0xae, 0x0c, 0x90, 0x89, 0xe6, 0x55,
+	0x5c, 0x23, 0x42, 0x2e, 0xf1, 0x31, 0x9c, 0xe6, 0xf7, 0x59, 0xc9, 0x7a, 0xf1, 0xc7, 0xcf, 0x26,
+	0x6b, 0x0d, 0x3d, 0x22, 0xad, 0x56, 0x47, 0x21, 0x55, 0xd4, 0xf5, 0x1d, 0x36, 0x67, 0x5e, 0x34,
+	0xda, 0x8c, 0x1b, 0x78, 0x06, 0x5a, 0xad, 0x0c, 0xa3, 0xd4, 0x0a, 0x0f, 0xc0, 0x5c, 0xa8, 0xa7,
+	0x9c, 0xfa, 0x99, 0xa0, 0x99, 0x3b, 0xfa, 0x8d, 0x67, 0xd6, 0x2d, 0xf0, 0xdf, 0xca, 0xb1, 0x25,
+	0xc3, 0x4e, 0x9d, 0x95, 0x1c, 0x73, 0x9b, 0x6b, 0x23, 0x5f, 0xd6, 0x11, 0x1f, 0xdf, 0x24, 0x3e,
+	0xbe, 0xc6, 0xeb, 0x27, 0xbd, 0x50, 0xdd, 0xce, 0xff, 0xdd, 0x1c, 0x2b, 0xa7, 0x9e, 0xe3, 0xf1,
+	0xf3, 0x56, 0x1c, 0x3a, 0xfc, 0x4c, 0x2f, 0x53, 0xa4, 0x36, 0x88, 0x82, 0x77, 0xc4, 0x1b, 0x13,
+	0x52, 0x50, 0x97, 0xf9, 0x17, 0xea, 0xd2, 0x1f, 0xe4, 0xd8, 0xa2, 0x7a, 0x10, 0x67, 0x6e, 0xfa,
+	0xe2, 0xd0, 0x7b, 0xe9, 0xf4, 0x0b, 0x3e, 0xfb, 0xa6, 0xd2, 0x13, 0xc4, 0x26, 0x51, 0xf4, 0xae,
+	0xb8, 0x73, 0x52, 0x8a, 0x74, 0xdc, 0x5a, 0xef, 0xc9, 0x15, 0x90, 0xa6, 0xdf, 0x81, 0xe8, 0x15,
+	0xdb, 0x3e, 0x83, 0xef, 0x4d, 0xc6, 0x49, 0xfb, 0xb9, 0xac, 0xd7, 0x1d, 0x74, 0x5d, 0xeb, 0x44,
+	0xda, 0xcd, 0x4c, 0x0b, 0xd7, 0xf9, 0x22, 0x8e, 0x6f, 0x59, 0xaf, 0x40, 0x90, 0x92, 0x23, 0x36,
+	0x0f, 0x1a, 0xb7, 0x7f, 0x12, 0xe3, 0x9d, 0xc4, 0x28, 0xa9, 0x97, 0x23, 0x93, 0xab, 0xfd, 0x1e,
+	0x6d, 0xc8, 0x9f, 0xb1, 0x22, 0xbd, 0x71, 0xd8, 0x7e, 0xb0, 0xc9, 0xad, 0x67, 0x2b, 0xe9, 0x57,
+	0x15, 0xb6, 0x45, 0x4f, 0xbd, 0x89, 0x10, 0xbf, 0x4c, 0xdb, 0xbe, 0x21, 0x5e, 0x3b, 0xe9, 0xb6,
+	0x4d, 0xfc, 0xf8, 0x56, 0xc7, 0x6f, 0xe2, 0xb9, 0xef, 0xb1, 0x79, 0xfb, 0x09, 0x01, 0x4f, 0x38,
+	0x3b, 0xe2, 0x65, 0x41, 0x75, 0xf0, 0xf5, 0xa9, 0x7c, 0x25, 0x70, 0x3b, 0x87, 0x17, 0xc9, 0x8d,
+	0x3b, 0x32, 0x9d, 0x78, 0x3e, 0xf8, 0x87, 0x0c, 0x83, 0x3d, 0xfa, 0x4c, 0x79, 0xbf, 0x43, 0x87,
+	0x5a, 0x17, 0xb7, 0x4e, 0x2c, 0x5d, 0xb8, 0x32, 0x1e, 0xe8, 0x2b, 0x10, 0xa9, 0x0f, 0x52, 0x94,
+	0xc8, 0xbe, 0xf6, 0x04, 0x9a, 0x9f, 0x7c, 0x25, 0xbe, 0x4d, 0x74, 0xd4, 0xf9, 0x64, 0x74, 0xf0,
+	0x1f, 0xe7, 0x28, 0xbc, 0xb2, 0xbb, 0xcd, 0x67, 0x07, 0x36, 0xb1, 0x7b, 0xdb, 0x56, 0x6c, 0x65,
+	0x21, 0x75, 0xe8, 0xc3, 0x4f, 0xac, 0xf4, 0x07, 0x20, 0xfd, 0x41, 0x78, 0x54, 0x7f, 0x86, 0x09,
+	0xf6, 0x97, 0xfc, 0x87, 0xac, 0x6c, 0xee, 0x84, 0x5a, 0xc1, 0xd5, 0xc1, 0x54, 0x2e, 0xe9, 0x50,
+	0x67, 0xde, 0x84, 0xb2, 0x7d, 0xe2, 0xe6, 0x49, 0x89, 0x88, 0x61, 0x51, 0xbc, 0x88, 0x3e, 0x2b,
+	0x7f, 0x90, 0xda, 0xfd, 0x98, 0x1b, 0x58, 0x19, 0x41, 0x98, 0x78, 0x9d, 0x76, 0xae, 0xf1, 0x89,
+	0x76, 0xe6, 0x5f, 0xb2, 0xd2, 0x23, 0xaf, 0xdb, 0x52, 0xbd, 0x4b, 0x7e, 0xc6, 0xee, 0x78, 0x58,
+	0xed, 0xdd, 0x6a, 0x65, 0x18, 0x21, 0x43, 0x73, 0xf1, 0x0e, 0xed, 0xfb, 0x6d, 0x71, 0xfb, 0xc4,
+	0x0a, 0x25, 0x17, 0x20, 0x3b, 0x12, 0x33, 0x96, 0x34, 0xef, 0x2c, 0x86, 0x0f, 0x75, 0xf4, 0xb2,
+	0x9d, 0xa0, 0xb8, 0x4d, 0x04, 0xdc, 0x10, 0x57, 0x33, 0x08, 0x30, 0x95, 0xf1, 0x7a, 0x0c, 0x0b,
+	0xe1, 0xae, 0xcf, 0x48, 0xe6, 0x87, 0xfa, 0x45, 0xe3, 0xcc, 0xe8, 0xda, 0x88, 0x76, 0x90, 0x32,
+	0x66, 0xaf, 0x10, 0x0d, 0x57, 0xf8, 0xe5, 0x0c, 0x1a, 0x9a, 0xe6, 0x03, 0xfe, 0xc7, 0x39, 0x76,
+	0x1e, 0xed, 0x6e, 0x56, 0x25, 0x7a, 0xbc, 0x39, 0xbf, 0x3a, 0xb6, 0x9a, 0x6d, 0xdb, 0x75, 0x7e,
+	0x63, 0x2c, 0x5f, 0x4c, 0xe9, 0x9b, 0xff, 0x34, 0xc7, 0x2a, 0xba, 0xd6, 0x3d, 0xb8, 0x38, 0xbf,
+	0x9e, 0xbd, 0x6f, 0xba, 0x3c, 0x9e, 0x1d, 0x4f, 0x2b, 0x21, 0x15, 0xaf, 0x8c, 0xa7, 0x49, 0x2d,
+	0x89, 0xf7, 0xf5, 0x93, 0x5c, 0x52, 0x37, 0xd3, 0x91, 0xc1, 0xc5, 0xa1, 0x0a, 0xd5, 0x40, 0x6c,
+	0x70, 0x21, 0x7b, 0xc2, 0xa4, 0xa4, 0xa8, 0xef, 0x95, 0x0b, 0x5e, 0x1e, 0x2a, 0x7b, 0xf1, 0x24,
+	0x83, 0xcd, 0x2a, 0x89, 0x59, 0x3e, 0x78, 0x44, 0xfd, 0x49, 0xbc, 0x46, 0xc4, 0xbc, 0x2a, 0xae,
+	0x65, 0x10, 0x13, 0xab, 0x89, 0x75, 0x8f, 0xd6, 0x47, 0x4a, 0x7e, 0xc8, 0x96, 0x1f, 0x74, 0x06,
+	0x09, 0x39, 0x76, 0x97, 0x4c, 0xa3, 0x75, 0xe2, 0xdd, 0xfd, 0x8e, 0xde, 0xfd, 0xb7, 0x73, 0x6c,
+	0xed, 0xbe, 0xdf, 0xf5, 0xa3, 0x83, 0x51, 0xe5, 0xb4, 0xe7, 0x4d, 0x18, 0x4f, 0x4c, 0xc8, 0x1e,
+	0x6d, 0x8d, 0x84, 0xb4, 0x59, 0x09, 0x74, 0x59, 0x55, 0x6e, 0xa2, 0x09, 0xd2, 0x72, 0xab, 0x28,
+	0x24, 0x5e, 0xa6, 0x7d, 0x2f, 0xf3, 0x8b, 0x19, 0xfb, 0xea, 0x7a, 0x10, 0xdf, 0x65, 0xec, 0x91,
+	0xd9, 0x8d, 0x0f, 0x55, 0x8e, 0x32, 0x0f, 0x76, 0x83, 0x36, 0x78, 0x49, 0x8c, 0xdb, 0x40, 0xc6,
+	0x56, 0xaa, 0xe6, 0xa4, 0xb7, 0xa9, 0x0e, 0x6e, 0x73, 0x02, 0x4e, 0x2a, 0xe9, 0xbe, 0x71, 0x73,
+	0xcc, 0x86, 0xf5, 0x67, 0x49, 0xed, 0x0c, 0xcb, 0x0f, 0xcb, 0xe4, 0x84, 0xac, 0x5a, 0xd2, 0x78,
+	0x96, 0x56, 0x47, 0x17, 0xa1, 0x88, 0xb1, 0x35, 0x22, 0xe3, 0x3a, 0xbf, 0x96, 0xe9, 0x1c, 0xe8,
+	0x8b, 0x5b, 0x3a, 0xb5, 0x7a, 0x86, 0x09, 0x00, 0x7a, 0xe5, 0x17, 0x48, 0x61, 0xb5, 0x0b, 0xae,
+	0x9f, 0x3c, 0x9f, 0xa4, 0x1d, 0x21, 0xa1, 0xe4, 0x6a, 0xf3, 0xaf, 0xa3, 0xf8, 0xa1, 0x5c, 0x42,
+	0x66, 0x72, 0xaf, 0x76, 0x85, 0x1b, 0x5f, 0xff, 0xb3, 0x29, 0xb6, 0xa0, 0xaa, 0x74, 0xba, 0xb2,
+	0xf5, 0x3a, 0x95, 0x46, 0xd4, 0xff, 0xae, 0x23, 0x09, 0xa1, 0x53, 0xff, 0x47, 0x0f, 0xab, 0x2e,
+	0xa2, 0x26, 0xee, 0x42, 0x7e, 0xe0, 0x0d, 0x79, 0x16, 0xfe, 0xd2, 0x98, 0x3f, 0x39, 0x90, 0xab,
+	0x5d, 0x1d, 0xf7, 0x87, 0x09, 0xb2, 0xcc, 0x77, 0x87, 0x31, 0xbc, 0x5a, 0x6a, 0xb8, 0x21, 0x69,
+	0x23, 0x4f, 0x5f, 0xe5, 0xe9, 0xce, 0x1c, 0x95, 0x2f, 0x5f, 0x67, 0x45, 0x72, 0xbb, 0xd8, 0xea,
+	0xac, 0xa4, 0xf1, 0x16, 0x3f, 0x07, 0x7a, 0x7a, 0x7c, 0x9d, 0x15, 0x1f, 0xe9, 0xaf, 0x06, 0x70,
+	0x99, 0xc9, 0xec, 0xfb, 0xf8, 0x74, 0x11, 0x25, 0x6b, 0xdc, 0x66, 0x59, 0x0b, 0x7c, 0xa4, 0x13,
+	0x51, 0xd5, 0xe3, 0x1b, 0x4a, 0x44, 0xd3, 0x8d, 0x45, 0xcb, 0xba, 0x8f, 0x6a, 0x0d, 0xde, 0x67,
+	0xf3, 0xb2, 0x5d, 0xa6, 0xe2, 0x9c, 0x44, 0xa4, 0x46, 0x76, 0xd1, 0xb2, 0xa8, 0xba, 0xfb, 0xd6,
+	0x3f, 0xfe, 0xd7, 0x85, 0xdc, 0x3f, 0xc3, 0x3f, 0xff, 0x09, 0xff, 0x7c, 0xfe, 0xea, 0x04, 0xff,
+	0xa7, 0xa0, 0xdd, 0x19, 0x5a, 0xea, 0x5b, 0xff, 0x0f, 0x88, 0xd0, 0x13, 0xfe, 0x5f, 0x48, 0x00,
+	0x00,
 }
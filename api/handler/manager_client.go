@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/user"
 	"sync"
+	"time"
 
 	"github.com/TheThingsNetwork/ttn/api"
 	"github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
@@ -15,6 +16,7 @@ import (
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 // ManagerClient is used to manage applications and devices on a handler
@@ -94,6 +96,25 @@ func (h *ManagerClient) SetApplication(in *Application) error {
 	return errors.Wrap(errors.FromGRPCError(err), "Could not set application on Handler")
 }
 
+// UpdateApplication updates only the given fields (see the update_mask of Application) of an application on the Handler
+func (h *ManagerClient) UpdateApplication(in *Application, fields ...string) error {
+	in.UpdateMask = fields
+	defer func() { in.UpdateMask = nil }()
+	_, err := h.applicationManagerClient.SetApplication(h.GetContext(), in)
+	return errors.Wrap(errors.FromGRPCError(err), "Could not update application on Handler")
+}
+
+// DryRunSetApplication validates the application settings and returns the changes, without setting them on the Handler
+func (h *ManagerClient) DryRunSetApplication(in *Application) (*MutationResult, error) {
+	in.DryRun = true
+	defer func() { in.DryRun = false }()
+	res, err := h.applicationManagerClient.SetApplication(h.GetContext(), in)
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not validate application on Handler")
+	}
+	return res, nil
+}
+
 // RegisterApplication registers an application on the Handler
 func (h *ManagerClient) RegisterApplication(appID string) error {
 	_, err := h.applicationManagerClient.RegisterApplication(h.GetContext(), &ApplicationIdentifier{AppId: appID})
@@ -121,12 +142,103 @@ func (h *ManagerClient) SetDevice(in *Device) error {
 	return errors.Wrap(errors.FromGRPCError(err), "Could not set device on Handler")
 }
 
+// UpdateDevice updates only the given fields (see the update_mask of Device) of a device on the Handler
+func (h *ManagerClient) UpdateDevice(in *Device, fields ...string) error {
+	in.UpdateMask = fields
+	defer func() { in.UpdateMask = nil }()
+	_, err := h.applicationManagerClient.SetDevice(h.GetContext(), in)
+	return errors.Wrap(errors.FromGRPCError(err), "Could not update device on Handler")
+}
+
+// DryRunSetDevice validates the device and returns the changes, without setting it on the Handler
+func (h *ManagerClient) DryRunSetDevice(in *Device) (*MutationResult, error) {
+	in.DryRun = true
+	defer func() { in.DryRun = false }()
+	res, err := h.applicationManagerClient.SetDevice(h.GetContext(), in)
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not validate device on Handler")
+	}
+	return res, nil
+}
+
 // DeleteDevice deletes a device from the Handler
 func (h *ManagerClient) DeleteDevice(appID string, devID string) error {
 	_, err := h.applicationManagerClient.DeleteDevice(h.GetContext(), &DeviceIdentifier{AppId: appID, DevId: devID})
 	return errors.Wrap(errors.FromGRPCError(err), "Could not delete device from Handler")
 }
 
+// ForgetDevice erases the stored payloads and payload fields of a device on the Handler
+func (h *ManagerClient) ForgetDevice(appID string, devID string) (*ErasureReport, error) {
+	report, err := h.applicationManagerClient.ForgetDevice(h.GetContext(), &DeviceIdentifier{AppId: appID, DevId: devID})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not forget device on Handler")
+	}
+	return report, nil
+}
+
+// CheckMIC checks the MIC of a raw LoRaWAN frame against the keys and frame counters of a device
+func (h *ManagerClient) CheckMIC(appID string, devID string, payload []byte) (*MICCheckResult, error) {
+	res, err := h.applicationManagerClient.CheckMIC(h.GetContext(), &MICCheckRequest{AppId: appID, DevId: devID, Payload: payload})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not check MIC on Handler")
+	}
+	return res, nil
+}
+
+// SetDeviceDebugMode enables the debug mode of a device for the given duration, or disables it if the duration is 0
+func (h *ManagerClient) SetDeviceDebugMode(appID string, devID string, duration time.Duration) error {
+	_, err := h.applicationManagerClient.SetDeviceDebugMode(h.GetContext(), &DeviceDebugModeRequest{AppId: appID, DevId: devID, Duration: uint32(duration.Seconds())})
+	return errors.Wrap(errors.FromGRPCError(err), "Could not set debug mode of device on Handler")
+}
+
+// GetDeviceDebugTrace gets the verbose traces that were captured while a device was in debug mode
+func (h *ManagerClient) GetDeviceDebugTrace(appID string, devID string) (*DeviceDebugTrace, error) {
+	res, err := h.applicationManagerClient.GetDeviceDebugTrace(h.GetContext(), &DeviceIdentifier{AppId: appID, DevId: devID})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not get debug trace of device from Handler")
+	}
+	return res, nil
+}
+
+// GetDeviceState gets the state of a device at the given time, reconstructed from its history on the Handler
+func (h *ManagerClient) GetDeviceState(appID string, devID string, t time.Time) (*DeviceState, error) {
+	res, err := h.applicationManagerClient.GetDeviceState(h.GetContext(), &DeviceStateRequest{AppId: appID, DevId: devID, Time: t.UnixNano()})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not get state of device from Handler")
+	}
+	return res, nil
+}
+
+// SetDeviceTwin sets the desired fields (JSON object) in the twin of a device, or clears the twin if the fields are empty
+func (h *ManagerClient) SetDeviceTwin(appID string, devID string, fPort uint8, desiredFields string) error {
+	_, err := h.applicationManagerClient.SetDeviceTwin(h.GetContext(), &DeviceTwinRequest{AppId: appID, DevId: devID, FPort: uint32(fPort), DesiredFields: desiredFields})
+	return errors.Wrap(errors.FromGRPCError(err), "Could not set twin of device on Handler")
+}
+
+// GetDeviceTwin gets the desired and reported fields in the twin of a device
+func (h *ManagerClient) GetDeviceTwin(appID string, devID string) (*DeviceTwin, error) {
+	res, err := h.applicationManagerClient.GetDeviceTwin(h.GetContext(), &DeviceIdentifier{AppId: appID, DevId: devID})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not get twin of device from Handler")
+	}
+	return res, nil
+}
+
+// SendCommand sends a downlink message to a device and waits for the response of the device
+func (h *ManagerClient) SendCommand(req *CommandRequest) (*CommandResponse, error) {
+	res, err := h.applicationManagerClient.SendCommand(h.GetContext(), req)
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not send command to device on Handler")
+	}
+	return res, nil
+}
+
+// ForceRejoin invalidates the session of a device on the Handler, so that it has to join again
+func (h *ManagerClient) ForceRejoin(appID string, devID string) error {
+	_, err := h.applicationManagerClient.ForceRejoin(h.GetContext(), &DeviceIdentifier{AppId: appID, DevId: devID})
+	return errors.Wrap(errors.FromGRPCError(err), "Could not force rejoin of device on Handler")
+}
+
 // GetDevicesForApplication retrieves all devices for an application from the Handler.
 // Pass a limit to indicate the maximum number of results you want to receive, and the offset to indicate how many results should be skipped.
 func (h *ManagerClient) GetDevicesForApplication(appID string, limit, offset int) (devices []*Device, err error) {
@@ -140,6 +252,66 @@ func (h *ManagerClient) GetDevicesForApplication(appID string, limit, offset int
 	return
 }
 
+// DeviceListOptions are options for listing the devices of an application
+type DeviceListOptions struct {
+	Limit   int               // The maximum number of devices in the page
+	Cursor  string            // The cursor of the page, as returned for the previous page
+	Sort    string            // dev_id, created_at or last_seen, with a - prefix for descending order
+	Filters map[string]string // Filters on app_eui, dev_eui or dev_addr
+}
+
+// ListDevices retrieves a page of devices for an application from the Handler, along with the cursor of the next page
+// (empty if there are no more devices)
+func (h *ManagerClient) ListDevices(appID string, opts DeviceListOptions) (devices []*Device, nextCursor string, err error) {
+	ctx := h.GetContextWithLimitAndOffset(opts.Limit, 0)
+	ctx = api.ContextWithListOptions(ctx, opts.Cursor, opts.Sort, opts.Filters)
+	var header metadata.MD
+	res, err := h.applicationManagerClient.GetDevicesForApplication(ctx, &ApplicationIdentifier{AppId: appID}, grpc.Header(&header))
+	if err != nil {
+		return nil, "", errors.Wrap(errors.FromGRPCError(err), "Could not list devices for application from Handler")
+	}
+	if cursor, ok := header["next-cursor"]; ok && len(cursor) > 0 {
+		nextCursor = cursor[0]
+	}
+	return res.Devices, nextCursor, nil
+}
+
+// WatchDevices streams the changes in the device registry of an application from the Handler, starting after the
+// given resume token (empty to only receive new changes). Call the returned function to stop watching.
+func (h *ManagerClient) WatchDevices(appID string, resumeToken string) (ApplicationManager_WatchDevicesClient, context.CancelFunc, error) {
+	ctx, cancel := context.WithCancel(h.GetContext())
+	stream, err := h.applicationManagerClient.WatchDevices(ctx, &WatchDevicesRequest{AppId: appID, ResumeToken: resumeToken})
+	if err != nil {
+		cancel()
+		return nil, nil, errors.Wrap(errors.FromGRPCError(err), "Could not watch devices on Handler")
+	}
+	return stream, cancel, nil
+}
+
+// GetDeviceUplinks retrieves the last uplink messages of a device from the Handler, newest first.
+// Pass a limit to indicate the maximum number of results you want to receive, and the offset to indicate how many results should be skipped.
+func (h *ManagerClient) GetDeviceUplinks(appID string, devID string, limit, offset int) ([]*DeviceUplink, error) {
+	res, err := h.applicationManagerClient.GetDeviceUplinks(h.GetContextWithLimitAndOffset(limit, offset), &DeviceIdentifier{AppId: appID, DevId: devID})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not get uplinks of device from Handler")
+	}
+	return res.Uplinks, nil
+}
+
+// ReplayUplinks processes the stored uplinks of a device that were received between since and until again,
+// and publishes them to the application. Pass a zero time to leave out a bound.
+func (h *ManagerClient) ReplayUplinks(appID string, devID string, since, until time.Time) error {
+	req := &ReplayUplinksRequest{AppId: appID, DevId: devID}
+	if !since.IsZero() {
+		req.Since = since.UnixNano()
+	}
+	if !until.IsZero() {
+		req.Until = until.UnixNano()
+	}
+	_, err := h.applicationManagerClient.ReplayUplinks(h.GetContext(), req)
+	return errors.Wrap(errors.FromGRPCError(err), "Could not replay uplinks of device on Handler")
+}
+
 // GetDevAddr requests a random device address with the given constraints
 func (h *ManagerClient) GetDevAddr(constraints ...string) (types.DevAddr, error) {
 	devAddrManager := lorawan.NewDevAddrManagerClient(h.conn)
@@ -166,6 +338,90 @@ func (h *ManagerClient) DryUplink(payload []byte, app *Application, port uint32)
 	return res, nil
 }
 
+// TestUplink transforms the uplink payload with the stored payload functions of the application
+func (h *ManagerClient) TestUplink(appID string, payload []byte, port uint32) (*DryUplinkResult, error) {
+	res, err := h.applicationManagerClient.TestUplink(h.GetContext(), &TestUplinkRequest{
+		AppId:   appID,
+		Payload: payload,
+		Port:    port,
+	})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not test uplink on Handler")
+	}
+	return res, nil
+}
+
+// GetComplianceReport summarizes the devices of the application by LoRaWAN version, Regional Parameters revision and
+// frequency plan
+func (h *ManagerClient) GetComplianceReport(appID string) (*ComplianceReport, error) {
+	res, err := h.applicationManagerClient.GetComplianceReport(h.GetContext(), &ApplicationIdentifier{AppId: appID})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not get compliance report from Handler")
+	}
+	return res, nil
+}
+
+// ListPayloadFunctionsRevisions returns the stored revisions of the payload functions of the application, newest first
+func (h *ManagerClient) ListPayloadFunctionsRevisions(appID string) (*PayloadFunctionsRevisionList, error) {
+	res, err := h.applicationManagerClient.ListPayloadFunctionsRevisions(h.GetContext(), &ApplicationIdentifier{AppId: appID})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not get payload functions revisions from Handler")
+	}
+	return res, nil
+}
+
+// RollbackPayloadFunctions restores the payload functions of a stored revision of the application
+func (h *ManagerClient) RollbackPayloadFunctions(appID string, revision uint64, dryRun bool) (*MutationResult, error) {
+	res, err := h.applicationManagerClient.RollbackPayloadFunctions(h.GetContext(), &PayloadFunctionsRollbackRequest{
+		AppId:    appID,
+		Revision: revision,
+		DryRun:   dryRun,
+	})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not roll back payload functions on Handler")
+	}
+	return res, nil
+}
+
+// RedecodeUplinks decodes the historical uplink messages with the current payload functions of the application. If
+// devID is not empty, the payload format of the device is used.
+func (h *ManagerClient) RedecodeUplinks(appID, devID string, uplinks []*RawUplink) ([]*RedecodedUplink, error) {
+	res, err := h.applicationManagerClient.RedecodeUplinks(h.GetContext(), &RedecodeUplinksRequest{
+		AppId:   appID,
+		DevId:   devID,
+		Uplinks: uplinks,
+	})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not redecode uplinks on Handler")
+	}
+	return res.Uplinks, nil
+}
+
+// ExportApplication exports the application with its devices, sessions and downlink queues for a transfer to another
+// Handler. With cutover, the Handler stops processing the traffic of the application.
+func (h *ManagerClient) ExportApplication(appID string, cutover bool) (*ApplicationTransfer, error) {
+	res, err := h.applicationManagerClient.ExportApplication(h.GetContext(), &ExportApplicationRequest{
+		AppId:   appID,
+		Cutover: cutover,
+	})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not export application from Handler")
+	}
+	return res, nil
+}
+
+// ImportApplication imports an application that was exported by another Handler
+func (h *ManagerClient) ImportApplication(transfer *ApplicationTransfer) error {
+	_, err := h.applicationManagerClient.ImportApplication(h.GetContext(), transfer)
+	return errors.Wrap(errors.FromGRPCError(err), "Could not import application on Handler")
+}
+
+// FinishApplicationTransfer removes an application from the Handler after it was transferred to another Handler
+func (h *ManagerClient) FinishApplicationTransfer(appID string) error {
+	_, err := h.applicationManagerClient.FinishApplicationTransfer(h.GetContext(), &ApplicationIdentifier{AppId: appID})
+	return errors.Wrap(errors.FromGRPCError(err), "Could not finish application transfer on Handler")
+}
+
 // DryDownlinkWithPayload transforms the downlink payload with the payload functions
 // provided in app.
 func (h *ManagerClient) DryDownlinkWithPayload(payload []byte, app *Application, port uint32) (*DryDownlinkResult, error) {
@@ -199,6 +455,92 @@ func (h *ManagerClient) DryDownlinkWithFields(fields map[string]interface{}, app
 	return res, nil
 }
 
+// PreviewDownlinkWithPayload estimates the transmission of the downlink payload to the device
+func (h *ManagerClient) PreviewDownlinkWithPayload(appID string, devID string, payload []byte, port uint32) (*DownlinkPreview, error) {
+	res, err := h.applicationManagerClient.PreviewDownlink(h.GetContext(), &DownlinkPreviewRequest{
+		AppId:   appID,
+		DevId:   devID,
+		Payload: payload,
+		Port:    port,
+	})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not preview downlink with payload on Handler")
+	}
+	return res, nil
+}
+
+// PreviewDownlinkWithFields estimates the transmission of the downlink fields to the device,
+// after encoding them with the payload functions of the application
+func (h *ManagerClient) PreviewDownlinkWithFields(appID string, devID string, fields map[string]interface{}, port uint32) (*DownlinkPreview, error) {
+	marshalled, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.applicationManagerClient.PreviewDownlink(h.GetContext(), &DownlinkPreviewRequest{
+		AppId:  appID,
+		DevId:  devID,
+		Fields: string(marshalled),
+		Port:   port,
+	})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not preview downlink with fields on Handler")
+	}
+	return res, nil
+}
+
+// SyncMQTTCredentials generates MQTT credentials for the collaborators of the application. Passwords are only
+// returned for credentials that were created.
+func (h *ManagerClient) SyncMQTTCredentials(appID string) ([]*MQTTCredentials, error) {
+	res, err := h.applicationManagerClient.SyncMQTTCredentials(h.GetContext(), &ApplicationIdentifier{AppId: appID})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not sync MQTT credentials on Handler")
+	}
+	return res.Credentials, nil
+}
+
+// GetWebhooks returns the webhooks of the application
+func (h *ManagerClient) GetWebhooks(appID string) ([]*Webhook, error) {
+	res, err := h.applicationManagerClient.GetWebhooks(h.GetContext(), &ApplicationIdentifier{AppId: appID})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not get webhooks from Handler")
+	}
+	return res.Webhooks, nil
+}
+
+// SetWebhook creates or updates a webhook of the application
+func (h *ManagerClient) SetWebhook(webhook *Webhook) error {
+	_, err := h.applicationManagerClient.SetWebhook(h.GetContext(), webhook)
+	return errors.Wrap(errors.FromGRPCError(err), "Could not set webhook on Handler")
+}
+
+// DeleteWebhook deletes a webhook of the application
+func (h *ManagerClient) DeleteWebhook(appID, webhookID string) error {
+	_, err := h.applicationManagerClient.DeleteWebhook(h.GetContext(), &WebhookIdentifier{AppId: appID, WebhookId: webhookID})
+	return errors.Wrap(errors.FromGRPCError(err), "Could not delete webhook from Handler")
+}
+
+// GetDeletedDevices returns the devices in the recycle bin of the application
+func (h *ManagerClient) GetDeletedDevices(appID string) ([]*DeletedDevice, error) {
+	res, err := h.applicationManagerClient.GetDeletedDevices(h.GetContext(), &ApplicationIdentifier{AppId: appID})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Could not get deleted devices from Handler")
+	}
+	return res.Devices, nil
+}
+
+// RestoreDevice restores a deleted device from the recycle bin
+func (h *ManagerClient) RestoreDevice(appID, devID string) error {
+	_, err := h.applicationManagerClient.RestoreDevice(h.GetContext(), &DeviceIdentifier{AppId: appID, DevId: devID})
+	return errors.Wrap(errors.FromGRPCError(err), "Could not restore device on Handler")
+}
+
+// RestoreApplication restores a deleted application and its devices from the recycle bin
+func (h *ManagerClient) RestoreApplication(appID string) error {
+	_, err := h.applicationManagerClient.RestoreApplication(h.GetContext(), &ApplicationIdentifier{AppId: appID})
+	return errors.Wrap(errors.FromGRPCError(err), "Could not restore application on Handler")
+}
+
 // SimulateUplink simulates an uplink message
 func (h *ManagerClient) SimulateUplink(appID string, devID string, port uint32, payload []byte) error {
 	_, err := h.applicationManagerClient.SimulateUplink(h.GetContext(), &SimulatedUplinkMessage{
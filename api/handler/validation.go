@@ -4,7 +4,14 @@
 package handler
 
 import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
 	"github.com/TheThingsNetwork/ttn/api"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 )
 
@@ -37,6 +44,261 @@ func (m *Application) Validate() error {
 	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
 		return err
 	}
+	if m.ProvisioningDownlink != nil {
+		if err := api.NotNilAndValid(m.ProvisioningDownlink, "ProvisioningDownlink"); err != nil {
+			return err
+		}
+	}
+	if m.AnomalyDetection != nil {
+		if err := api.NotNilAndValid(m.AnomalyDetection, "AnomalyDetection"); err != nil {
+			return err
+		}
+	}
+	names := make(map[string]bool, len(m.ComputedFields))
+	for _, field := range m.ComputedFields {
+		if err := api.NotNilAndValid(field, "ComputedFields"); err != nil {
+			return err
+		}
+		if names[field.Name] {
+			return errors.NewErrInvalidArgument("ComputedFields", "duplicate field "+field.Name)
+		}
+		names[field.Name] = true
+	}
+	for _, functions := range m.PortFunctions {
+		if err := api.NotNilAndValid(functions, "PortFunctions"); err != nil {
+			return err
+		}
+	}
+	binaryNames := make(map[string]bool, len(m.BinaryFields))
+	for _, field := range m.BinaryFields {
+		if err := api.NotNilAndValid(field, "BinaryFields"); err != nil {
+			return err
+		}
+		if binaryNames[field.Name] {
+			return errors.NewErrInvalidArgument("BinaryFields", "duplicate field "+field.Name)
+		}
+		binaryNames[field.Name] = true
+	}
+	if m.OutputPolicy != nil {
+		if err := api.NotNilAndValid(m.OutputPolicy, "OutputPolicy"); err != nil {
+			return err
+		}
+	}
+	if m.Aggregation != nil {
+		if err := api.NotNilAndValid(m.Aggregation, "Aggregation"); err != nil {
+			return err
+		}
+	}
+	for _, window := range m.MaintenanceWindows {
+		if err := api.NotNilAndValid(window, "MaintenanceWindows"); err != nil {
+			return err
+		}
+	}
+	switch m.IntegrationFormat {
+	case "", IntegrationFormatJSON, IntegrationFormatRaw:
+	default:
+		return errors.NewErrInvalidArgument("IntegrationFormat", "must be json or raw")
+	}
+	if !pb_lorawan.ValidDevAddrAllocation(m.DevAddrAllocation) {
+		return errors.NewErrInvalidArgument("DevAddrAllocation", "must be random, sequential or sticky")
+	}
+	switch m.PayloadFormat {
+	case "", PayloadFormatCustom, PayloadFormatCayenneLPP, PayloadFormatWASM, PayloadFormatBinary, PayloadFormatProtobuf, PayloadFormatCBOR, PayloadFormatGo:
+	default:
+		return errors.NewErrInvalidArgument("PayloadFormat", "must be custom, cayennelpp, wasm, binary, protobuf, cbor or go")
+	}
+	switch m.FunctionsLanguage {
+	case "", FunctionsLanguageJavaScript, FunctionsLanguageLua:
+	default:
+		return errors.NewErrInvalidArgument("FunctionsLanguage", "must be javascript or lua")
+	}
+	if len(m.WasmModule) > 0 && !bytes.HasPrefix(m.WasmModule, wasmMagic) {
+		return errors.NewErrInvalidArgument("WasmModule", "not a WebAssembly module")
+	}
+	if m.PayloadFormat == PayloadFormatProtobuf && (len(m.ProtobufDescriptor) == 0 || m.ProtobufMessage == "") {
+		return errors.NewErrInvalidArgument("PayloadFormat", "protobuf needs a protobuf descriptor and message")
+	}
+	if m.PayloadFormat == PayloadFormatGo && m.GoCodec == "" {
+		return errors.NewErrInvalidArgument("PayloadFormat", "go needs a Go codec")
+	}
+	if m.GoCodec != "" && !api.ValidID(m.GoCodec) {
+		return errors.NewErrInvalidArgument("GoCodec", "has wrong format")
+	}
+	if m.DataResidency != "" && !api.ValidID(m.DataResidency) {
+		return errors.NewErrInvalidArgument("DataResidency", "has wrong format")
+	}
+	if m.Codec != "" && !api.ValidID(m.Codec) {
+		return errors.NewErrInvalidArgument("Codec", "has wrong format")
+	}
+	for _, field := range m.SensitiveFields {
+		if field == "" || strings.HasPrefix(field, ".") || strings.HasSuffix(field, ".") {
+			return errors.NewErrInvalidArgument("SensitiveFields", "invalid field "+field)
+		}
+	}
+	return nil
+}
+
+// Formats of uplink messages that are delivered to integrations
+const (
+	IntegrationFormatJSON = "json"
+	IntegrationFormatRaw  = "raw"
+)
+
+// Formats of the payload of uplink and downlink messages
+const (
+	PayloadFormatCustom     = "custom"
+	PayloadFormatCayenneLPP = "cayennelpp"
+	PayloadFormatWASM       = "wasm"
+	PayloadFormatBinary     = "binary"
+	PayloadFormatProtobuf   = "protobuf"
+	PayloadFormatCBOR       = "cbor"
+	PayloadFormatGo         = "go"
+)
+
+// Languages of the payload functions
+const (
+	FunctionsLanguageJavaScript = "javascript"
+	FunctionsLanguageLua        = "lua"
+)
+
+// BinaryFieldSizes contains the size in bytes of each type of BinaryField
+var BinaryFieldSizes = map[string]int{
+	"uint8":   1,
+	"int8":    1,
+	"uint16":  2,
+	"int16":   2,
+	"uint24":  3,
+	"int24":   3,
+	"uint32":  4,
+	"int32":   4,
+	"float32": 4,
+	"float64": 8,
+	"bool":    1,
+}
+
+// wasmMagic is the start of a binary WebAssembly module
+var wasmMagic = []byte{0x00, 'a', 's', 'm'}
+
+var computedFieldNameRegexp = regexp.MustCompile("^[a-zA-Z_][a-zA-Z0-9_]*$")
+
+// Validate implements the api.Validator interface
+func (m *ComputedField) Validate() error {
+	if !computedFieldNameRegexp.MatchString(m.Name) {
+		return errors.NewErrInvalidArgument("Name", "must start with a letter or underscore and contain only letters, numbers and underscores")
+	}
+	if m.Expression == "" {
+		return errors.NewErrInvalidArgument("Expression", "can not be empty")
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *PortFunctions) Validate() error {
+	if m.MinPort < 1 || m.MinPort > 223 {
+		return errors.NewErrInvalidArgument("MinPort", "must be between 1 and 223")
+	}
+	if m.MaxPort != 0 && (m.MaxPort < m.MinPort || m.MaxPort > 223) {
+		return errors.NewErrInvalidArgument("MaxPort", "must be between MinPort and 223")
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *BinaryField) Validate() error {
+	if !computedFieldNameRegexp.MatchString(m.Name) {
+		return errors.NewErrInvalidArgument("Name", "must start with a letter or underscore and contain only letters, numbers and underscores")
+	}
+	if _, ok := BinaryFieldSizes[m.Type]; !ok {
+		return errors.NewErrInvalidArgument("Type", "must be uint8, int8, uint16, int16, uint24, int24, uint32, int32, float32, float64 or bool")
+	}
+	if m.Scale != 0 && m.Type == "bool" {
+		return errors.NewErrInvalidArgument("Scale", "can not be set for bool fields")
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *AnomalyDetection) Validate() error {
+	if m.Sensitivity < 0 {
+		return errors.NewErrInvalidArgument("Sensitivity", "can not be negative")
+	}
+	return nil
+}
+
+// Rounding modes of the OutputPolicy
+const (
+	RoundHalfUp   = "half-up"
+	RoundHalfEven = "half-even"
+	RoundTruncate = "truncate"
+)
+
+// Representations of NaN and infinite values of the OutputPolicy
+const (
+	SpecialValuesNull   = "null"
+	SpecialValuesString = "string"
+	SpecialValuesOmit   = "omit"
+)
+
+// Casings of the names of payload fields of the OutputPolicy
+const (
+	FieldCasingKeep  = "keep"
+	FieldCasingSnake = "snake"
+	FieldCasingCamel = "camel"
+)
+
+// MaxOutputDecimals is the maximum number of decimals of the OutputPolicy
+const MaxOutputDecimals = 15
+
+// Validate implements the api.Validator interface
+func (m *OutputPolicy) Validate() error {
+	if m.Decimals > MaxOutputDecimals {
+		return errors.NewErrInvalidArgument("Decimals", "can not be more than 15")
+	}
+	switch m.RoundingMode {
+	case "", RoundHalfUp, RoundHalfEven, RoundTruncate:
+	default:
+		return errors.NewErrInvalidArgument("RoundingMode", "must be half-up, half-even or truncate")
+	}
+	switch m.SpecialValues {
+	case "", SpecialValuesNull, SpecialValuesString, SpecialValuesOmit:
+	default:
+		return errors.NewErrInvalidArgument("SpecialValues", "must be null, string or omit")
+	}
+	switch m.FieldCasing {
+	case "", FieldCasingKeep, FieldCasingSnake, FieldCasingCamel:
+	default:
+		return errors.NewErrInvalidArgument("FieldCasing", "must be keep, snake or camel")
+	}
+	for _, r := range m.Replacement {
+		if !(r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return errors.NewErrInvalidArgument("Replacement", "can only contain letters, digits and underscores")
+		}
+	}
+	return nil
+}
+
+// MaxAggregationWindow is the maximum length of the aggregation window in seconds
+const MaxAggregationWindow = 24 * 60 * 60
+
+// Validate implements the api.Validator interface
+func (m *Aggregation) Validate() error {
+	if m.Window < 1 || m.Window > MaxAggregationWindow {
+		return errors.NewErrInvalidArgument("Window", "must be between 1 second and 24 hours")
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *ProvisioningDownlink) Validate() error {
+	if m.Port < 1 || m.Port > 223 {
+		return errors.NewErrInvalidArgument("Port", "must be between 1 and 223")
+	}
+	if m.Payload != nil && m.Fields != "" {
+		return errors.NewErrInvalidArgument("ProvisioningDownlink", "Both Fields and Payload provided")
+	}
+	if m.Payload == nil && m.Fields == "" {
+		return errors.NewErrInvalidArgument("ProvisioningDownlink", "Neither Fields nor Payload provided")
+	}
 	return nil
 }
 
@@ -62,6 +324,48 @@ func (m *Device) Validate() error {
 	if err := api.NotNilAndValid(m.Device, "Device"); err != nil {
 		return err
 	}
+	if m.Profile != nil {
+		if err := m.Profile.Validate(); err != nil {
+			return err
+		}
+	}
+	switch m.PayloadFormat {
+	case "", PayloadFormatCustom, PayloadFormatCayenneLPP, PayloadFormatWASM, PayloadFormatBinary, PayloadFormatProtobuf, PayloadFormatCBOR, PayloadFormatGo:
+	default:
+		return errors.NewErrInvalidArgument("PayloadFormat", "must be custom, cayennelpp, wasm, binary, protobuf, cbor or go")
+	}
+	if m.PayloadFormat == PayloadFormatGo && m.GoCodec == "" {
+		return errors.NewErrInvalidArgument("PayloadFormat", "go needs a Go codec")
+	}
+	if m.GoCodec != "" && m.PayloadFormat != PayloadFormatGo {
+		return errors.NewErrInvalidArgument("GoCodec", "can only be used with the go payload format")
+	}
+	if m.GoCodec != "" && !api.ValidID(m.GoCodec) {
+		return errors.NewErrInvalidArgument("GoCodec", "has wrong format")
+	}
+	if m.Codec != "" && !api.ValidID(m.Codec) {
+		return errors.NewErrInvalidArgument("Codec", "has wrong format")
+	}
+	if m.Codec != "" && m.PayloadFormat != "" && m.PayloadFormat != PayloadFormatCustom {
+		return errors.NewErrInvalidArgument("Codec", "can only be used with the custom payload format")
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *DeviceProfile) Validate() error {
+	if !pb_lorawan.ValidLoRaWANVersion(m.LorawanVersion) {
+		return errors.NewErrInvalidArgument("LorawanVersion", "unknown version")
+	}
+	if (m.BrandId == "") != (m.ModelId == "") {
+		return errors.NewErrInvalidArgument("ModelId", "brand and model must be set together")
+	}
+	if m.BrandId != "" && (!api.ValidID(m.BrandId) || !api.ValidID(m.ModelId)) {
+		return errors.NewErrInvalidArgument("ModelId", "brand and model have wrong format")
+	}
+	if m.FirmwareVersion != "" && m.ModelId == "" {
+		return errors.NewErrInvalidArgument("FirmwareVersion", "can only be used with a model")
+	}
 	return nil
 }
 
@@ -83,3 +387,307 @@ func (m *SimulatedUplinkMessage) Validate() error {
 	}
 	return nil
 }
+
+// Validate implements the api.Validator interface
+func (m *InjectedUplinkMessage) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
+		return err
+	}
+	if err := api.NotEmptyAndValidID(m.DevId, "DevId"); err != nil {
+		return err
+	}
+	if m.Port < 1 || m.Port > 223 {
+		return errors.NewErrInvalidArgument("Port", "must be between 1 and 223")
+	}
+	if m.Fields == "" {
+		return errors.NewErrInvalidArgument("Fields", "can not be empty")
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *ReplayUplinksRequest) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
+		return err
+	}
+	if err := api.NotEmptyAndValidID(m.DevId, "DevId"); err != nil {
+		return err
+	}
+	if m.Until != 0 && m.Until < m.Since {
+		return errors.NewErrInvalidArgument("Until", "must be after Since")
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *DownlinkPreviewRequest) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
+		return err
+	}
+	if err := api.NotEmptyAndValidID(m.DevId, "DevId"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *BulkPayloadFunctionsRequest) Validate() error {
+	if len(m.AppIds) == 0 {
+		return errors.NewErrInvalidArgument("AppIds", "can not be empty")
+	}
+	for _, appID := range m.AppIds {
+		if err := api.NotEmptyAndValidID(appID, "AppIds"); err != nil {
+			return err
+		}
+	}
+	if m.Decoder == "" && m.Converter == "" && m.Validator == "" && m.Encoder == "" {
+		return errors.NewErrInvalidArgument("BulkPayloadFunctionsRequest", "No payload functions provided")
+	}
+	if m.Version == "" {
+		return errors.NewErrInvalidArgument("Version", "can not be empty")
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *MICCheckRequest) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
+		return err
+	}
+	if err := api.NotEmptyAndValidID(m.DevId, "DevId"); err != nil {
+		return err
+	}
+	if len(m.Payload) == 0 {
+		return errors.NewErrInvalidArgument("Payload", "can not be empty")
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *WatchDevicesRequest) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *DeviceDebugModeRequest) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
+		return err
+	}
+	if err := api.NotEmptyAndValidID(m.DevId, "DevId"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *DeviceStateRequest) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
+		return err
+	}
+	if err := api.NotEmptyAndValidID(m.DevId, "DevId"); err != nil {
+		return err
+	}
+	if m.Time <= 0 {
+		return errors.NewErrInvalidArgument("Time", "must be set")
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *DeviceTwinRequest) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
+		return err
+	}
+	if err := api.NotEmptyAndValidID(m.DevId, "DevId"); err != nil {
+		return err
+	}
+	if m.DesiredFields != "" && (m.FPort < 1 || m.FPort > 223) {
+		return errors.NewErrInvalidArgument("FPort", "must be between 1 and 223")
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *TestUplinkRequest) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
+		return err
+	}
+	if m.Port > 255 {
+		return errors.NewErrInvalidArgument("Port", "must be at most 255")
+	}
+	return nil
+}
+
+// MaxRedecodeUplinks is the maximum number of uplink messages in a RedecodeUplinksRequest
+const MaxRedecodeUplinks = 1000
+
+// Validate implements the api.Validator interface
+func (m *RedecodeUplinksRequest) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
+		return err
+	}
+	if m.DevId != "" && !api.ValidID(m.DevId) {
+		return errors.NewErrInvalidArgument("DevId", "has wrong format")
+	}
+	if len(m.Uplinks) == 0 {
+		return errors.NewErrInvalidArgument("Uplinks", "can not be empty")
+	}
+	if len(m.Uplinks) > MaxRedecodeUplinks {
+		return errors.NewErrInvalidArgument("Uplinks", fmt.Sprintf("can contain at most %d messages", MaxRedecodeUplinks))
+	}
+	for _, uplink := range m.Uplinks {
+		if uplink == nil {
+			return errors.NewErrInvalidArgument("Uplinks", "can not contain empty messages")
+		}
+		if uplink.Port > 255 {
+			return errors.NewErrInvalidArgument("Port", "must be at most 255")
+		}
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *ExportApplicationRequest) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *ApplicationTransfer) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
+		return err
+	}
+	if len(m.Application) == 0 {
+		return errors.NewErrInvalidArgument("Application", "can not be empty")
+	}
+	for _, dev := range m.Devices {
+		if dev == nil {
+			return errors.NewErrInvalidArgument("Devices", "can not contain empty devices")
+		}
+		if err := api.NotEmptyAndValidID(dev.DevId, "DevId"); err != nil {
+			return err
+		}
+		if len(dev.Device) == 0 {
+			return errors.NewErrInvalidArgument("Device", "can not be empty")
+		}
+	}
+	if m.Activate && m.Cutover == 0 {
+		return errors.NewErrInvalidArgument("Cutover", "can not be empty when activating")
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *CommandRequest) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
+		return err
+	}
+	if err := api.NotEmptyAndValidID(m.DevId, "DevId"); err != nil {
+		return err
+	}
+	if m.Port < 1 || m.Port > 223 {
+		return errors.NewErrInvalidArgument("Port", "must be between 1 and 223")
+	}
+	if m.ResponsePort > 223 {
+		return errors.NewErrInvalidArgument("ResponsePort", "must be at most 223")
+	}
+	if m.Payload != nil && m.Fields != "" {
+		return errors.NewErrInvalidArgument("CommandRequest", "Both Fields and Payload provided")
+	}
+	if m.CorrelationField != "" && m.Fields == "" {
+		return errors.NewErrInvalidArgument("CorrelationField", "can only be used with Fields")
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *PayloadFunctionsRollbackRequest) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
+		return err
+	}
+	if m.Revision == 0 {
+		return errors.NewErrInvalidArgument("Revision", "can not be empty")
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *Codec) Validate() error {
+	if err := api.NotEmptyAndValidID(m.Id, "Id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *CodecIdentifier) Validate() error {
+	if err := api.NotEmptyAndValidID(m.Id, "Id"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *ReplayArchiveRequest) Validate() error {
+	if m.Since == 0 {
+		return errors.NewErrInvalidArgument("Since", "can not be empty")
+	}
+	if m.Until != 0 && m.Until <= m.Since {
+		return errors.NewErrInvalidArgument("Until", "must be after Since")
+	}
+	for _, appID := range m.AppIds {
+		if err := api.NotEmptyAndValidID(appID, "AppIds"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Types of messages that webhooks can POST
+const (
+	WebhookMessageUplink     = "uplink"
+	WebhookMessageActivation = "activation"
+	WebhookMessageDownlink   = "downlink"
+)
+
+// Validate implements the api.Validator interface
+func (m *Webhook) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
+		return err
+	}
+	if err := api.NotEmptyAndValidID(m.WebhookId, "WebhookId"); err != nil {
+		return err
+	}
+	if u, err := url.Parse(m.Url); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.NewErrInvalidArgument("Url", "must be an http or https URL")
+	}
+	for name := range m.Headers {
+		if name == "" || strings.ContainsAny(name, " :\r\n") {
+			return errors.NewErrInvalidArgument("Headers", "invalid header "+name)
+		}
+	}
+	for _, messageType := range m.MessageTypes {
+		switch messageType {
+		case WebhookMessageUplink, WebhookMessageActivation, WebhookMessageDownlink:
+		default:
+			return errors.NewErrInvalidArgument("MessageTypes", "must be uplink, activation or downlink")
+		}
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *WebhookIdentifier) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppId, "AppId"); err != nil {
+		return err
+	}
+	if err := api.NotEmptyAndValidID(m.WebhookId, "WebhookId"); err != nil {
+		return err
+	}
+	return nil
+}
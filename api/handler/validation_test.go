@@ -0,0 +1,185 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/api"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestComputedFieldValidate(t *testing.T) {
+	a := New(t)
+	a.So((&ComputedField{Name: "dewpoint", Expression: "1"}).Validate(), ShouldBeNil)
+	a.So((&ComputedField{Name: "dew point", Expression: "1"}).Validate(), ShouldNotBeNil)
+	a.So((&ComputedField{Name: "dewpoint"}).Validate(), ShouldNotBeNil)
+	a.So((&Application{AppId: "test", ComputedFields: []*ComputedField{
+		{Name: "dewpoint", Expression: "1"},
+		{Name: "dewpoint", Expression: "2"},
+	}}).Validate(), ShouldNotBeNil)
+}
+
+func TestPortFunctionsValidate(t *testing.T) {
+	a := New(t)
+	a.So((&PortFunctions{MinPort: 10}).Validate(), ShouldBeNil)
+	a.So((&PortFunctions{MinPort: 10, MaxPort: 20}).Validate(), ShouldBeNil)
+	a.So((&PortFunctions{}).Validate(), ShouldNotBeNil)
+	a.So((&PortFunctions{MinPort: 224}).Validate(), ShouldNotBeNil)
+	a.So((&PortFunctions{MinPort: 20, MaxPort: 10}).Validate(), ShouldNotBeNil)
+	a.So((&Application{AppId: "test", PortFunctions: []*PortFunctions{{}}}).Validate(), ShouldNotBeNil)
+}
+
+func TestDeviceProfileValidate(t *testing.T) {
+	a := New(t)
+	a.So((&DeviceProfile{}).Validate(), ShouldBeNil)
+	a.So((&DeviceProfile{LorawanVersion: "1.0.2", Vendor: "vendor", CertificationId: "1234"}).Validate(), ShouldBeNil)
+	a.So((&DeviceProfile{LorawanVersion: "1.2"}).Validate(), ShouldNotBeNil)
+	a.So((&DeviceProfile{BrandId: "the-things-products", ModelId: "the-things-node", FirmwareVersion: "1.0"}).Validate(), ShouldBeNil)
+	a.So((&DeviceProfile{BrandId: "the-things-products"}).Validate(), ShouldNotBeNil)
+	a.So((&DeviceProfile{BrandId: "The Things Products", ModelId: "the-things-node"}).Validate(), ShouldNotBeNil)
+	a.So((&DeviceProfile{FirmwareVersion: "1.0"}).Validate(), ShouldNotBeNil)
+}
+
+func TestDevicePayloadFormatValidate(t *testing.T) {
+	a := New(t)
+	dev := func(payloadFormat, codec, goCodec string) *Device {
+		return &Device{
+			AppId: "test",
+			DevId: "test",
+			Device: &Device_LorawanDevice{LorawanDevice: &pb_lorawan.Device{
+				AppId:  "test",
+				DevId:  "test",
+				AppEui: &types.AppEUI{1, 2, 3, 4, 5, 6, 7, 8},
+				DevEui: &types.DevEUI{1, 2, 3, 4, 5, 6, 7, 8},
+			}},
+			PayloadFormat: payloadFormat,
+			Codec:         codec,
+			GoCodec:       goCodec,
+		}
+	}
+	a.So(dev("", "", "").Validate(), ShouldBeNil)
+	a.So(dev(PayloadFormatCBOR, "", "").Validate(), ShouldBeNil)
+	a.So(dev(PayloadFormatGo, "", "acme-meter").Validate(), ShouldBeNil)
+	a.So(dev("", "acme-meter-v2", "").Validate(), ShouldBeNil)
+	a.So(dev("xml", "", "").Validate(), ShouldNotBeNil)
+	a.So(dev(PayloadFormatGo, "", "").Validate(), ShouldNotBeNil)
+	a.So(dev("", "", "acme-meter").Validate(), ShouldNotBeNil)
+	a.So(dev("", "Acme Meter", "").Validate(), ShouldNotBeNil)
+	a.So(dev(PayloadFormatCayenneLPP, "acme-meter-v2", "").Validate(), ShouldNotBeNil)
+}
+
+func TestRedecodeUplinksRequestValidate(t *testing.T) {
+	a := New(t)
+	a.So((&RedecodeUplinksRequest{AppId: "test", Uplinks: []*RawUplink{{Port: 1, Payload: []byte{0x08, 0x70}}}}).Validate(), ShouldBeNil)
+	a.So((&RedecodeUplinksRequest{AppId: "test", DevId: "test", Uplinks: []*RawUplink{{Port: 1}}}).Validate(), ShouldBeNil)
+	a.So((&RedecodeUplinksRequest{AppId: "test"}).Validate(), ShouldNotBeNil)
+	a.So((&RedecodeUplinksRequest{AppId: "test", DevId: "Test Device", Uplinks: []*RawUplink{{Port: 1}}}).Validate(), ShouldNotBeNil)
+	a.So((&RedecodeUplinksRequest{AppId: "test", Uplinks: []*RawUplink{{Port: 256}}}).Validate(), ShouldNotBeNil)
+	a.So((&RedecodeUplinksRequest{AppId: "test", Uplinks: []*RawUplink{nil}}).Validate(), ShouldNotBeNil)
+	a.So((&RedecodeUplinksRequest{AppId: "test", Uplinks: make([]*RawUplink, MaxRedecodeUplinks+1)}).Validate(), ShouldNotBeNil)
+}
+
+func TestApplicationTransferValidate(t *testing.T) {
+	a := New(t)
+	devices := []*DeviceTransfer{{DevId: "test", Device: []byte("{}")}}
+	a.So((&ApplicationTransfer{AppId: "test", Application: []byte("{}"), Devices: devices}).Validate(), ShouldBeNil)
+	a.So((&ApplicationTransfer{AppId: "test", Application: []byte("{}"), Cutover: 1, Activate: true}).Validate(), ShouldBeNil)
+	a.So((&ApplicationTransfer{AppId: "test"}).Validate(), ShouldNotBeNil)
+	a.So((&ApplicationTransfer{AppId: "test", Application: []byte("{}"), Activate: true}).Validate(), ShouldNotBeNil)
+	a.So((&ApplicationTransfer{AppId: "test", Application: []byte("{}"), Devices: []*DeviceTransfer{nil}}).Validate(), ShouldNotBeNil)
+	a.So((&ApplicationTransfer{AppId: "test", Application: []byte("{}"), Devices: []*DeviceTransfer{{DevId: "Test Device", Device: []byte("{}")}}}).Validate(), ShouldNotBeNil)
+	a.So((&ApplicationTransfer{AppId: "test", Application: []byte("{}"), Devices: []*DeviceTransfer{{DevId: "test"}}}).Validate(), ShouldNotBeNil)
+}
+
+func TestOutputPolicyValidate(t *testing.T) {
+	a := New(t)
+	a.So((&OutputPolicy{}).Validate(), ShouldBeNil)
+	a.So((&OutputPolicy{Round: true, Decimals: 2, RoundingMode: RoundHalfEven, SpecialValues: SpecialValuesOmit}).Validate(), ShouldBeNil)
+	a.So((&OutputPolicy{Decimals: 16}).Validate(), ShouldNotBeNil)
+	a.So((&OutputPolicy{RoundingMode: "ceil"}).Validate(), ShouldNotBeNil)
+	a.So((&OutputPolicy{SpecialValues: "zero"}).Validate(), ShouldNotBeNil)
+	a.So((&OutputPolicy{FieldCasing: FieldCasingSnake, Replacement: "_", ProtectReserved: true}).Validate(), ShouldBeNil)
+	a.So((&OutputPolicy{FieldCasing: "kebab"}).Validate(), ShouldNotBeNil)
+	a.So((&OutputPolicy{Replacement: "-"}).Validate(), ShouldNotBeNil)
+}
+
+func TestAggregationValidate(t *testing.T) {
+	a := New(t)
+	a.So((&Aggregation{Window: 900}).Validate(), ShouldBeNil)
+	a.So((&Aggregation{}).Validate(), ShouldNotBeNil)
+	a.So((&Aggregation{Window: MaxAggregationWindow + 1}).Validate(), ShouldNotBeNil)
+}
+
+func TestMaintenanceWindowsValidate(t *testing.T) {
+	a := New(t)
+	a.So((&Application{AppId: "test", MaintenanceWindows: []*api.MaintenanceWindow{
+		{Start: 1493604000000000000, Duration: 3600, Recurrence: api.RecurrenceWeekly},
+	}}).Validate(), ShouldBeNil)
+	a.So((&Application{AppId: "test", MaintenanceWindows: []*api.MaintenanceWindow{{Duration: 3600}}}).Validate(), ShouldNotBeNil)
+	a.So((&Application{AppId: "test", MaintenanceWindows: []*api.MaintenanceWindow{nil}}).Validate(), ShouldNotBeNil)
+}
+
+func TestIntegrationFormatValidate(t *testing.T) {
+	a := New(t)
+	a.So((&Application{AppId: "test"}).Validate(), ShouldBeNil)
+	a.So((&Application{AppId: "test", IntegrationFormat: IntegrationFormatRaw}).Validate(), ShouldBeNil)
+	a.So((&Application{AppId: "test", IntegrationFormat: "xml"}).Validate(), ShouldNotBeNil)
+}
+
+func TestPayloadFormatValidate(t *testing.T) {
+	a := New(t)
+	a.So((&Application{AppId: "test", PayloadFormat: PayloadFormatCayenneLPP}).Validate(), ShouldBeNil)
+	a.So((&Application{AppId: "test", PayloadFormat: "xml"}).Validate(), ShouldNotBeNil)
+	a.So((&Application{AppId: "test", PayloadFormat: PayloadFormatWASM, WasmModule: []byte{0x00, 'a', 's', 'm', 0x01, 0x00, 0x00, 0x00}}).Validate(), ShouldBeNil)
+	a.So((&Application{AppId: "test", PayloadFormat: PayloadFormatWASM, WasmModule: []byte("function Decoder() {}")}).Validate(), ShouldNotBeNil)
+	a.So((&Application{AppId: "test", PayloadFormat: PayloadFormatBinary, BinaryFields: []*BinaryField{
+		{Name: "temperature", Type: "int16", Scale: 0.01},
+		{Name: "battery", Offset: 2, Type: "uint8"},
+	}}).Validate(), ShouldBeNil)
+	a.So((&Application{AppId: "test", BinaryFields: []*BinaryField{{Name: "temperature", Type: "int12"}}}).Validate(), ShouldNotBeNil)
+	a.So((&Application{AppId: "test", BinaryFields: []*BinaryField{{Name: "led", Type: "bool", Scale: 2}}}).Validate(), ShouldNotBeNil)
+	a.So((&Application{AppId: "test", BinaryFields: []*BinaryField{{Name: "temperature", Type: "int16"}, {Name: "temperature", Type: "uint8"}}}).Validate(), ShouldNotBeNil)
+	a.So((&Application{AppId: "test", PayloadFormat: PayloadFormatProtobuf, ProtobufDescriptor: []byte{0x0a, 0x00}, ProtobufMessage: "sensors.Reading"}).Validate(), ShouldBeNil)
+	a.So((&Application{AppId: "test", PayloadFormat: PayloadFormatProtobuf}).Validate(), ShouldNotBeNil)
+	a.So((&Application{AppId: "test", PayloadFormat: PayloadFormatCBOR}).Validate(), ShouldBeNil)
+	a.So((&Application{AppId: "test", PayloadFormat: PayloadFormatGo, GoCodec: "acme-meter"}).Validate(), ShouldBeNil)
+	a.So((&Application{AppId: "test", PayloadFormat: PayloadFormatGo}).Validate(), ShouldNotBeNil)
+	a.So((&Application{AppId: "test", PayloadFormat: PayloadFormatGo, GoCodec: "Acme Meter"}).Validate(), ShouldNotBeNil)
+	a.So((&Application{AppId: "test", DataResidency: "eu"}).Validate(), ShouldBeNil)
+	a.So((&Application{AppId: "test", DataResidency: "EU West"}).Validate(), ShouldNotBeNil)
+}
+
+func TestFunctionsLanguageValidate(t *testing.T) {
+	a := New(t)
+	a.So((&Application{AppId: "test", FunctionsLanguage: FunctionsLanguageLua}).Validate(), ShouldBeNil)
+	a.So((&Application{AppId: "test", FunctionsLanguage: FunctionsLanguageJavaScript}).Validate(), ShouldBeNil)
+	a.So((&Application{AppId: "test", FunctionsLanguage: "python"}).Validate(), ShouldNotBeNil)
+}
+
+func TestDevAddrAllocationValidate(t *testing.T) {
+	a := New(t)
+	a.So((&Application{AppId: "test", DevAddrAllocation: "sequential"}).Validate(), ShouldBeNil)
+	a.So((&Application{AppId: "test", DevAddrAllocation: "linear"}).Validate(), ShouldNotBeNil)
+}
+
+func TestSensitiveFieldsValidate(t *testing.T) {
+	a := New(t)
+	a.So((&Application{AppId: "test", SensitiveFields: []string{"name", "location.lat"}}).Validate(), ShouldBeNil)
+	a.So((&Application{AppId: "test", SensitiveFields: []string{""}}).Validate(), ShouldNotBeNil)
+	a.So((&Application{AppId: "test", SensitiveFields: []string{"location."}}).Validate(), ShouldNotBeNil)
+}
+
+func TestWebhookValidate(t *testing.T) {
+	a := New(t)
+	a.So((&Webhook{AppId: "test", WebhookId: "dashboard", Url: "https://example.com/ttn"}).Validate(), ShouldBeNil)
+	a.So((&Webhook{AppId: "test", WebhookId: "dashboard", Url: "https://example.com/ttn", Headers: map[string]string{"Authorization": "Bearer secret"}, MessageTypes: []string{WebhookMessageUplink, WebhookMessageDownlink}}).Validate(), ShouldBeNil)
+	a.So((&Webhook{AppId: "test", Url: "https://example.com/ttn"}).Validate(), ShouldNotBeNil)
+	a.So((&Webhook{AppId: "test", WebhookId: "dashboard"}).Validate(), ShouldNotBeNil)
+	a.So((&Webhook{AppId: "test", WebhookId: "dashboard", Url: "ftp://example.com"}).Validate(), ShouldNotBeNil)
+	a.So((&Webhook{AppId: "test", WebhookId: "dashboard", Url: "https://example.com/ttn", Headers: map[string]string{"X Key": "secret"}}).Validate(), ShouldNotBeNil)
+	a.So((&Webhook{AppId: "test", WebhookId: "dashboard", Url: "https://example.com/ttn", MessageTypes: []string{"events"}}).Validate(), ShouldNotBeNil)
+}
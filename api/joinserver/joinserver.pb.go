@@ -0,0 +1,1266 @@
+// Code generated by protoc-gen-gogo.
+// source: github.com/TheThingsNetwork/ttn/api/joinserver/joinserver.proto
+// DO NOT EDIT!
+
+/*
+	Package joinserver is a generated protocol buffer package.
+
+	It is generated from these files:
+		github.com/TheThingsNetwork/ttn/api/joinserver/joinserver.proto
+
+	It has these top-level messages:
+		JoinRequest
+		JoinResponse
+		DeviceIdentifier
+		DeviceKeys
+*/
+package joinserver
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import google_protobuf "github.com/golang/protobuf/ptypes/empty"
+import _ "github.com/gogo/protobuf/gogoproto"
+
+import github_com_TheThingsNetwork_ttn_core_types "github.com/TheThingsNetwork/ttn/core/types"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type JoinRequest struct {
+	// The join request message (PHYPayload) of the device
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// The join accept message (PHYPayload) that was prepared by the Network Server, without AppNonce and MIC
+	AcceptTemplate []byte `protobuf:"bytes,2,opt,name=accept_template,json=acceptTemplate,proto3" json:"accept_template,omitempty"`
+}
+
+func (m *JoinRequest) Reset()                    { *m = JoinRequest{} }
+func (m *JoinRequest) String() string            { return proto.CompactTextString(m) }
+func (*JoinRequest) ProtoMessage()               {}
+func (*JoinRequest) Descriptor() ([]byte, []int) { return fileDescriptorJoinserver, []int{0} }
+
+func (m *JoinRequest) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *JoinRequest) GetAcceptTemplate() []byte {
+	if m != nil {
+		return m.AcceptTemplate
+	}
+	return nil
+}
+
+type JoinResponse struct {
+	// The encrypted join accept message (PHYPayload)
+	Payload []byte                                              `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	DevAddr *github_com_TheThingsNetwork_ttn_core_types.DevAddr `protobuf:"bytes,2,opt,name=dev_addr,json=devAddr,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.DevAddr" json:"dev_addr,omitempty"`
+	NwkSKey *github_com_TheThingsNetwork_ttn_core_types.NwkSKey `protobuf:"bytes,3,opt,name=nwk_s_key,json=nwkSKey,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.NwkSKey" json:"nwk_s_key,omitempty"`
+	AppSKey *github_com_TheThingsNetwork_ttn_core_types.AppSKey `protobuf:"bytes,4,opt,name=app_s_key,json=appSKey,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.AppSKey" json:"app_s_key,omitempty"`
+}
+
+func (m *JoinResponse) Reset()                    { *m = JoinResponse{} }
+func (m *JoinResponse) String() string            { return proto.CompactTextString(m) }
+func (*JoinResponse) ProtoMessage()               {}
+func (*JoinResponse) Descriptor() ([]byte, []int) { return fileDescriptorJoinserver, []int{1} }
+
+func (m *JoinResponse) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type DeviceIdentifier struct {
+	AppEui *github_com_TheThingsNetwork_ttn_core_types.AppEUI `protobuf:"bytes,1,opt,name=app_eui,json=appEui,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.AppEUI" json:"app_eui,omitempty"`
+	DevEui *github_com_TheThingsNetwork_ttn_core_types.DevEUI `protobuf:"bytes,2,opt,name=dev_eui,json=devEui,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.DevEUI" json:"dev_eui,omitempty"`
+}
+
+func (m *DeviceIdentifier) Reset()                    { *m = DeviceIdentifier{} }
+func (m *DeviceIdentifier) String() string            { return proto.CompactTextString(m) }
+func (*DeviceIdentifier) ProtoMessage()               {}
+func (*DeviceIdentifier) Descriptor() ([]byte, []int) { return fileDescriptorJoinserver, []int{2} }
+
+// The root keys of a device. The AppKey can be set, but is never returned by the Join Server.
+type DeviceKeys struct {
+	AppEui *github_com_TheThingsNetwork_ttn_core_types.AppEUI `protobuf:"bytes,1,opt,name=app_eui,json=appEui,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.AppEUI" json:"app_eui,omitempty"`
+	DevEui *github_com_TheThingsNetwork_ttn_core_types.DevEUI `protobuf:"bytes,2,opt,name=dev_eui,json=devEui,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.DevEUI" json:"dev_eui,omitempty"`
+	AppKey *github_com_TheThingsNetwork_ttn_core_types.AppKey `protobuf:"bytes,3,opt,name=app_key,json=appKey,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.AppKey" json:"app_key,omitempty"`
+}
+
+func (m *DeviceKeys) Reset()                    { *m = DeviceKeys{} }
+func (m *DeviceKeys) String() string            { return proto.CompactTextString(m) }
+func (*DeviceKeys) ProtoMessage()               {}
+func (*DeviceKeys) Descriptor() ([]byte, []int) { return fileDescriptorJoinserver, []int{3} }
+
+func init() {
+	proto.RegisterType((*JoinRequest)(nil), "joinserver.JoinRequest")
+	proto.RegisterType((*JoinResponse)(nil), "joinserver.JoinResponse")
+	proto.RegisterType((*DeviceIdentifier)(nil), "joinserver.DeviceIdentifier")
+	proto.RegisterType((*DeviceKeys)(nil), "joinserver.DeviceKeys")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for JoinServer service
+
+type JoinServerClient interface {
+	// Handler requests the Join Server to validate the join request, derive the session keys and encrypt the join
+	// accept message
+	Join(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*JoinResponse, error)
+}
+
+type joinServerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewJoinServerClient(cc *grpc.ClientConn) JoinServerClient {
+	return &joinServerClient{cc}
+}
+
+func (c *joinServerClient) Join(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*JoinResponse, error) {
+	out := new(JoinResponse)
+	err := grpc.Invoke(ctx, "/joinserver.JoinServer/Join", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for JoinServer service
+
+type JoinServerServer interface {
+	// Handler requests the Join Server to validate the join request, derive the session keys and encrypt the join
+	// accept message
+	Join(context.Context, *JoinRequest) (*JoinResponse, error)
+}
+
+func RegisterJoinServerServer(s *grpc.Server, srv JoinServerServer) {
+	s.RegisterService(&_JoinServer_serviceDesc, srv)
+}
+
+func _JoinServer_Join_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JoinServerServer).Join(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/joinserver.JoinServer/Join",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JoinServerServer).Join(ctx, req.(*JoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _JoinServer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "joinserver.JoinServer",
+	HandlerType: (*JoinServerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Join",
+			Handler:    _JoinServer_Join_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/TheThingsNetwork/ttn/api/joinserver/joinserver.proto",
+}
+
+// Client API for JoinServerManager service
+
+type JoinServerManagerClient interface {
+	// Handler sets the root keys of a device. If the AppKey is changed, the used nonces of the device are reset.
+	SetDeviceKeys(ctx context.Context, in *DeviceKeys, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// Handler deletes the root keys of a device
+	DeleteDeviceKeys(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+}
+
+type joinServerManagerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewJoinServerManagerClient(cc *grpc.ClientConn) JoinServerManagerClient {
+	return &joinServerManagerClient{cc}
+}
+
+func (c *joinServerManagerClient) SetDeviceKeys(ctx context.Context, in *DeviceKeys, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/joinserver.JoinServerManager/SetDeviceKeys", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *joinServerManagerClient) DeleteDeviceKeys(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/joinserver.JoinServerManager/DeleteDeviceKeys", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for JoinServerManager service
+
+type JoinServerManagerServer interface {
+	// Handler sets the root keys of a device. If the AppKey is changed, the used nonces of the device are reset.
+	SetDeviceKeys(context.Context, *DeviceKeys) (*google_protobuf.Empty, error)
+	// Handler deletes the root keys of a device
+	DeleteDeviceKeys(context.Context, *DeviceIdentifier) (*google_protobuf.Empty, error)
+}
+
+func RegisterJoinServerManagerServer(s *grpc.Server, srv JoinServerManagerServer) {
+	s.RegisterService(&_JoinServerManager_serviceDesc, srv)
+}
+
+func _JoinServerManager_SetDeviceKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceKeys)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JoinServerManagerServer).SetDeviceKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/joinserver.JoinServerManager/SetDeviceKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JoinServerManagerServer).SetDeviceKeys(ctx, req.(*DeviceKeys))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JoinServerManager_DeleteDeviceKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JoinServerManagerServer).DeleteDeviceKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/joinserver.JoinServerManager/DeleteDeviceKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JoinServerManagerServer).DeleteDeviceKeys(ctx, req.(*DeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _JoinServerManager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "joinserver.JoinServerManager",
+	HandlerType: (*JoinServerManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetDeviceKeys",
+			Handler:    _JoinServerManager_SetDeviceKeys_Handler,
+		},
+		{
+			MethodName: "DeleteDeviceKeys",
+			Handler:    _JoinServerManager_DeleteDeviceKeys_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/TheThingsNetwork/ttn/api/joinserver/joinserver.proto",
+}
+
+func (m *JoinRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JoinRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintJoinserver(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	if len(m.AcceptTemplate) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintJoinserver(dAtA, i, uint64(len(m.AcceptTemplate)))
+		i += copy(dAtA[i:], m.AcceptTemplate)
+	}
+	return i, nil
+}
+
+func (m *JoinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JoinResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintJoinserver(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	if m.DevAddr != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintJoinserver(dAtA, i, uint64(m.DevAddr.Size()))
+		n1, err := m.DevAddr.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.NwkSKey != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintJoinserver(dAtA, i, uint64(m.NwkSKey.Size()))
+		n2, err := m.NwkSKey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.AppSKey != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintJoinserver(dAtA, i, uint64(m.AppSKey.Size()))
+		n3, err := m.AppSKey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+
+func (m *DeviceIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.AppEui != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintJoinserver(dAtA, i, uint64(m.AppEui.Size()))
+		n4, err := m.AppEui.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.DevEui != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintJoinserver(dAtA, i, uint64(m.DevEui.Size()))
+		n5, err := m.DevEui.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
+
+func (m *DeviceKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceKeys) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.AppEui != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintJoinserver(dAtA, i, uint64(m.AppEui.Size()))
+		n6, err := m.AppEui.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.DevEui != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintJoinserver(dAtA, i, uint64(m.DevEui.Size()))
+		n7, err := m.DevEui.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.AppKey != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintJoinserver(dAtA, i, uint64(m.AppKey.Size()))
+		n8, err := m.AppKey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	return i, nil
+}
+
+func encodeFixed64Joinserver(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Joinserver(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintJoinserver(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *JoinRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	l = len(m.AcceptTemplate)
+	if l > 0 {
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	return n
+}
+
+func (m *JoinResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	if m.DevAddr != nil {
+		l = m.DevAddr.Size()
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	if m.NwkSKey != nil {
+		l = m.NwkSKey.Size()
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	if m.AppSKey != nil {
+		l = m.AppSKey.Size()
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	return n
+}
+
+func (m *DeviceIdentifier) Size() (n int) {
+	var l int
+	_ = l
+	if m.AppEui != nil {
+		l = m.AppEui.Size()
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	if m.DevEui != nil {
+		l = m.DevEui.Size()
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	return n
+}
+
+func (m *DeviceKeys) Size() (n int) {
+	var l int
+	_ = l
+	if m.AppEui != nil {
+		l = m.AppEui.Size()
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	if m.DevEui != nil {
+		l = m.DevEui.Size()
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	if m.AppKey != nil {
+		l = m.AppKey.Size()
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	return n
+}
+
+func sovJoinserver(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozJoinserver(x uint64) (n int) {
+	return sovJoinserver(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *JoinRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJoinserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptTemplate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptTemplate = append(m.AcceptTemplate[:0], dAtA[iNdEx:postIndex]...)
+			if m.AcceptTemplate == nil {
+				m.AcceptTemplate = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJoinserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJoinserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.DevAddr
+			m.DevAddr = &v
+			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NwkSKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.NwkSKey
+			m.NwkSKey = &v
+			if err := m.NwkSKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppSKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.AppSKey
+			m.AppSKey = &v
+			if err := m.AppSKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJoinserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJoinserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppEui", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.AppEUI
+			m.AppEui = &v
+			if err := m.AppEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEui", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.DevEUI
+			m.DevEui = &v
+			if err := m.DevEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJoinserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJoinserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppEui", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.AppEUI
+			m.AppEui = &v
+			if err := m.AppEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEui", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.DevEUI
+			m.DevEui = &v
+			if err := m.DevEui.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_TheThingsNetwork_ttn_core_types.AppKey
+			m.AppKey = &v
+			if err := m.AppKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJoinserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipJoinserver(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowJoinserver
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthJoinserver
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowJoinserver
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipJoinserver(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthJoinserver = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowJoinserver   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() {
+	proto.RegisterFile("github.com/TheThingsNetwork/ttn/api/joinserver/joinserver.proto", fileDescriptorJoinserver)
+}
+
+var fileDescriptorJoinserver = []byte{
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcd, 0x94, 0xcd, 0x4e, 0xdb, 0x40,
+	0x10, 0xc7, 0xe5, 0x80, 0x08, 0x4c, 0xa1, 0x2d, 0x3e, 0x50, 0x2b, 0xa0, 0x16, 0xe5, 0xd2, 0x5e,
+	0x6a, 0x8b, 0x20, 0x90, 0x2a, 0x55, 0x42, 0x44, 0x8d, 0xf8, 0xa8, 0x1a, 0x81, 0x93, 0x5e, 0xb8,
+	0x58, 0x1b, 0x7b, 0x70, 0x96, 0x04, 0xef, 0xd6, 0x5e, 0x27, 0xf2, 0xcb, 0x70, 0xe1, 0x0d, 0x78,
+	0x0a, 0x8e, 0x9c, 0x39, 0x54, 0x15, 0xcf, 0xc1, 0x81, 0x5d, 0x2f, 0x91, 0x2d, 0xa1, 0x16, 0x91,
+	0x53, 0x0f, 0x2b, 0xcf, 0xcc, 0xae, 0x7f, 0xf3, 0xf7, 0x78, 0x66, 0x61, 0x27, 0xa4, 0xa2, 0x9f,
+	0xf6, 0x6c, 0x9f, 0x9d, 0x3b, 0xdd, 0x3e, 0x76, 0xfb, 0x34, 0x0a, 0x93, 0x36, 0x8a, 0x31, 0x8b,
+	0x07, 0x8e, 0x10, 0x91, 0x43, 0x38, 0x75, 0xce, 0x18, 0x8d, 0x12, 0x8c, 0x47, 0x18, 0x97, 0x4c,
+	0x9b, 0xc7, 0x4c, 0x30, 0x13, 0x8a, 0x48, 0x6d, 0x35, 0x64, 0x2c, 0x1c, 0xa2, 0x93, 0xef, 0xf4,
+	0xd2, 0x53, 0x07, 0xcf, 0xb9, 0xc8, 0xf4, 0xc1, 0xda, 0xe7, 0x52, 0xa6, 0x90, 0x85, 0xac, 0x38,
+	0xa5, 0xbc, 0xdc, 0xc9, 0x2d, 0x7d, 0xbc, 0x7e, 0x04, 0xaf, 0x0e, 0x25, 0xd9, 0xc5, 0x5f, 0x29,
+	0x26, 0xc2, 0xb4, 0xa0, 0xca, 0x49, 0x36, 0x64, 0x24, 0xb0, 0x8c, 0x75, 0xe3, 0xd3, 0xa2, 0x3b,
+	0x71, 0xcd, 0x8f, 0xf0, 0x86, 0xf8, 0x3e, 0x72, 0xe1, 0x09, 0x99, 0x6e, 0x48, 0x04, 0x5a, 0x95,
+	0xfc, 0xc4, 0x6b, 0x1d, 0xee, 0x3e, 0x46, 0xeb, 0x97, 0x15, 0x58, 0xd4, 0xc8, 0x84, 0x33, 0x29,
+	0xf9, 0x1f, 0xcc, 0x63, 0x98, 0x0f, 0x70, 0xe4, 0x91, 0x20, 0x88, 0x35, 0xac, 0xb9, 0x7d, 0xfb,
+	0xfb, 0x43, 0xe3, 0xb9, 0x5a, 0xf9, 0x2c, 0x46, 0x47, 0x64, 0x1c, 0x13, 0xfb, 0x1b, 0x8e, 0x76,
+	0xe5, 0xdb, 0x6e, 0x35, 0xd0, 0x86, 0xe9, 0xc2, 0x42, 0x34, 0x1e, 0x78, 0x89, 0x37, 0xc0, 0xcc,
+	0x9a, 0x99, 0x8a, 0xd9, 0x1e, 0x0f, 0x3a, 0xdf, 0x31, 0x73, 0xab, 0x91, 0x36, 0x14, 0x93, 0x70,
+	0xfe, 0xc8, 0x9c, 0x9d, 0x8a, 0xb9, 0xcb, 0xb9, 0x66, 0x12, 0x6d, 0xd4, 0xaf, 0x0c, 0x78, 0x2b,
+	0xc5, 0x53, 0x1f, 0x0f, 0x02, 0x8c, 0x04, 0x3d, 0xa5, 0x18, 0x9b, 0x6d, 0x50, 0xfb, 0x1e, 0xa6,
+	0x54, 0x57, 0xaa, 0xb9, 0x25, 0xd3, 0x6c, 0xbc, 0x2c, 0x4d, 0xeb, 0xe7, 0x81, 0x3b, 0x27, 0x29,
+	0xad, 0x94, 0x2a, 0x9e, 0xaa, 0xaf, 0xe2, 0x55, 0xa6, 0xe2, 0x49, 0x85, 0x39, 0x4f, 0x52, 0x24,
+	0xaf, 0x7e, 0x6f, 0x00, 0x68, 0xd1, 0xf2, 0x13, 0x92, 0xff, 0x5d, 0xee, 0x44, 0x5f, 0xd1, 0x09,
+	0x53, 0xe8, 0x53, 0x3f, 0x4d, 0xe9, 0x93, 0xcf, 0xc6, 0x1e, 0x80, 0x6a, 0xec, 0x4e, 0x3e, 0x85,
+	0xe6, 0x17, 0x98, 0x55, 0x9e, 0xf9, 0xce, 0x2e, 0x0d, 0x6b, 0x69, 0x96, 0x6a, 0xd6, 0xd3, 0x0d,
+	0x3d, 0x11, 0x8d, 0x0b, 0x03, 0x96, 0x0b, 0xd2, 0x0f, 0x12, 0x91, 0x50, 0x02, 0x77, 0x60, 0xa9,
+	0x83, 0xa2, 0x54, 0xdf, 0x95, 0x32, 0xa0, 0x88, 0xd7, 0x56, 0x6c, 0x7d, 0x01, 0xd8, 0x93, 0xd1,
+	0xb6, 0x5b, 0xea, 0x02, 0x30, 0xf7, 0x55, 0x4b, 0x0d, 0x51, 0x60, 0x89, 0xb1, 0xf6, 0x94, 0x51,
+	0x34, 0xdc, 0xdf, 0x48, 0xcd, 0xaf, 0xd7, 0x77, 0xef, 0x8d, 0x1b, 0xb9, 0xfe, 0xc8, 0x75, 0x62,
+	0xbf, 0xec, 0xf2, 0xea, 0xcd, 0xe5, 0xb4, 0xcd, 0x07, 0xa6, 0x09, 0x1e, 0xe4, 0xf5, 0x04, 0x00,
+	0x00,
+}
@@ -0,0 +1,42 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package joinserver
+
+import "github.com/TheThingsNetwork/ttn/utils/errors"
+
+// Validate implements the api.Validator interface
+func (m *JoinRequest) Validate() error {
+	if len(m.Payload) != 23 {
+		return errors.NewErrInvalidArgument("Payload", "must be a join request of 23 bytes")
+	}
+	if len(m.AcceptTemplate) == 0 {
+		return errors.NewErrInvalidArgument("AcceptTemplate", "can not be empty")
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *DeviceIdentifier) Validate() error {
+	if m.AppEui == nil || m.AppEui.IsEmpty() {
+		return errors.NewErrInvalidArgument("AppEui", "can not be empty")
+	}
+	if m.DevEui == nil || m.DevEui.IsEmpty() {
+		return errors.NewErrInvalidArgument("DevEui", "can not be empty")
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *DeviceKeys) Validate() error {
+	if m.AppEui == nil || m.AppEui.IsEmpty() {
+		return errors.NewErrInvalidArgument("AppEui", "can not be empty")
+	}
+	if m.DevEui == nil || m.DevEui.IsEmpty() {
+		return errors.NewErrInvalidArgument("DevEui", "can not be empty")
+	}
+	if m.AppKey == nil || m.AppKey.IsEmpty() {
+		return errors.NewErrInvalidArgument("AppKey", "can not be empty")
+	}
+	return nil
+}
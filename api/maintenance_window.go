@@ -0,0 +1,82 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package api
+
+import (
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// Recurrences of maintenance windows
+const (
+	RecurrenceDaily   = "daily"
+	RecurrenceWeekly  = "weekly"
+	RecurrenceMonthly = "monthly"
+)
+
+// Validate implements the api.Validator interface
+func (m *MaintenanceWindow) Validate() error {
+	if m.Start == 0 {
+		return errors.NewErrInvalidArgument("Start", "can not be empty")
+	}
+	if m.Duration == 0 {
+		return errors.NewErrInvalidArgument("Duration", "can not be empty")
+	}
+	duration := time.Duration(m.Duration) * time.Second
+	switch m.Recurrence {
+	case "":
+	case RecurrenceDaily:
+		if duration > 24*time.Hour {
+			return errors.NewErrInvalidArgument("Duration", "can not be longer than a day for daily windows")
+		}
+	case RecurrenceWeekly:
+		if duration > 7*24*time.Hour {
+			return errors.NewErrInvalidArgument("Duration", "can not be longer than a week for weekly windows")
+		}
+	case RecurrenceMonthly:
+		if duration > 28*24*time.Hour {
+			return errors.NewErrInvalidArgument("Duration", "can not be longer than 28 days for monthly windows")
+		}
+	default:
+		return errors.NewErrInvalidArgument("Recurrence", "must be daily, weekly, monthly or empty")
+	}
+	if m.Until != 0 && m.Until < m.Start {
+		return errors.NewErrInvalidArgument("Until", "can not be before the start")
+	}
+	return nil
+}
+
+// Active returns whether t is within the maintenance window or one of its recurrences
+func (m *MaintenanceWindow) Active(t time.Time) bool {
+	t = t.UTC()
+	start := time.Unix(0, m.Start).UTC()
+	if t.Before(start) || (m.Until != 0 && t.After(time.Unix(0, m.Until))) {
+		return false
+	}
+	switch m.Recurrence {
+	case RecurrenceDaily:
+		start = start.Add(t.Sub(start) / (24 * time.Hour) * (24 * time.Hour))
+	case RecurrenceWeekly:
+		start = start.Add(t.Sub(start) / (7 * 24 * time.Hour) * (7 * 24 * time.Hour))
+	case RecurrenceMonthly:
+		months := (t.Year()-start.Year())*12 + int(t.Month()-start.Month())
+		if occurrence := start.AddDate(0, months, 0); occurrence.After(t) {
+			start = start.AddDate(0, months-1, 0)
+		} else {
+			start = occurrence
+		}
+	}
+	return t.Before(start.Add(time.Duration(m.Duration) * time.Second))
+}
+
+// InMaintenance returns whether t is within one of the maintenance windows
+func InMaintenance(windows []*MaintenanceWindow, t time.Time) bool {
+	for _, window := range windows {
+		if window.Active(t) {
+			return true
+		}
+	}
+	return false
+}
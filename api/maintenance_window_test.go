@@ -0,0 +1,57 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestMaintenanceWindowValidate(t *testing.T) {
+	a := New(t)
+	start := time.Date(2017, 5, 1, 2, 0, 0, 0, time.UTC).UnixNano()
+
+	a.So((&MaintenanceWindow{Start: start, Duration: 3600}).Validate(), ShouldBeNil)
+	a.So((&MaintenanceWindow{Start: start, Duration: 3600, Recurrence: RecurrenceWeekly}).Validate(), ShouldBeNil)
+	a.So((&MaintenanceWindow{Duration: 3600}).Validate(), ShouldNotBeNil)
+	a.So((&MaintenanceWindow{Start: start}).Validate(), ShouldNotBeNil)
+	a.So((&MaintenanceWindow{Start: start, Duration: 3600, Recurrence: "hourly"}).Validate(), ShouldNotBeNil)
+	a.So((&MaintenanceWindow{Start: start, Duration: 25 * 3600, Recurrence: RecurrenceDaily}).Validate(), ShouldNotBeNil)
+	a.So((&MaintenanceWindow{Start: start, Duration: 3600, Until: start - 1}).Validate(), ShouldNotBeNil)
+}
+
+func TestMaintenanceWindowActive(t *testing.T) {
+	a := New(t)
+	start := time.Date(2017, 5, 1, 2, 0, 0, 0, time.UTC)
+
+	single := &MaintenanceWindow{Start: start.UnixNano(), Duration: 3600}
+	a.So(single.Active(start.Add(-time.Second)), ShouldBeFalse)
+	a.So(single.Active(start), ShouldBeTrue)
+	a.So(single.Active(start.Add(59*time.Minute)), ShouldBeTrue)
+	a.So(single.Active(start.Add(time.Hour)), ShouldBeFalse)
+	a.So(single.Active(start.AddDate(0, 0, 1)), ShouldBeFalse)
+
+	daily := &MaintenanceWindow{Start: start.UnixNano(), Duration: 3600, Recurrence: RecurrenceDaily}
+	a.So(daily.Active(start.AddDate(0, 0, 3).Add(30*time.Minute)), ShouldBeTrue)
+	a.So(daily.Active(start.AddDate(0, 0, 3).Add(-30*time.Minute)), ShouldBeFalse)
+
+	weekly := &MaintenanceWindow{Start: start.UnixNano(), Duration: 3600, Recurrence: RecurrenceWeekly}
+	a.So(weekly.Active(start.AddDate(0, 0, 14).Add(30*time.Minute)), ShouldBeTrue)
+	a.So(weekly.Active(start.AddDate(0, 0, 13).Add(30*time.Minute)), ShouldBeFalse)
+
+	monthly := &MaintenanceWindow{Start: start.UnixNano(), Duration: 3600, Recurrence: RecurrenceMonthly}
+	a.So(monthly.Active(start.AddDate(0, 2, 0).Add(30*time.Minute)), ShouldBeTrue)
+	a.So(monthly.Active(start.AddDate(0, 2, 1).Add(30*time.Minute)), ShouldBeFalse)
+	a.So(monthly.Active(start.AddDate(0, 2, 0).Add(-30*time.Minute)), ShouldBeFalse)
+
+	until := &MaintenanceWindow{Start: start.UnixNano(), Duration: 3600, Recurrence: RecurrenceDaily, Until: start.AddDate(0, 0, 7).UnixNano()}
+	a.So(until.Active(start.AddDate(0, 0, 6).Add(30*time.Minute)), ShouldBeTrue)
+	a.So(until.Active(start.AddDate(0, 0, 8).Add(30*time.Minute)), ShouldBeFalse)
+
+	a.So(InMaintenance(nil, start), ShouldBeFalse)
+	a.So(InMaintenance([]*MaintenanceWindow{single, daily}, start.AddDate(0, 0, 1)), ShouldBeTrue)
+	a.So(InMaintenance([]*MaintenanceWindow{single}, start.AddDate(0, 0, 1)), ShouldBeFalse)
+}
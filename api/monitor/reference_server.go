@@ -84,6 +84,15 @@ type ReferenceMonitorServer struct {
 	handlerDownlinkMessages chan *broker.DownlinkMessage
 
 	metrics metrics
+
+	series *SeriesStore
+}
+
+// WithSeries makes the server store the statistics that it receives in the series store. Gateway series are recorded
+// from the streams of gateways, device series from the streams of Handlers.
+func (s *ReferenceMonitorServer) WithSeries(series *SeriesStore) *ReferenceMonitorServer {
+	s.series = series
+	return s
 }
 
 func (s *ReferenceMonitorServer) getAndAuthGateway(ctx context.Context) (string, error) {
@@ -133,6 +142,7 @@ func (s *ReferenceMonitorServer) GatewayStatus(stream Monitor_GatewayStatusServe
 			return err
 		}
 		ctx.WithFields(fields.Get(msg)).Info("Received GatewayStatus")
+		s.series.AddGatewayStatus(gatewayID, msg)
 		select {
 		case s.gatewayStatuses <- msg:
 		default:
@@ -179,6 +189,7 @@ func (s *ReferenceMonitorServer) GatewayUplink(stream Monitor_GatewayUplinkServe
 		} else {
 			ctx.WithFields(fields.Get(msg)).Info("Received UplinkMessage")
 		}
+		s.series.AddGatewayUplink(gatewayID, msg)
 		select {
 		case s.uplinkMessages <- msg:
 		default:
@@ -225,6 +236,7 @@ func (s *ReferenceMonitorServer) GatewayDownlink(stream Monitor_GatewayDownlinkS
 		} else {
 			ctx.WithFields(fields.Get(msg)).Info("Received DownlinkMessage")
 		}
+		s.series.AddGatewayDownlink(gatewayID)
 		select {
 		case s.downlinkMessages <- msg:
 		default:
@@ -391,6 +403,7 @@ func (s *ReferenceMonitorServer) HandlerUplink(stream Monitor_HandlerUplinkServe
 		} else {
 			ctx.WithFields(fields.Get(msg)).Info("Received DeduplicatedUplinkMessage")
 		}
+		s.series.AddDeviceUplink(msg)
 		select {
 		case s.handlerUplinkMessages <- msg:
 		default:
@@ -437,6 +450,7 @@ func (s *ReferenceMonitorServer) HandlerDownlink(stream Monitor_HandlerDownlinkS
 		} else {
 			ctx.WithFields(fields.Get(msg)).Info("Received DownlinkMessage")
 		}
+		s.series.AddDeviceDownlink(msg)
 		select {
 		case s.handlerDownlinkMessages <- msg:
 		default:
@@ -0,0 +1,318 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package monitor
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/api/broker"
+	"github.com/TheThingsNetwork/ttn/api/gateway"
+	"github.com/TheThingsNetwork/ttn/api/router"
+)
+
+// SeriesPoint contains the statistics of a gateway or device in one interval of its time series
+type SeriesPoint struct {
+	Time      time.Time `json:"time"`
+	Uplinks   uint64    `json:"uplinks"`
+	Downlinks uint64    `json:"downlinks"`
+	Statuses  uint64    `json:"statuses,omitempty"`
+	// The average RSSI and SNR of the uplink messages in the interval
+	RSSI float32 `json:"rssi,omitempty"`
+	SNR  float32 `json:"snr,omitempty"`
+	// The counters and round-trip time of the last status of the gateway in the interval
+	RxOk uint32 `json:"rx_ok,omitempty"`
+	TxOk uint32 `json:"tx_ok,omitempty"`
+	RTT  uint32 `json:"rtt,omitempty"`
+}
+
+// seriesBucket is an interval of a time series. The sums of the signal are stored, so that the averages stay correct
+// when messages are added after the series is loaded.
+type seriesBucket struct {
+	SeriesPoint
+	Signals uint64  `json:"signals,omitempty"`
+	RSSISum float64 `json:"rssi_sum,omitempty"`
+	SNRSum  float64 `json:"snr_sum,omitempty"`
+}
+
+func (b *seriesBucket) addSignal(rssi, snr float32) {
+	b.Signals++
+	b.RSSISum += float64(rssi)
+	b.SNRSum += float64(snr)
+}
+
+func (b *seriesBucket) point() SeriesPoint {
+	point := b.SeriesPoint
+	if b.Signals > 0 {
+		point.RSSI = float32(b.RSSISum / float64(b.Signals))
+		point.SNR = float32(b.SNRSum / float64(b.Signals))
+	}
+	return point
+}
+
+// SeriesStore keeps time series of the statistics that the monitor server receives, per gateway and per device, so
+// that operators get basic historical monitoring without an external time series database. Intervals that are older
+// than the retention are removed. A nil SeriesStore does not store anything.
+type SeriesStore struct {
+	retention  time.Duration
+	resolution time.Duration
+	now        func() time.Time
+
+	mu       sync.RWMutex
+	gateways map[string][]*seriesBucket
+	devices  map[string][]*seriesBucket
+}
+
+// NewSeriesStore returns a new SeriesStore that keeps intervals of the resolution for the retention
+func NewSeriesStore(retention, resolution time.Duration) *SeriesStore {
+	if resolution <= 0 {
+		resolution = time.Minute
+	}
+	return &SeriesStore{
+		retention:  retention,
+		resolution: resolution,
+		now:        time.Now,
+		gateways:   make(map[string][]*seriesBucket),
+		devices:    make(map[string][]*seriesBucket),
+	}
+}
+
+func deviceKey(appID, devID string) string {
+	return appID + "/" + devID
+}
+
+// bucket returns the current interval of the series with the key, and removes the intervals that are older than the
+// retention. It must be called with the lock held.
+func (s *SeriesStore) bucket(series map[string][]*seriesBucket, key string) *seriesBucket {
+	now := s.now()
+	buckets := s.expire(series[key], now)
+	start := now.Truncate(s.resolution)
+	if len(buckets) == 0 || buckets[len(buckets)-1].Time.Before(start) {
+		buckets = append(buckets, &seriesBucket{SeriesPoint: SeriesPoint{Time: start}})
+	}
+	series[key] = buckets
+	return buckets[len(buckets)-1]
+}
+
+// expire returns the buckets without the ones that are older than the retention
+func (s *SeriesStore) expire(buckets []*seriesBucket, now time.Time) []*seriesBucket {
+	if s.retention <= 0 {
+		return buckets
+	}
+	oldest := now.Add(-1 * s.retention)
+	i := sort.Search(len(buckets), func(i int) bool {
+		return !buckets[i].Time.Add(s.resolution).Before(oldest)
+	})
+	return buckets[i:]
+}
+
+// AddGatewayStatus adds the status of the gateway to its series
+func (s *SeriesStore) AddGatewayStatus(gatewayID string, status *gateway.Status) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.bucket(s.gateways, gatewayID)
+	b.Statuses++
+	b.RxOk, b.TxOk, b.RTT = status.RxOk, status.TxOk, status.Rtt
+}
+
+// AddGatewayUplink adds the uplink message that the gateway received to its series
+func (s *SeriesStore) AddGatewayUplink(gatewayID string, msg *router.UplinkMessage) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.bucket(s.gateways, gatewayID)
+	b.Uplinks++
+	if md := msg.GatewayMetadata; md != nil {
+		b.addSignal(md.Rssi, md.Snr)
+	}
+}
+
+// AddGatewayDownlink adds a downlink message that was sent to the gateway to its series
+func (s *SeriesStore) AddGatewayDownlink(gatewayID string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.bucket(s.gateways, gatewayID).Downlinks++
+}
+
+// AddDeviceUplink adds the uplink message to the series of its device. The signal of the best gateway is used.
+func (s *SeriesStore) AddDeviceUplink(msg *broker.DeduplicatedUplinkMessage) {
+	if s == nil || msg.AppId == "" || msg.DevId == "" {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.bucket(s.devices, deviceKey(msg.AppId, msg.DevId))
+	b.Uplinks++
+	var best *gateway.RxMetadata
+	for _, md := range msg.GatewayMetadata {
+		if best == nil || md.Snr > best.Snr {
+			best = md
+		}
+	}
+	if best != nil {
+		b.addSignal(best.Rssi, best.Snr)
+	}
+}
+
+// AddDeviceDownlink adds the downlink message to the series of its device
+func (s *SeriesStore) AddDeviceDownlink(msg *broker.DownlinkMessage) {
+	if s == nil || msg.AppId == "" || msg.DevId == "" {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.bucket(s.devices, deviceKey(msg.AppId, msg.DevId)).Downlinks++
+}
+
+// query returns the points of the series with the key between since and until (zero for no limit)
+func (s *SeriesStore) query(series map[string][]*seriesBucket, key string, since, until time.Time) []SeriesPoint {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	buckets := s.expire(series[key], s.now())
+	points := make([]SeriesPoint, 0, len(buckets))
+	for _, b := range buckets {
+		if !since.IsZero() && !b.Time.Add(s.resolution).After(since) {
+			continue
+		}
+		if !until.IsZero() && !b.Time.Before(until) {
+			continue
+		}
+		points = append(points, b.point())
+	}
+	return points
+}
+
+// Gateway returns the series of the gateway between since and until (zero for no limit)
+func (s *SeriesStore) Gateway(gatewayID string, since, until time.Time) []SeriesPoint {
+	return s.query(s.gateways, gatewayID, since, until)
+}
+
+// Device returns the series of the device between since and until (zero for no limit)
+func (s *SeriesStore) Device(appID, devID string, since, until time.Time) []SeriesPoint {
+	return s.query(s.devices, deviceKey(appID, devID), since, until)
+}
+
+func (s *SeriesStore) keys(series map[string][]*seriesBucket) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	keys := make([]string, 0, len(series))
+	for key := range series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Gateways returns the IDs of the gateways that have a series
+func (s *SeriesStore) Gateways() []string {
+	return s.keys(s.gateways)
+}
+
+// Devices returns the identifiers (app_id/dev_id) of the devices that have a series
+func (s *SeriesStore) Devices() []string {
+	return s.keys(s.devices)
+}
+
+// Cleanup removes the intervals that are older than the retention, and the series without intervals
+func (s *SeriesStore) Cleanup() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	for _, series := range []map[string][]*seriesBucket{s.gateways, s.devices} {
+		for key, buckets := range series {
+			if buckets = s.expire(buckets, now); len(buckets) == 0 {
+				delete(series, key)
+			} else {
+				series[key] = buckets
+			}
+		}
+	}
+}
+
+type seriesSnapshot struct {
+	Gateways map[string][]*seriesBucket `json:"gateways"`
+	Devices  map[string][]*seriesBucket `json:"devices"`
+}
+
+// Save writes the series to w, so that they can be loaded after a restart
+func (s *SeriesStore) Save(w io.Writer) error {
+	s.Cleanup()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return json.NewEncoder(w).Encode(seriesSnapshot{Gateways: s.gateways, Devices: s.devices})
+}
+
+// Load reads the series that were saved with Save from r, replacing the series in the store
+func (s *SeriesStore) Load(r io.Reader) error {
+	var snapshot seriesSnapshot
+	if err := json.NewDecoder(r).Decode(&snapshot); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	if snapshot.Gateways != nil {
+		s.gateways = snapshot.Gateways
+	}
+	if snapshot.Devices != nil {
+		s.devices = snapshot.Devices
+	}
+	s.mu.Unlock()
+	s.Cleanup()
+	return nil
+}
+
+// ServeHTTP serves the query API of the series:
+//
+//	GET /gateways                  the IDs of the gateways
+//	GET /gateways/<gateway_id>     the series of the gateway
+//	GET /devices                   the identifiers of the devices
+//	GET /devices/<app_id>/<dev_id> the series of the device
+//
+// The series can be limited with the since and until query parameters (RFC3339).
+func (s *SeriesStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var since, until time.Time
+	for param, t := range map[string]*time.Time{"since": &since, "until": &until} {
+		if value := r.URL.Query().Get(param); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				http.Error(w, "Invalid "+param, http.StatusBadRequest)
+				return
+			}
+			*t = parsed
+		}
+	}
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	var res interface{}
+	switch {
+	case len(parts) == 1 && parts[0] == "gateways":
+		res = s.Gateways()
+	case len(parts) == 2 && parts[0] == "gateways":
+		res = s.Gateway(parts[1], since, until)
+	case len(parts) == 1 && parts[0] == "devices":
+		res = s.Devices()
+	case len(parts) == 3 && parts[0] == "devices":
+		res = s.Device(parts[1], parts[2], since, until)
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
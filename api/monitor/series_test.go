@@ -0,0 +1,111 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package monitor
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/api/broker"
+	"github.com/TheThingsNetwork/ttn/api/gateway"
+	"github.com/TheThingsNetwork/ttn/api/router"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestSeriesStore(t *testing.T) {
+	a := New(t)
+
+	now := time.Date(2017, 6, 1, 12, 0, 30, 0, time.UTC)
+	s := NewSeriesStore(time.Hour, time.Minute)
+	s.now = func() time.Time { return now }
+
+	s.AddGatewayStatus("gtw", &gateway.Status{RxOk: 10, TxOk: 2, Rtt: 40})
+	s.AddGatewayUplink("gtw", &router.UplinkMessage{GatewayMetadata: &gateway.RxMetadata{Rssi: -100, Snr: 5}})
+	s.AddGatewayUplink("gtw", &router.UplinkMessage{GatewayMetadata: &gateway.RxMetadata{Rssi: -80, Snr: 7}})
+	s.AddGatewayDownlink("gtw")
+	s.AddDeviceUplink(&broker.DeduplicatedUplinkMessage{AppId: "app", DevId: "dev", GatewayMetadata: []*gateway.RxMetadata{
+		{Rssi: -110, Snr: -2},
+		{Rssi: -90, Snr: 4},
+	}})
+	s.AddDeviceDownlink(&broker.DownlinkMessage{AppId: "app", DevId: "dev"})
+
+	now = now.Add(time.Minute)
+	s.AddGatewayUplink("gtw", &router.UplinkMessage{})
+
+	points := s.Gateway("gtw", time.Time{}, time.Time{})
+	a.So(points, ShouldHaveLength, 2)
+	a.So(points[0].Time, ShouldResemble, time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC))
+	a.So(points[0].Uplinks, ShouldEqual, 2)
+	a.So(points[0].Downlinks, ShouldEqual, 1)
+	a.So(points[0].Statuses, ShouldEqual, 1)
+	a.So(points[0].RSSI, ShouldEqual, -90)
+	a.So(points[0].SNR, ShouldEqual, 6)
+	a.So(points[0].RxOk, ShouldEqual, 10)
+	a.So(points[0].RTT, ShouldEqual, 40)
+	a.So(points[1].Uplinks, ShouldEqual, 1)
+
+	a.So(s.Gateway("gtw", time.Date(2017, 6, 1, 12, 1, 0, 0, time.UTC), time.Time{}), ShouldHaveLength, 1)
+	a.So(s.Gateway("gtw", time.Time{}, time.Date(2017, 6, 1, 12, 1, 0, 0, time.UTC)), ShouldHaveLength, 1)
+
+	points = s.Device("app", "dev", time.Time{}, time.Time{})
+	a.So(points, ShouldHaveLength, 1)
+	a.So(points[0].Uplinks, ShouldEqual, 1)
+	a.So(points[0].Downlinks, ShouldEqual, 1)
+	a.So(points[0].RSSI, ShouldEqual, -90)
+	a.So(s.Devices(), ShouldResemble, []string{"app/dev"})
+
+	// Saved series can be loaded and extended
+	var buf bytes.Buffer
+	a.So(s.Save(&buf), ShouldBeNil)
+	loaded := NewSeriesStore(time.Hour, time.Minute)
+	loaded.now = s.now
+	a.So(loaded.Load(&buf), ShouldBeNil)
+	loaded.AddDeviceUplink(&broker.DeduplicatedUplinkMessage{AppId: "app", DevId: "dev", GatewayMetadata: []*gateway.RxMetadata{{Rssi: -70, Snr: 8}}})
+	loaded.AddGatewayUplink("gtw", &router.UplinkMessage{GatewayMetadata: &gateway.RxMetadata{Rssi: -60, Snr: 9}})
+	a.So(loaded.Gateway("gtw", time.Time{}, time.Time{})[0].RSSI, ShouldEqual, -90)
+	a.So(loaded.Gateway("gtw", time.Time{}, time.Time{})[1].RSSI, ShouldEqual, -60)
+	a.So(loaded.Device("app", "dev", time.Time{}, time.Time{}), ShouldHaveLength, 2)
+
+	// Intervals older than the retention are removed
+	now = now.Add(90 * time.Minute)
+	a.So(s.Gateway("gtw", time.Time{}, time.Time{}), ShouldBeEmpty)
+	s.Cleanup()
+	a.So(s.Gateways(), ShouldBeEmpty)
+
+	// A nil store does not store anything
+	var nilStore *SeriesStore
+	nilStore.AddGatewayDownlink("gtw")
+}
+
+func TestSeriesStoreHTTP(t *testing.T) {
+	a := New(t)
+
+	s := NewSeriesStore(time.Hour, time.Minute)
+	s.AddGatewayDownlink("gtw")
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest("GET", "/gateways", nil))
+	a.So(rec.Code, ShouldEqual, 200)
+	var ids []string
+	a.So(json.Unmarshal(rec.Body.Bytes(), &ids), ShouldBeNil)
+	a.So(ids, ShouldResemble, []string{"gtw"})
+
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest("GET", "/gateways/gtw", nil))
+	var points []SeriesPoint
+	a.So(json.Unmarshal(rec.Body.Bytes(), &points), ShouldBeNil)
+	a.So(points, ShouldHaveLength, 1)
+	a.So(points[0].Downlinks, ShouldEqual, 1)
+
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest("GET", "/gateways/gtw?since=yesterday", nil))
+	a.So(rec.Code, ShouldEqual, 400)
+
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest("GET", "/applications", nil))
+	a.So(rec.Code, ShouldEqual, 404)
+}
@@ -4,11 +4,14 @@
 package main
 
 import (
+	"flag"
 	"math"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"google.golang.org/grpc"
 
@@ -21,16 +24,55 @@ func main() {
 	log.Set(apex.Stdout())
 	ctx := log.Get()
 
-	if len(os.Args) != 2 {
-		ctx.Fatal("Usage: ttn-monitor-server-example [listen]")
+	retention := flag.Duration("retention", 0, "Store the statistics of gateways and devices for this duration (0 to not store statistics)")
+	resolution := flag.Duration("resolution", time.Minute, "The interval of the stored statistics")
+	httpAddress := flag.String("http", "", "Serve the query API of the stored statistics on this address")
+	dataFile := flag.String("data", "", "Load and save the stored statistics in this file")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		ctx.Fatal("Usage: ttn-monitor-server-example [-retention 24h] [-resolution 1m] [-http :8080] [-data file] [listen]")
 	}
 
-	lis, err := net.Listen("tcp", os.Args[1])
+	lis, err := net.Listen("tcp", flag.Arg(0))
 	if err != nil {
 		ctx.WithError(err).Fatal("Failed to listen")
 	}
 	s := grpc.NewServer(grpc.MaxConcurrentStreams(math.MaxUint16))
 	server := monitor.NewReferenceMonitorServer(10)
+
+	var series *monitor.SeriesStore
+	if *retention > 0 {
+		series = monitor.NewSeriesStore(*retention, *resolution)
+		if *dataFile != "" {
+			if f, err := os.Open(*dataFile); err == nil {
+				if err := series.Load(f); err != nil {
+					ctx.WithError(err).Warn("Could not load statistics")
+				}
+				f.Close()
+			}
+		}
+		server.WithSeries(series)
+		go func() {
+			for range time.Tick(*resolution) {
+				series.Cleanup()
+				if *dataFile != "" {
+					if err := saveSeries(series, *dataFile); err != nil {
+						ctx.WithError(err).Warn("Could not save statistics")
+					}
+				}
+			}
+		}()
+		if *httpAddress != "" {
+			go func() {
+				ctx.Infof("Serving statistics on %s", *httpAddress)
+				if err := http.ListenAndServe(*httpAddress, series); err != nil {
+					ctx.WithError(err).Fatal("Failed to serve statistics")
+				}
+			}()
+		}
+	}
+
 	monitor.RegisterMonitorServer(s, server)
 	go s.Serve(lis)
 	ctx.Infof("Listening on %s", lis.Addr().String())
@@ -40,4 +82,27 @@ func main() {
 	ctx.WithField("signal", <-sigChan).Info("signal received")
 
 	s.Stop()
+
+	if series != nil && *dataFile != "" {
+		if err := saveSeries(series, *dataFile); err != nil {
+			ctx.WithError(err).Fatal("Could not save statistics")
+		}
+	}
+}
+
+// saveSeries saves the series to a temporary file that replaces the file, so that the file is not corrupted if the
+// server stops while saving
+func saveSeries(series *monitor.SeriesStore, filename string) error {
+	f, err := os.Create(filename + ".tmp")
+	if err != nil {
+		return err
+	}
+	if err := series.Save(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(filename+".tmp", filename)
 }
@@ -0,0 +1,206 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package api
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ProtocolVersion is the version of the protocol between the components. It is incremented when a change can not be
+// expressed with a capability, for example when the meaning of an existing field changes.
+const ProtocolVersion = 2
+
+// MinProtocolVersion is the oldest version of the protocol that components still interoperate with. Version 1 is
+// used by components that do not advertise their protocol.
+const MinProtocolVersion = 1
+
+// Capabilities of the protocol between the components. Components only set the fields that belong to a capability in
+// messages to peers that advertise it, and return an error instead of letting the peer silently drop the fields.
+const (
+	CapabilityResetsFCnt         = "resets-f-cnt"
+	CapabilityRxWindow           = "rx-window"
+	CapabilityRelay              = "relay"
+	CapabilityRegionalParameters = "regional-parameters"
+	CapabilityDevAddrAllocation  = "dev-addr-allocation"
+)
+
+// Capabilities that this version of the components supports
+var Capabilities = []string{
+	CapabilityResetsFCnt,
+	CapabilityRxWindow,
+	CapabilityRelay,
+	CapabilityRegionalParameters,
+	CapabilityDevAddrAllocation,
+}
+
+// Protocol is the version and the capabilities of the protocol that a component supports
+type Protocol struct {
+	Version      int
+	Capabilities []string
+}
+
+// LegacyProtocol is the protocol of components that do not advertise their protocol
+var LegacyProtocol = Protocol{Version: MinProtocolVersion}
+
+// CurrentProtocol returns the protocol of this version of the components
+func CurrentProtocol() Protocol {
+	return Protocol{Version: ProtocolVersion, Capabilities: Capabilities}
+}
+
+// Supports returns whether the protocol has the capability
+func (p Protocol) Supports(capability string) bool {
+	for _, c := range p.Capabilities {
+		if c == capability {
+			return true
+		}
+	}
+	return false
+}
+
+// Missing returns the capabilities that the protocol does not have
+func (p Protocol) Missing(capabilities ...string) (missing []string) {
+	for _, capability := range capabilities {
+		if !p.Supports(capability) {
+			missing = append(missing, capability)
+		}
+	}
+	return
+}
+
+// Negotiate returns the protocol that both components support: the lowest version and the common capabilities
+func (p Protocol) Negotiate(peer Protocol) Protocol {
+	res := Protocol{Version: p.Version}
+	if peer.Version < res.Version {
+		res.Version = peer.Version
+	}
+	for _, capability := range p.Capabilities {
+		if peer.Supports(capability) {
+			res.Capabilities = append(res.Capabilities, capability)
+		}
+	}
+	return res
+}
+
+// Check returns an error if the peer uses a protocol version that is no longer supported
+func (p Protocol) Check() error {
+	if p.Version < MinProtocolVersion {
+		return errors.NewErrInvalidArgument("Protocol", fmt.Sprintf("version %d is no longer supported (minimum is %d)", p.Version, MinProtocolVersion))
+	}
+	return nil
+}
+
+// RequireCapabilities returns an error if the peer does not have all capabilities. The peer is described in the error.
+func (p Protocol) RequireCapabilities(peer string, capabilities ...string) error {
+	if missing := p.Missing(capabilities...); len(missing) > 0 {
+		return errors.NewErrInvalidArgument("Protocol", fmt.Sprintf("%s does not support %s, it needs to be upgraded", peer, strings.Join(missing, ", ")))
+	}
+	return nil
+}
+
+func (p Protocol) String() string {
+	capabilities := append([]string{}, p.Capabilities...)
+	sort.Strings(capabilities)
+	return fmt.Sprintf("v%d [%s]", p.Version, strings.Join(capabilities, ","))
+}
+
+// ProtocolFromMetadata gets the protocol from the metadata, or the LegacyProtocol if it is not present
+func ProtocolFromMetadata(md metadata.MD) (Protocol, error) {
+	version, ok := md["protocol-version"]
+	if !ok || len(version) == 0 {
+		return LegacyProtocol, nil
+	}
+	v, err := strconv.Atoi(version[0])
+	if err != nil {
+		return LegacyProtocol, errors.NewErrInvalidArgument("Metadata", "protocol version must be a number")
+	}
+	protocol := Protocol{Version: v}
+	if capabilities, ok := md["capabilities"]; ok && len(capabilities) > 0 && capabilities[0] != "" {
+		protocol.Capabilities = strings.Split(capabilities[0], ",")
+	}
+	return protocol, nil
+}
+
+// ProtocolFromContext gets the protocol from the metadata in the context, or the LegacyProtocol if it is not present
+func ProtocolFromContext(ctx context.Context) (Protocol, error) {
+	md := MetadataFromContext(ctx)
+	return ProtocolFromMetadata(md)
+}
+
+func protocolPairs(protocol Protocol) []string {
+	return []string{"protocol-version", strconv.Itoa(protocol.Version), "capabilities", strings.Join(protocol.Capabilities, ",")}
+}
+
+// ProtocolMetadata returns the metadata that advertises the protocol
+func ProtocolMetadata(protocol Protocol) metadata.MD {
+	return metadata.Pairs(protocolPairs(protocol)...)
+}
+
+// ContextWithProtocol returns a context that advertises the protocol
+func ContextWithProtocol(ctx context.Context, protocol Protocol) context.Context {
+	return contextWithMergedMetadata(ctx, protocolPairs(protocol)...)
+}
+
+// peerProtocols contains the protocols that servers advertised in the headers of their responses. The protocols of
+// connections are removed with ForgetPeerProtocol when the connections are closed.
+var peerProtocols = struct {
+	sync.RWMutex
+	byConn map[*grpc.ClientConn]Protocol
+}{byConn: make(map[*grpc.ClientConn]Protocol)}
+
+// PeerProtocol returns the protocol of the server on the other side of the connection. The protocol is only known
+// after the first unary response of the server; until then ok is false.
+func PeerProtocol(conn *grpc.ClientConn) (protocol Protocol, ok bool) {
+	peerProtocols.RLock()
+	defer peerProtocols.RUnlock()
+	protocol, ok = peerProtocols.byConn[conn]
+	return
+}
+
+// RequirePeerCapabilities returns an error if the server on the other side of the connection is known to not have
+// all capabilities. The peer is described in the error.
+func RequirePeerCapabilities(conn *grpc.ClientConn, peer string, capabilities ...string) error {
+	protocol, ok := PeerProtocol(conn)
+	if !ok {
+		return nil
+	}
+	return protocol.RequireCapabilities(peer, capabilities...)
+}
+
+// ForgetPeerProtocol removes the protocol of the server on the other side of the connection. It is called when the
+// connection is closed.
+func ForgetPeerProtocol(conn *grpc.ClientConn) {
+	peerProtocols.Lock()
+	defer peerProtocols.Unlock()
+	delete(peerProtocols.byConn, conn)
+}
+
+func setPeerProtocol(conn *grpc.ClientConn, header metadata.MD) {
+	protocol, err := ProtocolFromMetadata(header)
+	if err != nil {
+		return
+	}
+	peerProtocols.Lock()
+	defer peerProtocols.Unlock()
+	peerProtocols.byConn[conn] = protocol
+}
+
+// UnaryClientProtocolInterceptor records the protocol that servers advertise in the headers of unary responses. The
+// protocol of servers is only recorded from unary calls, as the streams of the components are restarted transparently.
+func UnaryClientProtocolInterceptor(ctx context.Context, method string, req, reply interface{}, conn *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	var header metadata.MD
+	err := invoker(ctx, method, req, reply, conn, append(opts, grpc.Header(&header))...)
+	if err == nil || len(header) > 0 {
+		setPeerProtocol(conn, header)
+	}
+	return err
+}
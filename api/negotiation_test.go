@@ -0,0 +1,83 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestProtocol(t *testing.T) {
+	a := New(t)
+
+	// Components that do not advertise their protocol use the legacy protocol
+	protocol, err := ProtocolFromContext(context.Background())
+	a.So(err, ShouldBeNil)
+	a.So(protocol, ShouldResemble, LegacyProtocol)
+	a.So(protocol.Check(), ShouldBeNil)
+
+	ctx := ContextWithProtocol(context.Background(), CurrentProtocol())
+	protocol, err = ProtocolFromContext(ctx)
+	a.So(err, ShouldBeNil)
+	a.So(protocol.Version, ShouldEqual, ProtocolVersion)
+	a.So(protocol.Capabilities, ShouldResemble, Capabilities)
+	a.So(protocol.Supports(CapabilityRelay), ShouldBeTrue)
+
+	_, err = ProtocolFromMetadata(metadata.Pairs("protocol-version", "two"))
+	a.So(err, ShouldNotBeNil)
+
+	a.So(Protocol{Version: 0}.Check(), ShouldNotBeNil)
+
+	old := Protocol{Version: 1, Capabilities: []string{CapabilityRelay, "unknown"}}
+	negotiated := CurrentProtocol().Negotiate(old)
+	a.So(negotiated.Version, ShouldEqual, 1)
+	a.So(negotiated.Capabilities, ShouldResemble, []string{CapabilityRelay})
+	a.So(negotiated.String(), ShouldEqual, "v1 [relay]")
+
+	a.So(old.RequireCapabilities("Broker", CapabilityRelay), ShouldBeNil)
+	err = old.RequireCapabilities("Broker", CapabilityRelay, CapabilityRxWindow)
+	a.So(err, ShouldNotBeNil)
+	a.So(err.Error(), ShouldContainSubstring, "Broker does not support rx-window")
+}
+
+func TestPeerProtocol(t *testing.T) {
+	a := New(t)
+
+	conn := new(grpc.ClientConn)
+
+	// The protocol of the peer is unknown before the first response
+	_, ok := PeerProtocol(conn)
+	a.So(ok, ShouldBeFalse)
+	a.So(RequirePeerCapabilities(conn, "Broker", CapabilityRelay), ShouldBeNil)
+
+	// Failed calls do not tell anything about the peer
+	err := UnaryClientProtocolInterceptor(context.Background(), "/test", nil, nil, conn, func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		return errors.New("unavailable")
+	})
+	a.So(err, ShouldNotBeNil)
+	_, ok = PeerProtocol(conn)
+	a.So(ok, ShouldBeFalse)
+
+	// Peers that do not send headers use the legacy protocol
+	err = UnaryClientProtocolInterceptor(context.Background(), "/test", nil, nil, conn, func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		return nil
+	})
+	a.So(err, ShouldBeNil)
+	protocol, ok := PeerProtocol(conn)
+	a.So(ok, ShouldBeTrue)
+	a.So(protocol, ShouldResemble, LegacyProtocol)
+	a.So(RequirePeerCapabilities(conn, "Broker", CapabilityRelay), ShouldNotBeNil)
+	a.So(RequirePeerCapabilities(conn, "Broker"), ShouldBeNil)
+
+	// The protocol is forgotten when the connection is closed
+	ForgetPeerProtocol(conn)
+	_, ok = PeerProtocol(conn)
+	a.So(ok, ShouldBeFalse)
+}
@@ -36,6 +36,7 @@ func TLSConfig(serverName string) *tls.Config {
 // Pool with connections
 type Pool struct {
 	dialOptions []grpc.DialOption
+	closeHooks  []func(*grpc.ClientConn)
 	bgCtx       context.Context
 
 	mu    sync.Mutex
@@ -94,6 +95,13 @@ func (p *Pool) AddDialOption(opts ...grpc.DialOption) {
 	p.dialOptions = append(p.dialOptions, opts...)
 }
 
+// AddCloseHook adds a function that is called with the connections of the pool that are closed
+func (p *Pool) AddCloseHook(hook func(*grpc.ClientConn)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closeHooks = append(p.closeHooks, hook)
+}
+
 // Close connections. If no target names supplied. just closes all.
 func (p *Pool) Close(target ...string) {
 	p.mu.Lock()
@@ -109,6 +117,9 @@ func (p *Pool) Close(target ...string) {
 			c.cancel()
 			if c.conn != nil {
 				c.conn.Close()
+				for _, hook := range p.closeHooks {
+					hook(c.conn)
+				}
 			}
 			delete(p.conns, target)
 		}
@@ -81,8 +81,12 @@ func TestPool(t *testing.T) {
 		a.So(ok, ShouldBeTrue)
 	}
 
+	var closed []*grpc.ClientConn
+	pool.AddCloseHook(func(conn *grpc.ClientConn) { closed = append(closed, conn) })
+
 	pool.Close(addr)
 	pool.Close(addr)
+	a.So(closed, ShouldResemble, []*grpc.ClientConn{conn1})
 
 	conn3, err := pool.DialInsecure(addr)
 	a.So(err, ShouldBeNil)
@@ -0,0 +1,27 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package lorawan
+
+import "github.com/TheThingsNetwork/ttn/api"
+
+// RequiredCapabilities returns the capabilities of the protocol that a peer needs to store the device without
+// dropping any of its settings
+func (m *Device) RequiredCapabilities() (capabilities []string) {
+	if m.ResetsFCnt {
+		capabilities = append(capabilities, api.CapabilityResetsFCnt)
+	}
+	if m.RxWindow != RxWindow_RX_AUTO {
+		capabilities = append(capabilities, api.CapabilityRxWindow)
+	}
+	if m.Relay {
+		capabilities = append(capabilities, api.CapabilityRelay)
+	}
+	if m.RegionalParameters != "" {
+		capabilities = append(capabilities, api.CapabilityRegionalParameters)
+	}
+	if m.DevAddrAllocation != "" && m.DevAddrAllocation != DevAddrAllocationRandom {
+		capabilities = append(capabilities, api.CapabilityDevAddrAllocation)
+	}
+	return
+}
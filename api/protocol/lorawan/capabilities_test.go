@@ -0,0 +1,22 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package lorawan
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/api"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestRequiredCapabilities(t *testing.T) {
+	a := New(t)
+
+	dev := &Device{AppId: "test", DevId: "test", DevAddrAllocation: DevAddrAllocationRandom}
+	a.So(dev.RequiredCapabilities(), ShouldBeEmpty)
+
+	dev.RxWindow = RxWindow_RX2
+	dev.DevAddrAllocation = DevAddrAllocationSticky
+	a.So(dev.RequiredCapabilities(), ShouldResemble, []string{api.CapabilityRxWindow, api.CapabilityDevAddrAllocation})
+}
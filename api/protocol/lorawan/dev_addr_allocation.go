@@ -0,0 +1,23 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package lorawan
+
+// Strategies for the allocation of a DevAddr when a device joins
+const (
+	// DevAddrAllocationRandom allocates a random address within a prefix
+	DevAddrAllocationRandom = "random"
+	// DevAddrAllocationSequential allocates the next address within a prefix
+	DevAddrAllocationSequential = "sequential"
+	// DevAddrAllocationSticky re-uses the previous address of the device if it is still within a suitable prefix
+	DevAddrAllocationSticky = "sticky"
+)
+
+// ValidDevAddrAllocation returns whether the strategy is known. An empty strategy selects random allocation.
+func ValidDevAddrAllocation(strategy string) bool {
+	switch strategy {
+	case "", DevAddrAllocationRandom, DevAddrAllocationSequential, DevAddrAllocationSticky:
+		return true
+	}
+	return false
+}
@@ -11,6 +11,9 @@
 	It has these top-level messages:
 		DeviceIdentifier
 		Device
+		ADRChange
+		BatteryReport
+		BatteryStatus
 */
 package lorawan
 
@@ -40,6 +43,31 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type RxWindow int32
+
+const (
+	// Let the network select the receive window
+	RxWindow_RX_AUTO RxWindow = 0
+	RxWindow_RX1     RxWindow = 1
+	RxWindow_RX2     RxWindow = 2
+)
+
+var RxWindow_name = map[int32]string{
+	0: "RX_AUTO",
+	1: "RX1",
+	2: "RX2",
+}
+var RxWindow_value = map[string]int32{
+	"RX_AUTO": 0,
+	"RX1":     1,
+	"RX2":     2,
+}
+
+func (x RxWindow) String() string {
+	return proto.EnumName(RxWindow_name, int32(x))
+}
+func (RxWindow) EnumDescriptor() ([]byte, []int) { return fileDescriptorDevice, []int{0} }
+
 type DeviceIdentifier struct {
 	// The AppEUI is a unique, 8 byte identifier for the application a device belongs to.
 	AppEui *github_com_TheThingsNetwork_ttn_core_types.AppEUI `protobuf:"bytes,1,opt,name=app_eui,json=appEui,proto3,customtype=github.com/TheThingsNetwork/ttn/core/types.AppEUI" json:"app_eui,omitempty"`
@@ -82,8 +110,20 @@ type Device struct {
 	// The ActivationContstraints are used to allocate a device address for a device (comma-separated).
 	// There are different prefixes for `otaa`, `abp`, `world`, `local`, `private`, `testing`.
 	ActivationConstraints string `protobuf:"bytes,13,opt,name=activation_constraints,json=activationConstraints,proto3" json:"activation_constraints,omitempty"`
+	// The ResetsFCnt option indicates that the device resets its frame counters to zero when it restarts (ABP only). An uplink with FCnt 0 then resets the session counters, which makes the device vulnerable to replay attacks.
+	ResetsFCnt bool `protobuf:"varint,14,opt,name=resets_f_cnt,json=resetsFCnt,proto3" json:"resets_f_cnt,omitempty"`
+	// The RxWindow option selects the receive window that is used for downlink messages. If set to RX1 or RX2, downlink is only sent in that window.
+	RxWindow RxWindow `protobuf:"varint,15,opt,name=rx_window,json=rxWindow,proto3,enum=lorawan.RxWindow" json:"rx_window,omitempty"`
+	// The Relay option indicates that the device is a relay that forwards messages of other devices (LoRaWAN Relay). Messages on FPort 226 are used for forwarded messages.
+	Relay bool `protobuf:"varint,16,opt,name=relay,proto3" json:"relay,omitempty"`
+	// The RegionalParameters option selects the revision of the LoRaWAN Regional Parameters that the device implements (1.0, 1.0.1, 1.0.2, 1.0.2-b or 1.0.3-a). The network server uses the transmit powers and maximum EIRP of that revision for ADR. If empty, 1.0.1 is used.
+	RegionalParameters string `protobuf:"bytes,17,opt,name=regional_parameters,json=regionalParameters,proto3" json:"regional_parameters,omitempty"`
+	// The DevAddrAllocation option selects how the network server allocates a device address when the device joins: random (default), sequential or sticky (re-use the previous address on rejoin). It is set by the Handler from the settings of the application.
+	DevAddrAllocation string `protobuf:"bytes,18,opt,name=dev_addr_allocation,json=devAddrAllocation,proto3" json:"dev_addr_allocation,omitempty"`
 	// When the device was last seen (Unix nanoseconds)
 	LastSeen int64 `protobuf:"varint,21,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	// The battery status of the device, based on the DevStatusAns of the device
+	Battery *BatteryStatus `protobuf:"bytes,22,opt,name=battery" json:"battery,omitempty"`
 }
 
 func (m *Device) Reset()                    { *m = Device{} }
@@ -140,6 +180,41 @@ func (m *Device) GetActivationConstraints() string {
 	return ""
 }
 
+func (m *Device) GetResetsFCnt() bool {
+	if m != nil {
+		return m.ResetsFCnt
+	}
+	return false
+}
+
+func (m *Device) GetRxWindow() RxWindow {
+	if m != nil {
+		return m.RxWindow
+	}
+	return RxWindow_RX_AUTO
+}
+
+func (m *Device) GetRelay() bool {
+	if m != nil {
+		return m.Relay
+	}
+	return false
+}
+
+func (m *Device) GetRegionalParameters() string {
+	if m != nil {
+		return m.RegionalParameters
+	}
+	return ""
+}
+
+func (m *Device) GetDevAddrAllocation() string {
+	if m != nil {
+		return m.DevAddrAllocation
+	}
+	return ""
+}
+
 func (m *Device) GetLastSeen() int64 {
 	if m != nil {
 		return m.LastSeen
@@ -147,9 +222,185 @@ func (m *Device) GetLastSeen() int64 {
 	return 0
 }
 
+func (m *Device) GetBattery() *BatteryStatus {
+	if m != nil {
+		return m.Battery
+	}
+	return nil
+}
+
+// ADRChange is a change of the data rate, transmit power or number of transmissions of a device by Adaptive Data Rate
+type ADRChange struct {
+	OldDataRate string `protobuf:"bytes,1,opt,name=old_data_rate,json=oldDataRate,proto3" json:"old_data_rate,omitempty"`
+	NewDataRate string `protobuf:"bytes,2,opt,name=new_data_rate,json=newDataRate,proto3" json:"new_data_rate,omitempty"`
+	OldTxPower  int32  `protobuf:"varint,3,opt,name=old_tx_power,json=oldTxPower,proto3" json:"old_tx_power,omitempty"`
+	NewTxPower  int32  `protobuf:"varint,4,opt,name=new_tx_power,json=newTxPower,proto3" json:"new_tx_power,omitempty"`
+	OldNbTrans  uint32 `protobuf:"varint,5,opt,name=old_nb_trans,json=oldNbTrans,proto3" json:"old_nb_trans,omitempty"`
+	NewNbTrans  uint32 `protobuf:"varint,6,opt,name=new_nb_trans,json=newNbTrans,proto3" json:"new_nb_trans,omitempty"`
+	// The reason for the change
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The SNR margin in dB that the change was based on
+	SnrMargin float32 `protobuf:"fixed32,8,opt,name=snr_margin,json=snrMargin,proto3" json:"snr_margin,omitempty"`
+	// Indicates whether the device accepted the change with a LinkADRAns
+	Accepted bool `protobuf:"varint,9,opt,name=accepted,proto3" json:"accepted,omitempty"`
+}
+
+func (m *ADRChange) Reset()                    { *m = ADRChange{} }
+func (m *ADRChange) String() string            { return proto.CompactTextString(m) }
+func (*ADRChange) ProtoMessage()               {}
+func (*ADRChange) Descriptor() ([]byte, []int) { return fileDescriptorDevice, []int{2} }
+
+func (m *ADRChange) GetOldDataRate() string {
+	if m != nil {
+		return m.OldDataRate
+	}
+	return ""
+}
+
+func (m *ADRChange) GetNewDataRate() string {
+	if m != nil {
+		return m.NewDataRate
+	}
+	return ""
+}
+
+func (m *ADRChange) GetOldTxPower() int32 {
+	if m != nil {
+		return m.OldTxPower
+	}
+	return 0
+}
+
+func (m *ADRChange) GetNewTxPower() int32 {
+	if m != nil {
+		return m.NewTxPower
+	}
+	return 0
+}
+
+func (m *ADRChange) GetOldNbTrans() uint32 {
+	if m != nil {
+		return m.OldNbTrans
+	}
+	return 0
+}
+
+func (m *ADRChange) GetNewNbTrans() uint32 {
+	if m != nil {
+		return m.NewNbTrans
+	}
+	return 0
+}
+
+func (m *ADRChange) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ADRChange) GetSnrMargin() float32 {
+	if m != nil {
+		return m.SnrMargin
+	}
+	return 0
+}
+
+func (m *ADRChange) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+// BatteryReport is a battery level that was reported by a device in a DevStatusAns
+type BatteryReport struct {
+	// When the report was received (Unix nanoseconds)
+	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// The battery level: 0 for external power, 1 (empty) to 254 (full), 255 if the device could not measure it
+	Battery uint32 `protobuf:"varint,2,opt,name=battery,proto3" json:"battery,omitempty"`
+	// The demodulation SNR margin in dB of the DevStatusReq
+	Margin int32 `protobuf:"varint,3,opt,name=margin,proto3" json:"margin,omitempty"`
+}
+
+func (m *BatteryReport) Reset()                    { *m = BatteryReport{} }
+func (m *BatteryReport) String() string            { return proto.CompactTextString(m) }
+func (*BatteryReport) ProtoMessage()               {}
+func (*BatteryReport) Descriptor() ([]byte, []int) { return fileDescriptorDevice, []int{3} }
+
+func (m *BatteryReport) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *BatteryReport) GetBattery() uint32 {
+	if m != nil {
+		return m.Battery
+	}
+	return 0
+}
+
+func (m *BatteryReport) GetMargin() int32 {
+	if m != nil {
+		return m.Margin
+	}
+	return 0
+}
+
+// BatteryStatus contains the battery reports of a device and its estimated battery life
+type BatteryStatus struct {
+	// The last reported battery level (see BatteryReport)
+	Battery uint32 `protobuf:"varint,1,opt,name=battery,proto3" json:"battery,omitempty"`
+	// The estimated remaining battery life in seconds, based on the airtime, transmit power and interval of the uplinks (0 if unknown)
+	EstimatedLifetime uint64 `protobuf:"varint,2,opt,name=estimated_lifetime,json=estimatedLifetime,proto3" json:"estimated_lifetime,omitempty"`
+	// The trend of the battery level in levels per day
+	Trend float32 `protobuf:"fixed32,3,opt,name=trend,proto3" json:"trend,omitempty"`
+	// The history of battery reports (newest first)
+	History []*BatteryReport `protobuf:"bytes,4,rep,name=history" json:"history,omitempty"`
+}
+
+func (m *BatteryStatus) Reset()                    { *m = BatteryStatus{} }
+func (m *BatteryStatus) String() string            { return proto.CompactTextString(m) }
+func (*BatteryStatus) ProtoMessage()               {}
+func (*BatteryStatus) Descriptor() ([]byte, []int) { return fileDescriptorDevice, []int{4} }
+
+func (m *BatteryStatus) GetBattery() uint32 {
+	if m != nil {
+		return m.Battery
+	}
+	return 0
+}
+
+func (m *BatteryStatus) GetEstimatedLifetime() uint64 {
+	if m != nil {
+		return m.EstimatedLifetime
+	}
+	return 0
+}
+
+func (m *BatteryStatus) GetTrend() float32 {
+	if m != nil {
+		return m.Trend
+	}
+	return 0
+}
+
+func (m *BatteryStatus) GetHistory() []*BatteryReport {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DeviceIdentifier)(nil), "lorawan.DeviceIdentifier")
 	proto.RegisterType((*Device)(nil), "lorawan.Device")
+	proto.RegisterType((*ADRChange)(nil), "lorawan.ADRChange")
+	proto.RegisterType((*BatteryReport)(nil), "lorawan.BatteryReport")
+	proto.RegisterType((*BatteryStatus)(nil), "lorawan.BatteryStatus")
+	proto.RegisterEnum("lorawan.RxWindow", RxWindow_name, RxWindow_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -451,6 +702,49 @@ func (m *Device) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintDevice(dAtA, i, uint64(len(m.ActivationConstraints)))
 		i += copy(dAtA[i:], m.ActivationConstraints)
 	}
+	if m.ResetsFCnt {
+		dAtA[i] = 0x70
+		i++
+		if m.ResetsFCnt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.RxWindow != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.RxWindow))
+	}
+	if m.Relay {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.Relay {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.RegionalParameters) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.RegionalParameters)))
+		i += copy(dAtA[i:], m.RegionalParameters)
+	}
+	if len(m.DevAddrAllocation) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.DevAddrAllocation)))
+		i += copy(dAtA[i:], m.DevAddrAllocation)
+	}
 	if m.LastSeen != 0 {
 		dAtA[i] = 0xa8
 		i++
@@ -458,6 +752,167 @@ func (m *Device) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintDevice(dAtA, i, uint64(m.LastSeen))
 	}
+	if m.Battery != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.Battery.Size()))
+		n9, err := m.Battery.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+
+func (m *ADRChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ADRChange) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.OldDataRate) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.OldDataRate)))
+		i += copy(dAtA[i:], m.OldDataRate)
+	}
+	if len(m.NewDataRate) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.NewDataRate)))
+		i += copy(dAtA[i:], m.NewDataRate)
+	}
+	if m.OldTxPower != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.OldTxPower))
+	}
+	if m.NewTxPower != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.NewTxPower))
+	}
+	if m.OldNbTrans != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.OldNbTrans))
+	}
+	if m.NewNbTrans != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.NewNbTrans))
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if m.SnrMargin != 0 {
+		dAtA[i] = 0x45
+		i++
+		i = encodeFixed32Device(dAtA, i, uint32(math.Float32bits(float32(m.SnrMargin))))
+	}
+	if m.Accepted {
+		dAtA[i] = 0x48
+		i++
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *BatteryReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatteryReport) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Time != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.Time))
+	}
+	if m.Battery != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.Battery))
+	}
+	if m.Margin != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.Margin))
+	}
+	return i, nil
+}
+
+func (m *BatteryStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatteryStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Battery != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.Battery))
+	}
+	if m.EstimatedLifetime != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintDevice(dAtA, i, uint64(m.EstimatedLifetime))
+	}
+	if m.Trend != 0 {
+		dAtA[i] = 0x1d
+		i++
+		i = encodeFixed32Device(dAtA, i, uint32(math.Float32bits(float32(m.Trend))))
+	}
+	if len(m.History) > 0 {
+		for _, msg := range m.History {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintDevice(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -553,13 +1008,106 @@ func (m *Device) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDevice(uint64(l))
 	}
-	if m.LastSeen != 0 {
-		n += 2 + sovDevice(uint64(m.LastSeen))
+	if m.ResetsFCnt {
+		n += 2
 	}
-	return n
-}
-
-func sovDevice(x uint64) (n int) {
+	if m.RxWindow != 0 {
+		n += 1 + sovDevice(uint64(m.RxWindow))
+	}
+	if m.Relay {
+		n += 3
+	}
+	l = len(m.RegionalParameters)
+	if l > 0 {
+		n += 2 + l + sovDevice(uint64(l))
+	}
+	l = len(m.DevAddrAllocation)
+	if l > 0 {
+		n += 2 + l + sovDevice(uint64(l))
+	}
+	if m.LastSeen != 0 {
+		n += 2 + sovDevice(uint64(m.LastSeen))
+	}
+	if m.Battery != nil {
+		l = m.Battery.Size()
+		n += 2 + l + sovDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *ADRChange) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.OldDataRate)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	l = len(m.NewDataRate)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.OldTxPower != 0 {
+		n += 1 + sovDevice(uint64(m.OldTxPower))
+	}
+	if m.NewTxPower != 0 {
+		n += 1 + sovDevice(uint64(m.NewTxPower))
+	}
+	if m.OldNbTrans != 0 {
+		n += 1 + sovDevice(uint64(m.OldNbTrans))
+	}
+	if m.NewNbTrans != 0 {
+		n += 1 + sovDevice(uint64(m.NewNbTrans))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovDevice(uint64(l))
+	}
+	if m.SnrMargin != 0 {
+		n += 5
+	}
+	if m.Accepted {
+		n += 2
+	}
+	return n
+}
+
+func (m *BatteryReport) Size() (n int) {
+	var l int
+	_ = l
+	if m.Time != 0 {
+		n += 1 + sovDevice(uint64(m.Time))
+	}
+	if m.Battery != 0 {
+		n += 1 + sovDevice(uint64(m.Battery))
+	}
+	if m.Margin != 0 {
+		n += 1 + sovDevice(uint64(m.Margin))
+	}
+	return n
+}
+
+func (m *BatteryStatus) Size() (n int) {
+	var l int
+	_ = l
+	if m.Battery != 0 {
+		n += 1 + sovDevice(uint64(m.Battery))
+	}
+	if m.EstimatedLifetime != 0 {
+		n += 1 + sovDevice(uint64(m.EstimatedLifetime))
+	}
+	if m.Trend != 0 {
+		n += 5
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovDevice(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDevice(x uint64) (n int) {
 	for {
 		n++
 		x >>= 7
@@ -1072,6 +1620,123 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 			}
 			m.ActivationConstraints = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetsFCnt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResetsFCnt = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxWindow", wireType)
+			}
+			m.RxWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RxWindow |= (RxWindow(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relay", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Relay = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionalParameters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegionalParameters = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddrAllocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevAddrAllocation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 21:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LastSeen", wireType)
@@ -1091,6 +1756,39 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Battery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Battery == nil {
+				m.Battery = &BatteryStatus{}
+			}
+			if err := m.Battery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDevice(dAtA[iNdEx:])
@@ -1112,6 +1810,496 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ADRChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ADRChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ADRChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldDataRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldDataRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDataRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewDataRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldTxPower", wireType)
+			}
+			m.OldTxPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldTxPower |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTxPower", wireType)
+			}
+			m.NewTxPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewTxPower |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldNbTrans", wireType)
+			}
+			m.OldNbTrans = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldNbTrans |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewNbTrans", wireType)
+			}
+			m.NewNbTrans = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewNbTrans |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnrMargin", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.SnrMargin = float32(math.Float32frombits(v))
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *BatteryReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatteryReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatteryReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Battery", wireType)
+			}
+			m.Battery = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Battery |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			m.Margin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Margin |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *BatteryStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatteryStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatteryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Battery", wireType)
+			}
+			m.Battery = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Battery |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedLifetime", wireType)
+			}
+			m.EstimatedLifetime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedLifetime |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trend", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.Trend = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, &BatteryReport{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipDevice(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1222,42 +2410,66 @@ func init() {
 }
 
 var fileDescriptorDevice = []byte{
-	// 579 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x4f, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc7, 0x95, 0xdf, 0x7e, 0x6b, 0x13, 0xb3, 0x8a, 0xc9, 0x68, 0x93, 0xe9, 0xd0, 0x56, 0xed,
-	0x42, 0x2f, 0x4b, 0xc4, 0xfe, 0xc0, 0xb9, 0xff, 0x40, 0x15, 0x62, 0x12, 0xd9, 0x76, 0xe1, 0x12,
-	0xb9, 0xf1, 0xd3, 0xd4, 0x6a, 0x66, 0x5b, 0x89, 0x93, 0xa8, 0x2f, 0x80, 0x37, 0xc4, 0x3b, 0xe0,
-	0xc6, 0x91, 0xf3, 0x0e, 0x13, 0xda, 0x2b, 0x41, 0x8e, 0x3b, 0x86, 0x2a, 0xa1, 0x89, 0x9e, 0xb8,
-	0x3d, 0xf9, 0x7e, 0xbf, 0xfe, 0x3c, 0x76, 0x5d, 0x3f, 0xa8, 0x97, 0x70, 0x3d, 0x2b, 0x26, 0x7e,
-	0x2c, 0xaf, 0x83, 0xcb, 0x19, 0x5c, 0xce, 0xb8, 0x48, 0xf2, 0x73, 0xd0, 0x95, 0xcc, 0xe6, 0x81,
-	0xd6, 0x22, 0xa0, 0x8a, 0x07, 0x2a, 0x93, 0x5a, 0xc6, 0x32, 0x0d, 0x52, 0x99, 0xd1, 0x8a, 0x8a,
-	0x80, 0x41, 0xc9, 0x63, 0xf0, 0x6b, 0x1d, 0x37, 0x97, 0x6a, 0x7b, 0x2f, 0x91, 0x32, 0x49, 0xc1,
-	0xc6, 0x27, 0xc5, 0x34, 0x80, 0x6b, 0xa5, 0x17, 0x36, 0xd5, 0x3e, 0xfa, 0xad, 0x51, 0x22, 0x13,
-	0xf9, 0x90, 0x32, 0x5f, 0xf5, 0x47, 0x5d, 0xd9, 0xf8, 0xe1, 0x17, 0x07, 0x6d, 0x0f, 0xeb, 0x2e,
-	0x63, 0x06, 0x42, 0xf3, 0x29, 0x87, 0x0c, 0x9f, 0xa3, 0x26, 0x55, 0x2a, 0x82, 0x82, 0x13, 0xa7,
-	0xe3, 0x74, 0xb7, 0xfa, 0x67, 0x37, 0xb7, 0x07, 0xaf, 0x1e, 0x3b, 0x41, 0x2c, 0x33, 0x08, 0xf4,
-	0x42, 0x41, 0xee, 0xf7, 0x94, 0x1a, 0x5d, 0x8d, 0xc3, 0x06, 0x55, 0x6a, 0x54, 0x70, 0xc3, 0x63,
-	0x50, 0xd6, 0xbc, 0xff, 0xd6, 0xe2, 0x0d, 0xa1, 0xac, 0x79, 0x0c, 0xca, 0x51, 0xc1, 0x0f, 0x3f,
-	0x37, 0x50, 0xc3, 0x6e, 0xfa, 0x5f, 0xdf, 0x2a, 0xde, 0x41, 0x86, 0x1c, 0x71, 0x46, 0x36, 0x3a,
-	0x4e, 0xd7, 0x0b, 0x37, 0xa9, 0x52, 0x63, 0x66, 0x64, 0xd3, 0x86, 0x33, 0xf2, 0xbf, 0x95, 0x19,
-	0x94, 0x63, 0x86, 0x3f, 0x22, 0xd7, 0xc8, 0x94, 0xb1, 0x8c, 0x6c, 0xd6, 0xed, 0x5f, 0xdf, 0xdc,
-	0x1e, 0x1c, 0xff, 0x5d, 0xfb, 0x1e, 0x63, 0x59, 0x68, 0x4e, 0x61, 0x0a, 0x1c, 0x22, 0x4f, 0x54,
-	0xf3, 0x28, 0x8f, 0xe6, 0xb0, 0x20, 0x8d, 0xb5, 0x98, 0xe7, 0xd5, 0xfc, 0xe2, 0x3d, 0x2c, 0xc2,
-	0xa6, 0xb0, 0x85, 0x61, 0x9a, 0x43, 0x59, 0x66, 0x73, 0x2d, 0x66, 0x4f, 0x29, 0xcb, 0xa4, 0xb6,
-	0xb8, 0xbf, 0x48, 0x43, 0x74, 0xd7, 0xbd, 0x48, 0x03, 0x34, 0x3f, 0xb7, 0xe1, 0x11, 0xe4, 0x4e,
-	0xa3, 0x58, 0xe8, 0xa8, 0x50, 0xc4, 0xeb, 0x38, 0xdd, 0x56, 0xd8, 0x98, 0x0e, 0x84, 0xbe, 0x52,
-	0xf8, 0x05, 0x42, 0xd6, 0x61, 0xb2, 0x12, 0x04, 0xd5, 0x9e, 0x6b, 0xbc, 0xa1, 0xac, 0x04, 0x3e,
-	0x42, 0xcf, 0x18, 0xcf, 0xe9, 0x24, 0x85, 0xc8, 0xa6, 0xe2, 0x19, 0xc4, 0x73, 0xf2, 0xa4, 0xe3,
-	0x74, 0xdd, 0x70, 0x7b, 0x69, 0xbd, 0x1d, 0x08, 0x3d, 0x30, 0x3a, 0x7e, 0x89, 0xb6, 0x8b, 0x1c,
-	0xf2, 0x93, 0xe3, 0x68, 0xc2, 0xb5, 0x5d, 0x41, 0xb6, 0xea, 0x6c, 0xcb, 0xea, 0x7d, 0xae, 0x4d,
-	0x1a, 0x9f, 0xa1, 0x5d, 0x1a, 0x6b, 0x5e, 0x52, 0xcd, 0xa5, 0x88, 0x62, 0x29, 0x72, 0x9d, 0x51,
-	0x2e, 0x74, 0x4e, 0x5a, 0xf5, 0x3f, 0x60, 0xe7, 0xc1, 0x1d, 0x3c, 0x98, 0x78, 0x0f, 0x79, 0x29,
-	0xcd, 0x75, 0x94, 0x03, 0x08, 0xb2, 0xd3, 0x71, 0xba, 0x1b, 0xa1, 0x6b, 0x84, 0x0b, 0x00, 0x71,
-	0xfc, 0xd5, 0x41, 0x2d, 0xfb, 0x0e, 0x3e, 0x50, 0x41, 0x13, 0xc8, 0xf0, 0x1b, 0xe4, 0xbd, 0x03,
-	0xbd, 0x7c, 0x1b, 0xcf, 0xfd, 0xe5, 0xc4, 0xf0, 0x57, 0x5f, 0x78, 0xfb, 0xe9, 0x8a, 0x85, 0x4f,
-	0x91, 0x77, 0xf1, 0x6b, 0xe1, 0xaa, 0xdb, 0xde, 0xf5, 0xed, 0xc8, 0xf1, 0xef, 0x87, 0x89, 0x3f,
-	0x32, 0x23, 0x07, 0xf7, 0xd0, 0xd6, 0x10, 0x52, 0xd0, 0xf0, 0x78, 0xc7, 0x3f, 0x20, 0xfa, 0xfd,
-	0x6f, 0x77, 0xfb, 0xce, 0xf7, 0xbb, 0x7d, 0xe7, 0xc7, 0xdd, 0xbe, 0xf3, 0xe9, 0x74, 0x9d, 0x31,
-	0x39, 0x69, 0xd4, 0xca, 0xc9, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x75, 0x3e, 0x90, 0x84, 0x65,
-	0x05, 0x00, 0x00,
+	// 969 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcd, 0x56, 0xcd, 0x8e, 0x23, 0x35,
+	0x10, 0x26, 0x3f, 0x93, 0x74, 0x7b, 0x26, 0xbb, 0x19, 0x0f, 0x13, 0x35, 0x59, 0x7e, 0x46, 0xb9,
+	0xf0, 0x23, 0x4d, 0xc2, 0x66, 0x77, 0xe1, 0x9c, 0x4c, 0x06, 0x34, 0x82, 0x1d, 0x16, 0x4f, 0x46,
+	0xbb, 0xe2, 0x62, 0x39, 0xdd, 0x4e, 0x62, 0xa5, 0xe3, 0x6e, 0xb9, 0x9d, 0xcd, 0xe4, 0xc6, 0xa3,
+	0xf0, 0x04, 0x1c, 0x78, 0x03, 0x6e, 0x1c, 0x39, 0x73, 0x40, 0x88, 0x27, 0xa1, 0x6c, 0x77, 0x7a,
+	0x86, 0x11, 0x68, 0x45, 0x4e, 0x1c, 0x22, 0x55, 0x7d, 0xf5, 0xf9, 0x2b, 0x57, 0xb9, 0xdc, 0x0e,
+	0x1a, 0xcc, 0x84, 0x9e, 0xaf, 0x26, 0xdd, 0x30, 0x59, 0xf6, 0xc6, 0x73, 0x3e, 0x9e, 0x0b, 0x39,
+	0xcb, 0x2e, 0xb9, 0x5e, 0x27, 0x6a, 0xd1, 0xd3, 0x5a, 0xf6, 0x58, 0x2a, 0x7a, 0xa9, 0x4a, 0x74,
+	0x12, 0x26, 0x71, 0x2f, 0x4e, 0x14, 0x5b, 0x33, 0xd9, 0x8b, 0xf8, 0x6b, 0x11, 0xf2, 0xae, 0xc5,
+	0x71, 0x3d, 0x47, 0xdb, 0x8f, 0x66, 0x49, 0x32, 0x8b, 0xb9, 0xa3, 0x4f, 0x56, 0xd3, 0x1e, 0x5f,
+	0xa6, 0x7a, 0xe3, 0x58, 0xed, 0xd3, 0x3b, 0x89, 0x66, 0xc9, 0x2c, 0xb9, 0x65, 0x19, 0xcf, 0x3a,
+	0xd6, 0x72, 0xf4, 0xce, 0x4f, 0x25, 0xd4, 0x1c, 0xd9, 0x2c, 0x17, 0x11, 0x97, 0x5a, 0x4c, 0x05,
+	0x57, 0xf8, 0x12, 0xd5, 0x59, 0x9a, 0x52, 0xbe, 0x12, 0x41, 0xe9, 0xa4, 0xf4, 0xd1, 0xc1, 0xf0,
+	0xd9, 0x6f, 0xbf, 0x7f, 0xf0, 0xf8, 0x4d, 0x15, 0x84, 0x89, 0xe2, 0x3d, 0xbd, 0x49, 0x79, 0xd6,
+	0x1d, 0xa4, 0xe9, 0xf9, 0xf5, 0x05, 0xa9, 0x81, 0xca, 0xf9, 0x4a, 0x18, 0x3d, 0xa8, 0xc4, 0xea,
+	0x95, 0x77, 0xd2, 0x83, 0x1d, 0x5a, 0x3d, 0x50, 0x01, 0xbd, 0xce, 0xf7, 0x1e, 0xaa, 0xb9, 0x4d,
+	0xff, 0xdf, 0xb7, 0x8a, 0x8f, 0x91, 0x51, 0xa6, 0x22, 0x0a, 0x2a, 0x20, 0xe7, 0x93, 0x3d, 0xf0,
+	0x2e, 0x22, 0x03, 0x9b, 0x34, 0x00, 0x57, 0x1d, 0x0c, 0x1e, 0xc0, 0xdf, 0x22, 0xcf, 0xc0, 0x2c,
+	0x8a, 0x54, 0xb0, 0x67, 0xd3, 0x7f, 0x06, 0xe9, 0xfb, 0xff, 0x2d, 0xfd, 0x00, 0x56, 0x13, 0x53,
+	0x85, 0x31, 0x30, 0x41, 0xbe, 0x5c, 0x2f, 0x68, 0x46, 0x17, 0x7c, 0x13, 0xd4, 0x76, 0xd2, 0xbc,
+	0x5c, 0x2f, 0xae, 0xbe, 0xe2, 0x1b, 0x52, 0x97, 0xce, 0x30, 0x9a, 0xa6, 0x28, 0xa7, 0x59, 0xdf,
+	0x49, 0x13, 0xda, 0xee, 0x34, 0x99, 0x33, 0xb6, 0x07, 0x69, 0x14, 0xbd, 0x5d, 0x0f, 0xd2, 0x08,
+	0x9a, 0x76, 0x1b, 0xbd, 0x00, 0x79, 0x53, 0x1a, 0x4a, 0x4d, 0x57, 0x69, 0xe0, 0x83, 0x60, 0x83,
+	0xd4, 0xa6, 0x67, 0x52, 0x5f, 0xa7, 0xf8, 0x5d, 0x84, 0x5c, 0x24, 0x4a, 0xd6, 0x32, 0x40, 0x36,
+	0xe6, 0x99, 0xd8, 0x08, 0x7c, 0x7c, 0x8a, 0x8e, 0x22, 0x91, 0xb1, 0x49, 0xcc, 0xa9, 0x63, 0x85,
+	0x73, 0x1e, 0x2e, 0x82, 0x7d, 0xa0, 0x79, 0xa4, 0x99, 0x87, 0xbe, 0x00, 0xf6, 0x99, 0xc1, 0xf1,
+	0x87, 0xa8, 0xb9, 0xca, 0x78, 0xf6, 0xa4, 0x4f, 0x27, 0x42, 0xbb, 0x15, 0xc1, 0x81, 0xe5, 0x36,
+	0x1c, 0x3e, 0x14, 0xda, 0xb0, 0xf1, 0x33, 0xd4, 0x62, 0xa1, 0x16, 0xaf, 0x99, 0x16, 0x89, 0xa4,
+	0x61, 0x22, 0x33, 0xad, 0x98, 0x90, 0x3a, 0x0b, 0x1a, 0x76, 0x02, 0x8e, 0x6f, 0xa3, 0x67, 0xb7,
+	0x41, 0x7c, 0x82, 0x0e, 0x14, 0xcf, 0xb8, 0xce, 0x72, 0xed, 0x07, 0x56, 0x1b, 0x39, 0xcc, 0x0a,
+	0x77, 0x91, 0xaf, 0x6e, 0xe8, 0x5a, 0x48, 0x28, 0x27, 0x78, 0x08, 0xe1, 0x07, 0xfd, 0xc3, 0x6e,
+	0xfe, 0xa9, 0xe8, 0x92, 0x9b, 0x97, 0x36, 0x40, 0x3c, 0x95, 0x5b, 0xf8, 0x11, 0xf2, 0x63, 0x96,
+	0x69, 0x9a, 0x71, 0x2e, 0x83, 0x63, 0xe0, 0x57, 0x88, 0x67, 0x80, 0x2b, 0xf0, 0xf1, 0xdb, 0x68,
+	0x4f, 0xf1, 0x98, 0x6d, 0x82, 0xa6, 0xcd, 0xe3, 0x1c, 0xfc, 0x29, 0xaa, 0x4f, 0x98, 0xd6, 0x5c,
+	0x6d, 0x82, 0x16, 0xe0, 0xfb, 0xfd, 0x56, 0x91, 0x60, 0xe8, 0xf0, 0x2b, 0xcd, 0xf4, 0x2a, 0x23,
+	0x5b, 0x1a, 0xee, 0xa1, 0x23, 0xc5, 0x67, 0x50, 0x0b, 0x8b, 0x69, 0xca, 0x14, 0x5b, 0x72, 0x80,
+	0xb3, 0xe0, 0xd0, 0x96, 0x8a, 0xb7, 0xa1, 0x17, 0x45, 0x04, 0xaa, 0x38, 0xda, 0x4e, 0x3e, 0x65,
+	0x71, 0x9c, 0x84, 0xb6, 0x13, 0x01, 0xb6, 0x0b, 0x0e, 0xf3, 0x61, 0x1e, 0x14, 0x81, 0xce, 0x8f,
+	0x65, 0xe4, 0x0f, 0x46, 0xe4, 0x6c, 0xce, 0xe4, 0x8c, 0xe3, 0x0e, 0x6a, 0x24, 0x71, 0x44, 0x23,
+	0xa6, 0x19, 0x55, 0x4c, 0x73, 0xfb, 0x2d, 0xf0, 0xc9, 0x3e, 0x80, 0x23, 0xc0, 0x08, 0x40, 0x86,
+	0x23, 0xf9, 0xfa, 0x0e, 0xa7, 0xec, 0x38, 0x00, 0x16, 0x1c, 0xe8, 0xb6, 0xd1, 0xd1, 0x37, 0x34,
+	0x4d, 0xd6, 0x5c, 0xd9, 0x3b, 0xbb, 0x47, 0x10, 0x60, 0xe3, 0x9b, 0x17, 0x06, 0x31, 0x0c, 0xa3,
+	0x52, 0x30, 0xaa, 0x8e, 0x01, 0xd8, 0x1d, 0x86, 0xd1, 0x90, 0x13, 0x0a, 0x67, 0x28, 0x33, 0x7b,
+	0x8f, 0x1b, 0x56, 0xe3, 0x72, 0x32, 0x36, 0xc8, 0x56, 0xa3, 0x60, 0xd4, 0x1c, 0x03, 0xb0, 0x2d,
+	0xa3, 0x85, 0x6a, 0x8a, 0xb3, 0x0c, 0x1a, 0x50, 0xb7, 0x9b, 0xcc, 0x3d, 0xfc, 0x1e, 0x42, 0x99,
+	0x54, 0x74, 0xc9, 0xd4, 0x4c, 0x48, 0x7b, 0x4f, 0xca, 0xc4, 0x07, 0xe4, 0xb9, 0x05, 0x70, 0x1b,
+	0x79, 0x2c, 0x0c, 0x79, 0xaa, 0x79, 0x64, 0x67, 0xde, 0x23, 0x85, 0xdf, 0xb9, 0x46, 0x8d, 0xfc,
+	0xac, 0x08, 0x4f, 0x13, 0xa5, 0x31, 0x46, 0x55, 0x2d, 0x96, 0xae, 0x55, 0x15, 0x62, 0x6d, 0xb8,
+	0x34, 0xc5, 0x41, 0x97, 0xed, 0xa6, 0x8a, 0x03, 0x85, 0x1d, 0xe5, 0x59, 0x5d, 0x4f, 0x72, 0xaf,
+	0xf3, 0x43, 0xa9, 0xd0, 0x75, 0x33, 0x70, 0x57, 0xa3, 0xf4, 0x77, 0x8d, 0x53, 0x84, 0x79, 0x06,
+	0x79, 0xa0, 0xd3, 0x11, 0x8d, 0xc5, 0x94, 0xdb, 0xfc, 0x26, 0x51, 0x95, 0x1c, 0x16, 0x91, 0xaf,
+	0xf3, 0x80, 0x99, 0x45, 0xad, 0xb8, 0x74, 0x5f, 0xce, 0x32, 0x71, 0x8e, 0x99, 0xc5, 0xb9, 0xc8,
+	0x74, 0x02, 0xf2, 0xd5, 0x93, 0xca, 0x3f, 0xcd, 0xa2, 0xab, 0x8f, 0x6c, 0x69, 0x9f, 0x7c, 0x8c,
+	0xbc, 0xed, 0x35, 0xc0, 0xfb, 0xa8, 0x4e, 0x5e, 0xd1, 0xc1, 0xf5, 0xf8, 0x9b, 0xe6, 0x5b, 0xb8,
+	0x8e, 0x2a, 0xe4, 0xd5, 0xe3, 0x66, 0xc9, 0x19, 0xfd, 0x66, 0xb9, 0xff, 0x33, 0x54, 0xe3, 0x1e,
+	0x96, 0xe7, 0x4c, 0xb2, 0x19, 0x9c, 0xe6, 0xe7, 0xc8, 0xff, 0x92, 0xeb, 0xfc, 0xb1, 0x79, 0xa7,
+	0x48, 0x75, 0xff, 0xc9, 0x6c, 0x3f, 0xbc, 0x17, 0xc2, 0x4f, 0x91, 0x7f, 0x55, 0x2c, 0xbc, 0x1f,
+	0x6d, 0xb7, 0xba, 0xee, 0x0d, 0xef, 0x6e, 0x5f, 0xe7, 0xee, 0xb9, 0x79, 0xc3, 0xf1, 0x00, 0x1d,
+	0x8c, 0x78, 0x0c, 0x57, 0xe2, 0xcd, 0x19, 0xff, 0x45, 0x62, 0x38, 0xfc, 0xe5, 0xcf, 0xf7, 0x4b,
+	0xbf, 0xc2, 0xef, 0x0f, 0xf8, 0x7d, 0xf7, 0x74, 0x97, 0xff, 0x1d, 0x93, 0x9a, 0x45, 0x9e, 0xfc,
+	0x05, 0xb8, 0x7c, 0xa1, 0x4e, 0xb6, 0x08, 0x00, 0x00,
 }
@@ -79,6 +79,7 @@ const (
 	FrequencyPlan_AS_920_923 FrequencyPlan = 61
 	FrequencyPlan_AS_923_925 FrequencyPlan = 62
 	FrequencyPlan_KR_920_923 FrequencyPlan = 7
+	FrequencyPlan_ISM_2400   FrequencyPlan = 8
 )
 
 var FrequencyPlan_name = map[int32]string{
@@ -92,6 +93,7 @@ var FrequencyPlan_name = map[int32]string{
 	61: "AS_920_923",
 	62: "AS_923_925",
 	7:  "KR_920_923",
+	8:  "ISM_2400",
 }
 var FrequencyPlan_value = map[string]int32{
 	"EU_863_870": 0,
@@ -104,6 +106,7 @@ var FrequencyPlan_value = map[string]int32{
 	"AS_920_923": 61,
 	"AS_923_925": 62,
 	"KR_920_923": 7,
+	"ISM_2400":   8,
 }
 
 func (x FrequencyPlan) String() string {
@@ -3841,88 +3844,89 @@ func init() {
 }
 
 var fileDescriptorLorawan = []byte{
-	// 1327 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcb, 0x52, 0x1b, 0xc7,
-	0x1a, 0x66, 0xd0, 0x8c, 0x24, 0x7e, 0x21, 0x18, 0xb7, 0xed, 0x73, 0x74, 0x6c, 0x17, 0x50, 0xaa,
-	0x73, 0xaa, 0x28, 0xea, 0x84, 0x8b, 0x64, 0x0c, 0x24, 0x65, 0x57, 0xe9, 0x46, 0x8c, 0x0d, 0x12,
-	0x6e, 0x50, 0x39, 0x95, 0x4d, 0x57, 0x33, 0xd3, 0x03, 0x83, 0x34, 0x17, 0xb7, 0x9a, 0x8b, 0xf2,
-	0x20, 0x59, 0xe4, 0x05, 0xb2, 0xc8, 0x36, 0x8b, 0x3c, 0x82, 0x97, 0xde, 0x64, 0xe3, 0x05, 0x95,
-	0xf2, 0x3a, 0x0f, 0x91, 0xea, 0x9e, 0xd1, 0x15, 0xc7, 0x29, 0x70, 0x16, 0x59, 0xcd, 0x7f, 0xfd,
-	0xfa, 0xef, 0xfe, 0x6f, 0x12, 0x94, 0x8f, 0x5d, 0x71, 0x72, 0x76, 0xb4, 0x6c, 0x05, 0xde, 0xca,
-	0xe1, 0x09, 0x3b, 0x3c, 0x71, 0xfd, 0xe3, 0x4e, 0x9d, 0x89, 0x8b, 0x80, 0xb7, 0x56, 0x84, 0xf0,
-	0x57, 0x68, 0xe8, 0xae, 0x84, 0x3c, 0x10, 0x81, 0x15, 0xb4, 0x57, 0xda, 0x01, 0xa7, 0x17, 0xd4,
-	0xef, 0x7d, 0x97, 0x95, 0x02, 0xa5, 0x62, 0xf6, 0xc1, 0x17, 0x43, 0x60, 0xc7, 0xc1, 0x71, 0x10,
-	0x39, 0x1e, 0x9d, 0x39, 0x8a, 0x53, 0x8c, 0xa2, 0x22, 0xbf, 0xfc, 0xef, 0x1a, 0xa4, 0xf7, 0x98,
-	0xa0, 0x36, 0x15, 0x14, 0x15, 0x01, 0xbc, 0xc0, 0x3e, 0x6b, 0x53, 0xe1, 0x06, 0x7e, 0x2e, 0xb3,
-	0xa0, 0x2d, 0xce, 0x14, 0xee, 0x2e, 0xf7, 0x0e, 0xda, 0xeb, 0xab, 0xf0, 0x90, 0x19, 0x7a, 0x08,
-	0x53, 0xd2, 0x99, 0x70, 0x2a, 0x58, 0x6e, 0x7a, 0x41, 0x5b, 0x9c, 0xc2, 0x69, 0x29, 0xc0, 0x54,
-	0x30, 0xf4, 0x1f, 0x48, 0x1f, 0xb9, 0x22, 0xd2, 0x65, 0x17, 0xb4, 0xc5, 0x2c, 0x4e, 0x1d, 0xb9,
-	0x42, 0xa9, 0xe6, 0x21, 0x63, 0x05, 0xb6, 0xeb, 0x1f, 0x47, 0xda, 0x19, 0xe5, 0x09, 0x91, 0x48,
-	0x19, 0xdc, 0x05, 0xc3, 0x21, 0x96, 0x2f, 0x72, 0xb3, 0xca, 0x51, 0x77, 0x2a, 0xbe, 0x40, 0x4f,
-	0x61, 0xc6, 0xe1, 0xec, 0xcd, 0x19, 0xf3, 0xad, 0x2e, 0x09, 0xdb, 0xd4, 0xcf, 0x99, 0x2a, 0xcc,
-	0x7f, 0xf5, 0xc3, 0xdc, 0xee, 0xa9, 0xf7, 0xdb, 0xd4, 0xc7, 0x59, 0x67, 0x98, 0xcd, 0xff, 0xac,
-	0xc1, 0xec, 0xe1, 0x65, 0x25, 0xf0, 0x1d, 0xf7, 0xf8, 0x8c, 0x47, 0x17, 0xf8, 0xe7, 0xdf, 0x3a,
-	0xff, 0x83, 0x0e, 0xa8, 0x64, 0x09, 0xf7, 0x5c, 0x1d, 0xde, 0xcf, 0x57, 0x1d, 0x52, 0x34, 0x0c,
-	0x09, 0x3b, 0x73, 0x73, 0xda, 0x82, 0xb6, 0x38, 0x5d, 0x5e, 0x7f, 0x7f, 0x35, 0xbf, 0xf6, 0x57,
-	0xd5, 0x64, 0x05, 0x9c, 0xad, 0x88, 0x6e, 0xc8, 0x3a, 0xcb, 0xa5, 0x30, 0xac, 0x35, 0x77, 0x70,
-	0x92, 0x86, 0x61, 0xed, 0xcc, 0x95, 0x78, 0x36, 0x3b, 0x57, 0x78, 0x93, 0xb7, 0xc2, 0xab, 0xb2,
-	0x73, 0x85, 0x67, 0xb3, 0x73, 0x89, 0xf7, 0x0a, 0xd2, 0x12, 0x8f, 0xda, 0x36, 0xcf, 0x25, 0x14,
-	0xe0, 0x93, 0xf7, 0x57, 0xf3, 0x85, 0x9b, 0x01, 0x96, 0x6c, 0x9b, 0x63, 0x19, 0x97, 0x24, 0x10,
-	0x86, 0x29, 0xff, 0xa2, 0x45, 0x3a, 0xa4, 0xc5, 0xba, 0x39, 0xfd, 0x56, 0x98, 0xf5, 0x8b, 0xd6,
-	0xc1, 0x4b, 0xd6, 0xc5, 0x29, 0x3f, 0x22, 0x50, 0x1e, 0xb2, 0xfc, 0x72, 0x8d, 0xd8, 0x9c, 0x04,
-	0x8e, 0xd3, 0x61, 0x42, 0xd5, 0x40, 0x16, 0x67, 0xf8, 0xe5, 0x5a, 0x95, 0x37, 0x94, 0x08, 0xdd,
-	0x87, 0x24, 0xbf, 0x2c, 0x10, 0x9b, 0xab, 0x64, 0x67, 0xb1, 0xc1, 0x2f, 0x0b, 0x55, 0x2e, 0x33,
-	0xcd, 0x2f, 0x89, 0xcd, 0xda, 0xb4, 0xdb, 0xcb, 0x34, 0xbf, 0xac, 0x4a, 0x16, 0x2d, 0x42, 0xca,
-	0x72, 0x48, 0xdb, 0xed, 0x08, 0x95, 0xe5, 0x4c, 0x61, 0xb6, 0x5f, 0x53, 0x95, 0xed, 0x5d, 0xb7,
-	0x23, 0x70, 0xd2, 0x72, 0xe4, 0xf7, 0x23, 0x35, 0x3d, 0x7b, 0x93, 0x9a, 0xfe, 0x69, 0x12, 0x52,
-	0x7b, 0xac, 0xd3, 0xa1, 0xc7, 0x0c, 0xfd, 0x1f, 0x0c, 0x8f, 0x9c, 0xd8, 0x5c, 0xd5, 0x43, 0xa6,
-	0x90, 0x1d, 0x94, 0xf1, 0xf3, 0x2a, 0x2e, 0xa7, 0xdf, 0x5e, 0xcd, 0x4f, 0xbc, 0xbb, 0x9a, 0xd7,
-	0xb0, 0xee, 0x3d, 0xb7, 0x39, 0x32, 0x21, 0xe1, 0xb9, 0x56, 0x94, 0x6b, 0x2c, 0x49, 0xf4, 0x04,
-	0x32, 0x1e, 0xb5, 0x48, 0x48, 0xbb, 0xed, 0x80, 0xda, 0x2a, 0x69, 0x99, 0xe1, 0x66, 0x28, 0x55,
-	0xf6, 0x23, 0xd5, 0xf3, 0x09, 0x0c, 0x1e, 0xb5, 0x62, 0x0e, 0x35, 0xe0, 0xde, 0x69, 0xe0, 0xfa,
-	0x44, 0x05, 0xd6, 0x11, 0x7d, 0x00, 0x5d, 0x01, 0x3c, 0xec, 0x03, 0xbc, 0x08, 0x5c, 0x1f, 0x47,
-	0x36, 0x03, 0x20, 0x74, 0x7a, 0x4d, 0x8a, 0x76, 0xe1, 0xae, 0x02, 0xa4, 0x96, 0xc5, 0xc2, 0x01,
-	0x9e, 0xa1, 0xf0, 0x1e, 0x8c, 0xe0, 0x95, 0x94, 0xc9, 0x00, 0xee, 0xce, 0xe9, 0xb8, 0xb0, 0x3c,
-	0x05, 0xa9, 0x98, 0xcc, 0x1f, 0x80, 0x2e, 0xdf, 0x02, 0xfd, 0x0f, 0x92, 0x1e, 0x91, 0x05, 0xa1,
-	0x9e, 0x6a, 0xa6, 0x30, 0x33, 0xb8, 0xe4, 0x61, 0x37, 0x64, 0xd8, 0xf0, 0xe4, 0x07, 0xfd, 0x17,
-	0x0c, 0x8f, 0x9e, 0x06, 0x5c, 0x3d, 0xd2, 0x88, 0x95, 0x94, 0xe2, 0x48, 0x99, 0xe7, 0x00, 0x83,
-	0xa7, 0x91, 0x49, 0x70, 0x3e, 0x9a, 0x84, 0xed, 0xb1, 0x24, 0x38, 0x32, 0x09, 0xf7, 0x21, 0xe9,
-	0x90, 0x30, 0xe0, 0x42, 0x1d, 0x61, 0x60, 0xc3, 0xd9, 0x0f, 0xb8, 0x90, 0x83, 0xc2, 0xe1, 0xde,
-	0x48, 0x26, 0xa6, 0x31, 0x38, 0xdc, 0xeb, 0x5d, 0xe4, 0x57, 0x0d, 0x74, 0x09, 0x88, 0x9a, 0x43,
-	0x5d, 0x16, 0x8d, 0x81, 0x2f, 0xe5, 0x11, 0x9f, 0xdb, 0x69, 0x2b, 0x32, 0x2e, 0x4b, 0xf0, 0xb6,
-	0x8a, 0x2b, 0x33, 0x74, 0xf5, 0xed, 0x8a, 0xe0, 0xed, 0xa1, 0x7b, 0x18, 0x8e, 0x14, 0x0c, 0x26,
-	0x57, 0x62, 0x68, 0x5e, 0xaf, 0x4a, 0x94, 0x20, 0x14, 0x9d, 0x9c, 0xbe, 0x90, 0x18, 0xaf, 0xa5,
-	0x4a, 0xe0, 0x79, 0xd4, 0xb7, 0xcb, 0xba, 0x84, 0xc2, 0x86, 0xd3, 0x08, 0x45, 0x27, 0x7f, 0x02,
-	0x86, 0x3a, 0x40, 0x56, 0x27, 0x8d, 0xaf, 0x94, 0xc6, 0x92, 0x44, 0x73, 0x90, 0xa1, 0x36, 0x27,
-	0xd4, 0x6a, 0xc9, 0x42, 0x53, 0x71, 0xa5, 0xf1, 0x14, 0xb5, 0x79, 0xc9, 0x6a, 0x61, 0xf6, 0x46,
-	0x79, 0x58, 0x2d, 0x75, 0xbe, 0xf4, 0xb0, 0x5a, 0x72, 0x4c, 0x3b, 0x24, 0x64, 0xbe, 0x1c, 0xaf,
-	0xaa, 0x18, 0xd3, 0x38, 0xed, 0xec, 0x47, 0x7c, 0x7e, 0x53, 0x65, 0x2d, 0x0e, 0x42, 0x3a, 0x5b,
-	0xae, 0xad, 0x8e, 0xcb, 0x62, 0x49, 0xa2, 0x1c, 0xa4, 0x7a, 0xcf, 0x1f, 0xb5, 0x48, 0x8f, 0xcd,
-	0x7f, 0x3f, 0x09, 0xe8, 0x7a, 0x29, 0x23, 0x3c, 0x3e, 0x8f, 0xb7, 0xe2, 0x44, 0x7c, 0xc6, 0x4c,
-	0xc6, 0xe3, 0x33, 0xf9, 0x36, 0x98, 0x63, 0x73, 0xf9, 0x1b, 0x98, 0x92, 0x98, 0x7e, 0xe0, 0x5b,
-	0x2c, 0x1e, 0xcc, 0x5f, 0xc5, 0xa8, 0xc5, 0x9b, 0xa1, 0xd6, 0x25, 0x04, 0x96, 0xf5, 0xa7, 0xa8,
-	0xfc, 0x2f, 0x09, 0xb8, 0x73, 0xad, 0x27, 0xd1, 0x23, 0x98, 0x62, 0xbe, 0xc5, 0xbb, 0xa1, 0x60,
-	0xd1, 0x03, 0x4f, 0xe3, 0x81, 0x40, 0x46, 0x23, 0x5f, 0x2d, 0x8a, 0x66, 0xf2, 0xd6, 0xd1, 0x94,
-	0xc2, 0x30, 0x8e, 0x86, 0xc6, 0x14, 0x6a, 0x40, 0xd2, 0x67, 0x82, 0xb8, 0x71, 0xfb, 0x94, 0x37,
-	0x63, 0xd8, 0xd5, 0x9b, 0x6c, 0x0b, 0x26, 0x76, 0xaa, 0xd8, 0xf0, 0x99, 0xd8, 0xb1, 0x47, 0x5a,
-	0x4d, 0xff, 0xfb, 0x5a, 0xed, 0x19, 0x64, 0xec, 0x36, 0xe9, 0x30, 0x21, 0xa4, 0x57, 0x3c, 0xe4,
-	0x06, 0x9d, 0x52, 0xdd, 0x3d, 0x88, 0x55, 0x43, 0x4d, 0x07, 0x76, 0xbb, 0x27, 0x1d, 0xd9, 0x42,
-	0xc9, 0x3f, 0xdd, 0x42, 0xa9, 0x4f, 0x6e, 0xa1, 0xfc, 0xd7, 0x00, 0x83, 0x83, 0xae, 0xef, 0x44,
-	0xed, 0x53, 0x3b, 0x71, 0x72, 0x68, 0x27, 0xe6, 0x1f, 0x41, 0x32, 0x82, 0x46, 0x08, 0x74, 0xb9,
-	0xaa, 0x72, 0xda, 0x42, 0x42, 0x0d, 0x04, 0xce, 0xde, 0x2c, 0xcd, 0x03, 0x0c, 0x7e, 0x52, 0xa1,
-	0x34, 0xe8, 0xbb, 0x0d, 0x5c, 0x32, 0x27, 0x50, 0x0a, 0x12, 0xdb, 0x07, 0x2f, 0x4d, 0x6d, 0xe9,
-	0x47, 0x0d, 0xb2, 0x23, 0xfb, 0x0e, 0xcd, 0x00, 0xd4, 0x9a, 0x64, 0xf3, 0x49, 0x91, 0x6c, 0x6e,
-	0xac, 0x9a, 0x13, 0x92, 0x6f, 0x1e, 0x90, 0xad, 0xd5, 0x02, 0xd9, 0x2a, 0x6c, 0x9a, 0x9a, 0xe4,
-	0x2b, 0x75, 0xb2, 0xb1, 0xb1, 0x45, 0x36, 0x36, 0x37, 0xcc, 0x49, 0x04, 0x90, 0xac, 0x35, 0xc9,
-	0xe3, 0x62, 0xd1, 0x4c, 0x48, 0x5d, 0xa9, 0x49, 0xb6, 0xd6, 0xd6, 0x95, 0xad, 0x1e, 0xdb, 0x3e,
-	0xde, 0x58, 0x25, 0xeb, 0x6b, 0xab, 0xa6, 0x21, 0x6d, 0x4b, 0x07, 0x64, 0xab, 0x50, 0x34, 0x93,
-	0xca, 0x56, 0xd2, 0xab, 0x8a, 0x7f, 0xda, 0xe7, 0x8b, 0x64, 0xab, 0xb0, 0x6e, 0x3e, 0x93, 0xfc,
-	0x4b, 0xdc, 0xd7, 0xa7, 0x96, 0xfe, 0x0d, 0x86, 0xda, 0x02, 0x52, 0x21, 0x6f, 0xf1, 0xba, 0x54,
-	0x27, 0x78, 0xcd, 0x9c, 0x58, 0xfa, 0x0e, 0x0c, 0xb5, 0x44, 0x90, 0x09, 0xd3, 0x2f, 0x1a, 0x3b,
-	0x75, 0x82, 0x6b, 0xaf, 0x9a, 0xb5, 0x83, 0x43, 0x73, 0x02, 0xcd, 0x42, 0x46, 0x49, 0x4a, 0x95,
-	0x4a, 0x6d, 0xff, 0xd0, 0xd4, 0x10, 0x82, 0x99, 0x66, 0xbd, 0xd2, 0xa8, 0x6f, 0xef, 0xe0, 0xbd,
-	0x5a, 0x95, 0x34, 0xf7, 0xcd, 0x49, 0x74, 0x0f, 0xcc, 0x61, 0x59, 0xb5, 0xf1, 0xba, 0x6e, 0x26,
-	0x24, 0xd8, 0x88, 0x9d, 0x2e, 0x7d, 0xc7, 0xac, 0x8c, 0x72, 0xf9, 0xed, 0x87, 0x39, 0xed, 0xdd,
-	0x87, 0x39, 0xed, 0xb7, 0x0f, 0x73, 0xda, 0xb7, 0x8f, 0x6f, 0xf3, 0xd7, 0xe2, 0x28, 0xa9, 0x24,
-	0xc5, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5c, 0xfb, 0x13, 0x90, 0x99, 0x0c, 0x00, 0x00,
+	// 1332 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x4f, 0x1b, 0xc7,
+	0x1b, 0x67, 0x6d, 0xaf, 0x6d, 0x1e, 0x63, 0xd8, 0x4c, 0x92, 0xff, 0xdf, 0x4d, 0x22, 0x40, 0x56,
+	0x2b, 0x21, 0xd4, 0x82, 0xb1, 0x21, 0x40, 0xab, 0x44, 0xf2, 0x1b, 0x0d, 0x09, 0xd8, 0x64, 0x8c,
+	0x95, 0xaa, 0x97, 0xd1, 0xb0, 0x3b, 0x0b, 0x8b, 0xed, 0xdd, 0xcd, 0x78, 0x00, 0xbb, 0x1f, 0xa4,
+	0x87, 0x7e, 0x85, 0x5e, 0x5b, 0xa9, 0x1f, 0x21, 0xc7, 0x5c, 0x7a, 0xc9, 0x01, 0x55, 0x39, 0xf7,
+	0x43, 0x54, 0x33, 0xbb, 0x7e, 0xc1, 0xa4, 0xa9, 0x20, 0x3d, 0xf4, 0xb4, 0xcf, 0xeb, 0x6f, 0x9e,
+	0x99, 0xe7, 0xcd, 0x86, 0xd2, 0xb1, 0x23, 0x4e, 0xce, 0x8e, 0x56, 0x4c, 0xaf, 0xb3, 0x7a, 0x78,
+	0xc2, 0x0e, 0x4f, 0x1c, 0xf7, 0xb8, 0x5b, 0x63, 0xe2, 0xc2, 0xe3, 0xad, 0x55, 0x21, 0xdc, 0x55,
+	0xea, 0x3b, 0xab, 0x3e, 0xf7, 0x84, 0x67, 0x7a, 0xed, 0xd5, 0xb6, 0xc7, 0xe9, 0x05, 0x75, 0x07,
+	0xdf, 0x15, 0xa5, 0x40, 0x89, 0x90, 0x7d, 0xf0, 0xd5, 0x18, 0xd8, 0xb1, 0x77, 0xec, 0x05, 0x8e,
+	0x47, 0x67, 0xb6, 0xe2, 0x14, 0xa3, 0xa8, 0xc0, 0x2f, 0xfb, 0xa7, 0x06, 0xc9, 0x7d, 0x26, 0xa8,
+	0x45, 0x05, 0x45, 0x05, 0x80, 0x8e, 0x67, 0x9d, 0xb5, 0xa9, 0x70, 0x3c, 0x37, 0x93, 0x5a, 0xd4,
+	0x96, 0x66, 0xf3, 0x77, 0x57, 0x06, 0x07, 0xed, 0x0f, 0x55, 0x78, 0xcc, 0x0c, 0x3d, 0x84, 0x69,
+	0xe9, 0x4c, 0x38, 0x15, 0x2c, 0x33, 0xb3, 0xa8, 0x2d, 0x4d, 0xe3, 0xa4, 0x14, 0x60, 0x2a, 0x18,
+	0xfa, 0x0c, 0x92, 0x47, 0x8e, 0x08, 0x74, 0xe9, 0x45, 0x6d, 0x29, 0x8d, 0x13, 0x47, 0x8e, 0x50,
+	0xaa, 0x05, 0x48, 0x99, 0x9e, 0xe5, 0xb8, 0xc7, 0x81, 0x76, 0x56, 0x79, 0x42, 0x20, 0x52, 0x06,
+	0x77, 0x41, 0xb7, 0x89, 0xe9, 0x8a, 0xcc, 0x9c, 0x72, 0x8c, 0xd9, 0x65, 0x57, 0xa0, 0x27, 0x30,
+	0x6b, 0x73, 0xf6, 0xfa, 0x8c, 0xb9, 0x66, 0x9f, 0xf8, 0x6d, 0xea, 0x66, 0x0c, 0x15, 0xe6, 0xff,
+	0x86, 0x61, 0xee, 0x0c, 0xd4, 0x07, 0x6d, 0xea, 0xe2, 0xb4, 0x3d, 0xce, 0x66, 0x7f, 0xd1, 0x60,
+	0xee, 0xb0, 0x57, 0xf6, 0x5c, 0xdb, 0x39, 0x3e, 0xe3, 0xc1, 0x05, 0xfe, 0xfb, 0xb7, 0xce, 0xfe,
+	0x14, 0x03, 0x54, 0x34, 0x85, 0x73, 0xae, 0x0e, 0x1f, 0xe6, 0xab, 0x06, 0x09, 0xea, 0xfb, 0x84,
+	0x9d, 0x39, 0x19, 0x6d, 0x51, 0x5b, 0x9a, 0x29, 0x6d, 0xbc, 0xbb, 0x5c, 0x58, 0xfb, 0xa7, 0x6a,
+	0x32, 0x3d, 0xce, 0x56, 0x45, 0xdf, 0x67, 0xdd, 0x95, 0xa2, 0xef, 0x57, 0x9b, 0xbb, 0x38, 0x4e,
+	0x7d, 0xbf, 0x7a, 0xe6, 0x48, 0x3c, 0x8b, 0x9d, 0x2b, 0xbc, 0xc8, 0xad, 0xf0, 0x2a, 0xec, 0x5c,
+	0xe1, 0x59, 0xec, 0x5c, 0xe2, 0xbd, 0x84, 0xa4, 0xc4, 0xa3, 0x96, 0xc5, 0x33, 0x51, 0x05, 0xf8,
+	0xf8, 0xdd, 0xe5, 0x42, 0xfe, 0x66, 0x80, 0x45, 0xcb, 0xe2, 0x38, 0x61, 0x05, 0x04, 0xc2, 0x30,
+	0xed, 0x5e, 0xb4, 0x48, 0x97, 0xb4, 0x58, 0x3f, 0x13, 0xbb, 0x15, 0x66, 0xed, 0xa2, 0xd5, 0x78,
+	0xc1, 0xfa, 0x38, 0xe1, 0x06, 0x04, 0xca, 0x42, 0x9a, 0xf7, 0xd6, 0x88, 0xc5, 0x89, 0x67, 0xdb,
+	0x5d, 0x26, 0x54, 0x0d, 0xa4, 0x71, 0x8a, 0xf7, 0xd6, 0x2a, 0xbc, 0xae, 0x44, 0xe8, 0x3e, 0xc4,
+	0x79, 0x2f, 0x4f, 0x2c, 0xae, 0x92, 0x9d, 0xc6, 0x3a, 0xef, 0xe5, 0x2b, 0x5c, 0x66, 0x9a, 0xf7,
+	0x88, 0xc5, 0xda, 0xb4, 0x3f, 0xc8, 0x34, 0xef, 0x55, 0x24, 0x8b, 0x96, 0x20, 0x61, 0xda, 0xa4,
+	0xed, 0x74, 0x85, 0xca, 0x72, 0x2a, 0x3f, 0x37, 0xac, 0xa9, 0xf2, 0xce, 0x9e, 0xd3, 0x15, 0x38,
+	0x6e, 0xda, 0xf2, 0xfb, 0x81, 0x9a, 0x9e, 0xbb, 0x49, 0x4d, 0xff, 0x1c, 0x81, 0xc4, 0x3e, 0xeb,
+	0x76, 0xe9, 0x31, 0x43, 0x5f, 0x82, 0xde, 0x21, 0x27, 0x16, 0x57, 0xf5, 0x90, 0xca, 0xa7, 0x47,
+	0x65, 0xfc, 0xac, 0x82, 0x4b, 0xc9, 0x37, 0x97, 0x0b, 0x53, 0x6f, 0x2f, 0x17, 0x34, 0x1c, 0xeb,
+	0x3c, 0xb3, 0x38, 0x32, 0x20, 0xda, 0x71, 0xcc, 0x20, 0xd7, 0x58, 0x92, 0xe8, 0x31, 0xa4, 0x3a,
+	0xd4, 0x24, 0x3e, 0xed, 0xb7, 0x3d, 0x6a, 0xa9, 0xa4, 0xa5, 0xc6, 0x9b, 0xa1, 0x58, 0x3e, 0x08,
+	0x54, 0xcf, 0xa6, 0x30, 0x74, 0xa8, 0x19, 0x72, 0xa8, 0x0e, 0xf7, 0x4e, 0x3d, 0xc7, 0x25, 0x2a,
+	0xb0, 0xae, 0x18, 0x02, 0xc4, 0x14, 0xc0, 0xc3, 0x21, 0xc0, 0x73, 0xcf, 0x71, 0x71, 0x60, 0x33,
+	0x02, 0x42, 0xa7, 0xd7, 0xa4, 0x68, 0x0f, 0xee, 0x2a, 0x40, 0x6a, 0x9a, 0xcc, 0x1f, 0xe1, 0xe9,
+	0x0a, 0xef, 0xc1, 0x15, 0xbc, 0xa2, 0x32, 0x19, 0xc1, 0xdd, 0x39, 0x9d, 0x14, 0x96, 0xa6, 0x21,
+	0x11, 0x92, 0xd9, 0x06, 0xc4, 0xe4, 0x5b, 0xa0, 0x2f, 0x20, 0xde, 0x21, 0xb2, 0x20, 0xd4, 0x53,
+	0xcd, 0xe6, 0x67, 0x47, 0x97, 0x3c, 0xec, 0xfb, 0x0c, 0xeb, 0x1d, 0xf9, 0x41, 0x9f, 0x83, 0xde,
+	0xa1, 0xa7, 0x1e, 0xcf, 0x44, 0x26, 0xad, 0xa4, 0x14, 0x07, 0xca, 0x2c, 0x07, 0x18, 0x3d, 0x8d,
+	0x4c, 0x82, 0xfd, 0xc1, 0x24, 0xec, 0x4c, 0x24, 0xc1, 0x96, 0x49, 0xb8, 0x0f, 0x71, 0x9b, 0xf8,
+	0x1e, 0x17, 0xea, 0x08, 0x1d, 0xeb, 0xf6, 0x81, 0xc7, 0x85, 0x1c, 0x14, 0x36, 0xef, 0x5c, 0xc9,
+	0xc4, 0x0c, 0x06, 0x9b, 0x77, 0x06, 0x17, 0xf9, 0x5d, 0x83, 0x98, 0x04, 0x44, 0xcd, 0xb1, 0x2e,
+	0x0b, 0xc6, 0xc0, 0xd7, 0xf2, 0x88, 0x4f, 0xed, 0xb4, 0x55, 0x19, 0x97, 0x29, 0x78, 0x5b, 0xc5,
+	0x95, 0x1a, 0xbb, 0xfa, 0x4e, 0x59, 0xf0, 0xf6, 0xd8, 0x3d, 0x74, 0x5b, 0x0a, 0x46, 0x93, 0x2b,
+	0x3a, 0x36, 0xaf, 0x73, 0x12, 0xc5, 0xf3, 0x45, 0x37, 0x13, 0x5b, 0x8c, 0x4e, 0xd6, 0x52, 0xd9,
+	0xeb, 0x74, 0xa8, 0x6b, 0x95, 0x62, 0x12, 0x0a, 0xeb, 0x76, 0xdd, 0x17, 0xdd, 0xec, 0x09, 0xe8,
+	0xea, 0x00, 0x59, 0x9d, 0x34, 0xbc, 0x52, 0x12, 0x4b, 0x12, 0xcd, 0x43, 0x8a, 0x5a, 0x9c, 0x50,
+	0xb3, 0x25, 0x0b, 0x4d, 0xc5, 0x95, 0xc4, 0xd3, 0xd4, 0xe2, 0x45, 0xb3, 0x85, 0xd9, 0x6b, 0xe5,
+	0x61, 0xb6, 0x32, 0xd1, 0xd0, 0xc3, 0x6c, 0xc9, 0x31, 0x6d, 0x13, 0x9f, 0xb9, 0x72, 0xbc, 0xaa,
+	0x62, 0x4c, 0xe2, 0xa4, 0x7d, 0x10, 0xf0, 0xd9, 0x2d, 0x80, 0x51, 0x10, 0xd2, 0xd9, 0x74, 0x2c,
+	0x75, 0x5c, 0x1a, 0x4b, 0x12, 0x65, 0x20, 0x31, 0x78, 0xfe, 0xa0, 0x45, 0x06, 0x6c, 0xf6, 0xc7,
+	0x08, 0xa0, 0xeb, 0xa5, 0x8c, 0xf0, 0xe4, 0x3c, 0xde, 0x0e, 0x13, 0xf1, 0x09, 0x33, 0x19, 0x4f,
+	0xce, 0xe4, 0xdb, 0x60, 0x4e, 0xcc, 0xe5, 0xef, 0x60, 0x5a, 0x62, 0xba, 0x9e, 0x6b, 0xb2, 0x70,
+	0x30, 0x7f, 0x13, 0xa2, 0x16, 0x6e, 0x86, 0x5a, 0x93, 0x10, 0x38, 0x69, 0x85, 0x54, 0xf6, 0xb7,
+	0x28, 0xdc, 0xb9, 0xd6, 0x93, 0xe8, 0x11, 0x4c, 0x33, 0xd7, 0xe4, 0x7d, 0x5f, 0xb0, 0xe0, 0x81,
+	0x67, 0xf0, 0x48, 0x20, 0xa3, 0x91, 0xaf, 0x16, 0x44, 0x13, 0xb9, 0x75, 0x34, 0x45, 0xdf, 0x0f,
+	0xa3, 0xa1, 0x21, 0x85, 0xea, 0x10, 0x77, 0x99, 0x20, 0x4e, 0xd8, 0x3e, 0xa5, 0xad, 0x10, 0x36,
+	0x77, 0x93, 0x6d, 0xc1, 0xc4, 0x6e, 0x05, 0xeb, 0x2e, 0x13, 0xbb, 0xd6, 0x95, 0x56, 0x8b, 0xfd,
+	0x7b, 0xad, 0xf6, 0x14, 0x52, 0x56, 0x9b, 0x74, 0x99, 0x10, 0xd2, 0x2b, 0x1c, 0x72, 0xa3, 0x4e,
+	0xa9, 0xec, 0x35, 0x42, 0xd5, 0x58, 0xd3, 0x81, 0xd5, 0x1e, 0x48, 0xaf, 0x6c, 0xa1, 0xf8, 0xdf,
+	0x6e, 0xa1, 0xc4, 0x47, 0xb7, 0x50, 0xf6, 0x5b, 0x80, 0xd1, 0x41, 0xd7, 0x77, 0xa2, 0xf6, 0xb1,
+	0x9d, 0x18, 0x19, 0xdb, 0x89, 0xd9, 0x47, 0x10, 0x0f, 0xa0, 0x11, 0x82, 0x98, 0x5c, 0x55, 0x19,
+	0x6d, 0x31, 0xaa, 0x06, 0x02, 0x67, 0xaf, 0x97, 0x17, 0x00, 0x46, 0x3f, 0xa9, 0x50, 0x12, 0x62,
+	0x7b, 0x75, 0x5c, 0x34, 0xa6, 0x50, 0x02, 0xa2, 0x3b, 0x8d, 0x17, 0x86, 0xb6, 0xfc, 0xab, 0x06,
+	0xe9, 0x2b, 0xfb, 0x0e, 0xcd, 0x02, 0x54, 0x9b, 0x64, 0xeb, 0x71, 0x81, 0x6c, 0x6d, 0xe6, 0x8c,
+	0x29, 0xc9, 0x37, 0x1b, 0x64, 0x3b, 0x97, 0x27, 0xdb, 0xf9, 0x2d, 0x43, 0x93, 0x7c, 0xb9, 0x46,
+	0x36, 0x37, 0xb7, 0xc9, 0xe6, 0xd6, 0xa6, 0x11, 0x41, 0x00, 0xf1, 0x6a, 0x93, 0xac, 0x17, 0x0a,
+	0x46, 0x54, 0xea, 0x8a, 0x4d, 0xb2, 0xbd, 0xb6, 0xa1, 0x6c, 0x63, 0xa1, 0xed, 0xfa, 0x66, 0x8e,
+	0x6c, 0xac, 0xe5, 0x0c, 0x5d, 0xda, 0x16, 0x1b, 0x64, 0x3b, 0x5f, 0x30, 0xe2, 0xca, 0x56, 0xd2,
+	0x39, 0xc5, 0x3f, 0x19, 0xf2, 0x05, 0xb2, 0x9d, 0xdf, 0x30, 0x9e, 0x4a, 0xfe, 0x05, 0x1e, 0xea,
+	0x13, 0x68, 0x06, 0x92, 0xbb, 0x8d, 0x7d, 0x92, 0x5f, 0xcf, 0xe5, 0x8c, 0xe4, 0xf2, 0xff, 0x41,
+	0x57, 0x3b, 0x41, 0x9a, 0xc9, 0x3b, 0xbd, 0x2a, 0xd6, 0x08, 0x5e, 0x33, 0xa6, 0x96, 0x7f, 0x00,
+	0x5d, 0xad, 0x14, 0x64, 0xc0, 0xcc, 0xf3, 0xfa, 0x6e, 0x8d, 0xe0, 0xea, 0xcb, 0x66, 0xb5, 0x71,
+	0x68, 0x4c, 0xa1, 0x39, 0x48, 0x29, 0x49, 0xb1, 0x5c, 0xae, 0x1e, 0x1c, 0x1a, 0x1a, 0x42, 0x30,
+	0xdb, 0xac, 0x95, 0xeb, 0xb5, 0x9d, 0x5d, 0xbc, 0x5f, 0xad, 0x90, 0xe6, 0x81, 0x11, 0x41, 0xf7,
+	0xc0, 0x18, 0x97, 0x55, 0xea, 0xaf, 0x6a, 0x46, 0x54, 0x82, 0x5d, 0xb1, 0x8b, 0x49, 0xdf, 0x09,
+	0x2b, 0xbd, 0x54, 0x7a, 0xf3, 0x7e, 0x5e, 0x7b, 0xfb, 0x7e, 0x5e, 0xfb, 0xe3, 0xfd, 0xbc, 0xf6,
+	0xfd, 0xfa, 0x6d, 0xfe, 0x68, 0x1c, 0xc5, 0x95, 0xa4, 0xf0, 0xd7, 0x00, 0x16, 0x5b, 0x14, 0x4b,
+	0xa7, 0x0c, 0x00, 0x00,
 }
@@ -0,0 +1,25 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package lorawan
+
+// Revisions of the LoRaWAN Regional Parameters that devices can implement
+const (
+	RegionalParameters1_0    = "1.0"
+	RegionalParameters1_0_1  = "1.0.1"
+	RegionalParameters1_0_2  = "1.0.2"
+	RegionalParameters1_0_2B = "1.0.2-b"
+	RegionalParameters1_0_3A = "1.0.3-a"
+)
+
+// DefaultRegionalParameters is the revision that is used for devices that do not set one
+const DefaultRegionalParameters = RegionalParameters1_0_1
+
+// ValidRegionalParameters returns whether the revision is known. An empty revision selects the default.
+func ValidRegionalParameters(revision string) bool {
+	switch revision {
+	case "", RegionalParameters1_0, RegionalParameters1_0_1, RegionalParameters1_0_2, RegionalParameters1_0_2B, RegionalParameters1_0_3A:
+		return true
+	}
+	return false
+}
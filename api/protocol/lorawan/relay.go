@@ -0,0 +1,77 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package lorawan
+
+import (
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// RelayFPort is the FPort that relays use for forwarded messages (LoRaWAN Relay)
+const RelayFPort = 226
+
+const (
+	relayMinSNR  = -20
+	relayMaxSNR  = 43
+	relayMaxRSSI = -15
+	relayMinRSSI = -142
+)
+
+// RelayUplink is an uplink message of an end device that was forwarded by a relay
+type RelayUplink struct {
+	DataRate   uint8  // Data rate index of the end device uplink
+	SNR        int8   // SNR of the end device uplink at the relay (dB)
+	RSSI       int16  // RSSI of the end device uplink at the relay (dBm)
+	WORChannel uint8  // Wake On Radio channel of the relay
+	Frequency  uint64 // Frequency of the end device uplink (Hz)
+	Payload    []byte // PHYPayload of the end device uplink
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (u RelayUplink) MarshalBinary() ([]byte, error) {
+	if u.DataRate > 15 {
+		return nil, errors.NewErrInvalidArgument("Relay DataRate", "must be lower than 16")
+	}
+	if u.WORChannel > 3 {
+		return nil, errors.NewErrInvalidArgument("Relay WORChannel", "must be lower than 4")
+	}
+	snr := int(u.SNR)
+	if snr < relayMinSNR {
+		snr = relayMinSNR
+	}
+	if snr > relayMaxSNR {
+		snr = relayMaxSNR
+	}
+	rssi := int(u.RSSI)
+	if rssi < relayMinRSSI {
+		rssi = relayMinRSSI
+	}
+	if rssi > relayMaxRSSI {
+		rssi = relayMaxRSSI
+	}
+	metadata := uint32(u.DataRate) |
+		uint32(snr-relayMinSNR)<<4 |
+		uint32(relayMaxRSSI-rssi)<<10 |
+		uint32(u.WORChannel)<<17
+	frequency := uint32(u.Frequency / 100)
+	b := []byte{
+		byte(metadata), byte(metadata >> 8), byte(metadata >> 16),
+		byte(frequency), byte(frequency >> 8), byte(frequency >> 16),
+	}
+	return append(b, u.Payload...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (u *RelayUplink) UnmarshalBinary(data []byte) error {
+	if len(data) < 6 {
+		return errors.NewErrInvalidArgument("Relay uplink", "too short")
+	}
+	metadata := uint32(data[0]) | uint32(data[1])<<8 | uint32(data[2])<<16
+	u.DataRate = uint8(metadata & 0x0f)
+	u.SNR = int8(int(metadata>>4&0x3f) + relayMinSNR)
+	u.RSSI = int16(relayMaxRSSI - int(metadata>>10&0x7f))
+	u.WORChannel = uint8(metadata >> 17 & 0x03)
+	u.Frequency = uint64(uint32(data[3])|uint32(data[4])<<8|uint32(data[5])<<16) * 100
+	u.Payload = append([]byte{}, data[6:]...)
+	return nil
+}
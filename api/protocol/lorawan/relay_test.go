@@ -0,0 +1,37 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package lorawan
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestRelayUplink(t *testing.T) {
+	a := New(t)
+
+	uplink := RelayUplink{
+		DataRate:   5,
+		SNR:        7,
+		RSSI:       -87,
+		WORChannel: 1,
+		Frequency:  868100000,
+		Payload:    []byte{0x40, 0x01, 0x02, 0x03, 0x04},
+	}
+
+	bin, err := uplink.MarshalBinary()
+	a.So(err, ShouldBeNil)
+	a.So(bin, ShouldHaveLength, 11)
+
+	var decoded RelayUplink
+	a.So(decoded.UnmarshalBinary(bin), ShouldBeNil)
+	a.So(decoded, ShouldResemble, uplink)
+
+	a.So(decoded.UnmarshalBinary([]byte{1, 2, 3}), ShouldNotBeNil)
+
+	uplink.DataRate = 16
+	_, err = uplink.MarshalBinary()
+	a.So(err, ShouldNotBeNil)
+}
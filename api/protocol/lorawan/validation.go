@@ -34,6 +34,12 @@ func (m *Device) Validate() error {
 	if err := api.NotEmptyAndValidID(m.DevId, "DevId"); err != nil {
 		return err
 	}
+	if !ValidRegionalParameters(m.RegionalParameters) {
+		return errors.NewErrInvalidArgument("RegionalParameters", "unknown revision")
+	}
+	if !ValidDevAddrAllocation(m.DevAddrAllocation) {
+		return errors.NewErrInvalidArgument("DevAddrAllocation", "must be random, sequential or sticky")
+	}
 	return nil
 }
 
@@ -0,0 +1,24 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package lorawan
+
+// Versions of the LoRaWAN specification that devices can implement
+const (
+	LoRaWAN1_0   = "1.0"
+	LoRaWAN1_0_1 = "1.0.1"
+	LoRaWAN1_0_2 = "1.0.2"
+	LoRaWAN1_0_3 = "1.0.3"
+	LoRaWAN1_0_4 = "1.0.4"
+	LoRaWAN1_1   = "1.1"
+)
+
+// ValidLoRaWANVersion returns whether the version of the LoRaWAN specification is known. An empty version is unknown
+// but valid.
+func ValidLoRaWANVersion(version string) bool {
+	switch version {
+	case "", LoRaWAN1_0, LoRaWAN1_0_1, LoRaWAN1_0_2, LoRaWAN1_0_3, LoRaWAN1_0_4, LoRaWAN1_1:
+		return true
+	}
+	return false
+}
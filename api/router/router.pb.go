@@ -18,6 +18,12 @@
 		GatewayStatusResponse
 		StatusRequest
 		Status
+		GatewayInventoryRequest
+		VersionWarning
+		GatewayVersions
+		GatewayInventoryResponse
+		GatewayGrayListRequest
+		GatewayMaintenanceRequest
 */
 package router
 
@@ -242,6 +248,16 @@ func (m *GatewayStatusRequest) GetGatewayId() string {
 type GatewayStatusResponse struct {
 	LastSeen int64           `protobuf:"varint,1,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
 	Status   *gateway.Status `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+	// The number of downlink messages that are queued for the gateway
+	QueuedDownlinks uint32 `protobuf:"varint,3,opt,name=queued_downlinks,json=queuedDownlinks,proto3" json:"queued_downlinks,omitempty"`
+	// The fraction of downlink messages that the gateway reported as transmitted
+	TxSuccessRate float32 `protobuf:"fixed32,4,opt,name=tx_success_rate,json=txSuccessRate,proto3" json:"tx_success_rate,omitempty"`
+	// Whether downlink through the gateway is avoided because it often fails to transmit
+	GrayListed bool `protobuf:"varint,5,opt,name=gray_listed,json=grayListed,proto3" json:"gray_listed,omitempty"`
+	// The override of the gray-listing by the network operator: never, always or empty
+	GrayListOverride string `protobuf:"bytes,6,opt,name=gray_list_override,json=grayListOverride,proto3" json:"gray_list_override,omitempty"`
+	// The maintenance windows of the gateway
+	MaintenanceWindows []*api.MaintenanceWindow `protobuf:"bytes,7,rep,name=maintenance_windows,json=maintenanceWindows" json:"maintenance_windows,omitempty"`
 }
 
 func (m *GatewayStatusResponse) Reset()                    { *m = GatewayStatusResponse{} }
@@ -263,6 +279,41 @@ func (m *GatewayStatusResponse) GetStatus() *gateway.Status {
 	return nil
 }
 
+func (m *GatewayStatusResponse) GetQueuedDownlinks() uint32 {
+	if m != nil {
+		return m.QueuedDownlinks
+	}
+	return 0
+}
+
+func (m *GatewayStatusResponse) GetTxSuccessRate() float32 {
+	if m != nil {
+		return m.TxSuccessRate
+	}
+	return 0
+}
+
+func (m *GatewayStatusResponse) GetGrayListed() bool {
+	if m != nil {
+		return m.GrayListed
+	}
+	return false
+}
+
+func (m *GatewayStatusResponse) GetGrayListOverride() string {
+	if m != nil {
+		return m.GrayListOverride
+	}
+	return ""
+}
+
+func (m *GatewayStatusResponse) GetMaintenanceWindows() []*api.MaintenanceWindow {
+	if m != nil {
+		return m.MaintenanceWindows
+	}
+	return nil
+}
+
 // message StatusRequest is used to request the status of this Router
 type StatusRequest struct {
 }
@@ -283,6 +334,10 @@ type Status struct {
 	// Connections
 	ConnectedGateways uint32 `protobuf:"varint,21,opt,name=connected_gateways,json=connectedGateways,proto3" json:"connected_gateways,omitempty"`
 	ConnectedBrokers  uint32 `protobuf:"varint,22,opt,name=connected_brokers,json=connectedBrokers,proto3" json:"connected_brokers,omitempty"`
+	// The number of connected gateways in each shard of the gateway state
+	ConnectedGatewaysPerShard []uint32 `protobuf:"varint,23,rep,packed,name=connected_gateways_per_shard,json=connectedGatewaysPerShard" json:"connected_gateways_per_shard,omitempty"`
+	// The number of downlink messages that are queued for all connected gateways
+	QueuedDownlinks uint32 `protobuf:"varint,24,opt,name=queued_downlinks,json=queuedDownlinks,proto3" json:"queued_downlinks,omitempty"`
 }
 
 func (m *Status) Reset()                    { *m = Status{} }
@@ -346,6 +401,217 @@ func (m *Status) GetConnectedBrokers() uint32 {
 	return 0
 }
 
+func (m *Status) GetConnectedGatewaysPerShard() []uint32 {
+	if m != nil {
+		return m.ConnectedGatewaysPerShard
+	}
+	return nil
+}
+
+func (m *Status) GetQueuedDownlinks() uint32 {
+	if m != nil {
+		return m.QueuedDownlinks
+	}
+	return 0
+}
+
+// message GatewayInventoryRequest is used to request the software versions of
+// the gateways that are connected to this Router
+type GatewayInventoryRequest struct {
+}
+
+func (m *GatewayInventoryRequest) Reset()                    { *m = GatewayInventoryRequest{} }
+func (m *GatewayInventoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*GatewayInventoryRequest) ProtoMessage()               {}
+func (*GatewayInventoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorRouter, []int{9} }
+
+// message VersionWarning indicates a known problem with the software version of a gateway
+type VersionWarning struct {
+	// The software component: platform, packet_forwarder or hal
+	Component string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	Version   string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Problem   string `protobuf:"bytes,3,opt,name=problem,proto3" json:"problem,omitempty"`
+	// How to resolve the problem
+	Remediation string `protobuf:"bytes,4,opt,name=remediation,proto3" json:"remediation,omitempty"`
+}
+
+func (m *VersionWarning) Reset()                    { *m = VersionWarning{} }
+func (m *VersionWarning) String() string            { return proto.CompactTextString(m) }
+func (*VersionWarning) ProtoMessage()               {}
+func (*VersionWarning) Descriptor() ([]byte, []int) { return fileDescriptorRouter, []int{10} }
+
+func (m *VersionWarning) GetComponent() string {
+	if m != nil {
+		return m.Component
+	}
+	return ""
+}
+
+func (m *VersionWarning) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *VersionWarning) GetProblem() string {
+	if m != nil {
+		return m.Problem
+	}
+	return ""
+}
+
+func (m *VersionWarning) GetRemediation() string {
+	if m != nil {
+		return m.Remediation
+	}
+	return ""
+}
+
+type GatewayVersions struct {
+	GatewayId       string            `protobuf:"bytes,1,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	LastSeen        int64             `protobuf:"varint,2,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	Platform        string            `protobuf:"bytes,11,opt,name=platform,proto3" json:"platform,omitempty"`
+	PacketForwarder string            `protobuf:"bytes,12,opt,name=packet_forwarder,json=packetForwarder,proto3" json:"packet_forwarder,omitempty"`
+	Hal             string            `protobuf:"bytes,13,opt,name=hal,proto3" json:"hal,omitempty"`
+	Fpga            uint32            `protobuf:"varint,14,opt,name=fpga,proto3" json:"fpga,omitempty"`
+	Dsp             uint32            `protobuf:"varint,15,opt,name=dsp,proto3" json:"dsp,omitempty"`
+	Warnings        []*VersionWarning `protobuf:"bytes,21,rep,name=warnings" json:"warnings,omitempty"`
+}
+
+func (m *GatewayVersions) Reset()                    { *m = GatewayVersions{} }
+func (m *GatewayVersions) String() string            { return proto.CompactTextString(m) }
+func (*GatewayVersions) ProtoMessage()               {}
+func (*GatewayVersions) Descriptor() ([]byte, []int) { return fileDescriptorRouter, []int{11} }
+
+func (m *GatewayVersions) GetGatewayId() string {
+	if m != nil {
+		return m.GatewayId
+	}
+	return ""
+}
+
+func (m *GatewayVersions) GetLastSeen() int64 {
+	if m != nil {
+		return m.LastSeen
+	}
+	return 0
+}
+
+func (m *GatewayVersions) GetPlatform() string {
+	if m != nil {
+		return m.Platform
+	}
+	return ""
+}
+
+func (m *GatewayVersions) GetPacketForwarder() string {
+	if m != nil {
+		return m.PacketForwarder
+	}
+	return ""
+}
+
+func (m *GatewayVersions) GetHal() string {
+	if m != nil {
+		return m.Hal
+	}
+	return ""
+}
+
+func (m *GatewayVersions) GetFpga() uint32 {
+	if m != nil {
+		return m.Fpga
+	}
+	return 0
+}
+
+func (m *GatewayVersions) GetDsp() uint32 {
+	if m != nil {
+		return m.Dsp
+	}
+	return 0
+}
+
+func (m *GatewayVersions) GetWarnings() []*VersionWarning {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+type GatewayInventoryResponse struct {
+	Gateways []*GatewayVersions `protobuf:"bytes,1,rep,name=gateways" json:"gateways,omitempty"`
+}
+
+func (m *GatewayInventoryResponse) Reset()                    { *m = GatewayInventoryResponse{} }
+func (m *GatewayInventoryResponse) String() string            { return proto.CompactTextString(m) }
+func (*GatewayInventoryResponse) ProtoMessage()               {}
+func (*GatewayInventoryResponse) Descriptor() ([]byte, []int) { return fileDescriptorRouter, []int{12} }
+
+func (m *GatewayInventoryResponse) GetGateways() []*GatewayVersions {
+	if m != nil {
+		return m.Gateways
+	}
+	return nil
+}
+
+// message GatewayGrayListRequest is used to override the gray-listing of a gateway for downlink
+type GatewayGrayListRequest struct {
+	GatewayId string `protobuf:"bytes,1,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	// never to never gray-list the gateway, always to always gray-list it, or empty to gray-list it automatically when
+	// it often fails to transmit downlink messages
+	Override string `protobuf:"bytes,2,opt,name=override,proto3" json:"override,omitempty"`
+}
+
+func (m *GatewayGrayListRequest) Reset()                    { *m = GatewayGrayListRequest{} }
+func (m *GatewayGrayListRequest) String() string            { return proto.CompactTextString(m) }
+func (*GatewayGrayListRequest) ProtoMessage()               {}
+func (*GatewayGrayListRequest) Descriptor() ([]byte, []int) { return fileDescriptorRouter, []int{13} }
+
+func (m *GatewayGrayListRequest) GetGatewayId() string {
+	if m != nil {
+		return m.GatewayId
+	}
+	return ""
+}
+
+func (m *GatewayGrayListRequest) GetOverride() string {
+	if m != nil {
+		return m.Override
+	}
+	return ""
+}
+
+// message GatewayMaintenanceRequest is used to set the maintenance windows of a gateway, during which the Router does
+// not send SNMP traps when the gateway goes offline or comes back online
+type GatewayMaintenanceRequest struct {
+	GatewayId string `protobuf:"bytes,1,opt,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	// The maintenance windows, or empty to remove all maintenance windows
+	MaintenanceWindows []*api.MaintenanceWindow `protobuf:"bytes,2,rep,name=maintenance_windows,json=maintenanceWindows" json:"maintenance_windows,omitempty"`
+}
+
+func (m *GatewayMaintenanceRequest) Reset()         { *m = GatewayMaintenanceRequest{} }
+func (m *GatewayMaintenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*GatewayMaintenanceRequest) ProtoMessage()    {}
+func (*GatewayMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorRouter, []int{14}
+}
+
+func (m *GatewayMaintenanceRequest) GetGatewayId() string {
+	if m != nil {
+		return m.GatewayId
+	}
+	return ""
+}
+
+func (m *GatewayMaintenanceRequest) GetMaintenanceWindows() []*api.MaintenanceWindow {
+	if m != nil {
+		return m.MaintenanceWindows
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SubscribeRequest)(nil), "router.SubscribeRequest")
 	proto.RegisterType((*UplinkMessage)(nil), "router.UplinkMessage")
@@ -356,6 +622,12 @@ func init() {
 	proto.RegisterType((*GatewayStatusResponse)(nil), "router.GatewayStatusResponse")
 	proto.RegisterType((*StatusRequest)(nil), "router.StatusRequest")
 	proto.RegisterType((*Status)(nil), "router.Status")
+	proto.RegisterType((*GatewayInventoryRequest)(nil), "router.GatewayInventoryRequest")
+	proto.RegisterType((*VersionWarning)(nil), "router.VersionWarning")
+	proto.RegisterType((*GatewayVersions)(nil), "router.GatewayVersions")
+	proto.RegisterType((*GatewayInventoryResponse)(nil), "router.GatewayInventoryResponse")
+	proto.RegisterType((*GatewayGrayListRequest)(nil), "router.GatewayGrayListRequest")
+	proto.RegisterType((*GatewayMaintenanceRequest)(nil), "router.GatewayMaintenanceRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -645,6 +917,12 @@ type RouterManagerClient interface {
 	GatewayStatus(ctx context.Context, in *GatewayStatusRequest, opts ...grpc.CallOption) (*GatewayStatusResponse, error)
 	// Network operator requests Router status
 	GetStatus(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*Status, error)
+	// Network operator requests the software versions of the connected gateways
+	GatewayInventory(ctx context.Context, in *GatewayInventoryRequest, opts ...grpc.CallOption) (*GatewayInventoryResponse, error)
+	// Network operator overrides the gray-listing of a gateway for downlink
+	SetGatewayGrayList(ctx context.Context, in *GatewayGrayListRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// Network operator sets the maintenance windows of a gateway
+	SetGatewayMaintenance(ctx context.Context, in *GatewayMaintenanceRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 }
 
 type routerManagerClient struct {
@@ -673,6 +951,33 @@ func (c *routerManagerClient) GetStatus(ctx context.Context, in *StatusRequest,
 	return out, nil
 }
 
+func (c *routerManagerClient) GatewayInventory(ctx context.Context, in *GatewayInventoryRequest, opts ...grpc.CallOption) (*GatewayInventoryResponse, error) {
+	out := new(GatewayInventoryResponse)
+	err := grpc.Invoke(ctx, "/router.RouterManager/GatewayInventory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerManagerClient) SetGatewayGrayList(ctx context.Context, in *GatewayGrayListRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/router.RouterManager/SetGatewayGrayList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerManagerClient) SetGatewayMaintenance(ctx context.Context, in *GatewayMaintenanceRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/router.RouterManager/SetGatewayMaintenance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for RouterManager service
 
 type RouterManagerServer interface {
@@ -681,6 +986,12 @@ type RouterManagerServer interface {
 	GatewayStatus(context.Context, *GatewayStatusRequest) (*GatewayStatusResponse, error)
 	// Network operator requests Router status
 	GetStatus(context.Context, *StatusRequest) (*Status, error)
+	// Network operator requests the software versions of the connected gateways
+	GatewayInventory(context.Context, *GatewayInventoryRequest) (*GatewayInventoryResponse, error)
+	// Network operator overrides the gray-listing of a gateway for downlink
+	SetGatewayGrayList(context.Context, *GatewayGrayListRequest) (*google_protobuf.Empty, error)
+	// Network operator sets the maintenance windows of a gateway
+	SetGatewayMaintenance(context.Context, *GatewayMaintenanceRequest) (*google_protobuf.Empty, error)
 }
 
 func RegisterRouterManagerServer(s *grpc.Server, srv RouterManagerServer) {
@@ -723,6 +1034,60 @@ func _RouterManager_GetStatus_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RouterManager_GatewayInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterManagerServer).GatewayInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/router.RouterManager/GatewayInventory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterManagerServer).GatewayInventory(ctx, req.(*GatewayInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouterManager_SetGatewayGrayList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayGrayListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterManagerServer).SetGatewayGrayList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/router.RouterManager/SetGatewayGrayList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterManagerServer).SetGatewayGrayList(ctx, req.(*GatewayGrayListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouterManager_SetGatewayMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterManagerServer).SetGatewayMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/router.RouterManager/SetGatewayMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterManagerServer).SetGatewayMaintenance(ctx, req.(*GatewayMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RouterManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "router.RouterManager",
 	HandlerType: (*RouterManagerServer)(nil),
@@ -735,6 +1100,18 @@ var _RouterManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetStatus",
 			Handler:    _RouterManager_GetStatus_Handler,
 		},
+		{
+			MethodName: "GatewayInventory",
+			Handler:    _RouterManager_GatewayInventory_Handler,
+		},
+		{
+			MethodName: "SetGatewayGrayList",
+			Handler:    _RouterManager_SetGatewayGrayList_Handler,
+		},
+		{
+			MethodName: "SetGatewayMaintenance",
+			Handler:    _RouterManager_SetGatewayMaintenance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/TheThingsNetwork/ttn/api/router/router.proto",
@@ -1064,6 +1441,44 @@ func (m *GatewayStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n16
 	}
+	if m.QueuedDownlinks != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(m.QueuedDownlinks))
+	}
+	if m.TxSuccessRate != 0 {
+		dAtA[i] = 0x25
+		i++
+		i = encodeFixed32Router(dAtA, i, uint32(math.Float32bits(float32(m.TxSuccessRate))))
+	}
+	if m.GrayListed {
+		dAtA[i] = 0x28
+		i++
+		if m.GrayListed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.GrayListOverride) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(len(m.GrayListOverride)))
+		i += copy(dAtA[i:], m.GrayListOverride)
+	}
+	if len(m.MaintenanceWindows) > 0 {
+		for _, msg := range m.MaintenanceWindows {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintRouter(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -1174,29 +1589,282 @@ func (m *Status) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintRouter(dAtA, i, uint64(m.ConnectedBrokers))
 	}
+	if len(m.ConnectedGatewaysPerShard) > 0 {
+		dAtA24 := make([]byte, len(m.ConnectedGatewaysPerShard)*10)
+		var j23 int
+		for _, num := range m.ConnectedGatewaysPerShard {
+			for num >= 1<<7 {
+				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j23++
+			}
+			dAtA24[j23] = uint8(num)
+			j23++
+		}
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(j23))
+		i += copy(dAtA[i:], dAtA24[:j23])
+	}
+	if m.QueuedDownlinks != 0 {
+		dAtA[i] = 0xc0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(m.QueuedDownlinks))
+	}
 	return i, nil
 }
 
-func encodeFixed64Router(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Router(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
+func (m *GatewayInventoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func encodeVarintRouter(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
+
+func (m *GatewayInventoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *VersionWarning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionWarning) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Component) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(len(m.Component)))
+		i += copy(dAtA[i:], m.Component)
+	}
+	if len(m.Version) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if len(m.Problem) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(len(m.Problem)))
+		i += copy(dAtA[i:], m.Problem)
+	}
+	if len(m.Remediation) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(len(m.Remediation)))
+		i += copy(dAtA[i:], m.Remediation)
+	}
+	return i, nil
+}
+
+func (m *GatewayVersions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayVersions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.GatewayId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(len(m.GatewayId)))
+		i += copy(dAtA[i:], m.GatewayId)
+	}
+	if m.LastSeen != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(m.LastSeen))
+	}
+	if len(m.Platform) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(len(m.Platform)))
+		i += copy(dAtA[i:], m.Platform)
+	}
+	if len(m.PacketForwarder) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(len(m.PacketForwarder)))
+		i += copy(dAtA[i:], m.PacketForwarder)
+	}
+	if len(m.Hal) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(len(m.Hal)))
+		i += copy(dAtA[i:], m.Hal)
+	}
+	if m.Fpga != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(m.Fpga))
+	}
+	if m.Dsp != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(m.Dsp))
+	}
+	if len(m.Warnings) > 0 {
+		for _, msg := range m.Warnings {
+			dAtA[i] = 0xaa
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintRouter(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GatewayInventoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayInventoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Gateways) > 0 {
+		for _, msg := range m.Gateways {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRouter(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GatewayGrayListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayGrayListRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.GatewayId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(len(m.GatewayId)))
+		i += copy(dAtA[i:], m.GatewayId)
+	}
+	if len(m.Override) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(len(m.Override)))
+		i += copy(dAtA[i:], m.Override)
+	}
+	return i, nil
+}
+
+func (m *GatewayMaintenanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayMaintenanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.GatewayId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRouter(dAtA, i, uint64(len(m.GatewayId)))
+		i += copy(dAtA[i:], m.GatewayId)
+	}
+	if len(m.MaintenanceWindows) > 0 {
+		for _, msg := range m.MaintenanceWindows {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintRouter(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func encodeFixed64Router(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Router(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintRouter(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
@@ -1326,6 +1994,25 @@ func (m *GatewayStatusResponse) Size() (n int) {
 		l = m.Status.Size()
 		n += 1 + l + sovRouter(uint64(l))
 	}
+	if m.QueuedDownlinks != 0 {
+		n += 1 + sovRouter(uint64(m.QueuedDownlinks))
+	}
+	if m.TxSuccessRate != 0 {
+		n += 5
+	}
+	if m.GrayListed {
+		n += 2
+	}
+	l = len(m.GrayListOverride)
+	if l > 0 {
+		n += 1 + l + sovRouter(uint64(l))
+	}
+	if len(m.MaintenanceWindows) > 0 {
+		for _, e := range m.MaintenanceWindows {
+			l = e.Size()
+			n += 1 + l + sovRouter(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1368,6 +2055,123 @@ func (m *Status) Size() (n int) {
 	if m.ConnectedBrokers != 0 {
 		n += 2 + sovRouter(uint64(m.ConnectedBrokers))
 	}
+	if len(m.ConnectedGatewaysPerShard) > 0 {
+		l = 0
+		for _, e := range m.ConnectedGatewaysPerShard {
+			l += sovRouter(uint64(e))
+		}
+		n += 2 + sovRouter(uint64(l)) + l
+	}
+	if m.QueuedDownlinks != 0 {
+		n += 2 + sovRouter(uint64(m.QueuedDownlinks))
+	}
+	return n
+}
+
+func (m *GatewayInventoryRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *VersionWarning) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Component)
+	if l > 0 {
+		n += 1 + l + sovRouter(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovRouter(uint64(l))
+	}
+	l = len(m.Problem)
+	if l > 0 {
+		n += 1 + l + sovRouter(uint64(l))
+	}
+	l = len(m.Remediation)
+	if l > 0 {
+		n += 1 + l + sovRouter(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayVersions) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.GatewayId)
+	if l > 0 {
+		n += 1 + l + sovRouter(uint64(l))
+	}
+	if m.LastSeen != 0 {
+		n += 1 + sovRouter(uint64(m.LastSeen))
+	}
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovRouter(uint64(l))
+	}
+	l = len(m.PacketForwarder)
+	if l > 0 {
+		n += 1 + l + sovRouter(uint64(l))
+	}
+	l = len(m.Hal)
+	if l > 0 {
+		n += 1 + l + sovRouter(uint64(l))
+	}
+	if m.Fpga != 0 {
+		n += 1 + sovRouter(uint64(m.Fpga))
+	}
+	if m.Dsp != 0 {
+		n += 1 + sovRouter(uint64(m.Dsp))
+	}
+	if len(m.Warnings) > 0 {
+		for _, e := range m.Warnings {
+			l = e.Size()
+			n += 2 + l + sovRouter(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GatewayInventoryResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Gateways) > 0 {
+		for _, e := range m.Gateways {
+			l = e.Size()
+			n += 1 + l + sovRouter(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GatewayGrayListRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.GatewayId)
+	if l > 0 {
+		n += 1 + l + sovRouter(uint64(l))
+	}
+	l = len(m.Override)
+	if l > 0 {
+		n += 1 + l + sovRouter(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayMaintenanceRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.GatewayId)
+	if l > 0 {
+		n += 1 + l + sovRouter(uint64(l))
+	}
+	if len(m.MaintenanceWindows) > 0 {
+		for _, e := range m.MaintenanceWindows {
+			l = e.Size()
+			n += 1 + l + sovRouter(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2380,7 +3184,120 @@ func (m *GatewayStatusResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedDownlinks", wireType)
+			}
+			m.QueuedDownlinks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedDownlinks |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSuccessRate", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.TxSuccessRate = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrayListed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GrayListed = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrayListOverride", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrayListOverride = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaintenanceWindows = append(m.MaintenanceWindows, &api.MaintenanceWindow{})
+			if err := m.MaintenanceWindows[len(m.MaintenanceWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
 			iNdEx = preIndex
 			skippy, err := skipRouter(dAtA[iNdEx:])
 			if err != nil {
@@ -2716,6 +3633,137 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 23:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRouter
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ConnectedGatewaysPerShard = append(m.ConnectedGatewaysPerShard, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRouter
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRouter
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRouter
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ConnectedGatewaysPerShard = append(m.ConnectedGatewaysPerShard, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectedGatewaysPerShard", wireType)
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedDownlinks", wireType)
+			}
+			m.QueuedDownlinks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedDownlinks |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRouter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayInventoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayInventoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayInventoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRouter(dAtA[iNdEx:])
@@ -2737,6 +3785,731 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
+func (m *VersionWarning) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionWarning: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionWarning: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Component = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Problem", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Problem = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remediation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remediation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRouter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *GatewayVersions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayVersions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayVersions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeen", wireType)
+			}
+			m.LastSeen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSeen |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketForwarder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketForwarder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fpga", wireType)
+			}
+			m.Fpga = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fpga |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dsp", wireType)
+			}
+			m.Dsp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dsp |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warnings = append(m.Warnings, &VersionWarning{})
+			if err := m.Warnings[len(m.Warnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRouter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *GatewayInventoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayInventoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayInventoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gateways", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gateways = append(m.Gateways, &GatewayVersions{})
+			if err := m.Gateways[len(m.Gateways)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRouter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *GatewayGrayListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayGrayListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayGrayListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Override", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Override = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRouter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *GatewayMaintenanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayMaintenanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayMaintenanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaintenanceWindows = append(m.MaintenanceWindows, &api.MaintenanceWindow{})
+			if err := m.MaintenanceWindows[len(m.MaintenanceWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRouter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipRouter(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2847,61 +4620,88 @@ func init() {
 }
 
 var fileDescriptorRouter = []byte{
-	// 884 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
-	0x14, 0x05, 0x1d, 0x94, 0xb6, 0xae, 0x25, 0x5b, 0x1e, 0x5b, 0x36, 0xa3, 0xc4, 0x0f, 0x70, 0xd1,
-	0x1a, 0x4d, 0x43, 0xd5, 0x2a, 0x82, 0x3e, 0x16, 0x45, 0xed, 0xd8, 0x08, 0x02, 0x54, 0x41, 0x41,
-	0x3b, 0x9b, 0x02, 0x85, 0x30, 0xa2, 0x6e, 0x68, 0xc2, 0x12, 0x87, 0xe5, 0x0c, 0xe5, 0xe8, 0x2f,
-	0xda, 0x0f, 0xe8, 0xff, 0x74, 0xd9, 0x75, 0x17, 0x45, 0xe1, 0x0f, 0xe8, 0xb2, 0xbb, 0x02, 0x05,
-	0xe7, 0x41, 0x8a, 0x92, 0xdd, 0xa6, 0x8f, 0x6c, 0x44, 0xce, 0x3d, 0xe7, 0x1e, 0xce, 0x9c, 0xb9,
-	0x33, 0x57, 0xf0, 0x71, 0x18, 0x89, 0xcb, 0x6c, 0xe0, 0x05, 0x6c, 0xdc, 0xb9, 0xb8, 0xc4, 0x8b,
-	0xcb, 0x28, 0x0e, 0xf9, 0x0b, 0x14, 0xd7, 0x2c, 0xbd, 0xea, 0x08, 0x11, 0x77, 0x68, 0x12, 0x75,
-	0x52, 0x96, 0x09, 0x4c, 0xf5, 0xc3, 0x4b, 0x52, 0x26, 0x18, 0xb1, 0xd5, 0xa8, 0xfd, 0x20, 0x64,
-	0x2c, 0x1c, 0x61, 0x47, 0x46, 0x07, 0xd9, 0xab, 0x0e, 0x8e, 0x13, 0x31, 0x55, 0xa4, 0xf6, 0xe3,
-	0x19, 0xf5, 0x90, 0x85, 0xac, 0x64, 0xe5, 0x23, 0x39, 0x90, 0x6f, 0x9a, 0xbe, 0x61, 0x3e, 0x48,
-	0x93, 0x48, 0x87, 0xf6, 0x4d, 0x48, 0x0e, 0x03, 0x36, 0x2a, 0x5e, 0x34, 0x61, 0xd7, 0x10, 0x42,
-	0x2a, 0xf0, 0x9a, 0x4e, 0xcd, 0x53, 0xc3, 0xf7, 0x0d, 0x2c, 0x52, 0x1a, 0xa0, 0xfa, 0x55, 0x90,
-	0x4b, 0xa0, 0x79, 0x9e, 0x0d, 0x78, 0x90, 0x46, 0x03, 0xf4, 0xf1, 0xdb, 0x0c, 0xb9, 0x70, 0xff,
-	0xb0, 0xa0, 0xf1, 0x32, 0x19, 0x45, 0xf1, 0x55, 0x0f, 0x39, 0xa7, 0x21, 0x12, 0x07, 0x96, 0x13,
-	0x3a, 0x1d, 0x31, 0x3a, 0x74, 0xac, 0x03, 0xeb, 0xb0, 0xee, 0x9b, 0x21, 0x79, 0x04, 0xcb, 0x63,
-	0x45, 0x72, 0x96, 0x0e, 0xac, 0xc3, 0xd5, 0xee, 0x86, 0x57, 0xcc, 0x4d, 0x67, 0xfb, 0x86, 0x41,
-	0x8e, 0x61, 0xc3, 0x80, 0xfd, 0x31, 0x0a, 0x3a, 0xa4, 0x82, 0x3a, 0xab, 0x32, 0x6d, 0xab, 0x4c,
-	0xf3, 0x5f, 0xf7, 0x34, 0xe6, 0x37, 0x4d, 0xd0, 0x44, 0xc8, 0xe7, 0xd0, 0xd4, 0x6b, 0x2b, 0x15,
-	0xea, 0x52, 0x61, 0xd3, 0x33, 0x8b, 0x9e, 0x11, 0x58, 0xd7, 0xb1, 0x22, 0xdf, 0x85, 0x77, 0xe4,
-	0xf2, 0x9d, 0x96, 0x4c, 0xaa, 0x7b, 0xca, 0x8c, 0x8b, 0xfc, 0xd7, 0x57, 0x90, 0xfb, 0xc3, 0x12,
-	0xac, 0x9f, 0xb2, 0xeb, 0xf8, 0x2d, 0x38, 0xf0, 0x15, 0x6c, 0x17, 0x0e, 0x04, 0x2c, 0x7e, 0x15,
-	0x85, 0x59, 0x4a, 0x45, 0xc4, 0x62, 0x6d, 0xc3, 0xfd, 0x32, 0xf7, 0xe2, 0xf5, 0xd3, 0x59, 0x82,
-	0xdf, 0x32, 0x48, 0x25, 0x4c, 0x7a, 0xd0, 0x32, 0x86, 0x54, 0x05, 0x95, 0x2b, 0x4e, 0xe1, 0xca,
-	0xbc, 0xde, 0x96, 0x06, 0xaa, 0x72, 0x6f, 0xe2, 0xcf, 0xef, 0xf7, 0x60, 0xe7, 0x14, 0x27, 0x51,
-	0x80, 0xc7, 0x81, 0x88, 0x26, 0x4a, 0x4e, 0xd5, 0xce, 0xff, 0xe5, 0xd3, 0x0b, 0x58, 0x1e, 0xe2,
-	0xa4, 0x8f, 0x59, 0x24, 0x8d, 0xa9, 0x9f, 0x3c, 0xf9, 0xf9, 0x97, 0xfd, 0xa3, 0xbf, 0x3b, 0xa6,
-	0x01, 0x4b, 0xb1, 0x23, 0xa6, 0x09, 0x72, 0xef, 0x14, 0x27, 0x67, 0x2f, 0x9f, 0xfb, 0xf6, 0x10,
-	0x27, 0x67, 0x59, 0x94, 0xeb, 0xd1, 0x24, 0x91, 0x7a, 0xf5, 0x7f, 0xa5, 0x77, 0x9c, 0x24, 0x52,
-	0x8f, 0x26, 0x49, 0xae, 0x77, 0x6b, 0x25, 0xb7, 0xfe, 0x73, 0x25, 0x6f, 0xff, 0x83, 0x4a, 0xee,
-	0xc1, 0x26, 0x2d, 0xec, 0x2f, 0x25, 0x76, 0xa4, 0xc4, 0xc3, 0x72, 0x12, 0xe5, 0x1e, 0x15, 0x5a,
-	0x84, 0x2e, 0xc4, 0xca, 0x8d, 0xdf, 0xbf, 0x7b, 0xe3, 0xdb, 0xe0, 0x2c, 0xee, 0x3b, 0x4f, 0x58,
-	0xcc, 0xd1, 0x7d, 0x02, 0x5b, 0xcf, 0xd4, 0x0c, 0xcf, 0x05, 0x15, 0x19, 0x37, 0x05, 0xb1, 0x0b,
-	0x60, 0x96, 0x19, 0xa9, 0x9a, 0xa8, 0xf9, 0x35, 0x1d, 0x79, 0x3e, 0x74, 0xbf, 0x81, 0xd6, 0x5c,
-	0x9a, 0xd2, 0x23, 0x0f, 0xa0, 0x36, 0xa2, 0x5c, 0xf4, 0x39, 0x62, 0x2c, 0xd3, 0xee, 0xf9, 0x2b,
-	0x79, 0xe0, 0x1c, 0x31, 0x26, 0xef, 0x81, 0xcd, 0x25, 0x5d, 0x97, 0xd2, 0x7a, 0xe1, 0x98, 0x56,
-	0xd1, 0xb0, 0xbb, 0x0e, 0x8d, 0xca, 0x74, 0xdc, 0xdf, 0x96, 0xc0, 0x56, 0x11, 0x72, 0x08, 0x36,
-	0x9f, 0x72, 0x81, 0x63, 0x29, 0xbf, 0xda, 0x6d, 0x7a, 0xf9, 0x8d, 0x7b, 0x2e, 0x43, 0x39, 0x25,
-	0x57, 0x91, 0x03, 0x72, 0x04, 0xb5, 0x80, 0x8d, 0x13, 0x16, 0x63, 0x2c, 0xf4, 0x17, 0x37, 0x25,
-	0xf9, 0xa9, 0x89, 0x2a, 0x7e, 0xc9, 0x22, 0x47, 0xb0, 0x66, 0x96, 0xad, 0x67, 0xaa, 0x0e, 0x38,
-	0xc8, 0x3c, 0x9f, 0x0a, 0xe4, 0x7e, 0x23, 0x9c, 0x5d, 0x39, 0x71, 0xc1, 0xce, 0xe4, 0xad, 0xab,
-	0x8f, 0xee, 0x2c, 0x55, 0x23, 0xe4, 0x5d, 0x58, 0x19, 0xea, 0x9b, 0xc9, 0x69, 0x2c, 0xb0, 0x0a,
-	0x8c, 0x7c, 0x00, 0xab, 0xe5, 0x1e, 0x73, 0x67, 0x6d, 0x81, 0x3a, 0x0b, 0x93, 0xc7, 0x40, 0x02,
-	0x16, 0xc7, 0x18, 0x08, 0x1c, 0xf6, 0xf5, 0xa4, 0xb8, 0x2c, 0xe7, 0x86, 0xbf, 0x51, 0x20, 0x7a,
-	0x9f, 0x38, 0x79, 0x04, 0x65, 0xb0, 0x3f, 0x48, 0xd9, 0x15, 0xa6, 0x5c, 0x96, 0x6e, 0xc3, 0x6f,
-	0x16, 0xc0, 0x89, 0x8a, 0x77, 0xbf, 0x5b, 0x02, 0xdb, 0x97, 0x5d, 0x92, 0x7c, 0x06, 0x8d, 0xca,
-	0x5e, 0x93, 0xf9, 0x6d, 0x6b, 0x6f, 0x7b, 0xaa, 0x91, 0x7a, 0xa6, 0x45, 0x7a, 0x67, 0x79, 0x23,
-	0x3d, 0xb4, 0xc8, 0xa7, 0x60, 0xab, 0x96, 0x44, 0x5a, 0x9e, 0x6e, 0xc1, 0x95, 0x16, 0xf5, 0x17,
-	0xa9, 0x5f, 0x40, 0xad, 0x68, 0x71, 0xc4, 0x31, 0xd9, 0xf3, 0x5d, 0xaf, 0xbd, 0x63, 0x90, 0xb9,
-	0xab, 0xff, 0x43, 0x8b, 0xf4, 0x60, 0x45, 0x57, 0x3c, 0x92, 0xfd, 0x82, 0x76, 0xfb, 0x0d, 0xd8,
-	0x3e, 0xb8, 0x9b, 0xa0, 0x4a, 0xbb, 0xfb, 0xbd, 0x05, 0x0d, 0x65, 0x49, 0x8f, 0xc6, 0x34, 0xc4,
-	0x94, 0x7c, 0x39, 0xef, 0xcc, 0x43, 0x23, 0x72, 0xdb, 0x99, 0x6a, 0xef, 0xde, 0x81, 0xea, 0xa3,
-	0xd3, 0x85, 0xda, 0x33, 0x14, 0x5a, 0xa9, 0xb0, 0xab, 0x2a, 0xb1, 0x56, 0x0d, 0x9f, 0x7c, 0xf2,
-	0xe3, 0xcd, 0x9e, 0xf5, 0xd3, 0xcd, 0x9e, 0xf5, 0xeb, 0xcd, 0x9e, 0xf5, 0xf5, 0xfb, 0x6f, 0xfe,
-	0x87, 0x68, 0x60, 0x4b, 0xc3, 0x3f, 0xfa, 0x33, 0x00, 0x00, 0xff, 0xff, 0x83, 0xd2, 0x76, 0xdc,
-	0x45, 0x09, 0x00, 0x00,
+	// 1319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb5, 0x57, 0x4d, 0x4f, 0x1b, 0x47,
+	0x18, 0x96, 0x6d, 0x62, 0xec, 0x17, 0x8c, 0xcd, 0x80, 0x61, 0x71, 0x20, 0xd0, 0x3d, 0xb4, 0xf4,
+	0x23, 0xa6, 0x21, 0x8a, 0xfa, 0x71, 0x68, 0x0b, 0x09, 0x45, 0x48, 0x90, 0x44, 0x63, 0x68, 0xa4,
+	0x5e, 0x56, 0xe3, 0xf5, 0x60, 0x56, 0xd8, 0xbb, 0xdb, 0x9d, 0x31, 0x1f, 0xb7, 0x4a, 0xed, 0xa9,
+	0xa7, 0xfe, 0x81, 0xfe, 0x9f, 0x1c, 0x7b, 0xce, 0xa1, 0xaa, 0xfa, 0x23, 0x7a, 0xab, 0xd4, 0xd9,
+	0xf9, 0xd8, 0xb5, 0xd7, 0x38, 0x21, 0xfd, 0x38, 0xd8, 0xde, 0x79, 0x3f, 0x9e, 0x9d, 0x79, 0xde,
+	0x67, 0xde, 0x19, 0xc3, 0x27, 0x5d, 0x8f, 0x9f, 0x0d, 0xda, 0x4d, 0x37, 0xe8, 0x6f, 0x1d, 0x9f,
+	0xd1, 0xe3, 0x33, 0xcf, 0xef, 0xb2, 0xa7, 0x94, 0x5f, 0x06, 0xd1, 0xf9, 0x16, 0xe7, 0xfe, 0x16,
+	0x09, 0xbd, 0xad, 0x28, 0x18, 0x70, 0x1a, 0xe9, 0x9f, 0x66, 0x18, 0x05, 0x3c, 0x40, 0x45, 0x35,
+	0x6a, 0xdc, 0xed, 0x06, 0x41, 0xb7, 0x47, 0xb7, 0xa4, 0xb5, 0x3d, 0x38, 0xdd, 0xa2, 0xfd, 0x90,
+	0x5f, 0xab, 0xa0, 0xc6, 0xfd, 0x21, 0xf4, 0x6e, 0xd0, 0x0d, 0xd2, 0xa8, 0x78, 0x24, 0x07, 0xf2,
+	0x49, 0x87, 0xcf, 0x9b, 0x17, 0x8a, 0x8f, 0x36, 0xad, 0x1b, 0x93, 0x1c, 0xba, 0x41, 0x2f, 0x79,
+	0xd0, 0x01, 0x6b, 0x26, 0xa0, 0x4b, 0x38, 0xbd, 0x24, 0xd7, 0xe6, 0x57, 0xbb, 0x57, 0x8c, 0x9b,
+	0x47, 0xc4, 0xa5, 0xea, 0x5b, 0xb9, 0x6c, 0x04, 0xb5, 0xd6, 0xa0, 0xcd, 0xdc, 0xc8, 0x6b, 0x53,
+	0x4c, 0xbf, 0x1b, 0x50, 0xc6, 0xed, 0xbf, 0x72, 0x50, 0x39, 0x09, 0x7b, 0x9e, 0x7f, 0x7e, 0x44,
+	0x19, 0x23, 0x5d, 0x8a, 0x2c, 0x98, 0x0e, 0xc9, 0x75, 0x2f, 0x20, 0x1d, 0x2b, 0xb7, 0x91, 0xdb,
+	0x9c, 0xc5, 0x66, 0x88, 0x3e, 0x84, 0xe9, 0xbe, 0x0a, 0xb2, 0xf2, 0xc2, 0x33, 0xb3, 0x3d, 0xdf,
+	0x4c, 0xe6, 0xa6, 0xb3, 0xb1, 0x89, 0x40, 0x3b, 0x30, 0x6f, 0x9c, 0x4e, 0x9f, 0x72, 0xd2, 0x21,
+	0x9c, 0x58, 0x33, 0x32, 0x6d, 0x31, 0x4d, 0xc3, 0x57, 0x47, 0xda, 0x87, 0x6b, 0xc6, 0x68, 0x2c,
+	0xe8, 0x0b, 0xa8, 0xe9, 0xb5, 0xa5, 0x08, 0xb3, 0x12, 0x61, 0xa1, 0x69, 0x16, 0x3d, 0x04, 0x50,
+	0xd5, 0xb6, 0x24, 0xdf, 0x86, 0x3b, 0x72, 0xf9, 0x56, 0x5d, 0x26, 0xcd, 0x36, 0x15, 0x19, 0xc7,
+	0xf1, 0x37, 0x56, 0x2e, 0xfb, 0x97, 0x3c, 0x54, 0x9f, 0x04, 0x97, 0xfe, 0xff, 0xc0, 0xc0, 0x73,
+	0x58, 0x4a, 0x18, 0x70, 0x03, 0xff, 0xd4, 0xeb, 0x0e, 0x22, 0xc2, 0xbd, 0xc0, 0xd7, 0x34, 0xac,
+	0xa4, 0xb9, 0xc7, 0x57, 0x8f, 0x87, 0x03, 0x70, 0xdd, 0x78, 0x46, 0xcc, 0xe8, 0x08, 0xea, 0x86,
+	0x90, 0x51, 0x40, 0xc5, 0x8a, 0x95, 0xb0, 0x92, 0xc5, 0x5b, 0xd4, 0x8e, 0x51, 0xb8, 0xdb, 0xf0,
+	0xf3, 0x67, 0x01, 0x96, 0x9f, 0xd0, 0x0b, 0xcf, 0xa5, 0x3b, 0x2e, 0xf7, 0x2e, 0x14, 0x9c, 0xd2,
+	0xce, 0x7f, 0xc5, 0xd3, 0x53, 0x98, 0xee, 0xd0, 0x0b, 0x87, 0x0e, 0x3c, 0x49, 0xcc, 0xec, 0xee,
+	0xa3, 0x57, 0xbf, 0xad, 0x3f, 0x78, 0xd3, 0x36, 0x75, 0x83, 0x48, 0xa8, 0xfb, 0x3a, 0xa4, 0xac,
+	0x29, 0xe6, 0xb7, 0x77, 0x72, 0x80, 0x8b, 0x02, 0x65, 0x6f, 0xe0, 0xc5, 0x78, 0x24, 0x0c, 0x25,
+	0xde, 0xec, 0x3f, 0xc2, 0xdb, 0x09, 0x43, 0x89, 0x27, 0x50, 0x62, 0xbc, 0x1b, 0x95, 0x5c, 0xff,
+	0xd7, 0x4a, 0x5e, 0x7a, 0x0b, 0x25, 0x1f, 0xc1, 0x02, 0x49, 0xe8, 0x4f, 0x21, 0x96, 0x25, 0xc4,
+	0x6a, 0x3a, 0x89, 0xb4, 0x46, 0x09, 0x16, 0x22, 0x63, 0xb6, 0xb4, 0xf0, 0xeb, 0x93, 0x0b, 0xdf,
+	0x00, 0x6b, 0xbc, 0xee, 0x2c, 0x0c, 0x7c, 0x46, 0xed, 0x47, 0xb0, 0xb8, 0xaf, 0x66, 0xd8, 0xe2,
+	0x84, 0x0f, 0x98, 0x11, 0xc4, 0x1a, 0x80, 0x59, 0xa6, 0xa7, 0x34, 0x51, 0xc6, 0x65, 0x6d, 0x39,
+	0xe8, 0xd8, 0x2f, 0xf3, 0x50, 0xcf, 0xe4, 0x29, 0x40, 0x74, 0x17, 0xca, 0x3d, 0xc2, 0xb8, 0xc3,
+	0x28, 0xf5, 0x65, 0x5e, 0x01, 0x97, 0x62, 0x43, 0x4b, 0x8c, 0xd1, 0x7b, 0x50, 0x64, 0x32, 0x5c,
+	0x6b, 0xa9, 0x9a, 0x50, 0xa6, 0x51, 0xb4, 0x1b, 0xbd, 0x0f, 0x35, 0x31, 0x8f, 0x01, 0xed, 0x38,
+	0x1d, 0xbd, 0xa3, 0x99, 0x55, 0x10, 0x29, 0x15, 0x5c, 0x55, 0x76, 0xb3, 0xd1, 0x19, 0x7a, 0x17,
+	0xaa, 0xfc, 0xca, 0x61, 0x03, 0xd7, 0x15, 0x22, 0x74, 0xc4, 0x7e, 0xa0, 0xd6, 0x94, 0x88, 0xcc,
+	0xe3, 0x0a, 0xbf, 0x6a, 0x29, 0x2b, 0x16, 0x46, 0xb4, 0x0e, 0x33, 0xdd, 0x48, 0x2c, 0xa7, 0xe7,
+	0x31, 0x4e, 0x3b, 0xd6, 0x1d, 0x11, 0x53, 0xc2, 0x10, 0x9b, 0x0e, 0xa5, 0x05, 0x7d, 0x04, 0x28,
+	0x09, 0x70, 0x82, 0x0b, 0x1a, 0x45, 0x5e, 0x87, 0x5a, 0x45, 0xb9, 0xf4, 0x9a, 0x89, 0x7b, 0xa6,
+	0xed, 0x68, 0x1f, 0x16, 0xfa, 0xc4, 0xf3, 0x39, 0xf5, 0x89, 0xef, 0x52, 0xe7, 0xd2, 0xf3, 0xc5,
+	0x4c, 0x99, 0x35, 0xbd, 0x51, 0x10, 0xeb, 0x5a, 0x6a, 0xc6, 0xa7, 0xc0, 0x51, 0xea, 0x7f, 0x21,
+	0xdd, 0x18, 0xf5, 0xb3, 0x26, 0x66, 0x57, 0xa1, 0x32, 0x42, 0xbd, 0xfd, 0xaa, 0x00, 0x45, 0x65,
+	0x41, 0x9b, 0x82, 0xaf, 0x6b, 0x31, 0xb9, 0xbe, 0x64, 0x72, 0x66, 0xbb, 0x26, 0x71, 0x5b, 0xd2,
+	0x14, 0x87, 0xc4, 0x84, 0xc9, 0x01, 0x7a, 0x00, 0x65, 0xb1, 0x1f, 0x44, 0x09, 0xa8, 0xcf, 0x35,
+	0xb9, 0x0b, 0x32, 0xf8, 0xb1, 0xb1, 0xaa, 0xf8, 0x34, 0x4a, 0xa4, 0xcc, 0x99, 0x12, 0xeb, 0xa2,
+	0xa8, 0x66, 0x06, 0x32, 0x2f, 0xe6, 0x8c, 0xe1, 0x4a, 0x77, 0xb8, 0xc8, 0x42, 0x6d, 0xc5, 0x81,
+	0x3c, 0x61, 0x74, 0x9b, 0x1a, 0x0e, 0xd5, 0x1e, 0x51, 0x8f, 0x92, 0xa9, 0x99, 0x55, 0x19, 0x8b,
+	0x4a, 0x7c, 0x82, 0xee, 0x99, 0x54, 0xcf, 0xcc, 0x9a, 0x1b, 0x0b, 0x1d, 0x76, 0xa3, 0xfb, 0x80,
+	0x44, 0x9f, 0xf4, 0xa9, 0x2b, 0x2a, 0xe5, 0xe8, 0x49, 0x31, 0xb9, 0x75, 0x2b, 0x78, 0x3e, 0xf1,
+	0x68, 0x49, 0x32, 0xd1, 0xb5, 0x52, 0xa3, 0xd3, 0x8e, 0x82, 0x73, 0x1a, 0x31, 0xb9, 0x4d, 0x2b,
+	0xb8, 0x96, 0x38, 0x76, 0x95, 0x1d, 0x7d, 0x09, 0xab, 0xe3, 0xd8, 0x4e, 0x48, 0x23, 0x87, 0x9d,
+	0x91, 0xa8, 0x23, 0xf6, 0x66, 0x41, 0xe4, 0xad, 0x8c, 0xbd, 0xe5, 0x39, 0x8d, 0x5a, 0x71, 0xc0,
+	0x8d, 0x6a, 0xb5, 0x6e, 0x54, 0xab, 0xbd, 0x02, 0xcb, 0x3a, 0xfd, 0xc0, 0xbf, 0x10, 0x55, 0x08,
+	0xa2, 0x6b, 0x53, 0xf7, 0x1f, 0x73, 0x30, 0xf7, 0x8d, 0x98, 0x8f, 0x58, 0xef, 0x0b, 0x12, 0xf9,
+	0xa2, 0xab, 0xa1, 0xd5, 0xe1, 0xaa, 0xea, 0x4d, 0x98, 0x16, 0x50, 0x34, 0xed, 0x0b, 0x15, 0x2f,
+	0x2b, 0x5e, 0xc6, 0x66, 0x28, 0xdb, 0x79, 0x14, 0xb4, 0x7b, 0x42, 0x38, 0x05, 0xe5, 0xd1, 0x43,
+	0xb4, 0x01, 0x33, 0x11, 0xed, 0xd3, 0x8e, 0xa7, 0x4e, 0x9b, 0x29, 0xe9, 0x1d, 0x36, 0xd9, 0xdf,
+	0x8b, 0x63, 0x54, 0x4f, 0x51, 0xcf, 0x86, 0xbd, 0xa1, 0x1b, 0x8c, 0xee, 0xf9, 0x7c, 0x66, 0xcf,
+	0x37, 0xa0, 0x14, 0xf6, 0x08, 0x3f, 0x0d, 0xa2, 0xbe, 0x14, 0x58, 0x19, 0x27, 0xe3, 0x98, 0xb8,
+	0x90, 0xb8, 0xe7, 0x94, 0x3b, 0x62, 0x78, 0x29, 0xa8, 0xa4, 0x91, 0x54, 0x56, 0x19, 0x57, 0x95,
+	0xfd, 0x6b, 0x63, 0x46, 0x35, 0x28, 0x9c, 0x91, 0x9e, 0x54, 0x54, 0x19, 0xc7, 0x8f, 0x08, 0xc1,
+	0xd4, 0x69, 0xd8, 0x25, 0x52, 0x39, 0x15, 0x2c, 0x9f, 0xe3, 0xa8, 0x0e, 0x0b, 0xad, 0xaa, 0x34,
+	0xc5, 0x8f, 0x68, 0x1b, 0x4a, 0x97, 0x8a, 0xcd, 0x58, 0x2e, 0x6a, 0x73, 0xea, 0xcb, 0xe0, 0x28,
+	0xd9, 0x38, 0x89, 0xb3, 0x9f, 0x81, 0x35, 0x5e, 0x24, 0xdd, 0xdf, 0x1e, 0x42, 0x29, 0x91, 0x5f,
+	0x4e, 0xe2, 0x2d, 0x1b, 0xbc, 0x0c, 0x6b, 0x38, 0x09, 0xb4, 0x5b, 0xb0, 0xa4, 0x9d, 0xfb, 0xba,
+	0x8f, 0xdc, 0xae, 0xcf, 0xc6, 0xe4, 0x25, 0x9d, 0x48, 0xd5, 0x38, 0x19, 0xdb, 0x3f, 0xe4, 0x60,
+	0x45, 0xa3, 0x0e, 0x75, 0x9a, 0x5b, 0x02, 0x4f, 0x68, 0x5f, 0xf9, 0xb7, 0x6d, 0x5f, 0xdb, 0x3f,
+	0xe7, 0xa1, 0x88, 0xe5, 0xfa, 0xd1, 0xe7, 0x50, 0x19, 0x39, 0x13, 0x50, 0xb6, 0xbd, 0x37, 0x96,
+	0x9a, 0xea, 0xc6, 0xdd, 0x34, 0x77, 0xe9, 0xe6, 0x5e, 0x7c, 0xe3, 0xde, 0xcc, 0xa1, 0xcf, 0xa0,
+	0xa8, 0xee, 0xae, 0xa8, 0x6e, 0xe8, 0x1c, 0xb9, 0xcb, 0xbe, 0x26, 0xf5, 0x2b, 0x28, 0x27, 0x77,
+	0x61, 0x64, 0x99, 0xec, 0xec, 0xf5, 0xb8, 0x91, 0x94, 0x29, 0x73, 0x47, 0xfc, 0x38, 0x27, 0xce,
+	0xe4, 0x92, 0x3e, 0x1a, 0xc5, 0x31, 0x91, 0x84, 0xdd, 0x7c, 0x55, 0x6a, 0x6c, 0x4c, 0x0e, 0x50,
+	0x12, 0xd9, 0xfe, 0xa9, 0x00, 0x15, 0x45, 0xc9, 0x11, 0xf1, 0xc5, 0x1b, 0x22, 0x74, 0x98, 0x65,
+	0x66, 0x35, 0xa3, 0x99, 0x91, 0x13, 0xa0, 0xb1, 0x36, 0xc1, 0xab, 0x25, 0xb8, 0x0d, 0xe5, 0x7d,
+	0xca, 0x35, 0x52, 0x42, 0xd7, 0x28, 0xc4, 0xdc, 0xa8, 0x19, 0x9d, 0x40, 0x2d, 0x2b, 0xe9, 0x74,
+	0xa9, 0x13, 0x3a, 0x52, 0xba, 0xd4, 0x89, 0xbb, 0xe1, 0x10, 0x50, 0x8b, 0xf2, 0x8c, 0xb6, 0xd1,
+	0xbd, 0x4c, 0x5e, 0x46, 0xf4, 0x93, 0x6a, 0x89, 0x30, 0xd4, 0x53, 0xb4, 0x21, 0xf9, 0xa1, 0x77,
+	0x32, 0x80, 0xe3, 0x7a, 0x9f, 0x84, 0xb9, 0xfb, 0xe9, 0xcb, 0x3f, 0xee, 0xe5, 0x7e, 0x15, 0x9f,
+	0xdf, 0xc5, 0xe7, 0xdb, 0x0f, 0x6e, 0xff, 0x97, 0xb1, 0x5d, 0x94, 0x48, 0x0f, 0xff, 0x06, 0xdd,
+	0x80, 0x9c, 0x0f, 0x67, 0x0e, 0x00, 0x00,
 }
@@ -7,19 +7,19 @@ import (
 	"io"
 
 	"github.com/TheThingsNetwork/go-utils/log"
-	"github.com/TheThingsNetwork/ttn/api"
 	"github.com/TheThingsNetwork/ttn/api/gateway"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 	"github.com/golang/protobuf/ptypes/empty"
-	"google.golang.org/grpc/metadata"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
 )
 
-// RouterStreamServer handles gRPC streams as channels
+// RouterStreamServer handles gRPC streams as channels. The functions get the context of the stream, which contains
+// the metadata and the client certificate of the gateway.
 type RouterStreamServer struct {
 	ctx                   log.Interface
-	UplinkChanFunc        func(md metadata.MD) (ch chan *UplinkMessage, err error)
-	GatewayStatusChanFunc func(md metadata.MD) (ch chan *gateway.Status, err error)
-	DownlinkChanFunc      func(md metadata.MD) (ch <-chan *DownlinkMessage, cancel func(), err error)
+	UplinkChanFunc        func(ctx context.Context) (ch chan *UplinkMessage, err error)
+	GatewayStatusChanFunc func(ctx context.Context) (ch chan *gateway.Status, err error)
+	DownlinkChanFunc      func(ctx context.Context) (ch <-chan *DownlinkMessage, cancel func(), err error)
 }
 
 // NewRouterStreamServer returns a new RouterStreamServer
@@ -36,8 +36,7 @@ func (s *RouterStreamServer) SetLogger(logger log.Interface) {
 
 // Uplink handles uplink streams
 func (s *RouterStreamServer) Uplink(stream Router_UplinkServer) (err error) {
-	md := api.MetadataFromContext(stream.Context())
-	ch, err := s.UplinkChanFunc(md)
+	ch, err := s.UplinkChanFunc(stream.Context())
 	if err != nil {
 		return err
 	}
@@ -70,8 +69,7 @@ func (s *RouterStreamServer) Uplink(stream Router_UplinkServer) (err error) {
 
 // Subscribe handles downlink streams
 func (s *RouterStreamServer) Subscribe(req *SubscribeRequest, stream Router_SubscribeServer) (err error) {
-	md := api.MetadataFromContext(stream.Context())
-	ch, cancel, err := s.DownlinkChanFunc(md)
+	ch, cancel, err := s.DownlinkChanFunc(stream.Context())
 	if err != nil {
 		return err
 	}
@@ -90,8 +88,7 @@ func (s *RouterStreamServer) Subscribe(req *SubscribeRequest, stream Router_Subs
 
 // GatewayStatus handles gateway status streams
 func (s *RouterStreamServer) GatewayStatus(stream Router_GatewayStatusServer) error {
-	md := api.MetadataFromContext(stream.Context())
-	ch, err := s.GatewayStatusChanFunc(md)
+	ch, err := s.GatewayStatusChanFunc(stream.Context())
 	if err != nil {
 		return err
 	}
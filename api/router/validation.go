@@ -55,3 +55,35 @@ func (m *DeviceActivationRequest) Validate() error {
 	}
 	return nil
 }
+
+// Overrides of the gray-listing of gateways for downlink
+const (
+	GrayListNever  = "never"
+	GrayListAlways = "always"
+)
+
+// Validate implements the api.Validator interface
+func (m *GatewayGrayListRequest) Validate() error {
+	if err := api.NotEmptyAndValidID(m.GatewayId, "GatewayId"); err != nil {
+		return err
+	}
+	switch m.Override {
+	case "", GrayListNever, GrayListAlways:
+	default:
+		return errors.NewErrInvalidArgument("Override", "must be never, always or empty")
+	}
+	return nil
+}
+
+// Validate implements the api.Validator interface
+func (m *GatewayMaintenanceRequest) Validate() error {
+	if err := api.NotEmptyAndValidID(m.GatewayId, "GatewayId"); err != nil {
+		return err
+	}
+	for _, window := range m.MaintenanceWindows {
+		if err := api.NotNilAndValid(window, "MaintenanceWindows"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -9,6 +9,8 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -57,6 +59,7 @@ var brokerCmd = &cobra.Command{
 			time.Duration(viper.GetInt("broker.deduplication-delay")) * time.Millisecond,
 		)
 		broker.SetNetworkServer(viper.GetString("broker.networkserver-address"), nsCert, viper.GetString("broker.networkserver-token"))
+		withUplinkFilters(broker)
 		err = broker.Init(component)
 		if err != nil {
 			ctx.WithError(err).Fatal("Could not initialize broker")
@@ -84,6 +87,47 @@ var brokerCmd = &cobra.Command{
 	},
 }
 
+// withUplinkFilters adds the uplink filters that are configured to the Broker
+func withUplinkFilters(b broker.Broker) {
+	if ports := viper.GetStringSlice("broker.filter-drop-ports"); len(ports) > 0 {
+		var drop []uint8
+		for _, port := range ports {
+			p, err := strconv.ParseUint(port, 10, 8)
+			if err != nil {
+				ctx.WithError(err).Fatalf("Invalid port %s", port)
+			}
+			drop = append(drop, uint8(p))
+		}
+		b.WithUplinkFilter("drop-ports", broker.DropPortsFilter(drop...))
+	}
+	if groups := viper.GetStringSlice("broker.filter-gateway-groups"); len(groups) > 0 {
+		b.WithUplinkFilter("gateway-groups", broker.GatewayGroupFilter(parseKeyValues(groups)))
+	}
+	if attributes := viper.GetStringSlice("broker.filter-attributes"); len(attributes) > 0 {
+		b.WithUplinkFilter("attributes", broker.AttributesFilter(parseKeyValues(attributes)))
+	}
+	if file := viper.GetString("broker.filter-script"); file != "" {
+		code, err := ioutil.ReadFile(file)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not read uplink filter script")
+		}
+		b.WithUplinkFilter("script", broker.ScriptFilter(string(code)))
+	}
+}
+
+// parseKeyValues parses key=value pairs
+func parseKeyValues(pairs []string) map[string]string {
+	values := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			ctx.Fatalf("Invalid key=value pair %s", pair)
+		}
+		values[parts[0]] = parts[1]
+	}
+	return values
+}
+
 func init() {
 	RootCmd.AddCommand(brokerCmd)
 
@@ -97,6 +141,15 @@ func init() {
 	brokerCmd.Flags().Int("deduplication-delay", 200, "Deduplication delay (in ms)")
 	viper.BindPFlag("broker.deduplication-delay", brokerCmd.Flags().Lookup("deduplication-delay"))
 
+	brokerCmd.Flags().StringSlice("filter-drop-ports", []string{}, "Drop uplink messages on these FPorts")
+	viper.BindPFlag("broker.filter-drop-ports", brokerCmd.Flags().Lookup("filter-drop-ports"))
+	brokerCmd.Flags().StringSlice("filter-gateway-groups", []string{}, "Add the groups of the gateways to uplink messages (gateway-id=group)")
+	viper.BindPFlag("broker.filter-gateway-groups", brokerCmd.Flags().Lookup("filter-gateway-groups"))
+	brokerCmd.Flags().StringSlice("filter-attributes", []string{}, "Add these attributes to all uplink messages (key=value)")
+	viper.BindPFlag("broker.filter-attributes", brokerCmd.Flags().Lookup("filter-attributes"))
+	brokerCmd.Flags().String("filter-script", "", "File with a JavaScript function Filter(uplink) that returns false to drop an uplink message, or an object of attributes to add")
+	viper.BindPFlag("broker.filter-script", brokerCmd.Flags().Lookup("filter-script"))
+
 	brokerCmd.Flags().String("server-address", "0.0.0.0", "The IP address to listen for communication")
 	brokerCmd.Flags().String("server-address-announce", "localhost", "The public IP address to announce")
 	brokerCmd.Flags().Int("server-port", 1902, "The port for communication")
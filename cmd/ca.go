@@ -0,0 +1,150 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/utils/security"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var caCmd = &cobra.Command{
+	Use:   "ca",
+	Short: "Manage the certificate authority for integrations and gateways",
+	Long: `ttn ca manages a certificate authority that issues client certificates for
+integrations (such as AMQP and webhooks) and gateway connections.
+
+The CA is stored in the key directory (ca.key, ca.cert and ca.crl). Components
+that have ca.cert in their key directory verify client certificates against the
+CA and reject certificates that have been revoked. If ca.crl is missing or
+expired, all client certificates are rejected. The CRL is reloaded when it
+changes, and expires after 30 days, so run ttn ca update-crl periodically and
+distribute ca.crl to the components.
+
+The common name of a gateway certificate is the gateway ID. The Router only
+accepts a gateway certificate for the gateway that it was issued to.`,
+}
+
+var caInitCmd = &cobra.Command{
+	Use:   "init [name]",
+	Short: "Generate a new certificate authority",
+	Long:  `ttn ca init generates the key, certificate and an empty CRL of a new certificate authority`,
+	Run: func(cmd *cobra.Command, args []string) {
+		name := "The Things Network CA"
+		if len(args) > 0 {
+			name = args[0]
+		}
+		if err := security.GenerateCA(viper.GetString("key-dir"), name); err != nil {
+			ctx.WithError(err).Fatal("Could not generate CA")
+		}
+		ca, err := security.LoadCA(viper.GetString("key-dir"))
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not load CA")
+		}
+		if err := ca.UpdateCRL(); err != nil {
+			ctx.WithError(err).Fatal("Could not write CRL")
+		}
+		ctx.WithField("TLSDir", viper.GetString("key-dir")).Info("Done")
+	},
+}
+
+var caIssueCmd = &cobra.Command{
+	Use:   "issue [id] [hostnames...]",
+	Short: "Issue a client certificate",
+	Long: `ttn ca issue issues a client certificate for the integration or gateway with
+the given ID. If hostnames are given, the certificate can also be used as server
+certificate. The certificate and key are written to <id>.cert and <id>.key.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 1 {
+			cmd.UsageFunc()(cmd)
+			return
+		}
+
+		ca, err := security.LoadCA(viper.GetString("key-dir"))
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not load CA")
+		}
+
+		valid, _ := cmd.Flags().GetInt("valid")
+		out, _ := cmd.Flags().GetString("out")
+
+		certPEM, keyPEM, serialNumber, err := ca.IssueCert(args[0], time.Duration(valid)*24*time.Hour, args[1:]...)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not issue certificate")
+		}
+
+		certFile := filepath.Join(out, args[0]+".cert")
+		keyFile := filepath.Join(out, args[0]+".key")
+		if err := ioutil.WriteFile(certFile, certPEM, 0644); err != nil {
+			ctx.WithError(err).Fatal("Could not write certificate")
+		}
+		if err := ioutil.WriteFile(keyFile, keyPEM, 0600); err != nil {
+			ctx.WithError(err).Fatal("Could not write key")
+		}
+
+		ctx.WithField("ID", args[0]).
+			WithField("Serial", serialNumber.String()).
+			WithField("Certificate", certFile).
+			WithField("Key", keyFile).
+			Info("Issued certificate")
+	},
+}
+
+var caRevokeCmd = &cobra.Command{
+	Use:   "revoke [serial]",
+	Short: "Revoke a certificate",
+	Long:  `ttn ca revoke adds the certificate with the given serial number to the CRL`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			cmd.UsageFunc()(cmd)
+			return
+		}
+
+		serialNumber, ok := new(big.Int).SetString(args[0], 10)
+		if !ok {
+			ctx.WithField("Serial", args[0]).Fatal("Invalid serial number")
+		}
+
+		ca, err := security.LoadCA(viper.GetString("key-dir"))
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not load CA")
+		}
+		if err := ca.Revoke(serialNumber); err != nil {
+			ctx.WithError(err).Fatal("Could not revoke certificate")
+		}
+
+		ctx.WithField("Serial", serialNumber.String()).Info("Revoked certificate")
+	},
+}
+
+var caUpdateCRLCmd = &cobra.Command{
+	Use:   "update-crl",
+	Short: "Sign the certificate revocation list again",
+	Long:  `ttn ca update-crl signs the CRL again, so that it does not expire`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ca, err := security.LoadCA(viper.GetString("key-dir"))
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not load CA")
+		}
+		if err := ca.UpdateCRL(); err != nil {
+			ctx.WithError(err).Fatal("Could not update CRL")
+		}
+		ctx.WithField("TLSDir", viper.GetString("key-dir")).Info("Done")
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(caCmd)
+	caCmd.AddCommand(caInitCmd)
+	caCmd.AddCommand(caIssueCmd)
+	caIssueCmd.Flags().Int("valid", 365, "The number of days the certificate is valid")
+	caIssueCmd.Flags().String("out", ".", "The directory to write the certificate and key to")
+	caCmd.AddCommand(caRevokeCmd)
+	caCmd.AddCommand(caUpdateCRLCmd)
+}
@@ -0,0 +1,624 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"crypto/ecdsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/api"
+	pb_discovery "github.com/TheThingsNetwork/ttn/api/discovery"
+	"github.com/TheThingsNetwork/ttn/api/pool"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/parse"
+	"github.com/TheThingsNetwork/ttn/utils/secrets"
+	"github.com/TheThingsNetwork/ttn/utils/security"
+	"github.com/gosuri/uitable"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"gopkg.in/redis.v5"
+)
+
+// configComponents are the components that can be checked with check-config
+var configComponents = []string{"discovery", "router", "broker", "networkserver", "joinserver", "handler"}
+
+// CertificateExpiryWarning is how long before the expiry of a certificate check-config warns about it
+var CertificateExpiryWarning = 30 * 24 * time.Hour
+
+// checkConfigCmd represents the check-config command
+var checkConfigCmd = &cobra.Command{
+	Use:   "check-config [component ...]",
+	Short: "Check the configuration of the components",
+	Long: `ttn check-config validates the configuration of the given components (default:
+the components that have a section in the config file) and connects to their
+dependencies, such as Redis, the Discovery server, the Networkserver and the Join
+Server. When multiple components are checked, it also validates that they refer
+to each other.
+
+Run it before starting the components, so that misconfigurations do not only
+surface as errors at runtime. It exits with a non-zero status if a check failed.`,
+	Example: `$ ttn check-config broker networkserver
+COMPONENT       CHECK                                   RESULT  DETAILS
+ttn             id                                      ok
+ttn             key-dir                                 ok
+ttn             certificate                             ok
+ttn             discovery-address                       ok
+ttn             auth-token                              ok
+ttn             frequency plans                         ok
+broker          server-address-announce                 ok
+broker          networkserver-address                   FAILED  context deadline exceeded
+networkserver   server-address-announce                 ok
+networkserver   redis                                   ok
+broker          networkserver-address -> networkserver  ok
+  FATAL Configuration is invalid                 NumFailed=1`,
+	Run: func(cmd *cobra.Command, args []string) {
+		for _, component := range args {
+			if !isConfigComponent(component) {
+				ctx.Fatalf("Unknown component %s, expected one of %s", component, strings.Join(configComponents, ", "))
+			}
+		}
+		components := args
+		if len(components) == 0 {
+			components = configuredComponents()
+		}
+
+		offline, _ := cmd.Flags().GetBool("offline")
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+		r := &configReport{
+			connect: !offline,
+			timeout: timeout,
+		}
+
+		checkCommonConfig(r, components)
+		for _, component := range components {
+			checkComponentConfig(r, component)
+		}
+		checkConfigReferences(r, components)
+
+		r.print()
+
+		if failed := r.failed(); failed > 0 {
+			ctx.WithField("NumFailed", failed).Fatal("Configuration is invalid")
+		}
+		ctx.WithField("NumWarnings", r.warnings()).Info("Configuration is valid")
+	},
+}
+
+type configCheckResult struct {
+	component string
+	name      string
+	err       error
+	warning   bool
+}
+
+// configReport collects the results of the configuration checks
+type configReport struct {
+	connect bool
+	timeout time.Duration
+	cert    *x509.Certificate
+	results []configCheckResult
+}
+
+// check adds the result of a check that failed if err is not nil
+func (r *configReport) check(component, name string, err error) {
+	r.results = append(r.results, configCheckResult{component: component, name: name, err: err})
+}
+
+// warn adds the result of a check that only warns if err is not nil
+func (r *configReport) warn(component, name string, err error) {
+	r.results = append(r.results, configCheckResult{component: component, name: name, err: err, warning: err != nil})
+}
+
+// checkExpiry fails if the certificate expired, and warns if it expires soon
+func (r *configReport) checkExpiry(component, name string, cert *x509.Certificate) {
+	switch {
+	case time.Now().After(cert.NotAfter):
+		r.check(component, name, fmt.Errorf("Certificate expired at %s", cert.NotAfter.Format(time.RFC3339)))
+	case time.Now().Add(CertificateExpiryWarning).After(cert.NotAfter):
+		r.warn(component, name, fmt.Errorf("Certificate expires at %s", cert.NotAfter.Format(time.RFC3339)))
+	default:
+		r.check(component, name, nil)
+	}
+}
+
+func (r *configReport) failed() (failed int) {
+	for _, res := range r.results {
+		if res.err != nil && !res.warning {
+			failed++
+		}
+	}
+	return
+}
+
+func (r *configReport) warnings() (warnings int) {
+	for _, res := range r.results {
+		if res.warning {
+			warnings++
+		}
+	}
+	return
+}
+
+func (r *configReport) print() {
+	table := uitable.New()
+	table.MaxColWidth = 80
+	table.AddRow("COMPONENT", "CHECK", "RESULT", "DETAILS")
+	for _, res := range r.results {
+		switch {
+		case res.err == nil:
+			table.AddRow(res.component, res.name, "ok", "")
+		case res.warning:
+			table.AddRow(res.component, res.name, "warning", res.err.Error())
+		default:
+			table.AddRow(res.component, res.name, "FAILED", res.err.Error())
+		}
+	}
+	fmt.Println(table)
+}
+
+// dial connects to the gRPC server at the address with the given credentials, or with the default TLS config if creds
+// is nil
+func (r *configReport) dial(address string, creds credentials.TransportCredentials) (*grpc.ClientConn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+	conn, err := pool.NewPool(ctx, pool.DefaultDialOptions...).DialSecure(address, creds)
+	if err != nil && creds == nil && api.AllowInsecureFallback {
+		ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+		defer cancel()
+		conn, err = pool.NewPool(ctx, pool.DefaultDialOptions...).DialInsecure(address)
+	}
+	return conn, err
+}
+
+// ping connects to the gRPC server at the address and disconnects again
+func (r *configReport) ping(address string, creds credentials.TransportCredentials) error {
+	conn, err := r.dial(address, creds)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// discover gets the announcement of the service from the Discovery server
+func (r *configReport) discover(serviceName, id string) error {
+	conn, err := r.dial(viper.GetString("discovery-address"), nil)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(api.ContextWithToken(context.Background(), viper.GetString("auth-token")), r.timeout)
+	defer cancel()
+	_, err = pb_discovery.NewDiscoveryClient(conn).Get(ctx, &pb_discovery.GetRequest{
+		ServiceName: serviceName,
+		Id:          id,
+	})
+	return errors.FromGRPCError(err)
+}
+
+// checkTCP checks that the (MQTT or AMQP) server at the address accepts connections
+func (r *configReport) checkTCP(address string) error {
+	conn, err := net.DialTimeout("tcp", address, r.timeout)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// checkCertFile checks the certificate in the file of the config key, and returns credentials that trust it. If the
+// key is not set, it returns nil credentials, so that the default TLS config is used
+func (r *configReport) checkCertFile(component, key string) (credentials.TransportCredentials, error) {
+	file := viper.GetString(component + "." + key)
+	if file == "" {
+		return nil, nil
+	}
+	contents, err := ioutil.ReadFile(file)
+	if err != nil {
+		r.check(component, key, err)
+		return nil, err
+	}
+	cert, err := parseCertificate(contents)
+	if err != nil {
+		r.check(component, key, err)
+		return nil, err
+	}
+	r.checkExpiry(component, key, cert)
+	certPool := x509.NewCertPool()
+	certPool.AddCert(cert)
+	return credentials.NewClientTLSFromCert(certPool, ""), nil
+}
+
+func isConfigComponent(name string) bool {
+	for _, component := range configComponents {
+		if component == name {
+			return true
+		}
+	}
+	return false
+}
+
+// configuredComponents returns the components that have a section in the config file, or all components if there are
+// none
+func configuredComponents() (components []string) {
+	for _, component := range configComponents {
+		if viper.InConfig(component) {
+			components = append(components, component)
+		}
+	}
+	if len(components) == 0 {
+		return configComponents
+	}
+	return components
+}
+
+// usesDiscovery returns whether the component uses the Discovery server
+func usesDiscovery(component string) bool {
+	return component != "discovery" && component != "networkserver" && component != "joinserver"
+}
+
+// resolveConfigSecret returns the value of the config key, resolving references to secrets ("secret:<name>")
+func resolveConfigSecret(component, key string) (string, error) {
+	backend, err := newSecretsBackend(component)
+	if err != nil {
+		return "", err
+	}
+	return secrets.Resolve(backend, viper.GetString(component+"."+key))
+}
+
+// checkAddress checks that the address consists of a host and a port
+func checkAddress(address string) error {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if host == "" {
+		return errors.NewErrInvalidArgument("Address", "host can not be empty")
+	}
+	if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		return errors.NewErrInvalidArgument("Port "+port, "must be a number between 0 and 65535")
+	}
+	return nil
+}
+
+// checkAuthServer checks that the auth server is an HTTP(S) URL or a file with a token key
+func checkAuthServer(server string) error {
+	if strings.HasPrefix(server, "file://") {
+		_, err := ioutil.ReadFile(filepath.Clean(strings.TrimPrefix(server, "file://")))
+		return err
+	}
+	u, err := url.Parse(server)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.NewErrInvalidArgument("Auth server", "must be an http(s):// or file:// URL")
+	}
+	return nil
+}
+
+// checkFrequencyPlans checks that all frequency plans can be loaded
+func checkFrequencyPlans() error {
+	names := make([]string, 0, len(pb_lorawan.FrequencyPlan_name))
+	for _, name := range pb_lorawan.FrequencyPlan_name {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var invalid []string
+	for _, name := range names {
+		fp, err := band.Get(name)
+		if err != nil || len(fp.DataRates) == 0 || len(fp.UplinkChannels) == 0 {
+			invalid = append(invalid, name)
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("Could not load frequency plans %s", strings.Join(invalid, ", "))
+	}
+	return nil
+}
+
+func parseCertificate(contents []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(contents)
+	if block == nil {
+		return nil, errors.NewErrInvalidArgument("Certificate", "no PEM data found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// loadCertificate loads the certificate in the key dir, and checks that it belongs to the private key
+func loadCertificate(keyDir string, priv *ecdsa.PrivateKey) (*x509.Certificate, error) {
+	certPEM, err := security.LoadCert(keyDir)
+	if err != nil {
+		return nil, err
+	}
+	privPEM, err := security.PrivatePEM(priv)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tls.X509KeyPair(certPEM, privPEM); err != nil {
+		return nil, err
+	}
+	return parseCertificate(certPEM)
+}
+
+func checkCommonConfig(r *configReport, components []string) {
+	if viper.GetString("id") == "" {
+		r.check("ttn", "id", errors.NewErrInvalidArgument("ID", "can not be empty"))
+	} else {
+		r.check("ttn", "id", nil)
+	}
+
+	for id, server := range viper.GetStringMapString("auth-servers") {
+		r.check("ttn", "auth-servers."+id, checkAuthServer(server))
+	}
+
+	keyDir := viper.GetString("key-dir")
+	priv, err := security.LoadKeypair(keyDir)
+	r.check("ttn", "key-dir", err)
+	if err == nil && viper.GetBool("tls") {
+		r.cert, err = loadCertificate(keyDir, priv)
+		if err != nil {
+			r.check("ttn", "certificate", err)
+		} else {
+			r.checkExpiry("ttn", "certificate", r.cert)
+		}
+	}
+
+	for _, component := range components {
+		if !usesDiscovery(component) {
+			continue
+		}
+		address := viper.GetString("discovery-address")
+		err := checkAddress(address)
+		if err == nil && r.connect {
+			err = r.ping(address, nil)
+		}
+		r.check("ttn", "discovery-address", err)
+		if viper.GetString("auth-token") == "" {
+			r.check("ttn", "auth-token", errors.NewErrInvalidArgument("Auth token", "can not be empty"))
+		} else {
+			r.check("ttn", "auth-token", nil)
+		}
+		break
+	}
+
+	r.check("ttn", "frequency plans", checkFrequencyPlans())
+}
+
+func checkComponentConfig(r *configReport, component string) {
+	if port := viper.GetInt(component + ".server-port"); port <= 0 || port > 65535 {
+		r.check(component, "server-port", errors.NewErrInvalidArgument("Server port", "must be between 1 and 65535"))
+	}
+
+	if component != "discovery" {
+		var err error
+		announce := viper.GetString(component + ".server-address-announce")
+		if announce == "" {
+			err = errors.NewErrInvalidArgument("Announced address", "can not be empty")
+		} else if r.cert != nil {
+			err = r.cert.VerifyHostname(announce)
+		}
+		r.check(component, "server-address-announce", err)
+	}
+
+	if _, err := newSecretsBackend(component); err != nil {
+		r.check(component, "secrets-backend", err)
+	}
+
+	switch component {
+	case "router":
+		if viper.GetString("router.redis-address") != "" {
+			checkRedis(r, component)
+		}
+	case "broker":
+		checkBrokerConfig(r)
+	case "networkserver":
+		checkRedis(r, component)
+		checkNetworkServerConfig(r)
+	case "discovery", "joinserver":
+		checkRedis(r, component)
+	case "handler":
+		checkRedis(r, component)
+		checkHandlerConfig(r)
+	}
+}
+
+// checkRedis checks the Redis address and database of the component, and connects to it
+func checkRedis(r *configReport, component string) {
+	address := viper.GetString(component + ".redis-address")
+	err := checkAddress(address)
+	if err == nil && viper.GetInt(component+".redis-db") < 0 {
+		err = errors.NewErrInvalidArgument("Redis database", "can not be negative")
+	}
+	var password string
+	if err == nil {
+		password, err = resolveConfigSecret(component, "redis-password")
+	}
+	if err == nil && r.connect {
+		client := redis.NewClient(&redis.Options{
+			Addr:         address,
+			Password:     password,
+			DB:           viper.GetInt(component + ".redis-db"),
+			DialTimeout:  r.timeout,
+			ReadTimeout:  r.timeout,
+			WriteTimeout: r.timeout,
+		})
+		_, err = client.Ping().Result()
+		client.Close()
+	}
+	r.check(component, "redis", err)
+}
+
+func checkBrokerConfig(r *configReport) {
+	creds, err := r.checkCertFile("broker", "networkserver-cert")
+	if err == nil {
+		address := viper.GetString("broker.networkserver-address")
+		err = checkAddress(address)
+		if err == nil && r.connect {
+			err = r.ping(address, creds)
+		}
+		r.check("broker", "networkserver-address", err)
+	}
+
+	if ports := viper.GetStringSlice("broker.filter-drop-ports"); len(ports) > 0 {
+		var err error
+		for _, port := range ports {
+			if _, perr := strconv.ParseUint(port, 10, 8); perr != nil {
+				err = errors.NewErrInvalidArgument("Port "+port, "must be a number between 0 and 255")
+			}
+		}
+		r.check("broker", "filter-drop-ports", err)
+	}
+	if file := viper.GetString("broker.filter-script"); file != "" {
+		_, err := ioutil.ReadFile(file)
+		r.check("broker", "filter-script", err)
+	}
+}
+
+func checkNetworkServerConfig(r *configReport) {
+	if netID := viper.GetInt("networkserver.net-id"); netID < 0 || netID > 0xFFFFFF {
+		r.check("networkserver", "net-id", errors.NewErrInvalidArgument("NetID", "must be between 0 and 0xFFFFFF"))
+	}
+	for prefix := range viper.GetStringMapString("networkserver.prefixes") {
+		// The Networkserver skips prefixes that it can not parse
+		_, err := types.ParseDevAddrPrefix(prefix)
+		r.warn("networkserver", "prefixes."+prefix, err)
+	}
+}
+
+func checkHandlerConfig(r *configReport) {
+	if strategy := viper.GetString("handler.dev-addr-allocation"); !pb_lorawan.ValidDevAddrAllocation(strategy) {
+		r.check("handler", "dev-addr-allocation", errors.NewErrInvalidArgument("DevAddr allocation", "unknown strategy "+strategy))
+	}
+
+	if dir := viper.GetString("handler.go-codec-plugins"); dir != "" {
+		_, err := ioutil.ReadDir(dir)
+		r.check("handler", "go-codec-plugins", err)
+	}
+
+	if peers := viper.GetStringSlice("handler.replica-peers"); len(peers) > 0 {
+		var err error
+		if viper.GetString("handler.http-address") == "" || viper.GetInt("handler.http-port") == 0 {
+			err = errors.NewErrInvalidArgument("HTTP address", "required for exchanging state with the replicas")
+		}
+		for _, peer := range peers {
+			if u, perr := url.Parse(peer); perr != nil || (u.Scheme != "http" && u.Scheme != "https") {
+				err = errors.NewErrInvalidArgument("Replica peer "+peer, "must be an http(s):// URL")
+			}
+		}
+		if err == nil {
+			var key string
+			if key, err = resolveConfigSecret("handler", "replica-key"); err == nil && key == "" {
+				err = errors.NewErrInvalidArgument("Replica key", "can not be empty")
+			}
+		}
+		r.check("handler", "replica-peers", err)
+	}
+
+	if address := viper.GetString("handler.join-server-address"); address != "" {
+		creds, err := r.checkCertFile("handler", "join-server-cert")
+		if err == nil {
+			err = checkAddress(address)
+			if err == nil && r.connect {
+				err = r.ping(address, creds)
+			}
+			r.check("handler", "join-server-address", err)
+		}
+	}
+
+	for _, integration := range []string{"mqtt", "amqp", "amqp-shadow"} {
+		address := viper.GetString("handler." + integration + "-address")
+		if address == "" {
+			continue
+		}
+		err := checkAddress(address)
+		if err == nil {
+			_, err = resolveConfigSecret("handler", integration+"-password")
+		}
+		if err == nil && r.connect {
+			err = r.checkTCP(address)
+		}
+		r.check("handler", integration+"-address", err)
+	}
+	if viper.GetString("handler.amqp-address") != "" && viper.GetBool("handler.amqp-tls") {
+		if certFile := viper.GetString("handler.amqp-cert"); certFile != "" {
+			_, err := tls.LoadX509KeyPair(certFile, viper.GetString("handler.amqp-key"))
+			r.check("handler", "amqp-cert", err)
+		}
+	}
+
+	if r.connect {
+		r.check("handler", "broker-id", r.discover("broker", viper.GetString("handler.broker-id")))
+	}
+}
+
+// checkConfigReferences checks that the components that are checked together refer to each other's ports
+func checkConfigReferences(r *configReport, components []string) {
+	checking := func(component string) bool {
+		for _, c := range components {
+			if c == component {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, ref := range []struct {
+		component string
+		key       string
+		target    string
+	}{
+		{"ttn", "discovery-address", "discovery"},
+		{"broker", "broker.networkserver-address", "networkserver"},
+		{"handler", "handler.join-server-address", "joinserver"},
+	} {
+		address := viper.GetString(ref.key)
+		if address == "" || !checking(ref.target) || (ref.component != "ttn" && !checking(ref.component)) {
+			continue
+		}
+		name := strings.TrimPrefix(ref.key, ref.component+".") + " -> " + ref.target
+		port, err := parse.Port(address)
+		if err == nil && int(port) != viper.GetInt(ref.target+".server-port") {
+			// The address can point to a proxy or load balancer, so this is not necessarily an error
+			err = fmt.Errorf("Port %d is not the server-port of the %s (%d)", port, ref.target, viper.GetInt(ref.target+".server-port"))
+		}
+		r.warn(ref.component, name, err)
+	}
+
+	// Components that run on the same host can not listen on the same port
+	ports := make(map[int]string)
+	for _, component := range components {
+		for _, key := range []string{"server-port", "http-port"} {
+			port := viper.GetInt(component + "." + key)
+			if port == 0 {
+				continue
+			}
+			if other, ok := ports[port]; ok {
+				r.warn(component, key, fmt.Errorf("Port %d is also used by %s", port, other))
+			}
+			ports[port] = component + "." + key
+		}
+	}
+}
+
+func init() {
+	RootCmd.AddCommand(checkConfigCmd)
+	checkConfigCmd.Flags().Bool("offline", false, "Only validate the configuration, without connecting to the dependencies of the components")
+	checkConfigCmd.Flags().Duration("timeout", 5*time.Second, "Timeout for connecting to the dependencies of the components")
+}
@@ -58,6 +58,7 @@ var discoveryCmd = &cobra.Command{
 		if err != nil {
 			ctx.WithError(err).Fatal("Could not initialize component")
 		}
+		component.WatchRedis(client)
 
 		// Discovery Server
 		discovery := discovery.NewRedisDiscovery(client)
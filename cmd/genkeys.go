@@ -48,10 +48,12 @@ func init() {
 	handlerCmd.AddCommand(genKeypairCmd("handler"))
 	discoveryCmd.AddCommand(genKeypairCmd("discovery"))
 	networkserverCmd.AddCommand(genKeypairCmd("networkserver"))
+	joinserverCmd.AddCommand(genKeypairCmd("joinserver"))
 
 	routerCmd.AddCommand(genCertCmd("router"))
 	brokerCmd.AddCommand(genCertCmd("broker"))
 	handlerCmd.AddCommand(genCertCmd("handler"))
 	discoveryCmd.AddCommand(genCertCmd("discovery"))
 	networkserverCmd.AddCommand(genCertCmd("networkserver"))
+	joinserverCmd.AddCommand(genCertCmd("joinserver"))
 }
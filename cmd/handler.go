@@ -4,18 +4,26 @@
 package cmd
 
 import (
+	"crypto/tls"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
 	pb "github.com/TheThingsNetwork/ttn/api/handler"
 	"github.com/TheThingsNetwork/ttn/api/pool"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/core/component"
 	"github.com/TheThingsNetwork/ttn/core/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/archive"
+	"github.com/TheThingsNetwork/ttn/core/handler/devicerepository"
+	"github.com/TheThingsNetwork/ttn/core/handler/functions"
+	"github.com/TheThingsNetwork/ttn/core/handler/gocodec"
 	"github.com/TheThingsNetwork/ttn/core/proxy"
 	"github.com/TheThingsNetwork/ttn/core/proxy/jsonpb"
 	"github.com/TheThingsNetwork/ttn/utils/parse"
@@ -41,6 +49,8 @@ var handlerCmd = &cobra.Command{
 			"TTN Broker ID": viper.GetString("handler.broker-id"),
 			"MQTT":          viper.GetString("handler.mqtt-address"),
 			"AMQP":          viper.GetString("handler.amqp-address"),
+			"Read-only":     viper.GetBool("handler.read-only"),
+			"Region":        viper.GetString("handler.region"),
 		}).Info("Initializing Handler")
 	},
 	Run: func(cmd *cobra.Command, args []string) {
@@ -49,7 +59,7 @@ var handlerCmd = &cobra.Command{
 		// Redis Client
 		client := redis.NewClient(&redis.Options{
 			Addr:     viper.GetString("handler.redis-address"),
-			Password: viper.GetString("handler.redis-password"),
+			Password: getSecret("handler", "redis-password"),
 			DB:       viper.GetInt("handler.redis-db"),
 		})
 
@@ -62,6 +72,7 @@ var handlerCmd = &cobra.Command{
 		if err != nil {
 			ctx.WithError(err).Fatal("Could not initialize component")
 		}
+		component.WatchRedis(client)
 
 		httpActive := viper.GetString("handler.http-address") != "" && viper.GetInt("handler.http-port") != 0
 		if httpActive && component.Identity.ApiAddress == "" {
@@ -73,10 +84,64 @@ var handlerCmd = &cobra.Command{
 			client,
 			viper.GetString("handler.broker-id"),
 		)
+		if viper.GetBool("handler.read-only") {
+			handler = handler.WithReadOnly()
+		}
+		if viper.GetBool("handler.verify-state") || viper.GetBool("handler.repair-state") {
+			handler = handler.WithStateVerification(viper.GetBool("handler.repair-state"))
+		}
+		handler = handler.WithMaxFunctionTimeout(viper.GetDuration("handler.max-function-timeout"))
+		functions.MaxCallStackSize = viper.GetInt("handler.max-function-call-depth")
+		functions.MaxSteps = viper.GetInt("handler.max-function-steps")
+		functions.MaxOutputFields = viper.GetInt("handler.max-decoded-fields")
+		functions.MaxOutputDepth = viper.GetInt("handler.max-decoded-depth")
+		functions.MaxOutputSize = viper.GetInt("handler.max-decoded-size") << 10
+		if dir := viper.GetString("handler.go-codec-plugins"); dir != "" {
+			names, err := gocodec.LoadPlugins(dir)
+			if err != nil {
+				ctx.WithError(err).Fatal("Could not load Go codec plugins")
+			}
+			ctx.WithField("Codecs", names).Info("Loaded Go codec plugins")
+		}
+		if strategy := viper.GetString("handler.dev-addr-allocation"); !pb_lorawan.ValidDevAddrAllocation(strategy) {
+			ctx.WithField("Strategy", strategy).Fatal("Invalid DevAddr allocation strategy")
+		}
+		handler = handler.WithDevAddrAllocation(viper.GetString("handler.dev-addr-allocation"))
+		handler = handler.WithRegion(viper.GetString("handler.region"))
+		handler = handler.WithSecrets(getSecretsBackend("handler"))
+		handler = handler.WithRecycleBin(viper.GetDuration("handler.recycle-bin-retention"))
+		if viper.GetBool("handler.device-repository") {
+			handler = handler.WithDeviceRepository(viper.GetString("handler.device-repository-url"))
+		}
+		if location := viper.GetString("handler.archive"); location != "" {
+			handler = handler.WithArchive(archive.NewBackend(location, viper.GetString("handler.archive-s3-region")))
+		}
+		replicas := viper.GetStringSlice("handler.replica-peers")
+		if len(replicas) > 0 {
+			if !httpActive {
+				ctx.Fatal("Replicas exchange their state over HTTP, the http-address and http-port should be set")
+			}
+			replicaKey := getSecret("handler", "replica-key")
+			if replicaKey == "" {
+				ctx.Fatal("Replicas exchange the state of devices and applications, the replica-key should be set")
+			}
+			handler = handler.WithReplicas(replicas, replicaKey)
+		}
+		if viper.GetString("handler.join-server-address") != "" {
+			var jsCert string
+			if jsCertFile := viper.GetString("handler.join-server-cert"); jsCertFile != "" {
+				contents, err := ioutil.ReadFile(jsCertFile)
+				if err != nil {
+					ctx.WithError(err).Fatal("Could not get Join Server certificate")
+				}
+				jsCert = string(contents)
+			}
+			handler = handler.WithJoinServer(viper.GetString("handler.join-server-address"), jsCert, viper.GetString("handler.join-server-token"))
+		}
 		if viper.GetString("handler.mqtt-address") != "" {
 			handler = handler.WithMQTT(
 				viper.GetString("handler.mqtt-username"),
-				viper.GetString("handler.mqtt-password"),
+				getSecret("handler", "mqtt-password"),
 				viper.GetString("handler.mqtt-address"),
 			)
 
@@ -95,11 +160,23 @@ var handlerCmd = &cobra.Command{
 		if viper.GetString("handler.amqp-address") != "" {
 			handler = handler.WithAMQP(
 				viper.GetString("handler.amqp-username"),
-				viper.GetString("handler.amqp-password"),
+				getSecret("handler", "amqp-password"),
 				viper.GetString("handler.amqp-address"),
 				viper.GetString("handler.amqp-exchange"),
 			)
 
+			if viper.GetBool("handler.amqp-tls") {
+				tlsConfig := &tls.Config{RootCAs: pool.RootCAs}
+				if certFile := viper.GetString("handler.amqp-cert"); certFile != "" {
+					cert, err := tls.LoadX509KeyPair(certFile, viper.GetString("handler.amqp-key"))
+					if err != nil {
+						ctx.WithError(err).Fatal("Could not load AMQP client certificate")
+					}
+					tlsConfig.Certificates = []tls.Certificate{cert}
+				}
+				handler = handler.WithAMQPTLS(tlsConfig)
+			}
+
 			amqpPort, err := parse.Port(viper.GetString("handler.amqp-address"))
 			if err != nil {
 				ctx.WithError(err).Error("Could not announce the handler")
@@ -112,6 +189,21 @@ var handlerCmd = &cobra.Command{
 		} else {
 			ctx.Warn("AMQP is not enabled in your configuration")
 		}
+		if viper.GetString("handler.amqp-shadow-address") != "" {
+			handler = handler.WithShadowAMQP(
+				viper.GetString("handler.amqp-shadow-username"),
+				getSecret("handler", "amqp-shadow-password"),
+				viper.GetString("handler.amqp-shadow-address"),
+				viper.GetString("handler.amqp-shadow-exchange"),
+			)
+		}
+		if certFile := viper.GetString("handler.webhook-cert"); certFile != "" {
+			cert, err := tls.LoadX509KeyPair(certFile, viper.GetString("handler.webhook-key"))
+			if err != nil {
+				ctx.WithError(err).Fatal("Could not load webhook client certificate")
+			}
+			handler = handler.WithWebhookTLS(&tls.Config{RootCAs: pool.RootCAs, Certificates: []tls.Certificate{cert}})
+		}
 		err = handler.Init(component)
 		if err != nil {
 			ctx.WithError(err).Fatal("Could not initialize handler")
@@ -148,6 +240,20 @@ var handlerCmd = &cobra.Command{
 			prxy = proxy.WithPagination(prxy)
 			prxy = proxy.WithLogger(prxy, ctx)
 
+			if viper.GetBool("handler.mqtt-auth") {
+				httpMux := http.NewServeMux()
+				httpMux.Handle("/mqtt/auth/", http.StripPrefix("/mqtt/auth", handler.MQTTAuth()))
+				httpMux.Handle("/", prxy)
+				prxy = httpMux
+			}
+
+			if len(replicas) > 0 {
+				httpMux := http.NewServeMux()
+				httpMux.Handle("/replicas/", http.StripPrefix("/replicas", handler.ReplicaSync()))
+				httpMux.Handle("/", prxy)
+				prxy = httpMux
+			}
+
 			go func() {
 				err := http.ListenAndServe(
 					fmt.Sprintf("%s:%d", viper.GetString("handler.http-address"), viper.GetInt("handler.http-port")),
@@ -171,7 +277,7 @@ func init() {
 
 	handlerCmd.Flags().String("redis-address", "localhost:6379", "Redis host and port")
 	viper.BindPFlag("handler.redis-address", handlerCmd.Flags().Lookup("redis-address"))
-	handlerCmd.Flags().String("redis-password", "", "Redis password")
+	handlerCmd.Flags().String("redis-password", "", "Redis password (or secret:<name>)")
 	viper.BindPFlag("handler.redis-password", handlerCmd.Flags().Lookup("redis-password"))
 	handlerCmd.Flags().Int("redis-db", 0, "Redis database")
 	viper.BindPFlag("handler.redis-db", handlerCmd.Flags().Lookup("redis-db"))
@@ -182,22 +288,44 @@ func init() {
 	handlerCmd.Flags().String("mqtt-address", "", "MQTT host and port. Leave empty to disable MQTT")
 	handlerCmd.Flags().String("mqtt-address-announce", "", "MQTT address to announce (takes value of server-address-announce if empty while enabled)")
 	handlerCmd.Flags().String("mqtt-username", "", "MQTT username")
-	handlerCmd.Flags().String("mqtt-password", "", "MQTT password")
+	handlerCmd.Flags().String("mqtt-password", "", "MQTT password (or secret:<name>)")
 	viper.BindPFlag("handler.mqtt-address", handlerCmd.Flags().Lookup("mqtt-address"))
 	viper.BindPFlag("handler.mqtt-address-announce", handlerCmd.Flags().Lookup("mqtt-address-announce"))
 	viper.BindPFlag("handler.mqtt-username", handlerCmd.Flags().Lookup("mqtt-username"))
 	viper.BindPFlag("handler.mqtt-password", handlerCmd.Flags().Lookup("mqtt-password"))
+	handlerCmd.Flags().Bool("mqtt-auth", false, "Serve the MQTT credentials of collaborators as HTTP authentication backend for the MQTT broker on /mqtt/auth")
+	viper.BindPFlag("handler.mqtt-auth", handlerCmd.Flags().Lookup("mqtt-auth"))
 
 	handlerCmd.Flags().String("amqp-address", "", "AMQP host and port. Leave empty to disable AMQP")
 	handlerCmd.Flags().String("amqp-address-announce", "", "AMQP address to announce (takes value of server-address-announce if empty while enabled)")
 	handlerCmd.Flags().String("amqp-username", "guest", "AMQP username")
-	handlerCmd.Flags().String("amqp-password", "guest", "AMQP password")
+	handlerCmd.Flags().String("amqp-password", "guest", "AMQP password (or secret:<name>)")
 	handlerCmd.Flags().String("amqp-exchange", "ttn.handler", "AMQP exchange")
 	viper.BindPFlag("handler.amqp-address", handlerCmd.Flags().Lookup("amqp-address"))
 	viper.BindPFlag("handler.amqp-address-announce", handlerCmd.Flags().Lookup("amqp-address-announce"))
 	viper.BindPFlag("handler.amqp-username", handlerCmd.Flags().Lookup("amqp-username"))
 	viper.BindPFlag("handler.amqp-password", handlerCmd.Flags().Lookup("amqp-password"))
 	viper.BindPFlag("handler.amqp-exchange", handlerCmd.Flags().Lookup("amqp-exchange"))
+	handlerCmd.Flags().Bool("amqp-tls", false, "Connect to the AMQP server with TLS")
+	handlerCmd.Flags().String("amqp-cert", "", "Client certificate for the AMQP server (for example issued with ttn ca issue)")
+	handlerCmd.Flags().String("amqp-key", "", "Private key of the client certificate for the AMQP server")
+	viper.BindPFlag("handler.amqp-tls", handlerCmd.Flags().Lookup("amqp-tls"))
+	viper.BindPFlag("handler.amqp-cert", handlerCmd.Flags().Lookup("amqp-cert"))
+	viper.BindPFlag("handler.amqp-key", handlerCmd.Flags().Lookup("amqp-key"))
+
+	handlerCmd.Flags().String("amqp-shadow-address", "", "AMQP host and port of a shadow integration that receives a copy of the uplink traffic without retries or alerts. Leave empty to disable")
+	handlerCmd.Flags().String("amqp-shadow-username", "guest", "AMQP username of the shadow integration")
+	handlerCmd.Flags().String("amqp-shadow-password", "guest", "AMQP password of the shadow integration (or secret:<name>)")
+	handlerCmd.Flags().String("amqp-shadow-exchange", "ttn.handler", "AMQP exchange of the shadow integration")
+	viper.BindPFlag("handler.amqp-shadow-address", handlerCmd.Flags().Lookup("amqp-shadow-address"))
+	viper.BindPFlag("handler.amqp-shadow-username", handlerCmd.Flags().Lookup("amqp-shadow-username"))
+	viper.BindPFlag("handler.amqp-shadow-password", handlerCmd.Flags().Lookup("amqp-shadow-password"))
+	viper.BindPFlag("handler.amqp-shadow-exchange", handlerCmd.Flags().Lookup("amqp-shadow-exchange"))
+
+	handlerCmd.Flags().String("webhook-cert", "", "Client certificate for webhooks with mutual TLS (for example issued with ttn ca issue)")
+	handlerCmd.Flags().String("webhook-key", "", "Private key of the client certificate for webhooks")
+	viper.BindPFlag("handler.webhook-cert", handlerCmd.Flags().Lookup("webhook-cert"))
+	viper.BindPFlag("handler.webhook-key", handlerCmd.Flags().Lookup("webhook-key"))
 
 	handlerCmd.Flags().String("server-address", "0.0.0.0", "The IP address to listen for communication")
 	handlerCmd.Flags().String("server-address-announce", "localhost", "The public IP address to announce")
@@ -210,4 +338,60 @@ func init() {
 	handlerCmd.Flags().Int("http-port", 8084, "The port where the gRPC proxy should listen")
 	viper.BindPFlag("handler.http-address", handlerCmd.Flags().Lookup("http-address"))
 	viper.BindPFlag("handler.http-port", handlerCmd.Flags().Lookup("http-port"))
+
+	addSecretsFlags(handlerCmd, "handler")
+
+	handlerCmd.Flags().Bool("read-only", false, "Only serve the ApplicationManager API from (a replica of) the database, without processing traffic")
+	viper.BindPFlag("handler.read-only", handlerCmd.Flags().Lookup("read-only"))
+
+	handlerCmd.Flags().Bool("verify-state", false, "Verify the referential integrity of the database on startup")
+	viper.BindPFlag("handler.verify-state", handlerCmd.Flags().Lookup("verify-state"))
+	handlerCmd.Flags().Bool("repair-state", false, "Repair inconsistencies in the database on startup (re-create missing applications with empty settings, such as without payload functions, and delete orphaned downlink queues and uplink histories)")
+	viper.BindPFlag("handler.repair-state", handlerCmd.Flags().Lookup("repair-state"))
+
+	handlerCmd.Flags().Duration("max-function-timeout", time.Second, "The maximum time that applications can allow each payload function to run")
+	viper.BindPFlag("handler.max-function-timeout", handlerCmd.Flags().Lookup("max-function-timeout"))
+	handlerCmd.Flags().Int("max-function-call-depth", 256, "The maximum depth of nested function calls in each run of a payload function")
+	viper.BindPFlag("handler.max-function-call-depth", handlerCmd.Flags().Lookup("max-function-call-depth"))
+	handlerCmd.Flags().Int("max-function-steps", 100, "The maximum number of 10ms steps that each run of a payload function may take (0 for no limit)")
+	viper.BindPFlag("handler.max-function-steps", handlerCmd.Flags().Lookup("max-function-steps"))
+	handlerCmd.Flags().Int("max-decoded-fields", 1000, "The maximum number of fields, including nested fields, that may be decoded from a payload (0 for no limit)")
+	viper.BindPFlag("handler.max-decoded-fields", handlerCmd.Flags().Lookup("max-decoded-fields"))
+	handlerCmd.Flags().Int("max-decoded-depth", 16, "The maximum nesting depth of the fields that are decoded from a payload (0 for no limit)")
+	viper.BindPFlag("handler.max-decoded-depth", handlerCmd.Flags().Lookup("max-decoded-depth"))
+	handlerCmd.Flags().Int("max-decoded-size", 64, "The maximum size in KB of the fields that are decoded from a payload as JSON (0 for no limit)")
+	viper.BindPFlag("handler.max-decoded-size", handlerCmd.Flags().Lookup("max-decoded-size"))
+	handlerCmd.Flags().String("go-codec-plugins", "", "Directory with Go plugins (*.so) to load as codecs for the go payload format. Leave empty to disable")
+	viper.BindPFlag("handler.go-codec-plugins", handlerCmd.Flags().Lookup("go-codec-plugins"))
+
+	handlerCmd.Flags().String("dev-addr-allocation", pb_lorawan.DevAddrAllocationRandom, "Default strategy for the allocation of a DevAddr when devices join (random, sequential or sticky)")
+	viper.BindPFlag("handler.dev-addr-allocation", handlerCmd.Flags().Lookup("dev-addr-allocation"))
+
+	handlerCmd.Flags().String("region", "", "The region that the Handler is deployed in. Applications with a data residency in another region are refused and their traffic is dropped")
+	viper.BindPFlag("handler.region", handlerCmd.Flags().Lookup("region"))
+
+	handlerCmd.Flags().Duration("recycle-bin-retention", 7*24*time.Hour, "How long deleted applications and devices are kept in the recycle bin, so that they can be restored (0 to delete them immediately)")
+	viper.BindPFlag("handler.recycle-bin-retention", handlerCmd.Flags().Lookup("recycle-bin-retention"))
+
+	handlerCmd.Flags().Bool("device-repository", false, "Decode and encode the payload of devices with a brand and model in their profile with the codec of the device repository, if the application has no payload functions")
+	viper.BindPFlag("handler.device-repository", handlerCmd.Flags().Lookup("device-repository"))
+	handlerCmd.Flags().String("device-repository-url", devicerepository.DefaultURL, "The URL of the device repository")
+	viper.BindPFlag("handler.device-repository-url", handlerCmd.Flags().Lookup("device-repository-url"))
+
+	handlerCmd.Flags().String("archive", "", "Archive all uplink messages to compressed hourly files in a directory or S3 bucket (s3://bucket/prefix). Leave empty to disable")
+	viper.BindPFlag("handler.archive", handlerCmd.Flags().Lookup("archive"))
+	handlerCmd.Flags().String("archive-s3-region", "eu-west-1", "Region of the S3 bucket of the archive")
+	viper.BindPFlag("handler.archive-s3-region", handlerCmd.Flags().Lookup("archive-s3-region"))
+
+	handlerCmd.Flags().StringSlice("replica-peers", []string{}, "HTTP addresses of the other replicas of this Handler (for example http://handler-2:8084) to exchange recently changed state with, so that short Redis outages are ridden out")
+	viper.BindPFlag("handler.replica-peers", handlerCmd.Flags().Lookup("replica-peers"))
+	handlerCmd.Flags().String("replica-key", "", "Key that authenticates the replicas of this Handler (or secret:<name>)")
+	viper.BindPFlag("handler.replica-key", handlerCmd.Flags().Lookup("replica-key"))
+
+	handlerCmd.Flags().String("join-server-address", "", "Join Server host and port. Leave empty to handle joins with the root keys in the Handler database")
+	viper.BindPFlag("handler.join-server-address", handlerCmd.Flags().Lookup("join-server-address"))
+	handlerCmd.Flags().String("join-server-cert", "", "Join Server certificate to use")
+	viper.BindPFlag("handler.join-server-cert", handlerCmd.Flags().Lookup("join-server-cert"))
+	handlerCmd.Flags().String("join-server-token", "", "Join Server token to use (issued with ttn joinserver authorize)")
+	viper.BindPFlag("handler.join-server-token", handlerCmd.Flags().Lookup("join-server-token"))
 }
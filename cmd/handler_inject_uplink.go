@@ -0,0 +1,57 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/spf13/cobra"
+)
+
+// handlerInjectUplinkCmd represents the handler inject-uplink command
+var handlerInjectUplinkCmd = &cobra.Command{
+	Use:   "inject-uplink [AppID] [DevID] [Fields]",
+	Short: "Publish a synthetic uplink message with fields to the integrations of an application",
+	Long: `ttn handler inject-uplink publishes an uplink message with the given decoded fields to the
+integrations of an application, without payload functions. The metadata of the message is flagged
+as simulated. This can be used to test dashboards and alerts end-to-end without devices.`,
+	Example: `$ ttn handler inject-uplink test dev '{"temperature": 21.5}'
+  INFO Injected uplink                          AppID=test DevID=dev`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 3 {
+			cmd.UsageFunc()(cmd)
+			return
+		}
+		port, _ := cmd.Flags().GetInt("port")
+		req := &pb.InjectedUplinkMessage{
+			AppId:  args[0],
+			DevId:  args[1],
+			Port:   uint32(port),
+			Fields: args[2],
+		}
+		if err := req.Validate(); err != nil {
+			ctx.WithError(err).Fatal("Invalid uplink")
+		}
+
+		conn, client, md := dialHandlerManager(cmd)
+		defer conn.Close()
+
+		if _, err := client.InjectUplink(md, req); err != nil {
+			ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not inject uplink")
+		}
+
+		ctx.WithFields(ttnlog.Fields{
+			"AppID": req.AppId,
+			"DevID": req.DevId,
+		}).Info("Injected uplink")
+	},
+}
+
+func init() {
+	handlerCmd.AddCommand(handlerInjectUplinkCmd)
+
+	addHandlerAddressFlag(handlerInjectUplinkCmd)
+	handlerInjectUplinkCmd.Flags().Int("port", 1, "The port number of the uplink message")
+}
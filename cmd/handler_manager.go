@@ -0,0 +1,44 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/TheThingsNetwork/ttn/api"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/api/pool"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// addHandlerAddressFlag adds the --handler-address flag that is used by dialHandlerManager
+func addHandlerAddressFlag(cmd *cobra.Command) {
+	cmd.Flags().String("handler-address", "localhost:1904", "The address of the Handler")
+}
+
+// dialHandlerManager connects to the HandlerManager of the Handler at the --handler-address of the command. The
+// returned context contains the auth-token.
+func dialHandlerManager(cmd *cobra.Command) (*grpc.ClientConn, pb.HandlerManagerClient, context.Context) {
+	path := filepath.Clean(viper.GetString("key-dir") + "/ca.cert")
+	cert, err := ioutil.ReadFile(path)
+	if err == nil && !pool.RootCAs.AppendCertsFromPEM(cert) {
+		ctx.Warnf("Could not add root certificates from %s", path)
+	}
+
+	address, _ := cmd.Flags().GetString("handler-address")
+	conn, err := api.Dial(address)
+	if err != nil {
+		ctx.WithError(err).Fatal("Could not connect to Handler")
+	}
+
+	md := metadata.Pairs(
+		"token", viper.GetString("auth-token"),
+	)
+	return conn, pb.NewHandlerManagerClient(conn), metadata.NewContext(context.Background(), md)
+}
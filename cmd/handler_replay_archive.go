@@ -0,0 +1,68 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/spf13/cobra"
+)
+
+// handlerReplayArchiveCmd represents the handler replay-archive command
+var handlerReplayArchiveCmd = &cobra.Command{
+	Use:   "replay-archive",
+	Short: "Replay the uplink messages in the archive of a Handler",
+	Long: `ttn handler replay-archive pushes the uplink messages in the archive of a Handler through
+the uplink processing again and publishes them to the applications. This can be used for disaster
+recovery, or for integrations that are added later.
+
+The Handler must be started with --archive.`,
+	Example: `$ ttn handler replay-archive --since 2017-10-19T13:00:00Z --until 2017-10-19T15:00:00Z --app-id test
+  INFO Replayed archive                         NumFiles=2 NumReplayed=118 NumUplinks=118`,
+	Run: func(cmd *cobra.Command, args []string) {
+		in, _ := cmd.Flags().GetString("since")
+		since, err := time.Parse(time.RFC3339, in)
+		if err != nil {
+			ctx.WithError(errors.NewErrInvalidArgument("Since", "must be a time like 2017-10-19T13:00:00Z")).Fatal("Invalid time range")
+		}
+		appIDs, _ := cmd.Flags().GetStringSlice("app-id")
+		req := &pb.ReplayArchiveRequest{
+			Since:  since.UnixNano(),
+			AppIds: appIDs,
+		}
+		if in, _ := cmd.Flags().GetString("until"); in != "" {
+			until, err := time.Parse(time.RFC3339, in)
+			if err != nil {
+				ctx.WithError(errors.NewErrInvalidArgument("Until", "must be a time like 2017-10-19T15:00:00Z")).Fatal("Invalid time range")
+			}
+			req.Until = until.UnixNano()
+		}
+
+		conn, client, md := dialHandlerManager(cmd)
+		defer conn.Close()
+
+		res, err := client.ReplayArchive(md, req)
+		if err != nil {
+			ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not replay archive")
+		}
+
+		ctx.WithFields(ttnlog.Fields{
+			"NumFiles":    res.NumFiles,
+			"NumUplinks":  res.NumUplinks,
+			"NumReplayed": res.NumReplayed,
+		}).Info("Replayed archive")
+	},
+}
+
+func init() {
+	handlerCmd.AddCommand(handlerReplayArchiveCmd)
+
+	addHandlerAddressFlag(handlerReplayArchiveCmd)
+	handlerReplayArchiveCmd.Flags().String("since", "", "Replay messages that were received at or after this time (RFC3339)")
+	handlerReplayArchiveCmd.Flags().String("until", "", "Only replay messages that were received before this time (RFC3339, default now)")
+	handlerReplayArchiveCmd.Flags().StringSlice("app-id", []string{}, "Only replay messages of these applications")
+}
@@ -0,0 +1,100 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/joinserver"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"gopkg.in/redis.v5"
+)
+
+// joinserverCmd represents the joinserver command
+var joinserverCmd = &cobra.Command{
+	Use:   "joinserver",
+	Short: "The Things Network joinserver",
+	Long: `ttn joinserver handles the OTAA joins of devices for Handlers that are started with --join-server-address.
+
+The Join Server stores the root keys of the devices, so that it can be deployed separately from the Handlers.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		ctx.WithFields(ttnlog.Fields{
+			"Server":   fmt.Sprintf("%s:%d", viper.GetString("joinserver.server-address"), viper.GetInt("joinserver.server-port")),
+			"Database": fmt.Sprintf("%s/%d", viper.GetString("joinserver.redis-address"), viper.GetInt("joinserver.redis-db")),
+		}).Info("Initializing Join Server")
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx.Info("Starting")
+
+		// Redis Client
+		client := redis.NewClient(&redis.Options{
+			Addr:     viper.GetString("joinserver.redis-address"),
+			Password: viper.GetString("joinserver.redis-password"),
+			DB:       viper.GetInt("joinserver.redis-db"),
+		})
+
+		if err := connectRedis(client); err != nil {
+			ctx.WithError(err).Fatal("Could not initialize database connection")
+		}
+
+		// Component
+		component, err := component.New(ttnlog.Get(), "joinserver", fmt.Sprintf("%s:%d", viper.GetString("joinserver.server-address-announce"), viper.GetInt("joinserver.server-port")))
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not initialize component")
+		}
+		component.WatchRedis(client)
+
+		// joinserver Server
+		joinserver := joinserver.NewRedisJoinServer(client)
+		err = joinserver.Init(component)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not initialize joinserver")
+		}
+
+		// gRPC Server
+		lis, err := net.Listen("tcp", fmt.Sprintf("%s:%d", viper.GetString("joinserver.server-address"), viper.GetInt("joinserver.server-port")))
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not start gRPC server")
+		}
+		grpc := grpc.NewServer(component.ServerOptions()...)
+
+		// Register and Listen
+		component.RegisterHealthServer(grpc)
+		joinserver.RegisterRPC(grpc)
+		go grpc.Serve(lis)
+
+		sigChan := make(chan os.Signal)
+		signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+		ctx.WithField("signal", <-sigChan).Info("signal received")
+
+		grpc.Stop()
+		joinserver.Shutdown()
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(joinserverCmd)
+
+	joinserverCmd.Flags().String("redis-address", "localhost:6379", "Redis server and port")
+	viper.BindPFlag("joinserver.redis-address", joinserverCmd.Flags().Lookup("redis-address"))
+	joinserverCmd.Flags().String("redis-password", "", "Redis password")
+	viper.BindPFlag("joinserver.redis-password", joinserverCmd.Flags().Lookup("redis-password"))
+	joinserverCmd.Flags().Int("redis-db", 0, "Redis database")
+	viper.BindPFlag("joinserver.redis-db", joinserverCmd.Flags().Lookup("redis-db"))
+
+	joinserverCmd.Flags().String("server-address", "0.0.0.0", "The IP address to listen for communication")
+	joinserverCmd.Flags().String("server-address-announce", "localhost", "The public IP address to announce")
+	joinserverCmd.Flags().Int("server-port", 1905, "The port for communication")
+	viper.BindPFlag("joinserver.server-address", joinserverCmd.Flags().Lookup("server-address"))
+	viper.BindPFlag("joinserver.server-address-announce", joinserverCmd.Flags().Lookup("server-address-announce"))
+	viper.BindPFlag("joinserver.server-port", joinserverCmd.Flags().Lookup("server-port"))
+}
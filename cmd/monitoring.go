@@ -0,0 +1,66 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func monitoringConfigCmd(component string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "monitoring-config",
+		Short: "Download recommended monitoring configuration",
+		Long: `ttn monitoring-config downloads recommended Prometheus alerting rules and a Grafana dashboard from the health server of a running component.
+
+Prometheus should scrape the load of the component from /load?format=prometheus on the health server.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			address, _ := cmd.Flags().GetString("health-address")
+			if address == "" {
+				address = fmt.Sprintf("localhost:%d", viper.GetInt("health-port"))
+			}
+			outputDir, _ := cmd.Flags().GetString("output-dir")
+			files := map[string]string{
+				"/monitoring/alerts":    fmt.Sprintf("ttn-%s-alerts.yml", component),
+				"/monitoring/dashboard": fmt.Sprintf("ttn-%s-dashboard.json", component),
+			}
+			for path, filename := range files {
+				res, err := http.Get(fmt.Sprintf("http://%s%s", address, path))
+				if err != nil {
+					ctx.WithError(err).Fatal("Could not connect to health server")
+				}
+				body, err := ioutil.ReadAll(res.Body)
+				res.Body.Close()
+				if err != nil {
+					ctx.WithError(err).Fatal("Could not read monitoring configuration")
+				}
+				if res.StatusCode != http.StatusOK {
+					ctx.WithField("Status", res.Status).Fatal("Could not get monitoring configuration")
+				}
+				filename = filepath.Join(outputDir, filename)
+				if err := ioutil.WriteFile(filename, body, 0644); err != nil {
+					ctx.WithError(err).Fatal("Could not write monitoring configuration")
+				}
+				ctx.WithField("File", filename).Info("Written")
+			}
+		},
+	}
+	cmd.Flags().String("health-address", "", "Address of the health server of the component (default localhost:<health-port>)")
+	cmd.Flags().String("output-dir", ".", "Directory to write the configuration files to")
+	return cmd
+}
+
+func init() {
+	routerCmd.AddCommand(monitoringConfigCmd("router"))
+	brokerCmd.AddCommand(monitoringConfigCmd("broker"))
+	handlerCmd.AddCommand(monitoringConfigCmd("handler"))
+	discoveryCmd.AddCommand(monitoringConfigCmd("discovery"))
+	networkserverCmd.AddCommand(monitoringConfigCmd("networkserver"))
+	joinserverCmd.AddCommand(monitoringConfigCmd("joinserver"))
+}
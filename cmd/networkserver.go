@@ -52,6 +52,7 @@ var networkserverCmd = &cobra.Command{
 		if err != nil {
 			ctx.WithError(err).Fatal("Could not initialize component")
 		}
+		component.WatchRedis(client)
 
 		// networkserver Server
 		networkserver := networkserver.NewRedisNetworkServer(client, viper.GetInt("networkserver.net-id"))
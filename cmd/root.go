@@ -18,6 +18,7 @@ import (
 	"github.com/TheThingsNetwork/go-utils/log/apex"
 	"github.com/TheThingsNetwork/go-utils/log/grpc"
 	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/core/component"
 	esHandler "github.com/TheThingsNetwork/ttn/utils/elasticsearch/handler"
 	"github.com/apex/log"
 	jsonHandler "github.com/apex/log/handlers/json"
@@ -140,6 +141,11 @@ func init() {
 	RootCmd.PersistentFlags().String("auth-token", "", "The JWT token to be used for the discovery server")
 
 	RootCmd.PersistentFlags().Int("health-port", 0, "The port number where the health server should be started")
+	RootCmd.PersistentFlags().Float64("message-capacity", 0, "The number of messages per second this component can handle (used for the load on the health server)")
+
+	RootCmd.PersistentFlags().String("snmp-trap-address", "", "Host and port of the SNMP manager that receives traps for critical events. Leave empty to disable SNMP traps")
+	RootCmd.PersistentFlags().String("snmp-community", "public", "SNMP community of the traps")
+	RootCmd.PersistentFlags().String("snmp-trap-oid", component.DefaultSNMPTrapOID, "OID under which the SNMP traps and their variables are defined")
 
 	viper.SetDefault("auth-servers", map[string]string{
 		"ttn-account-v2": "https://account.thethingsnetwork.org",
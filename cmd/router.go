@@ -13,9 +13,11 @@ import (
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
 	"github.com/TheThingsNetwork/ttn/core/component"
 	"github.com/TheThingsNetwork/ttn/core/router"
+	"github.com/TheThingsNetwork/ttn/core/router/gateway"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
+	"gopkg.in/redis.v5"
 )
 
 // routerCmd represents the router command
@@ -42,8 +44,27 @@ var routerCmd = &cobra.Command{
 			component.Identity.MqttAddress = mqttAddress
 		}
 
+		// Gateway state can be shared with other Router instances through Redis
+		newRouter := router.NewRouter
+		if redisAddress := viper.GetString("router.redis-address"); redisAddress != "" {
+			client := redis.NewClient(&redis.Options{
+				Addr:     redisAddress,
+				Password: viper.GetString("router.redis-password"),
+				DB:       viper.GetInt("router.redis-db"),
+			})
+			if err := connectRedis(client); err != nil {
+				ctx.WithError(err).Fatal("Could not initialize database connection")
+			}
+			component.WatchRedis(client)
+			newRouter = func() router.Router {
+				return router.NewRedisRouter(client)
+			}
+		}
+
+		gateway.MaxQueuedDownlinksPerApplication = viper.GetInt("router.max-queued-downlinks")
+
 		// Router
-		router := router.NewRouter()
+		router := newRouter()
 		err = router.Init(component)
 		if err != nil {
 			ctx.WithError(err).Fatal("Could not initialize router")
@@ -78,9 +99,17 @@ func init() {
 	routerCmd.Flags().Int("server-port", 1901, "The port for communication")
 	routerCmd.Flags().String("mqtt-address-announce", "", "MQTT address to announce")
 	routerCmd.Flags().Bool("skip-verify-gateway-token", false, "Skip verification of the gateway token")
+	routerCmd.Flags().String("redis-address", "", "Redis host and port for sharing gateway state between Routers")
+	routerCmd.Flags().String("redis-password", "", "Redis password")
+	routerCmd.Flags().Int("redis-db", 0, "Redis database")
+	routerCmd.Flags().Int("max-queued-downlinks", 8, "Maximum number of downlinks of one application that can be queued for a gateway (0 for no limit)")
 	viper.BindPFlag("router.server-address", routerCmd.Flags().Lookup("server-address"))
 	viper.BindPFlag("router.server-address-announce", routerCmd.Flags().Lookup("server-address-announce"))
 	viper.BindPFlag("router.server-port", routerCmd.Flags().Lookup("server-port"))
 	viper.BindPFlag("router.mqtt-address-announce", routerCmd.Flags().Lookup("mqtt-address-announce"))
 	viper.BindPFlag("router.skip-verify-gateway-token", routerCmd.Flags().Lookup("skip-verify-gateway-token"))
+	viper.BindPFlag("router.redis-address", routerCmd.Flags().Lookup("redis-address"))
+	viper.BindPFlag("router.redis-password", routerCmd.Flags().Lookup("redis-password"))
+	viper.BindPFlag("router.redis-db", routerCmd.Flags().Lookup("redis-db"))
+	viper.BindPFlag("router.max-queued-downlinks", routerCmd.Flags().Lookup("max-queued-downlinks"))
 }
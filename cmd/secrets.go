@@ -0,0 +1,76 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/secrets"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var secretsBackend secrets.Backend
+
+// getSecretsBackend returns the secrets backend that is configured for the component
+func getSecretsBackend(component string) secrets.Backend {
+	if secretsBackend != nil {
+		return secretsBackend
+	}
+	backend, err := newSecretsBackend(component)
+	if err != nil {
+		ctx.WithField("Backend", viper.GetString(component+".secrets-backend")).Fatal("Unknown secrets backend")
+	}
+	if backend == nil {
+		return nil
+	}
+	secretsBackend = backend
+	return secretsBackend
+}
+
+// newSecretsBackend returns a new secrets backend with the configuration of the component, or nil if the component
+// has no secrets backend
+func newSecretsBackend(component string) (secrets.Backend, error) {
+	var backend secrets.Backend
+	switch name := viper.GetString(component + ".secrets-backend"); name {
+	case "":
+		return nil, nil
+	case "vault":
+		backend = secrets.NewVaultBackend(
+			viper.GetString(component+".secrets-vault-address"),
+			viper.GetString(component+".secrets-vault-token"),
+			viper.GetString(component+".secrets-vault-mount"),
+		)
+	case "aws":
+		backend = secrets.NewAWSBackend(viper.GetString(component + ".secrets-aws-region"))
+	default:
+		return nil, errors.NewErrInvalidArgument("Secrets backend", "unknown backend "+name)
+	}
+	return secrets.NewCachedBackend(backend, viper.GetDuration(component+".secrets-cache-ttl")), nil
+}
+
+// getSecret returns the value of the config key, resolving references to secrets ("secret:<name>")
+func getSecret(component, key string) string {
+	value, err := secrets.Resolve(getSecretsBackend(component), viper.GetString(component+"."+key))
+	if err != nil {
+		ctx.WithError(err).WithField("Key", key).Fatal("Could not get secret")
+	}
+	return value
+}
+
+func addSecretsFlags(cmd *cobra.Command, component string) {
+	cmd.Flags().String("secrets-backend", "", "Secrets backend for credentials that are referenced as secret:<name> (vault or aws)")
+	cmd.Flags().String("secrets-vault-address", "http://localhost:8200", "Address of the Vault server")
+	cmd.Flags().String("secrets-vault-token", "", "Token for the Vault server")
+	cmd.Flags().String("secrets-vault-mount", "secret", "Mount of the KV secrets engine in Vault")
+	cmd.Flags().String("secrets-aws-region", "", "Region of AWS Secrets Manager")
+	cmd.Flags().Duration("secrets-cache-ttl", 5*time.Minute, "How long secrets are cached before they are fetched again")
+	viper.BindPFlag(component+".secrets-backend", cmd.Flags().Lookup("secrets-backend"))
+	viper.BindPFlag(component+".secrets-vault-address", cmd.Flags().Lookup("secrets-vault-address"))
+	viper.BindPFlag(component+".secrets-vault-token", cmd.Flags().Lookup("secrets-vault-token"))
+	viper.BindPFlag(component+".secrets-vault-mount", cmd.Flags().Lookup("secrets-vault-mount"))
+	viper.BindPFlag(component+".secrets-aws-region", cmd.Flags().Lookup("secrets-aws-region"))
+	viper.BindPFlag(component+".secrets-cache-ttl", cmd.Flags().Lookup("secrets-cache-ttl"))
+}
@@ -34,6 +34,18 @@ func (f *FrequencyPlan) GetDataRateIndexFor(dataRate string) (int, error) {
 	return f.Band.GetDataRate(lora.DataRate{Modulation: lora.LoRaModulation, SpreadFactor: int(dr.SpreadingFactor), Bandwidth: int(dr.Bandwidth)})
 }
 
+// GetMaxPayloadSizeFor returns the maximum size of the application payload (FRMPayload) at the given data rate
+func (f *FrequencyPlan) GetMaxPayloadSizeFor(dataRate string) (int, error) {
+	drIdx, err := f.GetDataRateIndexFor(dataRate)
+	if err != nil {
+		return 0, err
+	}
+	if drIdx >= len(f.MaxPayloadSize) {
+		return 0, errors.New("core/band: the maximum payload size for the given data rate is unknown")
+	}
+	return f.MaxPayloadSize[drIdx].N, nil
+}
+
 func (f *FrequencyPlan) GetTxPowerIndexFor(txPower int) (int, error) {
 	for i, power := range f.TXPower {
 		if power == txPower {
@@ -43,6 +55,29 @@ func (f *FrequencyPlan) GetTxPowerIndexFor(txPower int) (int, error) {
 	return 0, errors.New("core/band: the given tx-power does not exist")
 }
 
+// GetClosestTxPowerIndexFor returns the index of the lowest tx-power that is at least the given tx-power, or of the
+// highest tx-power if the given tx-power is higher than that
+func (f *FrequencyPlan) GetClosestTxPowerIndexFor(txPower int) (int, error) {
+	if len(f.TXPower) == 0 {
+		return 0, errors.New("core/band: the tx-powers are unknown")
+	}
+	closest := -1
+	for i, power := range f.TXPower {
+		if power >= txPower && (closest < 0 || power < f.TXPower[closest]) {
+			closest = i
+		}
+	}
+	if closest >= 0 {
+		return closest, nil
+	}
+	for i, power := range f.TXPower {
+		if closest < 0 || power > f.TXPower[closest] {
+			closest = i
+		}
+	}
+	return closest, nil
+}
+
 // Guess the region based on frequency
 func Guess(frequency uint64) string {
 	// Join frequencies
@@ -146,6 +181,42 @@ func Get(region string) (frequencyPlan FrequencyPlan, err error) {
 		}
 		frequencyPlan.DownlinkChannels = frequencyPlan.UplinkChannels
 		frequencyPlan.CFList = &lorawan.CFList{922700000, 922900000, 923100000, 923300000, 0}
+	case pb_lorawan.FrequencyPlan_ISM_2400.String():
+		// The 2.4GHz band uses the same RX1 behaviour as the EU band (downlink on
+		// the uplink frequency, same data rate offsets), so we start from there
+		frequencyPlan.Band, err = lora.GetConfig(lora.EU_863_870, false, lorawan.DwellTimeNoLimit)
+		frequencyPlan.DefaultTXPower = 10
+		frequencyPlan.RX2Frequency = 2423000000
+		frequencyPlan.RX2DataRate = 0
+		frequencyPlan.DataRates = []lora.DataRate{
+			{Modulation: lora.LoRaModulation, SpreadFactor: 12, Bandwidth: 812},
+			{Modulation: lora.LoRaModulation, SpreadFactor: 11, Bandwidth: 812},
+			{Modulation: lora.LoRaModulation, SpreadFactor: 10, Bandwidth: 812},
+			{Modulation: lora.LoRaModulation, SpreadFactor: 9, Bandwidth: 812},
+			{Modulation: lora.LoRaModulation, SpreadFactor: 8, Bandwidth: 812},
+			{Modulation: lora.LoRaModulation, SpreadFactor: 7, Bandwidth: 812},
+			{Modulation: lora.LoRaModulation, SpreadFactor: 6, Bandwidth: 812},
+			{Modulation: lora.LoRaModulation, SpreadFactor: 5, Bandwidth: 812},
+		}
+		frequencyPlan.MaxPayloadSize = []lora.MaxPayloadSize{
+			{M: 59, N: 51},
+			{M: 123, N: 115},
+			{M: 248, N: 240},
+			{M: 248, N: 240},
+			{M: 248, N: 240},
+			{M: 248, N: 240},
+			{M: 248, N: 240},
+			{M: 248, N: 240},
+		}
+		frequencyPlan.TXPower = []int{10, 8, 6, 4, 2, 0, -2, -4}
+		frequencyPlan.UplinkChannels = []lora.Channel{
+			lora.Channel{Frequency: 2403000000, DataRates: []int{0, 1, 2, 3, 4, 5, 6, 7}},
+			lora.Channel{Frequency: 2425000000, DataRates: []int{0, 1, 2, 3, 4, 5, 6, 7}},
+			lora.Channel{Frequency: 2479000000, DataRates: []int{0, 1, 2, 3, 4, 5, 6, 7}},
+		}
+		frequencyPlan.DownlinkChannels = frequencyPlan.UplinkChannels
+		// The CFList can not contain 2.4GHz frequencies
+		frequencyPlan.ImplementsCFlist = false
 	default:
 		err = errors.NewErrInvalidArgument("Frequency Band", "unknown")
 	}
@@ -169,6 +240,7 @@ func init() {
 		pb_lorawan.FrequencyPlan_KR_920_923,
 		pb_lorawan.FrequencyPlan_AU_915_928,
 		pb_lorawan.FrequencyPlan_CN_470_510,
+		pb_lorawan.FrequencyPlan_ISM_2400,
 	} {
 		region := r.String()
 		frequencyPlans[region], _ = Get(region)
@@ -22,6 +22,7 @@ func TestGuess(t *testing.T) {
 	a.So(Guess(922200000), ShouldEqual, "AS_920_923")
 	a.So(Guess(923600000), ShouldEqual, "AS_923_925")
 	a.So(Guess(922100000), ShouldEqual, "KR_920_923")
+	a.So(Guess(2425000000), ShouldEqual, "ISM_2400")
 
 	a.So(Guess(922100001), ShouldEqual, "") // Not allowed
 }
@@ -99,6 +100,18 @@ func TestGet(t *testing.T) {
 		a.So(fp.ADR, ShouldBeNil)
 	}
 
+	{
+		fp, err := Get("ISM_2400")
+		a.So(err, ShouldBeNil)
+		a.So(fp.CFList, ShouldBeNil)
+		a.So(fp.ADR, ShouldBeNil)
+		a.So(fp.ImplementsCFlist, ShouldBeFalse)
+		a.So(fp.RX2Frequency, ShouldEqual, 2423000000)
+		freq, err := fp.GetRX1Frequency(2479000000)
+		a.So(err, ShouldBeNil)
+		a.So(freq, ShouldEqual, 2479000000)
+	}
+
 }
 
 func TestGetDataRate(t *testing.T) {
@@ -115,6 +128,33 @@ func TestGetDataRate(t *testing.T) {
 		a.So(err, ShouldBeNil)
 		a.So(rate, ShouldEqual, expRate)
 	}
+
+	ism, _ := Get("ISM_2400")
+	ismRates := []string{"SF12BW812", "SF11BW812", "SF10BW812", "SF9BW812", "SF8BW812", "SF7BW812", "SF6BW812", "SF5BW812"}
+	for expIdx, expRate := range ismRates {
+		idx, err := ism.GetDataRateIndexFor(expRate)
+		a.So(err, ShouldBeNil)
+		a.So(idx, ShouldEqual, expIdx)
+
+		rate, err := ism.GetDataRateStringForIndex(expIdx)
+		a.So(err, ShouldBeNil)
+		a.So(rate, ShouldEqual, expRate)
+	}
+}
+
+func TestGetMaxPayloadSize(t *testing.T) {
+	a := New(t)
+
+	eu, _ := Get("EU_863_870")
+	euSizes := map[string]int{"SF12BW125": 51, "SF9BW125": 115, "SF7BW125": 222}
+	for dataRate, expSize := range euSizes {
+		size, err := eu.GetMaxPayloadSizeFor(dataRate)
+		a.So(err, ShouldBeNil)
+		a.So(size, ShouldEqual, expSize)
+	}
+
+	_, err := eu.GetMaxPayloadSizeFor("SF7BW500")
+	a.So(err, ShouldNotBeNil)
 }
 
 func TestGetTxPower(t *testing.T) {
@@ -128,3 +168,14 @@ func TestGetTxPower(t *testing.T) {
 		a.So(idx, ShouldEqual, expIdx)
 	}
 }
+
+func TestGetClosestTxPower(t *testing.T) {
+	a := New(t)
+
+	eu, _ := GetForRegionalParameters("EU_863_870", "1.0.2")
+	for txPower, expIdx := range map[int]int{16: 0, 20: 0, 14: 1, 11: 2, 5: 5, 2: 7, -1: 7} {
+		idx, err := eu.GetClosestTxPowerIndexFor(txPower)
+		a.So(err, ShouldBeNil)
+		a.So(idx, ShouldEqual, expIdx)
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package band
+
+import (
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// DutyCycle returns the maximum duty cycle for transmissions on the given frequency in the given region.
+// Regions without duty cycle limitations return 1.
+func DutyCycle(region string, frequency uint64) (float64, error) {
+	if region != pb_lorawan.FrequencyPlan_EU_863_870.String() {
+		return 1, nil
+	}
+	switch {
+	case frequency >= 863000000 && frequency < 868000000:
+		return 0.01, nil // g 863.0 – 868.0 MHz 1%
+	case frequency >= 868000000 && frequency < 868600000:
+		return 0.01, nil // g1 868.0 – 868.6 MHz 1%
+	case frequency >= 868700000 && frequency < 869200000:
+		return 0.001, nil // g2 868.7 – 869.2 MHz 0.1%
+	case frequency >= 869400000 && frequency < 869650000:
+		return 0.1, nil // g3 869.4 – 869.65 MHz 10%
+	case frequency >= 869700000 && frequency < 870000000:
+		return 0.01, nil // g4 869.7 – 870.0 MHz 1%
+	}
+	return 0, errors.New("core/band: transmissions on this frequency are forbidden")
+}
@@ -0,0 +1,33 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package band
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestDutyCycle(t *testing.T) {
+	a := New(t)
+
+	duty, err := DutyCycle("EU_863_870", 868100000)
+	a.So(err, ShouldBeNil)
+	a.So(duty, ShouldEqual, 0.01)
+
+	duty, err = DutyCycle("EU_863_870", 869525000)
+	a.So(err, ShouldBeNil)
+	a.So(duty, ShouldEqual, 0.1)
+
+	duty, err = DutyCycle("EU_863_870", 868900000)
+	a.So(err, ShouldBeNil)
+	a.So(duty, ShouldEqual, 0.001)
+
+	_, err = DutyCycle("EU_863_870", 869300000)
+	a.So(err, ShouldNotBeNil)
+
+	duty, err = DutyCycle("US_902_928", 923300000)
+	a.So(err, ShouldBeNil)
+	a.So(duty, ShouldEqual, 1)
+}
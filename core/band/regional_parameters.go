@@ -0,0 +1,65 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package band
+
+import (
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// maxEIRP contains the maximum EIRP (dBm) of the regions in which the transmit power is defined relative to it
+var maxEIRP = map[string]int{
+	pb_lorawan.FrequencyPlan_EU_863_870.String(): 16,
+	pb_lorawan.FrequencyPlan_US_902_928.String(): 30,
+	pb_lorawan.FrequencyPlan_AU_915_928.String(): 30,
+}
+
+// txPowerSteps returns the number of transmit powers in the region, as defined by the revision of the Regional
+// Parameters
+func txPowerSteps(region, revision string) int {
+	switch region {
+	case pb_lorawan.FrequencyPlan_EU_863_870.String():
+		return 8
+	case pb_lorawan.FrequencyPlan_US_902_928.String(), pb_lorawan.FrequencyPlan_AU_915_928.String():
+		if revision == pb_lorawan.RegionalParameters1_0_2 {
+			return 11
+		}
+		return 15
+	}
+	return 0
+}
+
+// GetForRegionalParameters returns the frequency plan for the given region, as defined by the given revision of the
+// Regional Parameters. Up to revision 1.0.1, the transmit powers are absolute values. From revision 1.0.2, they are
+// steps of 2 dB below the maximum EIRP of the region.
+func GetForRegionalParameters(region, revision string) (frequencyPlan FrequencyPlan, err error) {
+	frequencyPlan, err = Get(region)
+	if err != nil {
+		return
+	}
+	switch revision {
+	case "", pb_lorawan.RegionalParameters1_0, pb_lorawan.RegionalParameters1_0_1:
+		return frequencyPlan, nil
+	case pb_lorawan.RegionalParameters1_0_2, pb_lorawan.RegionalParameters1_0_2B, pb_lorawan.RegionalParameters1_0_3A:
+	default:
+		return frequencyPlan, errors.NewErrInvalidArgument("Regional Parameters", "unknown revision")
+	}
+
+	eirp, ok := maxEIRP[region]
+	if !ok {
+		return frequencyPlan, nil
+	}
+	// The TXPower slice is shared with the cached frequency plan, so we replace it instead of changing it
+	steps := txPowerSteps(region, revision)
+	frequencyPlan.TXPower = make([]int, steps)
+	for i := range frequencyPlan.TXPower {
+		frequencyPlan.TXPower[i] = eirp - 2*i
+	}
+	if frequencyPlan.ADR != nil && frequencyPlan.ADR.MaxTXPower > eirp {
+		adr := *frequencyPlan.ADR
+		adr.MaxTXPower = eirp
+		frequencyPlan.ADR = &adr
+	}
+	return frequencyPlan, nil
+}
@@ -0,0 +1,52 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package band
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestGetForRegionalParameters(t *testing.T) {
+	a := New(t)
+
+	{
+		fp, err := GetForRegionalParameters("EU_863_870", "")
+		a.So(err, ShouldBeNil)
+		a.So(fp.TXPower, ShouldResemble, []int{20, 14, 11, 8, 5, 2})
+	}
+
+	{
+		fp, err := GetForRegionalParameters("EU_863_870", "1.0.2-b")
+		a.So(err, ShouldBeNil)
+		a.So(fp.TXPower, ShouldResemble, []int{16, 14, 12, 10, 8, 6, 4, 2})
+		a.So(fp.ADR.MaxTXPower, ShouldEqual, 14)
+	}
+
+	// The cached frequency plan is not changed
+	{
+		fp, _ := Get("EU_863_870")
+		a.So(fp.TXPower, ShouldResemble, []int{20, 14, 11, 8, 5, 2})
+	}
+
+	{
+		fp, err := GetForRegionalParameters("US_902_928", "1.0.2")
+		a.So(err, ShouldBeNil)
+		a.So(fp.TXPower, ShouldHaveLength, 11)
+		a.So(fp.TXPower[10], ShouldEqual, 10)
+	}
+
+	{
+		fp, err := GetForRegionalParameters("US_902_928", "1.0.3-a")
+		a.So(err, ShouldBeNil)
+		a.So(fp.TXPower, ShouldHaveLength, 15)
+		a.So(fp.TXPower[14], ShouldEqual, 2)
+	}
+
+	{
+		_, err := GetForRegionalParameters("EU_863_870", "2.0")
+		a.So(err, ShouldNotBeNil)
+	}
+}
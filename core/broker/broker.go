@@ -25,6 +25,7 @@ type Broker interface {
 	component.ManagementInterface
 
 	SetNetworkServer(addr, cert, token string)
+	WithUplinkFilter(name string, filter UplinkFilter) Broker
 
 	HandleUplink(uplink *pb.UplinkMessage) error
 	HandleDownlink(downlink *pb.DownlinkMessage) error
@@ -66,6 +67,7 @@ type broker struct {
 	activationDeduplicator Deduplicator
 	status                 *status
 	monitorStream          pb_monitor.GenericStream
+	uplinkFilters          []namedUplinkFilter
 }
 
 func (b *broker) checkPrefixAnnouncements() error {
@@ -111,6 +113,7 @@ nextPrefix:
 func (b *broker) Init(c *component.Component) error {
 	b.Component = c
 	b.InitStatus()
+	b.Component.RegisterRateLoadSignal("uplink", b.status.uplink)
 	err := b.Component.UpdateTokenKey()
 	if err != nil {
 		return err
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
 	pb "github.com/TheThingsNetwork/ttn/api/broker"
 	"github.com/TheThingsNetwork/ttn/api/fields"
 	"github.com/TheThingsNetwork/ttn/api/trace"
@@ -44,22 +45,53 @@ func (b *broker) HandleDownlink(downlink *pb.DownlinkMessage) error {
 		return errors.Wrap(errors.FromGRPCError(err), "NetworkServer did not handle downlink")
 	}
 
+	var diversity *pb.DownlinkMessage
+	if downlink.DiversityDownlinkOption != nil {
+		clone := *downlink
+		clone.DownlinkOption = downlink.DiversityDownlinkOption
+		clone.DiversityDownlinkOption = nil
+		diversity = &clone
+		downlink.DiversityDownlinkOption = nil
+	}
+
+	routerID, err := b.forwardDownlink(downlink)
+	if err != nil {
+		return err
+	}
+	ctx = ctx.WithField("RouterID", routerID)
+
+	if diversity != nil {
+		routerID, err := b.forwardDownlink(diversity)
+		if err != nil {
+			ctx.WithError(err).Warn("Could not forward critical downlink to second gateway")
+		} else {
+			ctx.WithFields(ttnlog.Fields{
+				"DiversityRouterID":  routerID,
+				"DiversityGatewayID": diversity.DownlinkOption.GatewayId,
+			}).Debug("Forwarded critical downlink to second gateway")
+		}
+	}
+
+	return nil
+}
+
+// forwardDownlink forwards the downlink to the Router of its DownlinkOption and returns the ID of that Router
+func (b *broker) forwardDownlink(downlink *pb.DownlinkMessage) (string, error) {
 	var routerID string
 	if id := strings.Split(downlink.DownlinkOption.Identifier, ":"); len(id) == 2 {
 		routerID = id[0]
 	} else {
-		return errors.NewErrInvalidArgument("DownlinkOption Identifier", "invalid format")
+		return "", errors.NewErrInvalidArgument("DownlinkOption Identifier", "invalid format")
 	}
-	ctx = ctx.WithField("RouterID", routerID)
 
 	router, err := b.getRouter(routerID)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	downlink.Trace = downlink.Trace.WithEvent(trace.ForwardEvent, "router", routerID)
 
 	router <- downlink
 
-	return nil
+	return routerID, nil
 }
@@ -8,6 +8,7 @@ import (
 
 	pb "github.com/TheThingsNetwork/ttn/api/broker"
 	pb_monitor "github.com/TheThingsNetwork/ttn/api/monitor"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/core/component"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	. "github.com/TheThingsNetwork/ttn/utils/testing"
@@ -61,4 +62,60 @@ func TestDownlink(t *testing.T) {
 	})
 	a.So(err, ShouldBeNil)
 	a.So(len(dlch), ShouldEqual, 1)
+	<-dlch
+
+	// Critical downlink
+	err = b.HandleDownlink(&pb.DownlinkMessage{
+		DevEui: &devEUI,
+		AppEui: &appEUI,
+		DownlinkOption: &pb.DownlinkOption{
+			Identifier: "routerID:scheduleID",
+			GatewayId:  "gateway-1",
+		},
+		DiversityDownlinkOption: &pb.DownlinkOption{
+			Identifier: "routerID:otherScheduleID",
+			GatewayId:  "gateway-2",
+		},
+	})
+	a.So(err, ShouldBeNil)
+	a.So(len(dlch), ShouldEqual, 2)
+	first, second := <-dlch, <-dlch
+	a.So(first.DownlinkOption.GatewayId, ShouldEqual, "gateway-1")
+	a.So(first.DiversityDownlinkOption, ShouldBeNil)
+	a.So(second.DownlinkOption.GatewayId, ShouldEqual, "gateway-2")
+	a.So(second.DiversityDownlinkOption, ShouldBeNil)
+}
+
+func TestSelectDiversityDownlink(t *testing.T) {
+	a := New(t)
+
+	rx1gtw1 := &pb.DownlinkOption{GatewayId: "gateway-1", Score: 10}
+	rx2gtw1 := &pb.DownlinkOption{GatewayId: "gateway-1", Score: 20}
+	rx1gtw2 := &pb.DownlinkOption{GatewayId: "gateway-2", Score: 30}
+	rx2gtw2 := &pb.DownlinkOption{GatewayId: "gateway-2", Score: 40}
+	options := []*pb.DownlinkOption{rx1gtw1, rx2gtw1, rx1gtw2, rx2gtw2}
+	delays := map[*pb.DownlinkOption]uint32{
+		rx1gtw1: 1000000,
+		rx2gtw1: 2000000,
+		rx1gtw2: 1000000,
+		rx2gtw2: 2000000,
+	}
+
+	a.So(selectDiversityDownlink(rx1gtw1, options, delays), ShouldEqual, rx2gtw2)
+	a.So(selectDiversityDownlink(rx2gtw1, options, delays), ShouldEqual, rx1gtw2)
+
+	// Only one gateway
+	a.So(selectDiversityDownlink(rx1gtw1, options[:2], delays), ShouldBeNil)
+}
+
+func TestFilterDownlinkOptions(t *testing.T) {
+	a := New(t)
+
+	rx1 := &pb.DownlinkOption{GatewayId: "gateway-1", RxWindow: pb_lorawan.RxWindow_RX1}
+	rx2 := &pb.DownlinkOption{GatewayId: "gateway-1", RxWindow: pb_lorawan.RxWindow_RX2}
+	options := []*pb.DownlinkOption{rx1, rx2}
+
+	a.So(filterDownlinkOptions(options, pb_lorawan.RxWindow_RX1), ShouldResemble, []*pb.DownlinkOption{rx1})
+	a.So(filterDownlinkOptions(options, pb_lorawan.RxWindow_RX2), ShouldResemble, []*pb.DownlinkOption{rx2})
+	a.So(filterDownlinkOptions(options[:1], pb_lorawan.RxWindow_RX2), ShouldBeEmpty)
 }
@@ -0,0 +1,197 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package broker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/TheThingsNetwork/ttn/api/broker"
+	"github.com/TheThingsNetwork/ttn/core/handler/functions"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// UplinkFilter is applied to uplink messages before the Broker forwards them to the Handlers. Filters allow operators
+// to apply policies to the traffic of all applications.
+type UplinkFilter interface {
+	// FilterUplink returns false if the uplink message should be dropped. It may add attributes to the message.
+	FilterUplink(uplink *pb.DeduplicatedUplinkMessage) (forward bool, err error)
+}
+
+// UplinkFilterFunc is a function that implements UplinkFilter
+type UplinkFilterFunc func(uplink *pb.DeduplicatedUplinkMessage) (forward bool, err error)
+
+// FilterUplink implements UplinkFilter
+func (f UplinkFilterFunc) FilterUplink(uplink *pb.DeduplicatedUplinkMessage) (bool, error) {
+	return f(uplink)
+}
+
+type namedUplinkFilter struct {
+	name   string
+	filter UplinkFilter
+}
+
+// WithUplinkFilter adds the filter to the end of the chain of uplink filters
+func (b *broker) WithUplinkFilter(name string, filter UplinkFilter) Broker {
+	b.uplinkFilters = append(b.uplinkFilters, namedUplinkFilter{name: name, filter: filter})
+	return b
+}
+
+// filterUplink applies the uplink filters in order. It returns the name of the filter that dropped the uplink message,
+// or an empty string if all filters forward it.
+func (b *broker) filterUplink(uplink *pb.DeduplicatedUplinkMessage) (dropped string, err error) {
+	if len(b.uplinkFilters) == 0 {
+		return "", nil
+	}
+	if err := uplink.UnmarshalPayload(); err != nil {
+		return "", err
+	}
+	for _, f := range b.uplinkFilters {
+		forward, err := f.filter.FilterUplink(uplink)
+		if err != nil {
+			return "", errors.Wrap(err, fmt.Sprintf("Uplink filter %s failed", f.name))
+		}
+		if !forward {
+			return f.name, nil
+		}
+	}
+	return "", nil
+}
+
+// setAttribute sets an attribute of the uplink message
+func setAttribute(uplink *pb.DeduplicatedUplinkMessage, key, value string) {
+	if uplink.Attributes == nil {
+		uplink.Attributes = make(map[string]string)
+	}
+	uplink.Attributes[key] = value
+}
+
+// DropPortsFilter returns an UplinkFilter that drops uplink messages on the given FPorts
+func DropPortsFilter(ports ...uint8) UplinkFilter {
+	drop := make(map[int32]bool, len(ports))
+	for _, port := range ports {
+		drop[int32(port)] = true
+	}
+	return UplinkFilterFunc(func(uplink *pb.DeduplicatedUplinkMessage) (bool, error) {
+		mac := uplink.GetMessage().GetLorawan().GetMacPayload()
+		if mac == nil {
+			return true, nil
+		}
+		return !drop[mac.FPort], nil
+	})
+}
+
+// GatewayGroupAttribute is the attribute that GatewayGroupFilter sets
+const GatewayGroupAttribute = "gateway_groups"
+
+// GatewayGroupFilter returns an UplinkFilter that sets the gateway_groups attribute to the groups of the gateways
+// that received the uplink message, separated by commas. The groups map gateway IDs to groups.
+func GatewayGroupFilter(groups map[string]string) UplinkFilter {
+	return UplinkFilterFunc(func(uplink *pb.DeduplicatedUplinkMessage) (bool, error) {
+		found := make(map[string]bool)
+		for _, gateway := range uplink.GatewayMetadata {
+			if group, ok := groups[gateway.GetGatewayId()]; ok {
+				found[group] = true
+			}
+		}
+		if len(found) == 0 {
+			return true, nil
+		}
+		names := make([]string, 0, len(found))
+		for group := range found {
+			names = append(names, group)
+		}
+		sort.Strings(names)
+		setAttribute(uplink, GatewayGroupAttribute, strings.Join(names, ","))
+		return true, nil
+	})
+}
+
+// AttributesFilter returns an UplinkFilter that sets the given attributes (for example the region of the Broker) on
+// all uplink messages
+func AttributesFilter(attributes map[string]string) UplinkFilter {
+	return UplinkFilterFunc(func(uplink *pb.DeduplicatedUplinkMessage) (bool, error) {
+		for key, value := range attributes {
+			setAttribute(uplink, key, value)
+		}
+		return true, nil
+	})
+}
+
+// ScriptFilterTimeout is the time that the Filter function of a ScriptFilter may run
+var ScriptFilterTimeout = 20 * time.Millisecond
+
+// ScriptFilter returns an UplinkFilter that calls the JavaScript function Filter(uplink) in the code. The uplink
+// object contains the app_id, dev_id, dev_eui, app_eui, port, counter, frequency, data_rate, gateways (the IDs of the
+// gateways that received the message) and attributes. The function returns false to drop the message, true to
+// forward it, or an object of attributes to add before it is forwarded.
+func ScriptFilter(code string) UplinkFilter {
+	return UplinkFilterFunc(func(uplink *pb.DeduplicatedUplinkMessage) (bool, error) {
+		env := map[string]interface{}{
+			"uplink": scriptUplink(uplink),
+		}
+		value, err := functions.RunCode("Filter", fmt.Sprintf(`
+			%s;
+			Filter(uplink)
+		`, code), env, ScriptFilterTimeout, functions.Ignore)
+		if err != nil {
+			return false, err
+		}
+		if value.IsBoolean() {
+			return value.ToBoolean()
+		}
+		if value.IsUndefined() {
+			return true, nil
+		}
+		result, _ := value.Export()
+		if result == nil {
+			return true, nil
+		}
+		attributes, ok := result.(map[string]interface{})
+		if !ok {
+			return false, errors.NewErrInvalidArgument("Filter", "does not return a boolean or an object")
+		}
+		for key, value := range attributes {
+			setAttribute(uplink, key, fmt.Sprint(value))
+		}
+		return true, nil
+	})
+}
+
+// scriptUplink returns the fields of the uplink message that are passed to a ScriptFilter
+func scriptUplink(uplink *pb.DeduplicatedUplinkMessage) map[string]interface{} {
+	gateways := make([]interface{}, 0, len(uplink.GatewayMetadata))
+	for _, gateway := range uplink.GatewayMetadata {
+		gateways = append(gateways, gateway.GetGatewayId())
+	}
+	attributes := make(map[string]interface{}, len(uplink.Attributes))
+	for key, value := range uplink.Attributes {
+		attributes[key] = value
+	}
+	fields := map[string]interface{}{
+		"app_id":     uplink.AppId,
+		"dev_id":     uplink.DevId,
+		"gateways":   gateways,
+		"attributes": attributes,
+	}
+	if uplink.DevEui != nil {
+		fields["dev_eui"] = uplink.DevEui.String()
+	}
+	if uplink.AppEui != nil {
+		fields["app_eui"] = uplink.AppEui.String()
+	}
+	if mac := uplink.GetMessage().GetLorawan().GetMacPayload(); mac != nil {
+		fields["port"] = mac.FPort
+		fields["counter"] = mac.FCnt
+	}
+	if lorawan := uplink.GetProtocolMetadata().GetLorawan(); lorawan != nil {
+		fields["data_rate"] = lorawan.DataRate
+	}
+	if len(uplink.GatewayMetadata) > 0 {
+		fields["frequency"] = float64(uplink.GatewayMetadata[0].GetFrequency()) / 1000000
+	}
+	return fields
+}
@@ -0,0 +1,125 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package broker
+
+import (
+	"errors"
+	"testing"
+
+	pb "github.com/TheThingsNetwork/ttn/api/broker"
+	"github.com/TheThingsNetwork/ttn/api/gateway"
+	"github.com/TheThingsNetwork/ttn/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	. "github.com/smartystreets/assertions"
+)
+
+func buildFilterUplink(port int32) *pb.DeduplicatedUplinkMessage {
+	return &pb.DeduplicatedUplinkMessage{
+		AppId: "app",
+		DevId: "dev",
+		Message: &protocol.Message{Protocol: &protocol.Message_Lorawan{Lorawan: &pb_lorawan.Message{
+			Payload: &pb_lorawan.Message_MacPayload{MacPayload: &pb_lorawan.MACPayload{FPort: port}},
+		}}},
+		GatewayMetadata: []*gateway.RxMetadata{
+			{GatewayId: "gtw-1", Frequency: 868100000},
+			{GatewayId: "gtw-2", Frequency: 868100000},
+		},
+	}
+}
+
+func TestDropPortsFilter(t *testing.T) {
+	a := New(t)
+	filter := DropPortsFilter(224)
+
+	forward, err := filter.FilterUplink(buildFilterUplink(1))
+	a.So(err, ShouldBeNil)
+	a.So(forward, ShouldBeTrue)
+
+	forward, err = filter.FilterUplink(buildFilterUplink(224))
+	a.So(err, ShouldBeNil)
+	a.So(forward, ShouldBeFalse)
+}
+
+func TestGatewayGroupFilter(t *testing.T) {
+	a := New(t)
+	filter := GatewayGroupFilter(map[string]string{"gtw-1": "south", "gtw-2": "north", "gtw-3": "north"})
+
+	uplink := buildFilterUplink(1)
+	forward, err := filter.FilterUplink(uplink)
+	a.So(err, ShouldBeNil)
+	a.So(forward, ShouldBeTrue)
+	a.So(uplink.Attributes[GatewayGroupAttribute], ShouldEqual, "north,south")
+
+	uplink = buildFilterUplink(1)
+	uplink.GatewayMetadata = []*gateway.RxMetadata{{GatewayId: "gtw-4"}}
+	forward, err = filter.FilterUplink(uplink)
+	a.So(err, ShouldBeNil)
+	a.So(forward, ShouldBeTrue)
+	a.So(uplink.Attributes, ShouldBeEmpty)
+}
+
+func TestScriptFilter(t *testing.T) {
+	a := New(t)
+	filter := ScriptFilter(`function Filter(uplink) {
+		if (uplink.port === 100) return false;
+		if (uplink.port === 101) return true;
+		if (uplink.port === 102) return "invalid";
+		return { region: uplink.attributes.region || "eu", gateways: uplink.gateways.length, frequency: uplink.frequency };
+	}`)
+
+	forward, err := filter.FilterUplink(buildFilterUplink(100))
+	a.So(err, ShouldBeNil)
+	a.So(forward, ShouldBeFalse)
+
+	uplink := buildFilterUplink(101)
+	forward, err = filter.FilterUplink(uplink)
+	a.So(err, ShouldBeNil)
+	a.So(forward, ShouldBeTrue)
+	a.So(uplink.Attributes, ShouldBeEmpty)
+
+	_, err = filter.FilterUplink(buildFilterUplink(102))
+	a.So(err, ShouldNotBeNil)
+
+	uplink = buildFilterUplink(1)
+	forward, err = filter.FilterUplink(uplink)
+	a.So(err, ShouldBeNil)
+	a.So(forward, ShouldBeTrue)
+	a.So(uplink.Attributes, ShouldResemble, map[string]string{"region": "eu", "gateways": "2", "frequency": "868.1"})
+
+	_, err = ScriptFilter(`function Filter(uplink) { throw new Error("broken"); }`).FilterUplink(buildFilterUplink(1))
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestFilterUplink(t *testing.T) {
+	a := New(t)
+	b := getTestBroker(t)
+
+	uplink := buildFilterUplink(1)
+	dropped, err := b.filterUplink(uplink)
+	a.So(err, ShouldBeNil)
+	a.So(dropped, ShouldBeEmpty)
+
+	b.WithUplinkFilter("region", AttributesFilter(map[string]string{"region": "eu"}))
+	b.WithUplinkFilter("ports", DropPortsFilter(224))
+	b.WithUplinkFilter("failing", UplinkFilterFunc(func(uplink *pb.DeduplicatedUplinkMessage) (bool, error) {
+		if uplink.AppId == "failing" {
+			return false, errors.New("failed")
+		}
+		return true, nil
+	}))
+
+	dropped, err = b.filterUplink(uplink)
+	a.So(err, ShouldBeNil)
+	a.So(dropped, ShouldBeEmpty)
+	a.So(uplink.Attributes["region"], ShouldEqual, "eu")
+
+	dropped, err = b.filterUplink(buildFilterUplink(224))
+	a.So(err, ShouldBeNil)
+	a.So(dropped, ShouldEqual, "ports")
+
+	uplink = buildFilterUplink(1)
+	uplink.AppId = "failing"
+	_, err = b.filterUplink(uplink)
+	a.So(err, ShouldNotBeNil)
+}
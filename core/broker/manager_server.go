@@ -9,6 +9,7 @@ import (
 
 	"github.com/TheThingsNetwork/go-account-lib/claims"
 	"github.com/TheThingsNetwork/go-account-lib/rights"
+	"github.com/TheThingsNetwork/ttn/api"
 	pb "github.com/TheThingsNetwork/ttn/api/broker"
 	"github.com/TheThingsNetwork/ttn/api/discovery"
 	"github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
@@ -54,6 +55,10 @@ func (b *brokerManager) SetDevice(ctx context.Context, in *lorawan.Device) (*emp
 	if _, err := b.validateClient(ctx); err != nil {
 		return nil, err
 	}
+	// Refuse settings that the NetworkServer would silently drop during a rolling upgrade
+	if err := api.RequirePeerCapabilities(b.broker.nsConn, "NetworkServer", in.RequiredCapabilities()...); err != nil {
+		return nil, err
+	}
 	res, err := b.deviceManager.SetDevice(ctx, in)
 	if err != nil {
 		return nil, errors.Wrap(errors.FromGRPCError(err), "NetworkServer did not set device")
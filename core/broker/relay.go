@@ -0,0 +1,60 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package broker
+
+import (
+	pb "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_protocol "github.com/TheThingsNetwork/ttn/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/brocaar/lorawan"
+)
+
+// handleRelayUplink unwraps the uplink of an end device that was forwarded by a relay,
+// and handles it as if it was received by the gateways that received the relay.
+// The downlink options of the relay are used for downlink to the end device; the
+// NetworkServer wraps that downlink in a downlink to the relay.
+func (b *broker) handleRelayUplink(relay *pb_lorawan.Device, phyPayload lorawan.PHYPayload, uplink *pb.DeduplicatedUplinkMessage, downlinkOptions []*pb.DownlinkOption) error {
+	if err := phyPayload.DecryptFRMPayload(lorawan.AES128Key(*relay.NwkSKey)); err != nil {
+		return err
+	}
+	macPayload, ok := phyPayload.MACPayload.(*lorawan.MACPayload)
+	if !ok || len(macPayload.FRMPayload) != 1 {
+		return errors.NewErrInvalidArgument("Relay uplink", "does not contain a forwarded uplink")
+	}
+	dataPayload, ok := macPayload.FRMPayload[0].(*lorawan.DataPayload)
+	if !ok {
+		return errors.NewErrInvalidArgument("Relay uplink", "does not contain a forwarded uplink")
+	}
+	var relayed pb_lorawan.RelayUplink
+	if err := relayed.UnmarshalBinary(dataPayload.Bytes); err != nil {
+		return err
+	}
+
+	lorawanMetadata := *uplink.GetProtocolMetadata().GetLorawan()
+	lorawanMetadata.FCnt = 0
+	if fp, err := band.Get(lorawanMetadata.FrequencyPlan.String()); err == nil && int(relayed.DataRate) < len(fp.DataRates) {
+		if dataRate, err := fp.GetDataRateStringForIndex(int(relayed.DataRate)); err == nil {
+			lorawanMetadata.DataRate = dataRate
+		}
+	}
+
+	for _, gatewayMetadata := range uplink.GatewayMetadata {
+		relayedUplink := &pb.UplinkMessage{
+			Payload:          relayed.Payload,
+			ProtocolMetadata: &pb_protocol.RxMetadata{Protocol: &pb_protocol.RxMetadata_Lorawan{Lorawan: &lorawanMetadata}},
+			GatewayMetadata:  gatewayMetadata,
+			Trace:            uplink.Trace.WithEvent("unwrap relayed uplink", "relay", relay.DevId),
+		}
+		for _, option := range downlinkOptions {
+			if option.GatewayId == gatewayMetadata.GatewayId {
+				relayedUplink.DownlinkOptions = append(relayedUplink.DownlinkOptions, option)
+			}
+		}
+		go b.HandleUplink(relayedUplink)
+	}
+
+	return nil
+}
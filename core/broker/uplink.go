@@ -172,6 +172,8 @@ func (b *broker) HandleUplink(uplink *pb.UplinkMessage) (err error) {
 		// FCnt Check disabled. Rely on MIC check only
 	case device.FCntUp == 0:
 		// FCntUp is reset. We don't know where the device will start sending.
+	case device.ResetsFCnt && macPayload.FHDR.FCnt == 0:
+		// The device resets its FCnt when it restarts. Rely on MIC check only
 	case macPayload.FHDR.FCnt == device.FCntUp:
 		if phyPayload.MHDR.MType == lorawan.ConfirmedDataUp {
 			// Retry of confirmed uplink
@@ -191,19 +193,32 @@ func (b *broker) HandleUplink(uplink *pb.UplinkMessage) (err error) {
 
 	// Collect GatewayMetadata and DownlinkOptions
 	var downlinkOptions []*pb.DownlinkOption
+	downlinkDelays := make(map[*pb.DownlinkOption]uint32)
 	for _, duplicate := range duplicates {
 		deduplicatedUplink.GatewayMetadata = append(deduplicatedUplink.GatewayMetadata, duplicate.GatewayMetadata)
 		downlinkOptions = append(downlinkOptions, duplicate.DownlinkOptions...)
+		for _, option := range duplicate.DownlinkOptions {
+			if option.GatewayConfig != nil && duplicate.GatewayMetadata != nil {
+				downlinkDelays[option] = option.GatewayConfig.Timestamp - duplicate.GatewayMetadata.Timestamp
+			}
+		}
+	}
+
+	// Only use the receive window that is configured for the device
+	if device.RxWindow != pb_lorawan.RxWindow_RX_AUTO {
+		downlinkOptions = filterDownlinkOptions(downlinkOptions, device.RxWindow)
 	}
 
 	// Select best DownlinkOption
 	if len(downlinkOptions) > 0 {
+		best := selectBestDownlink(downlinkOptions)
 		deduplicatedUplink.ResponseTemplate = &pb.DownlinkMessage{
-			DevEui:         device.DevEui,
-			AppEui:         device.AppEui,
-			AppId:          device.AppId,
-			DevId:          device.DevId,
-			DownlinkOption: selectBestDownlink(downlinkOptions),
+			DevEui:                  device.DevEui,
+			AppEui:                  device.AppEui,
+			AppId:                   device.AppId,
+			DevId:                   device.DevId,
+			DownlinkOption:          best,
+			DiversityDownlinkOption: selectDiversityDownlink(best, downlinkOptions, downlinkDelays),
 		}
 	}
 
@@ -213,6 +228,23 @@ func (b *broker) HandleUplink(uplink *pb.UplinkMessage) (err error) {
 		return errors.Wrap(errors.FromGRPCError(err), "NetworkServer did not handle uplink")
 	}
 
+	// Relays forward uplink messages of other devices
+	if device.Relay && macPayload.FPort != nil && *macPayload.FPort == pb_lorawan.RelayFPort {
+		return b.handleRelayUplink(device, phyPayload, deduplicatedUplink, downlinkOptions)
+	}
+
+	// Apply the policies of the operator
+	var dropped string
+	dropped, err = b.filterUplink(deduplicatedUplink)
+	if err != nil {
+		return err
+	}
+	if dropped != "" {
+		ctx.WithField("Filter", dropped).Debug("Uplink dropped by filter")
+		deduplicatedUplink.Trace = deduplicatedUplink.Trace.WithEvent(trace.DropEvent, "reason", "filtered", "filter", dropped)
+		return nil
+	}
+
 	var announcements []*pb_discovery.Announcement
 	announcements, err = b.Discovery.GetAllHandlersForAppID(device.AppId)
 	if err != nil {
@@ -221,21 +253,33 @@ func (b *broker) HandleUplink(uplink *pb.UplinkMessage) (err error) {
 	if len(announcements) == 0 {
 		return errors.NewErrNotFound(fmt.Sprintf("Handler for AppID %s", device.AppId))
 	}
-	if len(announcements) > 1 {
-		return errors.NewErrInternal(fmt.Sprintf("Multiple Handlers for AppID %s", device.AppId))
-	}
 
-	var handler chan<- *pb.DeduplicatedUplinkMessage
-	handler, err = b.getHandlerUplink(announcements[0].Id)
-	if err != nil {
-		return err
-	}
+	// While an application is transferred between Handlers, both Handlers are announced for the AppID. The uplink is
+	// forwarded to both of them, and the Handlers agree on which of them processes it.
+	var forwardErr error
+	var numForwarded int
+	for _, announcement := range announcements {
+		handler, err := b.getHandlerUplink(announcement.Id)
+		if err != nil {
+			forwardErr = err
+			continue
+		}
 
-	deduplicatedUplink.Trace = deduplicatedUplink.Trace.WithEvent(trace.ForwardEvent,
-		"handler", announcements[0].Id,
-	)
+		forwarded := deduplicatedUplink
+		if len(announcements) > 1 {
+			copied := *deduplicatedUplink
+			forwarded = &copied
+		}
+		forwarded.Trace = forwarded.Trace.WithEvent(trace.ForwardEvent,
+			"handler", announcement.Id,
+		)
 
-	handler <- deduplicatedUplink
+		handler <- forwarded
+		numForwarded++
+	}
+	if numForwarded == 0 {
+		return forwardErr
+	}
 
 	return nil
 }
@@ -258,6 +302,36 @@ func selectBestDownlink(options []*pb.DownlinkOption) *pb.DownlinkOption {
 	return options[0]
 }
 
+// filterDownlinkOptions returns the options in the given receive window
+func filterDownlinkOptions(options []*pb.DownlinkOption, rxWindow pb_lorawan.RxWindow) (filtered []*pb.DownlinkOption) {
+	for _, option := range options {
+		if option.RxWindow == rxWindow {
+			filtered = append(filtered, option)
+		}
+	}
+	return
+}
+
+// selectDiversityDownlink selects the best DownlinkOption on another gateway than
+// the given best option, in the other receive window. The delays are the times
+// between the uplink and the downlink options (in microseconds). The options
+// should already be sorted by score.
+func selectDiversityDownlink(best *pb.DownlinkOption, options []*pb.DownlinkOption, delays map[*pb.DownlinkOption]uint32) *pb.DownlinkOption {
+	bestDelay, ok := delays[best]
+	if !ok {
+		return nil
+	}
+	for _, option := range options {
+		if option.GatewayId == best.GatewayId {
+			continue
+		}
+		if delay, ok := delays[option]; ok && delay != bestDelay {
+			return option
+		}
+	}
+	return nil
+}
+
 // ByFCntUp implements sort.Interface for []*pb_lorawan.Device based on FCnt
 type ByFCntUp []*pb_lorawan.Device
 
@@ -148,6 +148,51 @@ func TestHandleUplink(t *testing.T) {
 		ProtocolMetadata: &protocol.RxMetadata{Protocol: &protocol.RxMetadata_Lorawan{Lorawan: &pb_lorawan.Metadata{}}},
 	})
 	a.So(err, ShouldBeNil)
+
+	// Multiple Handlers during a transfer of the application
+	b.uplinkDeduplicator = NewDeduplicator(10 * time.Millisecond)
+	b.handlers["otherHandlerID"] = &handler{uplink: make(chan *pb.DeduplicatedUplinkMessage, 10)}
+	b.ns.EXPECT().GetDevices(gomock.Any(), gomock.Any()).Return(nsResponse, nil)
+	b.ns.EXPECT().Uplink(gomock.Any(), gomock.Any()).Return(&pb.DeduplicatedUplinkMessage{ServerTime: 42}, nil)
+	b.discovery.EXPECT().GetAllHandlersForAppID("appid-1").Return([]*pb_discovery.Announcement{
+		&pb_discovery.Announcement{
+			Id: "handlerID",
+		},
+		&pb_discovery.Announcement{
+			Id: "otherHandlerID",
+		},
+		&pb_discovery.Announcement{
+			Id: "inactiveHandlerID",
+		},
+	}, nil)
+	for len(b.handlers["handlerID"].uplink) > 0 {
+		<-b.handlers["handlerID"].uplink
+	}
+	err = b.HandleUplink(&pb.UplinkMessage{
+		Payload:          bytes,
+		GatewayMetadata:  &gateway.RxMetadata{Snr: 1.2, GatewayId: gtwID},
+		ProtocolMetadata: &protocol.RxMetadata{Protocol: &protocol.RxMetadata_Lorawan{Lorawan: &pb_lorawan.Metadata{}}},
+	})
+	a.So(err, ShouldBeNil)
+	a.So(b.handlers["handlerID"].uplink, ShouldHaveLength, 1)
+	a.So(b.handlers["otherHandlerID"].uplink, ShouldHaveLength, 1)
+	first, second := <-b.handlers["handlerID"].uplink, <-b.handlers["otherHandlerID"].uplink
+	a.So(first.ServerTime, ShouldEqual, second.ServerTime)
+
+	// Dropped by an uplink filter
+	b.uplinkDeduplicator = NewDeduplicator(10 * time.Millisecond)
+	b.WithUplinkFilter("drop", UplinkFilterFunc(func(*pb.DeduplicatedUplinkMessage) (bool, error) {
+		return false, nil
+	}))
+	b.ns.EXPECT().GetDevices(gomock.Any(), gomock.Any()).Return(nsResponse, nil)
+	b.ns.EXPECT().Uplink(gomock.Any(), gomock.Any()).Return(&pb.DeduplicatedUplinkMessage{}, nil)
+	err = b.HandleUplink(&pb.UplinkMessage{
+		Payload:          bytes,
+		GatewayMetadata:  &gateway.RxMetadata{Snr: 1.2, GatewayId: gtwID},
+		ProtocolMetadata: &protocol.RxMetadata{Protocol: &protocol.RxMetadata_Lorawan{Lorawan: &pb_lorawan.Metadata{}}},
+	})
+	a.So(err, ShouldBeNil)
+	a.So(b.handlers["handlerID"].uplink, ShouldBeEmpty)
 }
 
 func TestDeduplicateUplink(t *testing.T) {
@@ -5,6 +5,7 @@ package component
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"io/ioutil"
 	"net/url"
@@ -146,6 +147,16 @@ func (c *Component) initTLS() error {
 	}
 
 	c.tlsConfig = &tls.Config{Certificates: []tls.Certificate{cer}}
+
+	// Verify client certificates (of integrations and gateways) that are issued by the CA, if there is one
+	if caCert, err := security.LoadCACert(c.Config.KeyDir); err == nil {
+		clientCAs := x509.NewCertPool()
+		clientCAs.AddCert(caCert)
+		c.tlsConfig.ClientCAs = clientCAs
+		c.tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		c.tlsConfig.VerifyPeerCertificate = security.NewRevocationList(caCert, c.Config.KeyDir).VerifyPeerCertificate
+	}
+
 	return nil
 }
 
@@ -166,6 +177,7 @@ func (c *Component) initBgCtx() error {
 	if c.Identity != nil {
 		ctx = api.ContextWithID(ctx, c.Identity.Id)
 		ctx = api.ContextWithServiceInfo(ctx, c.Identity.ServiceName, c.Identity.ServiceVersion, c.Identity.NetAddress)
+		ctx = api.ContextWithProtocol(ctx, api.CurrentProtocol())
 	}
 	c.Context = ctx
 	return nil
@@ -226,13 +238,9 @@ func (c *Component) ExchangeAppKeyForToken(appID, key string) (string, error) {
 		return token.AccessToken, nil
 	}
 
-	srv, _ := parseAuthServer(issuer)
-	acc := account.New(srv.url)
-
-	if srv.username != "" {
-		acc = acc.WithAuth(auth.BasicAuth(srv.username, srv.password))
-	} else {
-		acc = acc.WithAuth(auth.AccessToken(c.AccessToken))
+	acc, err := c.ComponentAccountServer(issuerID)
+	if err != nil {
+		return "", err
 	}
 
 	token, err = acc.ExchangeAppKeyForToken(appID, key)
@@ -245,6 +253,37 @@ func (c *Component) ExchangeAppKeyForToken(appID, key string) (string, error) {
 	return token.AccessToken, nil
 }
 
+// ComponentAccountServer returns a client for the account server with the given ID, that authenticates with the
+// credentials in the URL of the auth server, or with the access token of the component
+func (c *Component) ComponentAccountServer(id string) (*account.Account, error) {
+	issuer, ok := c.Config.AuthServers[id]
+	if !ok {
+		return nil, fmt.Errorf("Auth server %s not registered", id)
+	}
+	srv, err := parseAuthServer(issuer)
+	if err != nil {
+		return nil, err
+	}
+	acc := account.New(srv.url)
+	if srv.username != "" {
+		return acc.WithAuth(auth.BasicAuth(srv.username, srv.password)), nil
+	}
+	return acc.WithAuth(auth.AccessToken(c.AccessToken)), nil
+}
+
+// AccountServer returns a client for the account server with the given ID, that authenticates with the given token
+func (c *Component) AccountServer(id string, token string) (*account.Account, error) {
+	issuer, ok := c.Config.AuthServers[id]
+	if !ok {
+		return nil, fmt.Errorf("Auth server %s not registered", id)
+	}
+	srv, err := parseAuthServer(issuer)
+	if err != nil {
+		return nil, err
+	}
+	return account.New(srv.url).WithAuth(auth.AccessToken(token)), nil
+}
+
 // ValidateNetworkContext validates the context of a network request (router-broker, broker-handler, etc)
 func (c *Component) ValidateNetworkContext(ctx context.Context) (component *pb_discovery.Announcement, err error) {
 	defer func() {
@@ -256,6 +256,13 @@ func TestInitTLS(t *testing.T) {
 
 	a.So(c.Identity.Certificate, assertions.ShouldNotBeEmpty)
 	a.So(c.tlsConfig, assertions.ShouldNotBeNil)
+	a.So(c.tlsConfig.ClientCAs, assertions.ShouldBeNil)
+
+	security.GenerateCA(tmpDir, "Test CA")
+
+	a.So(c.initTLS(), assertions.ShouldBeNil)
+	a.So(c.tlsConfig.ClientCAs, assertions.ShouldNotBeNil)
+	a.So(c.tlsConfig.VerifyPeerCertificate, assertions.ShouldNotBeNil)
 }
 
 func TestInit(t *testing.T) {
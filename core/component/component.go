@@ -1,7 +1,7 @@
 // Copyright © 2017 The Things Network
 // Use of this source code is governed by the MIT license that can be found in the LICENSE file.
 
-// Package component contains code that is shared by all components (discovery, router, broker, networkserver, handler)
+// Package component contains code that is shared by all components (discovery, router, broker, networkserver, joinserver, handler)
 package component
 
 import (
@@ -15,11 +15,13 @@ import (
 	"github.com/TheThingsNetwork/go-account-lib/claims"
 	"github.com/TheThingsNetwork/go-account-lib/tokenkey"
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api"
 	"github.com/TheThingsNetwork/ttn/api/auth"
 	pb_discovery "github.com/TheThingsNetwork/ttn/api/discovery"
 	pb_monitor "github.com/TheThingsNetwork/ttn/api/monitor"
 	"github.com/TheThingsNetwork/ttn/api/pool"
 	"github.com/TheThingsNetwork/ttn/api/trace"
+	"github.com/TheThingsNetwork/ttn/utils/snmp"
 	"github.com/spf13/viper"
 	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
 	"google.golang.org/grpc"
@@ -41,6 +43,8 @@ type Component struct {
 	TokenKeyProvider tokenkey.Provider
 	status           int64
 	healthServer     *health.Server
+	load             loadSignals
+	traps            *snmp.Client
 }
 
 type Interface interface {
@@ -88,6 +92,10 @@ func New(ctx ttnlog.Interface, serviceName string, announcedAddress string) (*Co
 
 	trace.SetComponent(component.Identity.ServiceName, component.Identity.Id)
 
+	if err := component.initTraps(); err != nil {
+		return nil, err
+	}
+
 	if err := component.InitAuth(); err != nil {
 		return nil, err
 	}
@@ -96,9 +104,14 @@ func New(ctx ttnlog.Interface, serviceName string, announcedAddress string) (*Co
 		token, _ := component.BuildJWT()
 		return token
 	})
-	component.Pool = pool.NewPool(component.Context, append(pool.DefaultDialOptions, auth.DialOption())...)
-
-	if serviceName != "discovery" && serviceName != "networkserver" {
+	// Record the protocol that the other components advertise in their responses
+	protocol := grpc.WithUnaryInterceptor(api.UnaryClientProtocolInterceptor)
+	pool.Global.AddDialOption(protocol)
+	pool.Global.AddCloseHook(api.ForgetPeerProtocol)
+	component.Pool = pool.NewPool(component.Context, append(pool.DefaultDialOptions, auth.DialOption(), protocol)...)
+	component.Pool.AddCloseHook(api.ForgetPeerProtocol)
+
+	if serviceName != "discovery" && serviceName != "networkserver" && serviceName != "joinserver" {
 		var err error
 		component.Discovery, err = pb_discovery.NewClient(
 			viper.GetString("discovery-address"),
@@ -126,6 +139,9 @@ func New(ctx ttnlog.Interface, serviceName string, announcedAddress string) (*Co
 				return
 			}
 		})
+		http.HandleFunc("/load", component.ServeLoad)
+		http.HandleFunc("/monitoring/alerts", component.ServeAlertingRules)
+		http.HandleFunc("/monitoring/dashboard", component.ServeGrafanaDashboard)
 		go http.ListenAndServe(fmt.Sprintf(":%d", healthPort), nil)
 	}
 
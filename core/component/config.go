@@ -10,6 +10,16 @@ type Config struct {
 	AuthServers map[string]string
 	KeyDir      string
 	UseTLS      bool
+
+	// MessageCapacity is the number of messages per second that the component can handle
+	MessageCapacity float64
+
+	// SNMPTrapAddress is the address of the SNMP manager that receives traps (empty to disable traps)
+	SNMPTrapAddress string
+	// SNMPCommunity is the SNMP community of the traps
+	SNMPCommunity string
+	// SNMPTrapOID is the OID under which the traps and their variables are defined
+	SNMPTrapOID string
 }
 
 // ConfigFromViper imports configuration from Viper
@@ -18,5 +28,11 @@ func ConfigFromViper() Config {
 		AuthServers: viper.GetStringMapString("auth-servers"),
 		KeyDir:      viper.GetString("key-dir"),
 		UseTLS:      viper.GetBool("tls"),
+
+		MessageCapacity: viper.GetFloat64("message-capacity"),
+
+		SNMPTrapAddress: viper.GetString("snmp-trap-address"),
+		SNMPCommunity:   viper.GetString("snmp-community"),
+		SNMPTrapOID:     viper.GetString("snmp-trap-oid"),
 	}
 }
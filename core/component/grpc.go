@@ -8,6 +8,7 @@ import (
 
 	"github.com/TheThingsNetwork/go-utils/grpc/interceptor"
 	"github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api"
 	"github.com/TheThingsNetwork/ttn/api/fields"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 	"github.com/mwitkow/go-grpc-middleware"
@@ -28,6 +29,15 @@ func (c *Component) ServerOptions() []grpc.ServerOption {
 		return iface, err
 	}
 
+	// Reject components with an unsupported protocol version, and advertise the protocol of this component
+	unaryProtocol := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkPeerProtocol(ctx); err != nil {
+			return nil, err
+		}
+		grpc.SetHeader(ctx, api.ProtocolMetadata(api.CurrentProtocol()))
+		return handler(ctx, req)
+	}
+
 	streamLog := interceptor.Stream(func(srv interface{}, info *grpc.StreamServerInfo) (log.Interface, string) {
 		return c.Ctx, "Stream"
 	})
@@ -38,10 +48,18 @@ func (c *Component) ServerOptions() []grpc.ServerOption {
 		return err
 	}
 
+	streamProtocol := func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkPeerProtocol(stream.Context()); err != nil {
+			return err
+		}
+		stream.SetHeader(api.ProtocolMetadata(api.CurrentProtocol()))
+		return handler(srv, stream)
+	}
+
 	opts := []grpc.ServerOption{
 		grpc.MaxConcurrentStreams(math.MaxUint16),
-		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryErr, unaryLog)),
-		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamErr, streamLog)),
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryErr, unaryLog, unaryProtocol)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamErr, streamLog, streamProtocol)),
 	}
 
 	if c.tlsConfig != nil {
@@ -50,3 +68,12 @@ func (c *Component) ServerOptions() []grpc.ServerOption {
 
 	return opts
 }
+
+// checkPeerProtocol returns an error if the peer uses a protocol version that is no longer supported
+func checkPeerProtocol(ctx context.Context) error {
+	protocol, err := api.ProtocolFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	return protocol.Check()
+}
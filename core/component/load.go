@@ -0,0 +1,113 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/rcrowley/go-metrics"
+)
+
+// LoadSignal returns the load of a part of the component, normalized to its capacity: 0 means idle and 1 means that
+// the component is at capacity. Values above 1 indicate that the component is overloaded.
+type LoadSignal func() float64
+
+type loadSignals struct {
+	sync.RWMutex
+	signals map[string]LoadSignal
+}
+
+// Load is the normalized load of a component
+type Load struct {
+	Component string             `json:"component"`
+	ID        string             `json:"id"`
+	Load      float64            `json:"load"`
+	Signals   map[string]float64 `json:"signals"`
+}
+
+// RegisterLoadSignal registers a signal that contributes to the load of the component
+func (c *Component) RegisterLoadSignal(name string, signal LoadSignal) {
+	c.load.Lock()
+	defer c.load.Unlock()
+	if c.load.signals == nil {
+		c.load.signals = make(map[string]LoadSignal)
+	}
+	c.load.signals[name] = signal
+}
+
+// RegisterRateLoadSignal registers a signal for the 1-minute rate of the meter relative to the configured message
+// capacity of the component. Nothing is registered if no capacity is configured.
+func (c *Component) RegisterRateLoadSignal(name string, meter metrics.Meter) {
+	capacity := c.Config.MessageCapacity
+	if capacity <= 0 {
+		return
+	}
+	c.RegisterLoadSignal(name, func() float64 {
+		return meter.Rate1() / capacity
+	})
+}
+
+// RegisterQueueLoadSignal registers a signal for the number of items in a buffered channel relative to its capacity
+func (c *Component) RegisterQueueLoadSignal(name string, length, capacity func() int) {
+	c.RegisterLoadSignal(name, func() float64 {
+		if capacity() == 0 {
+			return 0
+		}
+		return float64(length()) / float64(capacity())
+	})
+}
+
+// GetLoad returns the current load of the component, which is the highest load of its signals
+func (c *Component) GetLoad() *Load {
+	load := &Load{
+		Signals: make(map[string]float64),
+	}
+	if c.Identity != nil {
+		load.Component = c.Identity.ServiceName
+		load.ID = c.Identity.Id
+	}
+	c.load.RLock()
+	defer c.load.RUnlock()
+	for name, signal := range c.load.signals {
+		value := signal()
+		if value < 0 {
+			value = 0
+		}
+		load.Signals[name] = value
+		if value > load.Load {
+			load.Load = value
+		}
+	}
+	return load
+}
+
+// ServeLoad serves the load of the component as JSON, or in the Prometheus text format if the "format" query
+// parameter is "prometheus". The load can be used as external metric for horizontal autoscaling.
+func (c *Component) ServeLoad(w http.ResponseWriter, req *http.Request) {
+	load := c.GetLoad()
+	if req.URL.Query().Get("format") == "prometheus" {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		labels := fmt.Sprintf(`component="%s",id="%s"`, load.Component, load.ID)
+		fmt.Fprintln(w, "# HELP ttn_component_load Load of the component relative to its capacity")
+		fmt.Fprintln(w, "# TYPE ttn_component_load gauge")
+		fmt.Fprintf(w, "ttn_component_load{%s} %g\n", labels, load.Load)
+		names := make([]string, 0, len(load.Signals))
+		for name := range load.Signals {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Fprintln(w, "# HELP ttn_component_load_signal Load of a part of the component relative to its capacity")
+		fmt.Fprintln(w, "# TYPE ttn_component_load_signal gauge")
+		for _, name := range names {
+			fmt.Fprintf(w, "ttn_component_load_signal{%s,signal=\"%s\"} %g\n", labels, name, load.Signals[name])
+		}
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(load)
+}
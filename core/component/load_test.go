@@ -0,0 +1,63 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package component
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	pb_discovery "github.com/TheThingsNetwork/ttn/api/discovery"
+	"github.com/rcrowley/go-metrics"
+	"github.com/smartystreets/assertions"
+)
+
+func TestLoad(t *testing.T) {
+	a := assertions.New(t)
+
+	c := &Component{
+		Identity: &pb_discovery.Announcement{ServiceName: "handler", Id: "test"},
+	}
+
+	load := c.GetLoad()
+	a.So(load.Load, assertions.ShouldEqual, 0)
+	a.So(load.Signals, assertions.ShouldBeEmpty)
+
+	// Without capacity, no rate signal is registered
+	c.RegisterRateLoadSignal("uplink", metrics.NewMeter())
+	a.So(c.GetLoad().Signals, assertions.ShouldBeEmpty)
+
+	queue := make(chan int, 4)
+	queue <- 1
+	c.RegisterQueueLoadSignal("queue", func() int { return len(queue) }, func() int { return cap(queue) })
+	c.RegisterLoadSignal("negative", func() float64 { return -1 })
+	c.RegisterLoadSignal("functions", func() float64 { return 0.5 })
+
+	load = c.GetLoad()
+	a.So(load.Component, assertions.ShouldEqual, "handler")
+	a.So(load.ID, assertions.ShouldEqual, "test")
+	a.So(load.Signals["queue"], assertions.ShouldEqual, 0.25)
+	a.So(load.Signals["negative"], assertions.ShouldEqual, 0)
+	a.So(load.Load, assertions.ShouldEqual, 0.5)
+
+	queue <- 2
+	queue <- 3
+	a.So(c.GetLoad().Load, assertions.ShouldEqual, 0.75)
+
+	{
+		rec := httptest.NewRecorder()
+		c.ServeLoad(rec, httptest.NewRequest("GET", "/load", nil))
+		var served Load
+		a.So(json.NewDecoder(rec.Body).Decode(&served), assertions.ShouldBeNil)
+		a.So(served.Load, assertions.ShouldEqual, 0.75)
+		a.So(served.Signals, assertions.ShouldHaveLength, 3)
+	}
+
+	{
+		rec := httptest.NewRecorder()
+		c.ServeLoad(rec, httptest.NewRequest("GET", "/load?format=prometheus", nil))
+		a.So(rec.Body.String(), assertions.ShouldContainSubstring, `ttn_component_load{component="handler",id="test"} 0.75`)
+		a.So(rec.Body.String(), assertions.ShouldContainSubstring, `ttn_component_load_signal{component="handler",id="test",signal="queue"} 0.75`)
+	}
+}
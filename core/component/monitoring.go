@@ -0,0 +1,183 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Thresholds of the recommended alerting rules
+var (
+	AlertHighLoad        = 0.8
+	AlertOverloaded      = 1.0
+	AlertSignalSaturated = 0.9
+)
+
+// AlertingRule is a Prometheus alerting rule
+type AlertingRule struct {
+	Alert       string            `yaml:"alert"`
+	Expr        string            `yaml:"expr"`
+	For         string            `yaml:"for,omitempty"`
+	Labels      map[string]string `yaml:"labels,omitempty"`
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
+
+// AlertingRuleGroup is a group of Prometheus alerting rules
+type AlertingRuleGroup struct {
+	Name  string         `yaml:"name"`
+	Rules []AlertingRule `yaml:"rules"`
+}
+
+// AlertingRules is a Prometheus rule file
+type AlertingRules struct {
+	Groups []AlertingRuleGroup `yaml:"groups"`
+}
+
+// serviceName returns the service name of the component, used in the metric labels
+func (c *Component) serviceName() string {
+	if c.Identity != nil && c.Identity.ServiceName != "" {
+		return c.Identity.ServiceName
+	}
+	return "component"
+}
+
+// loadSignalNames returns the sorted names of the registered load signals
+func (c *Component) loadSignalNames() []string {
+	c.load.RLock()
+	defer c.load.RUnlock()
+	names := make([]string, 0, len(c.load.signals))
+	for name := range c.load.signals {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// alertName returns a CamelCase alert name for the given parts, for example TTNHandlerMqttUplinkQueueSaturated
+func alertName(parts ...string) string {
+	name := "TTN"
+	for _, part := range parts {
+		for _, word := range strings.FieldsFunc(part, func(r rune) bool { return r == '_' || r == '-' }) {
+			name += strings.Title(word)
+		}
+	}
+	return name
+}
+
+// GetAlertingRules returns recommended Prometheus alerting rules for the load metrics of the component (see
+// ServeLoad). The rules cover the load signals that are currently registered.
+func (c *Component) GetAlertingRules() *AlertingRules {
+	service := c.serviceName()
+	load := fmt.Sprintf(`ttn_component_load{component="%s"}`, service)
+	rules := []AlertingRule{
+		{
+			Alert:  alertName(service, "down"),
+			Expr:   fmt.Sprintf("absent(%s)", load),
+			For:    "5m",
+			Labels: map[string]string{"severity": "critical"},
+			Annotations: map[string]string{
+				"summary": fmt.Sprintf("No %s reports its load", service),
+			},
+		},
+		{
+			Alert:  alertName(service, "high", "load"),
+			Expr:   fmt.Sprintf("%s > %g", load, AlertHighLoad),
+			For:    "15m",
+			Labels: map[string]string{"severity": "warning"},
+			Annotations: map[string]string{
+				"summary": fmt.Sprintf("%s {{ $labels.id }} is at load {{ $value }}", service),
+			},
+		},
+		{
+			Alert:  alertName(service, "overloaded"),
+			Expr:   fmt.Sprintf("%s > %g", load, AlertOverloaded),
+			For:    "5m",
+			Labels: map[string]string{"severity": "critical"},
+			Annotations: map[string]string{
+				"summary": fmt.Sprintf("%s {{ $labels.id }} is over its capacity", service),
+			},
+		},
+	}
+	for _, signal := range c.loadSignalNames() {
+		rules = append(rules, AlertingRule{
+			Alert:  alertName(service, signal, "saturated"),
+			Expr:   fmt.Sprintf(`ttn_component_load_signal{component="%s",signal="%s"} > %g`, service, signal, AlertSignalSaturated),
+			For:    "5m",
+			Labels: map[string]string{"severity": "warning"},
+			Annotations: map[string]string{
+				"summary": fmt.Sprintf("%s of %s {{ $labels.id }} is at load {{ $value }}", signal, service),
+			},
+		})
+	}
+	return &AlertingRules{Groups: []AlertingRuleGroup{{Name: "ttn-" + service, Rules: rules}}}
+}
+
+// GetGrafanaDashboard returns a Grafana dashboard with the load metrics of the component (see ServeLoad), with a
+// panel for the load of the component and a panel for each load signal that is currently registered.
+func (c *Component) GetGrafanaDashboard() map[string]interface{} {
+	service := c.serviceName()
+	panel := func(id int, title, expr string, threshold float64) map[string]interface{} {
+		return map[string]interface{}{
+			"id":      id,
+			"type":    "graph",
+			"title":   title,
+			"gridPos": map[string]int{"x": 12 * ((id - 1) % 2), "y": 8 * ((id - 1) / 2), "w": 12, "h": 8},
+			"targets": []map[string]string{
+				{"expr": expr, "legendFormat": "{{id}}", "refId": "A"},
+			},
+			"yaxes": []map[string]interface{}{
+				{"format": "percentunit", "min": 0},
+				{"format": "short", "show": false},
+			},
+			"thresholds": []map[string]interface{}{
+				{"value": threshold, "op": "gt", "colorMode": "critical", "fill": true, "line": true},
+			},
+		}
+	}
+	panels := []map[string]interface{}{
+		panel(1, "Load", fmt.Sprintf(`ttn_component_load{component="%s"}`, service), AlertOverloaded),
+	}
+	for _, signal := range c.loadSignalNames() {
+		panels = append(panels, panel(
+			len(panels)+1,
+			fmt.Sprintf("Load: %s", signal),
+			fmt.Sprintf(`ttn_component_load_signal{component="%s",signal="%s"}`, service, signal),
+			AlertSignalSaturated,
+		))
+	}
+	return map[string]interface{}{
+		"title":         fmt.Sprintf("TTN %s", strings.Title(service)),
+		"uid":           "ttn-" + service,
+		"tags":          []string{"ttn", service},
+		"time":          map[string]string{"from": "now-6h", "to": "now"},
+		"refresh":       "1m",
+		"schemaVersion": 16,
+		"panels":        panels,
+	}
+}
+
+// ServeAlertingRules serves the recommended Prometheus alerting rules of the component as rule file
+func (c *Component) ServeAlertingRules(w http.ResponseWriter, req *http.Request) {
+	rules, err := yaml.Marshal(c.GetAlertingRules())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-yaml")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="ttn-%s-alerts.yml"`, c.serviceName()))
+	w.Write(rules)
+}
+
+// ServeGrafanaDashboard serves the Grafana dashboard of the component as JSON
+func (c *Component) ServeGrafanaDashboard(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="ttn-%s-dashboard.json"`, c.serviceName()))
+	json.NewEncoder(w).Encode(c.GetGrafanaDashboard())
+}
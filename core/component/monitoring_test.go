@@ -0,0 +1,60 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package component
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	pb_discovery "github.com/TheThingsNetwork/ttn/api/discovery"
+	"github.com/smartystreets/assertions"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func TestMonitoring(t *testing.T) {
+	a := assertions.New(t)
+
+	c := &Component{
+		Identity: &pb_discovery.Announcement{ServiceName: "handler", Id: "test"},
+	}
+	c.RegisterLoadSignal("mqtt_uplink_queue", func() float64 { return 0 })
+
+	a.So(alertName("handler", "mqtt_uplink_queue", "saturated"), assertions.ShouldEqual, "TTNHandlerMqttUplinkQueueSaturated")
+
+	rules := c.GetAlertingRules()
+	a.So(rules.Groups, assertions.ShouldHaveLength, 1)
+	a.So(rules.Groups[0].Rules, assertions.ShouldHaveLength, 4)
+	a.So(rules.Groups[0].Rules[0].Expr, assertions.ShouldEqual, `absent(ttn_component_load{component="handler"})`)
+	a.So(rules.Groups[0].Rules[3].Alert, assertions.ShouldEqual, "TTNHandlerMqttUplinkQueueSaturated")
+	a.So(rules.Groups[0].Rules[3].Expr, assertions.ShouldEqual, `ttn_component_load_signal{component="handler",signal="mqtt_uplink_queue"} > 0.9`)
+
+	{
+		rec := httptest.NewRecorder()
+		c.ServeAlertingRules(rec, httptest.NewRequest("GET", "/monitoring/alerts", nil))
+		var served AlertingRules
+		a.So(yaml.Unmarshal(rec.Body.Bytes(), &served), assertions.ShouldBeNil)
+		a.So(served.Groups[0].Name, assertions.ShouldEqual, "ttn-handler")
+		a.So(served.Groups[0].Rules, assertions.ShouldHaveLength, 4)
+	}
+
+	{
+		rec := httptest.NewRecorder()
+		c.ServeGrafanaDashboard(rec, httptest.NewRequest("GET", "/monitoring/dashboard", nil))
+		var served struct {
+			Title  string `json:"title"`
+			Panels []struct {
+				Title   string `json:"title"`
+				Targets []struct {
+					Expr string `json:"expr"`
+				} `json:"targets"`
+			} `json:"panels"`
+		}
+		a.So(json.NewDecoder(rec.Body).Decode(&served), assertions.ShouldBeNil)
+		a.So(served.Title, assertions.ShouldEqual, "TTN Handler")
+		a.So(served.Panels, assertions.ShouldHaveLength, 2)
+		a.So(served.Panels[1].Title, assertions.ShouldEqual, "Load: mqtt_uplink_queue")
+		a.So(served.Panels[1].Targets[0].Expr, assertions.ShouldEqual, `ttn_component_load_signal{component="handler",signal="mqtt_uplink_queue"}`)
+	}
+}
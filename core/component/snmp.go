@@ -0,0 +1,106 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package component
+
+import (
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/utils/snmp"
+	"gopkg.in/redis.v5"
+)
+
+// DefaultSNMPTrapOID is in the experimental subtree of Net-SNMP. Operators that integrate the traps in their network
+// operation center should configure an OID in their own enterprise subtree.
+const DefaultSNMPTrapOID = "1.3.6.1.4.1.8072.9999.9999.1900"
+
+// SNMP traps that the components send, relative to the configured trap OID
+const (
+	TrapComponentUnhealthy = "1.1"
+	TrapComponentHealthy   = "1.2"
+	TrapRedisUnreachable   = "1.3"
+	TrapRedisReachable     = "1.4"
+	TrapGatewayOffline     = "1.5"
+	TrapGatewayOnline      = "1.6"
+)
+
+// Variables of the SNMP traps, relative to the configured trap OID. All traps contain the component and its ID.
+const (
+	TrapVarComponent = "2.1"
+	TrapVarID        = "2.2"
+	TrapVarGatewayID = "2.3"
+	TrapVarMessage   = "2.4"
+)
+
+// RedisWatchInterval is the interval at which the connection to Redis is checked by WatchRedis
+var RedisWatchInterval = 30 * time.Second
+
+// initTraps connects to the SNMP manager if an address is configured
+func (c *Component) initTraps() error {
+	if c.Config.SNMPTrapAddress == "" {
+		return nil
+	}
+	if c.Config.SNMPTrapOID == "" {
+		c.Config.SNMPTrapOID = DefaultSNMPTrapOID
+	}
+	traps, err := snmp.NewClient(c.Config.SNMPTrapAddress, c.Config.SNMPCommunity)
+	if err != nil {
+		return err
+	}
+	c.traps = traps
+	return nil
+}
+
+// SendTrap sends an SNMP trap to the configured SNMP manager, if any. The trap and the OIDs of the variables are
+// relative to the configured trap OID, for example TrapGatewayOffline and TrapVarGatewayID.
+func (c *Component) SendTrap(trap string, variables ...snmp.Variable) {
+	if c.traps == nil {
+		return
+	}
+	var id string
+	if c.Identity != nil {
+		id = c.Identity.Id
+	}
+	all := []snmp.Variable{
+		{OID: c.Config.SNMPTrapOID + "." + TrapVarComponent, Value: c.serviceName()},
+		{OID: c.Config.SNMPTrapOID + "." + TrapVarID, Value: id},
+	}
+	for _, variable := range variables {
+		all = append(all, snmp.Variable{OID: c.Config.SNMPTrapOID + "." + variable.OID, Value: variable.Value})
+	}
+	if err := c.traps.Send(c.Config.SNMPTrapOID+"."+trap, all...); err != nil {
+		c.Ctx.WithError(err).WithField("Trap", trap).Warn("Could not send SNMP trap")
+	}
+}
+
+// WatchRedis periodically checks the connection to Redis. The component is unhealthy while Redis is unreachable, and
+// traps are sent when Redis becomes unreachable and when it is reachable again.
+func (c *Component) WatchRedis(client *redis.Client) {
+	go func() {
+		reachable := true
+		for range time.Tick(RedisWatchInterval) {
+			err := client.Ping().Err()
+			switch {
+			case err != nil && reachable:
+				c.Ctx.WithError(err).Error("Redis is unreachable")
+				c.SendTrap(TrapRedisUnreachable, snmp.Variable{OID: TrapVarMessage, Value: err.Error()})
+				c.SetStatus(StatusUnhealthy)
+			case err == nil && !reachable:
+				c.Ctx.Info("Redis is reachable again")
+				c.SendTrap(TrapRedisReachable)
+				c.SetStatus(StatusHealthy)
+			}
+			reachable = err == nil
+		}
+	}()
+}
+
+// sendStatusTrap sends a trap for a change of the health status of the component
+func (c *Component) sendStatusTrap(status Status) {
+	switch status {
+	case StatusHealthy:
+		c.SendTrap(TrapComponentHealthy)
+	case StatusUnhealthy:
+		c.SendTrap(TrapComponentUnhealthy)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package component
+
+import (
+	"bytes"
+	"net"
+	"testing"
+	"time"
+
+	pb_discovery "github.com/TheThingsNetwork/ttn/api/discovery"
+	"github.com/TheThingsNetwork/ttn/utils/snmp"
+	"github.com/smartystreets/assertions"
+)
+
+func TestSendTrap(t *testing.T) {
+	a := assertions.New(t)
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	a.So(err, assertions.ShouldBeNil)
+	defer conn.Close()
+
+	receive := func() []byte {
+		conn.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
+		buf := make([]byte, 1500)
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			return nil
+		}
+		return buf[:n]
+	}
+
+	// Without an SNMP manager, no traps are sent
+	c := &Component{
+		Identity: &pb_discovery.Announcement{ServiceName: "handler", Id: "test"},
+	}
+	a.So(c.initTraps(), assertions.ShouldBeNil)
+	c.SetStatus(StatusUnhealthy)
+	c.SetStatus(StatusHealthy)
+
+	c.Config.SNMPTrapAddress = conn.LocalAddr().String()
+	c.Config.SNMPCommunity = "public"
+	c.Config.SNMPTrapOID = "1.3.6.1.4.1.99999" // 99999 is encoded as 0x86 0x8d 0x1f
+	a.So(c.initTraps(), assertions.ShouldBeNil)
+
+	// Changes of the health status are sent
+	c.SetStatus(StatusUnhealthy)
+	trap := receive()
+	a.So(bytes.Contains(trap, []byte{0x86, 0x8d, 0x1f, 0x01, 0x01}), assertions.ShouldBeTrue)
+	a.So(string(trap), assertions.ShouldContainSubstring, "handler")
+	a.So(string(trap), assertions.ShouldContainSubstring, "test")
+
+	c.SetStatus(StatusUnhealthy)
+	a.So(receive(), assertions.ShouldBeNil)
+
+	c.SetStatus(StatusHealthy)
+	trap = receive()
+	a.So(bytes.Contains(trap, []byte{0x86, 0x8d, 0x1f, 0x01, 0x02}), assertions.ShouldBeTrue)
+
+	// Traps can have extra variables
+	c.SendTrap(TrapGatewayOffline, snmp.Variable{OID: TrapVarGatewayID, Value: "eui-0102030405060708"})
+	trap = receive()
+	a.So(bytes.Contains(trap, []byte{0x86, 0x8d, 0x1f, 0x01, 0x05}), assertions.ShouldBeTrue)
+	a.So(string(trap), assertions.ShouldContainSubstring, "eui-0102030405060708")
+}
@@ -26,9 +26,11 @@ func (c *Component) GetStatus() Status {
 	return Status(atomic.LoadInt64(&c.status))
 }
 
-// SetStatus sets the health status of the component
+// SetStatus sets the health status of the component, and sends an SNMP trap if it changed
 func (c *Component) SetStatus(status Status) {
-	atomic.StoreInt64(&c.status, int64(status))
+	if previous := Status(atomic.SwapInt64(&c.status, int64(status))); previous != status {
+		c.sendStatusTrap(status)
+	}
 	if c.healthServer != nil {
 		switch status {
 		case StatusHealthy:
@@ -8,15 +8,14 @@ import (
 	"time"
 
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
-	"github.com/TheThingsNetwork/go-utils/random"
 	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
 	"github.com/TheThingsNetwork/ttn/api/fields"
 	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	pb_joinserver "github.com/TheThingsNetwork/ttn/api/joinserver"
 	"github.com/TheThingsNetwork/ttn/api/trace"
 	"github.com/TheThingsNetwork/ttn/core/handler/device"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
-	"github.com/TheThingsNetwork/ttn/utils/otaa"
 	"github.com/brocaar/lorawan"
 )
 
@@ -34,14 +33,37 @@ func (h *handler) getActivationMetadata(ctx ttnlog.Interface, activation *pb_bro
 	return mqttUp.Metadata, nil
 }
 
+// logSessionChange logs a change of the session of a device, so that session changes can be audited
+func (h *handler) logSessionChange(dev *device.Device, previousDevAddr types.DevAddr, reason string) {
+	h.Ctx.WithFields(ttnlog.Fields{
+		"AppID":           dev.AppID,
+		"DevID":           dev.DevID,
+		"AppEUI":          dev.AppEUI,
+		"DevEUI":          dev.DevEUI,
+		"DevAddr":         dev.DevAddr,
+		"PreviousDevAddr": previousDevAddr,
+		"Reason":          reason,
+		"ChangedAt":       time.Now().UTC().Format(time.RFC3339Nano),
+	}).Info("Device session changed")
+}
+
 func (h *handler) HandleActivationChallenge(challenge *pb_broker.ActivationChallengeRequest) (*pb_broker.ActivationChallengeResponse, error) {
 
+	// Devices of applications that are being transferred can not join on either Handler
+	if err := h.checkTransfer(challenge.AppId); err != nil {
+		return nil, err
+	}
+
 	// Find Device
 	dev, err := h.devices.Get(challenge.AppId, challenge.DevId)
 	if err != nil {
 		return nil, err
 	}
 
+	if h.usesJoinServer() {
+		return nil, errors.NewErrInvalidArgument("Activation Challenge", "not supported when the root keys are stored by a Join Server")
+	}
+
 	if dev.AppKey.IsEmpty() {
 		err = errors.NewErrNotFound(fmt.Sprintf("AppKey for device %s", challenge.DevId))
 		return nil, err
@@ -104,6 +126,10 @@ func (h *handler) HandleActivation(activation *pb_broker.DeduplicatedDeviceActiv
 		return nil, err
 	}
 
+	if err = h.checkTransfer(appID); err != nil {
+		return nil, err
+	}
+
 	// Find Device
 	var dev *device.Device
 	dev, err = h.devices.Get(appID, devID)
@@ -111,67 +137,27 @@ func (h *handler) HandleActivation(activation *pb_broker.DeduplicatedDeviceActiv
 		return nil, err
 	}
 
-	if dev.AppKey.IsEmpty() {
-		err = errors.NewErrNotFound(fmt.Sprintf("AppKey for device %s", devID))
-		return nil, err
-	}
-
 	// Check for LoRaWAN
 	if lorawan := activation.ActivationMetadata.GetLorawan(); lorawan == nil {
 		err = errors.NewErrInvalidArgument("Activation", "does not contain LoRaWAN metadata")
 		return nil, err
 	}
 
-	// Unmarshal LoRaWAN
-	var reqPHY lorawan.PHYPayload
-	if err = reqPHY.UnmarshalBinary(activation.Payload); err != nil {
-		return nil, err
-	}
-	reqMAC, ok := reqPHY.MACPayload.(*lorawan.JoinRequestPayload)
-	if !ok {
-		err = errors.NewErrInvalidArgument("Activation", "does not contain a JoinRequestPayload")
-		return nil, err
-	}
-
-	// Validate MIC
+	// Validate the Join Request and prepare the Device Activation Response
 	activation.Trace = activation.Trace.WithEvent(trace.CheckMICEvent)
-	if ok, err = reqPHY.ValidateMIC(lorawan.AES128Key(dev.AppKey)); err != nil || !ok {
-		err = errors.NewErrNotFound("MIC does not match device")
-		return nil, err
-	}
-
-	// Validate DevNonce
-	var alreadyUsed bool
-	for _, usedNonce := range dev.UsedDevNonces {
-		if usedNonce == device.DevNonce(reqMAC.DevNonce) {
-			alreadyUsed = true
-			break
-		}
-	}
-	if alreadyUsed {
-		err = errors.NewErrInvalidArgument("Activation DevNonce", "already used")
+	dev.StartUpdate()
+	var joinRes *pb_joinserver.JoinResponse
+	joinRes, err = h.join(dev, &pb_joinserver.JoinRequest{
+		Payload:        activation.Payload,
+		AcceptTemplate: activation.ResponseTemplate.Payload,
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	ctx.Debug("Accepting Join Request")
+	ctx.Debug("Accepted Join Request")
 	activation.Trace = activation.Trace.WithEvent(trace.AcceptEvent)
 
-	// Prepare Device Activation Response
-	var resPHY lorawan.PHYPayload
-	if err = resPHY.UnmarshalBinary(activation.ResponseTemplate.Payload); err != nil {
-		return nil, err
-	}
-	resMAC, ok := resPHY.MACPayload.(*lorawan.DataPayload)
-	if !ok {
-		err = errors.NewErrInvalidArgument("Activation ResponseTemplate", "MACPayload must be a *DataPayload")
-		return nil, err
-	}
-	joinAccept := &lorawan.JoinAcceptPayload{}
-	if err = joinAccept.UnmarshalBinary(false, resMAC.Bytes); err != nil {
-		return nil, err
-	}
-	resPHY.MACPayload = joinAccept
-
 	// Publish Activation
 	mqttMetadata, _ := h.getActivationMetadata(ctx, activation, dev)
 	h.mqttEvent <- &types.DeviceEvent{
@@ -181,66 +167,34 @@ func (h *handler) HandleActivation(activation *pb_broker.DeduplicatedDeviceActiv
 		Data: types.ActivationEventData{
 			AppEUI:   *activation.AppEui,
 			DevEUI:   *activation.DevEui,
-			DevAddr:  types.DevAddr(joinAccept.DevAddr),
+			DevAddr:  *joinRes.DevAddr,
 			Metadata: mqttMetadata,
 		},
 	}
 
-	// Generate random AppNonce
-	var appNonce device.AppNonce
-	for {
-		// NOTE: As DevNonces are only 2 bytes, we will start rejecting those before we run out of AppNonces.
-		// It might just take some time to get one we didn't use yet...
-		alreadyUsed = false
-		random.FillBytes(appNonce[:])
-		for _, usedNonce := range dev.UsedAppNonces {
-			if usedNonce == appNonce {
-				alreadyUsed = true
-				break
-			}
-		}
-		if !alreadyUsed {
-			break
-		}
-	}
-	joinAccept.AppNonce = appNonce
-
-	// Calculate session keys
-	appSKey, nwkSKey, err := otaa.CalculateSessionKeys(dev.AppKey, joinAccept.AppNonce, joinAccept.NetID, reqMAC.DevNonce)
-	if err != nil {
-		return nil, err
-	}
-
 	// Update Device
-	dev.StartUpdate()
-	dev.DevAddr = types.DevAddr(joinAccept.DevAddr)
-	dev.AppSKey = appSKey
-	dev.NwkSKey = nwkSKey
-	dev.UsedAppNonces = append(dev.UsedAppNonces, appNonce)
-	dev.UsedDevNonces = append(dev.UsedDevNonces, reqMAC.DevNonce)
+	previousDevAddr := dev.DevAddr
+	dev.DevAddr = *joinRes.DevAddr
+	dev.AppSKey = *joinRes.AppSKey
+	dev.NwkSKey = *joinRes.NwkSKey
+	dev.PendingProvisioning = true
+	joinDownlink := h.runJoinHook(ctx, dev, mqttMetadata)
 	err = h.devices.Set(dev)
 	if err != nil {
 		return nil, err
 	}
+	h.logSessionChange(dev, previousDevAddr, "join")
+	h.recordDeviceChange(dev.AppID, dev.DevID, types.UpdateEvent)
 
-	if err = resPHY.SetMIC(lorawan.AES128Key(dev.AppKey)); err != nil {
-		return nil, err
-	}
-	if err = resPHY.EncryptJoinAcceptPayload(lorawan.AES128Key(dev.AppKey)); err != nil {
-		return nil, err
-	}
-
-	var resBytes []byte
-	resBytes, err = resPHY.MarshalBinary()
-	if err != nil {
-		return nil, err
+	if joinDownlink != nil {
+		h.EnqueueDownlink(joinDownlink) // Errors are logged and published as downlink error event
 	}
 
 	metadata := activation.ActivationMetadata
 	metadata.GetLorawan().NwkSKey = &dev.NwkSKey
 	metadata.GetLorawan().DevAddr = &dev.DevAddr
 	res = &pb.DeviceActivationResponse{
-		Payload:            resBytes,
+		Payload:            joinRes.Payload,
 		DownlinkOption:     activation.ResponseTemplate.DownlinkOption,
 		ActivationMetadata: metadata,
 		Trace:              activation.Trace,
@@ -0,0 +1,122 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+)
+
+// averageFields returns the fields with the numeric values replaced by their average in the aggregation window
+func averageFields(prefix string, fields map[string]interface{}, aggregation *device.Aggregation) map[string]interface{} {
+	averages := make(map[string]interface{}, len(fields))
+	for key, value := range fields {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		if nested, ok := value.(map[string]interface{}); ok {
+			averages[key] = averageFields(path, nested, aggregation)
+			continue
+		}
+		if count := aggregation.Counts[path]; count > 0 {
+			averages[key] = aggregation.Sums[path] / float64(count)
+			continue
+		}
+		averages[key] = value
+	}
+	return averages
+}
+
+// aggregatedUplink builds the uplink message with the averages of the aggregation window
+func aggregatedUplink(aggregation *device.Aggregation, window time.Duration) *types.UplinkMessage {
+	last := aggregation.Last
+	return &types.UplinkMessage{
+		AppID:          last.AppID,
+		DevID:          last.DevID,
+		HardwareSerial: last.HardwareSerial,
+		FPort:          last.FPort,
+		FCnt:           last.FCnt,
+		PayloadFields:  averageFields("", last.PayloadFields, aggregation),
+		Metadata: types.Metadata{
+			Time:             types.JSONTime(aggregation.Start.Add(window)),
+			LocationMetadata: last.LocationMetadata,
+		},
+		Aggregate: &types.AggregateMetadata{
+			Start: types.JSONTime(aggregation.Start),
+			End:   types.JSONTime(aggregation.Start.Add(window)),
+			Count: aggregation.Count,
+		},
+	}
+}
+
+// aggregateUplinks adds the uplink messages to the aggregation window of the device and returns the uplink messages
+// that should be published. If aggregation is enabled for the application, these are the averages of the windows that
+// were completed. Otherwise, the uplink messages are returned as-is.
+func (h *handler) aggregateUplinks(ctx ttnlog.Interface, dev *device.Device, appUplinks []*types.UplinkMessage) []*types.UplinkMessage {
+	if len(appUplinks) == 0 {
+		return appUplinks
+	}
+
+	app, err := h.applications.Get(appUplinks[0].AppID)
+	if err != nil || app.Aggregation == nil || app.Aggregation.Window <= 0 {
+		return appUplinks
+	}
+	window := app.Aggregation.Window
+
+	var current *device.Aggregation
+	if dev.Aggregation != nil {
+		current = dev.Aggregation.Copy()
+	}
+
+	var aggregated []*types.UplinkMessage
+	for _, appUp := range appUplinks {
+		if appUp.IsRetry {
+			// Retries were already added to the window
+			continue
+		}
+
+		measured := time.Time(appUp.Metadata.DeviceTime)
+		if measured.IsZero() {
+			measured = time.Time(appUp.Metadata.Time)
+		}
+		if measured.IsZero() {
+			measured = time.Now()
+		}
+		start := measured.UTC().Truncate(window)
+
+		// Readings that are older than the current window are added to the current window
+		if current != nil && start.After(current.Start) {
+			aggregated = append(aggregated, aggregatedUplink(current, window))
+			current = nil
+		}
+		if current == nil {
+			current = &device.Aggregation{
+				Start:  start,
+				Sums:   make(map[string]float64),
+				Counts: make(map[string]uint32),
+			}
+		}
+
+		values := make(map[string]float64)
+		numericFields("", appUp.PayloadFields, values)
+		for field, value := range values {
+			current.Sums[field] += value
+			current.Counts[field]++
+		}
+		current.Count++
+		current.Last = appUp
+	}
+	dev.Aggregation = current
+
+	for _, appUp := range aggregated {
+		ctx.WithField("Start", appUp.Aggregate.Start).WithField("Count", appUp.Aggregate.Count).Debug("Publish aggregated uplink")
+		h.ApplyOutputPolicy(ctx, nil, appUp, dev)
+	}
+
+	return aggregated
+}
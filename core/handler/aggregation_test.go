@@ -0,0 +1,81 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestAggregateUplinks(t *testing.T) {
+	a := New(t)
+	appID := "AppID-1"
+
+	h := &handler{
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-aggregate-uplinks"),
+	}
+	ctx := GetLogger(t, "TestAggregateUplinks")
+	dev := &device.Device{AppID: appID, DevID: "DevID-1"}
+	start := time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	uplink := func(fCnt uint32, offset time.Duration, temperature float64) *types.UplinkMessage {
+		return &types.UplinkMessage{
+			AppID: appID,
+			DevID: "DevID-1",
+			FCnt:  fCnt,
+			PayloadFields: map[string]interface{}{
+				"temperature": temperature,
+				"label":       "living room",
+				"position":    map[string]interface{}{"x": temperature * 2},
+			},
+			Metadata: types.Metadata{Time: types.JSONTime(start.Add(offset))},
+		}
+	}
+
+	// Uplinks are not aggregated by default
+	app := &application.Application{AppID: appID}
+	a.So(h.applications.Set(app), ShouldBeNil)
+	defer func() {
+		h.applications.Delete(appID)
+	}()
+	published := h.aggregateUplinks(ctx, dev, []*types.UplinkMessage{uplink(0, 0, 20)})
+	a.So(published, ShouldHaveLength, 1)
+	a.So(dev.Aggregation, ShouldBeNil)
+
+	app.Aggregation = &application.Aggregation{Window: 15 * time.Minute}
+	a.So(h.applications.Set(app), ShouldBeNil)
+
+	// Uplinks in the window are not published
+	for i, temperature := range []float64{20, 21, 22} {
+		published = h.aggregateUplinks(ctx, dev, []*types.UplinkMessage{uplink(uint32(i), time.Duration(i)*time.Minute, temperature)})
+		a.So(published, ShouldBeEmpty)
+	}
+	a.So(dev.Aggregation.Count, ShouldEqual, 3)
+
+	// Retries are not counted
+	retry := uplink(2, 3*time.Minute, 22)
+	retry.IsRetry = true
+	published = h.aggregateUplinks(ctx, dev, []*types.UplinkMessage{retry})
+	a.So(published, ShouldBeEmpty)
+	a.So(dev.Aggregation.Count, ShouldEqual, 3)
+
+	// The first uplink in the next window publishes the average of the previous window
+	published = h.aggregateUplinks(ctx, dev, []*types.UplinkMessage{uplink(3, 16*time.Minute, 30)})
+	a.So(published, ShouldHaveLength, 1)
+	a.So(published[0].FCnt, ShouldEqual, 2)
+	a.So(published[0].PayloadFields["temperature"], ShouldEqual, 21)
+	a.So(published[0].PayloadFields["label"], ShouldEqual, "living room")
+	a.So(published[0].PayloadFields["position"].(map[string]interface{})["x"], ShouldEqual, 42)
+	a.So(published[0].Aggregate.Count, ShouldEqual, 3)
+	a.So(time.Time(published[0].Aggregate.Start).Equal(start), ShouldBeTrue)
+	a.So(time.Time(published[0].Aggregate.End).Equal(start.Add(15*time.Minute)), ShouldBeTrue)
+	a.So(dev.Aggregation.Count, ShouldEqual, 1)
+	a.So(dev.Aggregation.Start.Equal(start.Add(15*time.Minute)), ShouldBeTrue)
+}
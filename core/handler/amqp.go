@@ -6,6 +6,7 @@ package handler
 import (
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
 	"github.com/TheThingsNetwork/ttn/amqp"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
 	"github.com/TheThingsNetwork/ttn/core/types"
 )
 
@@ -32,7 +33,11 @@ func (h *handler) assertAMQPExchange() error {
 }
 
 func (h *handler) HandleAMQP(username, password, host, exchange, downlinkQueue string) error {
-	h.amqpClient = amqp.NewClient(h.Ctx, username, password, host)
+	if h.amqpTLS != nil {
+		h.amqpClient = amqp.NewTLSClient(h.Ctx, username, password, host, h.amqpTLS)
+	} else {
+		h.amqpClient = amqp.NewClient(h.Ctx, username, password, host)
+	}
 
 	err := h.amqpClient.Connect()
 	if err != nil {
@@ -83,10 +88,16 @@ func (h *handler) HandleAMQP(username, password, host, exchange, downlinkQueue s
 				"DevID": up.DevID,
 				"AppID": up.AppID,
 			}).Debug("Publish Uplink")
-			err := publisher.PublishUplink(*up)
+			var err error
+			if app, _ := h.applications.Get(up.AppID); app != nil && app.IntegrationFormat == pb.IntegrationFormatRaw {
+				err = publisher.PublishRawUplink(*up)
+			} else {
+				err = publisher.PublishUplink(*up)
+			}
 			if err != nil {
 				ctx.WithError(err).Warn("Could not publish Uplink")
 			}
+			h.captureDelivery(up.AppID, up.DevID, "amqp", err)
 		}
 	}()
 
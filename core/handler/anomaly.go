@@ -0,0 +1,132 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api"
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+)
+
+// DefaultAnomalySensitivity is the number of standard deviations that a value must differ from the moving average to
+// be an anomaly, if the application does not set a sensitivity
+var DefaultAnomalySensitivity float32 = 3
+
+// AnomalySmoothing is the weight of a new value in the moving average and variance
+var AnomalySmoothing = 0.1
+
+// AnomalyWarmup is the number of values of a field that are needed before anomalies are detected
+var AnomalyWarmup uint32 = 10
+
+// numericFields flattens the numeric values in the fields to a map with dot-separated keys
+func numericFields(prefix string, fields map[string]interface{}, out map[string]float64) {
+	for key, value := range fields {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		switch value := value.(type) {
+		case float64:
+			out[key] = value
+		case float32:
+			out[key] = float64(value)
+		case int:
+			out[key] = float64(value)
+		case int32:
+			out[key] = float64(value)
+		case int64:
+			out[key] = float64(value)
+		case uint32:
+			out[key] = float64(value)
+		case uint64:
+			out[key] = float64(value)
+		case map[string]interface{}:
+			numericFields(key, value, out)
+		}
+	}
+}
+
+// DetectAnomalies updates the moving statistics of the numeric payload fields of the device and emits an event
+// for values that differ too much from the moving average. Values during maintenance windows of the application are
+// not counted and not reported.
+func (h *handler) DetectAnomalies(ctx ttnlog.Interface, _ *pb_broker.DeduplicatedUplinkMessage, appUp *types.UplinkMessage, dev *device.Device) error {
+	if len(appUp.PayloadFields) == 0 || appUp.IsRetry {
+		return nil
+	}
+
+	app, err := h.applications.Get(appUp.AppID)
+	if err != nil || app.AnomalyDetection == nil || api.InMaintenance(app.MaintenanceWindows, time.Now()) {
+		return nil
+	}
+	sensitivity := float64(app.AnomalyDetection.Sensitivity)
+	if sensitivity == 0 {
+		sensitivity = float64(DefaultAnomalySensitivity)
+	}
+
+	values := make(map[string]float64)
+	numericFields("", appUp.PayloadFields, values)
+	if len(values) == 0 {
+		return nil
+	}
+
+	// Work on a copy of the statistics, so that the change is detected when the device is saved
+	fields := make(map[string]*device.FieldStatistic, len(values))
+	if dev.FieldStatistics != nil {
+		for field, stat := range dev.FieldStatistics.Fields {
+			stat := *stat
+			fields[field] = &stat
+		}
+	}
+	dev.FieldStatistics = &device.FieldStatistics{Fields: fields}
+
+	var anomalies []types.FieldAnomaly
+	for field, value := range values {
+		stat, ok := fields[field]
+		if !ok {
+			stat = new(device.FieldStatistic)
+			fields[field] = stat
+		}
+		if stat.Count >= AnomalyWarmup {
+			if score := stat.Score(value); math.Abs(score) > sensitivity {
+				anomaly := types.FieldAnomaly{
+					Field:  field,
+					Value:  value,
+					Mean:   stat.Mean,
+					StdDev: stat.StdDev(),
+					Score:  score,
+				}
+				if isSensitiveField(app.SensitiveFields, field) {
+					anomaly.Value, anomaly.Mean, anomaly.StdDev = 0, 0, 0
+					anomaly.Redacted = true
+				}
+				anomalies = append(anomalies, anomaly)
+			}
+		}
+		stat.Add(value, AnomalySmoothing)
+	}
+
+	if len(anomalies) == 0 {
+		return nil
+	}
+
+	sort.Slice(anomalies, func(i, j int) bool { return anomalies[i].Field < anomalies[j].Field })
+	ctx.WithField("NumAnomalies", len(anomalies)).Debug("Detected anomalies in payload fields")
+
+	h.mqttEvent <- &types.DeviceEvent{
+		AppID: appUp.AppID,
+		DevID: appUp.DevID,
+		Event: types.AnomalyEvent,
+		Data: types.AnomalyEventData{
+			FCnt:      appUp.FCnt,
+			Anomalies: anomalies,
+		},
+	}
+
+	return nil
+}
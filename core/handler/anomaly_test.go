@@ -0,0 +1,138 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestNumericFields(t *testing.T) {
+	a := New(t)
+	out := make(map[string]float64)
+	numericFields("", map[string]interface{}{
+		"temperature": 21.5,
+		"count":       int64(3),
+		"label":       "living room",
+		"position": map[string]interface{}{
+			"x": 1,
+		},
+	}, out)
+	a.So(out, ShouldResemble, map[string]float64{
+		"temperature": 21.5,
+		"count":       3,
+		"position.x":  1,
+	})
+}
+
+func TestFieldStatistic(t *testing.T) {
+	a := New(t)
+	stat := new(device.FieldStatistic)
+	for i := 0; i < 100; i++ {
+		stat.Add(float64(20+i%2), 0.1)
+	}
+	a.So(stat.Count, ShouldEqual, 100)
+	a.So(stat.Mean, ShouldAlmostEqual, 20.5, 0.1)
+	a.So(stat.StdDev(), ShouldAlmostEqual, 0.5, 0.1)
+	a.So(stat.Score(25), ShouldBeGreaterThan, 3)
+	a.So(stat.Score(20.5), ShouldAlmostEqual, 0, 0.5)
+}
+
+func TestDetectAnomalies(t *testing.T) {
+	a := New(t)
+	appID := "AppID-1"
+
+	h := &handler{
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-detect-anomalies"),
+		mqttEvent:    make(chan *types.DeviceEvent, 10),
+	}
+	ctx := GetLogger(t, "TestDetectAnomalies")
+	dev := &device.Device{AppID: appID, DevID: "DevID-1"}
+
+	uplink := func(fCnt uint32, temperature float64) *types.UplinkMessage {
+		return &types.UplinkMessage{
+			AppID:         appID,
+			DevID:         "DevID-1",
+			FCnt:          fCnt,
+			PayloadFields: map[string]interface{}{"temperature": temperature},
+		}
+	}
+
+	// Disabled if the application is not found
+	err := h.DetectAnomalies(ctx, nil, uplink(0, 20), dev)
+	a.So(err, ShouldBeNil)
+	a.So(dev.FieldStatistics, ShouldBeNil)
+
+	// Disabled by default
+	app := &application.Application{AppID: appID}
+	a.So(h.applications.Set(app), ShouldBeNil)
+	defer func() {
+		h.applications.Delete(appID)
+	}()
+	err = h.DetectAnomalies(ctx, nil, uplink(0, 20), dev)
+	a.So(err, ShouldBeNil)
+	a.So(dev.FieldStatistics, ShouldBeNil)
+
+	app.AnomalyDetection = &application.AnomalyDetection{Sensitivity: 4}
+	a.So(h.applications.Set(app), ShouldBeNil)
+
+	// Normal values do not emit events
+	for i := 0; i < 20; i++ {
+		err = h.DetectAnomalies(ctx, nil, uplink(uint32(i), 20+float64(i%3)), dev)
+		a.So(err, ShouldBeNil)
+	}
+	a.So(dev.FieldStatistics.Fields["temperature"].Count, ShouldEqual, 20)
+	a.So(h.mqttEvent, ShouldBeEmpty)
+
+	// Retries are ignored
+	retry := uplink(19, 80)
+	retry.IsRetry = true
+	err = h.DetectAnomalies(ctx, nil, retry, dev)
+	a.So(err, ShouldBeNil)
+	a.So(dev.FieldStatistics.Fields["temperature"].Count, ShouldEqual, 20)
+
+	// Outliers emit an event
+	err = h.DetectAnomalies(ctx, nil, uplink(20, 80), dev)
+	a.So(err, ShouldBeNil)
+	a.So(h.mqttEvent, ShouldHaveLength, 1)
+	evt := <-h.mqttEvent
+	a.So(evt.Event, ShouldEqual, types.AnomalyEvent)
+	data := evt.Data.(types.AnomalyEventData)
+	a.So(data.FCnt, ShouldEqual, 20)
+	a.So(data.Anomalies, ShouldHaveLength, 1)
+	a.So(data.Anomalies[0].Field, ShouldEqual, "temperature")
+	a.So(data.Anomalies[0].Value, ShouldEqual, 80)
+	a.So(data.Anomalies[0].Score, ShouldBeGreaterThan, 4)
+	a.So(data.Anomalies[0].Redacted, ShouldBeFalse)
+
+	// The values of sensitive fields are redacted
+	app.SensitiveFields = []string{"temperature"}
+	a.So(h.applications.Set(app), ShouldBeNil)
+	err = h.DetectAnomalies(ctx, nil, uplink(21, -100), dev)
+	a.So(err, ShouldBeNil)
+	a.So(h.mqttEvent, ShouldHaveLength, 1)
+	data = (<-h.mqttEvent).Data.(types.AnomalyEventData)
+	a.So(data.Anomalies, ShouldHaveLength, 1)
+	a.So(data.Anomalies[0].Field, ShouldEqual, "temperature")
+	a.So(data.Anomalies[0].Redacted, ShouldBeTrue)
+	a.So(data.Anomalies[0].Value, ShouldEqual, 0)
+	a.So(data.Anomalies[0].Mean, ShouldEqual, 0)
+	a.So(data.Anomalies[0].Score, ShouldBeLessThan, -4)
+
+	// Values during maintenance windows are not counted and not reported
+	app.MaintenanceWindows = []*api.MaintenanceWindow{{Start: time.Now().Add(-time.Minute).UnixNano(), Duration: 3600}}
+	a.So(h.applications.Set(app), ShouldBeNil)
+	count := dev.FieldStatistics.Fields["temperature"].Count
+	err = h.DetectAnomalies(ctx, nil, uplink(22, 80), dev)
+	a.So(err, ShouldBeNil)
+	a.So(h.mqttEvent, ShouldBeEmpty)
+	a.So(dev.FieldStatistics.Fields["temperature"].Count, ShouldEqual, count)
+}
@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/TheThingsNetwork/ttn/api"
 	"github.com/fatih/structs"
 )
 
@@ -29,11 +30,164 @@ type Application struct {
 	// Encoder is a JavaScript function that encode the data send on Downlink messages
 	// Returns an object containing the converted values in []byte
 	Encoder string `redis:"encoder"`
+	// PortFunctions are used instead of the Decoder, Converter, Validator and Encoder for messages on a range of ports
+	PortFunctions []PortFunctions `redis:"port_functions"`
+	// DownlinkDecoder is a JavaScript function that decodes the payload of scheduled downlink messages for events
+	DownlinkDecoder string `redis:"downlink_decoder"`
+	// JoinHook is a JavaScript function that is executed when a device joins
+	// It returns an object containing attributes for the device and a downlink message
+	JoinHook string `redis:"join_hook"`
+	// ProvisioningDownlink is sent to devices after their first uplink message after joining
+	ProvisioningDownlink *ProvisioningDownlink `redis:"provisioning_downlink"`
+	// PayloadFunctionsVersion is the version of the payload functions if they were set in bulk
+	PayloadFunctionsVersion string `redis:"payload_functions_version"`
+	// AnomalyDetection flags outliers in the numeric payload fields of uplink messages
+	AnomalyDetection *AnomalyDetection `redis:"anomaly_detection"`
+	// ComputedFields are evaluated after the Converter and added to the payload fields
+	ComputedFields []ComputedField `redis:"computed_fields"`
+	// Normalizer is a JavaScript function that maps the payload fields to the standard schema of the normalized payload
+	Normalizer string `redis:"normalizer"`
+	// OutputPolicy controls the representation of numeric payload fields in uplink messages
+	OutputPolicy *OutputPolicy `redis:"output_policy"`
+	// Aggregation publishes the average of the uplink messages of each device per window
+	Aggregation *Aggregation `redis:"aggregation"`
+	// IntegrationFormat is the format of uplink messages that are delivered to integrations (json or raw)
+	IntegrationFormat string `redis:"integration_format"`
+	// DevAddrAllocation is the strategy for the allocation of a DevAddr when devices join (empty for the default of the Handler)
+	DevAddrAllocation string `redis:"dev_addr_allocation"`
+	// RetentionDays is the number of days that stored uplink messages are kept (0 to keep them until the history is full)
+	RetentionDays uint32 `redis:"retention_days"`
+	// SensitiveFields are the payload fields that are redacted from logs and events
+	SensitiveFields []string `redis:"sensitive_fields"`
+	// PayloadFormat is the format of the payload of uplink and downlink messages (custom, cayennelpp, wasm, binary,
+	// protobuf, cbor or go)
+	PayloadFormat string `redis:"payload_format"`
+	// MaintenanceWindows are the periods during which alerts of the application are suppressed
+	MaintenanceWindows []*api.MaintenanceWindow `redis:"maintenance_windows"`
+	// WASMModule is the compiled WebAssembly module that decodes and encodes the payload if the PayloadFormat is wasm
+	WASMModule []byte `redis:"wasm_module"`
+	// BinaryFields is the layout of the payload if the PayloadFormat is binary
+	BinaryFields []BinaryField `redis:"binary_fields"`
+	// ProtobufDescriptor is the serialized FileDescriptorSet that contains the ProtobufMessage
+	ProtobufDescriptor []byte `redis:"protobuf_descriptor"`
+	// ProtobufMessage is the full name of the message that the payload is decoded as if the PayloadFormat is protobuf
+	ProtobufMessage string `redis:"protobuf_message"`
+	// GoCodec is the name of the compiled Go codec that decodes and encodes the payload if the PayloadFormat is go
+	GoCodec string `redis:"go_codec"`
+	// DataResidency is the region that the data of the application must stay in, or empty for all regions
+	DataResidency string `redis:"data_residency"`
+	// StatefulDecoding passes the decoded fields of the previous uplink message of the device to the Decoder
+	StatefulDecoding bool `redis:"stateful_decoding"`
+	// Transfer is the state of a transfer of the application between Handlers (incoming or outgoing), or empty
+	Transfer string `redis:"transfer"`
+	// FunctionsLanguage is the language of the Decoder, Converter, Validator and Encoder (javascript or lua)
+	FunctionsLanguage string `redis:"functions_language"`
+	// FunctionTimeout is the maximum time that each payload function is allowed to run (0 for the default)
+	FunctionTimeout time.Duration `redis:"function_timeout"`
+	// MaxOutputFields, MaxOutputDepth and MaxOutputSize limit the fields that the payload functions return (0 for the
+	// limits of the Handler)
+	MaxOutputFields uint32 `redis:"max_output_fields"`
+	MaxOutputDepth  uint32 `redis:"max_output_depth"`
+	MaxOutputSize   uint32 `redis:"max_output_size"`
+	// FunctionsRevision is the Revision in which the payload functions were last changed
+	FunctionsRevision uint64 `redis:"functions_revision"`
+	// Codec is the ID of a codec in the codec library of the Handler. If set, the Decoder, Converter, Validator and
+	// Encoder of the codec are used instead of the ones of the application
+	Codec string `redis:"codec"`
+
+	// Revision is incremented on every update of the settings of the application
+	Revision uint64 `redis:"revision"`
+
+	// DeletedAt is the time since which the application is in the recycle bin
+	DeletedAt time.Time `redis:"deleted_at"`
 
 	CreatedAt time.Time `redis:"created_at"`
 	UpdatedAt time.Time `redis:"updated_at"`
 }
 
+// ProvisioningDownlink is a downlink message that is sent to devices after their first uplink message after joining
+type ProvisioningDownlink struct {
+	FPort      uint8  `json:"port"`
+	Confirmed  bool   `json:"confirmed,omitempty"`
+	PayloadRaw []byte `json:"payload_raw,omitempty"`
+	// PayloadFields is a JSON-encoded object with fields. String values can refer to attributes of the device as {{.key}}
+	PayloadFields string `json:"payload_fields,omitempty"`
+}
+
+// PortFunctions are the payload functions for messages on a range of ports
+type PortFunctions struct {
+	MinPort   uint8  `json:"min_port"`
+	MaxPort   uint8  `json:"max_port,omitempty"`
+	Decoder   string `json:"decoder,omitempty"`
+	Converter string `json:"converter,omitempty"`
+	Validator string `json:"validator,omitempty"`
+	Encoder   string `json:"encoder,omitempty"`
+}
+
+// BinaryField is a field at a fixed position in the payload
+type BinaryField struct {
+	Name         string `json:"name"`
+	Offset       int    `json:"offset,omitempty"`
+	Type         string `json:"type"`
+	LittleEndian bool   `json:"little_endian,omitempty"`
+	// Scale is the factor by which the stored number is multiplied to get the value (0 for no scaling)
+	Scale float64 `json:"scale,omitempty"`
+}
+
+// Contains returns whether the port is in the range of the PortFunctions
+func (f PortFunctions) Contains(port uint8) bool {
+	if f.MaxPort == 0 {
+		return port == f.MinPort
+	}
+	return port >= f.MinPort && port <= f.MaxPort
+}
+
+// AnomalyDetection contains the settings for detecting outliers in the numeric payload fields of uplink messages
+type AnomalyDetection struct {
+	// Sensitivity is the number of standard deviations that a value must differ from the moving average to be an anomaly
+	Sensitivity float32 `json:"sensitivity,omitempty"`
+}
+
+// ComputedField is a payload field that is computed from the other payload fields with a JavaScript expression
+type ComputedField struct {
+	Name       string `json:"name"`
+	Expression string `json:"expression"`
+}
+
+// OutputPolicy controls the rounding of numeric payload fields, the representation of NaN and infinite values and the
+// names of payload fields
+type OutputPolicy struct {
+	Round           bool   `json:"round,omitempty"`
+	Decimals        uint8  `json:"decimals,omitempty"`
+	RoundingMode    string `json:"rounding_mode,omitempty"`
+	SpecialValues   string `json:"special_values,omitempty"`
+	FieldCasing     string `json:"field_casing,omitempty"`
+	Replacement     string `json:"replacement,omitempty"`
+	ProtectReserved bool   `json:"protect_reserved,omitempty"`
+}
+
+// Aggregation contains the settings for publishing aggregated uplink messages
+type Aggregation struct {
+	// Window is the length of the aggregation window
+	Window time.Duration `json:"window"`
+}
+
+// FunctionsForPort returns the payload functions for messages on the port: the first PortFunctions that contain the
+// port, or the Decoder, Converter, Validator and Encoder of the application
+func (a *Application) FunctionsForPort(port uint8) PortFunctions {
+	for _, functions := range a.PortFunctions {
+		if functions.Contains(port) {
+			return functions
+		}
+	}
+	return PortFunctions{
+		Decoder:   a.Decoder,
+		Converter: a.Converter,
+		Validator: a.Validator,
+		Encoder:   a.Encoder,
+	}
+}
+
 // StartUpdate stores the state of the device
 func (a *Application) StartUpdate() {
 	old := *a
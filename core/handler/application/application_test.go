@@ -29,3 +29,22 @@ func TestApplicationChangedFields(t *testing.T) {
 	a.So(application.ChangedFields(), ShouldHaveLength, 1)
 	a.So(application.ChangedFields(), ShouldContain, "AppID")
 }
+
+func TestApplicationFunctionsForPort(t *testing.T) {
+	a := New(t)
+	application := &Application{
+		AppID:   "Application",
+		Decoder: "default",
+		PortFunctions: []PortFunctions{
+			{MinPort: 1, Decoder: "status"},
+			{MinPort: 10, MaxPort: 20, Decoder: "sensors"},
+			{MinPort: 15, MaxPort: 30, Decoder: "shadowed"},
+		},
+	}
+	a.So(application.FunctionsForPort(1).Decoder, ShouldEqual, "status")
+	a.So(application.FunctionsForPort(2).Decoder, ShouldEqual, "default")
+	a.So(application.FunctionsForPort(10).Decoder, ShouldEqual, "sensors")
+	a.So(application.FunctionsForPort(15).Decoder, ShouldEqual, "sensors")
+	a.So(application.FunctionsForPort(21).Decoder, ShouldEqual, "shadowed")
+	a.So(application.FunctionsForPort(31).Decoder, ShouldEqual, "default")
+}
@@ -0,0 +1,134 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package application
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+)
+
+// FunctionsHistoryLength is the maximum number of revisions of the payload functions that is stored for each application
+var FunctionsHistoryLength = 20
+
+// FunctionsRevision is a revision of the payload functions of an application
+type FunctionsRevision struct {
+	// Revision is the revision of the application in which the payload functions were set
+	Revision                uint64          `json:"revision"`
+	Time                    time.Time       `json:"time"`
+	Decoder                 string          `json:"decoder,omitempty"`
+	Converter               string          `json:"converter,omitempty"`
+	Validator               string          `json:"validator,omitempty"`
+	Encoder                 string          `json:"encoder,omitempty"`
+	PortFunctions           []PortFunctions `json:"port_functions,omitempty"`
+	DownlinkDecoder         string          `json:"downlink_decoder,omitempty"`
+	PayloadFormat           string          `json:"payload_format,omitempty"`
+	WASMModule              []byte          `json:"wasm_module,omitempty"`
+	BinaryFields            []BinaryField   `json:"binary_fields,omitempty"`
+	FunctionsLanguage       string          `json:"functions_language,omitempty"`
+	ProtobufDescriptor      []byte          `json:"protobuf_descriptor,omitempty"`
+	ProtobufMessage         string          `json:"protobuf_message,omitempty"`
+	GoCodec                 string          `json:"go_codec,omitempty"`
+	Normalizer              string          `json:"normalizer,omitempty"`
+	PayloadFunctionsVersion string          `json:"payload_functions_version,omitempty"`
+	Codec                   string          `json:"codec,omitempty"`
+}
+
+// Functions returns the current payload functions of the application as a revision
+func (a Application) Functions() *FunctionsRevision {
+	return &FunctionsRevision{
+		Revision:                a.FunctionsRevision,
+		Time:                    a.UpdatedAt,
+		Decoder:                 a.Decoder,
+		Converter:               a.Converter,
+		Validator:               a.Validator,
+		Encoder:                 a.Encoder,
+		PortFunctions:           a.PortFunctions,
+		DownlinkDecoder:         a.DownlinkDecoder,
+		PayloadFormat:           a.PayloadFormat,
+		WASMModule:              a.WASMModule,
+		BinaryFields:            a.BinaryFields,
+		FunctionsLanguage:       a.FunctionsLanguage,
+		ProtobufDescriptor:      a.ProtobufDescriptor,
+		ProtobufMessage:         a.ProtobufMessage,
+		GoCodec:                 a.GoCodec,
+		Normalizer:              a.Normalizer,
+		PayloadFunctionsVersion: a.PayloadFunctionsVersion,
+		Codec:                   a.Codec,
+	}
+}
+
+// Restore sets the payload functions of the application to the ones of the revision
+func (r FunctionsRevision) Restore(app *Application) {
+	app.Decoder = r.Decoder
+	app.Converter = r.Converter
+	app.Validator = r.Validator
+	app.Encoder = r.Encoder
+	app.PortFunctions = r.PortFunctions
+	app.DownlinkDecoder = r.DownlinkDecoder
+	app.PayloadFormat = r.PayloadFormat
+	app.WASMModule = r.WASMModule
+	app.BinaryFields = r.BinaryFields
+	app.FunctionsLanguage = r.FunctionsLanguage
+	app.ProtobufDescriptor = r.ProtobufDescriptor
+	app.ProtobufMessage = r.ProtobufMessage
+	app.GoCodec = r.GoCodec
+	app.Normalizer = r.Normalizer
+	app.PayloadFunctionsVersion = r.PayloadFunctionsVersion
+	app.Codec = r.Codec
+}
+
+// FunctionsChanged returns whether the payload functions changed since the last call to StartUpdate. If StartUpdate
+// was not called, it returns whether the application has payload functions.
+func (a Application) FunctionsChanged() bool {
+	old := a.old
+	if old == nil {
+		old = &Application{}
+	}
+	new, previous := a.Functions(), old.Functions()
+	new.Revision, new.Time, new.PayloadFunctionsVersion = 0, time.Time{}, ""
+	previous.Revision, previous.Time, previous.PayloadFunctionsVersion = 0, time.Time{}, ""
+	if len(new.PortFunctions) == 0 && len(previous.PortFunctions) == 0 {
+		new.PortFunctions, previous.PortFunctions = nil, nil
+	}
+	if len(new.WASMModule) == 0 && len(previous.WASMModule) == 0 {
+		new.WASMModule, previous.WASMModule = nil, nil
+	}
+	if len(new.BinaryFields) == 0 && len(previous.BinaryFields) == 0 {
+		new.BinaryFields, previous.BinaryFields = nil, nil
+	}
+	if len(new.ProtobufDescriptor) == 0 && len(previous.ProtobufDescriptor) == 0 {
+		new.ProtobufDescriptor, previous.ProtobufDescriptor = nil, nil
+	}
+	return !reflect.DeepEqual(new, previous)
+}
+
+// addFunctionsRevision adds the payload functions of the application to the history of its payload functions
+func (s *RedisApplicationStore) addFunctionsRevision(app *Application) error {
+	data, err := json.Marshal(app.Functions())
+	if err != nil {
+		return err
+	}
+	if err := s.functions.AddFront(app.AppID, string(data)); err != nil {
+		return err
+	}
+	return s.functions.Trim(app.AppID, FunctionsHistoryLength)
+}
+
+// FunctionsHistory returns the stored revisions of the payload functions of an Application, newest first
+func (s *RedisApplicationStore) FunctionsHistory(appID string) ([]*FunctionsRevision, error) {
+	stored, err := s.functions.Get(appID)
+	if err != nil {
+		return nil, err
+	}
+	revisions := make([]*FunctionsRevision, 0, len(stored))
+	for _, data := range stored {
+		revision := new(FunctionsRevision)
+		if err := json.Unmarshal([]byte(data), revision); err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
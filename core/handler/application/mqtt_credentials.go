@@ -0,0 +1,126 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package application
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/storage"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"gopkg.in/redis.v5"
+)
+
+// MQTTCredentials are the credentials of a collaborator of an application on the MQTT broker
+type MQTTCredentials struct {
+	Username     string   `redis:"username"`
+	AppID        string   `redis:"app_id"`
+	Collaborator string   `redis:"collaborator"`
+	Issuer       string   `redis:"issuer"` // The auth server that the collaborators are synchronized from
+	Salt         string   `redis:"salt"`
+	PasswordHash string   `redis:"password_hash"`
+	Read         []string `redis:"read"`
+	Write        []string `redis:"write"`
+
+	CreatedAt time.Time `redis:"created_at"`
+	UpdatedAt time.Time `redis:"updated_at"`
+}
+
+func hashPassword(salt, password string) string {
+	hash := sha256.Sum256([]byte(salt + password))
+	return hex.EncodeToString(hash[:])
+}
+
+// SetPassword sets the password hash of the credentials
+func (c *MQTTCredentials) SetPassword(salt, password string) {
+	c.Salt = salt
+	c.PasswordHash = hashPassword(salt, password)
+}
+
+// CheckPassword checks the password against the password hash of the credentials
+func (c *MQTTCredentials) CheckPassword(password string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashPassword(c.Salt, password)), []byte(c.PasswordHash)) == 1
+}
+
+// MQTTCredentialsUsername returns the username of the MQTT credentials of a collaborator of an application
+func MQTTCredentialsUsername(appID, collaborator string) string {
+	return appID + "." + collaborator
+}
+
+// MQTTCredentialsStore interface for MQTT credentials
+type MQTTCredentialsStore interface {
+	List(appID string) ([]*MQTTCredentials, error)
+	Get(username string) (*MQTTCredentials, error)
+	Set(new *MQTTCredentials) error
+	Delete(username string) error
+}
+
+const redisMQTTCredentialsPrefix = "mqtt_credentials"
+
+// NewRedisMQTTCredentialsStore creates a new Redis-based MQTT credentials store
+// if an empty prefix is passed, a default prefix will be used.
+func NewRedisMQTTCredentialsStore(client *redis.Client, prefix string) MQTTCredentialsStore {
+	if prefix == "" {
+		prefix = defaultRedisPrefix
+	}
+	store := storage.NewRedisMapStore(client, prefix+":"+redisMQTTCredentialsPrefix)
+	store.SetBase(MQTTCredentials{}, "")
+	return &RedisMQTTCredentialsStore{
+		store: store,
+	}
+}
+
+// RedisMQTTCredentialsStore stores MQTT credentials in Redis.
+// - Credentials are stored as a Hash, with the username as key
+type RedisMQTTCredentialsStore struct {
+	store *storage.RedisMapStore
+}
+
+// List the MQTT credentials of an application
+func (s *RedisMQTTCredentialsStore) List(appID string) ([]*MQTTCredentials, error) {
+	credentialsI, err := s.store.List(MQTTCredentialsUsername(appID, "*"), nil)
+	if err != nil {
+		return nil, err
+	}
+	credentials := make([]*MQTTCredentials, 0, len(credentialsI))
+	for _, credentialI := range credentialsI {
+		if credential, ok := credentialI.(MQTTCredentials); ok && credential.AppID == appID {
+			credentials = append(credentials, &credential)
+		}
+	}
+	return credentials, nil
+}
+
+// Get the MQTT credentials with the given username
+func (s *RedisMQTTCredentialsStore) Get(username string) (*MQTTCredentials, error) {
+	if strings.ContainsAny(username, "*?[]") {
+		return nil, errors.NewErrInvalidArgument("Username", "contains invalid characters")
+	}
+	credentialsI, err := s.store.Get(username)
+	if err != nil {
+		return nil, err
+	}
+	if credentials, ok := credentialsI.(MQTTCredentials); ok {
+		return &credentials, nil
+	}
+	return nil, errors.New("Database did not return MQTT credentials")
+}
+
+// Set new MQTT credentials or update existing ones
+func (s *RedisMQTTCredentialsStore) Set(new *MQTTCredentials) error {
+	now := time.Now()
+	new.UpdatedAt = now
+	if new.CreatedAt.IsZero() {
+		new.CreatedAt = now
+	}
+	return s.store.Set(new.Username, *new)
+}
+
+// Delete MQTT credentials
+func (s *RedisMQTTCredentialsStore) Delete(username string) error {
+	return s.store.Delete(username)
+}
@@ -4,6 +4,7 @@
 package application
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/TheThingsNetwork/ttn/core/handler/application/migrate"
@@ -17,11 +18,24 @@ type Store interface {
 	List(opts *storage.ListOptions) ([]*Application, error)
 	Get(appID string) (*Application, error)
 	Set(new *Application, properties ...string) (err error)
+	SetIfRevision(new *Application, revision uint64) (err error)
 	Delete(appID string) error
+	// FunctionsHistory returns the stored revisions of the payload functions of an Application, newest first
+	FunctionsHistory(appID string) ([]*FunctionsRevision, error)
+	// SoftDelete moves an Application with the history of its payload functions to the recycle bin
+	SoftDelete(app *Application) error
+	ListDeleted() ([]*Application, error)
+	GetDeleted(appID string) (*Application, error)
+	// Restore moves an Application from the recycle bin back to the store
+	Restore(appID string) (*Application, error)
+	// Purge deletes an Application from the recycle bin
+	Purge(appID string) error
 }
 
 const defaultRedisPrefix = "handler"
 const redisApplicationPrefix = "application"
+const redisFunctionsHistoryPrefix = "functions_history"
+const redisDeletedPrefix = "deleted"
 
 // NewRedisApplicationStore creates a new Redis-based Application store
 // if an empty prefix is passed, a default prefix will be used.
@@ -29,20 +43,31 @@ func NewRedisApplicationStore(client *redis.Client, prefix string) Store {
 	if prefix == "" {
 		prefix = defaultRedisPrefix
 	}
+	s := newRedisApplicationStore(client, prefix)
+	s.deleted = newRedisApplicationStore(client, prefix+":"+redisDeletedPrefix)
+	return s
+}
+
+func newRedisApplicationStore(client *redis.Client, prefix string) *RedisApplicationStore {
 	store := storage.NewRedisMapStore(client, prefix+":"+redisApplicationPrefix)
 	store.SetBase(Application{}, "")
 	for v, f := range migrate.ApplicationMigrations(prefix) {
 		store.AddMigration(v, f)
 	}
 	return &RedisApplicationStore{
-		store: store,
+		store:     store,
+		functions: storage.NewRedisQueueStore(client, prefix+":"+redisFunctionsHistoryPrefix),
 	}
 }
 
 // RedisApplicationStore stores Applications in Redis.
 // - Applications are stored as a Hash
+// - The history of the payload functions of an Application is stored as a List of JSON-encoded revisions
+// - Deleted Applications are moved to the same structures with the "deleted" prefix
 type RedisApplicationStore struct {
-	store *storage.RedisMapStore
+	store     *storage.RedisMapStore
+	functions *storage.RedisQueueStore
+	deleted   *RedisApplicationStore
 }
 
 // List all Applications
@@ -86,7 +111,94 @@ func (s *RedisApplicationStore) Set(new *Application, properties ...string) (err
 	return nil
 }
 
+// SetIfRevision updates an Application if the stored Application has the given revision, and increments the revision.
+// If the payload functions changed, they are added to the history of the payload functions.
+func (s *RedisApplicationStore) SetIfRevision(new *Application, revision uint64) (err error) {
+	now := time.Now()
+	new.UpdatedAt = now
+	if new.old == nil {
+		new.CreatedAt = now
+	}
+	new.Revision = revision + 1
+	functionsChanged := new.FunctionsChanged()
+	if functionsChanged {
+		new.FunctionsRevision = new.Revision
+	}
+	err = s.store.SetIf(new.AppID, *new, "revision", storage.ExpectRevision("Application", revision))
+	if err != nil {
+		return
+	}
+	if functionsChanged {
+		return s.addFunctionsRevision(new)
+	}
+	return nil
+}
+
 // Delete an Application
 func (s *RedisApplicationStore) Delete(appID string) error {
+	if err := s.functions.Delete(appID); err != nil {
+		return err
+	}
 	return s.store.Delete(appID)
 }
+
+// move an Application with the history of its payload functions to the other store
+func (s *RedisApplicationStore) move(to *RedisApplicationStore, appID string) error {
+	if err := s.functions.Rename(appID, to.functions.RedisStore, appID); err != nil {
+		return err
+	}
+	return s.store.Rename(appID, to.store.RedisStore, appID)
+}
+
+// SoftDelete stores the changes of the Application (such as DeletedAt) and moves it to the recycle bin, replacing an
+// Application with the same ID that was deleted before
+func (s *RedisApplicationStore) SoftDelete(app *Application) error {
+	if _, err := s.store.Get(app.AppID); err != nil {
+		return err
+	}
+	if err := s.store.Set(app.AppID, *app); err != nil {
+		return err
+	}
+	if err := s.deleted.Delete(app.AppID); err != nil {
+		return err
+	}
+	return s.move(s.deleted, app.AppID)
+}
+
+// ListDeleted lists the Applications in the recycle bin
+func (s *RedisApplicationStore) ListDeleted() ([]*Application, error) {
+	return s.deleted.List(nil)
+}
+
+// GetDeleted gets an Application from the recycle bin
+func (s *RedisApplicationStore) GetDeleted(appID string) (*Application, error) {
+	return s.deleted.Get(appID)
+}
+
+// Restore moves an Application from the recycle bin back to the store and clears its DeletedAt. It fails if an
+// Application with the same ID exists.
+func (s *RedisApplicationStore) Restore(appID string) (*Application, error) {
+	app, err := s.deleted.Get(appID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.Get(appID); err == nil {
+		return nil, errors.NewErrAlreadyExists(fmt.Sprintf("Application %s", appID))
+	} else if !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if err := s.deleted.move(s, appID); err != nil {
+		return nil, err
+	}
+	app.StartUpdate()
+	app.DeletedAt = time.Time{}
+	if err := s.store.Set(appID, *app); err != nil {
+		return nil, err
+	}
+	return app, nil
+}
+
+// Purge deletes an Application from the recycle bin
+func (s *RedisApplicationStore) Purge(appID string) error {
+	return s.deleted.Delete(appID)
+}
@@ -5,7 +5,9 @@ package application
 
 import (
 	"testing"
+	"time"
 
+	"github.com/TheThingsNetwork/ttn/utils/errors"
 	. "github.com/TheThingsNetwork/ttn/utils/testing"
 	. "github.com/smartystreets/assertions"
 )
@@ -69,3 +71,152 @@ func TestApplicationStore(t *testing.T) {
 	a.So(err, ShouldNotBeNil)
 	a.So(app, ShouldBeNil)
 }
+
+func TestApplicationStoreSetIfRevision(t *testing.T) {
+	a := New(t)
+
+	s := NewRedisApplicationStore(GetRedisClient(), "handler-test-application-store-revision")
+
+	appID := "AppID-1"
+
+	// Create
+	err := s.Set(&Application{AppID: appID})
+	defer func() {
+		s.Delete(appID)
+	}()
+	a.So(err, ShouldBeNil)
+
+	// Update with current revision
+	app, _ := s.Get(appID)
+	a.So(app.Revision, ShouldEqual, 0)
+	app.StartUpdate()
+	app.Encoder = "encoder"
+	err = s.SetIfRevision(app, app.Revision)
+	a.So(err, ShouldBeNil)
+	a.So(app.Revision, ShouldEqual, 1)
+
+	app, _ = s.Get(appID)
+	a.So(app.Revision, ShouldEqual, 1)
+	a.So(app.Encoder, ShouldEqual, "encoder")
+
+	// Update with outdated revision
+	app.StartUpdate()
+	app.Encoder = "other encoder"
+	err = s.SetIfRevision(app, 0)
+	a.So(err, ShouldNotBeNil)
+	a.So(errors.IsConflict(err), ShouldBeTrue)
+
+	app, _ = s.Get(appID)
+	a.So(app.Revision, ShouldEqual, 1)
+	a.So(app.Encoder, ShouldEqual, "encoder")
+}
+
+func TestApplicationStoreFunctionsHistory(t *testing.T) {
+	a := New(t)
+
+	s := NewRedisApplicationStore(GetRedisClient(), "handler-test-application-store-functions")
+
+	appID := "AppID-1"
+
+	err := s.Set(&Application{AppID: appID})
+	defer func() {
+		s.Delete(appID)
+	}()
+	a.So(err, ShouldBeNil)
+
+	revisions, err := s.FunctionsHistory(appID)
+	a.So(err, ShouldBeNil)
+	a.So(revisions, ShouldBeEmpty)
+
+	// Changes of the payload functions are recorded
+	app, _ := s.Get(appID)
+	app.StartUpdate()
+	app.Decoder = "decoder"
+	a.So(s.SetIfRevision(app, app.Revision), ShouldBeNil)
+	a.So(app.FunctionsRevision, ShouldEqual, 1)
+
+	// Other changes are not
+	app, _ = s.Get(appID)
+	app.StartUpdate()
+	app.RetentionDays = 7
+	a.So(s.SetIfRevision(app, app.Revision), ShouldBeNil)
+	a.So(app.FunctionsRevision, ShouldEqual, 1)
+
+	app, _ = s.Get(appID)
+	app.StartUpdate()
+	app.Decoder = "new decoder"
+	a.So(s.SetIfRevision(app, app.Revision), ShouldBeNil)
+	a.So(app.FunctionsRevision, ShouldEqual, 3)
+
+	revisions, err = s.FunctionsHistory(appID)
+	a.So(err, ShouldBeNil)
+	a.So(revisions, ShouldHaveLength, 2)
+	a.So(revisions[0].Revision, ShouldEqual, 3)
+	a.So(revisions[0].Decoder, ShouldEqual, "new decoder")
+	a.So(revisions[1].Revision, ShouldEqual, 1)
+	a.So(revisions[1].Decoder, ShouldEqual, "decoder")
+
+	// Restore
+	app, _ = s.Get(appID)
+	a.So(app.FunctionsRevision, ShouldEqual, 3)
+	app.StartUpdate()
+	revisions[1].Restore(app)
+	a.So(app.FunctionsChanged(), ShouldBeTrue)
+	a.So(app.Decoder, ShouldEqual, "decoder")
+
+	// The history is deleted with the application
+	a.So(s.Delete(appID), ShouldBeNil)
+	revisions, err = s.FunctionsHistory(appID)
+	a.So(err, ShouldBeNil)
+	a.So(revisions, ShouldBeEmpty)
+}
+
+func TestApplicationStoreRecycleBin(t *testing.T) {
+	a := New(t)
+
+	s := NewRedisApplicationStore(GetRedisClient(), "handler-test-application-store-recycle-bin")
+
+	appID := "AppID-1"
+
+	app := &Application{AppID: appID, Decoder: "decoder"}
+	a.So(s.Set(app), ShouldBeNil)
+	defer func() {
+		s.Delete(appID)
+		s.Purge(appID)
+	}()
+
+	// Soft delete
+	app.StartUpdate()
+	app.DeletedAt = time.Now()
+	a.So(s.SoftDelete(app), ShouldBeNil)
+
+	_, err := s.Get(appID)
+	a.So(errors.GetErrType(err), ShouldEqual, errors.NotFound)
+
+	deleted, err := s.ListDeleted()
+	a.So(err, ShouldBeNil)
+	a.So(deleted, ShouldHaveLength, 1)
+	a.So(deleted[0].DeletedAt.IsZero(), ShouldBeFalse)
+
+	// Restore fails if an application with the same ID exists
+	a.So(s.Set(&Application{AppID: appID}), ShouldBeNil)
+	_, err = s.Restore(appID)
+	a.So(errors.GetErrType(err), ShouldEqual, errors.AlreadyExists)
+	a.So(s.Delete(appID), ShouldBeNil)
+
+	// Restore
+	_, err = s.Restore(appID)
+	a.So(err, ShouldBeNil)
+	app, err = s.Get(appID)
+	a.So(err, ShouldBeNil)
+	a.So(app.Decoder, ShouldEqual, "decoder")
+	a.So(app.DeletedAt.IsZero(), ShouldBeTrue)
+
+	// Purge
+	app.StartUpdate()
+	app.DeletedAt = time.Now()
+	a.So(s.SoftDelete(app), ShouldBeNil)
+	a.So(s.Purge(appID), ShouldBeNil)
+	_, err = s.GetDeleted(appID)
+	a.So(errors.GetErrType(err), ShouldEqual, errors.NotFound)
+}
@@ -0,0 +1,116 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package application
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/storage"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"gopkg.in/redis.v5"
+)
+
+// Webhook POSTs the messages of an application as JSON to a URL
+type Webhook struct {
+	AppID     string `redis:"app_id"`
+	WebhookID string `redis:"webhook_id"`
+	URL       string `redis:"url"`
+	// Headers are added to the requests
+	Headers map[string]string `redis:"headers"`
+	// MessageTypes are the types of messages that are POSTed (uplink, activation and downlink), or empty for all types
+	MessageTypes []string `redis:"message_types"`
+
+	CreatedAt time.Time `redis:"created_at"`
+	UpdatedAt time.Time `redis:"updated_at"`
+}
+
+// Sends returns whether the webhook POSTs messages of the given type
+func (w *Webhook) Sends(messageType string) bool {
+	if len(w.MessageTypes) == 0 {
+		return true
+	}
+	for _, t := range w.MessageTypes {
+		if t == messageType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookStore interface for webhooks
+type WebhookStore interface {
+	List(appID string) ([]*Webhook, error)
+	Get(appID, webhookID string) (*Webhook, error)
+	Set(new *Webhook) error
+	Delete(appID, webhookID string) error
+}
+
+const redisWebhookPrefix = "webhook"
+
+// NewRedisWebhookStore creates a new Redis-based webhook store
+// if an empty prefix is passed, a default prefix will be used.
+func NewRedisWebhookStore(client *redis.Client, prefix string) WebhookStore {
+	if prefix == "" {
+		prefix = defaultRedisPrefix
+	}
+	store := storage.NewRedisMapStore(client, prefix+":"+redisWebhookPrefix)
+	store.SetBase(Webhook{}, "")
+	return &RedisWebhookStore{
+		store: store,
+	}
+}
+
+// RedisWebhookStore stores webhooks in Redis.
+// - Webhooks are stored as a Hash, with the AppID and WebhookID as key
+type RedisWebhookStore struct {
+	store *storage.RedisMapStore
+}
+
+// List the webhooks of an application
+func (s *RedisWebhookStore) List(appID string) ([]*Webhook, error) {
+	webhooksI, err := s.store.List(fmt.Sprintf("%s:*", appID), nil)
+	if err != nil {
+		return nil, err
+	}
+	webhooks := make([]*Webhook, 0, len(webhooksI))
+	for _, webhookI := range webhooksI {
+		if webhook, ok := webhookI.(Webhook); ok && webhook.AppID == appID {
+			webhooks = append(webhooks, &webhook)
+		}
+	}
+	return webhooks, nil
+}
+
+// Get the webhook of an application
+func (s *RedisWebhookStore) Get(appID, webhookID string) (*Webhook, error) {
+	webhookI, err := s.store.Get(fmt.Sprintf("%s:%s", appID, webhookID))
+	if err != nil {
+		return nil, err
+	}
+	if webhook, ok := webhookI.(Webhook); ok {
+		return &webhook, nil
+	}
+	return nil, errors.New("Database did not return a Webhook")
+}
+
+// Set a new webhook or update an existing one
+func (s *RedisWebhookStore) Set(new *Webhook) error {
+	now := time.Now()
+	new.UpdatedAt = now
+	if new.CreatedAt.IsZero() {
+		new.CreatedAt = now
+	}
+	// Replace the webhook, so that removed headers and message types are not kept
+	key := fmt.Sprintf("%s:%s", new.AppID, new.WebhookID)
+	if err := s.store.Delete(key); err != nil {
+		return err
+	}
+	return s.store.Set(key, *new)
+}
+
+// Delete a webhook
+func (s *RedisWebhookStore) Delete(appID, webhookID string) error {
+	return s.store.Delete(fmt.Sprintf("%s:%s", appID, webhookID))
+}
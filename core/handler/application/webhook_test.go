@@ -0,0 +1,69 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package application
+
+import (
+	"testing"
+
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestWebhookStore(t *testing.T) {
+	a := New(t)
+
+	s := NewRedisWebhookStore(GetRedisClient(), "handler-test-webhook-store")
+
+	appID := "AppID-1"
+
+	// Get non-existing
+	webhook, err := s.Get(appID, "dashboard")
+	a.So(err, ShouldNotBeNil)
+	a.So(webhook, ShouldBeNil)
+
+	// Create
+	err = s.Set(&Webhook{
+		AppID:        appID,
+		WebhookID:    "dashboard",
+		URL:          "https://example.com/ttn",
+		Headers:      map[string]string{"Authorization": "Bearer secret"},
+		MessageTypes: []string{"uplink"},
+	})
+	defer func() {
+		s.Delete(appID, "dashboard")
+	}()
+	a.So(err, ShouldBeNil)
+	err = s.Set(&Webhook{AppID: "AppID-2", WebhookID: "dashboard", URL: "https://example.com/other"})
+	defer func() {
+		s.Delete("AppID-2", "dashboard")
+	}()
+	a.So(err, ShouldBeNil)
+
+	// Get existing
+	webhook, err = s.Get(appID, "dashboard")
+	a.So(err, ShouldBeNil)
+	a.So(webhook.URL, ShouldEqual, "https://example.com/ttn")
+	a.So(webhook.Headers, ShouldResemble, map[string]string{"Authorization": "Bearer secret"})
+	a.So(webhook.Sends("uplink"), ShouldBeTrue)
+	a.So(webhook.Sends("activation"), ShouldBeFalse)
+
+	// List
+	webhooks, err := s.List(appID)
+	a.So(err, ShouldBeNil)
+	a.So(webhooks, ShouldHaveLength, 1)
+
+	// Update removes the headers and message types that are no longer set
+	webhook.Headers, webhook.MessageTypes = nil, nil
+	a.So(s.Set(webhook), ShouldBeNil)
+	webhook, err = s.Get(appID, "dashboard")
+	a.So(err, ShouldBeNil)
+	a.So(webhook.Headers, ShouldBeEmpty)
+	a.So(webhook.Sends("activation"), ShouldBeTrue)
+
+	// Delete
+	a.So(s.Delete(appID, "dashboard"), ShouldBeNil)
+	webhooks, err = s.List(appID)
+	a.So(err, ShouldBeNil)
+	a.So(webhooks, ShouldBeEmpty)
+}
@@ -0,0 +1,129 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/archive"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+func (h *handler) WithArchive(backend archive.Backend) Handler {
+	h.archiveBackend = backend
+	return h
+}
+
+// archiveUplink adds the uplink to the archive, if archival is enabled
+func (h *handler) archiveUplink(ctx ttnlog.Interface, uplink *pb_broker.DeduplicatedUplinkMessage, appUplink *types.UplinkMessage) {
+	if h.archiver == nil {
+		return
+	}
+	stored := deviceUplink(uplink, appUplink)
+	if stored.ServerTime == 0 {
+		stored.ServerTime = time.Now().UnixNano()
+	}
+	err := h.archiver.Add(archive.Record{
+		AppID:  appUplink.AppID,
+		DevID:  appUplink.DevID,
+		Uplink: stored,
+	})
+	if err != nil {
+		ctx.WithError(err).Warn("Could not archive uplink")
+	}
+}
+
+func (h *handlerManager) ReplayArchive(ctx context.Context, in *pb.ReplayArchiveRequest) (*pb.ReplayArchiveResult, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Replay Archive Request")
+	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
+	if err := h.validateComponentAccess(ctx); err != nil {
+		return nil, err
+	}
+	until := time.Now()
+	if in.Until != 0 {
+		until = time.Unix(0, in.Until)
+	}
+	return h.handler.replayArchive(time.Unix(0, in.Since), until, in.AppIds)
+}
+
+// replayArchive replays the uplink messages in the archive that were received at or after since and before until. If
+// appIDs is not empty, only the messages of these applications are replayed.
+func (h *handler) replayArchive(since, until time.Time, appIDs []string) (*pb.ReplayArchiveResult, error) {
+	if h.archiveBackend == nil {
+		return nil, errors.NewErrInvalidArgument("Archive", "archival is not enabled on this Handler")
+	}
+	files, err := archive.Files(h.archiveBackend, since, until)
+	if err != nil {
+		return nil, err
+	}
+
+	var filter map[string]bool
+	if len(appIDs) > 0 {
+		filter = make(map[string]bool, len(appIDs))
+		for _, appID := range appIDs {
+			filter[appID] = true
+		}
+	}
+
+	log := h.Ctx.WithFields(ttnlog.Fields{
+		"Since": since,
+		"Until": until,
+	})
+
+	res := &pb.ReplayArchiveResult{NumFiles: uint32(len(files))}
+	devices := make(map[string]*device.Device)
+	for _, file := range files {
+		err := archive.Read(h.archiveBackend, file, func(record archive.Record) error {
+			received := time.Unix(0, record.Uplink.ServerTime)
+			if received.Before(since) || !received.Before(until) {
+				return nil
+			}
+			if filter != nil && !filter[record.AppID] {
+				return nil
+			}
+			res.NumUplinks++
+
+			key := record.AppID + "/" + record.DevID
+			dev, ok := devices[key]
+			if !ok {
+				dev, _ = h.devices.Get(record.AppID, record.DevID)
+				devices[key] = dev
+			}
+			if dev == nil {
+				return nil // The device was deleted
+			}
+			if err := h.replayUplink(log, dev, record.Uplink); err != nil {
+				log.WithError(err).WithFields(ttnlog.Fields{
+					"AppID": record.AppID,
+					"DevID": record.DevID,
+					"FCnt":  record.Uplink.Counter,
+				}).Warn("Could not replay uplink")
+				return nil
+			}
+			res.NumReplayed++
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "Could not read archive file "+file)
+		}
+	}
+
+	log.WithFields(ttnlog.Fields{
+		"NumFiles":    res.NumFiles,
+		"NumUplinks":  res.NumUplinks,
+		"NumReplayed": res.NumReplayed,
+	}).Info("Replayed archive")
+
+	return res, nil
+}
@@ -0,0 +1,88 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/archive"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestReplayArchive(t *testing.T) {
+	a := New(t)
+
+	dir, err := ioutil.TempDir("", "ttn-handler-archive")
+	a.So(err, ShouldBeNil)
+	defer os.RemoveAll(dir)
+
+	h := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestReplayArchive")},
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-replay-archive"),
+		devices:      device.NewRedisDeviceStore(GetRedisClient(), "handler-test-replay-archive"),
+	}
+	h.applications.Set(&application.Application{
+		AppID:   "appid",
+		Decoder: `function Decoder (bytes) { return { temperature: bytes[0] / 2 }; }`,
+	})
+	h.devices.Set(&device.Device{
+		AppID:  "appid",
+		DevID:  "devid",
+		DevEUI: types.DevEUI([8]byte{1, 2, 3, 4, 5, 6, 7, 8}),
+	})
+	defer func() {
+		h.applications.Delete("appid")
+		h.devices.Delete("appid", "devid")
+	}()
+	h.mqttUp = make(chan *types.UplinkMessage, 10)
+	h.mqttEvent = make(chan *types.DeviceEvent, 10)
+
+	_, err = h.replayArchive(time.Unix(0, 0), time.Now(), nil)
+	a.So(err, ShouldNotBeNil)
+
+	h.WithArchive(archive.NewDirectoryBackend(dir))
+	h.archiver = archive.NewArchiver(h.Ctx, h.archiveBackend)
+
+	start := time.Date(2017, 10, 19, 13, 0, 0, 0, time.UTC)
+	for i, appID := range []string{"appid", "appid", "otherapp", "appid"} {
+		h.archiveUplink(h.Ctx, &pb_broker.DeduplicatedUplinkMessage{
+			ServerTime: start.Add(time.Duration(i) * 30 * time.Minute).UnixNano(),
+		}, &types.UplinkMessage{
+			AppID:      appID,
+			DevID:      "devid",
+			FPort:      1,
+			FCnt:       uint32(i),
+			PayloadRaw: []byte{21},
+		})
+	}
+	h.archiver.Close()
+
+	res, err := h.replayArchive(start.Add(15*time.Minute), start.Add(2*time.Hour), []string{"appid"})
+	a.So(err, ShouldBeNil)
+	a.So(res.NumFiles, ShouldEqual, 2)
+	a.So(res.NumUplinks, ShouldEqual, 2)
+	a.So(res.NumReplayed, ShouldEqual, 2)
+
+	uplink := <-h.mqttUp
+	a.So(uplink.IsReplay, ShouldBeTrue)
+	a.So(uplink.FCnt, ShouldEqual, 1)
+	a.So(uplink.PayloadFields, ShouldResemble, map[string]interface{}{"temperature": 10.5})
+	uplink = <-h.mqttUp
+	a.So(uplink.FCnt, ShouldEqual, 3)
+
+	// Messages of devices that do not exist are not replayed
+	res, err = h.replayArchive(start, start.Add(2*time.Hour), nil)
+	a.So(err, ShouldBeNil)
+	a.So(res.NumUplinks, ShouldEqual, 4)
+	a.So(res.NumReplayed, ShouldEqual, 3)
+}
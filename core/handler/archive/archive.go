@@ -0,0 +1,257 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+// Package archive writes all uplink messages of the Handler to compressed hourly files in cold storage, so that they
+// can be replayed for disaster recovery or for integrations that are added later.
+//
+// Each file contains the uplink messages of one hour as gzip-compressed JSON lines, and is named after the hour and
+// the time of its first message, for example 2017/10/19/13-1508418000.jsonl.gz.
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+)
+
+// Backend stores archive files
+type Backend interface {
+	// Put stores a file
+	Put(name string, data []byte) error
+	// Get returns the contents of a file
+	Get(name string) ([]byte, error)
+	// List returns the names of the files that start with the prefix
+	List(prefix string) ([]string, error)
+}
+
+// Record is an archived uplink message
+type Record struct {
+	AppID  string
+	DevID  string
+	Uplink *pb.DeviceUplink
+}
+
+// line is the JSON representation of a Record. The uplink message is encoded as protocol buffer, so that its
+// metadata is archived without loss.
+type line struct {
+	AppID  string `json:"app_id"`
+	DevID  string `json:"dev_id"`
+	Uplink []byte `json:"uplink"`
+}
+
+// MarshalJSON implements json.Marshaler
+func (r Record) MarshalJSON() ([]byte, error) {
+	uplink, err := r.Uplink.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(line{AppID: r.AppID, DevID: r.DevID, Uplink: uplink})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (r *Record) UnmarshalJSON(data []byte) error {
+	var l line
+	if err := json.Unmarshal(data, &l); err != nil {
+		return err
+	}
+	r.AppID, r.DevID, r.Uplink = l.AppID, l.DevID, new(pb.DeviceUplink)
+	return r.Uplink.Unmarshal(l.Uplink)
+}
+
+const hourLayout = "2006/01/02/15"
+
+// fileName returns the name of the file of the hour that starts with the first message at the given time
+func fileName(first time.Time) string {
+	first = first.UTC()
+	return fmt.Sprintf("%s-%d.jsonl.gz", first.Format(hourLayout), first.Unix())
+}
+
+// fileHour returns the hour of a file, or false if the name is not the name of an archive file
+func fileHour(name string) (time.Time, bool) {
+	i := strings.LastIndex(name, "-")
+	if i < 0 || !strings.HasSuffix(name, ".jsonl.gz") {
+		return time.Time{}, false
+	}
+	hour, err := time.Parse(hourLayout, name[:i])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return hour, true
+}
+
+// FlushRetries is the number of times that an archive file is retried if it could not be stored
+var FlushRetries = 3
+
+// Archiver writes uplink messages to hourly archive files
+type Archiver struct {
+	backend Backend
+	ctx     ttnlog.Interface
+
+	mu    sync.Mutex
+	hour  time.Time
+	first time.Time
+	buf   *bytes.Buffer
+	gz    *gzip.Writer
+	count int
+
+	uploads sync.WaitGroup
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewArchiver returns a new Archiver that stores archive files in the backend. The file of the current hour is
+// stored when the hour is over, and when the Archiver is closed.
+func NewArchiver(ctx ttnlog.Interface, backend Backend) *Archiver {
+	a := &Archiver{
+		backend: backend,
+		ctx:     ctx,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go a.run()
+	return a
+}
+
+func (a *Archiver) run() {
+	defer close(a.done)
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.stop:
+			return
+		case now := <-ticker.C:
+			a.mu.Lock()
+			if a.count > 0 && now.UTC().Truncate(time.Hour).After(a.hour) {
+				a.flush()
+			}
+			a.mu.Unlock()
+		}
+	}
+}
+
+// Add adds an uplink message to the archive file of the hour in which it was received
+func (a *Archiver) Add(record Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	received := time.Unix(0, record.Uplink.ServerTime).UTC()
+	hour := received.Truncate(time.Hour)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.count > 0 && !hour.Equal(a.hour) {
+		a.flush()
+	}
+	if a.count == 0 {
+		a.hour, a.first = hour, received
+		a.buf = new(bytes.Buffer)
+		a.gz = gzip.NewWriter(a.buf)
+	}
+	a.gz.Write(append(data, '\n'))
+	a.count++
+	return nil
+}
+
+// flush stores the current archive file in the background. The caller must hold the lock.
+func (a *Archiver) flush() {
+	if a.count == 0 {
+		return
+	}
+	a.gz.Close()
+	name, data, count := fileName(a.first), a.buf.Bytes(), a.count
+	a.buf, a.gz, a.count = nil, nil, 0
+	a.uploads.Add(1)
+	go func() {
+		defer a.uploads.Done()
+		a.store(name, data, count)
+	}()
+}
+
+// store stores an archive file in the backend, and retries if that fails
+func (a *Archiver) store(name string, data []byte, count int) {
+	ctx := a.ctx.WithFields(ttnlog.Fields{"File": name, "NumUplinks": count})
+	var err error
+	for attempt := 0; attempt < FlushRetries; attempt++ {
+		if err = a.backend.Put(name, data); err == nil {
+			ctx.Debug("Stored archive file")
+			return
+		}
+		time.Sleep(time.Duration(attempt+1) * time.Second)
+	}
+	ctx.WithError(err).Error("Could not store archive file")
+}
+
+// Flush stores the current archive file in the background, even if the hour is not over yet
+func (a *Archiver) Flush() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.flush()
+}
+
+// Close stores the current archive file, waits until all archive files are stored and stops the Archiver
+func (a *Archiver) Close() {
+	close(a.stop)
+	<-a.done
+	a.Flush()
+	a.uploads.Wait()
+}
+
+// Files returns the names of the archive files that may contain messages that were received at or after since and
+// before until, oldest first
+func Files(backend Backend, since, until time.Time) ([]string, error) {
+	since, until = since.UTC().Truncate(time.Hour), until.UTC()
+	var files []string
+	for day := since.Truncate(24 * time.Hour); day.Before(until); day = day.Add(24 * time.Hour) {
+		names, err := backend.List(day.Format("2006/01/02") + "/")
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			hour, ok := fileHour(path.Clean(name))
+			if !ok || hour.Before(since) || !hour.Before(until) {
+				continue
+			}
+			files = append(files, name)
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// Read reads the records of an archive file and calls fn for each record
+func Read(backend Backend, name string, fn func(Record) error) error {
+	data, err := backend.Get(name)
+	if err != nil {
+		return err
+	}
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+	scanner := bufio.NewScanner(gz)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return err
+		}
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,85 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package archive
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	pb_protocol "github.com/TheThingsNetwork/ttn/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestFileName(t *testing.T) {
+	a := New(t)
+	first := time.Date(2017, 10, 19, 13, 12, 0, 0, time.UTC)
+	name := fileName(first)
+	a.So(name, ShouldEqual, "2017/10/19/13-1508418720.jsonl.gz")
+	hour, ok := fileHour(name)
+	a.So(ok, ShouldBeTrue)
+	a.So(hour, ShouldResemble, first.Truncate(time.Hour))
+	_, ok = fileHour("2017/10/19/13-1508418720.jsonl.gz.tmp")
+	a.So(ok, ShouldBeFalse)
+}
+
+func TestArchiver(t *testing.T) {
+	a := New(t)
+
+	dir, err := ioutil.TempDir("", "ttn-archive")
+	a.So(err, ShouldBeNil)
+	defer os.RemoveAll(dir)
+	backend := NewDirectoryBackend(dir)
+
+	hour := time.Date(2017, 10, 19, 13, 0, 0, 0, time.UTC)
+	uplink := func(t time.Time, counter uint32) Record {
+		return Record{AppID: "app", DevID: "dev", Uplink: &pb.DeviceUplink{
+			ServerTime: t.UnixNano(),
+			Port:       1,
+			Counter:    counter,
+			PayloadRaw: []byte{1, 2, 3},
+			ProtocolMetadata: &pb_protocol.RxMetadata{Protocol: &pb_protocol.RxMetadata_Lorawan{
+				Lorawan: &pb_lorawan.Metadata{DataRate: "SF7BW125"},
+			}},
+		}}
+	}
+
+	archiver := NewArchiver(GetLogger(t, "TestArchiver"), backend)
+	a.So(archiver.Add(uplink(hour.Add(10*time.Minute), 1)), ShouldBeNil)
+	a.So(archiver.Add(uplink(hour.Add(20*time.Minute), 2)), ShouldBeNil)
+	a.So(archiver.Add(uplink(hour.Add(70*time.Minute), 3)), ShouldBeNil) // Next hour
+	a.So(archiver.Add(uplink(hour.Add(26*time.Hour), 4)), ShouldBeNil)   // Next day
+	archiver.Close()
+
+	files, err := Files(backend, hour, hour.Add(2*time.Hour))
+	a.So(err, ShouldBeNil)
+	a.So(files, ShouldHaveLength, 2)
+
+	files, err = Files(backend, hour.Add(30*time.Minute), hour.Add(48*time.Hour))
+	a.So(err, ShouldBeNil)
+	a.So(files, ShouldHaveLength, 3)
+
+	files, err = Files(backend, hour.Add(-time.Hour), hour)
+	a.So(err, ShouldBeNil)
+	a.So(files, ShouldBeEmpty)
+
+	var records []Record
+	err = Read(backend, fileName(hour.Add(10*time.Minute)), func(record Record) error {
+		records = append(records, record)
+		return nil
+	})
+	a.So(err, ShouldBeNil)
+	a.So(records, ShouldHaveLength, 2)
+	a.So(records[0].AppID, ShouldEqual, "app")
+	a.So(records[1].Uplink.Counter, ShouldEqual, 2)
+	a.So(records[1].Uplink.PayloadRaw, ShouldResemble, []byte{1, 2, 3})
+	a.So(records[1].Uplink.ProtocolMetadata.GetLorawan().DataRate, ShouldEqual, "SF7BW125")
+
+	_, err = backend.Get("2017/10/19/12-1508414400.jsonl.gz")
+	a.So(err, ShouldNotBeNil)
+}
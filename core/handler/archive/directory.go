@@ -0,0 +1,71 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package archive
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// DirectoryBackend stores archive files in a directory on the filesystem
+type DirectoryBackend struct {
+	Dir string
+}
+
+// NewDirectoryBackend returns a new DirectoryBackend for the directory
+func NewDirectoryBackend(dir string) *DirectoryBackend {
+	return &DirectoryBackend{Dir: dir}
+}
+
+// Put implements the Backend interface
+func (d *DirectoryBackend) Put(name string, data []byte) error {
+	path := filepath.Join(d.Dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	// Write to a temporary file first, so that readers never see a partial archive file
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Get implements the Backend interface
+func (d *DirectoryBackend) Get(name string) ([]byte, error) {
+	data, err := ioutil.ReadFile(filepath.Join(d.Dir, filepath.FromSlash(name)))
+	if os.IsNotExist(err) {
+		return nil, errors.NewErrNotFound("Archive file " + name)
+	}
+	return data, err
+}
+
+// List implements the Backend interface
+func (d *DirectoryBackend) List(prefix string) ([]string, error) {
+	var names []string
+	err := filepath.Walk(d.Dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || strings.HasSuffix(path, ".tmp") {
+			return nil
+		}
+		rel, err := filepath.Rel(d.Dir, path)
+		if err != nil {
+			return err
+		}
+		if name := filepath.ToSlash(rel); strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return names, err
+}
@@ -0,0 +1,204 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package archive
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// S3Backend stores archive files in an AWS S3 bucket, optionally under a prefix
+type S3Backend struct {
+	Bucket          string
+	Prefix          string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	Endpoint        string
+	Client          *http.Client
+}
+
+// NewS3Backend returns a new S3Backend for the bucket in the given region, with the credentials from the
+// AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment variables
+func NewS3Backend(bucket, prefix, region string) *S3Backend {
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return &S3Backend{
+		Bucket:          bucket,
+		Prefix:          prefix,
+		Region:          region,
+		AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		Endpoint:        fmt.Sprintf("https://s3.%s.amazonaws.com", region),
+		Client:          &http.Client{Timeout: time.Minute},
+	}
+}
+
+// NewBackend returns the Backend for the location: an S3 bucket for s3://bucket/prefix, or a directory otherwise
+func NewBackend(location, region string) Backend {
+	if strings.HasPrefix(location, "s3://") {
+		parts := strings.SplitN(strings.TrimPrefix(location, "s3://"), "/", 2)
+		var prefix string
+		if len(parts) == 2 {
+			prefix = parts[1]
+		}
+		return NewS3Backend(parts[0], prefix, region)
+	}
+	return NewDirectoryBackend(location)
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// escapePath escapes the object key for the canonical request, which requires every segment to be URI-encoded
+func escapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = strings.Replace(url.QueryEscape(segment), "+", "%20", -1)
+	}
+	return strings.Join(segments, "/")
+}
+
+// sign signs the request with AWS Signature Version 4
+func (s *S3Backend) sign(req *http.Request, body []byte, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	payloadHash := sha256Hex(body)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if s.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.SessionToken)
+	}
+
+	headers := []string{"host", "x-amz-content-sha256", "x-amz-date"}
+	if s.SessionToken != "" {
+		headers = append(headers, "x-amz-security-token")
+	}
+	sort.Strings(headers)
+	var canonicalHeaders string
+	for _, header := range headers {
+		value := req.Header.Get(header)
+		if header == "host" {
+			value = req.URL.Host
+		}
+		canonicalHeaders += header + ":" + strings.TrimSpace(value) + "\n"
+	}
+	signedHeaders := strings.Join(headers, ";")
+
+	// The query must be sorted by key, which url.Values.Encode does
+	query := strings.Replace(req.URL.Query().Encode(), "+", "%20", -1)
+	canonicalRequest := strings.Join([]string{req.Method, escapePath(req.URL.Path), query, canonicalHeaders, signedHeaders, payloadHash}, "\n")
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", date, s.Region)
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.SecretAccessKey), date)
+	key = hmacSHA256(key, s.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.AccessKeyID, scope, signedHeaders, signature,
+	))
+}
+
+func (s *S3Backend) do(method, key string, query url.Values, body []byte) ([]byte, error) {
+	u := fmt.Sprintf("%s/%s/%s", s.Endpoint, s.Bucket, key)
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequest(method, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	s.sign(req, body, time.Now())
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "Could not connect to S3")
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return respBody, nil
+	case http.StatusNotFound:
+		return nil, errors.NewErrNotFound("Archive file " + key)
+	case http.StatusForbidden:
+		return nil, errors.NewErrPermissionDenied("Not allowed to access S3 bucket " + s.Bucket)
+	default:
+		return nil, fmt.Errorf("S3 request failed: status %d", resp.StatusCode)
+	}
+}
+
+// Put implements the Backend interface
+func (s *S3Backend) Put(name string, data []byte) error {
+	_, err := s.do("PUT", s.Prefix+name, nil, data)
+	return err
+}
+
+// Get implements the Backend interface
+func (s *S3Backend) Get(name string) ([]byte, error) {
+	return s.do("GET", s.Prefix+name, nil, nil)
+}
+
+// List implements the Backend interface
+func (s *S3Backend) List(prefix string) ([]string, error) {
+	var names []string
+	query := url.Values{
+		"list-type": []string{"2"},
+		"prefix":    []string{s.Prefix + prefix},
+	}
+	for {
+		data, err := s.do("GET", "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+		var res struct {
+			Contents []struct {
+				Key string `xml:"Key"`
+			} `xml:"Contents"`
+			IsTruncated           bool   `xml:"IsTruncated"`
+			NextContinuationToken string `xml:"NextContinuationToken"`
+		}
+		if err := xml.Unmarshal(data, &res); err != nil {
+			return nil, errors.Wrap(err, "Could not decode S3 response")
+		}
+		for _, object := range res.Contents {
+			names = append(names, strings.TrimPrefix(object.Key, s.Prefix))
+		}
+		if !res.IsTruncated {
+			return names, nil
+		}
+		query.Set("continuation-token", res.NextContinuationToken)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package archive
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestS3Backend(t *testing.T) {
+	a := New(t)
+
+	objects := make(map[string][]byte)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=key-id/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		key := strings.TrimPrefix(r.URL.Path, "/bucket/")
+		switch {
+		case r.Method == "PUT":
+			objects[key], _ = ioutil.ReadAll(r.Body)
+		case r.Method == "GET" && key == "":
+			var keys []string
+			for key := range objects {
+				if strings.HasPrefix(key, r.URL.Query().Get("prefix")) {
+					keys = append(keys, key)
+				}
+			}
+			sort.Strings(keys)
+			fmt.Fprint(w, "<ListBucketResult>")
+			for _, key := range keys {
+				fmt.Fprintf(w, "<Contents><Key>%s</Key></Contents>", key)
+			}
+			fmt.Fprint(w, "<IsTruncated>false</IsTruncated></ListBucketResult>")
+		case r.Method == "GET":
+			data, ok := objects[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write(data)
+		}
+	}))
+	defer server.Close()
+
+	backend := NewBackend("s3://bucket/archive", "eu-west-1").(*S3Backend)
+	backend.Endpoint = server.URL
+	backend.AccessKeyID, backend.SecretAccessKey = "key-id", "secret"
+
+	a.So(backend.Put("2017/10/19/13-1508418720.jsonl.gz", []byte("data")), ShouldBeNil)
+	a.So(objects, ShouldContainKey, "archive/2017/10/19/13-1508418720.jsonl.gz")
+
+	data, err := backend.Get("2017/10/19/13-1508418720.jsonl.gz")
+	a.So(err, ShouldBeNil)
+	a.So(string(data), ShouldEqual, "data")
+
+	_, err = backend.Get("2017/10/19/14-1508422320.jsonl.gz")
+	a.So(err, ShouldNotBeNil)
+
+	names, err := backend.List("2017/10/19/")
+	a.So(err, ShouldBeNil)
+	a.So(names, ShouldResemble, []string{"2017/10/19/13-1508418720.jsonl.gz"})
+
+	names, err = backend.List("2017/10/20/")
+	a.So(err, ShouldBeNil)
+	a.So(names, ShouldBeEmpty)
+}
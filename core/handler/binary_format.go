@@ -0,0 +1,133 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// decodeBinary decodes the BinaryFields at their fixed positions in the payload. Numbers are multiplied by the scale
+// of the field, if it is set.
+func (f *UplinkFunctions) decodeBinary(payload []byte) (map[string]interface{}, error) {
+	if len(f.BinaryFields) == 0 {
+		return nil, nil
+	}
+	fields := make(map[string]interface{}, len(f.BinaryFields))
+	for _, field := range f.BinaryFields {
+		size, ok := pb.BinaryFieldSizes[field.Type]
+		if !ok {
+			return nil, errors.NewErrInvalidArgument("Binary Payload", fmt.Sprintf("field %s has unknown type %s", field.Name, field.Type))
+		}
+		if field.Offset+size > len(payload) {
+			return nil, errors.NewErrInvalidArgument("Binary Payload", fmt.Sprintf("payload is too short for field %s", field.Name))
+		}
+		raw := readBinary(payload[field.Offset:field.Offset+size], field.LittleEndian)
+		var value float64
+		switch {
+		case field.Type == "bool":
+			fields[field.Name] = raw != 0
+			continue
+		case field.Type == "float32":
+			value = float64(math.Float32frombits(uint32(raw)))
+		case field.Type == "float64":
+			value = math.Float64frombits(raw)
+		case strings.HasPrefix(field.Type, "int"):
+			value = float64(int64(raw<<uint(64-8*size)) >> uint(64-8*size))
+		default:
+			value = float64(raw)
+		}
+		if field.Scale != 0 {
+			value *= field.Scale
+		}
+		fields[field.Name] = value
+	}
+	return fields, nil
+}
+
+// encodeBinary encodes the fields at the positions of the BinaryFields. Numbers are divided by the scale of the field,
+// if it is set, and rounded to the nearest integer for integer types. All BinaryFields must be supplied.
+func (f *DownlinkFunctions) encodeBinary(fields map[string]interface{}) ([]byte, error) {
+	if len(f.BinaryFields) == 0 {
+		return nil, errors.NewErrInvalidArgument("Downlink Payload", "fields supplied, but no binary fields set")
+	}
+	var payload []byte
+	known := make(map[string]bool, len(f.BinaryFields))
+	for _, field := range f.BinaryFields {
+		known[field.Name] = true
+		size, ok := pb.BinaryFieldSizes[field.Type]
+		if !ok {
+			return nil, errors.NewErrInvalidArgument("Binary Payload", fmt.Sprintf("field %s has unknown type %s", field.Name, field.Type))
+		}
+		v, ok := fields[field.Name]
+		if !ok {
+			return nil, errors.NewErrInvalidArgument("Binary Payload", fmt.Sprintf("field %s is missing", field.Name))
+		}
+		value, ok := toFloat(v)
+		if !ok {
+			return nil, errors.NewErrInvalidArgument("Binary Payload", fmt.Sprintf("field %s is not a number", field.Name))
+		}
+		if field.Scale != 0 {
+			value /= field.Scale
+		}
+		var raw uint64
+		switch {
+		case field.Type == "bool":
+			if value != 0 {
+				raw = 1
+			}
+		case field.Type == "float32":
+			raw = uint64(math.Float32bits(float32(value)))
+		case field.Type == "float64":
+			raw = math.Float64bits(value)
+		default:
+			rounded := math.Floor(value + 0.5)
+			min, max := 0.0, math.Exp2(float64(8*size))-1
+			if strings.HasPrefix(field.Type, "int") {
+				min, max = -math.Exp2(float64(8*size-1)), math.Exp2(float64(8*size-1))-1
+			}
+			if rounded < min || rounded > max {
+				return nil, errors.NewErrInvalidArgument("Binary Payload", fmt.Sprintf("field %s is out of range", field.Name))
+			}
+			raw = uint64(int64(rounded))
+		}
+		if end := field.Offset + size; end > len(payload) {
+			payload = append(payload, make([]byte, end-len(payload))...)
+		}
+		writeBinary(payload[field.Offset:field.Offset+size], raw, field.LittleEndian)
+	}
+	for name := range fields {
+		if !known[name] {
+			return nil, errors.NewErrInvalidArgument("Binary Payload", fmt.Sprintf("field %s is not in the binary fields", name))
+		}
+	}
+	return payload, nil
+}
+
+// readBinary reads an unsigned integer from the bytes
+func readBinary(b []byte, littleEndian bool) (v uint64) {
+	for i := range b {
+		if littleEndian {
+			v |= uint64(b[i]) << uint(8*i)
+		} else {
+			v = v<<8 | uint64(b[i])
+		}
+	}
+	return
+}
+
+// writeBinary writes the lower bytes of an unsigned integer to the bytes
+func writeBinary(b []byte, v uint64, littleEndian bool) {
+	for i := range b {
+		if littleEndian {
+			b[i] = byte(v >> uint(8*i))
+		} else {
+			b[len(b)-1-i] = byte(v >> uint(8*i))
+		}
+	}
+}
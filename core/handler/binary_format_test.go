@@ -0,0 +1,69 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestBinaryPayloadFormat(t *testing.T) {
+	a := New(t)
+
+	layout := []application.BinaryField{
+		{Name: "temperature", Offset: 0, Type: "int16", Scale: 0.01},
+		{Name: "battery", Offset: 2, Type: "uint8"},
+		{Name: "pulses", Offset: 3, Type: "uint16", LittleEndian: true},
+		{Name: "alarm", Offset: 5, Type: "bool"},
+		{Name: "offset", Offset: 6, Type: "int24"},
+		{Name: "ratio", Offset: 9, Type: "float32"},
+	}
+	payload := []byte{0x08, 0x66, 0xFA, 0x10, 0x27, 0x01, 0xFF, 0xFF, 0xFE, 0x3F, 0xC0, 0x00, 0x00}
+
+	up := &UplinkFunctions{
+		PayloadFormat: pb.PayloadFormatBinary,
+		BinaryFields:  layout,
+		Validator:     `function Validator(fields) { return fields.battery > 0; }`,
+	}
+	fields, valid, err := up.Process(payload, 1)
+	a.So(err, ShouldBeNil)
+	a.So(valid, ShouldBeTrue)
+	a.So(fields["temperature"], ShouldAlmostEqual, 21.5)
+	a.So(fields["battery"], ShouldEqual, 250)
+	a.So(fields["pulses"], ShouldEqual, 10000)
+	a.So(fields["alarm"], ShouldEqual, true)
+	a.So(fields["offset"], ShouldEqual, -2)
+	a.So(fields["ratio"], ShouldEqual, 1.5)
+
+	_, _, err = up.Process(payload[:12], 1)
+	a.So(err, ShouldNotBeNil)
+
+	down := &DownlinkFunctions{
+		PayloadFormat: pb.PayloadFormatBinary,
+		BinaryFields:  layout,
+	}
+	encoded, _, err := down.Process(fields, 1)
+	a.So(err, ShouldBeNil)
+	a.So(encoded, ShouldResemble, payload)
+
+	// All fields must be supplied and in range
+	_, _, err = down.Process(map[string]interface{}{"battery": 1}, 1)
+	a.So(err, ShouldNotBeNil)
+	fields["battery"] = 256
+	_, _, err = down.Process(fields, 1)
+	a.So(err, ShouldNotBeNil)
+	fields["battery"], fields["unknown"] = 250, 1
+	_, _, err = down.Process(fields, 1)
+	a.So(err, ShouldNotBeNil)
+
+	// Without a layout, the payload is not decoded
+	up.BinaryFields, up.Validator = nil, ""
+	fields, _, err = up.Process(payload, 1)
+	a.So(err, ShouldBeNil)
+	a.So(fields, ShouldBeNil)
+}
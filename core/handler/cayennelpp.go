@@ -0,0 +1,200 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// cayenneLPPValue is a value in a Cayenne LPP data type
+type cayenneLPPValue struct {
+	name    string // empty if the data type has a single value
+	size    int
+	signed  bool
+	divisor float64 // the stored integer is the value multiplied by the divisor
+}
+
+// cayenneLPPType is a Cayenne LPP data type
+type cayenneLPPType struct {
+	code   byte
+	name   string
+	values []cayenneLPPValue
+}
+
+func (t cayenneLPPType) size() (size int) {
+	for _, value := range t.values {
+		size += value.size
+	}
+	return
+}
+
+var cayenneLPPTypes = []cayenneLPPType{
+	{0, "digital_in", []cayenneLPPValue{{"", 1, false, 1}}},
+	{1, "digital_out", []cayenneLPPValue{{"", 1, false, 1}}},
+	{2, "analog_in", []cayenneLPPValue{{"", 2, true, 100}}},
+	{3, "analog_out", []cayenneLPPValue{{"", 2, true, 100}}},
+	{101, "luminosity", []cayenneLPPValue{{"", 2, false, 1}}},
+	{102, "presence", []cayenneLPPValue{{"", 1, false, 1}}},
+	{103, "temperature", []cayenneLPPValue{{"", 2, true, 10}}},
+	{104, "relative_humidity", []cayenneLPPValue{{"", 1, false, 2}}},
+	{113, "accelerometer", []cayenneLPPValue{{"x", 2, true, 1000}, {"y", 2, true, 1000}, {"z", 2, true, 1000}}},
+	{115, "barometric_pressure", []cayenneLPPValue{{"", 2, false, 10}}},
+	{134, "gyrometer", []cayenneLPPValue{{"x", 2, true, 100}, {"y", 2, true, 100}, {"z", 2, true, 100}}},
+	{136, "gps", []cayenneLPPValue{{"latitude", 3, true, 10000}, {"longitude", 3, true, 10000}, {"altitude", 3, true, 100}}},
+}
+
+// decodeCayenneLPP decodes a Cayenne LPP payload to fields. The fields are named after the data type and the channel,
+// for example temperature_1. Data types with multiple values, such as gps_2, are decoded to objects.
+func decodeCayenneLPP(payload []byte) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+	for len(payload) > 0 {
+		if len(payload) < 2 {
+			return nil, errors.NewErrInvalidArgument("Cayenne LPP", "payload ends in the middle of a channel")
+		}
+		channel, code := payload[0], payload[1]
+		var typ *cayenneLPPType
+		for i := range cayenneLPPTypes {
+			if cayenneLPPTypes[i].code == code {
+				typ = &cayenneLPPTypes[i]
+				break
+			}
+		}
+		if typ == nil {
+			return nil, errors.NewErrInvalidArgument("Cayenne LPP", fmt.Sprintf("unknown data type %d on channel %d", code, channel))
+		}
+		payload = payload[2:]
+		if len(payload) < typ.size() {
+			return nil, errors.NewErrInvalidArgument("Cayenne LPP", fmt.Sprintf("payload ends in the middle of channel %d", channel))
+		}
+		values := make(map[string]interface{})
+		for _, value := range typ.values {
+			var raw int64
+			for _, b := range payload[:value.size] {
+				raw = raw<<8 | int64(b)
+			}
+			if value.signed && raw&(1<<uint(8*value.size-1)) != 0 {
+				raw -= 1 << uint(8*value.size)
+			}
+			payload = payload[value.size:]
+			values[value.name] = float64(raw) / value.divisor
+		}
+		name := fmt.Sprintf("%s_%d", typ.name, channel)
+		if len(typ.values) == 1 {
+			fields[name] = values[""]
+		} else {
+			fields[name] = values
+		}
+	}
+	return fields, nil
+}
+
+// encodeCayenneLPP encodes fields that are named after the data type and the channel (see decodeCayenneLPP) to a
+// Cayenne LPP payload. The channels are encoded in order.
+func encodeCayenneLPP(fields map[string]interface{}) ([]byte, error) {
+	channels := make(cayenneLPPChannels, 0, len(fields))
+	for name, value := range fields {
+		sep := strings.LastIndex(name, "_")
+		if sep < 0 {
+			return nil, errors.NewErrInvalidArgument("Cayenne LPP", fmt.Sprintf("field %s is not named <type>_<channel>", name))
+		}
+		number, err := strconv.ParseUint(name[sep+1:], 10, 8)
+		if err != nil {
+			return nil, errors.NewErrInvalidArgument("Cayenne LPP", fmt.Sprintf("field %s does not have a valid channel", name))
+		}
+		found := false
+		for _, typ := range cayenneLPPTypes {
+			if typ.name == name[:sep] {
+				channels = append(channels, cayenneLPPChannel{byte(number), typ, value})
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.NewErrInvalidArgument("Cayenne LPP", fmt.Sprintf("field %s has an unknown data type", name))
+		}
+	}
+	sort.Sort(channels)
+
+	var payload []byte
+	for _, channel := range channels {
+		name := fmt.Sprintf("%s_%d", channel.typ.name, channel.number)
+		payload = append(payload, channel.number, channel.typ.code)
+		for _, value := range channel.typ.values {
+			v := channel.value
+			if value.name != "" {
+				object, ok := channel.value.(map[string]interface{})
+				if !ok {
+					return nil, errors.NewErrInvalidArgument("Cayenne LPP", fmt.Sprintf("field %s is not an object", name))
+				}
+				v = object[value.name]
+				name = fmt.Sprintf("%s_%d.%s", channel.typ.name, channel.number, value.name)
+			}
+			f, ok := toFloat(v)
+			if !ok {
+				return nil, errors.NewErrInvalidArgument("Cayenne LPP", fmt.Sprintf("field %s is not a number", name))
+			}
+			raw := int64(math.Floor(f*value.divisor + 0.5))
+			min, max := int64(0), int64(1)<<uint(8*value.size)-1
+			if value.signed {
+				min, max = -(int64(1) << uint(8*value.size-1)), int64(1)<<uint(8*value.size-1)-1
+			}
+			if raw < min || raw > max {
+				return nil, errors.NewErrInvalidArgument("Cayenne LPP", fmt.Sprintf("field %s is out of range", name))
+			}
+			for i := value.size - 1; i >= 0; i-- {
+				payload = append(payload, byte(raw>>uint(8*i)))
+			}
+		}
+	}
+	return payload, nil
+}
+
+// cayenneLPPChannel is a channel with a value that is encoded to Cayenne LPP
+type cayenneLPPChannel struct {
+	number byte
+	typ    cayenneLPPType
+	value  interface{}
+}
+
+// cayenneLPPChannels sorts channels by number and data type
+type cayenneLPPChannels []cayenneLPPChannel
+
+func (c cayenneLPPChannels) Len() int      { return len(c) }
+func (c cayenneLPPChannels) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+func (c cayenneLPPChannels) Less(i, j int) bool {
+	if c[i].number != c[j].number {
+		return c[i].number < c[j].number
+	}
+	return c[i].typ.code < c[j].typ.code
+}
+
+// toFloat converts a number or boolean from decoded JSON or JavaScript to a float
+func toFloat(v interface{}) (float64, bool) {
+	if b, ok := v.(bool); ok {
+		if b {
+			return 1, true
+		}
+		return 0, true
+	}
+	if v == nil {
+		return 0, false
+	}
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(value.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), true
+	}
+	return 0, false
+}
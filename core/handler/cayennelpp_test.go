@@ -0,0 +1,93 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestDecodeCayenneLPP(t *testing.T) {
+	a := New(t)
+
+	fields, err := decodeCayenneLPP([]byte{
+		0x03, 0x67, 0x01, 0x10, // temperature_3: 27.2
+		0x05, 0x67, 0x00, 0xFF, // temperature_5: 25.5
+		0x06, 0x71, 0x04, 0xD2, 0xFB, 0x2E, 0x00, 0x00, // accelerometer_6: 1.234, -1.234, 0
+		0x01, 0x88, 0x06, 0x76, 0x5F, 0xF2, 0x96, 0x0A, 0x00, 0x03, 0xE8, // gps_1: 42.3519, -87.9094, 10
+		0x02, 0x02, 0xFF, 0x9C, // analog_in_2: -1
+		0x07, 0x68, 0x61, // relative_humidity_7: 48.5
+	})
+	a.So(err, ShouldBeNil)
+	a.So(fields["temperature_3"], ShouldEqual, 27.2)
+	a.So(fields["temperature_5"], ShouldEqual, 25.5)
+	a.So(fields["accelerometer_6"], ShouldResemble, map[string]interface{}{"x": 1.234, "y": -1.234, "z": 0.0})
+	a.So(fields["gps_1"], ShouldResemble, map[string]interface{}{"latitude": 42.3519, "longitude": -87.9094, "altitude": 10.0})
+	a.So(fields["analog_in_2"], ShouldEqual, -1.0)
+	a.So(fields["relative_humidity_7"], ShouldEqual, 48.5)
+
+	_, err = decodeCayenneLPP([]byte{0x03, 0x67, 0x01})
+	a.So(err, ShouldNotBeNil)
+
+	_, err = decodeCayenneLPP([]byte{0x03, 0x42, 0x01})
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestEncodeCayenneLPP(t *testing.T) {
+	a := New(t)
+
+	payload, err := encodeCayenneLPP(map[string]interface{}{
+		"digital_out_4": true,
+		"analog_out_2":  -1.5,
+		"gps_1": map[string]interface{}{
+			"latitude":  42.3519,
+			"longitude": -87.9094,
+			"altitude":  10,
+		},
+	})
+	a.So(err, ShouldBeNil)
+	a.So(payload, ShouldResemble, []byte{
+		0x01, 0x88, 0x06, 0x76, 0x5F, 0xF2, 0x96, 0x0A, 0x00, 0x03, 0xE8,
+		0x02, 0x03, 0xFF, 0x6A,
+		0x04, 0x01, 0x01,
+	})
+
+	fields, err := decodeCayenneLPP(payload)
+	a.So(err, ShouldBeNil)
+	a.So(fields["analog_out_2"], ShouldEqual, -1.5)
+
+	_, err = encodeCayenneLPP(map[string]interface{}{"temperature": 20})
+	a.So(err, ShouldNotBeNil)
+	_, err = encodeCayenneLPP(map[string]interface{}{"voltage_1": 20})
+	a.So(err, ShouldNotBeNil)
+	_, err = encodeCayenneLPP(map[string]interface{}{"digital_out_1": 256})
+	a.So(err, ShouldNotBeNil)
+	_, err = encodeCayenneLPP(map[string]interface{}{"gps_1": 42})
+	a.So(err, ShouldNotBeNil)
+	_, err = encodeCayenneLPP(map[string]interface{}{"temperature_1": "hot"})
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestCayenneLPPPayloadFormat(t *testing.T) {
+	a := New(t)
+
+	up := &UplinkFunctions{
+		PayloadFormat: pb.PayloadFormatCayenneLPP,
+		Validator:     `function Validator(fields) { return fields.temperature_1 > 0; }`,
+	}
+	fields, valid, err := up.Process([]byte{0x01, 0x67, 0x00, 0xD7}, 1)
+	a.So(err, ShouldBeNil)
+	a.So(valid, ShouldBeTrue)
+	a.So(fields["temperature_1"], ShouldEqual, 21.5)
+
+	down := &DownlinkFunctions{
+		PayloadFormat: pb.PayloadFormatCayenneLPP,
+	}
+	payload, _, err := down.Process(map[string]interface{}{"digital_out_1": 1}, 1)
+	a.So(err, ShouldBeNil)
+	a.So(payload, ShouldResemble, []byte{0x01, 0x01, 0x01})
+}
@@ -0,0 +1,315 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// maxCBORDepth is the maximum nesting of arrays and maps in a CBOR payload
+const maxCBORDepth = 16
+
+// senMLLabels are the names of the integer labels of SenML records in CBOR (RFC 8428)
+var senMLLabels = map[int64]string{
+	-1: "bver", -2: "bn", -3: "bt", -4: "bu", -5: "bv", -6: "bs",
+	0: "n", 1: "u", 2: "v", 3: "vs", 4: "vb", 5: "s", 6: "t", 7: "ut", 8: "vd",
+}
+
+var errCBORTruncated = errors.NewErrInvalidArgument("CBOR", "payload ends in the middle of a value")
+
+// decodeCBOR decodes a CBOR payload to fields. The payload contains a map, which is decoded to fields, or an array of
+// maps, such as a SenML pack, which is decoded to buffered readings. Integer keys are decoded as decimal strings,
+// except for the labels of SenML records, which are decoded to their names (for example 2 to v). Tags are ignored.
+func decodeCBOR(payload []byte) (map[string]interface{}, error) {
+	if len(payload) == 0 {
+		return nil, nil
+	}
+	d := &cborDecoder{data: payload, senML: payload[0]>>5 == 4}
+	v, err := d.value(0)
+	if err != nil {
+		return nil, err
+	}
+	if len(d.data) > 0 {
+		return nil, errors.NewErrInvalidArgument("CBOR", "payload contains more than one value")
+	}
+	if m, ok := v.(map[string]interface{}); ok {
+		return m, nil
+	}
+	if _, ok := readingsOf(v); ok {
+		return map[string]interface{}{ReadingsField: v}, nil
+	}
+	return nil, errors.NewErrInvalidArgument("CBOR", "payload does not contain a map or an array of maps")
+}
+
+type cborDecoder struct {
+	data  []byte
+	senML bool
+}
+
+// head reads the initial byte and argument of a data item. For indefinite lengths, info is 31 and arg is 0.
+func (d *cborDecoder) head() (major, info byte, arg uint64, err error) {
+	if len(d.data) == 0 {
+		return 0, 0, 0, errCBORTruncated
+	}
+	major, info = d.data[0]>>5, d.data[0]&0x1f
+	d.data = d.data[1:]
+	var size int
+	switch {
+	case info < 24:
+		return major, info, uint64(info), nil
+	case info <= 27:
+		size = 1 << (info - 24)
+	case info == 31:
+		return major, info, 0, nil
+	default:
+		return 0, 0, 0, errors.NewErrInvalidArgument("CBOR", fmt.Sprintf("invalid additional information %d", info))
+	}
+	if len(d.data) < size {
+		return 0, 0, 0, errCBORTruncated
+	}
+	arg = readBinary(d.data[:size], false)
+	d.data = d.data[size:]
+	return major, info, arg, nil
+}
+
+// atBreak returns whether the next byte is the break of an indefinite length item, and consumes it
+func (d *cborDecoder) atBreak() bool {
+	if len(d.data) > 0 && d.data[0] == 0xff {
+		d.data = d.data[1:]
+		return true
+	}
+	return false
+}
+
+// bytes reads a byte or text string
+func (d *cborDecoder) bytes(major byte, info byte, arg uint64) ([]byte, error) {
+	if info != 31 {
+		if arg > uint64(len(d.data)) {
+			return nil, errCBORTruncated
+		}
+		b := append([]byte{}, d.data[:arg]...)
+		d.data = d.data[arg:]
+		return b, nil
+	}
+	b := []byte{}
+	for !d.atBreak() {
+		chunkMajor, chunkInfo, chunkArg, err := d.head()
+		if err != nil {
+			return nil, err
+		}
+		if chunkMajor != major || chunkInfo == 31 {
+			return nil, errors.NewErrInvalidArgument("CBOR", "invalid chunk in indefinite length string")
+		}
+		chunk, err := d.bytes(chunkMajor, chunkInfo, chunkArg)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, chunk...)
+	}
+	return b, nil
+}
+
+func (d *cborDecoder) value(depth int) (interface{}, error) {
+	if depth > maxCBORDepth {
+		return nil, errors.NewErrInvalidArgument("CBOR", "payload is nested too deeply")
+	}
+	major, info, arg, err := d.head()
+	if err != nil {
+		return nil, err
+	}
+	indefinite := info == 31
+	if indefinite && (major < 2 || major == 6) {
+		return nil, errors.NewErrInvalidArgument("CBOR", fmt.Sprintf("indefinite length for major type %d", major))
+	}
+	switch major {
+	case 0:
+		if arg > math.MaxInt64 {
+			return float64(arg), nil
+		}
+		return int64(arg), nil
+	case 1:
+		if arg > math.MaxInt64 {
+			return -1 - float64(arg), nil
+		}
+		return -1 - int64(arg), nil
+	case 2:
+		return d.bytes(major, info, arg)
+	case 3:
+		b, err := d.bytes(major, info, arg)
+		return string(b), err
+	case 4:
+		array := []interface{}{}
+		for i := uint64(0); indefinite || i < arg; i++ {
+			if indefinite && d.atBreak() {
+				break
+			}
+			v, err := d.value(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, v)
+		}
+		return array, nil
+	case 5:
+		object := map[string]interface{}{}
+		for i := uint64(0); indefinite || i < arg; i++ {
+			if indefinite && d.atBreak() {
+				break
+			}
+			key, err := d.value(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			var name string
+			switch key := key.(type) {
+			case string:
+				name = key
+			case int64:
+				name = strconv.FormatInt(key, 10)
+				if label, ok := senMLLabels[key]; ok && d.senML && depth == 1 {
+					name = label
+				}
+			default:
+				return nil, errors.NewErrInvalidArgument("CBOR", "map keys must be strings or integers")
+			}
+			if object[name], err = d.value(depth + 1); err != nil {
+				return nil, err
+			}
+		}
+		return object, nil
+	case 6:
+		return d.value(depth + 1)
+	}
+	var f float64
+	switch info {
+	case 20:
+		return false, nil
+	case 21:
+		return true, nil
+	case 22, 23:
+		return nil, nil
+	case 25:
+		f = float16(uint16(arg))
+	case 26:
+		f = float64(math.Float32frombits(uint32(arg)))
+	case 27:
+		f = math.Float64frombits(arg)
+	default:
+		return nil, errors.NewErrInvalidArgument("CBOR", fmt.Sprintf("unsupported simple value %d", arg))
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, errors.NewErrInvalidArgument("CBOR", "NaN and infinity are not supported")
+	}
+	return f, nil
+}
+
+// float16 converts a half-precision floating point number to a float64
+func float16(h uint16) float64 {
+	exp, mant := int(h>>10&0x1f), float64(h&0x3ff)
+	var v float64
+	switch exp {
+	case 0:
+		v = math.Ldexp(mant, -24)
+	case 0x1f:
+		if mant == 0 {
+			v = math.Inf(1)
+		} else {
+			v = math.NaN()
+		}
+	default:
+		v = math.Ldexp(mant+1024, exp-25)
+	}
+	if h&0x8000 != 0 {
+		return -v
+	}
+	return v
+}
+
+// encodeCBOR encodes the fields to a CBOR map with sorted keys. Numbers without a fractional part are encoded as
+// integers, other numbers as single-precision floats if that does not lose precision.
+func encodeCBOR(fields map[string]interface{}) ([]byte, error) {
+	return appendCBOR(nil, fields)
+}
+
+func appendCBORHead(b []byte, major byte, arg uint64) []byte {
+	var size int
+	var info byte
+	switch {
+	case arg < 24:
+		return append(b, major<<5|byte(arg))
+	case arg <= math.MaxUint8:
+		size, info = 1, 24
+	case arg <= math.MaxUint16:
+		size, info = 2, 25
+	case arg <= math.MaxUint32:
+		size, info = 4, 26
+	default:
+		size, info = 8, 27
+	}
+	b = append(b, major<<5|info)
+	b = append(b, make([]byte, size)...)
+	writeBinary(b[len(b)-size:], arg, false)
+	return b
+}
+
+func appendCBOR(b []byte, v interface{}) ([]byte, error) {
+	var err error
+	switch v := v.(type) {
+	case nil:
+		return append(b, 0xf6), nil
+	case bool:
+		if v {
+			return append(b, 0xf5), nil
+		}
+		return append(b, 0xf4), nil
+	case string:
+		return append(appendCBORHead(b, 3, uint64(len(v))), v...), nil
+	case []byte:
+		return append(appendCBORHead(b, 2, uint64(len(v))), v...), nil
+	case []interface{}:
+		b = appendCBORHead(b, 4, uint64(len(v)))
+		for _, e := range v {
+			if b, err = appendCBOR(b, e); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		b = appendCBORHead(b, 5, uint64(len(v)))
+		for _, key := range keys {
+			b = append(appendCBORHead(b, 3, uint64(len(key))), key...)
+			if b, err = appendCBOR(b, v[key]); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	}
+	if n, ok := toInteger(v); ok {
+		if n < 0 {
+			return appendCBORHead(b, 1, uint64(-1-n)), nil
+		}
+		return appendCBORHead(b, 0, uint64(n)), nil
+	}
+	if f, ok := toFloat(v); ok {
+		if float64(float32(f)) == f {
+			b = append(b, 0xfa, 0, 0, 0, 0)
+			writeBinary(b[len(b)-4:], uint64(math.Float32bits(float32(f))), false)
+			return b, nil
+		}
+		b = append(b, 0xfb, 0, 0, 0, 0, 0, 0, 0, 0)
+		writeBinary(b[len(b)-8:], math.Float64bits(f), false)
+		return b, nil
+	}
+	return nil, errors.NewErrInvalidArgument("CBOR", fmt.Sprintf("can not encode value of type %T", v))
+}
@@ -0,0 +1,71 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestCBORPayloadFormat(t *testing.T) {
+	a := New(t)
+
+	// {"temperature": 21.5 (half-precision), "battery": 250}
+	payload := append([]byte{0xa2, 0x6b}, "temperature"...)
+	payload = append(payload, 0xf9, 0x4d, 0x60, 0x67)
+	payload = append(payload, "battery"...)
+	payload = append(payload, 0x18, 0xfa)
+
+	up := &UplinkFunctions{PayloadFormat: pb.PayloadFormatCBOR}
+	fields, valid, err := up.Process(payload, 1)
+	a.So(err, ShouldBeNil)
+	a.So(valid, ShouldBeTrue)
+	a.So(fields, ShouldResemble, map[string]interface{}{"temperature": 21.5, "battery": int64(250)})
+
+	// A SenML pack [{0: "temperature", 1: "Cel", 2: 21}, {0: "humidity", 2: 50}] is decoded to readings
+	senML := append([]byte{0x82, 0xa3, 0x00, 0x6b}, "temperature"...)
+	senML = append(senML, 0x01, 0x63, 'C', 'e', 'l', 0x02, 0x15, 0xa2, 0x00, 0x68)
+	senML = append(senML, "humidity"...)
+	senML = append(senML, 0x02, 0x18, 0x32)
+	fields, _, err = up.Process(senML, 1)
+	a.So(err, ShouldBeNil)
+	a.So(fields[ReadingsField], ShouldResemble, []interface{}{
+		map[string]interface{}{"n": "temperature", "u": "Cel", "v": int64(21)},
+		map[string]interface{}{"n": "humidity", "v": int64(50)},
+	})
+
+	// Indefinite length map {_ "a": [_ 1, 2]}
+	fields, _, err = up.Process([]byte{0xbf, 0x61, 'a', 0x9f, 0x01, 0x02, 0xff, 0xff}, 1)
+	a.So(err, ShouldBeNil)
+	a.So(fields["a"], ShouldResemble, []interface{}{int64(1), int64(2)})
+
+	for _, invalid := range [][]byte{
+		payload[:len(payload)-1],            // truncated
+		append(payload, 0x00),               // trailing value
+		{0x18, 0x2a},                        // not a map
+		{0xa1, 0xf5, 0x01},                  // boolean key
+		{0xa1, 0x61, 'a', 0xf9, 0x7c, 0x00}, // infinity
+	} {
+		_, _, err = up.Process(invalid, 1)
+		a.So(err, ShouldNotBeNil)
+	}
+
+	down := &DownlinkFunctions{PayloadFormat: pb.PayloadFormatCBOR}
+	encoded, _, err := down.Process(map[string]interface{}{"led": true, "interval": 600.0, "ratio": 1.5, "offset": -2}, 1)
+	a.So(err, ShouldBeNil)
+	expected := append([]byte{0xa4, 0x68}, "interval"...)
+	expected = append(expected, 0x19, 0x02, 0x58, 0x63, 'l', 'e', 'd', 0xf5, 0x66)
+	expected = append(expected, "offset"...)
+	expected = append(expected, 0x21, 0x65)
+	expected = append(expected, "ratio"...)
+	expected = append(expected, 0xfa, 0x3f, 0xc0, 0x00, 0x00)
+	a.So(encoded, ShouldResemble, expected)
+
+	fields, _, err = up.Process(encoded, 1)
+	a.So(err, ShouldBeNil)
+	a.So(fields, ShouldResemble, map[string]interface{}{"led": true, "interval": int64(600), "ratio": 1.5, "offset": int64(-2)})
+}
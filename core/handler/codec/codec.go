@@ -0,0 +1,35 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+// Package codec contains the codec library of the Handler: named sets of payload functions that multiple
+// applications can use instead of their own payload functions
+package codec
+
+import "time"
+
+const currentDBVersion = "2.4.1"
+
+// Codec is a named set of payload functions in the codec library
+type Codec struct {
+	ID          string `redis:"id"`
+	Description string `redis:"description"`
+	// Decoder, Converter, Validator and Encoder are the payload functions that are used by the applications that
+	// refer to the codec
+	Decoder   string `redis:"decoder"`
+	Converter string `redis:"converter"`
+	Validator string `redis:"validator"`
+	Encoder   string `redis:"encoder"`
+	// Version is a label of the codec, for example the version of the firmware of the devices that it decodes
+	Version string `redis:"version"`
+
+	// Revision is incremented on every update of the codec
+	Revision uint64 `redis:"revision"`
+
+	CreatedAt time.Time `redis:"created_at"`
+	UpdatedAt time.Time `redis:"updated_at"`
+}
+
+// DBVersion of the model
+func (c *Codec) DBVersion() string {
+	return currentDBVersion
+}
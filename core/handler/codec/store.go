@@ -0,0 +1,86 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package codec
+
+import (
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/storage"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"gopkg.in/redis.v5"
+)
+
+// Store interface for Codecs
+type Store interface {
+	List(opts *storage.ListOptions) ([]*Codec, error)
+	Get(id string) (*Codec, error)
+	SetIfRevision(new *Codec, revision uint64) error
+	Delete(id string) error
+}
+
+const defaultRedisPrefix = "handler"
+const redisCodecPrefix = "codec"
+
+// NewRedisCodecStore creates a new Redis-based Codec store
+// if an empty prefix is passed, a default prefix will be used.
+func NewRedisCodecStore(client *redis.Client, prefix string) Store {
+	if prefix == "" {
+		prefix = defaultRedisPrefix
+	}
+	store := storage.NewRedisMapStore(client, prefix+":"+redisCodecPrefix)
+	store.SetBase(Codec{}, "")
+	return &RedisCodecStore{
+		store: store,
+	}
+}
+
+// RedisCodecStore stores Codecs in Redis.
+// - Codecs are stored as a Hash
+type RedisCodecStore struct {
+	store *storage.RedisMapStore
+}
+
+// List all Codecs
+func (s *RedisCodecStore) List(opts *storage.ListOptions) ([]*Codec, error) {
+	codecsI, err := s.store.List("", opts)
+	if err != nil {
+		return nil, err
+	}
+	codecs := make([]*Codec, len(codecsI))
+	for i, codecI := range codecsI {
+		if codec, ok := codecI.(Codec); ok {
+			codecs[i] = &codec
+		}
+	}
+	return codecs, nil
+}
+
+// Get a specific Codec
+func (s *RedisCodecStore) Get(id string) (*Codec, error) {
+	codecI, err := s.store.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if codec, ok := codecI.(Codec); ok {
+		return &codec, nil
+	}
+	return nil, errors.New("Database did not return a Codec")
+}
+
+// SetIfRevision creates or updates a Codec if the stored Codec has the given revision (0 for new codecs), and
+// increments the revision
+func (s *RedisCodecStore) SetIfRevision(new *Codec, revision uint64) error {
+	now := time.Now()
+	new.UpdatedAt = now
+	if new.CreatedAt.IsZero() {
+		new.CreatedAt = now
+	}
+	new.Revision = revision + 1
+	return s.store.SetIf(new.ID, *new, "revision", storage.ExpectRevision("Codec", revision))
+}
+
+// Delete a Codec
+func (s *RedisCodecStore) Delete(id string) error {
+	return s.store.Delete(id)
+}
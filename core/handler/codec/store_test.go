@@ -0,0 +1,67 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package codec
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestCodecStore(t *testing.T) {
+	a := New(t)
+
+	NewRedisCodecStore(GetRedisClient(), "")
+
+	s := NewRedisCodecStore(GetRedisClient(), "handler-test-codec-store")
+
+	// Get non-existing
+	codec, err := s.Get("sensor-v1")
+	a.So(err, ShouldNotBeNil)
+	a.So(codec, ShouldBeNil)
+
+	// Create
+	err = s.SetIfRevision(&Codec{
+		ID:      "sensor-v1",
+		Decoder: "decoder",
+	}, 0)
+	defer func() {
+		s.Delete("sensor-v1")
+	}()
+	a.So(err, ShouldBeNil)
+
+	// Get existing
+	codec, err = s.Get("sensor-v1")
+	a.So(err, ShouldBeNil)
+	a.So(codec.Decoder, ShouldEqual, "decoder")
+	a.So(codec.Revision, ShouldEqual, 1)
+	a.So(codec.CreatedAt.IsZero(), ShouldBeFalse)
+
+	// Update
+	codec.Decoder = "new decoder"
+	a.So(s.SetIfRevision(codec, codec.Revision), ShouldBeNil)
+	a.So(codec.Revision, ShouldEqual, 2)
+
+	// Update with outdated revision
+	codec.Decoder = "other decoder"
+	err = s.SetIfRevision(codec, 1)
+	a.So(err, ShouldNotBeNil)
+	a.So(errors.IsConflict(err), ShouldBeTrue)
+
+	codec, _ = s.Get("sensor-v1")
+	a.So(codec.Decoder, ShouldEqual, "new decoder")
+
+	// List
+	codecs, err := s.List(nil)
+	a.So(err, ShouldBeNil)
+	a.So(codecs, ShouldHaveLength, 1)
+
+	// Delete
+	a.So(s.Delete("sensor-v1"), ShouldBeNil)
+	codec, err = s.Get("sensor-v1")
+	a.So(err, ShouldNotBeNil)
+	a.So(codec, ShouldBeNil)
+}
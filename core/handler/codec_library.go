@@ -0,0 +1,206 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/codec"
+	"github.com/TheThingsNetwork/ttn/core/handler/functions"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// resolveCodec replaces the Decoder, Converter, Validator and Encoder of the application by the ones of its codec in
+// the codec library, if it uses one. The codec is resolved every time, so that updates of the codec are used by all
+// applications that use it. Codecs are JavaScript, so the functions language of the application is reset.
+func (h *handler) resolveCodec(app *application.Application) error {
+	if app.Codec == "" {
+		return nil
+	}
+	c, err := h.codecs.Get(app.Codec)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("Could not get codec %s", app.Codec))
+	}
+	app.Decoder = c.Decoder
+	app.Converter = c.Converter
+	app.Validator = c.Validator
+	app.Encoder = c.Encoder
+	app.FunctionsLanguage = pb.FunctionsLanguageJavaScript
+	return nil
+}
+
+// codecUsers returns the IDs of the applications that use the codec
+func (h *handler) codecUsers(id string) ([]string, error) {
+	apps, err := h.applications.List(nil)
+	if err != nil {
+		return nil, err
+	}
+	var appIDs []string
+	for _, app := range apps {
+		if app.Codec == id {
+			appIDs = append(appIDs, app.AppID)
+		}
+	}
+	sort.Strings(appIDs)
+	return appIDs, nil
+}
+
+func codecToPb(c *codec.Codec, appIDs []string) *pb.Codec {
+	return &pb.Codec{
+		Id:          c.ID,
+		Description: c.Description,
+		Decoder:     c.Decoder,
+		Converter:   c.Converter,
+		Validator:   c.Validator,
+		Encoder:     c.Encoder,
+		Version:     c.Version,
+		Revision:    c.Revision,
+		AppIds:      appIDs,
+	}
+}
+
+func (h *handlerManager) ListCodecs(ctx context.Context, in *empty.Empty) (*pb.CodecList, error) {
+	if err := h.validateComponentAccess(ctx); err != nil {
+		return nil, err
+	}
+	codecs, err := h.handler.codecs.List(nil)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.CodecList{Codecs: make([]*pb.Codec, 0, len(codecs))}
+	for _, c := range codecs {
+		appIDs, err := h.handler.codecUsers(c.ID)
+		if err != nil {
+			return nil, err
+		}
+		res.Codecs = append(res.Codecs, codecToPb(c, appIDs))
+	}
+	return res, nil
+}
+
+func (h *handlerManager) GetCodec(ctx context.Context, in *pb.CodecIdentifier) (*pb.Codec, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Codec Identifier")
+	}
+	if err := h.validateComponentAccess(ctx); err != nil {
+		return nil, err
+	}
+	c, err := h.handler.codecs.Get(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	appIDs, err := h.handler.codecUsers(c.ID)
+	if err != nil {
+		return nil, err
+	}
+	return codecToPb(c, appIDs), nil
+}
+
+func (h *handlerManager) SetCodec(ctx context.Context, in *pb.Codec) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Codec")
+	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
+	if err := h.validateComponentAccess(ctx); err != nil {
+		return nil, err
+	}
+	if err := h.handler.setCodec(in); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// setCodec creates or updates a codec in the codec library. If the revision is set, the update is rejected if the
+// codec was modified by someone else in the meantime.
+func (h *handler) setCodec(in *pb.Codec) error {
+	c, err := h.codecs.Get(in.Id)
+	if err != nil && errors.GetErrType(err) != errors.NotFound {
+		return err
+	}
+	if c == nil {
+		c = &codec.Codec{ID: in.Id}
+	}
+	if in.Revision != 0 && in.Revision != c.Revision {
+		return errors.NewErrConflict(fmt.Sprintf("Codec (revision %d, expected %d)", c.Revision, in.Revision))
+	}
+
+	c.Description = in.Description
+	c.Decoder = in.Decoder
+	c.Converter = in.Converter
+	c.Validator = in.Validator
+	c.Encoder = in.Encoder
+	c.Version = in.Version
+
+	names := []string{"Decoder", "Converter", "Validator", "Encoder"}
+	code := []string{c.Decoder, c.Converter, c.Validator, c.Encoder}
+	for i := range code {
+		if code[i] == "" {
+			continue
+		}
+		if err := functions.CheckSyntax(names[i], code[i]); err != nil {
+			return err
+		}
+	}
+
+	if err := h.codecs.SetIfRevision(c, c.Revision); err != nil {
+		return err
+	}
+
+	appIDs, err := h.codecUsers(c.ID)
+	if err != nil {
+		return err
+	}
+	for _, appID := range appIDs {
+		functions.Invalidate(appID)
+	}
+
+	h.Ctx.WithFields(ttnlog.Fields{
+		"Codec":           c.ID,
+		"Version":         c.Version,
+		"Revision":        c.Revision,
+		"NumApplications": len(appIDs),
+	}).Info("Set codec")
+
+	return nil
+}
+
+func (h *handlerManager) DeleteCodec(ctx context.Context, in *pb.CodecIdentifier) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Codec Identifier")
+	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
+	if err := h.validateComponentAccess(ctx); err != nil {
+		return nil, err
+	}
+	if err := h.handler.deleteCodec(in.Id); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// deleteCodec deletes a codec from the codec library, unless it is used by applications
+func (h *handler) deleteCodec(id string) error {
+	if _, err := h.codecs.Get(id); err != nil {
+		return err
+	}
+	appIDs, err := h.codecUsers(id)
+	if err != nil {
+		return err
+	}
+	if len(appIDs) > 0 {
+		return errors.NewErrInvalidArgument("Codec", "is used by "+strings.Join(appIDs, ", "))
+	}
+	return h.codecs.Delete(id)
+}
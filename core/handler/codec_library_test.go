@@ -0,0 +1,104 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	pb_discovery "github.com/TheThingsNetwork/ttn/api/discovery"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/codec"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+	"golang.org/x/net/context"
+)
+
+func TestCodecLibrary(t *testing.T) {
+	a := New(t)
+	h := &handlerManager{
+		handler: &handler{
+			Component: &component.Component{
+				Ctx:      GetLogger(t, "TestCodecLibrary"),
+				Identity: &pb_discovery.Announcement{Id: "dev"},
+			},
+			applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-codec-library"),
+			codecs:       codec.NewRedisCodecStore(GetRedisClient(), "handler-test-codec-library"),
+		},
+	}
+	h.handler.applications.Set(&application.Application{
+		AppID:   "app-1",
+		Decoder: "function Decoder(bytes) { return {}; }",
+		Codec:   "sensor",
+	})
+	h.handler.applications.Set(&application.Application{
+		AppID:   "app-2",
+		Decoder: "function Decoder(bytes) { return {}; }",
+	})
+	defer func() {
+		h.handler.applications.Delete("app-1")
+		h.handler.applications.Delete("app-2")
+		h.handler.codecs.Delete("sensor")
+	}()
+
+	decoder := "function Decoder(bytes) { return { value: bytes[0] }; }"
+
+	// Codec does not exist yet
+	app, _ := h.handler.applications.Get("app-1")
+	err := h.handler.resolveCodec(app)
+	a.So(err, ShouldNotBeNil)
+
+	// Syntax error
+	_, err = h.SetCodec(context.Background(), &pb.Codec{Id: "sensor", Decoder: "function Decoder(bytes) {"})
+	a.So(err, ShouldNotBeNil)
+
+	_, err = h.SetCodec(context.Background(), &pb.Codec{Id: "sensor", Decoder: decoder, Version: "1.0"})
+	a.So(err, ShouldBeNil)
+
+	res, err := h.GetCodec(context.Background(), &pb.CodecIdentifier{Id: "sensor"})
+	a.So(err, ShouldBeNil)
+	a.So(res.Decoder, ShouldEqual, decoder)
+	a.So(res.Revision, ShouldEqual, 1)
+	a.So(res.AppIds, ShouldResemble, []string{"app-1"})
+
+	app, _ = h.handler.applications.Get("app-1")
+	a.So(h.handler.resolveCodec(app), ShouldBeNil)
+	a.So(app.Decoder, ShouldEqual, decoder)
+
+	app, _ = h.handler.applications.Get("app-2")
+	a.So(h.handler.resolveCodec(app), ShouldBeNil)
+	a.So(app.Decoder, ShouldEqual, "function Decoder(bytes) { return {}; }")
+
+	// Updates are used by all applications that use the codec
+	decoder = "function Decoder(bytes) { return { value: bytes[1] }; }"
+	_, err = h.SetCodec(context.Background(), &pb.Codec{Id: "sensor", Decoder: decoder, Version: "1.1", Revision: 1})
+	a.So(err, ShouldBeNil)
+
+	app, _ = h.handler.applications.Get("app-1")
+	a.So(h.handler.resolveCodec(app), ShouldBeNil)
+	a.So(app.Decoder, ShouldEqual, decoder)
+
+	// Outdated revision
+	_, err = h.SetCodec(context.Background(), &pb.Codec{Id: "sensor", Revision: 1})
+	a.So(err, ShouldNotBeNil)
+	a.So(errors.IsConflict(err), ShouldBeTrue)
+
+	list, err := h.ListCodecs(context.Background(), nil)
+	a.So(err, ShouldBeNil)
+	a.So(list.Codecs, ShouldHaveLength, 1)
+	a.So(list.Codecs[0].Version, ShouldEqual, "1.1")
+
+	// Codecs that are used can not be deleted
+	_, err = h.DeleteCodec(context.Background(), &pb.CodecIdentifier{Id: "sensor"})
+	a.So(err, ShouldNotBeNil)
+
+	h.handler.applications.Delete("app-1")
+	_, err = h.DeleteCodec(context.Background(), &pb.CodecIdentifier{Id: "sensor"})
+	a.So(err, ShouldBeNil)
+
+	_, err = h.GetCodec(context.Background(), &pb.CodecIdentifier{Id: "sensor"})
+	a.So(err, ShouldNotBeNil)
+}
@@ -0,0 +1,191 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/random"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	// DefaultCommandTimeout is the time that the Handler waits for the response to a command if no timeout is given
+	DefaultCommandTimeout = 5 * time.Minute
+	// MaxCommandTimeout is the maximum time that the Handler waits for the response to a command
+	MaxCommandTimeout = time.Hour
+)
+
+// commandWaiter waits for the response to a command
+type commandWaiter struct {
+	port             uint8 // 0 for any port
+	correlationField string
+	correlationID    string
+	response         chan *types.UplinkMessage
+}
+
+// matches returns true if the uplink message is a response to the command
+func (w *commandWaiter) matches(up *types.UplinkMessage) bool {
+	if w.port != 0 && up.FPort != w.port {
+		return false
+	}
+	if w.correlationField != "" {
+		value, ok := up.PayloadFields[w.correlationField]
+		if !ok || fmt.Sprint(value) != w.correlationID {
+			return false
+		}
+	}
+	return true
+}
+
+// pendingCommands keeps track of the commands that are waiting for a response
+type pendingCommands struct {
+	sync.Mutex
+	waiters map[string][]*commandWaiter
+}
+
+func (c *pendingCommands) add(appID, devID string, waiter *commandWaiter) {
+	c.Lock()
+	defer c.Unlock()
+	if c.waiters == nil {
+		c.waiters = make(map[string][]*commandWaiter)
+	}
+	c.waiters[appID+":"+devID] = append(c.waiters[appID+":"+devID], waiter)
+}
+
+func (c *pendingCommands) remove(appID, devID string, waiter *commandWaiter) {
+	c.Lock()
+	defer c.Unlock()
+	waiters := c.waiters[appID+":"+devID]
+	for i, w := range waiters {
+		if w == waiter {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+	if len(waiters) == 0 {
+		delete(c.waiters, appID+":"+devID)
+	} else {
+		c.waiters[appID+":"+devID] = waiters
+	}
+}
+
+// notify passes the uplink message to the first command that it is a response to
+func (c *pendingCommands) notify(up *types.UplinkMessage) {
+	c.Lock()
+	defer c.Unlock()
+	waiters := c.waiters[up.AppID+":"+up.DevID]
+	for i, w := range waiters {
+		if !w.matches(up) {
+			continue
+		}
+		w.response <- up // The channel is buffered and only used once
+		waiters = append(waiters[:i], waiters[i+1:]...)
+		if len(waiters) == 0 {
+			delete(c.waiters, up.AppID+":"+up.DevID)
+		} else {
+			c.waiters[up.AppID+":"+up.DevID] = waiters
+		}
+		return
+	}
+}
+
+func (h *handlerManager) SendCommand(ctx context.Context, in *pb.CommandRequest) (*pb.CommandResponse, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Command Request")
+	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.Devices)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := h.handler.applications.Get(in.AppId); err != nil {
+		return nil, errors.Wrap(err, "Application not registered to this Handler")
+	}
+
+	timeout := time.Duration(in.Timeout) * time.Second
+	if timeout == 0 {
+		timeout = DefaultCommandTimeout
+	}
+	if timeout > MaxCommandTimeout {
+		return nil, errors.NewErrInvalidArgument("Timeout", fmt.Sprintf("can not be longer than %s", MaxCommandTimeout))
+	}
+
+	downlink := &types.DownlinkMessage{
+		AppID:      in.AppId,
+		DevID:      in.DevId,
+		FPort:      uint8(in.Port),
+		Confirmed:  in.Confirmed,
+		Schedule:   types.ScheduleLast,
+		PayloadRaw: in.Payload,
+	}
+	waiter := &commandWaiter{
+		port:             uint8(in.ResponsePort),
+		correlationField: in.CorrelationField,
+		response:         make(chan *types.UplinkMessage, 1),
+	}
+	if in.Fields != "" {
+		if err := json.Unmarshal([]byte(in.Fields), &downlink.PayloadFields); err != nil {
+			return nil, errors.NewErrInvalidArgument("Fields", err.Error())
+		}
+		if in.CorrelationField != "" {
+			if downlink.PayloadFields == nil {
+				downlink.PayloadFields = make(map[string]interface{})
+			}
+			waiter.correlationID = random.String(16)
+			downlink.PayloadFields[in.CorrelationField] = waiter.correlationID
+		}
+	}
+
+	// Start waiting before the downlink is enqueued, so that the response can not be missed
+	h.handler.commands.add(in.AppId, in.DevId, waiter)
+	defer h.handler.commands.remove(in.AppId, in.DevId, waiter)
+
+	if err := h.handler.EnqueueDownlink(downlink); err != nil {
+		return nil, err
+	}
+
+	var up *types.UplinkMessage
+	select {
+	case up = <-waiter.response:
+	case <-time.After(timeout):
+		return nil, grpc.Errorf(codes.DeadlineExceeded, "No response from device within %s", timeout)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	res := &pb.CommandResponse{
+		CorrelationId: waiter.correlationID,
+		Port:          uint32(up.FPort),
+		Counter:       up.FCnt,
+		PayloadRaw:    up.PayloadRaw,
+	}
+	if t := time.Time(up.Metadata.Time); !t.IsZero() {
+		res.ServerTime = t.UnixNano()
+	}
+	if up.PayloadFields != nil {
+		fields, err := json.Marshal(up.PayloadFields)
+		if err != nil {
+			return nil, err
+		}
+		res.Fields = string(fields)
+	}
+	return res, nil
+}
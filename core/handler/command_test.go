@@ -0,0 +1,46 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestPendingCommands(t *testing.T) {
+	a := New(t)
+
+	var commands pendingCommands
+
+	byPort := &commandWaiter{port: 3, response: make(chan *types.UplinkMessage, 1)}
+	byCorrelation := &commandWaiter{correlationField: "id", correlationID: "abc", response: make(chan *types.UplinkMessage, 1)}
+	commands.add("app", "dev", byPort)
+	commands.add("app", "dev", byCorrelation)
+
+	// Other device
+	commands.notify(&types.UplinkMessage{AppID: "app", DevID: "other", FPort: 3})
+	a.So(byPort.response, ShouldBeEmpty)
+
+	// Other port and other correlation ID
+	commands.notify(&types.UplinkMessage{AppID: "app", DevID: "dev", FPort: 1, PayloadFields: map[string]interface{}{"id": "def"}})
+	a.So(byPort.response, ShouldBeEmpty)
+	a.So(byCorrelation.response, ShouldBeEmpty)
+
+	commands.notify(&types.UplinkMessage{AppID: "app", DevID: "dev", FPort: 1, PayloadFields: map[string]interface{}{"id": "abc"}})
+	a.So(byPort.response, ShouldBeEmpty)
+	a.So(byCorrelation.response, ShouldHaveLength, 1)
+
+	commands.notify(&types.UplinkMessage{AppID: "app", DevID: "dev", FPort: 3})
+	a.So(byPort.response, ShouldHaveLength, 1)
+	a.So(commands.waiters, ShouldBeEmpty)
+
+	// Removed waiters are not notified
+	any := &commandWaiter{response: make(chan *types.UplinkMessage, 1)}
+	commands.add("app", "dev", any)
+	commands.remove("app", "dev", any)
+	commands.notify(&types.UplinkMessage{AppID: "app", DevID: "dev", FPort: 1})
+	a.So(any.response, ShouldBeEmpty)
+}
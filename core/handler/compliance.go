@@ -0,0 +1,99 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"sort"
+
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// complianceKey identifies the group of a device in the compliance report
+type complianceKey struct {
+	lorawanVersion     string
+	regionalParameters string
+	frequencyPlan      string
+}
+
+// complianceReport summarizes the devices by LoRaWAN version, Regional Parameters revision and frequency plan. The
+// frequency plan of a device is looked up with frequencyPlan.
+func complianceReport(appID string, devices []*device.Device, frequencyPlan func(dev *device.Device) string) *pb.ComplianceReport {
+	res := &pb.ComplianceReport{AppId: appID, Groups: []*pb.ComplianceGroup{}}
+	groups := make(map[complianceKey]*pb.ComplianceGroup)
+	for _, dev := range devices {
+		if dev == nil {
+			continue
+		}
+		key := complianceKey{
+			lorawanVersion:     dev.Profile.LoRaWANVersion,
+			regionalParameters: dev.Options.RegionalParameters,
+			frequencyPlan:      frequencyPlan(dev),
+		}
+		group, ok := groups[key]
+		if !ok {
+			group = &pb.ComplianceGroup{
+				LorawanVersion:     key.lorawanVersion,
+				RegionalParameters: key.regionalParameters,
+				FrequencyPlan:      key.frequencyPlan,
+			}
+			groups[key] = group
+			res.Groups = append(res.Groups, group)
+		}
+		group.Devices++
+		if dev.Profile.CertificationID != "" {
+			group.Certified++
+		}
+		res.Devices++
+	}
+	// Devices of which the version is unknown are listed last
+	sort.Slice(res.Groups, func(i, j int) bool {
+		a, b := res.Groups[i], res.Groups[j]
+		if a.LorawanVersion != b.LorawanVersion {
+			return b.LorawanVersion == "" || a.LorawanVersion != "" && a.LorawanVersion < b.LorawanVersion
+		}
+		if a.RegionalParameters != b.RegionalParameters {
+			return b.RegionalParameters == "" || a.RegionalParameters != "" && a.RegionalParameters < b.RegionalParameters
+		}
+		return b.FrequencyPlan == "" || a.FrequencyPlan != "" && a.FrequencyPlan < b.FrequencyPlan
+	})
+	return res
+}
+
+// frequencyPlan returns the frequency plan of the last uplink of the device, or an empty string if it is unknown
+func (h *handler) frequencyPlan(dev *device.Device) string {
+	_, lorawan, err := h.lastUplink(dev)
+	if err != nil {
+		return ""
+	}
+	return lorawan.FrequencyPlan.String()
+}
+
+func (h *handlerManager) GetComplianceReport(ctx context.Context, in *pb.ApplicationIdentifier) (*pb.ComplianceReport, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Identifier")
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.Devices)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := h.handler.applications.Get(in.AppId); err != nil {
+		return nil, errors.Wrap(err, "Application not registered to this Handler")
+	}
+
+	devices, err := h.handler.devices.ListForApp(in.AppId, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return complianceReport(in.AppId, devices, h.handler.frequencyPlan), nil
+}
@@ -0,0 +1,39 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestComplianceReport(t *testing.T) {
+	a := New(t)
+
+	devices := []*device.Device{
+		{DevID: "unknown"},
+		{DevID: "certified", Profile: device.Profile{LoRaWANVersion: "1.0.2", CertificationID: "1234"}, Options: device.Options{RegionalParameters: "1.0.2-b"}},
+		{DevID: "uncertified", Profile: device.Profile{LoRaWANVersion: "1.0.2"}, Options: device.Options{RegionalParameters: "1.0.2-b"}},
+		{DevID: "old", Profile: device.Profile{LoRaWANVersion: "1.0"}},
+		nil,
+	}
+	frequencyPlan := func(dev *device.Device) string {
+		if dev.DevID == "unknown" {
+			return ""
+		}
+		return "EU_863_870"
+	}
+
+	res := complianceReport("test", devices, frequencyPlan)
+	a.So(res.AppId, ShouldEqual, "test")
+	a.So(res.Devices, ShouldEqual, 4)
+	a.So(res.Groups, ShouldResemble, []*pb.ComplianceGroup{
+		{LorawanVersion: "1.0", FrequencyPlan: "EU_863_870", Devices: 1},
+		{LorawanVersion: "1.0.2", RegionalParameters: "1.0.2-b", FrequencyPlan: "EU_863_870", Devices: 2, Certified: 1},
+		{Devices: 1},
+	})
+}
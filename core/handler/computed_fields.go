@@ -0,0 +1,81 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/handler/functions"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// computeField evaluates the expression of the computed field with the payload fields and the previous value
+func computeField(field application.ComputedField, fields map[string]interface{}, previous interface{}) (interface{}, error) {
+	env := make(map[string]interface{}, len(fields)+1)
+	for key, value := range fields {
+		env[key] = value
+	}
+	env["previous"] = previous
+
+	value, err := functions.RunCode(fmt.Sprintf("computed field %s", field.Name), field.Expression, env, timeOut, functions.Ignore)
+	if err != nil {
+		return nil, err
+	}
+	if value.IsUndefined() {
+		return nil, nil
+	}
+	if value.IsFunction() {
+		return nil, errors.NewErrInvalidArgument("Computed field "+field.Name, "expression returned a function")
+	}
+	return value.Export()
+}
+
+// ComputeFields adds the computed fields of the application to the payload fields
+func (h *handler) ComputeFields(ctx ttnlog.Interface, _ *pb_broker.DeduplicatedUplinkMessage, appUp *types.UplinkMessage, dev *device.Device) error {
+	if appUp.PayloadFields == nil {
+		return nil
+	}
+
+	app, err := h.applications.Get(appUp.AppID)
+	if err != nil || len(app.ComputedFields) == 0 {
+		return nil
+	}
+
+	var previous map[string]interface{}
+	if dev.ComputedFields != nil {
+		previous = dev.ComputedFields.Values
+	}
+
+	computed := make(map[string]interface{}, len(app.ComputedFields))
+	for _, field := range app.ComputedFields {
+		value, err := computeField(field, appUp.PayloadFields, previous[field.Name])
+		if err != nil {
+			// Emit the error, but continue with the other fields
+			h.mqttEvent <- &types.DeviceEvent{
+				AppID: appUp.AppID,
+				DevID: appUp.DevID,
+				Event: types.UplinkErrorEvent,
+				Data:  types.ErrorEventData{Error: err.Error()},
+			}
+			continue
+		}
+		if value == nil {
+			continue
+		}
+		appUp.PayloadFields[field.Name] = value
+		computed[field.Name] = value
+	}
+
+	// The previous values are only updated for new uplinks
+	if !appUp.IsRetry {
+		dev.ComputedFields = &device.ComputedFields{Values: computed}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestComputeFields(t *testing.T) {
+	a := New(t)
+	appID := "AppID-1"
+
+	h := &handler{
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-compute-fields"),
+		mqttEvent:    make(chan *types.DeviceEvent, 10),
+	}
+	ctx := GetLogger(t, "TestComputeFields")
+	dev := &device.Device{AppID: appID, DevID: "DevID-1"}
+
+	uplink := func(fields map[string]interface{}) *types.UplinkMessage {
+		return &types.UplinkMessage{
+			AppID:         appID,
+			DevID:         "DevID-1",
+			PayloadFields: fields,
+		}
+	}
+
+	app := &application.Application{
+		AppID: appID,
+		ComputedFields: []application.ComputedField{
+			{Name: "dewpoint", Expression: "temperature - (100 - humidity) / 5"},
+			{Name: "flow", Expression: "(previous || 0) + pulses * 0.5"},
+			{Name: "broken", Expression: "throw new Error('broken')"},
+			{Name: "nothing", Expression: "undefined"},
+		},
+	}
+	a.So(h.applications.Set(app), ShouldBeNil)
+	defer func() {
+		h.applications.Delete(appID)
+	}()
+
+	appUp := uplink(map[string]interface{}{"temperature": 20, "humidity": 50, "pulses": 10})
+	err := h.ComputeFields(ctx, nil, appUp, dev)
+	a.So(err, ShouldBeNil)
+	a.So(appUp.PayloadFields["dewpoint"], ShouldEqual, 10)
+	a.So(appUp.PayloadFields["flow"], ShouldEqual, 5)
+	a.So(appUp.PayloadFields, ShouldNotContainKey, "broken")
+	a.So(appUp.PayloadFields, ShouldNotContainKey, "nothing")
+	a.So(h.mqttEvent, ShouldHaveLength, 1)
+	<-h.mqttEvent
+
+	// The previous value is available in the next uplink
+	appUp = uplink(map[string]interface{}{"temperature": 20, "humidity": 50, "pulses": 4})
+	err = h.ComputeFields(ctx, nil, appUp, dev)
+	a.So(err, ShouldBeNil)
+	a.So(appUp.PayloadFields["flow"], ShouldEqual, 7)
+	<-h.mqttEvent
+
+	// No payload fields
+	appUp = uplink(nil)
+	err = h.ComputeFields(ctx, nil, appUp, dev)
+	a.So(err, ShouldBeNil)
+	a.So(appUp.PayloadFields, ShouldBeNil)
+	a.So(dev.ComputedFields.Values["flow"], ShouldEqual, 7)
+}
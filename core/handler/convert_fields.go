@@ -5,42 +5,56 @@ package handler
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 	"time"
 
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
 	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
 	"github.com/TheThingsNetwork/ttn/core/handler/device"
 	"github.com/TheThingsNetwork/ttn/core/handler/functions"
+	"github.com/TheThingsNetwork/ttn/core/handler/gocodec"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 )
 
 // ConvertFieldsUp converts the payload to fields using payload functions
-func (h *handler) ConvertFieldsUp(ctx ttnlog.Interface, _ *pb_broker.DeduplicatedUplinkMessage, appUp *types.UplinkMessage, _ *device.Device) error {
+func (h *handler) ConvertFieldsUp(ctx ttnlog.Interface, _ *pb_broker.DeduplicatedUplinkMessage, appUp *types.UplinkMessage, dev *device.Device) error {
 	// Find Application
 	app, err := h.applications.Get(appUp.AppID)
 	if err != nil {
 		return nil // Do not process if application not found
 	}
 
-	functions := &UplinkFunctions{
-		Decoder:   app.Decoder,
-		Converter: app.Converter,
-		Validator: app.Validator,
-		Logger:    functions.Ignore,
+	// The revision of the payload functions allows to find the edit that broke them
+	ctx = ctx.WithField("FunctionsRevision", app.FunctionsRevision)
+	applyDevicePayloadFormat(app, dev)
+	if err := h.resolveCodec(app); err != nil {
+		ctx.WithError(err).Warn("Could not process payload functions")
+		return nil
+	}
+
+	portFunctions, err := h.deviceFunctions(app, dev, appUp.FPort)
+	if err != nil {
+		ctx.WithError(err).Warn("Could not get codec from device repository")
+	}
+	logger := h.functionLogger(appUp.AppID, appUp.DevID)
+	metadata := functionMetadata(appUp, dev)
+	if app.StatefulDecoding {
+		addDecoderState(metadata, dev, appUp.FCnt)
 	}
+	functions := h.uplinkFunctions(app, portFunctions, logger)
+	functions.Metadata = metadata
 
 	fields, valid, err := functions.Process(appUp.PayloadRaw, appUp.FPort)
+	h.captureFunctionLogs(appUp.AppID, appUp.DevID, logger, err)
 	if err != nil {
+		ctx.WithError(err).Warn("Could not process payload functions")
 
 		// Emit the error
-		h.mqttEvent <- &types.DeviceEvent{
-			AppID: appUp.AppID,
-			DevID: appUp.DevID,
-			Event: types.UplinkErrorEvent,
-			Data:  types.ErrorEventData{Error: err.Error()},
-		}
+		h.publishFunctionError(appUp, err)
 
 		// Do not set fields if processing failed, but allow the handler to continue processing
 		// without payload functions
@@ -48,16 +62,116 @@ func (h *handler) ConvertFieldsUp(ctx ttnlog.Interface, _ *pb_broker.Deduplicate
 	}
 
 	if !valid {
-		return errors.NewErrInvalidArgument("Payload", "payload validator function returned false")
+		err := &FunctionError{
+			Function: "Validator",
+			Err:      errors.NewErrInvalidArgument("Payload", "payload validator function returned false"),
+		}
+		h.publishFunctionError(appUp, err)
+		return err
 	}
 
+	ctx.Debug("Processed payload functions")
 	appUp.PayloadFields = fields
+	updateDecoderState(dev, app.StatefulDecoding, appUp.FCnt, functions.decoded)
 
 	return nil
 }
 
+// publishFunctionError publishes an uplink error event with the function that failed and the payload
+func (h *handler) publishFunctionError(appUp *types.UplinkMessage, err error) {
+	data := types.FunctionErrorEventData{
+		ErrorEventData: types.ErrorEventData{Error: err.Error()},
+		Type:           functionErrorType(err),
+		FPort:          appUp.FPort,
+		FCnt:           appUp.FCnt,
+		PayloadRaw:     appUp.PayloadRaw,
+	}
+	if err, ok := err.(*FunctionError); ok {
+		data.Function = err.Function
+	}
+	h.mqttEvent <- &types.DeviceEvent{
+		AppID: appUp.AppID,
+		DevID: appUp.DevID,
+		Event: types.UplinkErrorEvent,
+		Data:  data,
+	}
+}
+
+// functionErrorType returns the type of an error of the payload functions
+func functionErrorType(err error) string {
+	switch {
+	case functions.IsResourceLimitExceeded(err):
+		return "resource limit"
+	case functions.IsOutputLimitExceeded(err):
+		return "output limit"
+	}
+	return string(errors.GetErrType(err))
+}
+
+// FunctionError is returned by UplinkFunctions.Process with the name of the function that failed. For payload formats
+// without payload functions, the Decoder is the function that failed to decode the payload.
+type FunctionError struct {
+	Function string
+	Err      error
+}
+
+func (err *FunctionError) Error() string {
+	return err.Err.Error()
+}
+
+// Cause returns the error of the function
+func (err *FunctionError) Cause() error {
+	return err.Err
+}
+
+// functionMetadata returns the identifiers of the device and the metadata of the uplink message that are passed to
+// the Decoder and Converter
+func functionMetadata(appUp *types.UplinkMessage, dev *device.Device) map[string]interface{} {
+	metadata := map[string]interface{}{
+		"app_id":        appUp.AppID,
+		"dev_id":        appUp.DevID,
+		"frequency":     appUp.Metadata.Frequency,
+		"data_rate":     appUp.Metadata.DataRate,
+		"gateway_count": len(appUp.Metadata.Gateways),
+	}
+	if dev != nil {
+		metadata["app_eui"] = dev.AppEUI.String()
+		metadata["dev_eui"] = dev.DevEUI.String()
+	}
+	if gateways := appUp.Metadata.Gateways; len(gateways) > 0 {
+		rssi, snr := gateways[0].RSSI, gateways[0].SNR
+		for _, gateway := range gateways[1:] {
+			rssi = float32(math.Max(float64(rssi), float64(gateway.RSSI)))
+			snr = float32(math.Max(float64(snr), float64(gateway.SNR)))
+		}
+		metadata["rssi"], metadata["snr"] = rssi, snr
+	}
+	return metadata
+}
+
 // UplinkFunctions decodes, converts and validates payload using JavaScript functions
 type UplinkFunctions struct {
+	// PayloadFormat is the format of the payload. If it is cayennelpp, the payload
+	// is decoded as Cayenne LPP instead of with the Decoder. If it is wasm, the
+	// payload is decoded with the WASMModule instead of with the Decoder. If it
+	// is binary, the BinaryFields are decoded instead of with the Decoder. If it
+	// is protobuf, the payload is decoded as the ProtobufMessage. If it is cbor,
+	// the payload is decoded as CBOR. If it is go, the payload is decoded with
+	// the GoCodec
+	PayloadFormat string
+	// WASMModule is a compiled WebAssembly module that exports a decode function
+	WASMModule []byte
+	// BinaryFields is the layout of the payload if the PayloadFormat is binary
+	BinaryFields []application.BinaryField
+	// ProtobufDescriptor is the serialized FileDescriptorSet that contains the
+	// ProtobufMessage, which is the full name of the message of the payload
+	ProtobufDescriptor []byte
+	ProtobufMessage    string
+	// GoCodec is the name of the compiled Go codec if the PayloadFormat is go
+	GoCodec string
+	// Language is the language of the Decoder, Converter and Validator: javascript
+	// (default) or lua
+	Language string
 	// Decoder is a JavaScript function that accepts the payload as byte array and
 	// returns an object containing the decoded values
 	Decoder string
@@ -68,29 +182,158 @@ type UplinkFunctions struct {
 	// Converter and returns a boolean value indicating the validity of the data
 	Validator string
 
+	// Metadata is passed to the Decoder and Converter as their third argument. It
+	// contains the identifiers of the device (app_id, dev_id, app_eui, dev_eui) and
+	// the metadata of the uplink message (frequency, data_rate, gateway_count and
+	// the best rssi and snr). With stateful decoding, previous contains the
+	// fields and frame counter of the previous uplink message
+	Metadata map[string]interface{}
+
+	// AppID is the ID of the application of the functions. If it is set, the
+	// compiled functions are cached for the application
+	AppID string
+
+	// Timeout is the maximum time that each function is allowed to run (default timeOut)
+	Timeout time.Duration
+
+	// OutputLimits are the limits of the fields that the Decoder and Converter
+	// return, in addition to the limits of the Handler
+	OutputLimits functions.OutputLimits
+
 	// Logger is the logger that will be used to store logs
 	Logger functions.Logger
+
+	// decoded are the fields that were returned by the Decoder in the last call to Process
+	decoded map[string]interface{}
+}
+
+// builtinPayloadFormat returns whether the Handler decodes and encodes payloads of the format without a Decoder and
+// Encoder
+func builtinPayloadFormat(format string) bool {
+	switch format {
+	case pb.PayloadFormatCayenneLPP, pb.PayloadFormatWASM, pb.PayloadFormatBinary, pb.PayloadFormatProtobuf, pb.PayloadFormatCBOR, pb.PayloadFormatGo:
+		return true
+	}
+	return false
+}
+
+// uplinkFunctions returns the UplinkFunctions for the payload format of the application and the payload functions of
+// the port
+func (h *handler) uplinkFunctions(app *application.Application, portFunctions application.PortFunctions, logger functions.Logger) *UplinkFunctions {
+	return &UplinkFunctions{
+		AppID:              app.AppID,
+		PayloadFormat:      app.PayloadFormat,
+		WASMModule:         app.WASMModule,
+		BinaryFields:       app.BinaryFields,
+		Language:           app.FunctionsLanguage,
+		ProtobufDescriptor: app.ProtobufDescriptor,
+		ProtobufMessage:    app.ProtobufMessage,
+		GoCodec:            app.GoCodec,
+		Decoder:            portFunctions.Decoder,
+		Converter:          portFunctions.Converter,
+		Validator:          portFunctions.Validator,
+		Timeout:            h.functionTimeout(app.FunctionTimeout),
+		OutputLimits:       outputLimits(app),
+		Logger:             logger,
+	}
+}
+
+// downlinkFunctions returns the DownlinkFunctions for the payload format of the application and the Encoder of the port
+func (h *handler) downlinkFunctions(app *application.Application, portFunctions application.PortFunctions, logger functions.Logger) *DownlinkFunctions {
+	return &DownlinkFunctions{
+		AppID:              app.AppID,
+		PayloadFormat:      app.PayloadFormat,
+		WASMModule:         app.WASMModule,
+		BinaryFields:       app.BinaryFields,
+		Language:           app.FunctionsLanguage,
+		ProtobufDescriptor: app.ProtobufDescriptor,
+		ProtobufMessage:    app.ProtobufMessage,
+		GoCodec:            app.GoCodec,
+		Encoder:            portFunctions.Encoder,
+		Timeout:            h.functionTimeout(app.FunctionTimeout),
+		Logger:             logger,
+	}
 }
 
-// timeOut is the maximum allowed time a payload function is allowed to run
+// timeOut is the default maximum time a payload function is allowed to run
 var timeOut = 100 * time.Millisecond
 
+// DefaultMaxFunctionTimeout is the default maximum of the payload function timeout that applications can set
+var DefaultMaxFunctionTimeout = time.Second
+
+// orDefaultTimeout returns the timeout, or timeOut if it is not set
+func orDefaultTimeout(timeout time.Duration) time.Duration {
+	if timeout == 0 {
+		return timeOut
+	}
+	return timeout
+}
+
+// functionTimeout returns the time that payload functions with the given timeout are allowed to run: timeOut if the
+// timeout is not set, and at most the maximum that is configured on the handler
+func (h *handler) functionTimeout(timeout time.Duration) time.Duration {
+	max := h.maxFunctionTimeout
+	if max == 0 {
+		max = DefaultMaxFunctionTimeout
+	}
+	timeout = orDefaultTimeout(timeout)
+	if timeout > max {
+		return max
+	}
+	return timeout
+}
+
+// outputLimits returns the limits of the fields that the payload functions of the application return
+func outputLimits(app *application.Application) functions.OutputLimits {
+	return functions.OutputLimits{
+		Fields: int(app.MaxOutputFields),
+		Depth:  int(app.MaxOutputDepth),
+		Size:   int(app.MaxOutputSize),
+	}
+}
+
+// metadata returns the Metadata, or an empty object if it is not set
+func (f *UplinkFunctions) metadata() map[string]interface{} {
+	if f.Metadata == nil {
+		return map[string]interface{}{}
+	}
+	return f.Metadata
+}
+
 // Decode decodes the payload using the Decoder function into a map
 func (f *UplinkFunctions) Decode(payload []byte, port uint8) (map[string]interface{}, error) {
+	switch f.PayloadFormat {
+	case pb.PayloadFormatCayenneLPP:
+		return decodeCayenneLPP(payload)
+	case pb.PayloadFormatWASM:
+		return f.decodeWASM(payload, port)
+	case pb.PayloadFormatBinary:
+		return f.decodeBinary(payload)
+	case pb.PayloadFormatProtobuf:
+		return f.decodeProtobuf(payload)
+	case pb.PayloadFormatCBOR:
+		return decodeCBOR(payload)
+	case pb.PayloadFormatGo:
+		return gocodec.Decode(f.GoCodec, payload, port)
+	}
 	if f.Decoder == "" {
 		return nil, nil
 	}
+	if f.Language == pb.FunctionsLanguageLua {
+		return f.decodeLua(payload, port)
+	}
 
 	env := map[string]interface{}{
-		"payload": payload,
-		"port":    port,
+		"payload":  payload,
+		"port":     port,
+		"metadata": f.metadata(),
 	}
 	code := fmt.Sprintf(`
 		%s;
-		Decoder(payload.slice(0), port);
+		Decoder(payload.slice(0), port, metadata);
 	`, f.Decoder)
 
-	value, err := functions.RunCode("Decoder", code, env, timeOut, f.Logger)
+	value, err := functions.RunCachedCode(f.AppID, "Decoder", code, env, orDefaultTimeout(f.Timeout), f.Logger)
 	if err != nil {
 		return nil, err
 	}
@@ -100,11 +343,14 @@ func (f *UplinkFunctions) Decode(payload []byte, port uint8) (map[string]interfa
 	}
 
 	v, _ := value.Export()
-	m, ok := v.(map[string]interface{})
-	if !ok {
-		return nil, errors.NewErrInvalidArgument("Decoder", "does not return an object")
+	if m, ok := v.(map[string]interface{}); ok {
+		return m, nil
 	}
-	return m, nil
+	if _, ok := readingsOf(v); ok {
+		// Buffered readings are wrapped in an object, so that they can be converted and validated
+		return map[string]interface{}{ReadingsField: v}, nil
+	}
+	return nil, errors.NewErrInvalidArgument("Decoder", "does not return an object or an array of objects")
 }
 
 // Convert converts the values in the specified map to a another map using the
@@ -114,18 +360,22 @@ func (f *UplinkFunctions) Convert(fields map[string]interface{}, port uint8) (ma
 	if f.Converter == "" {
 		return fields, nil
 	}
+	if f.Language == pb.FunctionsLanguageLua {
+		return f.convertLua(fields, port)
+	}
 
 	env := map[string]interface{}{
-		"fields": fields,
-		"port":   port,
+		"fields":   fields,
+		"port":     port,
+		"metadata": f.metadata(),
 	}
 
 	code := fmt.Sprintf(`
 		%s;
-		Converter(fields, port)
+		Converter(fields, port, metadata)
 	`, f.Converter)
 
-	value, err := functions.RunCode("Converter", code, env, timeOut, f.Logger)
+	value, err := functions.RunCachedCode(f.AppID, "Converter", code, env, orDefaultTimeout(f.Timeout), f.Logger)
 	if err != nil {
 		return nil, err
 	}
@@ -149,6 +399,9 @@ func (f *UplinkFunctions) Validate(fields map[string]interface{}, port uint8) (b
 	if f.Validator == "" {
 		return true, nil
 	}
+	if f.Language == pb.FunctionsLanguageLua {
+		return f.validateLua(fields, port)
+	}
 
 	env := map[string]interface{}{
 		"fields": fields,
@@ -159,7 +412,7 @@ func (f *UplinkFunctions) Validate(fields map[string]interface{}, port uint8) (b
 		Validator(fields, port)
 	`, f.Validator)
 
-	value, err := functions.RunCode("Validator", code, env, timeOut, f.Logger)
+	value, err := functions.RunCachedCode(f.AppID, "Validator", code, env, orDefaultTimeout(f.Timeout), f.Logger)
 	if err != nil {
 		return false, err
 	}
@@ -174,32 +427,133 @@ func (f *UplinkFunctions) Validate(fields map[string]interface{}, port uint8) (b
 // Process decodes the specified payload, converts it and test the validity
 func (f *UplinkFunctions) Process(payload []byte, port uint8) (map[string]interface{}, bool, error) {
 	decoded, err := f.Decode(payload, port)
+	if err == nil {
+		err = f.OutputLimits.Check("Decoder", decoded)
+	}
 	if err != nil {
-		return nil, false, err
+		return nil, false, &FunctionError{Function: "Decoder", Err: err}
+	}
+
+	// The Converter may change the decoded fields
+	f.decoded = make(map[string]interface{}, len(decoded))
+	for k, v := range decoded {
+		f.decoded[k] = v
 	}
 
 	converted, err := f.Convert(decoded, port)
+	if err == nil {
+		err = f.OutputLimits.Check("Converter", converted)
+	}
 	if err != nil {
-		return nil, false, err
+		return nil, false, &FunctionError{Function: "Converter", Err: err}
 	}
 
 	valid, err := f.Validate(converted, port)
-	return converted, valid, err
+	if err != nil {
+		return converted, false, &FunctionError{Function: "Validator", Err: err}
+	}
+	return converted, valid, nil
 }
 
 // DownlinkFunctions encodes payload using JavaScript functions
 type DownlinkFunctions struct {
+	// PayloadFormat is the format of the payload. If it is cayennelpp, the payload
+	// is encoded as Cayenne LPP instead of with the Encoder. If it is wasm, the
+	// payload is encoded with the WASMModule instead of with the Encoder. If it
+	// is binary, the BinaryFields are encoded instead of with the Encoder. If it
+	// is protobuf, the payload is encoded as the ProtobufMessage. If it is cbor,
+	// the payload is encoded as CBOR. If it is go, the payload is encoded with
+	// the GoCodec
+	PayloadFormat string
+	// WASMModule is a compiled WebAssembly module that exports an encode function
+	WASMModule []byte
+	// BinaryFields is the layout of the payload if the PayloadFormat is binary
+	BinaryFields []application.BinaryField
+	// ProtobufDescriptor is the serialized FileDescriptorSet that contains the
+	// ProtobufMessage, which is the full name of the message of the payload
+	ProtobufDescriptor []byte
+	ProtobufMessage    string
+	// GoCodec is the name of the compiled Go codec if the PayloadFormat is go
+	GoCodec string
+	// Language is the language of the Encoder: javascript (default) or lua. The
+	// Decoder is always JavaScript
+	Language string
 	// Encoder is a JavaScript function that accepts the payload as JSON and
 	// returns an array of bytes
 	Encoder string
+	// Decoder is a JavaScript function that accepts the payload of a scheduled
+	// downlink message as byte array and returns an object containing the
+	// decoded values
+	Decoder string
+
+	// AppID is the ID of the application of the Encoder and Decoder. If it is
+	// set, the compiled functions are cached for the application
+	AppID string
+
+	// Timeout is the maximum time that the Encoder and Decoder are allowed to run (default timeOut)
+	Timeout time.Duration
+
+	// OutputLimits are the limits of the fields that the Decoder returns, in
+	// addition to the limits of the Handler
+	OutputLimits functions.OutputLimits
 
 	// Logger is the logger that will be used to store logs
 	Logger functions.Logger
 }
 
+// EncodedDownlink is the result of encoding the fields of a downlink message
+type EncodedDownlink struct {
+	Payload []byte
+	// FPort is the port that the Encoder chose for the downlink, or 0 if it did not choose one
+	FPort uint8
+	// Confirmed is whether the Encoder chose to confirm the downlink, or nil if it did not choose
+	Confirmed *bool
+}
+
 // Encode encodes the map into a byte slice using the encoder payload function
 // If no encoder function is set, this function returns an array.
 func (f *DownlinkFunctions) Encode(payload map[string]interface{}, port uint8) ([]byte, error) {
+	encoded, err := f.EncodeDownlink(payload, port)
+	if err != nil {
+		return nil, err
+	}
+	return encoded.Payload, nil
+}
+
+// EncodeDownlink encodes the map using the encoder payload function. The Encoder
+// returns either an array of bytes, or an object like {bytes: [...], fPort: 12, confirmed: true}
+// to also choose the port and confirmation of the downlink.
+func (f *DownlinkFunctions) EncodeDownlink(payload map[string]interface{}, port uint8) (*EncodedDownlink, error) {
+	var (
+		bytes []byte
+		err   error
+	)
+	switch f.PayloadFormat {
+	case pb.PayloadFormatCayenneLPP:
+		bytes, err = encodeCayenneLPP(payload)
+	case pb.PayloadFormatWASM:
+		bytes, err = f.encodeWASM(payload, port)
+	case pb.PayloadFormatBinary:
+		bytes, err = f.encodeBinary(payload)
+	case pb.PayloadFormatProtobuf:
+		bytes, err = f.encodeProtobuf(payload)
+	case pb.PayloadFormatCBOR:
+		bytes, err = encodeCBOR(payload)
+	case pb.PayloadFormatGo:
+		bytes, err = gocodec.Encode(f.GoCodec, payload, port)
+	default:
+		if f.Language == pb.FunctionsLanguageLua {
+			return f.encodeLua(payload, port)
+		}
+		return f.encodeJavaScript(payload, port)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &EncodedDownlink{Payload: bytes}, nil
+}
+
+func (f *DownlinkFunctions) encodeJavaScript(payload map[string]interface{}, port uint8) (*EncodedDownlink, error) {
 	if f.Encoder == "" {
 		return nil, errors.NewErrInvalidArgument("Downlink Payload", "fields supplied, but no Encoder function set")
 	}
@@ -213,7 +567,7 @@ func (f *DownlinkFunctions) Encode(payload map[string]interface{}, port uint8) (
 		Encoder(payload, port)
 	`, f.Encoder)
 
-	value, err := functions.RunCode("Encoder", code, env, timeOut, f.Logger)
+	value, err := functions.RunCachedCode(f.AppID, "Encoder", code, env, orDefaultTimeout(f.Timeout), f.Logger)
 	if err != nil {
 		return nil, err
 	}
@@ -227,8 +581,117 @@ func (f *DownlinkFunctions) Encode(payload map[string]interface{}, port uint8) (
 		return nil, err
 	}
 
-	if reflect.TypeOf(v).Kind() != reflect.Slice {
-		return nil, errors.NewErrInvalidArgument("Encoder", "does not return an Array")
+	return toEncodedDownlink(v)
+}
+
+// toEncodedDownlink converts the result of the Encoder, which is either an array of bytes or an object like
+// {bytes: [...], fPort: 12, confirmed: true}, to an EncodedDownlink
+func toEncodedDownlink(v interface{}) (*EncodedDownlink, error) {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		bytes, err := toBytes("Encoder", v)
+		if err != nil {
+			return nil, err
+		}
+		return &EncodedDownlink{Payload: bytes}, nil
+	}
+
+	var err error
+	encoded := new(EncodedDownlink)
+	if encoded.Payload, err = toBytes("Encoder bytes", m["bytes"]); err != nil {
+		return nil, err
+	}
+	if fPort, ok := m["fPort"]; ok && fPort != nil {
+		n, ok := toInteger(fPort)
+		if !ok || n < 1 || n > 223 {
+			return nil, errors.NewErrInvalidArgument("Encoder fPort", "should be an integer number between 1 and 223")
+		}
+		encoded.FPort = uint8(n)
+	}
+	if confirmed, ok := m["confirmed"]; ok && confirmed != nil {
+		b, ok := confirmed.(bool)
+		if !ok {
+			return nil, errors.NewErrInvalidArgument("Encoder confirmed", "should be a boolean")
+		}
+		encoded.Confirmed = &b
+	}
+	return encoded, nil
+}
+
+// Decode decodes the payload of a scheduled downlink message into a map using the Decoder function
+func (f *DownlinkFunctions) Decode(payload []byte, port uint8) (map[string]interface{}, error) {
+	if f.Decoder == "" {
+		return nil, errors.NewErrInvalidArgument("Downlink Payload", "no DownlinkDecoder function set")
+	}
+
+	env := map[string]interface{}{
+		"payload": payload,
+		"port":    port,
+	}
+	code := fmt.Sprintf(`
+		%s;
+		DownlinkDecoder(payload.slice(0), port);
+	`, f.Decoder)
+
+	value, err := functions.RunCachedCode(f.AppID, "DownlinkDecoder", code, env, orDefaultTimeout(f.Timeout), f.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	v, _ := value.Export()
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, errors.NewErrInvalidArgument("DownlinkDecoder", "does not return an object")
+	}
+	if err := f.OutputLimits.Check("DownlinkDecoder", m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// toInteger converts a number that was exported from JavaScript to an integer
+func toInteger(v interface{}) (n int64, ok bool) {
+	// type switch does not have fallthrough so we need
+	// to check every type individually
+	switch t := v.(type) {
+	case byte:
+		n = int64(t)
+	case int:
+		n = int64(t)
+	case int8:
+		n = int64(t)
+	case int16:
+		n = int64(t)
+	case uint16:
+		n = int64(t)
+	case int32:
+		n = int64(t)
+	case uint32:
+		n = int64(t)
+	case int64:
+		n = int64(t)
+	case uint64:
+		n = int64(t)
+	case float32:
+		n = int64(t)
+		if float32(n) != t {
+			return 0, false
+		}
+	case float64:
+		n = int64(t)
+		if float64(n) != t {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+	return n, true
+}
+
+// toBytes converts an Array that was exported from JavaScript to a byte slice
+func toBytes(function string, v interface{}) ([]byte, error) {
+	if v == nil || reflect.TypeOf(v).Kind() != reflect.Slice {
+		return nil, errors.NewErrInvalidArgument(function, "does not return an Array")
 	}
 
 	s := reflect.ValueOf(v)
@@ -236,47 +699,14 @@ func (f *DownlinkFunctions) Encode(payload map[string]interface{}, port uint8) (
 
 	res := make([]byte, l)
 
-	var n int64
 	for i := 0; i < l; i++ {
-		el := s.Index(i).Interface()
-
-		// type switch does not have fallthrough so we need
-		// to check every element individually
-		switch t := el.(type) {
-		case byte:
-			n = int64(t)
-		case int:
-			n = int64(t)
-		case int8:
-			n = int64(t)
-		case int16:
-			n = int64(t)
-		case uint16:
-			n = int64(t)
-		case int32:
-			n = int64(t)
-		case uint32:
-			n = int64(t)
-		case int64:
-			n = int64(t)
-		case uint64:
-			n = int64(t)
-		case float32:
-			n = int64(t)
-			if float32(n) != t {
-				return nil, errors.NewErrInvalidArgument("Encoder", "should return an Array of integer numbers")
-			}
-		case float64:
-			n = int64(t)
-			if float64(n) != t {
-				return nil, errors.NewErrInvalidArgument("Encoder", "should return an Array of integer numbers")
-			}
-		default:
-			return nil, errors.NewErrInvalidArgument("Encoder", "should return an Array of integer numbers")
+		n, ok := toInteger(s.Index(i).Interface())
+		if !ok {
+			return nil, errors.NewErrInvalidArgument(function, "should return an Array of integer numbers")
 		}
 
 		if n < 0 || n > 255 {
-			return nil, errors.NewErrInvalidArgument("Encoder Output", "Numbers in Array should be between 0 and 255")
+			return nil, errors.NewErrInvalidArgument(function+" Output", "Numbers in Array should be between 0 and 255")
 		}
 
 		res[i] = byte(n)
@@ -296,7 +726,7 @@ func (f *DownlinkFunctions) Process(payload map[string]interface{}, port uint8)
 }
 
 // ConvertFieldsDown converts the fields into a payload
-func (h *handler) ConvertFieldsDown(ctx ttnlog.Interface, appDown *types.DownlinkMessage, ttnDown *pb_broker.DownlinkMessage, _ *device.Device) error {
+func (h *handler) ConvertFieldsDown(ctx ttnlog.Interface, appDown *types.DownlinkMessage, ttnDown *pb_broker.DownlinkMessage, dev *device.Device) error {
 	if appDown.PayloadFields == nil || len(appDown.PayloadFields) == 0 {
 		return nil
 	}
@@ -309,18 +739,64 @@ func (h *handler) ConvertFieldsDown(ctx ttnlog.Interface, appDown *types.Downlin
 	if err != nil {
 		return nil
 	}
-
-	functions := &DownlinkFunctions{
-		Encoder: app.Encoder,
-		Logger:  functions.Ignore,
+	applyDevicePayloadFormat(app, dev)
+	if err := h.resolveCodec(app); err != nil {
+		return err
+	}
+	portFunctions, err := h.deviceFunctions(app, dev, appDown.FPort)
+	if err != nil {
+		return err
 	}
 
-	message, _, err := functions.Process(appDown.PayloadFields, appDown.FPort)
+	logger := h.functionLogger(appDown.AppID, appDown.DevID)
+	functions := h.downlinkFunctions(app, portFunctions, logger)
+
+	encoded, err := functions.EncodeDownlink(appDown.PayloadFields, appDown.FPort)
+	h.captureFunctionLogs(appDown.AppID, appDown.DevID, logger, err)
 	if err != nil {
 		return err
 	}
 
-	appDown.PayloadRaw = message
+	appDown.PayloadRaw = encoded.Payload
+	if encoded.FPort != 0 {
+		appDown.FPort = encoded.FPort
+	}
+	if encoded.Confirmed != nil {
+		appDown.Confirmed = *encoded.Confirmed
+	}
 
 	return nil
 }
+
+// decodedDownlink returns a copy of the downlink message with the fields that the DownlinkDecoder of the application
+// decoded from its payload, so that they can be added to the downlink events. It returns the message itself if it
+// already has fields, if the application has no DownlinkDecoder or if the payload could not be decoded.
+func (h *handler) decodedDownlink(appID, devID string, appDown *types.DownlinkMessage) *types.DownlinkMessage {
+	if len(appDown.PayloadRaw) == 0 || len(appDown.PayloadFields) > 0 {
+		return appDown
+	}
+	app, err := h.applications.Get(appID)
+	if err != nil || app.DownlinkDecoder == "" {
+		return appDown
+	}
+
+	logger := h.functionLogger(appID, devID)
+	functions := &DownlinkFunctions{
+		AppID:        app.AppID,
+		Decoder:      app.DownlinkDecoder,
+		Timeout:      h.functionTimeout(app.FunctionTimeout),
+		OutputLimits: outputLimits(app),
+		Logger:       logger,
+	}
+
+	fields, err := functions.Decode(appDown.PayloadRaw, appDown.FPort)
+	h.captureFunctionLogs(appID, devID, logger, err)
+	if err != nil {
+		h.Ctx.WithFields(ttnlog.Fields{"AppID": appID, "DevID": devID}).WithError(err).Debug("Could not decode downlink")
+		return appDown
+	}
+
+	decoded := *appDown
+	decoded.PayloadFields = fields
+	return &decoded
+}
@@ -9,8 +9,11 @@ import (
 	"time"
 
 	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
 
 	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/handler/functions"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	. "github.com/TheThingsNetwork/ttn/utils/testing"
 	. "github.com/smartystreets/assertions"
@@ -71,6 +74,14 @@ func TestConvertFieldsUp(t *testing.T) {
 	a.So(err, ShouldNotBeNil)
 	a.So(appUp.PayloadFields, ShouldBeEmpty)
 
+	a.So(len(h.mqttEvent), ShouldEqual, 1)
+	evt := <-h.mqttEvent
+	data, ok := evt.Data.(types.FunctionErrorEventData)
+	a.So(ok, ShouldBeTrue)
+	a.So(data.Function, ShouldEqual, "Validator")
+	a.So(data.Type, ShouldEqual, "invalid argument")
+	a.So(data.PayloadRaw, ShouldResemble, []byte{0x08, 0x70})
+
 	// Function error
 	app.StartUpdate()
 	app.Validator = `function Validator (data) { throw new Error("expected"); }`
@@ -81,12 +92,178 @@ func TestConvertFieldsUp(t *testing.T) {
 	a.So(appUp.PayloadFields, ShouldBeEmpty)
 
 	a.So(len(h.mqttEvent), ShouldEqual, 1)
-	evt := <-h.mqttEvent
-	data, ok := evt.Data.(types.ErrorEventData)
+	evt = <-h.mqttEvent
+	data, ok = evt.Data.(types.FunctionErrorEventData)
 	a.So(ok, ShouldBeTrue)
+	a.So(data.Function, ShouldEqual, "Validator")
+	a.So(data.Type, ShouldEqual, "internal")
+	a.So(data.FPort, ShouldEqual, 1)
 	fmt.Println(data.Error)
 }
 
+func TestConvertFieldsUpPortFunctions(t *testing.T) {
+	a := New(t)
+	appID := "AppID-1"
+
+	h := &handler{
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-convert-fields-up-port"),
+		mqttEvent:    make(chan *types.DeviceEvent, 1),
+	}
+
+	app := &application.Application{
+		AppID:   appID,
+		Decoder: `function Decoder (data) { return { temperature: ((data[0] << 8) | data[1]) / 100 }; }`,
+		PortFunctions: []application.PortFunctions{
+			{MinPort: 10, MaxPort: 20, Decoder: `function Decoder (data, port) { return { status: data[0], port: port }; }`},
+		},
+	}
+	a.So(h.applications.Set(app), ShouldBeNil)
+	defer func() {
+		h.applications.Delete(appID)
+	}()
+
+	ttnUp, appUp := buildConversionUplink(appID)
+	appUp.FPort = 15
+	err := h.ConvertFieldsUp(GetLogger(t, "TestConvertFieldsUpPortFunctions"), ttnUp, appUp, nil)
+	a.So(err, ShouldBeNil)
+	a.So(appUp.PayloadFields["status"], ShouldEqual, 8)
+	a.So(appUp.PayloadFields["port"], ShouldEqual, 15)
+
+	// Fall back to the default functions
+	ttnUp, appUp = buildConversionUplink(appID)
+	appUp.FPort = 21
+	err = h.ConvertFieldsUp(GetLogger(t, "TestConvertFieldsUpPortFunctions"), ttnUp, appUp, nil)
+	a.So(err, ShouldBeNil)
+	a.So(appUp.PayloadFields, ShouldResemble, map[string]interface{}{
+		"temperature": 21.6,
+	})
+}
+
+func TestConvertFieldsUpDevicePayloadFormat(t *testing.T) {
+	a := New(t)
+	appID := "AppID-1"
+
+	h := &handler{
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-convert-fields-up-device"),
+		mqttEvent:    make(chan *types.DeviceEvent, 1),
+	}
+
+	app := &application.Application{
+		AppID:        appID,
+		Decoder:      `function Decoder (data) { return { temperature: ((data[0] << 8) | data[1]) / 100 }; }`,
+		BinaryFields: []application.BinaryField{{Name: "raw", Type: "uint16"}},
+		PortFunctions: []application.PortFunctions{
+			{MinPort: 1, Decoder: `function Decoder (data, port) { return { port: port }; }`},
+		},
+	}
+	a.So(h.applications.Set(app), ShouldBeNil)
+	defer func() {
+		h.applications.Delete(appID)
+	}()
+
+	// The payload format of the device overrides the payload format and port functions of the application
+	dev := &device.Device{AppID: appID, DevID: "DevID-1", PayloadFormat: pb.PayloadFormatBinary}
+	ttnUp, appUp := buildConversionUplink(appID)
+	err := h.ConvertFieldsUp(GetLogger(t, "TestConvertFieldsUpDevicePayloadFormat"), ttnUp, appUp, dev)
+	a.So(err, ShouldBeNil)
+	a.So(appUp.PayloadFields["raw"], ShouldEqual, 2160)
+
+	// Other devices use the payload functions of the application
+	ttnUp, appUp = buildConversionUplink(appID)
+	err = h.ConvertFieldsUp(GetLogger(t, "TestConvertFieldsUpDevicePayloadFormat"), ttnUp, appUp, &device.Device{AppID: appID, DevID: "DevID-2"})
+	a.So(err, ShouldBeNil)
+	a.So(appUp.PayloadFields["port"], ShouldEqual, 1)
+}
+
+func TestConvertFieldsUpStatefulDecoding(t *testing.T) {
+	a := New(t)
+	appID := "AppID-1"
+
+	h := &handler{
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-convert-fields-up-stateful"),
+		mqttEvent:    make(chan *types.DeviceEvent, 1),
+	}
+
+	app := &application.Application{
+		AppID:            appID,
+		StatefulDecoding: true,
+		Decoder: `function Decoder (data, port, metadata) {
+			var total = data[0];
+			if (metadata.previous) total += metadata.previous.fields.total;
+			return { total: total };
+		}`,
+		Converter: `function Converter (decoded) { decoded.total = "converted"; return decoded; }`,
+	}
+	a.So(h.applications.Set(app), ShouldBeNil)
+	defer func() {
+		h.applications.Delete(appID)
+	}()
+
+	dev := &device.Device{AppID: appID, DevID: "DevID-1"}
+	decode := func(fCnt uint32, value byte) interface{} {
+		ttnUp, appUp := buildConversionUplink(appID)
+		appUp.FCnt = fCnt
+		appUp.PayloadRaw = []byte{value}
+		a.So(h.ConvertFieldsUp(GetLogger(t, "TestConvertFieldsUpStatefulDecoding"), ttnUp, appUp, dev), ShouldBeNil)
+		return dev.DecoderState.Last.Fields["total"]
+	}
+
+	a.So(decode(1, 10), ShouldEqual, 10)
+	a.So(decode(2, 5), ShouldEqual, 15)
+
+	// A retry is decoded with the same previous fields
+	a.So(decode(2, 5), ShouldEqual, 15)
+	a.So(dev.DecoderState.Previous.FCnt, ShouldEqual, 1)
+
+	// The state is removed when stateful decoding is disabled
+	app.StatefulDecoding = false
+	a.So(h.applications.Set(app), ShouldBeNil)
+	ttnUp, appUp := buildConversionUplink(appID)
+	a.So(h.ConvertFieldsUp(GetLogger(t, "TestConvertFieldsUpStatefulDecoding"), ttnUp, appUp, dev), ShouldBeNil)
+	a.So(dev.DecoderState, ShouldBeNil)
+}
+
+func TestFunctionMetadata(t *testing.T) {
+	a := New(t)
+
+	_, appUp := buildConversionUplink("AppID-1")
+	appUp.Metadata.Frequency = 868.1
+	appUp.Metadata.DataRate = "SF7BW125"
+	appUp.Metadata.Gateways = []types.GatewayMetadata{
+		{GtwID: "gtw-1", RSSI: -110, SNR: 2},
+		{GtwID: "gtw-2", RSSI: -90, SNR: -1},
+	}
+	dev := &device.Device{
+		AppEUI: types.AppEUI([8]byte{1, 2, 3, 4, 5, 6, 7, 8}),
+		DevEUI: types.DevEUI([8]byte{8, 7, 6, 5, 4, 3, 2, 1}),
+	}
+
+	functions := &UplinkFunctions{
+		Decoder: `function Decoder (payload, port, metadata) {
+  return { dev: metadata.dev_id, eui: metadata.dev_eui, gateways: metadata.gateway_count, rssi: metadata.rssi };
+}`,
+		Converter: `function Converter (fields, port, metadata) {
+  fields.data_rate = metadata.data_rate;
+  return fields;
+}`,
+		Metadata: functionMetadata(appUp, dev),
+	}
+	fields, valid, err := functions.Process(appUp.PayloadRaw, appUp.FPort)
+	a.So(err, ShouldBeNil)
+	a.So(valid, ShouldBeTrue)
+	a.So(fields["dev"], ShouldEqual, "DevID-1")
+	a.So(fields["eui"], ShouldEqual, "0807060504030201")
+	a.So(fields["gateways"], ShouldEqual, 2)
+	a.So(fields["rssi"], ShouldEqual, -90)
+	a.So(fields["data_rate"], ShouldEqual, "SF7BW125")
+
+	// Without metadata
+	functions.Metadata = nil
+	fields, _, err = functions.Process(appUp.PayloadRaw, appUp.FPort)
+	a.So(err, ShouldBeNil)
+	a.So(fields["dev"], ShouldBeNil)
+}
+
 func TestDecode(t *testing.T) {
 	a := New(t)
 
@@ -181,6 +358,41 @@ func TestProcessUplink(t *testing.T) {
 	a.So(data["humidity"], ShouldEqual, 110)
 }
 
+func TestProcessUplinkOutputLimits(t *testing.T) {
+	a := New(t)
+
+	up := &UplinkFunctions{
+		Decoder: `function Decoder (payload) {
+	return {
+		temperature: payload[0],
+		humidity: payload[1],
+		location: { latitude: 52.37, longitude: 4.89 }
+	}
+}`,
+	}
+
+	_, _, err := up.Process([]byte{40, 110}, 1)
+	a.So(err, ShouldBeNil)
+
+	up.OutputLimits = functions.OutputLimits{Fields: 3}
+	_, _, err = up.Process([]byte{40, 110}, 1)
+	a.So(functions.IsOutputLimitExceeded(err), ShouldBeTrue)
+	a.So(err.Error(), ShouldContainSubstring, "Decoder")
+
+	up.OutputLimits = functions.OutputLimits{Depth: 1}
+	_, _, err = up.Process([]byte{40, 110}, 1)
+	a.So(functions.IsOutputLimitExceeded(err), ShouldBeTrue)
+
+	up.Converter = `function Converter (data) {
+	data.history = [data.temperature, data.temperature, data.temperature, data.temperature, data.temperature];
+	return data;
+}`
+	up.OutputLimits = functions.OutputLimits{Size: 100}
+	_, _, err = up.Process([]byte{40, 110}, 1)
+	a.So(functions.IsOutputLimitExceeded(err), ShouldBeTrue)
+	a.So(err.Error(), ShouldContainSubstring, "Converter")
+}
+
 func TestProcessInvalidUplinkFunction(t *testing.T) {
 	a := New(t)
 
@@ -288,6 +500,36 @@ func TestTimeoutExceeded(t *testing.T) {
 	a.So(interrupted, ShouldHaveLength, 1)
 }
 
+func TestFunctionTimeout(t *testing.T) {
+	a := New(t)
+
+	h := &handler{}
+	a.So(h.functionTimeout(0), ShouldEqual, timeOut)
+	a.So(h.functionTimeout(500*time.Millisecond), ShouldEqual, 500*time.Millisecond)
+	a.So(h.functionTimeout(time.Minute), ShouldEqual, DefaultMaxFunctionTimeout)
+
+	h.WithMaxFunctionTimeout(50 * time.Millisecond)
+	a.So(h.functionTimeout(0), ShouldEqual, 50*time.Millisecond)
+}
+
+func TestCustomTimeoutExceeded(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+
+	a := New(t)
+	start := time.Now()
+
+	functions := &UplinkFunctions{
+		Decoder: `function(payload){ while (true) { } }`,
+		Timeout: 300 * time.Millisecond,
+	}
+
+	_, _, err := functions.Process([]byte{0}, 1)
+	a.So(err, ShouldNotBeNil)
+	a.So(time.Since(start), ShouldBeGreaterThanOrEqualTo, 300*time.Millisecond)
+}
+
 func TestEncode(t *testing.T) {
 	a := New(t)
 
@@ -315,6 +557,84 @@ func TestEncode(t *testing.T) {
 	a.So(err, ShouldBeNil)
 }
 
+func TestEncodeDownlink(t *testing.T) {
+	a := New(t)
+
+	// Array of bytes
+	functions := &DownlinkFunctions{
+		Encoder: `function Encoder(payload, port) { return [1, 2, 3]; }`,
+	}
+	encoded, err := functions.EncodeDownlink(map[string]interface{}{"led": true}, 1)
+	a.So(err, ShouldBeNil)
+	a.So(encoded.Payload, ShouldResemble, []byte{1, 2, 3})
+	a.So(encoded.FPort, ShouldEqual, 0)
+	a.So(encoded.Confirmed, ShouldBeNil)
+
+	// Object with bytes, fPort and confirmed
+	functions = &DownlinkFunctions{
+		Encoder: `function Encoder(payload, port) { return { bytes: [payload.led ? 1 : 0], fPort: 12, confirmed: true }; }`,
+	}
+	encoded, err = functions.EncodeDownlink(map[string]interface{}{"led": true}, 1)
+	a.So(err, ShouldBeNil)
+	a.So(encoded.Payload, ShouldResemble, []byte{1})
+	a.So(encoded.FPort, ShouldEqual, 12)
+	a.So(*encoded.Confirmed, ShouldBeTrue)
+
+	// Encode only returns the bytes
+	bytes, err := functions.Encode(map[string]interface{}{"led": false}, 1)
+	a.So(err, ShouldBeNil)
+	a.So(bytes, ShouldResemble, []byte{0})
+
+	// Object with only bytes
+	functions = &DownlinkFunctions{
+		Encoder: `function Encoder(payload, port) { return { bytes: [1] }; }`,
+	}
+	encoded, err = functions.EncodeDownlink(map[string]interface{}{"led": true}, 1)
+	a.So(err, ShouldBeNil)
+	a.So(encoded.FPort, ShouldEqual, 0)
+	a.So(encoded.Confirmed, ShouldBeNil)
+
+	// Object without bytes
+	functions = &DownlinkFunctions{
+		Encoder: `function Encoder(payload, port) { return { fPort: 2 }; }`,
+	}
+	_, err = functions.EncodeDownlink(map[string]interface{}{"led": true}, 1)
+	a.So(err, ShouldNotBeNil)
+
+	// Invalid fPort
+	functions = &DownlinkFunctions{
+		Encoder: `function Encoder(payload, port) { return { bytes: [1], fPort: 224 }; }`,
+	}
+	_, err = functions.EncodeDownlink(map[string]interface{}{"led": true}, 1)
+	a.So(err, ShouldNotBeNil)
+
+	// Invalid confirmed
+	functions = &DownlinkFunctions{
+		Encoder: `function Encoder(payload, port) { return { bytes: [1], confirmed: "yes" }; }`,
+	}
+	_, err = functions.EncodeDownlink(map[string]interface{}{"led": true}, 1)
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestDecodeDownlink(t *testing.T) {
+	a := New(t)
+
+	functions := &DownlinkFunctions{}
+	_, err := functions.Decode([]byte{1, 2}, 1)
+	a.So(err, ShouldNotBeNil)
+
+	functions.Decoder = `function DownlinkDecoder(bytes, port) { return { led: bytes[0] === 1, interval: bytes[1], port: port }; }`
+	fields, err := functions.Decode([]byte{1, 60}, 2)
+	a.So(err, ShouldBeNil)
+	a.So(fields["led"], ShouldBeTrue)
+	a.So(fields["interval"], ShouldEqual, 60)
+	a.So(fields["port"], ShouldEqual, 2)
+
+	functions.Decoder = `function DownlinkDecoder(bytes, port) { return bytes; }`
+	_, err = functions.Decode([]byte{1, 60}, 2)
+	a.So(err, ShouldNotBeNil)
+}
+
 func buildConversionDownlink() (*pb_broker.DownlinkMessage, *types.DownlinkMessage) {
 	appEUI := types.AppEUI([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
 	devEUI := types.DevEUI([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
@@ -362,6 +682,23 @@ func TestConvertFieldsDown(t *testing.T) {
 	err = h.ConvertFieldsDown(GetLogger(t, "TestConvertFieldsDown"), appDown, ttnDown, nil)
 	a.So(err, ShouldBeNil)
 	a.So(appDown.PayloadRaw, ShouldResemble, []byte{byte(appDown.FPort), 1, 2, 3, 4, 5, 6, 7})
+
+	// Case3: Encoder chooses the port and confirmation
+	h.applications.Set(&application.Application{
+		AppID:   "AppID-2",
+		Encoder: `function Encoder (payload, port){ return { bytes: [ port ], fPort: 12, confirmed: true } }`,
+	})
+	defer func() {
+		h.applications.Delete("AppID-2")
+	}()
+
+	ttnDown, appDown = buildConversionDownlink()
+	appDown.AppID = "AppID-2"
+	err = h.ConvertFieldsDown(GetLogger(t, "TestConvertFieldsDown"), appDown, ttnDown, nil)
+	a.So(err, ShouldBeNil)
+	a.So(appDown.PayloadRaw, ShouldResemble, []byte{1})
+	a.So(appDown.FPort, ShouldEqual, 12)
+	a.So(appDown.Confirmed, ShouldBeTrue)
 }
 
 func TestConvertFieldsDownNoPort(t *testing.T) {
@@ -469,3 +806,30 @@ func TestEncodeCharCode(t *testing.T) {
 
 	fmt.Println("VALUE", val)
 }
+
+func BenchmarkDecode(b *testing.B) {
+	functions := &UplinkFunctions{
+		Decoder: `function Decoder (data) { return { temperature: ((data[0] << 8) | data[1]) / 100 }; }`,
+	}
+	for n := 0; n < b.N; n++ {
+		functions.Decode([]byte{0x08, 0x70}, 1)
+	}
+}
+
+func BenchmarkConvert(b *testing.B) {
+	functions := &UplinkFunctions{
+		Converter: `function Converter (data) { data.temperature = data.temperature * 9 / 5 + 32; return data; }`,
+	}
+	for n := 0; n < b.N; n++ {
+		functions.Convert(map[string]interface{}{"temperature": 21.6}, 1)
+	}
+}
+
+func BenchmarkValidate(b *testing.B) {
+	functions := &UplinkFunctions{
+		Validator: `function Validator (data) { return data.temperature < 100; }`,
+	}
+	for n := 0; n < b.N; n++ {
+		functions.Validate(map[string]interface{}{"temperature": 21.6}, 1)
+	}
+}
@@ -72,6 +72,7 @@ func (h *handler) ConvertFromLoRaWAN(ctx ttnlog.Interface, ttnUp *pb_broker.Dedu
 	if dev.CurrentDownlink != nil && !appUp.IsRetry {
 		// We have a downlink pending
 		if dev.CurrentDownlink.Confirmed {
+			h.trackDownlinkDelivery(dev, macPayload.FHDR.FCtrl.ACK)
 			// If it's confirmed, we can only unset it if we receive an ack.
 			if macPayload.FHDR.FCtrl.ACK {
 				// Send event over MQTT
@@ -91,6 +92,26 @@ func (h *handler) ConvertFromLoRaWAN(ctx ttnlog.Interface, ttnUp *pb_broker.Dedu
 		}
 	}
 
+	if change := ttnUp.AdrChange; change != nil {
+		// Send event over MQTT
+		h.mqttEvent <- &types.DeviceEvent{
+			AppID: appUp.AppID,
+			DevID: appUp.DevID,
+			Event: types.ADREvent,
+			Data: types.ADREventData{
+				OldDataRate: change.OldDataRate,
+				NewDataRate: change.NewDataRate,
+				OldTxPower:  int(change.OldTxPower),
+				NewTxPower:  int(change.NewTxPower),
+				OldNbTrans:  uint(change.OldNbTrans),
+				NewNbTrans:  uint(change.NewNbTrans),
+				Reason:      change.Reason,
+				SNRMargin:   change.SnrMargin,
+				Accepted:    change.Accepted,
+			},
+		}
+	}
+
 	return nil
 }
 
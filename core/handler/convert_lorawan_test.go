@@ -57,6 +57,14 @@ func TestConvertFromLoRaWAN(t *testing.T) {
 	a.So(err, ShouldBeNil)
 	a.So(appUp.Confirmed, ShouldBeTrue)
 
+	ttnUp.AdrChange = &pb_lorawan.ADRChange{OldDataRate: "SF12BW125", NewDataRate: "SF9BW125", Reason: "snr-margin", Accepted: true}
+	err = h.ConvertFromLoRaWAN(h.Ctx, ttnUp, appUp, device)
+	a.So(err, ShouldBeNil)
+	a.So(h.mqttEvent, ShouldHaveLength, 1)
+	evt := <-h.mqttEvent
+	a.So(evt.Event, ShouldEqual, types.ADREvent)
+	a.So(evt.Data.(types.ADREventData).NewDataRate, ShouldEqual, "SF9BW125")
+	a.So(evt.Data.(types.ADREventData).Accepted, ShouldBeTrue)
 }
 
 func buildLorawanDownlink(payload []byte) (*types.DownlinkMessage, *pb_broker.DownlinkMessage) {
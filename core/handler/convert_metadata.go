@@ -23,6 +23,9 @@ func (h *handler) ConvertMetadata(ctx ttnlog.Interface, ttnUp *pb_broker.Dedupli
 		appUp.Metadata.CodingRate = lorawan.CodingRate
 	}
 
+	// Attributes that the Broker added
+	appUp.Metadata.Attributes = ttnUp.Attributes
+
 	// Transform Gateway Metadata
 	appUp.Metadata.Gateways = make([]types.GatewayMetadata, 0, len(ttnUp.GatewayMetadata))
 	for i, in := range ttnUp.GatewayMetadata {
@@ -49,6 +52,22 @@ func (h *handler) ConvertMetadata(ctx ttnlog.Interface, ttnUp *pb_broker.Dedupli
 			gatewayMetadata.Latitude = gps.Latitude
 		}
 
+		for _, antenna := range in.Antennas {
+			antennaMetadata := types.AntennaMetadata{
+				Antenna: antenna.Antenna,
+				Channel: antenna.Channel,
+				RSSI:    antenna.Rssi,
+				SNR:     antenna.Snr,
+				Gain:    antenna.Gain,
+			}
+			if gps := antenna.GetGps(); gps != nil {
+				antennaMetadata.Altitude = gps.Altitude
+				antennaMetadata.Longitude = gps.Longitude
+				antennaMetadata.Latitude = gps.Latitude
+			}
+			gatewayMetadata.Antennas = append(gatewayMetadata.Antennas, antennaMetadata)
+		}
+
 		appUp.Metadata.Gateways = append(appUp.Metadata.Gateways, gatewayMetadata)
 	}
 
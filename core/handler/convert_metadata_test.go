@@ -58,6 +58,12 @@ func TestConvertMetadata(t *testing.T) {
 	a.So(err, ShouldBeNil)
 	a.So(appUp.Metadata.DataRate, ShouldEqual, "SF7BW125")
 
+	ttnUp.Attributes = map[string]string{"region": "eu"}
+
+	err = h.ConvertMetadata(h.Ctx, ttnUp, appUp, device)
+	a.So(err, ShouldBeNil)
+	a.So(appUp.Metadata.Attributes, ShouldResemble, map[string]string{"region": "eu"})
+
 	ttnUp.GatewayMetadata[0].Time = 1465831736000000000
 	ttnUp.GatewayMetadata[0].Gps = &pb_gateway.GPSMetadata{
 		Latitude: 42,
@@ -68,4 +74,18 @@ func TestConvertMetadata(t *testing.T) {
 	a.So(appUp.Metadata.Gateways[0].Latitude, ShouldEqual, 42)
 	a.So(time.Time(appUp.Metadata.Gateways[0].Time).UTC(), ShouldResemble, time.Date(2016, 06, 13, 15, 28, 56, 0, time.UTC))
 
+	ttnUp.GatewayMetadata[1].Antennas = []*pb_gateway.RxMetadata_Antenna{
+		{Antenna: 0, Rssi: -100, Snr: 5, Gain: 3},
+		{Antenna: 1, Rssi: -90, Snr: 7, Gps: &pb_gateway.GPSMetadata{Latitude: 52}},
+	}
+
+	err = h.ConvertMetadata(h.Ctx, ttnUp, appUp, device)
+	a.So(err, ShouldBeNil)
+	a.So(appUp.Metadata.Gateways[0].Antennas, ShouldBeEmpty)
+	a.So(appUp.Metadata.Gateways[1].Antennas, ShouldHaveLength, 2)
+	a.So(appUp.Metadata.Gateways[1].Antennas[0].RSSI, ShouldEqual, -100)
+	a.So(appUp.Metadata.Gateways[1].Antennas[0].Gain, ShouldEqual, 3)
+	a.So(appUp.Metadata.Gateways[1].Antennas[1].SNR, ShouldEqual, 7)
+	a.So(appUp.Metadata.Gateways[1].Antennas[1].Latitude, ShouldEqual, 52)
+
 }
@@ -0,0 +1,63 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// WithRegion sets the region that the Handler is deployed in. Applications with a data residency in another region
+// are refused, and their traffic is dropped.
+func (h *handler) WithRegion(region string) Handler {
+	h.region = region
+	return h
+}
+
+// checkDataResidency returns an error if the data of the application with the data residency can not be processed by
+// this Handler
+func (h *handler) checkDataResidency(dataResidency string) error {
+	if dataResidency == "" || dataResidency == h.region {
+		return nil
+	}
+	region := h.region
+	if region == "" {
+		region = "no region"
+	}
+	return errors.NewErrPermissionDenied(fmt.Sprintf("Data of the application must stay in region %s, but this Handler is deployed in %s", dataResidency, region))
+}
+
+// logDataResidency logs a change of the data residency of an application or traffic that was refused because of the
+// data residency, so that data residency can be audited
+func (h *handler) logDataResidency(appID, devID, dataResidency, action string) {
+	h.Ctx.WithFields(ttnlog.Fields{
+		"AppID":         appID,
+		"DevID":         devID,
+		"DataResidency": dataResidency,
+		"Region":        h.region,
+		"Action":        action,
+		"At":            time.Now().UTC().Format(time.RFC3339Nano),
+	}).Info("Data residency audit")
+}
+
+// EnforceDataResidency drops uplink messages of applications with a data residency in another region than the region
+// of the Handler, before they are stored or published to integrations
+func (h *handler) EnforceDataResidency(ctx ttnlog.Interface, _ *pb_broker.DeduplicatedUplinkMessage, appUp *types.UplinkMessage, _ *device.Device) error {
+	app, err := h.applications.Get(appUp.AppID)
+	if err != nil {
+		return nil
+	}
+	if err := h.checkDataResidency(app.DataResidency); err != nil {
+		ctx.WithError(err).Warn("Dropped uplink because of data residency")
+		h.logDataResidency(appUp.AppID, appUp.DevID, app.DataResidency, "drop uplink")
+		return ErrNotNeeded
+	}
+	return nil
+}
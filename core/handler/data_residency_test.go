@@ -0,0 +1,56 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestCheckDataResidency(t *testing.T) {
+	a := New(t)
+	h := &handler{}
+	a.So(h.checkDataResidency(""), ShouldBeNil)
+	a.So(h.checkDataResidency("eu"), ShouldNotBeNil)
+
+	h.WithRegion("eu")
+	a.So(h.checkDataResidency(""), ShouldBeNil)
+	a.So(h.checkDataResidency("eu"), ShouldBeNil)
+	a.So(h.checkDataResidency("us"), ShouldNotBeNil)
+}
+
+func TestEnforceDataResidency(t *testing.T) {
+	a := New(t)
+	appID := "AppID-1"
+
+	h := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestEnforceDataResidency")},
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-data-residency"),
+		region:       "eu",
+	}
+	appUp := &types.UplinkMessage{AppID: appID, DevID: "DevID-1"}
+
+	// Applications that do not exist are handled elsewhere
+	a.So(h.EnforceDataResidency(h.Ctx, nil, appUp, nil), ShouldBeNil)
+
+	app := &application.Application{AppID: appID}
+	a.So(h.applications.Set(app), ShouldBeNil)
+	defer func() {
+		h.applications.Delete(appID)
+	}()
+	a.So(h.EnforceDataResidency(h.Ctx, nil, appUp, nil), ShouldBeNil)
+
+	app.DataResidency = "eu"
+	a.So(h.applications.Set(app), ShouldBeNil)
+	a.So(h.EnforceDataResidency(h.Ctx, nil, appUp, nil), ShouldBeNil)
+
+	app.DataResidency = "us"
+	a.So(h.applications.Set(app), ShouldBeNil)
+	a.So(h.EnforceDataResidency(h.Ctx, nil, appUp, nil), ShouldEqual, ErrNotNeeded)
+}
@@ -0,0 +1,236 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/api/trace"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/handler/functions"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// MaxDebugDuration is the maximum time that the debug mode of a device can be enabled
+var MaxDebugDuration = 24 * time.Hour
+
+// debugDevices keeps track of the devices that are in debug mode, so that the debug mode can be checked without
+// getting the device from the store
+type debugDevices struct {
+	sync.RWMutex
+	until map[string]time.Time
+}
+
+func (d *debugDevices) set(appID, devID string, until time.Time) {
+	d.Lock()
+	defer d.Unlock()
+	if d.until == nil {
+		d.until = make(map[string]time.Time)
+	}
+	if until.After(time.Now()) {
+		d.until[appID+":"+devID] = until
+	} else {
+		delete(d.until, appID+":"+devID)
+	}
+}
+
+func (d *debugDevices) enabled(appID, devID string) bool {
+	d.RLock()
+	defer d.RUnlock()
+	until, ok := d.until[appID+":"+devID]
+	return ok && until.After(time.Now())
+}
+
+// captureDebug adds an entry to the debug trace of the device if it is in debug mode
+func (h *handler) captureDebug(appID, devID, entryType, message string, metadata map[string]string) {
+	if !h.debugging.enabled(appID, devID) {
+		return
+	}
+	ctx := h.Ctx.WithField("AppID", appID).WithField("DevID", devID)
+	debugTrace, err := h.devices.DebugTrace(appID, devID)
+	if err != nil {
+		ctx.WithError(err).Warn("Could not get debug trace")
+		return
+	}
+	err = debugTrace.Add(&device.DebugTraceEntry{
+		Time:     time.Now(),
+		Type:     entryType,
+		Message:  message,
+		Metadata: metadata,
+	})
+	if err != nil {
+		ctx.WithError(err).Warn("Could not add to debug trace")
+	}
+}
+
+// captureDebugTrace adds the events of the trace of a message to the debug trace of the device if it is in debug
+// mode. This includes the events of the Router, Broker and NetworkServer, such as handled MAC commands.
+func (h *handler) captureDebugTrace(appID, devID string, t *trace.Trace) {
+	if t == nil || !h.debugging.enabled(appID, devID) {
+		return
+	}
+	for _, event := range t.Flatten() {
+		metadata := map[string]string{"service_name": event.ServiceName, "service_id": event.ServiceId}
+		for k, v := range event.Metadata {
+			metadata[k] = v
+		}
+		h.captureDebug(appID, devID, device.DebugTraceEvent, event.Event, metadata)
+	}
+}
+
+// functionLogger returns a logger that keeps the logs of payload functions if the device is in debug mode
+func (h *handler) functionLogger(appID, devID string) functions.Logger {
+	if h.debugging.enabled(appID, devID) {
+		return functions.NewEntryLogger()
+	}
+	return functions.Ignore
+}
+
+// captureFunctionLogs adds the logs and the error of payload functions to the debug trace of the device, and publishes
+// them in a function log event
+func (h *handler) captureFunctionLogs(appID, devID string, logger functions.Logger, err error) {
+	entryLogger, ok := logger.(*functions.EntryLogger)
+	if !ok {
+		return
+	}
+	data := types.FunctionLogEventData{Logs: make([]types.FunctionLogEntry, 0, len(entryLogger.Logs))}
+	for _, entry := range entryLogger.Logs {
+		h.captureDebug(appID, devID, device.DebugTraceFunctionLog, strings.Join(entry.Fields, " "), map[string]string{
+			"function": entry.Function,
+		})
+		data.Logs = append(data.Logs, types.FunctionLogEntry{Function: entry.Function, Fields: entry.Fields})
+	}
+	if err != nil {
+		h.captureDebug(appID, devID, device.DebugTraceFunctionLog, "Payload function failed", map[string]string{
+			"error": err.Error(),
+		})
+		data.Error = err.Error()
+	}
+	if len(data.Logs) == 0 && err == nil {
+		return
+	}
+	h.mqttEvent <- &types.DeviceEvent{
+		AppID: appID,
+		DevID: devID,
+		Event: types.FunctionLogEvent,
+		Data:  data,
+	}
+}
+
+// captureDelivery adds the result of publishing an uplink message to an integration to the debug trace of the device
+func (h *handler) captureDelivery(appID, devID, integration string, err error) {
+	if err != nil {
+		h.captureDebug(appID, devID, device.DebugTraceIntegration, "Could not publish uplink", map[string]string{
+			"integration": integration,
+			"error":       err.Error(),
+		})
+		return
+	}
+	h.captureDebug(appID, devID, device.DebugTraceIntegration, "Published uplink", map[string]string{
+		"integration": integration,
+	})
+}
+
+func (h *handlerManager) SetDeviceDebugMode(ctx context.Context, in *pb.DeviceDebugModeRequest) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Debug Mode Request")
+	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.Devices)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := time.Duration(in.Duration) * time.Second
+	if duration > MaxDebugDuration {
+		return nil, errors.NewErrInvalidArgument("Duration", fmt.Sprintf("can not be longer than %s", MaxDebugDuration))
+	}
+
+	dev, err := h.handler.devices.Get(in.AppId, in.DevId)
+	if err != nil {
+		return nil, err
+	}
+	dev.StartUpdate()
+
+	if duration > 0 {
+		// Start a new trace when the debug mode is enabled
+		if !dev.DebugUntil.After(time.Now()) {
+			debugTrace, err := h.handler.devices.DebugTrace(in.AppId, in.DevId)
+			if err != nil {
+				return nil, err
+			}
+			if err := debugTrace.Clear(); err != nil {
+				return nil, err
+			}
+		}
+		dev.DebugUntil = time.Now().Add(duration)
+	} else {
+		dev.DebugUntil = time.Time{}
+	}
+	if err := h.handler.devices.Set(dev); err != nil {
+		return nil, err
+	}
+	h.handler.debugging.set(in.AppId, in.DevId, dev.DebugUntil)
+
+	return &empty.Empty{}, nil
+}
+
+func (h *handlerManager) GetDeviceDebugTrace(ctx context.Context, in *pb.DeviceIdentifier) (*pb.DeviceDebugTrace, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Identifier")
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.Devices)
+	if err != nil {
+		return nil, err
+	}
+
+	dev, err := h.handler.devices.Get(in.AppId, in.DevId)
+	if err != nil {
+		return nil, err
+	}
+	debugTrace, err := h.handler.devices.DebugTrace(in.AppId, in.DevId)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := debugTrace.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.DeviceDebugTrace{
+		AppId:   in.AppId,
+		DevId:   in.DevId,
+		Entries: make([]*pb.DebugTraceEntry, 0, len(entries)),
+	}
+	if dev.DebugUntil.After(time.Now()) {
+		res.DebugUntil = dev.DebugUntil.UnixNano()
+	}
+	for _, entry := range entries {
+		res.Entries = append(res.Entries, &pb.DebugTraceEntry{
+			Time:     entry.Time.UnixNano(),
+			Type:     entry.Type,
+			Message:  entry.Message,
+			Metadata: entry.Metadata,
+		})
+	}
+	return res, nil
+}
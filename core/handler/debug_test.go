@@ -0,0 +1,77 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/api/trace"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestCaptureDebug(t *testing.T) {
+	a := New(t)
+	appID := "app1"
+	devID := "dev1"
+	h := &handler{
+		Component: &component.Component{Ctx: GetLogger(t, "TestCaptureDebug")},
+		devices:   device.NewRedisDeviceStore(GetRedisClient(), "handler-test-capture-debug"),
+		mqttEvent: make(chan *types.DeviceEvent, 1),
+	}
+	h.devices.Set(&device.Device{
+		AppID: appID,
+		DevID: devID,
+	})
+	defer func() {
+		h.devices.Delete(appID, devID)
+	}()
+	debugTrace, _ := h.devices.DebugTrace(appID, devID)
+
+	// Nothing is captured if the device is not in debug mode
+	h.captureDebug(appID, devID, device.DebugTraceIntegration, "Published uplink", nil)
+	entries, _ := debugTrace.Get()
+	a.So(entries, ShouldBeEmpty)
+
+	h.debugging.set(appID, devID, time.Now().Add(time.Hour))
+	a.So(h.debugging.enabled(appID, devID), ShouldBeTrue)
+	a.So(h.debugging.enabled(appID, "other"), ShouldBeFalse)
+
+	h.captureDelivery(appID, devID, "mqtt", nil)
+	var uplinkTrace *trace.Trace
+	uplinkTrace = uplinkTrace.WithEvent(trace.ReceiveEvent).WithEvent(trace.HandleMACEvent, "command", "LinkCheckReq")
+	h.captureDebugTrace(appID, devID, uplinkTrace)
+	entries, _ = debugTrace.Get()
+	a.So(entries, ShouldHaveLength, 3)
+	a.So(entries[0].Type, ShouldEqual, device.DebugTraceEvent)
+	a.So(entries[0].Message, ShouldEqual, trace.HandleMACEvent)
+	a.So(entries[0].Metadata["command"], ShouldEqual, "LinkCheckReq")
+	a.So(entries[2].Type, ShouldEqual, device.DebugTraceIntegration)
+	a.So(entries[2].Metadata["integration"], ShouldEqual, "mqtt")
+
+	logger := h.functionLogger(appID, devID)
+	logger.Enter("Decoder")
+	logger.Log([]string{`"temperature"`, "21.5"})
+	h.captureFunctionLogs(appID, devID, logger, nil)
+	entries, _ = debugTrace.Get()
+	a.So(entries, ShouldHaveLength, 4)
+	a.So(entries[0].Type, ShouldEqual, device.DebugTraceFunctionLog)
+	a.So(len(h.mqttEvent), ShouldEqual, 1)
+	evt := <-h.mqttEvent
+	a.So(evt.Event, ShouldEqual, types.FunctionLogEvent)
+	data := evt.Data.(types.FunctionLogEventData)
+	a.So(data.Logs, ShouldHaveLength, 1)
+	a.So(data.Logs[0].Function, ShouldEqual, "Decoder")
+
+	// Nothing is published if the functions did not log
+	h.captureFunctionLogs(appID, devID, h.functionLogger(appID, devID), nil)
+	a.So(len(h.mqttEvent), ShouldEqual, 0)
+
+	h.debugging.set(appID, devID, time.Now().Add(-1*time.Second))
+	a.So(h.debugging.enabled(appID, devID), ShouldBeFalse)
+}
@@ -0,0 +1,15 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import "github.com/TheThingsNetwork/ttn/core/handler/application"
+
+// devAddrAllocation returns the strategy that the NetworkServer uses to allocate a DevAddr when devices of the
+// application join. Applications that did not choose a strategy use the default of the Handler.
+func (h *handler) devAddrAllocation(app *application.Application) string {
+	if app.DevAddrAllocation != "" {
+		return app.DevAddrAllocation
+	}
+	return h.defaultDevAddrAllocation
+}
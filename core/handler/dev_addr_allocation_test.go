@@ -0,0 +1,26 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestDevAddrAllocation(t *testing.T) {
+	a := New(t)
+	h := &handler{}
+	app := &application.Application{AppID: "app"}
+
+	a.So(h.devAddrAllocation(app), ShouldEqual, "")
+
+	h.WithDevAddrAllocation(pb_lorawan.DevAddrAllocationSequential)
+	a.So(h.devAddrAllocation(app), ShouldEqual, pb_lorawan.DevAddrAllocationSequential)
+
+	app.DevAddrAllocation = pb_lorawan.DevAddrAllocationSticky
+	a.So(h.devAddrAllocation(app), ShouldEqual, pb_lorawan.DevAddrAllocationSticky)
+}
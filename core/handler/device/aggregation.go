@@ -0,0 +1,33 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+)
+
+// Aggregation contains the state of the current aggregation window of a device
+type Aggregation struct {
+	Start  time.Time            `json:"start"`
+	Count  uint32               `json:"count"`
+	Sums   map[string]float64   `json:"sums"`
+	Counts map[string]uint32    `json:"counts"`
+	Last   *types.UplinkMessage `json:"last"`
+}
+
+// Copy returns a deep copy of the aggregation, so that changes are detected when the device is saved
+func (a *Aggregation) Copy() *Aggregation {
+	copy := *a
+	copy.Sums = make(map[string]float64, len(a.Sums))
+	for field, sum := range a.Sums {
+		copy.Sums[field] = sum
+	}
+	copy.Counts = make(map[string]uint32, len(a.Counts))
+	for field, count := range a.Counts {
+		copy.Counts[field] = count
+	}
+	return &copy
+}
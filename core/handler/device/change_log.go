@@ -0,0 +1,95 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/storage"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// ChangeLogLength is the maximum number of device registry changes that is stored for each application
+var ChangeLogLength = 1000
+
+// Change is a change in the device registry of an application
+type Change struct {
+	ID    string          `json:"id"`
+	Type  types.EventType `json:"type"`
+	DevID string          `json:"dev_id"`
+	Time  time.Time       `json:"time"`
+}
+
+// ChangeLog stores the last changes in the device registry of an application
+type ChangeLog interface {
+	// Add a change to the log. The oldest change is removed if the log is full.
+	Add(change *Change) error
+	// Get the changes in the log, newest first
+	Get() ([]*Change, error)
+	// Since returns the changes after the change with the given ID (empty for all changes), oldest first
+	Since(id string) ([]*Change, error)
+}
+
+// RedisChangeLog implements the change log in Redis
+type RedisChangeLog struct {
+	appID   string
+	changes *storage.RedisQueueStore
+}
+
+// Add a change to the log
+func (s *RedisChangeLog) Add(change *Change) error {
+	data, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+	if err := s.changes.AddFront(s.appID, string(data)); err != nil {
+		return err
+	}
+	return s.changes.Trim(s.appID, ChangeLogLength)
+}
+
+// Get the changes in the log
+func (s *RedisChangeLog) Get() ([]*Change, error) {
+	stored, err := s.changes.Get(s.appID)
+	if err != nil {
+		return nil, err
+	}
+	changes := make([]*Change, 0, len(stored))
+	for _, data := range stored {
+		change := new(Change)
+		if err := json.Unmarshal([]byte(data), change); err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// Since returns the changes after the change with the given ID
+func (s *RedisChangeLog) Since(id string) ([]*Change, error) {
+	changes, err := s.Get()
+	if err != nil {
+		return nil, err
+	}
+	newer := len(changes)
+	if id != "" {
+		newer = -1
+		for i, change := range changes {
+			if change.ID == id {
+				newer = i
+				break
+			}
+		}
+		if newer < 0 {
+			return nil, errors.NewErrNotFound("Change " + id)
+		}
+	}
+	since := make([]*Change, 0, newer)
+	for i := newer - 1; i >= 0; i-- {
+		since = append(since, changes[i])
+	}
+	return since, nil
+}
@@ -0,0 +1,71 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestChangeLog(t *testing.T) {
+	a := New(t)
+
+	client := GetRedisClient()
+	store := NewRedisDeviceStore(client, "handler-test-change-log")
+	s, _ := store.ChangeLog("test")
+
+	defer func() {
+		client.Del("handler-test-change-log:changes:test")
+	}()
+
+	{
+		changes, err := s.Since("")
+		a.So(err, ShouldBeNil)
+		a.So(changes, ShouldBeEmpty)
+	}
+
+	for i := 1; i <= ChangeLogLength+2; i++ {
+		err := s.Add(&Change{
+			ID:    fmt.Sprintf("change-%d", i),
+			Type:  types.UpdateEvent,
+			DevID: "test",
+			Time:  time.Now(),
+		})
+		a.So(err, ShouldBeNil)
+	}
+
+	{
+		changes, err := s.Get()
+		a.So(err, ShouldBeNil)
+		a.So(changes, ShouldHaveLength, ChangeLogLength)
+		a.So(changes[0].ID, ShouldEqual, fmt.Sprintf("change-%d", ChangeLogLength+2))
+		a.So(changes[0].Type, ShouldEqual, types.UpdateEvent)
+	}
+
+	{
+		changes, err := s.Since(fmt.Sprintf("change-%d", ChangeLogLength))
+		a.So(err, ShouldBeNil)
+		a.So(changes, ShouldHaveLength, 2)
+		a.So(changes[0].ID, ShouldEqual, fmt.Sprintf("change-%d", ChangeLogLength+1))
+		a.So(changes[1].ID, ShouldEqual, fmt.Sprintf("change-%d", ChangeLogLength+2))
+	}
+
+	{
+		changes, err := s.Since(fmt.Sprintf("change-%d", ChangeLogLength+2))
+		a.So(err, ShouldBeNil)
+		a.So(changes, ShouldBeEmpty)
+	}
+
+	// The first changes are no longer in the log
+	{
+		_, err := s.Since("change-1")
+		a.So(errors.GetErrType(err), ShouldEqual, errors.NotFound)
+	}
+}
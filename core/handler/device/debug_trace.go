@@ -0,0 +1,85 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/storage"
+)
+
+// DebugTraceLength is the maximum number of entries that is stored in the debug trace of a device
+var DebugTraceLength = 1000
+
+// Types of debug trace entries
+const (
+	DebugTraceEvent       = "trace"
+	DebugTraceScheduling  = "scheduling"
+	DebugTraceFunctionLog = "function_log"
+	DebugTraceIntegration = "integration"
+)
+
+// DebugTraceEntry is an entry in the debug trace of a device
+type DebugTraceEntry struct {
+	Time     time.Time         `json:"time"`
+	Type     string            `json:"type"`
+	Message  string            `json:"message"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// DebugTrace stores the verbose traces that are captured while a device is in debug mode
+type DebugTrace interface {
+	// Add an entry to the trace. The oldest entry is removed if the trace is full.
+	Add(entry *DebugTraceEntry) error
+	// Get the entries in the trace, newest first
+	Get() ([]*DebugTraceEntry, error)
+	// Clear the trace
+	Clear() error
+}
+
+// RedisDebugTrace implements the debug trace in Redis
+type RedisDebugTrace struct {
+	appID  string
+	devID  string
+	traces *storage.RedisQueueStore
+}
+
+func (s *RedisDebugTrace) key() string {
+	return s.appID + ":" + s.devID
+}
+
+// Add an entry to the trace
+func (s *RedisDebugTrace) Add(entry *DebugTraceEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err := s.traces.AddFront(s.key(), string(data)); err != nil {
+		return err
+	}
+	return s.traces.Trim(s.key(), DebugTraceLength)
+}
+
+// Get the entries in the trace
+func (s *RedisDebugTrace) Get() ([]*DebugTraceEntry, error) {
+	stored, err := s.traces.Get(s.key())
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*DebugTraceEntry, 0, len(stored))
+	for _, data := range stored {
+		entry := new(DebugTraceEntry)
+		if err := json.Unmarshal([]byte(data), entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// Clear the trace
+func (s *RedisDebugTrace) Clear() error {
+	return s.traces.Delete(s.key())
+}
@@ -0,0 +1,57 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestDebugTrace(t *testing.T) {
+	a := New(t)
+
+	client := GetRedisClient()
+	store := NewRedisDeviceStore(client, "handler-test-debug-trace")
+	s, _ := store.DebugTrace("test", "test")
+
+	defer func() {
+		client.Del("handler-test-debug-trace:debug:test:test")
+	}()
+
+	{
+		entries, err := s.Get()
+		a.So(err, ShouldBeNil)
+		a.So(entries, ShouldBeEmpty)
+	}
+
+	for i := 1; i <= DebugTraceLength+2; i++ {
+		err := s.Add(&DebugTraceEntry{
+			Time:     time.Now(),
+			Type:     DebugTraceEvent,
+			Message:  fmt.Sprintf("event-%d", i),
+			Metadata: map[string]string{"service_name": "handler"},
+		})
+		a.So(err, ShouldBeNil)
+	}
+
+	{
+		entries, err := s.Get()
+		a.So(err, ShouldBeNil)
+		a.So(entries, ShouldHaveLength, DebugTraceLength)
+		a.So(entries[0].Message, ShouldEqual, fmt.Sprintf("event-%d", DebugTraceLength+2))
+		a.So(entries[0].Metadata["service_name"], ShouldEqual, "handler")
+	}
+
+	a.So(s.Clear(), ShouldBeNil)
+
+	{
+		entries, err := s.Get()
+		a.So(err, ShouldBeNil)
+		a.So(entries, ShouldBeEmpty)
+	}
+}
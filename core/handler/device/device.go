@@ -19,9 +19,13 @@ type AppNonce [3]byte
 
 // Options for the device
 type Options struct {
-	ActivationConstraints string `json:"activation_constraints,omitempty"` // Activation Constraints (public/local/private)
-	DisableFCntCheck      bool   `json:"disable_fcnt_check,omitemtpy"`     // Disable Frame counter check (insecure)
-	Uses32BitFCnt         bool   `json:"uses_32_bit_fcnt,omitemtpy"`       // Use 32-bit Frame counters
+	ActivationConstraints string              `json:"activation_constraints,omitempty"` // Activation Constraints (public/local/private)
+	DisableFCntCheck      bool                `json:"disable_fcnt_check,omitemtpy"`     // Disable Frame counter check (insecure)
+	Uses32BitFCnt         bool                `json:"uses_32_bit_fcnt,omitemtpy"`       // Use 32-bit Frame counters
+	ResetsFCnt            bool                `json:"resets_fcnt,omitempty"`            // Accept Frame counter resets (insecure)
+	RxWindow              pb_lorawan.RxWindow `json:"rx_window,omitempty"`              // Receive window for downlink
+	Relay                 bool                `json:"relay,omitempty"`                  // Device is a relay for other devices
+	RegionalParameters    string              `json:"regional_parameters,omitempty"`    // Revision of the Regional Parameters
 }
 
 // Device contains the state of a device
@@ -33,13 +37,19 @@ type Device struct {
 	AppID  string       `redis:"app_id"`
 	DevID  string       `redis:"dev_id"`
 
-	Description string `redis:"description"`
+	Description string            `redis:"description"`
+	Attributes  map[string]string `redis:"attributes"`
 
 	Latitude  float32 `redis:"latitude"`
 	Longitude float32 `redis:"longitude"`
 	Altitude  int32   `redis:"altitude"`
 
 	Options Options `redis:"options"`
+	Profile Profile `redis:"profile"`
+
+	PayloadFormat string `redis:"payload_format"` // Overrides the payload format of the application
+	Codec         string `redis:"codec"`          // Overrides the payload functions of the application
+	GoCodec       string `redis:"go_codec"`
 
 	AppKey        types.AppKey `redis:"app_key"`
 	UsedDevNonces []DevNonce   `redis:"used_dev_nonces"`
@@ -52,10 +62,66 @@ type Device struct {
 
 	CurrentDownlink *types.DownlinkMessage `redis:"current_downlink"`
 
+	PendingProvisioning bool `redis:"pending_provisioning"` // The provisioning downlink is sent after the next uplink
+
+	Revision uint64 `redis:"revision"` // Incremented on every update of the settings of the device
+
+	FieldStatistics *FieldStatistics `redis:"field_statistics"` // Used for anomaly detection
+	ComputedFields  *ComputedFields  `redis:"computed_fields"`  // The computed fields of the last uplink
+	DecoderState    *DecoderState    `redis:"decoder_state"`    // The decoded fields of the last uplinks, for stateful decoding
+	Aggregation     *Aggregation     `redis:"aggregation"`      // The current aggregation window
+	Twin            *Twin            `redis:"twin"`             // The desired and reported state
+
+	DownlinkDelivery *DownlinkDelivery `redis:"downlink_delivery"` // The delivery of confirmed downlinks
+
+	DebugUntil time.Time `redis:"debug_until"` // Verbose traces of the device are captured until this time
+
+	DeletedAt       time.Time `redis:"deleted_at"`         // The device is in the recycle bin since this time
+	DeletedFCntDown uint32    `redis:"deleted_f_cnt_down"` // The downlink frame counter in the NetworkServer when the device was deleted
+
+	LastSeen  time.Time `redis:"last_seen"` // Time of the last uplink message
 	CreatedAt time.Time `redis:"created_at"`
 	UpdatedAt time.Time `redis:"updated_at"`
 }
 
+// ComputedFields contains the values of the computed payload fields of an uplink message
+type ComputedFields struct {
+	Values map[string]interface{} `json:"values"`
+}
+
+// DecodedUplink contains the fields that the Decoder returned for an uplink message
+type DecodedUplink struct {
+	FCnt   uint32                 `json:"f_cnt"`
+	Fields map[string]interface{} `json:"fields"`
+}
+
+// DecoderState contains the decoded fields of the last uplink messages of a device, that are passed to the Decoder of
+// the next uplink message if the application uses stateful decoding. The uplink message before the last one is kept,
+// so that a retry of the last uplink message is decoded with the same state as the original.
+type DecoderState struct {
+	Last     *DecodedUplink `json:"last,omitempty"`
+	Previous *DecodedUplink `json:"previous,omitempty"`
+}
+
+// PreviousFor returns the decoded uplink message that precedes the uplink message with the frame counter
+func (s *DecoderState) PreviousFor(fCnt uint32) *DecodedUplink {
+	if s == nil {
+		return nil
+	}
+	if s.Last != nil && s.Last.FCnt == fCnt {
+		return s.Previous
+	}
+	return s.Last
+}
+
+// Add adds the decoded fields of the uplink message with the frame counter to the state
+func (s *DecoderState) Add(fCnt uint32, fields map[string]interface{}) {
+	if s.Last == nil || s.Last.FCnt != fCnt {
+		s.Previous = s.Last
+	}
+	s.Last = &DecodedUplink{FCnt: fCnt, Fields: fields}
+}
+
 // StartUpdate stores the state of the device
 func (d *Device) StartUpdate() {
 	old := *d
@@ -103,6 +169,10 @@ func (d Device) GetLoRaWAN() *pb_lorawan.Device {
 		NwkSKey:               &d.NwkSKey,
 		DisableFCntCheck:      d.Options.DisableFCntCheck,
 		Uses32BitFCnt:         d.Options.Uses32BitFCnt,
+		ResetsFCnt:            d.Options.ResetsFCnt,
+		RxWindow:              d.Options.RxWindow,
+		Relay:                 d.Options.Relay,
+		RegionalParameters:    d.Options.RegionalParameters,
 		ActivationConstraints: d.Options.ActivationConstraints,
 	}
 	return dev
@@ -36,3 +36,22 @@ func TestDeviceGetLoRaWAN(t *testing.T) {
 	}
 	device.GetLoRaWAN()
 }
+
+func TestDecoderState(t *testing.T) {
+	a := New(t)
+	var state *DecoderState
+	a.So(state.PreviousFor(1), ShouldBeNil)
+
+	state = new(DecoderState)
+	state.Add(1, map[string]interface{}{"temperature": 20.0})
+	a.So(state.PreviousFor(2).Fields["temperature"], ShouldEqual, 20.0)
+
+	state.Add(2, map[string]interface{}{"temperature": 20.5})
+	a.So(state.PreviousFor(3).FCnt, ShouldEqual, 2)
+
+	// A retry is decoded with the state of the original
+	a.So(state.PreviousFor(2).FCnt, ShouldEqual, 1)
+	state.Add(2, map[string]interface{}{"temperature": 20.5})
+	a.So(state.Previous.FCnt, ShouldEqual, 1)
+	a.So(state.Last.FCnt, ShouldEqual, 2)
+}
@@ -0,0 +1,46 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+)
+
+// DownlinkDelivery counts the uplinks of a device that did or did not acknowledge a pending confirmed downlink
+type DownlinkDelivery struct {
+	Acked             uint32 `json:"acked"`
+	Missed            uint32 `json:"missed"`
+	ConsecutiveMissed uint32 `json:"consecutive_missed"`
+}
+
+// Add an uplink that did or did not acknowledge the pending confirmed downlink
+func (d *DownlinkDelivery) Add(ack bool) {
+	if ack {
+		d.Acked++
+		d.ConsecutiveMissed = 0
+		return
+	}
+	d.Missed++
+	d.ConsecutiveMissed++
+}
+
+// Rate returns the fraction of the uplinks that acknowledged the pending confirmed downlink
+func (d *DownlinkDelivery) Rate() float64 {
+	if d.Acked+d.Missed == 0 {
+		return 0
+	}
+	return float64(d.Acked) / float64(d.Acked+d.Missed)
+}
+
+// ToPb returns the DownlinkDelivery as a proto, or nil if it is nil
+func (d *DownlinkDelivery) ToPb() *pb.DownlinkDelivery {
+	if d == nil {
+		return nil
+	}
+	return &pb.DownlinkDelivery{
+		Acked:             d.Acked,
+		Missed:            d.Missed,
+		ConsecutiveMissed: d.ConsecutiveMissed,
+	}
+}
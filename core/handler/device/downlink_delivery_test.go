@@ -0,0 +1,33 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestDownlinkDelivery(t *testing.T) {
+	a := New(t)
+
+	var nilDelivery *DownlinkDelivery
+	a.So(nilDelivery.ToPb(), ShouldBeNil)
+
+	d := new(DownlinkDelivery)
+	a.So(d.Rate(), ShouldEqual, 0)
+
+	d.Add(false)
+	d.Add(false)
+	a.So(d.ConsecutiveMissed, ShouldEqual, 2)
+
+	d.Add(true)
+	a.So(d.ConsecutiveMissed, ShouldEqual, 0)
+	a.So(d.Acked, ShouldEqual, 1)
+	a.So(d.Missed, ShouldEqual, 2)
+
+	d.Add(true)
+	a.So(d.Rate(), ShouldEqual, 0.5)
+	a.So(d.ToPb().Acked, ShouldEqual, 2)
+}
@@ -15,9 +15,11 @@ import (
 type DownlinkQueue interface {
 	Length() (int, error)
 	Next() (*types.DownlinkMessage, error)
+	All() ([]*types.DownlinkMessage, error)
 	Replace(msg *types.DownlinkMessage) error
 	PushFirst(msg *types.DownlinkMessage) error
 	PushLast(msg *types.DownlinkMessage) error
+	Clear() (int, error)
 }
 
 // RedisDownlinkQueue implements the downlink queue in Redis
@@ -52,6 +54,23 @@ func (s *RedisDownlinkQueue) Next() (*types.DownlinkMessage, error) {
 	return msg, nil
 }
 
+// All items in the downlink queue, without removing them
+func (s *RedisDownlinkQueue) All() ([]*types.DownlinkMessage, error) {
+	qd, err := s.queues.Get(s.key())
+	if err != nil {
+		return nil, err
+	}
+	msgs := make([]*types.DownlinkMessage, 0, len(qd))
+	for _, qd := range qd {
+		msg := new(types.DownlinkMessage)
+		if err := json.Unmarshal([]byte(qd), msg); err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
 // Replace the downlink queue with msg
 func (s *RedisDownlinkQueue) Replace(msg *types.DownlinkMessage) error {
 	if err := s.queues.Delete(s.key()); err != nil {
@@ -77,3 +96,12 @@ func (s *RedisDownlinkQueue) PushLast(msg *types.DownlinkMessage) error {
 	}
 	return s.queues.AddEnd(s.key(), string(qd))
 }
+
+// Clear the downlink queue and return how many items were removed
+func (s *RedisDownlinkQueue) Clear() (int, error) {
+	length, err := s.Length()
+	if err != nil {
+		return 0, err
+	}
+	return length, s.queues.Delete(s.key())
+}
@@ -53,6 +53,15 @@ func TestDownlinkQueue(t *testing.T) {
 		a.So(length, ShouldEqual, 2)
 	}
 
+	{
+		all, err := s.All()
+		a.So(err, ShouldBeNil)
+		a.So(all, ShouldHaveLength, 2)
+		a.So(all[0].PayloadRaw, ShouldResemble, []byte{0xab, 0xcd})
+		length, _ := s.Length()
+		a.So(length, ShouldEqual, 2)
+	}
+
 	{
 		next, err := s.Next()
 		a.So(err, ShouldBeNil)
@@ -80,4 +89,14 @@ func TestDownlinkQueue(t *testing.T) {
 		a.So(next.PayloadRaw, ShouldResemble, []byte{0xaa, 0xbc})
 	}
 
+	{
+		s.PushLast(&types.DownlinkMessage{PayloadRaw: []byte{0x01}})
+		s.PushLast(&types.DownlinkMessage{PayloadRaw: []byte{0x02}})
+		cleared, err := s.Clear()
+		a.So(err, ShouldBeNil)
+		a.So(cleared, ShouldEqual, 2)
+		length, _ := s.Length()
+		a.So(length, ShouldEqual, 0)
+	}
+
 }
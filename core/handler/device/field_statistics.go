@@ -0,0 +1,44 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import "math"
+
+// FieldStatistics contains the moving statistics of the numeric payload fields of a device
+type FieldStatistics struct {
+	Fields map[string]*FieldStatistic `json:"fields"`
+}
+
+// FieldStatistic contains the exponentially weighted moving average and variance of a numeric payload field
+type FieldStatistic struct {
+	Count    uint32  `json:"count"`
+	Mean     float64 `json:"mean"`
+	Variance float64 `json:"variance"`
+}
+
+// StdDev returns the moving standard deviation
+func (s *FieldStatistic) StdDev() float64 {
+	return math.Sqrt(s.Variance)
+}
+
+// Score returns the number of standard deviations that the value differs from the moving average
+func (s *FieldStatistic) Score(value float64) float64 {
+	if s.Variance == 0 {
+		return 0
+	}
+	return (value - s.Mean) / s.StdDev()
+}
+
+// Add a value to the moving statistics, where alpha is the weight of the new value
+func (s *FieldStatistic) Add(value float64, alpha float64) {
+	if s.Count == 0 {
+		s.Mean, s.Variance = value, 0
+	} else {
+		diff := value - s.Mean
+		incr := alpha * diff
+		s.Mean += incr
+		s.Variance = (1 - alpha) * (s.Variance + diff*incr)
+	}
+	s.Count++
+}
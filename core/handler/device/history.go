@@ -0,0 +1,191 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/storage"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// HistoryLength is the maximum number of state changes that is stored for each device
+var HistoryLength = 1000
+
+// historyFields maps the fields of the Device that are recorded in the history (settings, session and frame counter)
+// to their names in the State
+var historyFields = map[string]string{
+	"DevEUI":      "dev_eui",
+	"AppEUI":      "app_eui",
+	"Description": "description",
+	"Attributes":  "attributes",
+	"Latitude":    "latitude",
+	"Longitude":   "longitude",
+	"Altitude":    "altitude",
+	"Options":     "options",
+	"AppKey":      "app_key",
+	"DevAddr":     "dev_addr",
+	"NwkSKey":     "nwk_s_key",
+	"AppSKey":     "app_s_key",
+	"FCntUp":      "f_cnt_up",
+	"Revision":    "revision",
+	"LastSeen":    "last_seen",
+}
+
+// State is the state of a device that is recorded in the history
+type State struct {
+	DevEUI      types.DevEUI      `json:"dev_eui"`
+	AppEUI      types.AppEUI      `json:"app_eui"`
+	Description string            `json:"description,omitempty"`
+	Attributes  map[string]string `json:"attributes,omitempty"`
+	Latitude    float32           `json:"latitude,omitempty"`
+	Longitude   float32           `json:"longitude,omitempty"`
+	Altitude    int32             `json:"altitude,omitempty"`
+	Options     Options           `json:"options"`
+	AppKey      types.AppKey      `json:"app_key"`
+	DevAddr     types.DevAddr     `json:"dev_addr"`
+	NwkSKey     types.NwkSKey     `json:"nwk_s_key"`
+	AppSKey     types.AppSKey     `json:"app_s_key"`
+	FCntUp      uint32            `json:"f_cnt_up"`
+	Revision    uint64            `json:"revision"`
+	LastSeen    time.Time         `json:"last_seen"`
+}
+
+// StateChange is a change in the state of a device
+type StateChange struct {
+	Time time.Time `json:"time"`
+	// Changed contains the names of the changed fields
+	Changed []string `json:"changed"`
+	// State of the device after the change
+	State State `json:"state"`
+}
+
+// State returns the state of the device that is recorded in the history
+func (d Device) State() State {
+	return State{
+		DevEUI:      d.DevEUI,
+		AppEUI:      d.AppEUI,
+		Description: d.Description,
+		Attributes:  d.Attributes,
+		Latitude:    d.Latitude,
+		Longitude:   d.Longitude,
+		Altitude:    d.Altitude,
+		Options:     d.Options,
+		AppKey:      d.AppKey,
+		DevAddr:     d.DevAddr,
+		NwkSKey:     d.NwkSKey,
+		AppSKey:     d.AppSKey,
+		FCntUp:      d.FCntUp,
+		Revision:    d.Revision,
+		LastSeen:    d.LastSeen,
+	}
+}
+
+// Device reconstructs the device with the given identifiers from the state
+func (s State) Device(appID, devID string) *Device {
+	return &Device{
+		AppID:       appID,
+		DevID:       devID,
+		DevEUI:      s.DevEUI,
+		AppEUI:      s.AppEUI,
+		Description: s.Description,
+		Attributes:  s.Attributes,
+		Latitude:    s.Latitude,
+		Longitude:   s.Longitude,
+		Altitude:    s.Altitude,
+		Options:     s.Options,
+		AppKey:      s.AppKey,
+		DevAddr:     s.DevAddr,
+		NwkSKey:     s.NwkSKey,
+		AppSKey:     s.AppSKey,
+		FCntUp:      s.FCntUp,
+		Revision:    s.Revision,
+		LastSeen:    s.LastSeen,
+	}
+}
+
+// StateChange returns the change in the recorded state of the device since the last call to StartUpdate, or nil if
+// none of the recorded fields changed
+func (d Device) StateChange() *StateChange {
+	var changed []string
+	for _, field := range d.ChangedFields() {
+		if name, ok := historyFields[field]; ok {
+			changed = append(changed, name)
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+	return &StateChange{
+		Time:    d.UpdatedAt,
+		Changed: changed,
+		State:   d.State(),
+	}
+}
+
+// History stores the last state changes of a device
+type History interface {
+	// Add a state change to the history. The oldest change is removed if the history is full.
+	Add(change *StateChange) error
+	// Get the state changes in the history, newest first
+	Get() ([]*StateChange, error)
+	// At returns the last state change at or before the given time
+	At(t time.Time) (*StateChange, error)
+}
+
+// RedisHistory implements the device history in Redis
+type RedisHistory struct {
+	appID   string
+	devID   string
+	history *storage.RedisQueueStore
+}
+
+func (s *RedisHistory) key() string {
+	return s.appID + ":" + s.devID
+}
+
+// Add a state change to the history
+func (s *RedisHistory) Add(change *StateChange) error {
+	data, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+	if err := s.history.AddFront(s.key(), string(data)); err != nil {
+		return err
+	}
+	return s.history.Trim(s.key(), HistoryLength)
+}
+
+// Get the state changes in the history
+func (s *RedisHistory) Get() ([]*StateChange, error) {
+	stored, err := s.history.Get(s.key())
+	if err != nil {
+		return nil, err
+	}
+	changes := make([]*StateChange, 0, len(stored))
+	for _, data := range stored {
+		change := new(StateChange)
+		if err := json.Unmarshal([]byte(data), change); err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// At returns the last state change at or before the given time
+func (s *RedisHistory) At(t time.Time) (*StateChange, error) {
+	changes, err := s.Get()
+	if err != nil {
+		return nil, err
+	}
+	for _, change := range changes {
+		if !change.Time.After(t) {
+			return change, nil
+		}
+	}
+	return nil, errors.NewErrNotFound("Device state at " + t.UTC().Format(time.RFC3339))
+}
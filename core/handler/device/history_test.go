@@ -0,0 +1,117 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestHistory(t *testing.T) {
+	a := New(t)
+
+	client := GetRedisClient()
+	store := NewRedisDeviceStore(client, "handler-test-history")
+	s, _ := store.History("test", "test")
+
+	defer func() {
+		client.Del("handler-test-history:history:test:test")
+	}()
+
+	{
+		changes, err := s.Get()
+		a.So(err, ShouldBeNil)
+		a.So(changes, ShouldBeEmpty)
+	}
+
+	start := time.Now()
+	for i := 1; i <= HistoryLength+2; i++ {
+		err := s.Add(&StateChange{
+			Time:    start.Add(time.Duration(i) * time.Second),
+			Changed: []string{"f_cnt_up"},
+			State:   State{FCntUp: uint32(i)},
+		})
+		a.So(err, ShouldBeNil)
+	}
+
+	{
+		changes, err := s.Get()
+		a.So(err, ShouldBeNil)
+		a.So(changes, ShouldHaveLength, HistoryLength)
+		a.So(changes[0].State.FCntUp, ShouldEqual, HistoryLength+2)
+	}
+
+	{
+		change, err := s.At(start.Add(10*time.Second + time.Millisecond))
+		a.So(err, ShouldBeNil)
+		a.So(change.State.FCntUp, ShouldEqual, 10)
+	}
+
+	{
+		_, err := s.At(start.Add(2 * time.Second))
+		a.So(errors.GetErrType(err), ShouldEqual, errors.NotFound)
+	}
+}
+
+func TestDeviceStoreHistory(t *testing.T) {
+	a := New(t)
+
+	client := GetRedisClient()
+	s := NewRedisDeviceStore(client, "handler-test-device-history")
+
+	defer func() {
+		client.Del("handler-test-device-history:device:test:test")
+		client.Del("handler-test-device-history:history:test:test")
+	}()
+
+	dev := &Device{
+		AppID:   "test",
+		DevID:   "test",
+		DevAddr: types.DevAddr{1, 2, 3, 4},
+	}
+	a.So(s.Set(dev), ShouldBeNil)
+	created := time.Now()
+
+	time.Sleep(10 * time.Millisecond)
+
+	dev.StartUpdate()
+	dev.DevAddr = types.DevAddr{5, 6, 7, 8}
+	dev.FCntUp = 42
+	a.So(s.Set(dev), ShouldBeNil)
+
+	// Changes to fields that are not recorded do not add to the history
+	dev.StartUpdate()
+	dev.DebugUntil = time.Now().Add(time.Hour)
+	a.So(s.Set(dev), ShouldBeNil)
+
+	history, _ := s.History("test", "test")
+	changes, err := history.Get()
+	a.So(err, ShouldBeNil)
+	a.So(changes, ShouldHaveLength, 2)
+	a.So(changes[0].Changed, ShouldResemble, []string{"dev_addr", "f_cnt_up"})
+
+	{
+		change, err := history.At(created)
+		a.So(err, ShouldBeNil)
+		a.So(change.State.DevAddr, ShouldEqual, types.DevAddr{1, 2, 3, 4})
+		a.So(change.State.FCntUp, ShouldEqual, 0)
+	}
+
+	{
+		change, err := history.At(time.Now())
+		a.So(err, ShouldBeNil)
+		a.So(change.State.Device("test", "test").DevAddr, ShouldEqual, types.DevAddr{5, 6, 7, 8})
+		a.So(change.State.FCntUp, ShouldEqual, 42)
+	}
+
+	a.So(s.Delete("test", "test"), ShouldBeNil)
+	changes, err = history.Get()
+	a.So(err, ShouldBeNil)
+	a.So(changes, ShouldBeEmpty)
+}
@@ -0,0 +1,48 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+)
+
+// Profile contains the certification metadata of a device
+type Profile struct {
+	LoRaWANVersion  string `json:"lorawan_version,omitempty"`
+	Vendor          string `json:"vendor,omitempty"`
+	CertificationID string `json:"certification_id,omitempty"`
+	BrandID         string `json:"brand_id,omitempty"`
+	ModelID         string `json:"model_id,omitempty"`
+	FirmwareVersion string `json:"firmware_version,omitempty"`
+}
+
+// ProfileFromPb returns the Profile of a DeviceProfile proto
+func ProfileFromPb(in *pb.DeviceProfile) Profile {
+	if in == nil {
+		return Profile{}
+	}
+	return Profile{
+		LoRaWANVersion:  in.LorawanVersion,
+		Vendor:          in.Vendor,
+		CertificationID: in.CertificationId,
+		BrandID:         in.BrandId,
+		ModelID:         in.ModelId,
+		FirmwareVersion: in.FirmwareVersion,
+	}
+}
+
+// ToPb returns the Profile as a DeviceProfile proto, or nil if the profile is empty
+func (p Profile) ToPb() *pb.DeviceProfile {
+	if p == (Profile{}) {
+		return nil
+	}
+	return &pb.DeviceProfile{
+		LorawanVersion:  p.LoRaWANVersion,
+		Vendor:          p.Vendor,
+		CertificationId: p.CertificationID,
+		BrandId:         p.BrandID,
+		ModelId:         p.ModelID,
+		FirmwareVersion: p.FirmwareVersion,
+	}
+}
@@ -5,6 +5,8 @@ package device
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/TheThingsNetwork/ttn/core/handler/device/migrate"
@@ -19,36 +21,87 @@ type Store interface {
 	ListForApp(appID string, opts *storage.ListOptions) ([]*Device, error)
 	Get(appID, devID string) (*Device, error)
 	DownlinkQueue(appID, devID string) (DownlinkQueue, error)
+	UplinkHistory(appID, devID string) (UplinkHistory, error)
+	ChangeLog(appID string) (ChangeLog, error)
+	DebugTrace(appID, devID string) (DebugTrace, error)
+	History(appID, devID string) (History, error)
+	ListQueues() ([]string, error)
 	Set(new *Device, properties ...string) (err error)
+	SetIfRevision(new *Device, revision uint64) (err error)
+	ClaimIdempotencyKey(appID, devID, key, value string, window time.Duration) (string, error)
+	// ReleaseIdempotencyKey releases a claimed idempotency key, so that the message can be retried
+	ReleaseIdempotencyKey(appID, devID, key string) error
 	Delete(appID, devID string) error
+	// SoftDelete moves a Device with its downlink queue, uplink history, debug trace and history to the recycle bin
+	SoftDelete(dev *Device) error
+	// ListDeleted lists the Devices of an Application in the recycle bin, or of all Applications if appID is empty
+	ListDeleted(appID string) ([]*Device, error)
+	GetDeleted(appID, devID string) (*Device, error)
+	// Restore moves a Device from the recycle bin back to the store
+	Restore(appID, devID string) (*Device, error)
+	// Purge deletes a Device from the recycle bin
+	Purge(appID, devID string) error
 }
 
 const defaultRedisPrefix = "handler"
 const redisDevicePrefix = "device"
 const redisDownlinkQueuePrefix = "downlink"
+const redisUplinkHistoryPrefix = "uplink"
+const redisIdempotencyPrefix = "idempotency"
+const redisChangeLogPrefix = "changes"
+const redisDebugTracePrefix = "debug"
+const redisHistoryPrefix = "history"
+const redisDeletedPrefix = "deleted"
 
 // NewRedisDeviceStore creates a new Redis-based Device store
 func NewRedisDeviceStore(client *redis.Client, prefix string) *RedisDeviceStore {
 	if prefix == "" {
 		prefix = defaultRedisPrefix
 	}
+	s := newRedisDeviceStore(client, prefix)
+	s.deleted = newRedisDeviceStore(client, prefix+":"+redisDeletedPrefix)
+	return s
+}
+
+func newRedisDeviceStore(client *redis.Client, prefix string) *RedisDeviceStore {
 	store := storage.NewRedisMapStore(client, prefix+":"+redisDevicePrefix)
 	store.SetBase(Device{}, "")
 	for v, f := range migrate.DeviceMigrations(prefix) {
 		store.AddMigration(v, f)
 	}
 	queues := storage.NewRedisQueueStore(client, prefix+":"+redisDownlinkQueuePrefix)
+	uplinks := storage.NewRedisQueueStore(client, prefix+":"+redisUplinkHistoryPrefix)
+	idempotency := storage.NewRedisKVStore(client, prefix+":"+redisIdempotencyPrefix)
+	changes := storage.NewRedisQueueStore(client, prefix+":"+redisChangeLogPrefix)
+	traces := storage.NewRedisQueueStore(client, prefix+":"+redisDebugTracePrefix)
+	history := storage.NewRedisQueueStore(client, prefix+":"+redisHistoryPrefix)
 	return &RedisDeviceStore{
-		store:  store,
-		queues: queues,
+		prefix:      prefix,
+		store:       store,
+		queues:      queues,
+		uplinks:     uplinks,
+		idempotency: idempotency,
+		changes:     changes,
+		traces:      traces,
+		history:     history,
 	}
 }
 
 // RedisDeviceStore stores Devices in Redis.
 // - Devices are stored as a Hash
+// - The history of a Device is stored as a List of JSON-encoded state changes
+// - Idempotency keys are stored as Strings that expire
+// - Deleted Devices are moved to the same structures with the "deleted" prefix
 type RedisDeviceStore struct {
-	store  *storage.RedisMapStore
-	queues *storage.RedisQueueStore
+	prefix      string
+	store       *storage.RedisMapStore
+	queues      *storage.RedisQueueStore
+	uplinks     *storage.RedisQueueStore
+	idempotency *storage.RedisKVStore
+	changes     *storage.RedisQueueStore
+	traces      *storage.RedisQueueStore
+	history     *storage.RedisQueueStore
+	deleted     *RedisDeviceStore
 }
 
 // List all Devices
@@ -102,6 +155,68 @@ func (s *RedisDeviceStore) DownlinkQueue(appID, devID string) (DownlinkQueue, er
 	}, nil
 }
 
+// UplinkHistory for a specific Device
+func (s *RedisDeviceStore) UplinkHistory(appID, devID string) (UplinkHistory, error) {
+	return &RedisUplinkHistory{
+		appID:   appID,
+		devID:   devID,
+		uplinks: s.uplinks,
+	}, nil
+}
+
+// ChangeLog for a specific Application
+func (s *RedisDeviceStore) ChangeLog(appID string) (ChangeLog, error) {
+	return &RedisChangeLog{
+		appID:   appID,
+		changes: s.changes,
+	}, nil
+}
+
+// DebugTrace for a specific Device
+func (s *RedisDeviceStore) DebugTrace(appID, devID string) (DebugTrace, error) {
+	return &RedisDebugTrace{
+		appID:  appID,
+		devID:  devID,
+		traces: s.traces,
+	}, nil
+}
+
+// History of a specific Device
+func (s *RedisDeviceStore) History(appID, devID string) (History, error) {
+	return &RedisHistory{
+		appID:   appID,
+		devID:   devID,
+		history: s.history,
+	}, nil
+}
+
+// ListQueues lists the keys (<AppID>:<DevID>) of all downlink queues and uplink histories
+func (s *RedisDeviceStore) ListQueues() ([]string, error) {
+	var keys []string
+	seen := make(map[string]bool)
+	for _, queues := range []struct {
+		prefix string
+		store  *storage.RedisQueueStore
+	}{
+		{redisDownlinkQueuePrefix, s.queues},
+		{redisUplinkHistoryPrefix, s.uplinks},
+	} {
+		queueKeys, err := queues.store.Keys("")
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range queueKeys {
+			key = strings.TrimPrefix(key, fmt.Sprintf("%s:%s:", s.prefix, queues.prefix))
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
 // Set a new Device or update an existing one
 func (s *RedisDeviceStore) Set(new *Device, properties ...string) (err error) {
 	now := time.Now()
@@ -114,7 +229,49 @@ func (s *RedisDeviceStore) Set(new *Device, properties ...string) (err error) {
 	if err != nil {
 		return
 	}
-	return nil
+	return s.recordStateChange(new)
+}
+
+// SetIfRevision creates or updates a Device if the stored Device has the given revision (0 for new devices), and
+// increments the revision
+func (s *RedisDeviceStore) SetIfRevision(new *Device, revision uint64) (err error) {
+	now := time.Now()
+	new.UpdatedAt = now
+	key := fmt.Sprintf("%s:%s", new.AppID, new.DevID)
+	if new.old == nil {
+		new.CreatedAt = now
+	}
+	new.Revision = revision + 1
+	err = s.store.SetIf(key, *new, "revision", storage.ExpectRevision("Device", revision))
+	if err != nil {
+		return
+	}
+	return s.recordStateChange(new)
+}
+
+// recordStateChange adds the change in the recorded state of a Device to its history
+func (s *RedisDeviceStore) recordStateChange(dev *Device) error {
+	change := dev.StateChange()
+	if change == nil {
+		return nil
+	}
+	history, err := s.History(dev.AppID, dev.DevID)
+	if err != nil {
+		return err
+	}
+	return history.Add(change)
+}
+
+// ClaimIdempotencyKey stores the value for the idempotency key of a Device, unless the key was already claimed within
+// the window. It returns the value that was stored first.
+func (s *RedisDeviceStore) ClaimIdempotencyKey(appID, devID, key, value string, window time.Duration) (string, error) {
+	current, _, err := s.idempotency.GetOrCreate(fmt.Sprintf("%s:%s:%s", appID, devID, key), value, window)
+	return current, err
+}
+
+// ReleaseIdempotencyKey deletes the idempotency key of a Device
+func (s *RedisDeviceStore) ReleaseIdempotencyKey(appID, devID, key string) error {
+	return s.idempotency.Delete(fmt.Sprintf("%s:%s:%s", appID, devID, key))
 }
 
 // Delete a Device
@@ -123,5 +280,107 @@ func (s *RedisDeviceStore) Delete(appID, devID string) error {
 	if err := s.queues.Delete(key); err != nil {
 		return err
 	}
+	if err := s.uplinks.Delete(key); err != nil {
+		return err
+	}
+	if err := s.traces.Delete(key); err != nil {
+		return err
+	}
+	if err := s.history.Delete(key); err != nil {
+		return err
+	}
+	idempotencyKeys, err := s.idempotency.Keys(key + ":*")
+	if err != nil {
+		return err
+	}
+	for _, idempotencyKey := range idempotencyKeys {
+		if err := s.idempotency.Delete(idempotencyKey); err != nil {
+			return err
+		}
+	}
 	return s.store.Delete(key)
 }
+
+// move a Device with its downlink queue, uplink history, debug trace and history to the other store
+func (s *RedisDeviceStore) move(to *RedisDeviceStore, appID, devID string) error {
+	key := fmt.Sprintf("%s:%s", appID, devID)
+	for _, stores := range [][2]*storage.RedisStore{
+		{s.queues.RedisStore, to.queues.RedisStore},
+		{s.uplinks.RedisStore, to.uplinks.RedisStore},
+		{s.traces.RedisStore, to.traces.RedisStore},
+		{s.history.RedisStore, to.history.RedisStore},
+		{s.store.RedisStore, to.store.RedisStore},
+	} {
+		if err := stores[0].Rename(key, stores[1], key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SoftDelete stores the changes of the Device (such as DeletedAt) and moves it to the recycle bin, replacing a Device
+// with the same ID that was deleted before
+func (s *RedisDeviceStore) SoftDelete(dev *Device) error {
+	key := fmt.Sprintf("%s:%s", dev.AppID, dev.DevID)
+	if _, err := s.store.Get(key); err != nil {
+		return err
+	}
+	if err := s.store.Set(key, *dev); err != nil {
+		return err
+	}
+	idempotencyKeys, err := s.idempotency.Keys(key + ":*")
+	if err != nil {
+		return err
+	}
+	for _, idempotencyKey := range idempotencyKeys {
+		if err := s.idempotency.Delete(idempotencyKey); err != nil {
+			return err
+		}
+	}
+	if err := s.deleted.Delete(dev.AppID, dev.DevID); err != nil {
+		return err
+	}
+	return s.move(s.deleted, dev.AppID, dev.DevID)
+}
+
+// ListDeleted lists the Devices in the recycle bin
+func (s *RedisDeviceStore) ListDeleted(appID string) ([]*Device, error) {
+	if appID == "" {
+		return s.deleted.List(nil)
+	}
+	return s.deleted.ListForApp(appID, nil)
+}
+
+// GetDeleted gets a Device from the recycle bin
+func (s *RedisDeviceStore) GetDeleted(appID, devID string) (*Device, error) {
+	return s.deleted.Get(appID, devID)
+}
+
+// Restore moves a Device from the recycle bin back to the store and clears its DeletedAt. It fails if a Device with
+// the same ID exists.
+func (s *RedisDeviceStore) Restore(appID, devID string) (*Device, error) {
+	dev, err := s.deleted.Get(appID, devID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.Get(appID, devID); err == nil {
+		return nil, errors.NewErrAlreadyExists(fmt.Sprintf("Device %s", devID))
+	} else if !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if err := s.deleted.move(s, appID, devID); err != nil {
+		return nil, err
+	}
+	dev.StartUpdate()
+	dev.DeletedAt = time.Time{}
+	dev.DeletedFCntDown = 0
+	if err := s.store.Set(fmt.Sprintf("%s:%s", appID, devID), *dev); err != nil {
+		return nil, err
+	}
+	return dev, nil
+}
+
+// Purge deletes a Device from the recycle bin
+func (s *RedisDeviceStore) Purge(appID, devID string) error {
+	return s.deleted.Delete(appID, devID)
+}
@@ -5,8 +5,10 @@ package device
 
 import (
 	"testing"
+	"time"
 
 	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
 	. "github.com/TheThingsNetwork/ttn/utils/testing"
 	. "github.com/smartystreets/assertions"
 )
@@ -99,3 +101,149 @@ func TestDeviceStore(t *testing.T) {
 	a.So(devs, ShouldHaveLength, 1)
 
 }
+
+func TestDeviceStoreSetIfRevision(t *testing.T) {
+	a := New(t)
+
+	s := NewRedisDeviceStore(GetRedisClient(), "handler-test-device-store-revision")
+
+	// Create
+	err := s.SetIfRevision(&Device{AppID: "AppID-1", DevID: "DevID-1"}, 0)
+	a.So(err, ShouldBeNil)
+
+	defer func() {
+		s.Delete("AppID-1", "DevID-1")
+	}()
+
+	// Create existing
+	err = s.SetIfRevision(&Device{AppID: "AppID-1", DevID: "DevID-1"}, 0)
+	a.So(errors.IsConflict(err), ShouldBeTrue)
+
+	// Update with current revision
+	dev, _ := s.Get("AppID-1", "DevID-1")
+	a.So(dev.Revision, ShouldEqual, 1)
+	dev.StartUpdate()
+	dev.Description = "updated"
+	err = s.SetIfRevision(dev, 1)
+	a.So(err, ShouldBeNil)
+
+	// Update with outdated revision
+	dev, _ = s.Get("AppID-1", "DevID-1")
+	a.So(dev.Revision, ShouldEqual, 2)
+	dev.StartUpdate()
+	dev.Description = "outdated"
+	err = s.SetIfRevision(dev, 1)
+	a.So(errors.IsConflict(err), ShouldBeTrue)
+
+	dev, _ = s.Get("AppID-1", "DevID-1")
+	a.So(dev.Description, ShouldEqual, "updated")
+
+	// Updates of other fields do not change the revision
+	dev.StartUpdate()
+	dev.FCntUp = 42
+	err = s.Set(dev)
+	a.So(err, ShouldBeNil)
+	dev, _ = s.Get("AppID-1", "DevID-1")
+	a.So(dev.Revision, ShouldEqual, 2)
+}
+
+func TestDeviceStoreIdempotencyKey(t *testing.T) {
+	a := New(t)
+
+	s := NewRedisDeviceStore(GetRedisClient(), "handler-test-device-store-idempotency")
+
+	defer func() {
+		s.Delete("AppID-1", "DevID-1")
+	}()
+
+	current, err := s.ClaimIdempotencyKey("AppID-1", "DevID-1", "key", "first", time.Minute)
+	a.So(err, ShouldBeNil)
+	a.So(current, ShouldEqual, "first")
+
+	current, err = s.ClaimIdempotencyKey("AppID-1", "DevID-1", "key", "second", time.Minute)
+	a.So(err, ShouldBeNil)
+	a.So(current, ShouldEqual, "first")
+
+	err = s.ReleaseIdempotencyKey("AppID-1", "DevID-1", "key")
+	a.So(err, ShouldBeNil)
+
+	current, err = s.ClaimIdempotencyKey("AppID-1", "DevID-1", "key", "third", time.Minute)
+	a.So(err, ShouldBeNil)
+	a.So(current, ShouldEqual, "third")
+}
+
+func TestDeviceStoreRecycleBin(t *testing.T) {
+	a := New(t)
+
+	s := NewRedisDeviceStore(GetRedisClient(), "handler-test-device-store-recycle-bin")
+
+	dev := &Device{
+		AppID:  "AppID-1",
+		DevID:  "DevID-1",
+		FCntUp: 42,
+	}
+	a.So(s.Set(dev), ShouldBeNil)
+	defer func() {
+		s.Delete("AppID-1", "DevID-1")
+		s.Purge("AppID-1", "DevID-1")
+	}()
+
+	queue, _ := s.DownlinkQueue("AppID-1", "DevID-1")
+	a.So(queue.PushLast(&types.DownlinkMessage{FPort: 1}), ShouldBeNil)
+
+	// Soft delete
+	dev.StartUpdate()
+	dev.DeletedAt = time.Now()
+	dev.DeletedFCntDown = 12
+	a.So(s.SoftDelete(dev), ShouldBeNil)
+
+	_, err := s.Get("AppID-1", "DevID-1")
+	a.So(errors.GetErrType(err), ShouldEqual, errors.NotFound)
+	queue, _ = s.DownlinkQueue("AppID-1", "DevID-1")
+	length, _ := queue.Length()
+	a.So(length, ShouldEqual, 0)
+
+	deleted, err := s.ListDeleted("AppID-1")
+	a.So(err, ShouldBeNil)
+	a.So(deleted, ShouldHaveLength, 1)
+	a.So(deleted[0].DeletedAt.IsZero(), ShouldBeFalse)
+
+	deleted, err = s.ListDeleted("")
+	a.So(err, ShouldBeNil)
+	a.So(deleted, ShouldHaveLength, 1)
+
+	// Soft delete a device that does not exist
+	err = s.SoftDelete(&Device{AppID: "AppID-1", DevID: "DevID-2"})
+	a.So(errors.GetErrType(err), ShouldEqual, errors.NotFound)
+
+	// Restore fails if a device with the same ID exists
+	a.So(s.Set(&Device{AppID: "AppID-1", DevID: "DevID-1"}), ShouldBeNil)
+	_, err = s.Restore("AppID-1", "DevID-1")
+	a.So(errors.GetErrType(err), ShouldEqual, errors.AlreadyExists)
+	a.So(s.Delete("AppID-1", "DevID-1"), ShouldBeNil)
+
+	// Restore
+	restored, err := s.Restore("AppID-1", "DevID-1")
+	a.So(err, ShouldBeNil)
+	a.So(restored.DeletedFCntDown, ShouldEqual, 0)
+
+	dev, err = s.Get("AppID-1", "DevID-1")
+	a.So(err, ShouldBeNil)
+	a.So(dev.FCntUp, ShouldEqual, 42)
+	a.So(dev.DeletedAt.IsZero(), ShouldBeTrue)
+	queue, _ = s.DownlinkQueue("AppID-1", "DevID-1")
+	length, _ = queue.Length()
+	a.So(length, ShouldEqual, 1)
+
+	_, err = s.GetDeleted("AppID-1", "DevID-1")
+	a.So(errors.GetErrType(err), ShouldEqual, errors.NotFound)
+
+	// Purge
+	dev.StartUpdate()
+	dev.DeletedAt = time.Now()
+	a.So(s.SoftDelete(dev), ShouldBeNil)
+	a.So(s.Purge("AppID-1", "DevID-1"), ShouldBeNil)
+	deleted, err = s.ListDeleted("AppID-1")
+	a.So(err, ShouldBeNil)
+	a.So(deleted, ShouldBeEmpty)
+}
@@ -0,0 +1,101 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+)
+
+// Twin contains the desired and reported state of a device. The desired fields are set by the application, the
+// reported fields are taken from the decoded uplink messages of the device. Only the fields that are desired are
+// reported.
+type Twin struct {
+	FPort    uint8                  `json:"f_port"`
+	Desired  map[string]interface{} `json:"desired,omitempty"`
+	Reported map[string]interface{} `json:"reported,omitempty"`
+
+	DesiredAt  time.Time `json:"desired_at"`
+	ReportedAt time.Time `json:"reported_at"`
+	PushedAt   time.Time `json:"pushed_at"`
+
+	UplinksSincePush uint32 `json:"uplinks_since_push"` // The number of uplink messages since the pending fields were sent
+}
+
+// Copy returns a deep copy of the twin, so that changes are detected when the device is saved
+func (t *Twin) Copy() *Twin {
+	copy := *t
+	copy.Desired = make(map[string]interface{}, len(t.Desired))
+	for field, value := range t.Desired {
+		copy.Desired[field] = value
+	}
+	copy.Reported = make(map[string]interface{}, len(t.Reported))
+	for field, value := range t.Reported {
+		copy.Reported[field] = value
+	}
+	return &copy
+}
+
+// Report updates the reported fields with the desired fields that are present in the decoded payload fields of an
+// uplink message. It returns true if any of the fields was reported.
+func (t *Twin) Report(fields map[string]interface{}, at time.Time) (reported bool) {
+	for field := range t.Desired {
+		value, ok := fields[field]
+		if !ok {
+			continue
+		}
+		if t.Reported == nil {
+			t.Reported = make(map[string]interface{})
+		}
+		t.Reported[field] = normalizeTwinValue(value)
+		reported = true
+	}
+	if reported {
+		t.ReportedAt = at
+	}
+	return
+}
+
+// Pending returns the names of the desired fields that do not match the reported fields, sorted by name
+func (t *Twin) Pending() (pending []string) {
+	for field, desired := range t.Desired {
+		reported, ok := t.Reported[field]
+		if !ok || !reflect.DeepEqual(normalizeTwinValue(desired), reported) {
+			pending = append(pending, field)
+		}
+	}
+	sort.Strings(pending)
+	return
+}
+
+// InSync returns true if the reported fields match the desired fields
+func (t *Twin) InSync() bool {
+	return len(t.Pending()) == 0
+}
+
+// PendingFields returns the desired values of the pending fields
+func (t *Twin) PendingFields() map[string]interface{} {
+	pending := t.Pending()
+	fields := make(map[string]interface{}, len(pending))
+	for _, field := range pending {
+		fields[field] = t.Desired[field]
+	}
+	return fields
+}
+
+// normalizeTwinValue converts a value to the type that it would have after JSON encoding and decoding, so that the
+// values from payload functions (such as int64) can be compared with the values from the API (such as float64)
+func normalizeTwinValue(value interface{}) interface{} {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return value
+	}
+	return normalized
+}
@@ -0,0 +1,40 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestTwin(t *testing.T) {
+	a := New(t)
+
+	twin := &Twin{
+		Desired: map[string]interface{}{
+			"interval": 60.0,
+			"led":      true,
+			"config":   map[string]interface{}{"mode": "eco"},
+		},
+	}
+	a.So(twin.InSync(), ShouldBeFalse)
+	a.So(twin.Pending(), ShouldResemble, []string{"config", "interval", "led"})
+
+	// Only desired fields are reported, numbers from payload functions match numbers from JSON
+	a.So(twin.Report(map[string]interface{}{"interval": int64(60), "temperature": 21.5}, time.Now()), ShouldBeTrue)
+	a.So(twin.Reported, ShouldNotContainKey, "temperature")
+	a.So(twin.Pending(), ShouldResemble, []string{"config", "led"})
+
+	a.So(twin.Report(map[string]interface{}{"temperature": 21.5}, time.Now()), ShouldBeFalse)
+
+	twin.Report(map[string]interface{}{"led": false, "config": map[string]interface{}{"mode": "eco"}}, time.Now())
+	a.So(twin.PendingFields(), ShouldResemble, map[string]interface{}{"led": true})
+
+	copy := twin.Copy()
+	copy.Report(map[string]interface{}{"led": true}, time.Now())
+	a.So(copy.InSync(), ShouldBeTrue)
+	a.So(twin.InSync(), ShouldBeFalse)
+}
@@ -0,0 +1,95 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/storage"
+)
+
+// UplinkHistoryLength is the maximum number of uplink messages that is stored for each device
+var UplinkHistoryLength = 20
+
+// UplinkHistory stores the last uplink messages of a device
+type UplinkHistory interface {
+	// Push an uplink message to the history. The oldest message is removed if the history is full.
+	Push(msg *pb.DeviceUplink) error
+	// Get the uplink messages in the history, newest first
+	Get() ([]*pb.DeviceUplink, error)
+	// Prune removes the uplink messages that were received before the given time and returns how many were removed
+	Prune(before time.Time) (int, error)
+	// Clear removes all uplink messages from the history and returns how many were removed
+	Clear() (int, error)
+}
+
+// RedisUplinkHistory implements the uplink history in Redis
+type RedisUplinkHistory struct {
+	appID   string
+	devID   string
+	uplinks *storage.RedisQueueStore
+}
+
+func (s *RedisUplinkHistory) key() string {
+	return fmt.Sprintf("%s:%s", s.appID, s.devID)
+}
+
+// Push an uplink message to the history
+func (s *RedisUplinkHistory) Push(msg *pb.DeviceUplink) error {
+	data, err := msg.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := s.uplinks.AddFront(s.key(), string(data)); err != nil {
+		return err
+	}
+	return s.uplinks.Trim(s.key(), UplinkHistoryLength)
+}
+
+// Get the uplink messages in the history
+func (s *RedisUplinkHistory) Get() ([]*pb.DeviceUplink, error) {
+	stored, err := s.uplinks.Get(s.key())
+	if err != nil {
+		return nil, err
+	}
+	uplinks := make([]*pb.DeviceUplink, 0, len(stored))
+	for _, data := range stored {
+		msg := new(pb.DeviceUplink)
+		if err := msg.Unmarshal([]byte(data)); err != nil {
+			return nil, err
+		}
+		uplinks = append(uplinks, msg)
+	}
+	return uplinks, nil
+}
+
+// Prune the uplink messages that were received before the given time
+func (s *RedisUplinkHistory) Prune(before time.Time) (int, error) {
+	uplinks, err := s.Get()
+	if err != nil {
+		return 0, err
+	}
+	keep := len(uplinks)
+	for keep > 0 && time.Unix(0, uplinks[keep-1].ServerTime).Before(before) {
+		keep--
+	}
+	if keep == len(uplinks) {
+		return 0, nil
+	}
+	if keep == 0 {
+		return len(uplinks), s.uplinks.Delete(s.key())
+	}
+	return len(uplinks) - keep, s.uplinks.Trim(s.key(), keep)
+}
+
+// Clear the uplink history
+func (s *RedisUplinkHistory) Clear() (int, error) {
+	length, err := s.uplinks.Length(s.key())
+	if err != nil {
+		return 0, err
+	}
+	return length, s.uplinks.Delete(s.key())
+}
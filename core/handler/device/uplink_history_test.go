@@ -0,0 +1,90 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"testing"
+	"time"
+
+	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestUplinkHistory(t *testing.T) {
+	a := New(t)
+
+	store := NewRedisDeviceStore(GetRedisClient(), "handler-test-uplink-history")
+	s, _ := store.UplinkHistory("test", "test")
+
+	defer func() {
+		store.Delete("test", "test")
+	}()
+
+	{
+		uplinks, err := s.Get()
+		a.So(err, ShouldBeNil)
+		a.So(uplinks, ShouldBeEmpty)
+	}
+
+	for i := 1; i <= UplinkHistoryLength+2; i++ {
+		err := s.Push(&pb.DeviceUplink{
+			Counter: uint32(i),
+			GatewayMetadata: []*pb_gateway.RxMetadata{
+				{GatewayId: "gtw-1", Rssi: -100, Snr: 5},
+				{GatewayId: "gtw-2", Rssi: -110, Snr: -2},
+			},
+		})
+		a.So(err, ShouldBeNil)
+	}
+
+	{
+		uplinks, err := s.Get()
+		a.So(err, ShouldBeNil)
+		a.So(uplinks, ShouldHaveLength, UplinkHistoryLength)
+		a.So(uplinks[0].Counter, ShouldEqual, UplinkHistoryLength+2)
+		a.So(uplinks[0].GatewayMetadata, ShouldHaveLength, 2)
+		a.So(uplinks[0].GatewayMetadata[1].GatewayId, ShouldEqual, "gtw-2")
+		a.So(uplinks[UplinkHistoryLength-1].Counter, ShouldEqual, 3)
+	}
+}
+
+func TestUplinkHistoryRetention(t *testing.T) {
+	a := New(t)
+
+	store := NewRedisDeviceStore(GetRedisClient(), "handler-test-uplink-history-retention")
+	s, _ := store.UplinkHistory("test", "test")
+
+	defer func() {
+		store.Delete("test", "test")
+	}()
+
+	now := time.Now()
+	for i := 5; i >= 0; i-- {
+		s.Push(&pb.DeviceUplink{
+			Counter:    uint32(5 - i),
+			ServerTime: now.Add(-1 * time.Duration(i) * 24 * time.Hour).UnixNano(),
+		})
+	}
+
+	pruned, err := s.Prune(now.Add(-36 * time.Hour))
+	a.So(err, ShouldBeNil)
+	a.So(pruned, ShouldEqual, 4)
+
+	uplinks, _ := s.Get()
+	a.So(uplinks, ShouldHaveLength, 2)
+	a.So(uplinks[1].Counter, ShouldEqual, 4)
+
+	pruned, err = s.Prune(now.Add(-36 * time.Hour))
+	a.So(err, ShouldBeNil)
+	a.So(pruned, ShouldEqual, 0)
+
+	cleared, err := s.Clear()
+	a.So(err, ShouldBeNil)
+	a.So(cleared, ShouldEqual, 2)
+
+	uplinks, _ = s.Get()
+	a.So(uplinks, ShouldBeEmpty)
+}
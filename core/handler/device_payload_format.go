@@ -0,0 +1,29 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+)
+
+// applyDevicePayloadFormat replaces the payload format of the application by the one of the device, if the device
+// overrides it, so that applications can mix devices with different frame formats. A codec of the device replaces the
+// payload functions of the application, including its port functions. The configuration of the format, such as the
+// binary fields, is taken from the application.
+func applyDevicePayloadFormat(app *application.Application, dev *device.Device) {
+	if dev == nil || (dev.PayloadFormat == "" && dev.Codec == "") {
+		return
+	}
+	if dev.PayloadFormat != "" {
+		app.PayloadFormat = dev.PayloadFormat
+		app.GoCodec = dev.GoCodec
+	}
+	if dev.Codec != "" {
+		app.PayloadFormat = pb.PayloadFormatCustom
+		app.Codec = dev.Codec
+	}
+	app.PortFunctions = nil
+}
@@ -0,0 +1,48 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/handler/devicerepository"
+)
+
+func (h *handler) WithDeviceRepository(url string) Handler {
+	h.deviceRepository = devicerepository.NewClient(url)
+	return h
+}
+
+// deviceFunctions returns the payload functions for messages of the device on the port. If the application has no
+// Decoder or Encoder for the port, the ones of the codec of the model of the device in the device repository are
+// used, so that devices of known hardware are decoded without custom payload functions. If the codec can not be
+// retrieved, the payload functions of the application are returned with the error. Codecs are JavaScript, so they are
+// not used for applications with Lua payload functions.
+func (h *handler) deviceFunctions(app *application.Application, dev *device.Device, port uint8) (application.PortFunctions, error) {
+	functions := app.FunctionsForPort(port)
+	if h.deviceRepository == nil || dev == nil || dev.Profile.ModelID == "" {
+		return functions, nil
+	}
+	if app.PayloadFormat != "" && app.PayloadFormat != pb.PayloadFormatCustom {
+		return functions, nil
+	}
+	if app.FunctionsLanguage == pb.FunctionsLanguageLua {
+		return functions, nil
+	}
+	if functions.Decoder != "" && functions.Encoder != "" {
+		return functions, nil
+	}
+	codec, err := h.deviceRepository.GetCodec(dev.Profile.BrandID, dev.Profile.ModelID, dev.Profile.FirmwareVersion)
+	if err != nil {
+		return functions, err
+	}
+	if functions.Decoder == "" {
+		functions.Decoder = codec.Decoder
+	}
+	if functions.Encoder == "" {
+		functions.Encoder = codec.Encoder
+	}
+	return functions, nil
+}
@@ -0,0 +1,83 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestDeviceFunctions(t *testing.T) {
+	a := New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/vendor/some-brand/some-model.yaml":
+			w.Write([]byte("firmwareVersions:\n  - version: '1.0'\n    profiles:\n      EU863-870:\n        codec: some-codec\n"))
+		case "/vendor/some-brand/some-codec.yaml":
+			w.Write([]byte("uplinkDecoder:\n  fileName: some-codec.js\ndownlinkEncoder:\n  fileName: some-codec.js\n"))
+		case "/vendor/some-brand/some-codec.js":
+			w.Write([]byte(`
+				function decodeUplink(input) { return { data: { temperature: input.bytes[0] } }; }
+				function encodeDownlink(input) { return { bytes: [input.data.interval], fPort: 2 }; }
+			`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	h := &handler{}
+	app := &application.Application{AppID: "some-app"}
+	dev := &device.Device{Profile: device.Profile{BrandID: "some-brand", ModelID: "some-model"}}
+
+	// Device repository not enabled
+	functions, err := h.deviceFunctions(app, dev, 1)
+	a.So(err, ShouldBeNil)
+	a.So(functions.Decoder, ShouldBeEmpty)
+
+	h.WithDeviceRepository(server.URL)
+
+	functions, err = h.deviceFunctions(app, dev, 1)
+	a.So(err, ShouldBeNil)
+	a.So(functions.Decoder, ShouldNotBeEmpty)
+	a.So(functions.Encoder, ShouldNotBeEmpty)
+
+	up := &UplinkFunctions{Decoder: functions.Decoder}
+	fields, err := up.Decode([]byte{21}, 1)
+	a.So(err, ShouldBeNil)
+	a.So(fields["temperature"], ShouldEqual, 21)
+
+	down := &DownlinkFunctions{Encoder: functions.Encoder}
+	encoded, err := down.EncodeDownlink(map[string]interface{}{"interval": 10}, 1)
+	a.So(err, ShouldBeNil)
+	a.So(encoded.Payload, ShouldResemble, []byte{10})
+	a.So(encoded.FPort, ShouldEqual, 2)
+
+	// The payload functions of the application take precedence
+	app.Decoder = "function Decoder(bytes, port) { return {}; }"
+	functions, err = h.deviceFunctions(app, dev, 1)
+	a.So(err, ShouldBeNil)
+	a.So(functions.Decoder, ShouldEqual, app.Decoder)
+	a.So(functions.Encoder, ShouldNotBeEmpty)
+
+	// Not for other payload formats
+	app.PayloadFormat = pb.PayloadFormatCayenneLPP
+	functions, err = h.deviceFunctions(app, dev, 1)
+	a.So(err, ShouldBeNil)
+	a.So(functions.Encoder, ShouldBeEmpty)
+	app.PayloadFormat = ""
+
+	// Unknown model
+	dev.Profile.ModelID = "unknown-model"
+	functions, err = h.deviceFunctions(app, dev, 1)
+	a.So(err, ShouldNotBeNil)
+	a.So(functions.Decoder, ShouldEqual, app.Decoder)
+}
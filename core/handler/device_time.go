@@ -0,0 +1,94 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+)
+
+// DeviceTimeField is the payload field that contains the time of the measurement as reported by the device. The
+// value must be an RFC3339 timestamp or a JavaScript Date.
+const DeviceTimeField = "time"
+
+// ClockSkewTolerance is the maximum time that the device time can be ahead of the network time before it is flagged
+var ClockSkewTolerance = 10 * time.Second
+
+// MaxDeviceTimeAge is the maximum time that the device time can be behind the network time before it is flagged.
+// Devices that buffer measurements while they are offline report times that are behind the network time.
+var MaxDeviceTimeAge = 30 * 24 * time.Hour
+
+// parseDeviceTime parses the device time in the payload field. The second return value is false if the value is not
+// a time
+func parseDeviceTime(value interface{}) (time.Time, bool) {
+	switch value := value.(type) {
+	case time.Time:
+		return value, !value.IsZero()
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+// reconcileDeviceTime validates the device time against the network time. It returns the device time, clamped to the
+// network time if it is in the future or too far in the past, and whether the clock skew is significant.
+func reconcileDeviceTime(deviceTime, networkTime time.Time) (time.Time, bool) {
+	skew := deviceTime.Sub(networkTime)
+	if skew > 0 {
+		return networkTime, skew > ClockSkewTolerance
+	}
+	if -skew > MaxDeviceTimeAge {
+		return networkTime, true
+	}
+	return deviceTime, false
+}
+
+// ConvertDeviceTime adds the time of the measurement as reported by the device to the metadata and emits an event if
+// the clock of the device is skewed
+func (h *handler) ConvertDeviceTime(ctx ttnlog.Interface, _ *pb_broker.DeduplicatedUplinkMessage, appUp *types.UplinkMessage, _ *device.Device) error {
+	deviceTime, ok := parseDeviceTime(appUp.PayloadFields[DeviceTimeField])
+	if !ok {
+		return nil
+	}
+
+	networkTime := time.Time(appUp.Metadata.Time)
+	if networkTime.IsZero() {
+		networkTime = time.Now()
+	}
+
+	reconciled, skewed := reconcileDeviceTime(deviceTime, networkTime)
+	appUp.Metadata.DeviceTime = types.JSONTime(reconciled.UTC())
+
+	if skewed {
+		data := types.ClockSkewEventData{
+			FCnt:        appUp.FCnt,
+			DeviceTime:  types.JSONTime(deviceTime.UTC()),
+			NetworkTime: types.JSONTime(networkTime.UTC()),
+			Skew:        deviceTime.Sub(networkTime).Seconds(),
+		}
+		var loggedDeviceTime interface{} = deviceTime
+		if isSensitiveField(h.sensitiveFieldsOf(appUp.AppID), DeviceTimeField) {
+			data.DeviceTime, data.Skew = types.JSONTime{}, 0
+			data.Redacted = true
+			loggedDeviceTime = RedactedValue
+		}
+		ctx.WithField("DeviceTime", loggedDeviceTime).WithField("NetworkTime", networkTime).Warn("Clock skew detected")
+		h.mqttEvent <- &types.DeviceEvent{
+			AppID: appUp.AppID,
+			DevID: appUp.DevID,
+			Event: types.ClockSkewEvent,
+			Data:  data,
+		}
+	}
+
+	return nil
+}
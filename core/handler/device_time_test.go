@@ -0,0 +1,109 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestParseDeviceTime(t *testing.T) {
+	a := New(t)
+	now := time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	parsed, ok := parseDeviceTime("2017-06-01T12:00:00Z")
+	a.So(ok, ShouldBeTrue)
+	a.So(parsed.Equal(now), ShouldBeTrue)
+
+	parsed, ok = parseDeviceTime(now)
+	a.So(ok, ShouldBeTrue)
+	a.So(parsed.Equal(now), ShouldBeTrue)
+
+	_, ok = parseDeviceTime("noon")
+	a.So(ok, ShouldBeFalse)
+	_, ok = parseDeviceTime(42)
+	a.So(ok, ShouldBeFalse)
+	_, ok = parseDeviceTime(nil)
+	a.So(ok, ShouldBeFalse)
+}
+
+func TestConvertDeviceTime(t *testing.T) {
+	a := New(t)
+	h := &handler{
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-convert-device-time"),
+		mqttEvent:    make(chan *types.DeviceEvent, 10),
+	}
+	ctx := GetLogger(t, "TestConvertDeviceTime")
+	networkTime := time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	uplink := func(deviceTime interface{}) *types.UplinkMessage {
+		return &types.UplinkMessage{
+			AppID:         "AppID-1",
+			DevID:         "DevID-1",
+			FCnt:          42,
+			PayloadFields: map[string]interface{}{DeviceTimeField: deviceTime},
+			Metadata:      types.Metadata{Time: types.JSONTime(networkTime)},
+		}
+	}
+
+	// Without device time
+	appUp := uplink(nil)
+	err := h.ConvertDeviceTime(ctx, nil, appUp, nil)
+	a.So(err, ShouldBeNil)
+	a.So(time.Time(appUp.Metadata.DeviceTime).IsZero(), ShouldBeTrue)
+
+	// Buffered measurement
+	appUp = uplink(networkTime.Add(-time.Hour).Format(time.RFC3339))
+	err = h.ConvertDeviceTime(ctx, nil, appUp, nil)
+	a.So(err, ShouldBeNil)
+	a.So(time.Time(appUp.Metadata.DeviceTime).Equal(networkTime.Add(-time.Hour)), ShouldBeTrue)
+	a.So(h.mqttEvent, ShouldBeEmpty)
+
+	// Small skew is clamped without an event
+	appUp = uplink(networkTime.Add(time.Second))
+	err = h.ConvertDeviceTime(ctx, nil, appUp, nil)
+	a.So(err, ShouldBeNil)
+	a.So(time.Time(appUp.Metadata.DeviceTime).Equal(networkTime), ShouldBeTrue)
+	a.So(h.mqttEvent, ShouldBeEmpty)
+
+	// Device time in the future
+	appUp = uplink(networkTime.Add(30 * time.Second))
+	err = h.ConvertDeviceTime(ctx, nil, appUp, nil)
+	a.So(err, ShouldBeNil)
+	a.So(time.Time(appUp.Metadata.DeviceTime).Equal(networkTime), ShouldBeTrue)
+	a.So(h.mqttEvent, ShouldHaveLength, 1)
+	evt := <-h.mqttEvent
+	a.So(evt.Event, ShouldEqual, types.ClockSkewEvent)
+	data := evt.Data.(types.ClockSkewEventData)
+	a.So(data.FCnt, ShouldEqual, 42)
+	a.So(data.Skew, ShouldEqual, 30)
+
+	// Device clock was reset
+	appUp = uplink(time.Unix(0, 0))
+	err = h.ConvertDeviceTime(ctx, nil, appUp, nil)
+	a.So(err, ShouldBeNil)
+	a.So(time.Time(appUp.Metadata.DeviceTime).Equal(networkTime), ShouldBeTrue)
+	a.So(h.mqttEvent, ShouldHaveLength, 1)
+	evt = <-h.mqttEvent
+	a.So(evt.Data.(types.ClockSkewEventData).Skew, ShouldBeLessThan, 0)
+
+	// Sensitive device time is redacted from the event
+	h.applications.Set(&application.Application{AppID: "AppID-1", SensitiveFields: []string{DeviceTimeField}})
+	defer h.applications.Delete("AppID-1")
+	appUp = uplink(networkTime.Add(30 * time.Second))
+	err = h.ConvertDeviceTime(ctx, nil, appUp, nil)
+	a.So(err, ShouldBeNil)
+	a.So(time.Time(appUp.Metadata.DeviceTime).Equal(networkTime), ShouldBeTrue)
+	a.So(h.mqttEvent, ShouldHaveLength, 1)
+	evt = <-h.mqttEvent
+	data = evt.Data.(types.ClockSkewEventData)
+	a.So(data.Redacted, ShouldBeTrue)
+	a.So(time.Time(data.DeviceTime).IsZero(), ShouldBeTrue)
+	a.So(data.Skew, ShouldEqual, 0)
+}
@@ -0,0 +1,208 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+// Package devicerepository gets the payload codecs of devices from the TTN device repository
+// (https://github.com/TheThingsNetwork/lorawan-devices), so that devices of known hardware are decoded without
+// custom payload functions.
+package devicerepository
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// DefaultURL is the URL of the TTN device repository
+const DefaultURL = "https://raw.githubusercontent.com/TheThingsNetwork/lorawan-devices/master"
+
+// CacheDuration is the time that codecs (and models that have no codec) are cached
+var CacheDuration = time.Hour
+
+// Codec contains the payload functions of a device model in the device repository. The functions are wrapped in a
+// Decoder and Encoder, so that they can be used as payload functions of the Handler.
+type Codec struct {
+	ID      string
+	Decoder string
+	Encoder string
+}
+
+// Client gets codecs from the device repository
+type Client struct {
+	URL    string
+	Client *http.Client
+
+	mu    sync.Mutex
+	cache map[string]cachedCodec
+}
+
+type cachedCodec struct {
+	codec   *Codec
+	err     error
+	expires time.Time
+}
+
+// NewClient returns a new Client for the device repository at the given URL (DefaultURL if empty)
+func NewClient(url string) *Client {
+	if url == "" {
+		url = DefaultURL
+	}
+	return &Client{
+		URL:    strings.TrimSuffix(url, "/"),
+		Client: &http.Client{Timeout: 10 * time.Second},
+		cache:  make(map[string]cachedCodec),
+	}
+}
+
+type model struct {
+	FirmwareVersions []struct {
+		Version  string `yaml:"version"`
+		Profiles map[string]struct {
+			Codec string `yaml:"codec"`
+		} `yaml:"profiles"`
+	} `yaml:"firmwareVersions"`
+}
+
+type codecDefinition struct {
+	UplinkDecoder struct {
+		FileName string `yaml:"fileName"`
+	} `yaml:"uplinkDecoder"`
+	DownlinkEncoder struct {
+		FileName string `yaml:"fileName"`
+	} `yaml:"downlinkEncoder"`
+}
+
+// decoderWrapper calls the decodeUplink function of the device repository as Decoder
+const decoderWrapper = `
+function Decoder(bytes, port) {
+	var result = decodeUplink({ bytes: bytes, fPort: port });
+	if (result.errors && result.errors.length) {
+		throw new Error(result.errors.join(", "));
+	}
+	return result.data;
+}`
+
+// encoderWrapper calls the encodeDownlink function of the device repository as Encoder
+const encoderWrapper = `
+function Encoder(object, port) {
+	var result = encodeDownlink({ data: object, fPort: port });
+	if (result.errors && result.errors.length) {
+		throw new Error(result.errors.join(", "));
+	}
+	return { bytes: result.bytes, fPort: result.fPort };
+}`
+
+// GetCodec returns the codec of the firmware version of the model of the brand. If the firmware version is empty,
+// the latest firmware version is used. Codecs are cached for the CacheDuration.
+func (c *Client) GetCodec(brandID, modelID, firmwareVersion string) (*Codec, error) {
+	key := strings.Join([]string{brandID, modelID, firmwareVersion}, "/")
+	c.mu.Lock()
+	cached, ok := c.cache[key]
+	c.mu.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.codec, cached.err
+	}
+
+	codec, err := c.getCodec(brandID, modelID, firmwareVersion)
+
+	// Errors other than missing models and codecs are not cached, so that they are retried
+	if err == nil || errors.GetErrType(err) == errors.NotFound {
+		c.mu.Lock()
+		c.cache[key] = cachedCodec{codec: codec, err: err, expires: time.Now().Add(CacheDuration)}
+		c.mu.Unlock()
+	}
+	return codec, err
+}
+
+func (c *Client) getCodec(brandID, modelID, firmwareVersion string) (*Codec, error) {
+	var m model
+	if err := c.getYAML(fmt.Sprintf("vendor/%s/%s.yaml", brandID, modelID), &m); err != nil {
+		return nil, err
+	}
+	if len(m.FirmwareVersions) == 0 {
+		return nil, errors.NewErrNotFound(fmt.Sprintf("Firmware versions of %s/%s", brandID, modelID))
+	}
+	firmware := m.FirmwareVersions[len(m.FirmwareVersions)-1]
+	if firmwareVersion != "" {
+		var found bool
+		for _, candidate := range m.FirmwareVersions {
+			if candidate.Version == firmwareVersion {
+				firmware, found = candidate, true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.NewErrNotFound(fmt.Sprintf("Firmware version %s of %s/%s", firmwareVersion, brandID, modelID))
+		}
+	}
+
+	// The codec is the same for all regions in practice; sort the regions to choose deterministically
+	var codecID string
+	regions := make([]string, 0, len(firmware.Profiles))
+	for region := range firmware.Profiles {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	for _, region := range regions {
+		if codecID = firmware.Profiles[region].Codec; codecID != "" {
+			break
+		}
+	}
+	if codecID == "" {
+		return nil, errors.NewErrNotFound(fmt.Sprintf("Codec of %s/%s", brandID, modelID))
+	}
+
+	var definition codecDefinition
+	if err := c.getYAML(fmt.Sprintf("vendor/%s/%s.yaml", brandID, codecID), &definition); err != nil {
+		return nil, err
+	}
+	codec := &Codec{ID: codecID}
+	if fileName := definition.UplinkDecoder.FileName; fileName != "" {
+		source, err := c.get(fmt.Sprintf("vendor/%s/%s", brandID, fileName))
+		if err != nil {
+			return nil, err
+		}
+		codec.Decoder = string(source) + "\n" + decoderWrapper
+	}
+	if fileName := definition.DownlinkEncoder.FileName; fileName != "" {
+		source, err := c.get(fmt.Sprintf("vendor/%s/%s", brandID, fileName))
+		if err != nil {
+			return nil, err
+		}
+		codec.Encoder = string(source) + "\n" + encoderWrapper
+	}
+	return codec, nil
+}
+
+func (c *Client) getYAML(path string, out interface{}) error {
+	data, err := c.get(path)
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(data, out); err != nil {
+		return errors.Wrap(err, fmt.Sprintf("Could not decode %s from device repository", path))
+	}
+	return nil
+}
+
+func (c *Client) get(path string) ([]byte, error) {
+	resp, err := c.Client.Get(c.URL + "/" + path)
+	if err != nil {
+		return nil, errors.Wrap(err, "Could not get "+path+" from device repository")
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, errors.NewErrNotFound(path + " in device repository")
+	default:
+		return nil, fmt.Errorf("Could not get %s from device repository: status %d", path, resp.StatusCode)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
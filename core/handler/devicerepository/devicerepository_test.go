@@ -0,0 +1,97 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package devicerepository
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	. "github.com/smartystreets/assertions"
+)
+
+var repository = map[string]string{
+	"/vendor/some-brand/some-model.yaml": `
+name: Some Model
+firmwareVersions:
+  - version: '1.0'
+    profiles:
+      EU863-870:
+        id: some-profile
+        codec: some-model-codec-v1
+  - version: '2.0'
+    profiles:
+      US902-928:
+        id: some-profile
+        codec: some-model-codec
+      EU863-870:
+        id: some-profile
+        codec: some-model-codec
+`,
+	"/vendor/some-brand/some-model-codec.yaml": `
+uplinkDecoder:
+  fileName: some-model.js
+downlinkEncoder:
+  fileName: some-model.js
+`,
+	"/vendor/some-brand/some-model-codec-v1.yaml": `
+uplinkDecoder:
+  fileName: some-model-v1.js
+`,
+	"/vendor/some-brand/some-model.js":    "function decodeUplink(input) { return { data: { version: 2 } }; }",
+	"/vendor/some-brand/some-model-v1.js": "function decodeUplink(input) { return { data: { version: 1 } }; }",
+	"/vendor/some-brand/other-model.yaml": `
+firmwareVersions:
+  - version: '1.0'
+`,
+}
+
+func TestGetCodec(t *testing.T) {
+	a := New(t)
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if content, ok := repository[r.URL.Path]; ok {
+			w.Write([]byte(content))
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL + "/")
+
+	codec, err := c.GetCodec("some-brand", "some-model", "")
+	a.So(err, ShouldBeNil)
+	a.So(codec.ID, ShouldEqual, "some-model-codec")
+	a.So(codec.Decoder, ShouldStartWith, repository["/vendor/some-brand/some-model.js"])
+	a.So(codec.Decoder, ShouldContainSubstring, "function Decoder(bytes, port)")
+	a.So(codec.Encoder, ShouldContainSubstring, "function Encoder(object, port)")
+
+	codec, err = c.GetCodec("some-brand", "some-model", "1.0")
+	a.So(err, ShouldBeNil)
+	a.So(codec.ID, ShouldEqual, "some-model-codec-v1")
+	a.So(strings.Contains(codec.Decoder, "version: 1"), ShouldBeTrue)
+	a.So(codec.Encoder, ShouldBeEmpty)
+
+	_, err = c.GetCodec("some-brand", "some-model", "3.0")
+	a.So(errors.GetErrType(err), ShouldEqual, errors.NotFound)
+
+	_, err = c.GetCodec("some-brand", "other-model", "")
+	a.So(errors.GetErrType(err), ShouldEqual, errors.NotFound)
+
+	_, err = c.GetCodec("some-brand", "unknown-model", "")
+	a.So(errors.GetErrType(err), ShouldEqual, errors.NotFound)
+
+	// Codecs and missing models are cached
+	before := requests
+	_, err = c.GetCodec("some-brand", "some-model", "")
+	a.So(err, ShouldBeNil)
+	_, err = c.GetCodec("some-brand", "unknown-model", "")
+	a.So(err, ShouldNotBeNil)
+	a.So(requests, ShouldEqual, before)
+}
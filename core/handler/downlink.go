@@ -4,15 +4,21 @@
 package handler
 
 import (
+	"fmt"
 	"time"
 
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
 	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
 	"github.com/TheThingsNetwork/ttn/api/trace"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/random"
 )
 
+// DownlinkIdempotencyWindow indicates how long idempotency keys of downlinks are remembered
+var DownlinkIdempotencyWindow = 24 * time.Hour
+
 func (h *handler) EnqueueDownlink(appDownlink *types.DownlinkMessage) (err error) {
 	appID, devID := appDownlink.AppID, appDownlink.DevID
 	ctx := h.Ctx.WithFields(ttnlog.Fields{
@@ -29,15 +35,24 @@ func (h *handler) EnqueueDownlink(appDownlink *types.DownlinkMessage) (err error
 		}
 	}()
 
+	// Downlinks that are enqueued during a transfer would be lost
+	if err = h.checkTransfer(appID); err != nil {
+		return err
+	}
+
 	// Check if device exists
 	dev, err := h.devices.Get(appID, devID)
 	if err != nil {
 		return err
 	}
 	dev.StartUpdate()
+	h.debugging.set(appID, devID, dev.DebugUntil)
 
 	defer func() {
 		if err != nil {
+			h.captureDebug(appID, devID, device.DebugTraceScheduling, "Could not schedule downlink", map[string]string{
+				"error": err.Error(),
+			})
 			h.mqttEvent <- &types.DeviceEvent{
 				AppID: appID,
 				DevID: devID,
@@ -50,6 +65,62 @@ func (h *handler) EnqueueDownlink(appDownlink *types.DownlinkMessage) (err error
 		}
 	}()
 
+	switch appDownlink.Schedule {
+	case types.ScheduleReplace, types.ScheduleFirst, types.ScheduleLast, "": // Empty string for default
+	default:
+		return errors.NewErrInvalidArgument("ScheduleType", "unknown")
+	}
+
+	// Encode the fields once, so that the size of the payload and the port that the Encoder chose are known before
+	// the downlink is scheduled. The queued downlink only contains the encoded payload.
+	if err = h.ConvertFieldsDown(ctx, appDownlink, nil, dev); err != nil {
+		return err
+	}
+	if appDownlink.PayloadRaw != nil {
+		appDownlink.PayloadFields = nil
+	}
+
+	if err = h.checkPayloadSize(dev, appDownlink); err != nil {
+		return err
+	}
+
+	appDownlink.ID = random.String(16)
+
+	if appDownlink.IdempotencyKey != "" {
+		var originalID string
+		originalID, err = h.devices.ClaimIdempotencyKey(appID, devID, appDownlink.IdempotencyKey, appDownlink.ID, DownlinkIdempotencyWindow)
+		if err != nil {
+			return err
+		}
+		if originalID == appDownlink.ID {
+			// Release the key if the downlink is not scheduled, so that it can be retried
+			defer func(key string) {
+				if err != nil {
+					h.devices.ReleaseIdempotencyKey(appID, devID, key)
+				}
+			}(appDownlink.IdempotencyKey)
+		} else {
+			ctx.WithField("ID", originalID).Debug("Downlink with this idempotency key was already scheduled")
+			h.captureDebug(appID, devID, device.DebugTraceScheduling, "Downlink with this idempotency key was already scheduled", map[string]string{
+				"id":              originalID,
+				"idempotency_key": appDownlink.IdempotencyKey,
+			})
+			appDownlink.ID = originalID
+			appDownlink.AppID = ""
+			appDownlink.DevID = ""
+			h.mqttEvent <- &types.DeviceEvent{
+				AppID: appID,
+				DevID: devID,
+				Event: types.DownlinkScheduledEvent,
+				Data: types.DownlinkEventData{
+					Message:   appDownlink,
+					Duplicate: true,
+				},
+			}
+			return nil
+		}
+	}
+
 	// Clear redundant fields
 	appDownlink.AppID = ""
 	appDownlink.DevID = ""
@@ -70,24 +141,32 @@ func (h *handler) EnqueueDownlink(appDownlink *types.DownlinkMessage) (err error
 		err = queue.PushFirst(appDownlink)
 	case types.ScheduleLast:
 		err = queue.PushLast(appDownlink)
-	default:
-		return errors.NewErrInvalidArgument("ScheduleType", "unknown")
 	}
 
 	if err != nil {
 		return err
 	}
 
-	if err := h.devices.Set(dev); err != nil {
+	if err = h.devices.Set(dev); err != nil {
 		return err
 	}
 
+	if schedule == "" {
+		schedule = types.ScheduleReplace
+	}
+	h.captureDebug(appID, devID, device.DebugTraceScheduling, "Scheduled downlink", map[string]string{
+		"id":        appDownlink.ID,
+		"schedule":  string(schedule),
+		"port":      fmt.Sprint(appDownlink.FPort),
+		"confirmed": fmt.Sprint(appDownlink.Confirmed),
+	})
+
 	h.mqttEvent <- &types.DeviceEvent{
 		AppID: appID,
 		DevID: devID,
 		Event: types.DownlinkScheduledEvent,
 		Data: types.DownlinkEventData{
-			Message: appDownlink,
+			Message: h.decodedDownlink(appID, devID, appDownlink),
 		},
 	}
 
@@ -117,6 +196,9 @@ func (h *handler) HandleDownlink(appDownlink *types.DownlinkMessage, downlink *p
 			}
 			ctx.WithError(err).Warn("Could not handle downlink")
 			downlink.Trace = downlink.Trace.WithEvent(trace.DropEvent, "reason", err)
+			h.captureDebug(appID, devID, device.DebugTraceScheduling, "Could not send downlink", map[string]string{
+				"error": err.Error(),
+			})
 		}
 		if downlink != nil && h.monitorStream != nil {
 			h.monitorStream.Send(downlink)
@@ -155,6 +237,11 @@ func (h *handler) HandleDownlink(appDownlink *types.DownlinkMessage, downlink *p
 		}
 	}
 
+	// Only critical downlinks are transmitted by a second gateway
+	if !appDownlink.Critical {
+		downlink.DiversityDownlinkOption = nil
+	}
+
 	downlink.Message = nil
 	downlink.UnmarshalPayload()
 
@@ -165,6 +252,7 @@ func (h *handler) HandleDownlink(appDownlink *types.DownlinkMessage, downlink *p
 	downlink.Trace = downlink.Trace.WithEvent(trace.ForwardEvent, "broker", h.ttnBrokerID)
 
 	h.downlink <- downlink
+	h.trackDownlink(downlink)
 
 	downlinkConfig := types.DownlinkEventConfigInfo{}
 
@@ -181,13 +269,21 @@ func (h *handler) HandleDownlink(appDownlink *types.DownlinkMessage, downlink *p
 		downlinkConfig.Power = int(downlink.DownlinkOption.GatewayConfig.Power)
 	}
 
+	h.captureDebug(appID, devID, device.DebugTraceScheduling, "Sent downlink", map[string]string{
+		"id":         appDownlink.ID,
+		"gateway_id": downlink.DownlinkOption.GetGatewayId(),
+		"frequency":  fmt.Sprint(downlinkConfig.Frequency),
+		"data_rate":  downlinkConfig.DataRate,
+		"f_cnt":      fmt.Sprint(downlinkConfig.FCnt),
+	})
+
 	h.mqttEvent <- &types.DeviceEvent{
 		AppID: appDownlink.AppID,
 		DevID: appDownlink.DevID,
 		Event: types.DownlinkSentEvent,
 		Data: types.DownlinkEventData{
 			Payload:   downlink.Payload,
-			Message:   appDownlink,
+			Message:   h.decodedDownlink(appID, devID, appDownlink),
 			GatewayID: downlink.DownlinkOption.GatewayId,
 			Config:    downlinkConfig,
 		},
@@ -0,0 +1,48 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+)
+
+// MissedDownlinkThreshold is the number of consecutive uplinks that do not acknowledge a pending confirmed downlink
+// after which a down/missed event is published
+var MissedDownlinkThreshold uint32 = 3
+
+// trackDownlinkDelivery counts an uplink of the device that did or did not acknowledge the pending confirmed downlink,
+// and publishes a down/missed event when the device missed the downlink MissedDownlinkThreshold times in a row. Uplinks
+// during a maintenance window of the application are not counted.
+func (h *handler) trackDownlinkDelivery(dev *device.Device, ack bool) {
+	if app, err := h.applications.Get(dev.AppID); err == nil && api.InMaintenance(app.MaintenanceWindows, time.Now()) {
+		return
+	}
+	if dev.DownlinkDelivery == nil {
+		dev.DownlinkDelivery = new(device.DownlinkDelivery)
+	}
+	dev.DownlinkDelivery.Add(ack)
+	if dev.DownlinkDelivery.ConsecutiveMissed != MissedDownlinkThreshold {
+		return
+	}
+	h.Ctx.WithFields(ttnlog.Fields{
+		"AppID":  dev.AppID,
+		"DevID":  dev.DevID,
+		"Missed": dev.DownlinkDelivery.ConsecutiveMissed,
+	}).Warn("Device missed confirmed downlink")
+	h.mqttEvent <- &types.DeviceEvent{
+		AppID: dev.AppID,
+		DevID: dev.DevID,
+		Event: types.DownlinkMissedEvent,
+		Data: types.DownlinkMissedEventData{
+			Message:           dev.CurrentDownlink,
+			ConsecutiveMissed: dev.DownlinkDelivery.ConsecutiveMissed,
+			DeliveryRate:      dev.DownlinkDelivery.Rate(),
+		},
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestTrackDownlinkDelivery(t *testing.T) {
+	a := New(t)
+	appID := "appid"
+	h := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestTrackDownlinkDelivery")},
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-track-downlink-delivery"),
+		mqttEvent:    make(chan *types.DeviceEvent, 10),
+	}
+	app := &application.Application{AppID: appID}
+	h.applications.Set(app)
+	defer h.applications.Delete(appID)
+
+	dev := &device.Device{AppID: appID, DevID: "devid", CurrentDownlink: &types.DownlinkMessage{Confirmed: true}}
+	for i := uint32(0); i < MissedDownlinkThreshold; i++ {
+		h.trackDownlinkDelivery(dev, false)
+	}
+	a.So(h.mqttEvent, ShouldHaveLength, 1)
+	event := <-h.mqttEvent
+	a.So(event.Event, ShouldEqual, types.DownlinkMissedEvent)
+
+	h.trackDownlinkDelivery(dev, true)
+	a.So(dev.DownlinkDelivery.Acked, ShouldEqual, 1)
+
+	// Uplinks during maintenance windows are not counted
+	app, _ = h.applications.Get(appID)
+	app.StartUpdate()
+	app.MaintenanceWindows = []*api.MaintenanceWindow{{Start: time.Now().Add(-time.Minute).UnixNano(), Duration: 3600}}
+	h.applications.Set(app)
+	for i := uint32(0); i < MissedDownlinkThreshold; i++ {
+		h.trackDownlinkDelivery(dev, false)
+	}
+	a.So(h.mqttEvent, ShouldHaveLength, 0)
+	a.So(dev.DownlinkDelivery.ConsecutiveMissed, ShouldEqual, 0)
+}
@@ -0,0 +1,265 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"encoding/json"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/handler/functions"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/toa"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// loRaWANOverhead is the length of the LoRaWAN header and MIC around the application payload
+const loRaWANOverhead = 13
+
+type transmission struct {
+	time    time.Time
+	airtime time.Duration
+}
+
+// dutyCycle keeps track of the last downlink transmission on each frequency of each gateway.
+// It only knows about the downlink messages that were sent by this Handler.
+type dutyCycle struct {
+	mu            sync.Mutex
+	transmissions map[string]map[uint64]transmission
+}
+
+func (d *dutyCycle) transmit(gatewayID string, frequency uint64, airtime time.Duration) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.transmissions == nil {
+		d.transmissions = make(map[string]map[uint64]transmission)
+	}
+	if _, ok := d.transmissions[gatewayID]; !ok {
+		d.transmissions[gatewayID] = make(map[uint64]transmission)
+	}
+	d.transmissions[gatewayID][frequency] = transmission{time: time.Now(), airtime: airtime}
+}
+
+// wait returns the time until the gateway is allowed to transmit on the frequency again
+func (d *dutyCycle) wait(gatewayID string, frequency uint64, duty float64) time.Duration {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	last, ok := d.transmissions[gatewayID][frequency]
+	if !ok || duty <= 0 {
+		return 0
+	}
+	wait := last.time.Add(time.Duration(float64(last.airtime) / duty)).Sub(time.Now())
+	if wait < 0 {
+		return 0
+	}
+	return wait
+}
+
+// trackDownlink registers the transmission of a downlink message in the duty cycle of the gateway
+func (h *handler) trackDownlink(downlink *pb_broker.DownlinkMessage) {
+	option := downlink.DownlinkOption
+	if option == nil || option.GatewayConfig == nil {
+		return
+	}
+	lorawan := option.GetProtocolConfig().GetLorawan()
+	if lorawan == nil {
+		return
+	}
+	var airtime time.Duration
+	switch lorawan.Modulation {
+	case pb_lorawan.Modulation_LORA:
+		airtime, _ = toa.ComputeLoRa(uint(len(downlink.Payload)), lorawan.DataRate, lorawan.CodingRate)
+	case pb_lorawan.Modulation_FSK:
+		airtime, _ = toa.ComputeFSK(uint(len(downlink.Payload)), int(lorawan.BitRate))
+	}
+	if airtime == 0 {
+		return
+	}
+	h.dutyCycle.transmit(option.GatewayId, option.GatewayConfig.Frequency, airtime)
+}
+
+// signalScore is lower for gateways that received the uplink better (see the downlink scores of the Router)
+func signalScore(gateway *pb_gateway.RxMetadata) (score float64) {
+	if gateway.Snr < 5 {
+		score += 10
+	}
+	score += math.Min(float64(gateway.Rssi*-0.1), 10)
+	return
+}
+
+// lastUplink returns the last uplink of the device and its LoRaWAN metadata
+func (h *handler) lastUplink(dev *device.Device) (*pb.DeviceUplink, *pb_lorawan.Metadata, error) {
+	history, err := h.devices.UplinkHistory(dev.AppID, dev.DevID)
+	if err != nil {
+		return nil, nil, err
+	}
+	uplinks, err := history.Get()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(uplinks) == 0 {
+		return nil, nil, errors.NewErrNotFound("Uplink")
+	}
+	uplink := uplinks[0]
+
+	lorawan := uplink.GetProtocolMetadata().GetLorawan()
+	if lorawan == nil {
+		return nil, nil, errors.NewErrInvalidArgument("Uplink", "No LoRaWAN metadata")
+	}
+	return uplink, lorawan, nil
+}
+
+// downlinkDataRate returns the data rate of a downlink to the device after an uplink with the given metadata
+func downlinkDataRate(fp band.FrequencyPlan, dev *device.Device, uplink *pb_lorawan.Metadata) (string, error) {
+	if dev.Options.RxWindow == pb_lorawan.RxWindow_RX2 {
+		return fp.GetDataRateStringForIndex(fp.RX2DataRate)
+	}
+	upDR, err := fp.GetDataRateIndexFor(uplink.DataRate)
+	if err != nil {
+		return "", err
+	}
+	downDR, err := fp.GetRX1DataRate(upDR, 0)
+	if err != nil {
+		return "", err
+	}
+	return fp.GetDataRateStringForIndex(downDR)
+}
+
+// previewDownlink estimates the transmission of the payload to the device, based on the last uplink of the device
+func (h *handler) previewDownlink(dev *device.Device, payload []byte) (*pb.DownlinkPreview, error) {
+	uplink, lorawan, err := h.lastUplink(dev)
+	if err != nil {
+		return nil, err
+	}
+
+	var gateway *pb_gateway.RxMetadata
+	for _, md := range uplink.GatewayMetadata {
+		if gateway == nil || signalScore(md) < signalScore(gateway) {
+			gateway = md
+		}
+	}
+	if gateway == nil {
+		return nil, errors.NewErrNotFound("Gateway")
+	}
+
+	region := lorawan.FrequencyPlan.String()
+	fp, err := band.Get(region)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &pb.DownlinkPreview{
+		Payload:   payload,
+		GatewayId: gateway.GatewayId,
+	}
+
+	preview.DataRate, err = downlinkDataRate(fp, dev, lorawan)
+	if err != nil {
+		return nil, err
+	}
+
+	if dev.Options.RxWindow == pb_lorawan.RxWindow_RX2 {
+		preview.RxWindow = pb_lorawan.RxWindow_RX2
+		preview.Frequency = uint64(fp.RX2Frequency)
+	} else {
+		preview.RxWindow = pb_lorawan.RxWindow_RX1
+		freq, err := fp.GetRX1Frequency(int(gateway.Frequency))
+		if err != nil {
+			return nil, err
+		}
+		preview.Frequency = uint64(freq)
+	}
+
+	var airtime time.Duration
+	if lorawan.Modulation == pb_lorawan.Modulation_FSK {
+		airtime, err = toa.ComputeFSK(uint(len(payload)+loRaWANOverhead), int(lorawan.BitRate))
+	} else {
+		airtime, err = toa.ComputeLoRa(uint(len(payload)+loRaWANOverhead), preview.DataRate, "4/5")
+	}
+	if err != nil {
+		return nil, err
+	}
+	preview.Airtime = airtime.Nanoseconds()
+
+	duty, err := band.DutyCycle(region, preview.Frequency)
+	if err != nil {
+		return preview, nil // Transmissions on this frequency are forbidden
+	}
+	preview.DutyCycle = float32(duty)
+
+	wait := h.dutyCycle.wait(preview.GatewayId, preview.Frequency, duty)
+	preview.Allowed = wait == 0
+	preview.Wait = wait.Nanoseconds()
+
+	return preview, nil
+}
+
+// PreviewDownlink estimates the time on air of a downlink message to the device, the gateway that would transmit it,
+// and whether the duty cycle allows it to be transmitted now, without actually going to the network.
+func (h *handlerManager) PreviewDownlink(ctx context.Context, in *pb.DownlinkPreviewRequest) (*pb.DownlinkPreview, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Downlink Preview Request")
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.Devices)
+	if err != nil {
+		return nil, err
+	}
+
+	dev, err := h.handler.devices.Get(in.AppId, in.DevId)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := in.Payload
+	if payload != nil {
+		if in.Fields != "" {
+			return nil, errors.NewErrInvalidArgument("Downlink", "Both Fields and Payload provided")
+		}
+	} else {
+		if in.Fields == "" {
+			return nil, errors.NewErrInvalidArgument("Downlink", "Neither Fields nor Payload provided")
+		}
+
+		app, err := h.handler.applications.Get(in.AppId)
+		if err != nil {
+			return nil, err
+		}
+		applyDevicePayloadFormat(app, dev)
+		if err := h.handler.resolveCodec(app); err != nil {
+			return nil, err
+		}
+		portFunctions, err := h.handler.deviceFunctions(app, dev, uint8(in.Port))
+		if err != nil {
+			return nil, err
+		}
+		if portFunctions.Encoder == "" && !builtinPayloadFormat(app.PayloadFormat) {
+			return nil, errors.NewErrInvalidArgument("Encoder", "Not specified")
+		}
+
+		var parsed map[string]interface{}
+		if err := json.Unmarshal([]byte(in.Fields), &parsed); err != nil {
+			return nil, errors.NewErrInvalidArgument("Fields", err.Error())
+		}
+
+		functions := h.handler.downlinkFunctions(app, portFunctions, functions.Ignore)
+		payload, _, err = functions.Process(parsed, uint8(in.Port))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return h.handler.previewDownlink(dev, payload)
+}
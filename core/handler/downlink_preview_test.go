@@ -0,0 +1,89 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+	"time"
+
+	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	pb_protocol "github.com/TheThingsNetwork/ttn/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestDutyCycle(t *testing.T) {
+	a := New(t)
+
+	var d dutyCycle
+	a.So(d.wait("gtw", 868100000, 0.01), ShouldEqual, 0)
+
+	d.transmit("gtw", 868100000, 50*time.Millisecond)
+	a.So(d.wait("gtw", 868100000, 0.01), ShouldBeBetween, 4900*time.Millisecond, 5*time.Second)
+	a.So(d.wait("gtw", 868100000, 1), ShouldEqual, 0)
+	a.So(d.wait("gtw", 869525000, 0.1), ShouldEqual, 0)
+	a.So(d.wait("other-gtw", 868100000, 0.01), ShouldEqual, 0)
+}
+
+func TestPreviewDownlink(t *testing.T) {
+	a := New(t)
+	h := &handler{
+		Component: &component.Component{Ctx: GetLogger(t, "TestPreviewDownlink")},
+		devices:   device.NewRedisDeviceStore(GetRedisClient(), "handler-test-preview-downlink"),
+	}
+	dev := &device.Device{
+		AppID: "appid",
+		DevID: "devid",
+	}
+	h.devices.Set(dev)
+	defer func() {
+		h.devices.Delete("appid", "devid")
+	}()
+
+	_, err := h.previewDownlink(dev, []byte{1, 2, 3, 4})
+	a.So(err, ShouldNotBeNil)
+
+	history, _ := h.devices.UplinkHistory("appid", "devid")
+	history.Push(&pb.DeviceUplink{
+		ProtocolMetadata: &pb_protocol.RxMetadata{Protocol: &pb_protocol.RxMetadata_Lorawan{Lorawan: &pb_lorawan.Metadata{
+			Modulation:    pb_lorawan.Modulation_LORA,
+			DataRate:      "SF7BW125",
+			CodingRate:    "4/5",
+			FrequencyPlan: pb_lorawan.FrequencyPlan_EU_863_870,
+		}}},
+		GatewayMetadata: []*pb_gateway.RxMetadata{
+			{GatewayId: "gtw-1", Frequency: 868100000, Rssi: -110, Snr: 2},
+			{GatewayId: "gtw-2", Frequency: 868100000, Rssi: -100, Snr: 7},
+		},
+	})
+
+	preview, err := h.previewDownlink(dev, []byte{1, 2, 3, 4})
+	a.So(err, ShouldBeNil)
+	a.So(preview.GatewayId, ShouldEqual, "gtw-2")
+	a.So(preview.RxWindow, ShouldEqual, pb_lorawan.RxWindow_RX1)
+	a.So(preview.Frequency, ShouldEqual, 868100000)
+	a.So(preview.DataRate, ShouldEqual, "SF7BW125")
+	a.So(preview.Airtime, ShouldEqual, 51456000)
+	a.So(preview.DutyCycle, ShouldAlmostEqual, 0.01)
+	a.So(preview.Allowed, ShouldBeTrue)
+
+	h.dutyCycle.transmit("gtw-2", 868100000, time.Duration(preview.Airtime))
+	preview, err = h.previewDownlink(dev, []byte{1, 2, 3, 4})
+	a.So(err, ShouldBeNil)
+	a.So(preview.Allowed, ShouldBeFalse)
+	a.So(preview.Wait, ShouldBeGreaterThan, 0)
+
+	dev.Options.RxWindow = pb_lorawan.RxWindow_RX2
+	preview, err = h.previewDownlink(dev, []byte{1, 2, 3, 4})
+	a.So(err, ShouldBeNil)
+	a.So(preview.RxWindow, ShouldEqual, pb_lorawan.RxWindow_RX2)
+	a.So(preview.Frequency, ShouldEqual, 869525000)
+	a.So(preview.DataRate, ShouldEqual, "SF9BW125")
+	a.So(preview.DutyCycle, ShouldAlmostEqual, 0.1)
+	a.So(preview.Allowed, ShouldBeTrue)
+}
@@ -21,9 +21,10 @@ func TestEnqueueDownlink(t *testing.T) {
 	appID := "app1"
 	devID := "dev1"
 	h := &handler{
-		Component: &component.Component{Ctx: GetLogger(t, "TestEnqueueDownlink")},
-		devices:   device.NewRedisDeviceStore(GetRedisClient(), "handler-test-enqueue-downlink"),
-		mqttEvent: make(chan *types.DeviceEvent, 10),
+		Component:    &component.Component{Ctx: GetLogger(t, "TestEnqueueDownlink")},
+		devices:      device.NewRedisDeviceStore(GetRedisClient(), "handler-test-enqueue-downlink"),
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-enqueue-downlink"),
+		mqttEvent:    make(chan *types.DeviceEvent, 10),
 	}
 	err := h.EnqueueDownlink(&types.DownlinkMessage{
 		AppID: appID,
@@ -91,6 +92,125 @@ func TestEnqueueDownlink(t *testing.T) {
 	downlink, _ := queue.Next()
 	a.So(downlink, ShouldNotBeNil)
 	a.So(downlink.PayloadFields, ShouldHaveLength, 3)
+
+	// Fields are encoded when the downlink is enqueued
+	h.applications.Set(&application.Application{
+		AppID:   appID,
+		Encoder: `function Encoder (payload, port){ return { bytes: [ port, 1, 2 ], fPort: 12 } }`,
+	})
+	defer func() {
+		h.applications.Delete(appID)
+	}()
+	err = h.EnqueueDownlink(&types.DownlinkMessage{
+		AppID:         appID,
+		DevID:         devID,
+		FPort:         1,
+		PayloadFields: map[string]interface{}{"string": "hello!"},
+	})
+	a.So(err, ShouldBeNil)
+	downlink, _ = queue.Next()
+	a.So(downlink, ShouldNotBeNil)
+	a.So(downlink.PayloadRaw, ShouldResemble, []byte{1, 1, 2})
+	a.So(downlink.FPort, ShouldEqual, 12)
+	a.So(downlink.PayloadFields, ShouldBeEmpty)
+
+	err = h.EnqueueDownlink(&types.DownlinkMessage{
+		AppID:         appID,
+		DevID:         devID,
+		FPort:         1,
+		PayloadRaw:    []byte{1},
+		PayloadFields: map[string]interface{}{"string": "hello!"},
+	})
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestEnqueueDownlinkIdempotency(t *testing.T) {
+	a := New(t)
+	appID := "app1"
+	devID := "dev1"
+	h := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestEnqueueDownlinkIdempotency")},
+		devices:      device.NewRedisDeviceStore(GetRedisClient(), "handler-test-enqueue-downlink-idempotency"),
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-enqueue-downlink-idempotency"),
+		mqttEvent:    make(chan *types.DeviceEvent, 10),
+	}
+	h.devices.Set(&device.Device{
+		AppID: appID,
+		DevID: devID,
+	})
+	defer func() {
+		h.devices.Delete(appID, devID)
+	}()
+	queue, _ := h.devices.DownlinkQueue(appID, devID)
+
+	first := &types.DownlinkMessage{
+		AppID:          appID,
+		DevID:          devID,
+		IdempotencyKey: "key",
+		PayloadRaw:     []byte{0x01},
+		Schedule:       "last",
+	}
+	err := h.EnqueueDownlink(first)
+	a.So(err, ShouldBeNil)
+	a.So(first.ID, ShouldNotBeEmpty)
+	event := <-h.mqttEvent
+	a.So(event.Data.(types.DownlinkEventData).Duplicate, ShouldBeFalse)
+
+	retry := &types.DownlinkMessage{
+		AppID:          appID,
+		DevID:          devID,
+		IdempotencyKey: "key",
+		PayloadRaw:     []byte{0x01},
+		Schedule:       "last",
+	}
+	err = h.EnqueueDownlink(retry)
+	a.So(err, ShouldBeNil)
+	a.So(retry.ID, ShouldEqual, first.ID)
+	event = <-h.mqttEvent
+	a.So(event.Data.(types.DownlinkEventData).Duplicate, ShouldBeTrue)
+	qLen, _ := queue.Length()
+	a.So(qLen, ShouldEqual, 1)
+
+	other := &types.DownlinkMessage{
+		AppID:          appID,
+		DevID:          devID,
+		IdempotencyKey: "other-key",
+		PayloadRaw:     []byte{0x02},
+		Schedule:       "last",
+	}
+	err = h.EnqueueDownlink(other)
+	a.So(err, ShouldBeNil)
+	a.So(other.ID, ShouldNotEqual, first.ID)
+	qLen, _ = queue.Length()
+	a.So(qLen, ShouldEqual, 2)
+
+	// Downlinks that are not scheduled do not claim the key
+	invalid := &types.DownlinkMessage{
+		AppID:          appID,
+		DevID:          devID,
+		IdempotencyKey: "invalid-key",
+		PayloadRaw:     []byte{0x03},
+		Schedule:       "unknown",
+	}
+	<-h.mqttEvent // scheduled event of the other downlink
+	err = h.EnqueueDownlink(invalid)
+	a.So(err, ShouldNotBeNil)
+	event = <-h.mqttEvent
+	a.So(event.Event, ShouldEqual, types.DownlinkErrorEvent)
+
+	valid := &types.DownlinkMessage{
+		AppID:          appID,
+		DevID:          devID,
+		IdempotencyKey: "invalid-key",
+		PayloadRaw:     []byte{0x03},
+		Schedule:       "last",
+	}
+	err = h.EnqueueDownlink(valid)
+	a.So(err, ShouldBeNil)
+	event = <-h.mqttEvent
+	a.So(event.Data.(types.DownlinkEventData).Duplicate, ShouldBeFalse)
+	qLen, _ = queue.Length()
+	a.So(qLen, ShouldEqual, 3)
 }
 
 func TestHandleDownlink(t *testing.T) {
@@ -5,8 +5,11 @@ package handler
 
 import (
 	"encoding/json"
+	"time"
 
+	"github.com/TheThingsNetwork/go-account-lib/rights"
 	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
 	"github.com/TheThingsNetwork/ttn/core/handler/functions"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
@@ -16,21 +19,17 @@ import (
 // functions that are provided in the DryUplinkMessage, without actually going to the network.
 // This is helpful for testing the payload functions without having to save them.
 func (h *handlerManager) DryUplink(ctx context.Context, in *pb.DryUplinkMessage) (*pb.DryUplinkResult, error) {
-	app := in.App
+	app := dryRunApplication(in.App)
 
 	logger := functions.NewEntryLogger()
 
 	flds := ""
 	valid := true
-	if app != nil && app.Decoder != "" {
-		functions := &UplinkFunctions{
-			Decoder:   app.Decoder,
-			Converter: app.Converter,
-			Validator: app.Validator,
-			Logger:    logger,
-		}
+	portFunctions := app.FunctionsForPort(uint8(in.Port))
+	if in.App != nil && (portFunctions.Decoder != "" || builtinPayloadFormat(app.PayloadFormat)) {
+		up := h.handler.uplinkFunctions(app, portFunctions, logger)
 
-		fields, val, err := functions.Process(in.Payload, uint8(in.Port))
+		fields, val, err := up.Process(in.Payload, uint8(in.Port))
 		if err != nil {
 			return nil, err
 		}
@@ -53,11 +52,64 @@ func (h *handlerManager) DryUplink(ctx context.Context, in *pb.DryUplinkMessage)
 	}, nil
 }
 
+// TestUplink converts the uplink message payload by running the stored payload functions of the application, without
+// publishing the result. Errors of the payload functions are returned in the result, together with the logs.
+func (h *handlerManager) TestUplink(ctx context.Context, in *pb.TestUplinkRequest) (*pb.DryUplinkResult, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Test Uplink Request")
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.AppSettings)
+	if err != nil {
+		return nil, err
+	}
+	return h.handler.testUplink(in.AppId, uint8(in.Port), in.Payload)
+}
+
+// testUplink runs the stored payload functions of the application on the payload
+func (h *handler) testUplink(appID string, port uint8, payload []byte) (*pb.DryUplinkResult, error) {
+	app, err := h.applications.Get(appID)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.resolveCodec(app); err != nil {
+		return nil, err
+	}
+
+	logger := functions.NewEntryLogger()
+	up := h.uplinkFunctions(app, app.FunctionsForPort(port), logger)
+
+	res := &pb.DryUplinkResult{
+		Payload: payload,
+	}
+
+	fields, valid, err := up.Process(payload, port)
+	res.Logs = logger.Logs
+	if err != nil {
+		res.Error = err.Error()
+		return res, nil
+	}
+	res.Valid = valid
+
+	if fields != nil {
+		marshalled, err := json.Marshal(fields)
+		if err != nil {
+			return nil, err
+		}
+		res.Fields = string(marshalled)
+	}
+
+	return res, nil
+}
+
 // DryDownlink converts the downlink message payload by running the payload
 // functions that are provided in the DryDownlinkMessage, without actually going to the network.
 // This is helpful for testing the payload functions without having to save them.
 func (h *handlerManager) DryDownlink(ctx context.Context, in *pb.DryDownlinkMessage) (*pb.DryDownlinkResult, error) {
-	app := in.App
+	app := dryRunApplication(in.App)
 
 	if in.Payload != nil {
 		if in.Fields != "" {
@@ -72,16 +124,14 @@ func (h *handlerManager) DryDownlink(ctx context.Context, in *pb.DryDownlinkMess
 		return nil, errors.NewErrInvalidArgument("Downlink", "Neither Fields nor Payload provided")
 	}
 
-	if app == nil || app.Encoder == "" {
+	portFunctions := app.FunctionsForPort(uint8(in.Port))
+	if in.App == nil || (portFunctions.Encoder == "" && !builtinPayloadFormat(app.PayloadFormat)) {
 		return nil, errors.NewErrInvalidArgument("Encoder", "Not specified")
 	}
 
 	logger := functions.NewEntryLogger()
 
-	functions := &DownlinkFunctions{
-		Encoder: app.Encoder,
-		Logger:  logger,
-	}
+	down := h.handler.downlinkFunctions(app, portFunctions, logger)
 
 	var parsed map[string]interface{}
 	err := json.Unmarshal([]byte(in.Fields), &parsed)
@@ -89,7 +139,7 @@ func (h *handlerManager) DryDownlink(ctx context.Context, in *pb.DryDownlinkMess
 		return nil, errors.NewErrInvalidArgument("Fields", err.Error())
 	}
 
-	payload, _, err := functions.Process(parsed, uint8(in.Port))
+	payload, _, err := down.Process(parsed, uint8(in.Port))
 	if err != nil {
 		return nil, err
 	}
@@ -99,3 +149,29 @@ func (h *handlerManager) DryDownlink(ctx context.Context, in *pb.DryDownlinkMess
 		Logs:    logger.Logs,
 	}, nil
 }
+
+// dryRunApplication returns the application with the payload format and payload functions that are provided in a dry
+// run. The application is not cached, as it does not have an AppID.
+func dryRunApplication(app *pb.Application) *application.Application {
+	if app == nil {
+		return &application.Application{}
+	}
+	return &application.Application{
+		PayloadFormat:      app.PayloadFormat,
+		WASMModule:         app.WasmModule,
+		BinaryFields:       binaryFieldsFromPb(app.BinaryFields),
+		FunctionsLanguage:  app.FunctionsLanguage,
+		ProtobufDescriptor: app.ProtobufDescriptor,
+		ProtobufMessage:    app.ProtobufMessage,
+		GoCodec:            app.GoCodec,
+		FunctionTimeout:    time.Duration(app.FunctionTimeout) * time.Millisecond,
+		MaxOutputFields:    app.MaxOutputFields,
+		MaxOutputDepth:     app.MaxOutputDepth,
+		MaxOutputSize:      app.MaxOutputSize,
+		Decoder:            app.Decoder,
+		Converter:          app.Converter,
+		Validator:          app.Validator,
+		Encoder:            app.Encoder,
+		PortFunctions:      portFunctionsFromPb(app.PortFunctions),
+	}
+}
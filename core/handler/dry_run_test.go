@@ -56,11 +56,46 @@ func (s *countingStore) Set(app *application.Application, fields ...string) erro
 	return s.store.Set(app, fields...)
 }
 
+func (s *countingStore) SetIfRevision(app *application.Application, revision uint64) error {
+	s.inc("set")
+	return s.store.SetIfRevision(app, revision)
+}
+
 func (s *countingStore) Delete(appID string) error {
 	s.inc("delete")
 	return s.store.Delete(appID)
 }
 
+func (s *countingStore) FunctionsHistory(appID string) ([]*application.FunctionsRevision, error) {
+	s.inc("history")
+	return s.store.FunctionsHistory(appID)
+}
+
+func (s *countingStore) SoftDelete(app *application.Application) error {
+	s.inc("delete")
+	return s.store.SoftDelete(app)
+}
+
+func (s *countingStore) ListDeleted() ([]*application.Application, error) {
+	s.inc("list")
+	return s.store.ListDeleted()
+}
+
+func (s *countingStore) GetDeleted(appID string) (*application.Application, error) {
+	s.inc("get")
+	return s.store.GetDeleted(appID)
+}
+
+func (s *countingStore) Restore(appID string) (*application.Application, error) {
+	s.inc("set")
+	return s.store.Restore(appID)
+}
+
+func (s *countingStore) Purge(appID string) error {
+	s.inc("delete")
+	return s.store.Purge(appID)
+}
+
 func TestDryUplinkFields(t *testing.T) {
 	a := New(t)
 
@@ -260,3 +295,50 @@ func TestLogs(t *testing.T) {
 		},
 	})
 }
+
+func TestTestUplink(t *testing.T) {
+	a := New(t)
+
+	h := &handler{
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-test-uplink"),
+	}
+	h.applications.Set(&application.Application{
+		AppID: "TestUplink",
+		Decoder: `function Decoder (bytes, port) {
+				console.log("port", port)
+				if (port == 2) { throw new Error("unknown port") }
+				return { length: bytes.length }
+			}`,
+		Validator: `function Validator (fields) { return fields.length > 1 }`,
+	})
+	defer func() {
+		h.applications.Delete("TestUplink")
+	}()
+
+	_, err := h.testUplink("UnknownApp", 1, []byte{11, 22, 33})
+	a.So(err, ShouldNotBeNil)
+
+	res, err := h.testUplink("TestUplink", 1, []byte{11, 22, 33})
+	a.So(err, ShouldBeNil)
+	a.So(res.Payload, ShouldResemble, []byte{11, 22, 33})
+	a.So(res.Fields, ShouldEqual, `{"length":3}`)
+	a.So(res.Valid, ShouldBeTrue)
+	a.So(res.Error, ShouldBeEmpty)
+	a.So(res.Logs, ShouldResemble, []*pb.LogEntry{
+		&pb.LogEntry{
+			Function: "Decoder",
+			Fields:   []string{`"port"`, "1"},
+		},
+	})
+
+	res, err = h.testUplink("TestUplink", 1, []byte{11})
+	a.So(err, ShouldBeNil)
+	a.So(res.Valid, ShouldBeFalse)
+
+	// Errors of the payload functions are returned in the result
+	res, err = h.testUplink("TestUplink", 2, []byte{11, 22, 33})
+	a.So(err, ShouldBeNil)
+	a.So(res.Error, ShouldContainSubstring, "unknown port")
+	a.So(res.Fields, ShouldBeEmpty)
+	a.So(res.Logs, ShouldHaveLength, 1)
+}
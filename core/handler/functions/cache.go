@@ -0,0 +1,76 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package functions
+
+import (
+	"crypto/sha1"
+	"sync"
+
+	"github.com/dop251/goja"
+)
+
+// MaxCachedPrograms is the maximum number of compiled programs that are cached per application. If an application
+// has more programs, for example because its functions change often, its cache is cleared.
+var MaxCachedPrograms = 16
+
+// programCache caches compiled programs by application ID and hash of the code
+type programCache struct {
+	sync.RWMutex
+	programs map[string]map[[sha1.Size]byte]*goja.Program
+}
+
+var cache programCache
+
+func (c *programCache) get(appID string, hash [sha1.Size]byte) (*goja.Program, bool) {
+	c.RLock()
+	defer c.RUnlock()
+	program, ok := c.programs[appID][hash]
+	return program, ok
+}
+
+func (c *programCache) set(appID string, hash [sha1.Size]byte, program *goja.Program) {
+	c.Lock()
+	defer c.Unlock()
+	if c.programs == nil {
+		c.programs = make(map[string]map[[sha1.Size]byte]*goja.Program)
+	}
+	programs, ok := c.programs[appID]
+	if !ok || len(programs) >= MaxCachedPrograms {
+		programs = make(map[[sha1.Size]byte]*goja.Program)
+		c.programs[appID] = programs
+	}
+	programs[hash] = program
+}
+
+func (c *programCache) invalidate(appID string) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.programs, appID)
+}
+
+// compile compiles the code. If the application ID is not empty, the compiled program is cached for the application,
+// so that the code is only parsed once.
+func compile(appID, name, code string) (*goja.Program, error) {
+	if appID == "" {
+		return goja.Compile(name, code, false)
+	}
+	hash := sha1.Sum([]byte(code))
+	if program, ok := cache.get(appID, hash); ok {
+		return program, nil
+	}
+	program, err := goja.Compile(name, code, false)
+	if err != nil {
+		return nil, err
+	}
+	cache.set(appID, hash, program)
+	return program, nil
+}
+
+// Invalidate removes the compiled programs, Lua chunks and WebAssembly modules of the application from the cache. It should be
+// called when the payload functions of the application are updated.
+func Invalidate(appID string) {
+	cache.invalidate(appID)
+	luaProtos.invalidate(appID)
+	modules.invalidate(appID)
+}
@@ -0,0 +1,62 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package functions
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestCompileCache(t *testing.T) {
+	a := New(t)
+
+	defer Invalidate("test")
+
+	first, err := compile("test", "test", "1 + 1")
+	a.So(err, ShouldBeNil)
+	second, err := compile("test", "test", "1 + 1")
+	a.So(err, ShouldBeNil)
+	a.So(second, ShouldEqual, first)
+
+	// Without application ID, the code is compiled every time
+	uncached, err := compile("", "test", "1 + 1")
+	a.So(err, ShouldBeNil)
+	a.So(uncached, ShouldNotEqual, first)
+
+	// Other code
+	other, err := compile("test", "test", "2 + 2")
+	a.So(err, ShouldBeNil)
+	a.So(other, ShouldNotEqual, first)
+
+	// Syntax errors are not cached
+	_, err = compile("test", "test", "1 +")
+	a.So(err, ShouldNotBeNil)
+
+	Invalidate("test")
+	third, err := compile("test", "test", "1 + 1")
+	a.So(err, ShouldBeNil)
+	a.So(third, ShouldNotEqual, first)
+
+	// The cache of an application is cleared when it has too many programs
+	for i := 0; i < MaxCachedPrograms; i++ {
+		compile("test", "test", fmt.Sprintf("%d", i))
+	}
+	a.So(len(cache.programs["test"]), ShouldBeLessThanOrEqualTo, MaxCachedPrograms)
+}
+
+func TestRunCachedCode(t *testing.T) {
+	a := New(t)
+
+	defer Invalidate("test")
+
+	for i := 0; i < 2; i++ {
+		val, err := RunCachedCode("test", "test", "foo * 2", map[string]interface{}{"foo": i}, time.Second, Ignore)
+		a.So(err, ShouldBeNil)
+		e, _ := val.Export()
+		a.So(e, ShouldEqual, i*2)
+	}
+}
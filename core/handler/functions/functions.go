@@ -5,16 +5,95 @@ package functions
 
 import (
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/TheThingsNetwork/ttn/utils/errors"
-	"github.com/robertkrimen/otto"
+	"github.com/dop251/goja"
 )
 
 var errTimeOutExceeded = errors.NewErrInternal("Code has been running to long")
 
-func RunCode(name, code string, env map[string]interface{}, timeout time.Duration, logger Logger) (val otto.Value, err error) {
-	vm := otto.New()
+var running int64
+
+// Running returns the number of functions that are currently running
+func Running() int {
+	return int(atomic.LoadInt64(&running))
+}
+
+// Value is the result of running JavaScript code
+type Value struct {
+	value goja.Value
+}
+
+// IsUndefined returns true if the value is undefined
+func (v Value) IsUndefined() bool {
+	return v.value == nil || goja.IsUndefined(v.value)
+}
+
+// IsObject returns true if the value is an object (including arrays and functions)
+func (v Value) IsObject() bool {
+	_, ok := v.value.(*goja.Object)
+	return ok
+}
+
+// IsFunction returns true if the value is a function
+func (v Value) IsFunction() bool {
+	if v.value == nil {
+		return false
+	}
+	_, ok := goja.AssertFunction(v.value)
+	return ok
+}
+
+// IsBoolean returns true if the value is a boolean
+func (v Value) IsBoolean() bool {
+	if v.value == nil {
+		return false
+	}
+	_, ok := v.value.Export().(bool)
+	return ok
+}
+
+// ToBoolean returns the value as a boolean
+func (v Value) ToBoolean() (bool, error) {
+	if !v.IsBoolean() {
+		return false, errors.NewErrInvalidArgument("Value", "not a boolean")
+	}
+	return v.value.ToBoolean(), nil
+}
+
+// Export returns the value as a Go value. Objects are exported as map[string]interface{}, arrays as []interface{}
+// and numbers as int64 or float64
+func (v Value) Export() (interface{}, error) {
+	if v.value == nil {
+		return nil, nil
+	}
+	return v.value.Export(), nil
+}
+
+// RunCode runs the JavaScript code in a new VM with the given environment and returns the value of the last
+// statement. The execution is interrupted after the timeout, or with a ResourceLimitError when the code nests more than
+// MaxCallStackSize function calls or runs more than MaxSteps. Calls to console.log are passed to the logger. The
+// Helpers are available to the code, unless the environment contains a value with the same name.
+func RunCode(name, code string, env map[string]interface{}, timeout time.Duration, logger Logger) (Value, error) {
+	return RunCachedCode("", name, code, env, timeout, logger)
+}
+
+// RunCachedCode is like RunCode, but the compiled code is cached for the application with the given ID (if not
+// empty). Use Invalidate to remove the compiled code of an application from the cache.
+func RunCachedCode(appID, name, code string, env map[string]interface{}, timeout time.Duration, logger Logger) (val Value, err error) {
+	atomic.AddInt64(&running, 1)
+	defer atomic.AddInt64(&running, -1)
+
+	program, err := compile(appID, name, code)
+	if err != nil {
+		return Value{}, errors.NewErrInternal(fmt.Sprintf("%s threw error: %s", name, err))
+	}
+
+	vm := goja.New()
+	vm.SetMaxCallStackSize(MaxCallStackSize)
+	loadHelpers(vm)
 
 	// load the environment
 	for key, val := range env {
@@ -26,40 +105,60 @@ func RunCode(name, code string, env map[string]interface{}, timeout time.Duratio
 	}
 	logger.Enter(name)
 
-	vm.Set("__log", func(call otto.FunctionCall) otto.Value {
-		logger.Log(call)
-		return otto.UndefinedValue()
+	stringify, _ := goja.AssertFunction(vm.Get("JSON").ToObject(vm).Get("stringify"))
+	console := vm.NewObject()
+	console.Set("log", func(call goja.FunctionCall) goja.Value {
+		fields := make([]string, 0, len(call.Arguments))
+		for _, argument := range call.Arguments {
+			fields = append(fields, JSON(stringify, argument))
+		}
+		logger.Log(fields)
+		return goja.Undefined()
 	})
-	vm.Run("console.log = __log")
+	vm.Set("console", console)
 
 	start := time.Now()
 
 	defer func() {
-		duration := time.Since(start)
 		if caught := recover(); caught != nil {
-			val = otto.Value{}
-			if caught == errTimeOutExceeded {
-				err = errors.NewErrInternal(fmt.Sprintf("Interrupted javascript execution for %s after %v", name, duration))
-				return
-			} else {
-				err = errors.NewErrInternal(fmt.Sprintf("Fatal error in %s: %s", name, caught))
-			}
+			val = Value{}
+			err = errors.NewErrInternal(fmt.Sprintf("Fatal error in %s: %s", name, caught))
 		}
 	}()
 
-	vm.Interrupt = make(chan func(), 1)
+	timer := time.AfterFunc(timeout, func() {
+		vm.Interrupt(errTimeOutExceeded)
+	})
+	defer timer.Stop()
 
-	go func() {
-		time.Sleep(timeout)
-		vm.Interrupt <- func() {
-			panic(errTimeOutExceeded)
-		}
-	}()
+	stopWatching := watchSteps(name, func(err *ResourceLimitError) {
+		vm.Interrupt(err)
+	})
+	defer stopWatching()
 
-	val, err = vm.Run(code)
+	value, err := vm.RunProgram(program)
 	if err != nil {
-		return val, errors.NewErrInternal(fmt.Sprintf("%s threw error: %s", name, err))
+		if _, ok := err.(*goja.StackOverflowError); ok {
+			return Value{}, callStackLimitError(name)
+		}
+		if interrupted, ok := err.(*goja.InterruptedError); ok {
+			if limitErr, ok := interrupted.Value().(*ResourceLimitError); ok {
+				return Value{}, limitErr
+			}
+			if interrupted.Value() == errTimeOutExceeded {
+				return Value{}, errors.NewErrInternal(fmt.Sprintf("Interrupted javascript execution for %s after %v", name, time.Since(start)))
+			}
+		}
+		return Value{}, errors.NewErrInternal(fmt.Sprintf("%s threw error: %s", name, err))
 	}
 
-	return val, nil
+	return Value{value}, nil
+}
+
+// CheckSyntax returns an error if the code contains a syntax error
+func CheckSyntax(name, code string) error {
+	if _, err := goja.Compile(name, code, false); err != nil {
+		return errors.NewErrInvalidArgument(name, err.Error())
+	}
+	return nil
 }
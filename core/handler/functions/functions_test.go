@@ -8,7 +8,7 @@ import (
 	"time"
 
 	pb_handler "github.com/TheThingsNetwork/ttn/api/handler"
-	"github.com/robertkrimen/otto"
+	"github.com/dop251/goja"
 
 	. "github.com/smartystreets/assertions"
 )
@@ -62,14 +62,59 @@ func TestRunCodeThrow(t *testing.T) {
 var result string
 
 func BenchmarkJSON(b *testing.B) {
-	v, _ := otto.ToValue("foo")
+	vm := goja.New()
+	stringify, _ := goja.AssertFunction(vm.Get("JSON").ToObject(vm).Get("stringify"))
+	v := vm.ToValue("foo")
 	var r string
 	for n := 0; n < b.N; n++ {
-		r = JSON(v)
+		r = JSON(stringify, v)
 	}
 	result = r
 }
 
+func BenchmarkRunCode(b *testing.B) {
+	env := map[string]interface{}{
+		"payload": []byte{0x01, 0x02, 0x03, 0x04},
+	}
+	code := `
+		(function (bytes) {
+			return { sum: bytes[0] + bytes[1] + bytes[2] + bytes[3] }
+		})(payload)
+	`
+	for n := 0; n < b.N; n++ {
+		RunCode("bench", code, env, time.Second, Ignore)
+	}
+}
+
+func TestRunCodeTimeout(t *testing.T) {
+	a := New(t)
+
+	start := time.Now()
+	_, err := RunCode("test", `while (true) {}`, map[string]interface{}{}, 50*time.Millisecond, Ignore)
+	a.So(err, ShouldNotBeNil)
+	a.So(time.Since(start), ShouldBeLessThan, time.Second)
+}
+
+func TestRunCodeResourceLimit(t *testing.T) {
+	a := New(t)
+
+	maxSteps := MaxSteps
+	defer func() { MaxSteps = maxSteps }()
+	MaxSteps = 5
+
+	_, err := RunCode("test", `while (true) {}`, map[string]interface{}{}, 10*time.Second, Ignore)
+	a.So(err, ShouldNotBeNil)
+	a.So(IsResourceLimitExceeded(err), ShouldBeTrue)
+	a.So(err.Error(), ShouldStartWith, "function resource limit exceeded")
+
+	_, err = RunCode("test", `function f(n) { return f(n + 1); } f(0)`, map[string]interface{}{}, 10*time.Second, Ignore)
+	a.So(IsResourceLimitExceeded(err), ShouldBeTrue)
+	a.So(err.Error(), ShouldContainSubstring, "call stack")
+
+	_, err = RunCode("test", `while (true) {}`, map[string]interface{}{}, 20*time.Millisecond, Ignore)
+	a.So(IsResourceLimitExceeded(err), ShouldBeFalse)
+}
+
 func TestRunInvalidCode(t *testing.T) {
 	a := New(t)
 
@@ -0,0 +1,109 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package functions
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+
+	"github.com/dop251/goja"
+)
+
+// Helper is a function that is available in the environment of all JavaScript payload functions
+type Helper struct {
+	Usage       string
+	Description string
+}
+
+// Helpers documents the helper functions, in the order in which they are listed to users. Bytes are arrays of
+// numbers, such as the bytes that are passed to the Decoder. The functions throw an error if the offset and length
+// are out of range.
+var Helpers = []Helper{
+	{"int8(bytes, offset)", "Signed 8 bit integer at the offset"},
+	{"uint16BE(bytes, offset)", "Unsigned 16 bit integer at the offset, most significant byte first"},
+	{"uint16LE(bytes, offset)", "Unsigned 16 bit integer at the offset, least significant byte first"},
+	{"int16BE(bytes, offset)", "Signed 16 bit integer at the offset, most significant byte first"},
+	{"int16LE(bytes, offset)", "Signed 16 bit integer at the offset, least significant byte first"},
+	{"uint32BE(bytes, offset)", "Unsigned 32 bit integer at the offset, most significant byte first"},
+	{"uint32LE(bytes, offset)", "Unsigned 32 bit integer at the offset, least significant byte first"},
+	{"int32BE(bytes, offset)", "Signed 32 bit integer at the offset, most significant byte first"},
+	{"int32LE(bytes, offset)", "Signed 32 bit integer at the offset, least significant byte first"},
+	{"bytesToFloat32(bytes, offset, littleEndian)", "IEEE 754 single precision float at the offset, most significant byte first unless littleEndian is true"},
+	{"bcd(bytes, offset, length)", "Number of the binary-coded decimal digits in length bytes at the offset, high nibble first"},
+	{"toHex(bytes)", "Lowercase hexadecimal string of the bytes"},
+	{"fromHex(hex)", "Bytes of the hexadecimal string"},
+}
+
+// loadHelpers sets the helper functions in the VM
+func loadHelpers(vm *goja.Runtime) {
+	read := func(bytes []byte, offset, length int) []byte {
+		if offset < 0 || length < 0 || offset+length > len(bytes) {
+			panic(vm.NewTypeError(fmt.Sprintf("can not read %d bytes at offset %d of %d bytes", length, offset, len(bytes))))
+		}
+		return bytes[offset : offset+length]
+	}
+
+	vm.Set("int8", func(bytes []byte, offset int) int64 {
+		return int64(int8(read(bytes, offset, 1)[0]))
+	})
+	vm.Set("uint16BE", func(bytes []byte, offset int) int64 {
+		return int64(binary.BigEndian.Uint16(read(bytes, offset, 2)))
+	})
+	vm.Set("uint16LE", func(bytes []byte, offset int) int64 {
+		return int64(binary.LittleEndian.Uint16(read(bytes, offset, 2)))
+	})
+	vm.Set("int16BE", func(bytes []byte, offset int) int64 {
+		return int64(int16(binary.BigEndian.Uint16(read(bytes, offset, 2))))
+	})
+	vm.Set("int16LE", func(bytes []byte, offset int) int64 {
+		return int64(int16(binary.LittleEndian.Uint16(read(bytes, offset, 2))))
+	})
+	vm.Set("uint32BE", func(bytes []byte, offset int) int64 {
+		return int64(binary.BigEndian.Uint32(read(bytes, offset, 4)))
+	})
+	vm.Set("uint32LE", func(bytes []byte, offset int) int64 {
+		return int64(binary.LittleEndian.Uint32(read(bytes, offset, 4)))
+	})
+	vm.Set("int32BE", func(bytes []byte, offset int) int64 {
+		return int64(int32(binary.BigEndian.Uint32(read(bytes, offset, 4))))
+	})
+	vm.Set("int32LE", func(bytes []byte, offset int) int64 {
+		return int64(int32(binary.LittleEndian.Uint32(read(bytes, offset, 4))))
+	})
+	vm.Set("bytesToFloat32", func(bytes []byte, offset int, littleEndian bool) float64 {
+		b := read(bytes, offset, 4)
+		if littleEndian {
+			return float64(math.Float32frombits(binary.LittleEndian.Uint32(b)))
+		}
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b)))
+	})
+	vm.Set("bcd", func(bytes []byte, offset, length int) int64 {
+		var value int64
+		for _, b := range read(bytes, offset, length) {
+			high, low := b>>4, b&0x0f
+			if high > 9 || low > 9 {
+				panic(vm.NewTypeError(fmt.Sprintf("0x%02x is not binary-coded decimal", b)))
+			}
+			value = value*100 + int64(high)*10 + int64(low)
+		}
+		return value
+	})
+	vm.Set("toHex", func(bytes []byte) string {
+		return hex.EncodeToString(bytes)
+	})
+	vm.Set("fromHex", func(str string) []interface{} {
+		bytes, err := hex.DecodeString(str)
+		if err != nil {
+			panic(vm.NewTypeError(fmt.Sprintf("%q is not hexadecimal", str)))
+		}
+		// Return an array of numbers, like the bytes that are passed to the Decoder
+		res := make([]interface{}, len(bytes))
+		for i, b := range bytes {
+			res[i] = int64(b)
+		}
+		return res
+	})
+}
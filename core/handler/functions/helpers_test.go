@@ -0,0 +1,58 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package functions
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestHelpers(t *testing.T) {
+	a := New(t)
+
+	env := map[string]interface{}{
+		"payload": []byte{0xff, 0xfe, 0x41, 0xac, 0xcc, 0xcd, 0x12, 0x34},
+	}
+
+	for code, expected := range map[string]interface{}{
+		`int8(payload, 0)`:                                  int64(-1),
+		`uint16BE(payload, 0)`:                              int64(0xfffe),
+		`uint16LE(payload, 0)`:                              int64(0xfeff),
+		`int16BE(payload, 0)`:                               int64(-2),
+		`int16LE(payload, 6)`:                               int64(0x3412),
+		`uint32BE(payload, 4)`:                              int64(0xcccd1234),
+		`int32LE(payload, 0)`:                               int64(-1404961025),
+		`bytesToFloat32(payload, 2)`:                        21.6,
+		`bytesToFloat32([0xcd, 0xcc, 0xac, 0x41], 0, true)`: 21.6,
+		`bcd(payload, 6, 2)`:                                int64(1234),
+		`toHex(payload.slice(6))`:                           "1234",
+		`toHex(fromHex("0A1b"))`:                            "0a1b",
+	} {
+		val, err := RunCode("test", code, env, time.Second, nil)
+		a.So(err, ShouldBeNil)
+		e, _ := val.Export()
+		if f, ok := e.(float64); ok {
+			a.So(f, ShouldAlmostEqual, expected, 0.00001)
+			continue
+		}
+		a.So(e, ShouldEqual, expected)
+	}
+
+	for _, code := range []string{
+		`uint16BE(payload, 7)`,
+		`bcd(payload, 0, 1)`,
+		`fromHex("xyz")`,
+	} {
+		_, err := RunCode("test", code, env, time.Second, nil)
+		a.So(err, ShouldNotBeNil)
+	}
+
+	// The environment overrides the helpers
+	val, err := RunCode("test", `toHex`, map[string]interface{}{"toHex": 1}, time.Second, nil)
+	a.So(err, ShouldBeNil)
+	e, _ := val.Export()
+	a.So(e, ShouldEqual, int64(1))
+}
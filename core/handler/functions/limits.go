@@ -0,0 +1,183 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package functions
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	errs "github.com/pkg/errors"
+)
+
+// The limits of the resources that a single run of a function may use. They are enforced by the VM of the run, so a
+// function that exceeds them does not affect other functions.
+var (
+	// MaxCallStackSize is the maximum depth of nested function calls
+	MaxCallStackSize = 256
+	// MaxSteps is the number of steps of LimitCheckInterval that a function may run, or 0 for no limit
+	MaxSteps = 100
+	// LimitCheckInterval is the length of a step
+	LimitCheckInterval = 10 * time.Millisecond
+)
+
+// The limits of the fields that are decoded from a payload. They prevent a decoder from producing objects that are too
+// large to publish and store.
+var (
+	// MaxOutputFields is the number of fields, including the fields of nested objects, or 0 for no limit
+	MaxOutputFields = 1000
+	// MaxOutputDepth is the nesting depth of objects and arrays, or 0 for no limit
+	MaxOutputDepth = 16
+	// MaxOutputSize is the number of bytes of the fields as JSON, or 0 for no limit
+	MaxOutputSize = 64 << 10
+)
+
+// OutputLimitError is returned when the fields that a function returns exceed an output limit
+type OutputLimitError struct {
+	Function string
+	Limit    string
+	Max      int
+}
+
+func (err *OutputLimitError) Error() string {
+	return fmt.Sprintf("function output limit exceeded: %s returned more than the %s limit of %d", err.Function, err.Limit, err.Max)
+}
+
+// IsOutputLimitExceeded returns whether the error is an OutputLimitError
+func IsOutputLimitExceeded(err error) bool {
+	_, ok := errs.Cause(err).(*OutputLimitError)
+	return ok
+}
+
+// OutputLimits are the output limits of the functions of an application. They can only be stricter than
+// MaxOutputFields, MaxOutputDepth and MaxOutputSize; a limit of 0 uses the limit of the handler.
+type OutputLimits struct {
+	Fields int
+	Depth  int
+	Size   int
+}
+
+// stricter returns the limit if it is stricter than max, or max otherwise
+func stricter(limit, max int) int {
+	if limit == 0 || (max != 0 && limit > max) {
+		return max
+	}
+	return limit
+}
+
+// Validate returns an error if one of the limits is higher than the limit of the handler
+func (l OutputLimits) Validate() error {
+	for _, limit := range []struct {
+		name       string
+		value, max int
+	}{
+		{"MaxOutputFields", l.Fields, MaxOutputFields},
+		{"MaxOutputDepth", l.Depth, MaxOutputDepth},
+		{"MaxOutputSize", l.Size, MaxOutputSize},
+	} {
+		if limit.max != 0 && limit.value > limit.max {
+			return errors.NewErrInvalidArgument(limit.name, fmt.Sprintf("can not be higher than %d", limit.max))
+		}
+	}
+	return nil
+}
+
+// CheckOutput returns an OutputLimitError if the fields that the function returned exceed MaxOutputFields,
+// MaxOutputDepth or MaxOutputSize
+func CheckOutput(name string, fields map[string]interface{}) error {
+	return OutputLimits{}.Check(name, fields)
+}
+
+// Check returns an OutputLimitError if the fields that the function returned exceed the limits
+func (l OutputLimits) Check(name string, fields map[string]interface{}) error {
+	maxFields, maxDepth, maxSize := stricter(l.Fields, MaxOutputFields), stricter(l.Depth, MaxOutputDepth), stricter(l.Size, MaxOutputSize)
+	var count int
+	var walk func(v interface{}, depth int) error
+	walk = func(v interface{}, depth int) error {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			if maxDepth != 0 && depth > maxDepth {
+				return &OutputLimitError{Function: name, Limit: "depth", Max: maxDepth}
+			}
+			if count += len(v); maxFields != 0 && count > maxFields {
+				return &OutputLimitError{Function: name, Limit: "fields", Max: maxFields}
+			}
+			for _, e := range v {
+				if err := walk(e, depth+1); err != nil {
+					return err
+				}
+			}
+		case []interface{}:
+			if maxDepth != 0 && depth > maxDepth {
+				return &OutputLimitError{Function: name, Limit: "depth", Max: maxDepth}
+			}
+			for _, e := range v {
+				if err := walk(e, depth+1); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := walk(fields, 1); err != nil {
+		return err
+	}
+	if maxSize != 0 {
+		// Fields that can not be marshaled fail when they are published
+		if data, err := json.Marshal(fields); err == nil && len(data) > maxSize {
+			return &OutputLimitError{Function: name, Limit: "size", Max: maxSize}
+		}
+	}
+	return nil
+}
+
+// ResourceLimitError is returned when a function is interrupted because it exceeded a resource limit
+type ResourceLimitError struct {
+	Function string
+	Resource string
+	Limit    int
+}
+
+func (err *ResourceLimitError) Error() string {
+	return fmt.Sprintf("function resource limit exceeded: %s exceeded the %s limit of %d", err.Function, err.Resource, err.Limit)
+}
+
+// IsResourceLimitExceeded returns whether the error is a ResourceLimitError
+func IsResourceLimitExceeded(err error) bool {
+	_, ok := errs.Cause(err).(*ResourceLimitError)
+	return ok
+}
+
+// callStackLimitError returns the ResourceLimitError for a function that exceeded MaxCallStackSize
+func callStackLimitError(name string) *ResourceLimitError {
+	return &ResourceLimitError{Function: name, Resource: "call stack", Limit: MaxCallStackSize}
+}
+
+// watchSteps counts the steps of a run and calls interrupt with a ResourceLimitError once it exceeds MaxSteps. The
+// returned function stops counting.
+func watchSteps(name string, interrupt func(err *ResourceLimitError)) (stop func()) {
+	maxSteps := MaxSteps
+	if maxSteps == 0 {
+		return func() {}
+	}
+
+	ticker := time.NewTicker(LimitCheckInterval)
+	done := make(chan struct{})
+	go func() {
+		defer ticker.Stop()
+		for steps := 1; ; steps++ {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+			if steps > maxSteps {
+				interrupt(&ResourceLimitError{Function: name, Resource: "steps", Limit: maxSteps})
+				return
+			}
+		}
+	}()
+	return func() { close(done) }
+}
@@ -0,0 +1,80 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package functions
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestCheckOutput(t *testing.T) {
+	a := New(t)
+
+	maxFields, maxDepth, maxSize := MaxOutputFields, MaxOutputDepth, MaxOutputSize
+	defer func() { MaxOutputFields, MaxOutputDepth, MaxOutputSize = maxFields, maxDepth, maxSize }()
+	MaxOutputFields, MaxOutputDepth, MaxOutputSize = 4, 3, 100
+
+	a.So(CheckOutput("Decoder", map[string]interface{}{
+		"temperature": 21.5,
+		"location":    map[string]interface{}{"latitude": 52.37, "longitude": 4.89},
+	}), ShouldBeNil)
+
+	err := CheckOutput("Decoder", map[string]interface{}{"a": 1, "b": 2, "c": map[string]interface{}{"d": 3, "e": 4}})
+	a.So(IsOutputLimitExceeded(err), ShouldBeTrue)
+	a.So(err.Error(), ShouldContainSubstring, "fields limit of 4")
+
+	err = CheckOutput("Converter", map[string]interface{}{"a": []interface{}{[]interface{}{[]interface{}{1}}}})
+	a.So(IsOutputLimitExceeded(err), ShouldBeTrue)
+	a.So(err.Error(), ShouldContainSubstring, "Converter")
+	a.So(err.Error(), ShouldContainSubstring, "depth limit of 3")
+
+	err = CheckOutput("Decoder", map[string]interface{}{"text": strings.Repeat("x", 100)})
+	a.So(IsOutputLimitExceeded(err), ShouldBeTrue)
+	a.So(err.Error(), ShouldContainSubstring, "size limit of 100")
+
+	MaxOutputFields, MaxOutputDepth, MaxOutputSize = 0, 0, 0
+	a.So(CheckOutput("Decoder", map[string]interface{}{"text": strings.Repeat("x", 100)}), ShouldBeNil)
+}
+
+func TestOutputLimits(t *testing.T) {
+	a := New(t)
+
+	maxFields, maxDepth, maxSize := MaxOutputFields, MaxOutputDepth, MaxOutputSize
+	defer func() { MaxOutputFields, MaxOutputDepth, MaxOutputSize = maxFields, maxDepth, maxSize }()
+	MaxOutputFields, MaxOutputDepth, MaxOutputSize = 4, 3, 100
+
+	a.So(OutputLimits{}.Validate(), ShouldBeNil)
+	a.So(OutputLimits{Fields: 2, Depth: 3, Size: 50}.Validate(), ShouldBeNil)
+	a.So(OutputLimits{Fields: 5}.Validate(), ShouldNotBeNil)
+	a.So(OutputLimits{Depth: 4}.Validate(), ShouldNotBeNil)
+	a.So(OutputLimits{Size: 101}.Validate(), ShouldNotBeNil)
+
+	fields := map[string]interface{}{"a": 1, "b": 2, "c": map[string]interface{}{"d": 3}}
+	a.So(OutputLimits{}.Check("Decoder", fields), ShouldBeNil)
+
+	err := OutputLimits{Fields: 3}.Check("Decoder", fields)
+	a.So(IsOutputLimitExceeded(err), ShouldBeTrue)
+	a.So(err.Error(), ShouldContainSubstring, "fields limit of 3")
+
+	err = OutputLimits{Depth: 1}.Check("Converter", fields)
+	a.So(IsOutputLimitExceeded(err), ShouldBeTrue)
+	a.So(err.Error(), ShouldContainSubstring, "depth limit of 1")
+
+	err = OutputLimits{Size: 10}.Check("Decoder", fields)
+	a.So(IsOutputLimitExceeded(err), ShouldBeTrue)
+	a.So(err.Error(), ShouldContainSubstring, "size limit of 10")
+
+	// The limits of the handler still apply if the limits of the application are higher
+	MaxOutputFields = 2
+	err = OutputLimits{Fields: 4}.Check("Decoder", fields)
+	a.So(IsOutputLimitExceeded(err), ShouldBeTrue)
+	a.So(err.Error(), ShouldContainSubstring, "fields limit of 2")
+
+	// Without a limit on the handler, the limit of the application applies
+	MaxOutputFields = 0
+	a.So(OutputLimits{}.Check("Decoder", fields), ShouldBeNil)
+	a.So(IsOutputLimitExceeded(OutputLimits{Fields: 3}.Check("Decoder", fields)), ShouldBeTrue)
+}
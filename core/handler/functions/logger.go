@@ -5,13 +5,13 @@ package functions
 
 import (
 	pb_handler "github.com/TheThingsNetwork/ttn/api/handler"
-	"github.com/robertkrimen/otto"
+	"github.com/dop251/goja"
 )
 
 // Logger is something that can be logged to, saving the logs for later use
 type Logger interface {
-	// Log passes the console.log function call to the logger
-	Log(call otto.FunctionCall)
+	// Log passes the arguments of a console.log call to the logger, stringified as JSON
+	Log(fields []string)
 
 	// Enter tells the Logger what function it is currently in
 	Enter(function string)
@@ -30,22 +30,18 @@ func NewEntryLogger() *EntryLogger {
 	}
 }
 
-// JSON stringifies a value inside of the otto vm, yielding better
-// results than Export for Object-like class such as Date, but being much
+// JSON stringifies a value with the JSON.stringify function of the VM, yielding
+// better results than Export for Object-like class such as Date, but being much
 // slower.
-func JSON(val otto.Value) string {
-	vm := otto.New()
-	vm.Set("value", val)
-	res, _ := vm.Run(`JSON.stringify(value)`)
+func JSON(stringify goja.Callable, val goja.Value) string {
+	res, err := stringify(goja.Undefined(), val)
+	if err != nil {
+		return val.String()
+	}
 	return res.String()
 }
 
-func (c *EntryLogger) Log(call otto.FunctionCall) {
-	fields := []string{}
-	for _, field := range call.ArgumentList {
-		fields = append(fields, JSON(field))
-	}
-
+func (c *EntryLogger) Log(fields []string) {
 	c.Logs = append(c.Logs, &pb_handler.LogEntry{
 		Function: c.function,
 		Fields:   fields,
@@ -60,5 +56,5 @@ type IgnoreLogger struct{}
 
 var Ignore = &IgnoreLogger{}
 
-func (c *IgnoreLogger) Log(call otto.FunctionCall) {}
-func (c *IgnoreLogger) Enter(function string)      {}
+func (c *IgnoreLogger) Log(fields []string)   {}
+func (c *IgnoreLogger) Enter(function string) {}
@@ -0,0 +1,275 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package functions
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	lua "github.com/yuin/gopher-lua"
+	"github.com/yuin/gopher-lua/parse"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// luaCache caches compiled Lua chunks by application ID and hash of the code
+type luaCache struct {
+	sync.RWMutex
+	protos map[string]map[[sha1.Size]byte]*lua.FunctionProto
+}
+
+var luaProtos luaCache
+
+func (c *luaCache) get(appID string, hash [sha1.Size]byte) (*lua.FunctionProto, bool) {
+	c.RLock()
+	defer c.RUnlock()
+	proto, ok := c.protos[appID][hash]
+	return proto, ok
+}
+
+func (c *luaCache) set(appID string, hash [sha1.Size]byte, proto *lua.FunctionProto) {
+	c.Lock()
+	defer c.Unlock()
+	if c.protos == nil {
+		c.protos = make(map[string]map[[sha1.Size]byte]*lua.FunctionProto)
+	}
+	protos, ok := c.protos[appID]
+	if !ok || len(protos) >= MaxCachedPrograms {
+		protos = make(map[[sha1.Size]byte]*lua.FunctionProto)
+		c.protos[appID] = protos
+	}
+	protos[hash] = proto
+}
+
+func (c *luaCache) invalidate(appID string) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.protos, appID)
+}
+
+func parseLua(name, code string) (*lua.FunctionProto, error) {
+	chunk, err := parse.Parse(strings.NewReader(code), name)
+	if err != nil {
+		return nil, err
+	}
+	return lua.Compile(chunk, name)
+}
+
+// compileLua compiles the Lua code. If the application ID is not empty, the compiled chunk is cached for the
+// application.
+func compileLua(appID, name, code string) (*lua.FunctionProto, error) {
+	if appID == "" {
+		return parseLua(name, code)
+	}
+	hash := sha1.Sum([]byte(code))
+	if proto, ok := luaProtos.get(appID, hash); ok {
+		return proto, nil
+	}
+	proto, err := parseLua(name, code)
+	if err != nil {
+		return nil, err
+	}
+	luaProtos.set(appID, hash, proto)
+	return proto, nil
+}
+
+// CheckLuaSyntax returns an error if the Lua code contains a syntax error
+func CheckLuaSyntax(name, code string) error {
+	if _, err := parseLua(name, code); err != nil {
+		return errors.NewErrInvalidArgument(name, err.Error())
+	}
+	return nil
+}
+
+// luaLibs are the Lua libraries that are available to payload functions. The io, os and package libraries are not
+// loaded, so that functions can not access the file system or load modules.
+var luaLibs = []struct {
+	name string
+	open lua.LGFunction
+}{
+	{lua.BaseLibName, lua.OpenBase},
+	{lua.TabLibName, lua.OpenTable},
+	{lua.StringLibName, lua.OpenString},
+	{lua.MathLibName, lua.OpenMath},
+}
+
+// RunLua runs the Lua code in a new state and calls the global function with the given name with the arguments.
+// Byte slices are passed as arrays of numbers (starting at index 1), maps as tables. The function returns the result
+// as a Go value: tables with only consecutive integer keys as []interface{}, other tables as map[string]interface{}
+// and numbers as int64 or float64. The compiled code is cached for the application with the given ID (if not empty).
+// The execution is interrupted after the timeout, or with a ResourceLimitError when the code nests more than
+// MaxCallStackSize function calls or runs more than MaxSteps. Calls to print are passed to the logger.
+func RunLua(appID, name, code string, args []interface{}, timeout time.Duration, logger Logger) (result interface{}, err error) {
+	atomic.AddInt64(&running, 1)
+	defer atomic.AddInt64(&running, -1)
+
+	proto, err := compileLua(appID, name, code)
+	if err != nil {
+		return nil, errors.NewErrInternal(fmt.Sprintf("%s threw error: %s", name, err))
+	}
+
+	L := lua.NewState(lua.Options{SkipOpenLibs: true, CallStackSize: MaxCallStackSize})
+	defer L.Close()
+	for _, lib := range luaLibs {
+		L.Push(L.NewFunction(lib.open))
+		L.Push(lua.LString(lib.name))
+		L.Call(1, 0)
+	}
+	for _, unsafe := range []string{"dofile", "loadfile", "load", "loadstring"} {
+		L.SetGlobal(unsafe, lua.LNil)
+	}
+
+	if logger == nil {
+		logger = Ignore
+	}
+	logger.Enter(name)
+
+	L.SetGlobal("print", L.NewFunction(func(L *lua.LState) int {
+		fields := make([]string, 0, L.GetTop())
+		for i := 1; i <= L.GetTop(); i++ {
+			field, err := json.Marshal(fromLua(L.Get(i)))
+			if err != nil {
+				field, _ = json.Marshal(L.Get(i).String())
+			}
+			fields = append(fields, string(field))
+		}
+		logger.Log(fields)
+		return 0
+	}))
+
+	start := time.Now()
+
+	defer func() {
+		if caught := recover(); caught != nil {
+			result = nil
+			err = errors.NewErrInternal(fmt.Sprintf("Fatal error in %s: %s", name, caught))
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	L.SetContext(ctx)
+
+	limitErr := make(chan *ResourceLimitError, 1)
+	stopWatching := watchSteps(name, func(err *ResourceLimitError) {
+		limitErr <- err
+		cancel()
+	})
+	defer stopWatching()
+
+	interrupted := func(err error) error {
+		select {
+		case err := <-limitErr:
+			return err
+		default:
+		}
+		if ctx.Err() == context.DeadlineExceeded {
+			return errors.NewErrInternal(fmt.Sprintf("Interrupted Lua execution for %s after %v", name, time.Since(start)))
+		}
+		if strings.Contains(err.Error(), "stack overflow") {
+			return callStackLimitError(name)
+		}
+		return errors.NewErrInternal(fmt.Sprintf("%s threw error: %s", name, err))
+	}
+
+	L.Push(L.NewFunctionFromProto(proto))
+	if err := L.PCall(0, 0, nil); err != nil {
+		return nil, interrupted(err)
+	}
+
+	fn, ok := L.GetGlobal(name).(*lua.LFunction)
+	if !ok {
+		return nil, errors.NewErrInvalidArgument(name, "function is not defined")
+	}
+	values := make([]lua.LValue, 0, len(args))
+	for _, arg := range args {
+		values = append(values, toLua(L, arg))
+	}
+	if err := L.CallByParam(lua.P{Fn: fn, NRet: 1, Protect: true}, values...); err != nil {
+		return nil, interrupted(err)
+	}
+	ret := L.Get(-1)
+	L.Pop(1)
+
+	return fromLua(ret), nil
+}
+
+// toLua converts a Go value to a Lua value
+func toLua(L *lua.LState, v interface{}) lua.LValue {
+	switch v := v.(type) {
+	case nil:
+		return lua.LNil
+	case bool:
+		return lua.LBool(v)
+	case string:
+		return lua.LString(v)
+	case []byte:
+		table := L.CreateTable(len(v), 0)
+		for _, b := range v {
+			table.Append(lua.LNumber(b))
+		}
+		return table
+	case []interface{}:
+		table := L.CreateTable(len(v), 0)
+		for _, e := range v {
+			table.Append(toLua(L, e))
+		}
+		return table
+	case map[string]interface{}:
+		table := L.CreateTable(0, len(v))
+		for key, e := range v {
+			table.RawSetString(key, toLua(L, e))
+		}
+		return table
+	}
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return lua.LNumber(value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return lua.LNumber(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		return lua.LNumber(value.Float())
+	}
+	return lua.LString(fmt.Sprint(v))
+}
+
+// fromLua converts a Lua value to a Go value
+func fromLua(v lua.LValue) interface{} {
+	switch v := v.(type) {
+	case lua.LBool:
+		return bool(v)
+	case lua.LString:
+		return string(v)
+	case lua.LNumber:
+		f := float64(v)
+		if f == math.Trunc(f) && math.Abs(f) < 1<<53 {
+			return int64(f)
+		}
+		return f
+	case *lua.LTable:
+		var keys int
+		v.ForEach(func(lua.LValue, lua.LValue) { keys++ })
+		if n := v.MaxN(); n > 0 && n == keys {
+			array := make([]interface{}, 0, n)
+			for i := 1; i <= n; i++ {
+				array = append(array, fromLua(v.RawGetInt(i)))
+			}
+			return array
+		}
+		object := make(map[string]interface{}, keys)
+		v.ForEach(func(key, value lua.LValue) {
+			object[key.String()] = fromLua(value)
+		})
+		return object
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package functions
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestRunLua(t *testing.T) {
+	a := New(t)
+
+	code := `
+function Decoder(bytes, port)
+  print("decoding", port)
+  return { temperature = bytes[1] / 2, port = port, raw = { bytes[1], bytes[2] } }
+end`
+
+	logger := NewEntryLogger()
+	out, err := RunLua("test", "Decoder", code, []interface{}{[]byte{43, 2}, uint8(1)}, time.Second, logger)
+	a.So(err, ShouldBeNil)
+	a.So(out, ShouldResemble, map[string]interface{}{
+		"temperature": 21.5,
+		"port":        int64(1),
+		"raw":         []interface{}{int64(43), int64(2)},
+	})
+	a.So(logger.Logs, ShouldHaveLength, 1)
+	a.So(logger.Logs[0].Fields, ShouldResemble, []string{`"decoding"`, `1`})
+
+	out, err = RunLua("test", "Validator", `function Validator(fields) return fields.led == true end`, []interface{}{map[string]interface{}{"led": true}}, time.Second, nil)
+	a.So(err, ShouldBeNil)
+	a.So(out, ShouldEqual, true)
+
+	_, err = RunLua("test", "Converter", code, nil, time.Second, Ignore)
+	a.So(err, ShouldNotBeNil)
+
+	_, err = RunLua("test", "Decoder", `function Decoder(bytes) error("invalid") end`, nil, time.Second, Ignore)
+	a.So(err, ShouldNotBeNil)
+
+	// The file system is not available
+	_, err = RunLua("test", "Decoder", `function Decoder(bytes) return io.open("/etc/passwd") end`, nil, time.Second, Ignore)
+	a.So(err, ShouldNotBeNil)
+
+	Invalidate("test")
+}
+
+func TestRunLuaTimeout(t *testing.T) {
+	a := New(t)
+
+	start := time.Now()
+	_, err := RunLua("", "Decoder", `function Decoder(bytes) while true do end end`, nil, 50*time.Millisecond, Ignore)
+	a.So(err, ShouldNotBeNil)
+	a.So(time.Since(start), ShouldBeLessThan, time.Second)
+}
+
+func TestRunLuaResourceLimit(t *testing.T) {
+	a := New(t)
+
+	maxSteps := MaxSteps
+	defer func() { MaxSteps = maxSteps }()
+	MaxSteps = 5
+
+	_, err := RunLua("", "Decoder", `function Decoder(bytes) while true do end end`, nil, 10*time.Second, Ignore)
+	a.So(IsResourceLimitExceeded(err), ShouldBeTrue)
+
+	_, err = RunLua("", "Decoder", `function Decoder(bytes) return Decoder(bytes) + 1 end`, nil, 10*time.Second, Ignore)
+	a.So(IsResourceLimitExceeded(err), ShouldBeTrue)
+	a.So(err.Error(), ShouldContainSubstring, "call stack")
+}
+
+func TestCheckLuaSyntax(t *testing.T) {
+	a := New(t)
+	a.So(CheckLuaSyntax("Decoder", `function Decoder(bytes) return {} end`), ShouldBeNil)
+	a.So(CheckLuaSyntax("Decoder", `function Decoder(bytes) return {}`), ShouldNotBeNil)
+}
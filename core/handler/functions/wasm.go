@@ -0,0 +1,203 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package functions
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// WASMMemoryLimit is the maximum memory of a WebAssembly module, in pages of 64KiB
+var WASMMemoryLimit uint32 = 256
+
+var (
+	wasmRuntime     wazero.Runtime
+	wasmRuntimeOnce sync.Once
+)
+
+// getWASMRuntime returns the runtime that compiles and runs all WebAssembly modules. Modules can import the log
+// function from the env module to log a message.
+func getWASMRuntime() wazero.Runtime {
+	wasmRuntimeOnce.Do(func() {
+		ctx := context.Background()
+		wasmRuntime = wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+			WithCloseOnContextDone(true).
+			WithMemoryLimitPages(WASMMemoryLimit))
+		wasmRuntime.NewHostModuleBuilder("env").
+			NewFunctionBuilder().WithFunc(wasmLog).Export("log").
+			Instantiate(ctx)
+	})
+	return wasmRuntime
+}
+
+type wasmLoggerKey struct{}
+
+// wasmLog passes a message from the memory of the module to the Logger in the context
+func wasmLog(ctx context.Context, module api.Module, ptr, length uint32) {
+	logger, ok := ctx.Value(wasmLoggerKey{}).(Logger)
+	if !ok {
+		return
+	}
+	message, ok := module.Memory().Read(ptr, length)
+	if !ok {
+		return
+	}
+	field, _ := json.Marshal(string(message))
+	logger.Log([]string{string(field)})
+}
+
+// moduleCache caches compiled WebAssembly modules by application ID and hash of the module
+type moduleCache struct {
+	sync.RWMutex
+	modules map[string]map[[sha1.Size]byte]wazero.CompiledModule
+}
+
+var modules moduleCache
+
+func (c *moduleCache) get(appID string, hash [sha1.Size]byte) (wazero.CompiledModule, bool) {
+	c.RLock()
+	defer c.RUnlock()
+	module, ok := c.modules[appID][hash]
+	return module, ok
+}
+
+func (c *moduleCache) set(appID string, hash [sha1.Size]byte, module wazero.CompiledModule) {
+	c.Lock()
+	defer c.Unlock()
+	if c.modules == nil {
+		c.modules = make(map[string]map[[sha1.Size]byte]wazero.CompiledModule)
+	}
+	compiled, ok := c.modules[appID]
+	if !ok || len(compiled) >= MaxCachedPrograms {
+		closeModules(compiled)
+		compiled = make(map[[sha1.Size]byte]wazero.CompiledModule)
+		c.modules[appID] = compiled
+	}
+	compiled[hash] = module
+}
+
+func (c *moduleCache) invalidate(appID string) {
+	c.Lock()
+	defer c.Unlock()
+	closeModules(c.modules[appID])
+	delete(c.modules, appID)
+}
+
+func closeModules(compiled map[[sha1.Size]byte]wazero.CompiledModule) {
+	for _, module := range compiled {
+		module.Close(context.Background())
+	}
+}
+
+// compileWASM compiles the WebAssembly module. If the application ID is not empty, the compiled module is cached for
+// the application.
+func compileWASM(appID string, module []byte) (wazero.CompiledModule, error) {
+	runtime := getWASMRuntime()
+	if appID == "" {
+		return runtime.CompileModule(context.Background(), module)
+	}
+	hash := sha1.Sum(module)
+	if compiled, ok := modules.get(appID, hash); ok {
+		return compiled, nil
+	}
+	compiled, err := runtime.CompileModule(context.Background(), module)
+	if err != nil {
+		return nil, err
+	}
+	modules.set(appID, hash, compiled)
+	return compiled, nil
+}
+
+// CheckWASM returns an error if the WebAssembly module can not be compiled
+func CheckWASM(module []byte) error {
+	compiled, err := compileWASM("", module)
+	if err != nil {
+		return errors.NewErrInvalidArgument("WebAssembly module", err.Error())
+	}
+	compiled.Close(context.Background())
+	return nil
+}
+
+// RunWASM calls the exported function of the WebAssembly module with the input and the port, and returns its output.
+// The module must export its memory, an alloc(size i32) i32 function that allocates memory for the input, and the
+// function, which is called as function(ptr i32, len i32, port i32) i64 and returns the pointer to its output in the
+// upper 32 bits and the length of its output in the lower 32 bits. Every call gets a new instance of the module. The
+// execution is interrupted after the timeout. Messages that are logged with env.log(ptr i32, len i32) are passed to
+// the logger.
+func RunWASM(appID string, module []byte, function string, input []byte, port uint8, timeout time.Duration, logger Logger) (output []byte, err error) {
+	atomic.AddInt64(&running, 1)
+	defer atomic.AddInt64(&running, -1)
+
+	compiled, err := compileWASM(appID, module)
+	if err != nil {
+		return nil, errors.NewErrInvalidArgument("WebAssembly module", err.Error())
+	}
+
+	if logger == nil {
+		logger = Ignore
+	}
+	logger.Enter(function)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	ctx = context.WithValue(ctx, wasmLoggerKey{}, logger)
+
+	start := time.Now()
+
+	instance, err := getWASMRuntime().InstantiateModule(ctx, compiled, wazero.NewModuleConfig().WithName(""))
+	if err != nil {
+		return nil, errors.NewErrInternal(fmt.Sprintf("Could not instantiate WebAssembly module: %s", err))
+	}
+	defer instance.Close(context.Background())
+
+	alloc, fn := instance.ExportedFunction("alloc"), instance.ExportedFunction(function)
+	if alloc == nil || fn == nil || instance.Memory() == nil {
+		return nil, errors.NewErrInvalidArgument("WebAssembly module", fmt.Sprintf("does not export memory, alloc and %s", function))
+	}
+
+	interrupted := func(err error) error {
+		if ctx.Err() == context.DeadlineExceeded {
+			return errors.NewErrInternal(fmt.Sprintf("Interrupted WebAssembly execution for %s after %v", function, time.Since(start)))
+		}
+		return errors.NewErrInternal(fmt.Sprintf("%s threw error: %s", function, err))
+	}
+
+	res, err := alloc.Call(ctx, uint64(len(input)))
+	if err != nil {
+		return nil, interrupted(err)
+	}
+	if len(res) != 1 {
+		return nil, errors.NewErrInvalidArgument("alloc", "does not return a pointer")
+	}
+	ptr := uint32(res[0])
+	if !instance.Memory().Write(ptr, input) {
+		return nil, errors.NewErrInternal(fmt.Sprintf("alloc returned memory out of range for %s", function))
+	}
+
+	res, err = fn.Call(ctx, uint64(ptr), uint64(len(input)), uint64(port))
+	if err != nil {
+		return nil, interrupted(err)
+	}
+	if len(res) != 1 {
+		return nil, errors.NewErrInvalidArgument(function, "does not return a pointer and length")
+	}
+	out, ok := instance.Memory().Read(uint32(res[0]>>32), uint32(res[0]))
+	if !ok {
+		return nil, errors.NewErrInvalidArgument(function, "returns memory out of range")
+	}
+
+	// The memory of the module is released when it is closed
+	output = make([]byte, len(out))
+	copy(output, out)
+	return output, nil
+}
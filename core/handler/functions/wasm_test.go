@@ -0,0 +1,75 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package functions
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+// testWASMModule exports memory, alloc (which always returns 1024), decode (which returns {"temperature":21.5}),
+// encode (which returns its input) and spin (which never returns)
+var testWASMModule = []byte{
+	0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+	// types: (i32) -> i32 and (i32, i32, i32) -> i64
+	0x01, 0x0d, 0x02, 0x60, 0x01, 0x7f, 0x01, 0x7f, 0x60, 0x03, 0x7f, 0x7f, 0x7f, 0x01, 0x7e,
+	// functions
+	0x03, 0x05, 0x04, 0x00, 0x01, 0x01, 0x01,
+	// memory of 1 page
+	0x05, 0x03, 0x01, 0x00, 0x01,
+	// exports
+	0x07, 0x2b, 0x05,
+	0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x02, 0x00,
+	0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x00, 0x00,
+	0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x00, 0x01,
+	0x06, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x00, 0x02,
+	0x04, 0x73, 0x70, 0x69, 0x6e, 0x00, 0x03,
+	// code
+	0x0a, 0x23, 0x04,
+	0x05, 0x00, 0x41, 0x80, 0x08, 0x0b,
+	0x04, 0x00, 0x42, 0x14, 0x0b,
+	0x0c, 0x00, 0x20, 0x00, 0xad, 0x42, 0x20, 0x86, 0x20, 0x01, 0xad, 0x84, 0x0b,
+	0x09, 0x00, 0x03, 0x40, 0x0c, 0x00, 0x0b, 0x42, 0x00, 0x0b,
+	// data: {"temperature":21.5} at 0
+	0x0b, 0x1a, 0x01, 0x00, 0x41, 0x00, 0x0b, 0x14,
+	0x7b, 0x22, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x3a, 0x32, 0x31, 0x2e, 0x35, 0x7d,
+}
+
+func TestRunWASM(t *testing.T) {
+	a := New(t)
+
+	out, err := RunWASM("test", testWASMModule, "decode", []byte{0x01, 0x02}, 1, time.Second, Ignore)
+	a.So(err, ShouldBeNil)
+	a.So(string(out), ShouldEqual, `{"temperature":21.5}`)
+
+	out, err = RunWASM("test", testWASMModule, "encode", []byte(`{"led":true}`), 1, time.Second, nil)
+	a.So(err, ShouldBeNil)
+	a.So(string(out), ShouldEqual, `{"led":true}`)
+
+	_, err = RunWASM("test", testWASMModule, "convert", nil, 1, time.Second, Ignore)
+	a.So(err, ShouldNotBeNil)
+
+	_, err = RunWASM("test", []byte("function Decoder() {}"), "decode", nil, 1, time.Second, Ignore)
+	a.So(err, ShouldNotBeNil)
+
+	Invalidate("test")
+}
+
+func TestRunWASMTimeout(t *testing.T) {
+	a := New(t)
+
+	start := time.Now()
+	_, err := RunWASM("", testWASMModule, "spin", nil, 1, 50*time.Millisecond, Ignore)
+	a.So(err, ShouldNotBeNil)
+	a.So(time.Since(start), ShouldBeLessThan, time.Second)
+}
+
+func TestCheckWASM(t *testing.T) {
+	a := New(t)
+	a.So(CheckWASM(testWASMModule), ShouldBeNil)
+	a.So(CheckWASM(testWASMModule[:20]), ShouldNotBeNil)
+}
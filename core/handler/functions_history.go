@@ -0,0 +1,160 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/functions"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// functionsRevisionToPb converts a stored revision of the payload functions
+func functionsRevisionToPb(revision *application.FunctionsRevision) *pb.PayloadFunctionsRevision {
+	res := &pb.PayloadFunctionsRevision{
+		Revision:                revision.Revision,
+		Time:                    revision.Time.UnixNano(),
+		Decoder:                 revision.Decoder,
+		Converter:               revision.Converter,
+		Validator:               revision.Validator,
+		Encoder:                 revision.Encoder,
+		DownlinkDecoder:         revision.DownlinkDecoder,
+		PayloadFormat:           revision.PayloadFormat,
+		WasmModule:              revision.WASMModule,
+		PayloadFunctionsVersion: revision.PayloadFunctionsVersion,
+		Codec:                   revision.Codec,
+		FunctionsLanguage:       revision.FunctionsLanguage,
+		ProtobufDescriptor:      revision.ProtobufDescriptor,
+		ProtobufMessage:         revision.ProtobufMessage,
+		GoCodec:                 revision.GoCodec,
+		Normalizer:              revision.Normalizer,
+	}
+	for _, functions := range revision.PortFunctions {
+		res.PortFunctions = append(res.PortFunctions, &pb.PortFunctions{
+			MinPort:   uint32(functions.MinPort),
+			MaxPort:   uint32(functions.MaxPort),
+			Decoder:   functions.Decoder,
+			Converter: functions.Converter,
+			Validator: functions.Validator,
+			Encoder:   functions.Encoder,
+		})
+	}
+	res.BinaryFields = binaryFieldsToPb(revision.BinaryFields)
+	return res
+}
+
+func (h *handlerManager) ListPayloadFunctionsRevisions(ctx context.Context, in *pb.ApplicationIdentifier) (*pb.PayloadFunctionsRevisionList, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Identifier")
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.AppSettings)
+	if err != nil {
+		return nil, err
+	}
+	app, err := h.handler.applications.Get(in.AppId)
+	if err != nil {
+		return nil, errors.Wrap(err, "Application not registered to this Handler")
+	}
+
+	revisions, err := h.handler.applications.FunctionsHistory(in.AppId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.PayloadFunctionsRevisionList{
+		AppId:          in.AppId,
+		ActiveRevision: app.FunctionsRevision,
+		Revisions:      make([]*pb.PayloadFunctionsRevision, 0, len(revisions)),
+	}
+	for _, revision := range revisions {
+		res.Revisions = append(res.Revisions, functionsRevisionToPb(revision))
+	}
+	return res, nil
+}
+
+func (h *handlerManager) RollbackPayloadFunctions(ctx context.Context, in *pb.PayloadFunctionsRollbackRequest) (*pb.MutationResult, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Payload Functions Rollback Request")
+	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.AppSettings)
+	if err != nil {
+		return nil, err
+	}
+	result, err := h.handler.rollbackPayloadFunctions(in.AppId, in.Revision, in.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// rollbackPayloadFunctions restores the payload functions of a stored revision of the application. If dryRun is set,
+// the payload functions are only validated.
+func (h *handler) rollbackPayloadFunctions(appID string, revisionID uint64, dryRun bool) (*pb.MutationResult, error) {
+	app, err := h.applications.Get(appID)
+	if err != nil {
+		return nil, errors.Wrap(err, "Application not registered to this Handler")
+	}
+
+	revisions, err := h.applications.FunctionsHistory(appID)
+	if err != nil {
+		return nil, err
+	}
+	var revision *application.FunctionsRevision
+	for _, stored := range revisions {
+		if stored.Revision == revisionID {
+			revision = stored
+			break
+		}
+	}
+	if revision == nil {
+		return nil, errors.NewErrNotFound(fmt.Sprintf("Payload functions revision %d", revisionID))
+	}
+
+	app.StartUpdate()
+	revision.Restore(app)
+
+	result := &pb.MutationResult{
+		DryRun:   dryRun,
+		Changed:  app.ChangedFields(),
+		Revision: app.Revision + 1,
+	}
+	if err := checkFunctionsSyntax(app); err != nil {
+		return nil, err
+	}
+	if dryRun {
+		return result, nil
+	}
+
+	// Reject the rollback if the application was modified by someone else in the meantime
+	previous := app.FunctionsRevision
+	if err := h.applications.SetIfRevision(app, app.Revision); err != nil {
+		return nil, err
+	}
+	functions.Invalidate(appID)
+
+	h.Ctx.WithFields(ttnlog.Fields{
+		"AppID":    appID,
+		"Previous": previous,
+		"Restored": revisionID,
+		"Revision": app.FunctionsRevision,
+	}).Info("Rolled back payload functions")
+
+	return result, nil
+}
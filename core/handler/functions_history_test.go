@@ -0,0 +1,70 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	pb_discovery "github.com/TheThingsNetwork/ttn/api/discovery"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestRollbackPayloadFunctions(t *testing.T) {
+	a := New(t)
+	h := &handler{
+		Component: &component.Component{
+			Ctx:      GetLogger(t, "TestRollbackPayloadFunctions"),
+			Identity: &pb_discovery.Announcement{Id: "dev"},
+		},
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-rollback-payload-functions"),
+	}
+	h.applications.Set(&application.Application{AppID: "app-1"})
+	defer h.applications.Delete("app-1")
+
+	good := "function Decoder(bytes) { return { value: bytes[0] }; }"
+	broken := "function Decoder(bytes) { return { value: bytes[0]"
+
+	app, _ := h.applications.Get("app-1")
+	app.StartUpdate()
+	app.Decoder = good
+	a.So(h.applications.SetIfRevision(app, app.Revision), ShouldBeNil)
+
+	// The syntax of functions that are set without validation is not checked by the store
+	app, _ = h.applications.Get("app-1")
+	app.StartUpdate()
+	app.Decoder = broken
+	a.So(h.applications.SetIfRevision(app, app.Revision), ShouldBeNil)
+
+	_, err := h.rollbackPayloadFunctions("app-1", 42, false)
+	a.So(err, ShouldNotBeNil)
+	a.So(errors.GetErrType(err), ShouldEqual, errors.NotFound)
+
+	res, err := h.rollbackPayloadFunctions("app-1", 1, true)
+	a.So(err, ShouldBeNil)
+	a.So(res.DryRun, ShouldBeTrue)
+	a.So(res.Changed, ShouldContain, "Decoder")
+	app, _ = h.applications.Get("app-1")
+	a.So(app.Decoder, ShouldEqual, broken)
+
+	res, err = h.rollbackPayloadFunctions("app-1", 1, false)
+	a.So(err, ShouldBeNil)
+	a.So(res.Revision, ShouldEqual, 3)
+	app, _ = h.applications.Get("app-1")
+	a.So(app.Decoder, ShouldEqual, good)
+	a.So(app.FunctionsRevision, ShouldEqual, 3)
+
+	// The rollback is stored as a new revision, so it can be undone as well
+	revisions, err := h.applications.FunctionsHistory("app-1")
+	a.So(err, ShouldBeNil)
+	a.So(revisions, ShouldHaveLength, 3)
+	a.So(revisions[0].Revision, ShouldEqual, 3)
+
+	// Revisions with syntax errors can not be restored
+	_, err = h.rollbackPayloadFunctions("app-1", 2, false)
+	a.So(err, ShouldNotBeNil)
+}
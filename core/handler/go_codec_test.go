@@ -0,0 +1,44 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/gocodec"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+type testGoCodec struct{}
+
+func (testGoCodec) Decode(payload []byte, port uint8) (map[string]interface{}, error) {
+	return map[string]interface{}{"battery": payload[0], "port": port}, nil
+}
+
+func (testGoCodec) Encode(fields map[string]interface{}, port uint8) ([]byte, error) {
+	return []byte{byte(fields["led"].(float64))}, nil
+}
+
+func TestGoPayloadFormat(t *testing.T) {
+	a := New(t)
+
+	gocodec.Register("test-handler-codec", testGoCodec{})
+
+	up := &UplinkFunctions{PayloadFormat: pb.PayloadFormatGo, GoCodec: "test-handler-codec"}
+	fields, valid, err := up.Process([]byte{250}, 2)
+	a.So(err, ShouldBeNil)
+	a.So(valid, ShouldBeTrue)
+	a.So(fields, ShouldResemble, map[string]interface{}{"battery": byte(250), "port": uint8(2)})
+
+	down := &DownlinkFunctions{PayloadFormat: pb.PayloadFormatGo, GoCodec: "test-handler-codec"}
+	encoded, _, err := down.Process(map[string]interface{}{"led": 1.0}, 1)
+	a.So(err, ShouldBeNil)
+	a.So(encoded, ShouldResemble, []byte{1})
+
+	up.GoCodec = "unknown"
+	_, _, err = up.Process([]byte{250}, 2)
+	a.So(err, ShouldNotBeNil)
+}
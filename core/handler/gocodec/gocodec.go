@@ -0,0 +1,149 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+// Package gocodec contains the compiled Go codecs of the Handler. Applications with the go payload format are decoded
+// and encoded by a codec that is selected by name, without running payload functions. Codecs are built into the
+// Handler with Register, or loaded from Go plugins with LoadPlugins.
+package gocodec
+
+import (
+	"fmt"
+	"path/filepath"
+	"plugin"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// Codec decodes and encodes the payload of uplink and downlink messages
+type Codec interface {
+	Decode(payload []byte, port uint8) (map[string]interface{}, error)
+	Encode(fields map[string]interface{}, port uint8) ([]byte, error)
+}
+
+var (
+	mu     sync.RWMutex
+	codecs = make(map[string]Codec)
+)
+
+// Register registers the codec with the name, replacing a codec with the same name
+func Register(name string, codec Codec) {
+	mu.Lock()
+	defer mu.Unlock()
+	codecs[name] = codec
+}
+
+// Get returns the codec with the name
+func Get(name string) (Codec, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	codec, ok := codecs[name]
+	return codec, ok
+}
+
+// Names returns the sorted names of the registered codecs
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	names := make([]string, 0, len(codecs))
+	for name := range codecs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Decode decodes the payload with the codec with the name. A panic in the codec is returned as an error.
+func Decode(name string, payload []byte, port uint8) (fields map[string]interface{}, err error) {
+	codec, ok := Get(name)
+	if !ok {
+		return nil, errors.NewErrNotFound(fmt.Sprintf("Go codec %s", name))
+	}
+	defer func() {
+		if caught := recover(); caught != nil {
+			fields, err = nil, errors.NewErrInternal(fmt.Sprintf("Fatal error in Go codec %s: %s", name, caught))
+		}
+	}()
+	return codec.Decode(payload, port)
+}
+
+// Encode encodes the fields with the codec with the name. A panic in the codec is returned as an error.
+func Encode(name string, fields map[string]interface{}, port uint8) (payload []byte, err error) {
+	codec, ok := Get(name)
+	if !ok {
+		return nil, errors.NewErrNotFound(fmt.Sprintf("Go codec %s", name))
+	}
+	defer func() {
+		if caught := recover(); caught != nil {
+			payload, err = nil, errors.NewErrInternal(fmt.Sprintf("Fatal error in Go codec %s: %s", name, caught))
+		}
+	}()
+	return codec.Encode(fields, port)
+}
+
+// pluginCodec is a Codec of the functions of a Go plugin
+type pluginCodec struct {
+	decode func(payload []byte, port uint8) (map[string]interface{}, error)
+	encode func(fields map[string]interface{}, port uint8) ([]byte, error)
+}
+
+func (c *pluginCodec) Decode(payload []byte, port uint8) (map[string]interface{}, error) {
+	return c.decode(payload, port)
+}
+
+func (c *pluginCodec) Encode(fields map[string]interface{}, port uint8) ([]byte, error) {
+	if c.encode == nil {
+		return nil, errors.NewErrInvalidArgument("Go codec", "does not encode downlink messages")
+	}
+	return c.encode(fields, port)
+}
+
+// LoadPlugin loads the Go plugin at the path and registers it as a codec with the file name of the plugin (without
+// .so). The plugin exports a function Decode(payload []byte, port uint8) (map[string]interface{}, error) and
+// optionally a function Encode(fields map[string]interface{}, port uint8) ([]byte, error).
+func LoadPlugin(path string) (name string, err error) {
+	name = strings.TrimSuffix(filepath.Base(path), ".so")
+	if !api.ValidID(name) {
+		return "", errors.NewErrInvalidArgument("Go codec", fmt.Sprintf("%s is not a valid codec name", name))
+	}
+	p, err := plugin.Open(path)
+	if err != nil {
+		return "", errors.Wrap(err, fmt.Sprintf("Could not open Go codec plugin %s", path))
+	}
+	codec := new(pluginCodec)
+	decode, err := p.Lookup("Decode")
+	if err != nil {
+		return "", errors.NewErrInvalidArgument("Go codec", fmt.Sprintf("%s does not export Decode", name))
+	}
+	var ok bool
+	if codec.decode, ok = decode.(func([]byte, uint8) (map[string]interface{}, error)); !ok {
+		return "", errors.NewErrInvalidArgument("Go codec", fmt.Sprintf("Decode of %s has the wrong signature", name))
+	}
+	if encode, err := p.Lookup("Encode"); err == nil {
+		if codec.encode, ok = encode.(func(map[string]interface{}, uint8) ([]byte, error)); !ok {
+			return "", errors.NewErrInvalidArgument("Go codec", fmt.Sprintf("Encode of %s has the wrong signature", name))
+		}
+	}
+	Register(name, codec)
+	return name, nil
+}
+
+// LoadPlugins loads the Go plugins (*.so) in the directory as codecs, and returns their names
+func LoadPlugins(dir string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.so"))
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(paths))
+	for _, path := range paths {
+		name, err := LoadPlugin(path)
+		if err != nil {
+			return names, err
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
@@ -0,0 +1,69 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package gocodec
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+type testCodec struct{}
+
+func (testCodec) Decode(payload []byte, port uint8) (map[string]interface{}, error) {
+	return map[string]interface{}{"temperature": float64(payload[0]) / 2}, nil
+}
+
+func (testCodec) Encode(fields map[string]interface{}, port uint8) ([]byte, error) {
+	return []byte{byte(fields["interval"].(float64) / 60)}, nil
+}
+
+func TestGoCodecs(t *testing.T) {
+	a := New(t)
+
+	Register("test-codec", testCodec{})
+	_, ok := Get("test-codec")
+	a.So(ok, ShouldBeTrue)
+	a.So(Names(), ShouldContain, "test-codec")
+
+	fields, err := Decode("test-codec", []byte{43}, 1)
+	a.So(err, ShouldBeNil)
+	a.So(fields["temperature"], ShouldEqual, 21.5)
+
+	payload, err := Encode("test-codec", map[string]interface{}{"interval": 600.0}, 1)
+	a.So(err, ShouldBeNil)
+	a.So(payload, ShouldResemble, []byte{10})
+
+	// Panics are returned as errors
+	_, err = Decode("test-codec", []byte{}, 1)
+	a.So(err, ShouldNotBeNil)
+	_, err = Encode("test-codec", map[string]interface{}{}, 1)
+	a.So(err, ShouldNotBeNil)
+
+	_, err = Decode("unknown", []byte{43}, 1)
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestLoadPlugins(t *testing.T) {
+	a := New(t)
+
+	dir, err := ioutil.TempDir("", "ttn-go-codecs")
+	a.So(err, ShouldBeNil)
+	defer os.RemoveAll(dir)
+
+	names, err := LoadPlugins(dir)
+	a.So(err, ShouldBeNil)
+	a.So(names, ShouldBeEmpty)
+
+	ioutil.WriteFile(filepath.Join(dir, "Not Valid.so"), []byte{}, 0644)
+	_, err = LoadPlugins(dir)
+	a.So(err, ShouldNotBeNil)
+
+	_, err = LoadPlugin(filepath.Join(dir, "not-a-plugin.so"))
+	a.So(err, ShouldNotBeNil)
+}
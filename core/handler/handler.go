@@ -4,17 +4,25 @@
 package handler
 
 import (
+	"crypto/tls"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/TheThingsNetwork/ttn/amqp"
 	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
 	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	pb_joinserver "github.com/TheThingsNetwork/ttn/api/joinserver"
 	pb_monitor "github.com/TheThingsNetwork/ttn/api/monitor"
 	"github.com/TheThingsNetwork/ttn/core/component"
 	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/archive"
+	"github.com/TheThingsNetwork/ttn/core/handler/codec"
 	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/handler/devicerepository"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/mqtt"
+	"github.com/TheThingsNetwork/ttn/utils/secrets"
 	"google.golang.org/grpc"
 	"gopkg.in/redis.v5"
 )
@@ -26,11 +34,28 @@ type Handler interface {
 
 	WithMQTT(username, password string, brokers ...string) Handler
 	WithAMQP(username, password, host, exchange string) Handler
+	WithAMQPTLS(tlsConfig *tls.Config) Handler
+	WithShadowAMQP(username, password, host, exchange string) Handler
+	WithWebhookTLS(tlsConfig *tls.Config) Handler
+	WithSecrets(backend secrets.Backend) Handler
+	WithReadOnly() Handler
+	WithStateVerification(repair bool) Handler
+	WithMaxFunctionTimeout(timeout time.Duration) Handler
+	WithJoinServer(addr, cert, token string) Handler
+	WithDevAddrAllocation(strategy string) Handler
+	WithDeviceRepository(url string) Handler
+	WithArchive(backend archive.Backend) Handler
+	WithRegion(region string) Handler
+	WithReplicas(peers []string, key string) Handler
+	WithRecycleBin(retention time.Duration) Handler
 
 	HandleUplink(uplink *pb_broker.DeduplicatedUplinkMessage) error
 	HandleActivationChallenge(challenge *pb_broker.ActivationChallengeRequest) (*pb_broker.ActivationChallengeResponse, error)
 	HandleActivation(activation *pb_broker.DeduplicatedDeviceActivationRequest) (*pb.DeviceActivationResponse, error)
 	EnqueueDownlink(appDownlink *types.DownlinkMessage) error
+
+	MQTTAuth() http.Handler
+	ReplicaSync() http.Handler
 }
 
 // NewRedisHandler creates a new Redis-backed Handler
@@ -38,7 +63,11 @@ func NewRedisHandler(client *redis.Client, ttnBrokerID string) Handler {
 	return &handler{
 		devices:      device.NewRedisDeviceStore(client, "handler"),
 		applications: application.NewRedisApplicationStore(client, "handler"),
+		codecs:       codec.NewRedisCodecStore(client, "handler"),
 		ttnBrokerID:  ttnBrokerID,
+
+		mqttCredentials: application.NewRedisMQTTCredentialsStore(client, "handler"),
+		webhooks:        application.NewRedisWebhookStore(client, "handler"),
 	}
 }
 
@@ -47,13 +76,40 @@ type handler struct {
 
 	devices      device.Store
 	applications application.Store
+	codecs       codec.Store
+
+	readOnly bool
+
+	stateVerification bool
+	stateRepair       bool
+
+	maxFunctionTimeout time.Duration
+
+	defaultDevAddrAllocation string
+
+	region string
+
+	recycleBinRetention time.Duration
+
+	deviceRepository *devicerepository.Client
+
+	archiveBackend archive.Backend
+	archiver       *archive.Archiver
 
 	ttnBrokerID      string
 	ttnBrokerConn    *grpc.ClientConn
 	ttnBroker        pb_broker.BrokerClient
 	ttnBrokerManager pb_broker.BrokerManagerClient
 
-	downlink chan *pb_broker.DownlinkMessage
+	joinServerAddr    string
+	joinServerCert    string
+	joinServerToken   string
+	joinServerConn    *grpc.ClientConn
+	joinServer        pb_joinserver.JoinServerClient
+	joinServerManager pb_joinserver.JoinServerManagerClient
+
+	downlink  chan *pb_broker.DownlinkMessage
+	dutyCycle dutyCycle
 
 	mqttClient   mqtt.Client
 	mqttUsername string
@@ -63,16 +119,44 @@ type handler struct {
 	mqttUp       chan *types.UplinkMessage
 	mqttEvent    chan *types.DeviceEvent
 
+	mqttCredentials application.MQTTCredentialsStore
+
 	amqpClient   amqp.Client
 	amqpUsername string
 	amqpPassword string
 	amqpHost     string
 	amqpExchange string
+	amqpTLS      *tls.Config
 	amqpEnabled  bool
 	amqpUp       chan *types.UplinkMessage
 
+	amqpShadowClient   amqp.Client
+	amqpShadowUsername string
+	amqpShadowPassword string
+	amqpShadowHost     string
+	amqpShadowExchange string
+	amqpShadowEnabled  bool
+	amqpShadowUp       chan *types.UplinkMessage
+
+	webhooks      application.WebhookStore
+	webhookQueue  chan *webhookRequest
+	webhookClient *http.Client
+	webhookTLS    *tls.Config
+	secrets       secrets.Backend
+	webhookCache  webhookCache
+
 	status        *status
 	monitorStream pb_monitor.GenericStream
+
+	shedding shedding
+
+	debugging debugDevices
+
+	commands pendingCommands
+
+	transfers transfers
+
+	replicas *replicas
 }
 
 var (
@@ -97,14 +181,61 @@ func (h *handler) WithAMQP(username, password, host, exchange string) Handler {
 	return h
 }
 
+func (h *handler) WithAMQPTLS(tlsConfig *tls.Config) Handler {
+	h.amqpTLS = tlsConfig
+	return h
+}
+
+func (h *handler) WithWebhookTLS(tlsConfig *tls.Config) Handler {
+	h.webhookTLS = tlsConfig
+	return h
+}
+
+func (h *handler) WithReadOnly() Handler {
+	h.readOnly = true
+	return h
+}
+
+func (h *handler) WithStateVerification(repair bool) Handler {
+	h.stateVerification = true
+	h.stateRepair = repair
+	return h
+}
+
+func (h *handler) WithMaxFunctionTimeout(timeout time.Duration) Handler {
+	h.maxFunctionTimeout = timeout
+	return h
+}
+
+func (h *handler) WithDevAddrAllocation(strategy string) Handler {
+	h.defaultDevAddrAllocation = strategy
+	return h
+}
+
 func (h *handler) Init(c *component.Component) error {
 	h.Component = c
 	h.InitStatus()
+	h.registerLoadSignals()
 	err := h.Component.UpdateTokenKey()
 	if err != nil {
 		return err
 	}
 
+	if h.stateVerification {
+		h.verifyStateOnStart()
+	}
+
+	if h.usesJoinServer() {
+		err = h.connectJoinServer()
+		if err != nil {
+			return err
+		}
+	}
+
+	if h.readOnly {
+		return h.initReadOnly()
+	}
+
 	err = h.Announce()
 	if err != nil {
 		return err
@@ -128,11 +259,30 @@ func (h *handler) Init(c *component.Component) error {
 		}
 	}
 
+	if h.amqpShadowEnabled {
+		h.HandleShadowAMQP(h.amqpShadowUsername, h.amqpShadowPassword, h.amqpShadowHost, h.amqpShadowExchange)
+	}
+
+	if h.webhooks != nil {
+		h.HandleWebhooks()
+	}
+
 	err = h.associateBroker()
 	if err != nil {
 		return err
 	}
 
+	go h.runRetention()
+	go h.runMQTTCredentialsSync()
+
+	if h.replicas != nil {
+		go h.runReplicas()
+	}
+
+	if h.archiveBackend != nil {
+		h.archiver = archive.NewArchiver(h.Ctx, h.archiveBackend)
+	}
+
 	h.Component.SetStatus(component.StatusHealthy)
 	if h.Component.Monitor != nil {
 		h.monitorStream = h.Component.Monitor.NewHandlerStreams(h.Identity.Id, h.AccessToken)
@@ -148,9 +298,15 @@ func (h *handler) Shutdown() {
 	if h.amqpEnabled {
 		h.amqpClient.Disconnect()
 	}
+	if h.amqpShadowEnabled && h.amqpShadowClient != nil {
+		h.amqpShadowClient.Disconnect()
+	}
+	if h.archiver != nil {
+		h.archiver.Close()
+	}
 }
 
-func (h *handler) associateBroker() error {
+func (h *handler) connectBroker() error {
 	broker, err := h.Discover("broker", h.ttnBrokerID)
 	if err != nil {
 		return err
@@ -162,6 +318,14 @@ func (h *handler) associateBroker() error {
 	h.ttnBrokerConn = conn
 	h.ttnBroker = pb_broker.NewBrokerClient(conn)
 	h.ttnBrokerManager = pb_broker.NewBrokerManagerClient(conn)
+	return nil
+}
+
+func (h *handler) associateBroker() error {
+	err := h.connectBroker()
+	if err != nil {
+		return err
+	}
 
 	h.downlink = make(chan *pb_broker.DownlinkMessage)
 
@@ -0,0 +1,81 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"time"
+
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+func (h *handlerManager) GetDeviceState(ctx context.Context, in *pb.DeviceStateRequest) (*pb.DeviceState, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device State Request")
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.Devices)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := h.handler.applications.Get(in.AppId); err != nil {
+		return nil, errors.Wrap(err, "Application not registered to this Handler")
+	}
+
+	history, err := h.handler.devices.History(in.AppId, in.DevId)
+	if err != nil {
+		return nil, err
+	}
+	change, err := history.At(time.Unix(0, in.Time))
+	if err != nil {
+		return nil, err
+	}
+
+	dev := change.State.Device(in.AppId, in.DevId)
+	pbDev := &pb.Device{
+		AppId:       dev.AppID,
+		DevId:       dev.DevID,
+		Description: dev.Description,
+		Attributes:  dev.Attributes,
+		Device: &pb.Device_LorawanDevice{LorawanDevice: &pb_lorawan.Device{
+			AppId:                 dev.AppID,
+			AppEui:                &dev.AppEUI,
+			DevId:                 dev.DevID,
+			DevEui:                &dev.DevEUI,
+			DevAddr:               &dev.DevAddr,
+			NwkSKey:               &dev.NwkSKey,
+			AppSKey:               &dev.AppSKey,
+			AppKey:                &dev.AppKey,
+			FCntUp:                dev.FCntUp,
+			DisableFCntCheck:      dev.Options.DisableFCntCheck,
+			Uses32BitFCnt:         dev.Options.Uses32BitFCnt,
+			ResetsFCnt:            dev.Options.ResetsFCnt,
+			RxWindow:              dev.Options.RxWindow,
+			Relay:                 dev.Options.Relay,
+			RegionalParameters:    dev.Options.RegionalParameters,
+			ActivationConstraints: dev.Options.ActivationConstraints,
+		}},
+		Latitude:  dev.Latitude,
+		Longitude: dev.Longitude,
+		Altitude:  dev.Altitude,
+		Revision:  dev.Revision,
+		Profile:   dev.Profile.ToPb(),
+	}
+	if !dev.LastSeen.IsZero() {
+		pbDev.GetLorawanDevice().LastSeen = dev.LastSeen.UnixNano()
+	}
+
+	return &pb.DeviceState{
+		Time:    change.Time.UnixNano(),
+		Changed: change.Changed,
+		Device:  pbDev,
+	}, nil
+}
@@ -0,0 +1,76 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"encoding/json"
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+func (h *handlerManager) InjectUplink(ctx context.Context, in *pb.InjectedUplinkMessage) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Injected Uplink")
+	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
+	if err := h.validateComponentAccess(ctx); err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(in.Fields), &fields); err != nil || fields == nil {
+		return nil, errors.NewErrInvalidArgument("Fields", "must be a JSON object")
+	}
+	if err := h.handler.injectUplink(in.AppId, in.DevId, uint8(in.Port), fields); err != nil {
+		return nil, err
+	}
+	return new(empty.Empty), nil
+}
+
+// injectUplink publishes a synthetic uplink message with the fields to the integrations of the application. The
+// fields are not processed by the payload functions, and the metadata of the message is flagged as simulated.
+func (h *handler) injectUplink(appID, devID string, port uint8, fields map[string]interface{}) error {
+	dev, err := h.devices.Get(appID, devID)
+	if err != nil {
+		return err
+	}
+
+	uplink := &types.UplinkMessage{
+		AppID:          appID,
+		DevID:          devID,
+		HardwareSerial: dev.DevEUI.String(),
+		FPort:          port,
+		PayloadFields:  fields,
+		Metadata: types.Metadata{
+			Time:      types.JSONTime(time.Now().UTC()),
+			Simulated: true,
+			LocationMetadata: types.LocationMetadata{
+				Latitude:  dev.Latitude,
+				Longitude: dev.Longitude,
+				Altitude:  dev.Altitude,
+			},
+		},
+	}
+
+	for _, uplink := range expandReadings(uplink) {
+		h.mqttUp <- uplink
+		if h.amqpEnabled {
+			h.amqpUp <- uplink
+		}
+	}
+
+	h.Ctx.WithFields(ttnlog.Fields{
+		"AppID": appID,
+		"DevID": devID,
+	}).Info("Injected uplink")
+
+	return nil
+}
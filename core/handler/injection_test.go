@@ -0,0 +1,45 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestInjectUplink(t *testing.T) {
+	a := New(t)
+
+	h := &handler{
+		Component: &component.Component{Ctx: GetLogger(t, "TestInjectUplink")},
+		devices:   device.NewRedisDeviceStore(GetRedisClient(), "handler-test-inject-uplink"),
+		mqttUp:    make(chan *types.UplinkMessage, 10),
+	}
+	h.devices.Set(&device.Device{
+		AppID:    "appid",
+		DevID:    "devid",
+		DevEUI:   types.DevEUI([8]byte{1, 2, 3, 4, 5, 6, 7, 8}),
+		Latitude: 52.37,
+	})
+	defer h.devices.Delete("appid", "devid")
+
+	err := h.injectUplink("appid", "devid", 1, map[string]interface{}{"temperature": 21.5})
+	a.So(err, ShouldBeNil)
+
+	uplink := <-h.mqttUp
+	a.So(uplink.HardwareSerial, ShouldEqual, "0102030405060708")
+	a.So(uplink.FPort, ShouldEqual, 1)
+	a.So(uplink.PayloadRaw, ShouldBeEmpty)
+	a.So(uplink.PayloadFields, ShouldResemble, map[string]interface{}{"temperature": 21.5})
+	a.So(uplink.Metadata.Simulated, ShouldBeTrue)
+	a.So(uplink.Metadata.Latitude, ShouldEqual, float32(52.37))
+
+	err = h.injectUplink("appid", "unknown", 1, map[string]interface{}{"temperature": 21.5})
+	a.So(err, ShouldNotBeNil)
+}
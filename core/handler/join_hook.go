@@ -0,0 +1,170 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/handler/functions"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// JoinHookFunctions runs the join hook of an application using JavaScript functions
+type JoinHookFunctions struct {
+	// JoinHook is a JavaScript function that accepts the device and the metadata of the join
+	// and returns an object containing attributes for the device and a downlink message
+	JoinHook string
+
+	// Logger is the logger that will be used to store logs
+	Logger functions.Logger
+}
+
+// JoinHookResult is the result of a join hook
+type JoinHookResult struct {
+	// Attributes that should be set on the device
+	Attributes map[string]string
+	// Downlink message that should be enqueued for the device
+	Downlink *types.DownlinkMessage
+}
+
+// Process runs the JoinHook function for the device that joined with the given metadata
+func (f *JoinHookFunctions) Process(dev *device.Device, metadata types.Metadata) (*JoinHookResult, error) {
+	if f.JoinHook == "" {
+		return nil, nil
+	}
+
+	// Convert the metadata to an object with the same fields as in MQTT messages
+	marshalled, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+	var md map[string]interface{}
+	if err := json.Unmarshal(marshalled, &md); err != nil {
+		return nil, err
+	}
+
+	attributes := make(map[string]interface{}, len(dev.Attributes))
+	for k, v := range dev.Attributes {
+		attributes[k] = v
+	}
+
+	env := map[string]interface{}{
+		"device": map[string]interface{}{
+			"app_id":     dev.AppID,
+			"dev_id":     dev.DevID,
+			"app_eui":    dev.AppEUI.String(),
+			"dev_eui":    dev.DevEUI.String(),
+			"dev_addr":   dev.DevAddr.String(),
+			"attributes": attributes,
+		},
+		"metadata": md,
+	}
+	code := fmt.Sprintf(`
+		%s;
+		JoinHook(device, metadata)
+	`, f.JoinHook)
+
+	value, err := functions.RunCode("JoinHook", code, env, timeOut, f.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if !value.IsObject() {
+		return nil, errors.NewErrInvalidArgument("JoinHook", "does not return an object")
+	}
+
+	v, _ := value.Export()
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, errors.NewErrInvalidArgument("JoinHook", "does not return an object")
+	}
+
+	res := new(JoinHookResult)
+
+	if attributes, ok := m["attributes"]; ok {
+		attributes, ok := attributes.(map[string]interface{})
+		if !ok {
+			return nil, errors.NewErrInvalidArgument("JoinHook", "attributes should be an object")
+		}
+		res.Attributes = make(map[string]string, len(attributes))
+		for k, v := range attributes {
+			res.Attributes[k] = fmt.Sprint(v)
+		}
+	}
+
+	if downlink, ok := m["downlink"]; ok {
+		downlink, ok := downlink.(map[string]interface{})
+		if !ok {
+			return nil, errors.NewErrInvalidArgument("JoinHook", "downlink should be an object")
+		}
+		res.Downlink = &types.DownlinkMessage{
+			AppID:    dev.AppID,
+			DevID:    dev.DevID,
+			FPort:    1,
+			Schedule: types.ScheduleLast,
+		}
+		if port, ok := downlink["port"]; ok {
+			port, ok := toInteger(port)
+			if !ok || port < 1 || port > 223 {
+				return nil, errors.NewErrInvalidArgument("JoinHook", "downlink port should be a number between 1 and 223")
+			}
+			res.Downlink.FPort = uint8(port)
+		}
+		if confirmed, ok := downlink["confirmed"].(bool); ok {
+			res.Downlink.Confirmed = confirmed
+		}
+		if payload, ok := downlink["payload"]; ok {
+			res.Downlink.PayloadRaw, err = toBytes("JoinHook", payload)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if fields, ok := downlink["fields"]; ok {
+			res.Downlink.PayloadFields, ok = fields.(map[string]interface{})
+			if !ok {
+				return nil, errors.NewErrInvalidArgument("JoinHook", "downlink fields should be an object")
+			}
+		}
+		if res.Downlink.PayloadRaw == nil && res.Downlink.PayloadFields == nil {
+			return nil, errors.NewErrInvalidArgument("JoinHook", "downlink should contain payload or fields")
+		}
+	}
+
+	return res, nil
+}
+
+// runJoinHook runs the join hook of the application of the device and sets the attributes it returns.
+// The returned downlink message should be enqueued after the device has been saved.
+func (h *handler) runJoinHook(ctx ttnlog.Interface, dev *device.Device, metadata types.Metadata) *types.DownlinkMessage {
+	app, err := h.applications.Get(dev.AppID)
+	if err != nil || app.JoinHook == "" {
+		return nil
+	}
+
+	functions := &JoinHookFunctions{
+		JoinHook: app.JoinHook,
+		Logger:   functions.Ignore,
+	}
+
+	res, err := functions.Process(dev, metadata)
+	if err != nil {
+		ctx.WithError(err).Warn("Could not run join hook")
+		return nil
+	}
+
+	if len(res.Attributes) > 0 {
+		if dev.Attributes == nil {
+			dev.Attributes = make(map[string]string, len(res.Attributes))
+		}
+		for k, v := range res.Attributes {
+			dev.Attributes[k] = v
+		}
+	}
+
+	return res.Downlink
+}
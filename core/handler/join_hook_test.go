@@ -0,0 +1,109 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestJoinHookFunctions(t *testing.T) {
+	a := New(t)
+
+	dev := &device.Device{
+		AppID:   "appid",
+		DevID:   "devid",
+		DevEUI:  types.DevEUI([8]byte{1, 2, 3, 4, 5, 6, 7, 8}),
+		DevAddr: types.DevAddr([4]byte{1, 2, 3, 4}),
+	}
+	metadata := types.Metadata{DataRate: "SF7BW125"}
+
+	// No hook
+	functions := &JoinHookFunctions{}
+	res, err := functions.Process(dev, metadata)
+	a.So(err, ShouldBeNil)
+	a.So(res, ShouldBeNil)
+
+	// Attributes and downlink
+	functions = &JoinHookFunctions{
+		JoinHook: `function JoinHook(device, metadata) {
+			return {
+				attributes: { dev_addr: device.dev_addr, data_rate: metadata.data_rate, version: 2 },
+				downlink: { port: 2, payload: [1, 2, 3], confirmed: true }
+			};
+		}`,
+	}
+	res, err = functions.Process(dev, metadata)
+	a.So(err, ShouldBeNil)
+	a.So(res.Attributes, ShouldResemble, map[string]string{
+		"dev_addr":  "01020304",
+		"data_rate": "SF7BW125",
+		"version":   "2",
+	})
+	a.So(res.Downlink, ShouldNotBeNil)
+	a.So(res.Downlink.FPort, ShouldEqual, 2)
+	a.So(res.Downlink.PayloadRaw, ShouldResemble, []byte{1, 2, 3})
+	a.So(res.Downlink.Confirmed, ShouldBeTrue)
+	a.So(res.Downlink.Schedule, ShouldEqual, types.ScheduleLast)
+
+	// Downlink with fields
+	functions = &JoinHookFunctions{
+		JoinHook: `function JoinHook(device, metadata) {
+			return { downlink: { fields: { interval: 60 } } };
+		}`,
+	}
+	res, err = functions.Process(dev, metadata)
+	a.So(err, ShouldBeNil)
+	a.So(res.Attributes, ShouldBeNil)
+	a.So(res.Downlink.FPort, ShouldEqual, 1)
+	a.So(res.Downlink.PayloadFields, ShouldContainKey, "interval")
+
+	// Invalid results
+	for _, hook := range []string{
+		`function JoinHook(device, metadata) { return "foo"; }`,
+		`function JoinHook(device, metadata) { return { attributes: "foo" }; }`,
+		`function JoinHook(device, metadata) { return { downlink: { port: 1 } }; }`,
+		`function JoinHook(device, metadata) { return { downlink: { port: 0, payload: [1] } }; }`,
+		`function JoinHook(device, metadata) { return { downlink: { payload: [256] } }; }`,
+	} {
+		functions = &JoinHookFunctions{JoinHook: hook}
+		_, err = functions.Process(dev, metadata)
+		a.So(err, ShouldNotBeNil)
+	}
+}
+
+func TestRunJoinHook(t *testing.T) {
+	a := New(t)
+	h := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestRunJoinHook")},
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-run-join-hook"),
+	}
+	h.applications.Set(&application.Application{
+		AppID: "appid",
+		JoinHook: `function JoinHook(device, metadata) {
+			return { attributes: { joined: "true" }, downlink: { payload: [1] } };
+		}`,
+	})
+	defer func() {
+		h.applications.Delete("appid")
+	}()
+
+	dev := &device.Device{
+		AppID:      "appid",
+		DevID:      "devid",
+		Attributes: map[string]string{"model": "foo"},
+	}
+	downlink := h.runJoinHook(GetLogger(t, "TestRunJoinHook"), dev, types.Metadata{})
+	a.So(dev.Attributes, ShouldResemble, map[string]string{"model": "foo", "joined": "true"})
+	a.So(downlink, ShouldNotBeNil)
+	a.So(downlink.AppID, ShouldEqual, "appid")
+	a.So(downlink.DevID, ShouldEqual, "devid")
+	a.So(downlink.PayloadRaw, ShouldResemble, []byte{1})
+}
@@ -0,0 +1,104 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+
+	"github.com/TheThingsNetwork/ttn/api"
+	pb_joinserver "github.com/TheThingsNetwork/ttn/api/joinserver"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/joinserver"
+	js_device "github.com/TheThingsNetwork/ttn/core/joinserver/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"google.golang.org/grpc"
+)
+
+func (h *handler) WithJoinServer(addr, cert, token string) Handler {
+	h.joinServerAddr = addr
+	h.joinServerCert = cert
+	h.joinServerToken = token
+	return h
+}
+
+// usesJoinServer returns whether the joins of devices are handled by a Join Server that stores their root keys
+func (h *handler) usesJoinServer() bool {
+	return h.joinServerAddr != ""
+}
+
+func (h *handler) connectJoinServer() (err error) {
+	var conn *grpc.ClientConn
+	if h.joinServerCert == "" {
+		conn, err = api.Dial(h.joinServerAddr)
+	} else {
+		conn, err = api.DialWithCert(h.joinServerAddr, h.joinServerCert)
+	}
+	if err != nil {
+		return err
+	}
+	h.joinServerConn = conn
+	h.joinServer = pb_joinserver.NewJoinServerClient(conn)
+	h.joinServerManager = pb_joinserver.NewJoinServerManagerClient(conn)
+	return nil
+}
+
+// join handles the join request of the device with the Join Server, or with the root keys of the device if the
+// Handler does not use a Join Server. In the latter case, the used nonces of the device are updated.
+func (h *handler) join(dev *device.Device, req *pb_joinserver.JoinRequest) (*pb_joinserver.JoinResponse, error) {
+	if h.usesJoinServer() {
+		res, err := h.joinServer.Join(h.GetContext(h.joinServerToken), req)
+		if err != nil {
+			return nil, errors.Wrap(errors.FromGRPCError(err), "Join Server did not accept join")
+		}
+		return res, nil
+	}
+
+	if dev.AppKey.IsEmpty() {
+		return nil, errors.NewErrNotFound(fmt.Sprintf("AppKey for device %s", dev.DevID))
+	}
+	keys := &js_device.Device{
+		AppEUI: dev.AppEUI,
+		DevEUI: dev.DevEUI,
+		AppKey: dev.AppKey,
+	}
+	for _, nonce := range dev.UsedDevNonces {
+		keys.UsedDevNonces = append(keys.UsedDevNonces, js_device.DevNonce(nonce))
+	}
+	for _, nonce := range dev.UsedAppNonces {
+		keys.UsedAppNonces = append(keys.UsedAppNonces, js_device.AppNonce(nonce))
+	}
+	res, err := joinserver.Join(keys, req)
+	if err != nil {
+		return nil, err
+	}
+	dev.UsedDevNonces = append(dev.UsedDevNonces, device.DevNonce(keys.UsedDevNonces[len(keys.UsedDevNonces)-1]))
+	dev.UsedAppNonces = append(dev.UsedAppNonces, device.AppNonce(keys.UsedAppNonces[len(keys.UsedAppNonces)-1]))
+	return res, nil
+}
+
+// setDeviceKeys sets the root keys of a device in the Join Server
+func (h *handler) setDeviceKeys(appEUI types.AppEUI, devEUI types.DevEUI, appKey types.AppKey) error {
+	_, err := h.joinServerManager.SetDeviceKeys(h.GetContext(h.joinServerToken), &pb_joinserver.DeviceKeys{
+		AppEui: &appEUI,
+		DevEui: &devEUI,
+		AppKey: &appKey,
+	})
+	if err != nil {
+		return errors.Wrap(errors.FromGRPCError(err), "Join Server did not set device keys")
+	}
+	return nil
+}
+
+// deleteDeviceKeys deletes the root keys of a device from the Join Server, if it has any
+func (h *handler) deleteDeviceKeys(appEUI types.AppEUI, devEUI types.DevEUI) error {
+	_, err := h.joinServerManager.DeleteDeviceKeys(h.GetContext(h.joinServerToken), &pb_joinserver.DeviceIdentifier{
+		AppEui: &appEUI,
+		DevEui: &devEUI,
+	})
+	if err != nil && errors.GetErrType(errors.FromGRPCError(err)) != errors.NotFound {
+		return errors.Wrap(errors.FromGRPCError(err), "Join Server did not delete device keys")
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"github.com/TheThingsNetwork/ttn/core/handler/functions"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// decodeLua decodes the payload with the Lua Decoder function, which gets the payload as a table of bytes (starting at
+// index 1) and returns a table with the fields, or buffered readings as an array of tables
+func (f *UplinkFunctions) decodeLua(payload []byte, port uint8) (map[string]interface{}, error) {
+	v, err := functions.RunLua(f.AppID, "Decoder", f.Decoder, []interface{}{payload, port, f.metadata()}, orDefaultTimeout(f.Timeout), f.Logger)
+	if err != nil {
+		return nil, err
+	}
+	if m, ok := v.(map[string]interface{}); ok {
+		return m, nil
+	}
+	if _, ok := readingsOf(v); ok {
+		return map[string]interface{}{ReadingsField: v}, nil
+	}
+	return nil, errors.NewErrInvalidArgument("Decoder", "does not return a table or an array of tables")
+}
+
+// convertLua converts the fields with the Lua Converter function
+func (f *UplinkFunctions) convertLua(fields map[string]interface{}, port uint8) (map[string]interface{}, error) {
+	v, err := functions.RunLua(f.AppID, "Converter", f.Converter, []interface{}{fields, port, f.metadata()}, orDefaultTimeout(f.Timeout), f.Logger)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, errors.NewErrInvalidArgument("Converter", "does not return a table")
+	}
+	return m, nil
+}
+
+// validateLua validates the fields with the Lua Validator function
+func (f *UplinkFunctions) validateLua(fields map[string]interface{}, port uint8) (bool, error) {
+	v, err := functions.RunLua(f.AppID, "Validator", f.Validator, []interface{}{fields, port}, orDefaultTimeout(f.Timeout), f.Logger)
+	if err != nil {
+		return false, err
+	}
+	valid, ok := v.(bool)
+	if !ok {
+		return false, errors.NewErrInvalidArgument("Validator", "does not return a boolean")
+	}
+	return valid, nil
+}
+
+// encodeLua encodes the fields with the Lua Encoder function, which returns a table of bytes, or a table like
+// {bytes = {...}, fPort = 12, confirmed = true}
+func (f *DownlinkFunctions) encodeLua(fields map[string]interface{}, port uint8) (*EncodedDownlink, error) {
+	if f.Encoder == "" {
+		return nil, errors.NewErrInvalidArgument("Downlink Payload", "fields supplied, but no Encoder function set")
+	}
+	v, err := functions.RunLua(f.AppID, "Encoder", f.Encoder, []interface{}{fields, port}, orDefaultTimeout(f.Timeout), f.Logger)
+	if err != nil {
+		return nil, err
+	}
+	return toEncodedDownlink(v)
+}
@@ -0,0 +1,79 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestLuaPayloadFunctions(t *testing.T) {
+	a := New(t)
+
+	up := &UplinkFunctions{
+		Language: pb.FunctionsLanguageLua,
+		Decoder: `
+function Decoder(bytes, port)
+  return { temperature = (bytes[1] * 256 + bytes[2]) / 100 }
+end`,
+		Converter: `
+function Converter(decoded, port)
+  decoded.fahrenheit = decoded.temperature * 9 / 5 + 32
+  return decoded
+end`,
+		Validator: `
+function Validator(converted, port)
+  return converted.temperature < 100
+end`,
+	}
+	fields, valid, err := up.Process([]byte{0x08, 0x66}, 1)
+	a.So(err, ShouldBeNil)
+	a.So(valid, ShouldBeTrue)
+	a.So(fields["temperature"], ShouldEqual, 21.5)
+	a.So(fields["fahrenheit"], ShouldAlmostEqual, 70.7)
+
+	fields, valid, err = up.Process([]byte{0xFF, 0xFF}, 1)
+	a.So(err, ShouldBeNil)
+	a.So(valid, ShouldBeFalse)
+
+	// Buffered readings
+	up.Decoder = `
+function Decoder(bytes, port)
+  return { { temperature = bytes[1] }, { temperature = bytes[2] } }
+end`
+	up.Converter, up.Validator = "", ""
+	fields, _, err = up.Process([]byte{21, 22}, 1)
+	a.So(err, ShouldBeNil)
+	a.So(fields[ReadingsField], ShouldHaveLength, 2)
+
+	up.Decoder = `function Decoder(bytes, port) return "temperature" end`
+	_, _, err = up.Process([]byte{21}, 1)
+	a.So(err, ShouldNotBeNil)
+
+	down := &DownlinkFunctions{
+		Language: pb.FunctionsLanguageLua,
+		Encoder: `
+function Encoder(fields, port)
+  if fields.led then
+    return { 1 }
+  end
+  return { bytes = { 0 }, fPort = 2, confirmed = true }
+end`,
+	}
+	encoded, err := down.EncodeDownlink(map[string]interface{}{"led": true}, 1)
+	a.So(err, ShouldBeNil)
+	a.So(encoded.Payload, ShouldResemble, []byte{1})
+	encoded, err = down.EncodeDownlink(map[string]interface{}{"led": false}, 1)
+	a.So(err, ShouldBeNil)
+	a.So(encoded.Payload, ShouldResemble, []byte{0})
+	a.So(encoded.FPort, ShouldEqual, 2)
+	a.So(*encoded.Confirmed, ShouldBeTrue)
+
+	down.Encoder = ""
+	_, err = down.EncodeDownlink(map[string]interface{}{"led": true}, 1)
+	a.So(err, ShouldNotBeNil)
+}
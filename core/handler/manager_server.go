@@ -18,6 +18,8 @@ import (
 	"github.com/TheThingsNetwork/ttn/api/ratelimit"
 	"github.com/TheThingsNetwork/ttn/core/handler/application"
 	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/handler/functions"
+	"github.com/TheThingsNetwork/ttn/core/handler/gocodec"
 	"github.com/TheThingsNetwork/ttn/core/storage"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
@@ -87,7 +89,8 @@ func (h *handlerManager) GetDevice(ctx context.Context, in *pb.DeviceIdentifier)
 		return nil, err
 	}
 
-	if _, err := h.handler.applications.Get(in.AppId); err != nil {
+	app, err := h.handler.applications.Get(in.AppId)
+	if err != nil {
 		return nil, errors.Wrap(err, "Application not registered to this Handler")
 	}
 
@@ -100,6 +103,7 @@ func (h *handlerManager) GetDevice(ctx context.Context, in *pb.DeviceIdentifier)
 		AppId:       dev.AppID,
 		DevId:       dev.DevID,
 		Description: dev.Description,
+		Attributes:  dev.Attributes,
 		Device: &pb.Device_LorawanDevice{LorawanDevice: &pb_lorawan.Device{
 			AppId:                 dev.AppID,
 			AppEui:                &dev.AppEUI,
@@ -111,13 +115,30 @@ func (h *handlerManager) GetDevice(ctx context.Context, in *pb.DeviceIdentifier)
 			AppKey:                &dev.AppKey,
 			DisableFCntCheck:      dev.Options.DisableFCntCheck,
 			Uses32BitFCnt:         dev.Options.Uses32BitFCnt,
+			ResetsFCnt:            dev.Options.ResetsFCnt,
+			RxWindow:              dev.Options.RxWindow,
+			Relay:                 dev.Options.Relay,
+			RegionalParameters:    dev.Options.RegionalParameters,
 			ActivationConstraints: dev.Options.ActivationConstraints,
 		}},
 		Latitude:  dev.Latitude,
 		Longitude: dev.Longitude,
 		Altitude:  dev.Altitude,
+		Revision:  dev.Revision,
+		Profile:   dev.Profile.ToPb(),
+
+		PayloadFormat: dev.PayloadFormat,
+		Codec:         dev.Codec,
+		GoCodec:       dev.GoCodec,
+	}
+
+	if size, dataRate, err := h.handler.maxPayloadSize(dev); err == nil {
+		pbDev.MaxDownlinkPayloadSize = uint32(size)
+		pbDev.DownlinkDataRate = dataRate
 	}
 
+	pbDev.DownlinkDelivery = dev.DownlinkDelivery.ToPb()
+
 	nsDev, err := h.deviceManager.GetDevice(ctx, &pb_lorawan.DeviceIdentifier{
 		AppEui: &dev.AppEUI,
 		DevEui: &dev.DevEUI,
@@ -131,6 +152,10 @@ func (h *handlerManager) GetDevice(ctx context.Context, in *pb.DeviceIdentifier)
 			"DevEUI": dev.DevEUI,
 		}).Warn("Re-registering missing device to Broker")
 		nsDev = dev.GetLoRaWAN()
+		nsDev.DevAddrAllocation = h.handler.devAddrAllocation(app)
+		if err := h.handler.checkBrokerCapabilities(nsDev); err != nil {
+			return nil, err
+		}
 		_, err = h.deviceManager.SetDevice(ctx, nsDev)
 		if err != nil {
 			return nil, errors.Wrap(errors.FromGRPCError(err), "Could not re-register missing device to Broker")
@@ -142,14 +167,25 @@ func (h *handlerManager) GetDevice(ctx context.Context, in *pb.DeviceIdentifier)
 	pbDev.GetLorawanDevice().FCntUp = nsDev.FCntUp
 	pbDev.GetLorawanDevice().FCntDown = nsDev.FCntDown
 	pbDev.GetLorawanDevice().LastSeen = nsDev.LastSeen
+	pbDev.GetLorawanDevice().Battery = nsDev.Battery
 
 	return pbDev, nil
 }
 
-func (h *handlerManager) SetDevice(ctx context.Context, in *pb.Device) (*empty.Empty, error) {
+func (h *handlerManager) SetDevice(ctx context.Context, in *pb.Device) (*pb.MutationResult, error) {
+	if len(in.UpdateMask) > 0 {
+		merged, err := h.mergeDevice(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		in = merged
+	}
 	if err := in.Validate(); err != nil {
 		return nil, errors.Wrap(err, "Invalid Device")
 	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
 
 	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
 	if err != nil {
@@ -160,7 +196,8 @@ func (h *handlerManager) SetDevice(ctx context.Context, in *pb.Device) (*empty.E
 		return nil, err
 	}
 
-	if _, err := h.handler.applications.Get(in.AppId); err != nil {
+	app, err := h.handler.applications.Get(in.AppId)
+	if err != nil {
 		return nil, errors.Wrap(err, "Application not registered to this Handler")
 	}
 
@@ -175,31 +212,40 @@ func (h *handlerManager) SetDevice(ctx context.Context, in *pb.Device) (*empty.E
 	}
 
 	var eventType types.EventType
+	var currentRevision uint64
+	var previousDevAddr types.DevAddr
+	var previousAppEUI types.AppEUI
+	var previousDevEUI types.DevEUI
+	var euisChanged bool
 	if dev != nil {
 		eventType = types.UpdateEvent
-		if dev.AppEUI != *lorawan.AppEui || dev.DevEUI != *lorawan.DevEui {
-			// If the AppEUI or DevEUI is changed, we should remove the device from the NetworkServer and re-add it later
-			_, err = h.deviceManager.DeleteDevice(ctx, &pb_lorawan.DeviceIdentifier{
-				AppEui: &dev.AppEUI,
-				DevEui: &dev.DevEUI,
-			})
-			if err != nil {
-				return nil, errors.Wrap(errors.FromGRPCError(err), "Broker did not delete device")
-			}
-		}
+		currentRevision = dev.Revision
+		previousDevAddr = dev.DevAddr
+		previousAppEUI, previousDevEUI = dev.AppEUI, dev.DevEUI
+		euisChanged = dev.AppEUI != *lorawan.AppEui || dev.DevEUI != *lorawan.DevEui
 		dev.StartUpdate()
 	} else {
 		eventType = types.CreateEvent
+		dev = new(device.Device)
+	}
+
+	if in.Revision != currentRevision {
+		return nil, errors.NewErrConflict(fmt.Sprintf("Device (revision %d, expected %d)", currentRevision, in.Revision))
+	}
+
+	if eventType == types.CreateEvent || euisChanged {
 		existingDevices, err := h.handler.devices.ListForApp(in.AppId, nil)
 		if err != nil {
 			return nil, err
 		}
 		for _, existingDevice := range existingDevices {
+			if existingDevice.DevID == in.DevId {
+				continue
+			}
 			if existingDevice.AppEUI == *lorawan.AppEui && existingDevice.DevEUI == *lorawan.DevEui {
 				return nil, errors.NewErrAlreadyExists("Device with AppEUI and DevEUI")
 			}
 		}
-		dev = new(device.Device)
 	}
 
 	dev.AppID = in.AppId
@@ -208,10 +254,31 @@ func (h *handlerManager) SetDevice(ctx context.Context, in *pb.Device) (*empty.E
 	dev.DevEUI = *lorawan.DevEui
 
 	dev.Description = in.Description
+	dev.Attributes = in.Attributes
+	dev.Profile = device.ProfileFromPb(in.Profile)
+
+	// The payload format and codec of the device override the ones of the application
+	if in.Codec != dev.Codec && in.Codec != "" {
+		if _, err := h.handler.codecs.Get(in.Codec); err != nil {
+			return nil, errors.Wrap(err, "Codec not in the codec library of this Handler")
+		}
+	}
+	if in.GoCodec != dev.GoCodec && in.GoCodec != "" {
+		if _, ok := gocodec.Get(in.GoCodec); !ok {
+			return nil, errors.NewErrNotFound(fmt.Sprintf("Go codec %s on this Handler", in.GoCodec))
+		}
+	}
+	dev.PayloadFormat = in.PayloadFormat
+	dev.Codec = in.Codec
+	dev.GoCodec = in.GoCodec
 
 	dev.Options = device.Options{
 		DisableFCntCheck:      lorawan.DisableFCntCheck,
 		Uses32BitFCnt:         lorawan.Uses32BitFCnt,
+		ResetsFCnt:            lorawan.ResetsFCnt,
+		RxWindow:              lorawan.RxWindow,
+		Relay:                 lorawan.Relay,
+		RegionalParameters:    lorawan.RegionalParameters,
 		ActivationConstraints: lorawan.ActivationConstraints,
 	}
 	if dev.Options.ActivationConstraints == "" {
@@ -228,7 +295,13 @@ func (h *handlerManager) SetDevice(ctx context.Context, in *pb.Device) (*empty.E
 		dev.AppSKey = *lorawan.AppSKey
 	}
 
-	if lorawan.AppKey != nil {
+	// The root keys are not stored by the Handler if it uses a Join Server
+	var joinServerAppKey *types.AppKey
+	if lorawan.AppKey != nil && h.handler.usesJoinServer() {
+		if !lorawan.AppKey.IsEmpty() {
+			joinServerAppKey = lorawan.AppKey
+		}
+	} else if lorawan.AppKey != nil {
 		if dev.AppKey != *lorawan.AppKey { // When the AppKey of an existing device is changed
 			dev.UsedAppNonces = []device.AppNonce{}
 			dev.UsedDevNonces = []device.DevNonce{}
@@ -240,21 +313,70 @@ func (h *handlerManager) SetDevice(ctx context.Context, in *pb.Device) (*empty.E
 	dev.Longitude = in.Longitude
 	dev.Altitude = in.Altitude
 
-	// Update the device in the Broker (NetworkServer)
 	nsUpdated := dev.GetLoRaWAN()
 	nsUpdated.FCntUp = lorawan.FCntUp
 	nsUpdated.FCntDown = lorawan.FCntDown
+	nsUpdated.DevAddrAllocation = h.handler.devAddrAllocation(app)
+	if err := h.handler.checkBrokerCapabilities(nsUpdated); err != nil {
+		return nil, err
+	}
+
+	result := &pb.MutationResult{
+		DryRun:   in.DryRun,
+		Created:  eventType == types.CreateEvent,
+		Changed:  dev.ChangedFields(),
+		Revision: in.Revision + 1,
+	}
+	if in.DryRun {
+		return result, nil
+	}
+
+	if euisChanged {
+		// If the AppEUI or DevEUI is changed, we should remove the device from the NetworkServer and re-add it later
+		_, err = h.deviceManager.DeleteDevice(ctx, &pb_lorawan.DeviceIdentifier{
+			AppEui: &previousAppEUI,
+			DevEui: &previousDevEUI,
+		})
+		if err != nil {
+			return nil, errors.Wrap(errors.FromGRPCError(err), "Broker did not delete device")
+		}
+		if h.handler.usesJoinServer() {
+			if err := h.handler.deleteDeviceKeys(previousAppEUI, previousDevEUI); err != nil {
+				return nil, err
+			}
+		}
+	}
 
+	if joinServerAppKey != nil {
+		if err := h.handler.setDeviceKeys(dev.AppEUI, dev.DevEUI, *joinServerAppKey); err != nil {
+			return nil, err
+		}
+	}
+
+	// Update the device in the Broker (NetworkServer)
 	_, err = h.deviceManager.SetDevice(ctx, nsUpdated)
 	if err != nil {
 		return nil, errors.Wrap(errors.FromGRPCError(err), "Broker did not set device")
 	}
 
-	err = h.handler.devices.Set(dev)
+	var sessionChanged bool
+	for _, field := range dev.ChangedFields() {
+		if field == "DevAddr" || field == "NwkSKey" || field == "AppSKey" {
+			sessionChanged = true
+		}
+	}
+
+	err = h.handler.devices.SetIfRevision(dev, in.Revision)
 	if err != nil {
 		return nil, err
 	}
 
+	if sessionChanged && !(eventType == types.CreateEvent && dev.DevAddr.IsEmpty()) {
+		h.handler.logSessionChange(dev, previousDevAddr, "update")
+	}
+
+	h.handler.recordDeviceChange(dev.AppID, dev.DevID, eventType)
+
 	h.handler.mqttEvent <- &types.DeviceEvent{
 		AppID: dev.AppID,
 		DevID: dev.DevID,
@@ -262,13 +384,94 @@ func (h *handlerManager) SetDevice(ctx context.Context, in *pb.Device) (*empty.E
 		Data:  nil, // Don't send potentially sensitive details over MQTT
 	}
 
+	return result, nil
+}
+
+func (h *handlerManager) ForceRejoin(ctx context.Context, in *pb.DeviceIdentifier) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Identifier")
+	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.Devices)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.forceRejoin(ctx, in.AppId, in.DevId); err != nil {
+		return nil, err
+	}
 	return &empty.Empty{}, nil
 }
 
+// forceRejoin clears the session of the device in the Handler and in the Broker, so that the device has to join again
+func (h *handlerManager) forceRejoin(ctx context.Context, appID, devID string) error {
+	app, err := h.handler.applications.Get(appID)
+	if err != nil {
+		return errors.Wrap(err, "Application not registered to this Handler")
+	}
+
+	dev, err := h.handler.devices.Get(appID, devID)
+	if err != nil {
+		return err
+	}
+
+	if dev.AppKey.IsEmpty() && !h.handler.usesJoinServer() {
+		return errors.NewErrInvalidArgument("Device", "can not force a rejoin of a device without AppKey")
+	}
+
+	dev.StartUpdate()
+	previousDevAddr := dev.DevAddr
+	dev.DevAddr = types.DevAddr{}
+	dev.NwkSKey = types.NwkSKey{}
+	dev.AppSKey = types.AppSKey{}
+	dev.FCntUp = 0
+	dev.CurrentDownlink = nil
+
+	// Clear the session in the Broker (NetworkServer)
+	nsDev := dev.GetLoRaWAN()
+	nsDev.DevAddrAllocation = h.handler.devAddrAllocation(app)
+	if nsDev.DevAddrAllocation == pb_lorawan.DevAddrAllocationSticky {
+		// The NetworkServer re-uses the DevAddr when the device joins again
+		nsDev.DevAddr = &previousDevAddr
+	}
+	if err := h.handler.checkBrokerCapabilities(nsDev); err != nil {
+		return err
+	}
+	_, err = h.deviceManager.SetDevice(ctx, nsDev)
+	if err != nil {
+		return errors.Wrap(errors.FromGRPCError(err), "Broker did not clear device session")
+	}
+
+	err = h.handler.devices.SetIfRevision(dev, dev.Revision)
+	if err != nil {
+		return err
+	}
+
+	h.handler.logSessionChange(dev, previousDevAddr, "forced rejoin")
+
+	h.handler.recordDeviceChange(dev.AppID, dev.DevID, types.UpdateEvent)
+
+	h.handler.mqttEvent <- &types.DeviceEvent{
+		AppID: dev.AppID,
+		DevID: dev.DevID,
+		Event: types.UpdateEvent,
+	}
+
+	return nil
+}
+
 func (h *handlerManager) DeleteDevice(ctx context.Context, in *pb.DeviceIdentifier) (*empty.Empty, error) {
 	if err := in.Validate(); err != nil {
 		return nil, errors.Wrap(err, "Invalid Device Identifier")
 	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
 	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
 	if err != nil {
 		return nil, err
@@ -286,14 +489,11 @@ func (h *handlerManager) DeleteDevice(ctx context.Context, in *pb.DeviceIdentifi
 	if err != nil {
 		return nil, err
 	}
-	_, err = h.deviceManager.DeleteDevice(ctx, &pb_lorawan.DeviceIdentifier{AppEui: &dev.AppEUI, DevEui: &dev.DevEUI})
-	if err != nil && errors.GetErrType(errors.FromGRPCError(err)) != errors.NotFound {
-		return nil, errors.Wrap(errors.FromGRPCError(err), "Broker did not delete device")
-	}
-	err = h.handler.devices.Delete(in.AppId, in.DevId)
+	err = h.deleteDevice(ctx, dev, time.Now())
 	if err != nil {
 		return nil, err
 	}
+	h.handler.recordDeviceChange(in.AppId, in.DevId, types.DeleteEvent)
 	h.handler.mqttEvent <- &types.DeviceEvent{
 		AppID: in.AppId,
 		DevID: in.DevId,
@@ -302,6 +502,30 @@ func (h *handlerManager) DeleteDevice(ctx context.Context, in *pb.DeviceIdentifi
 	return &empty.Empty{}, nil
 }
 
+func (h *handlerManager) ForgetDevice(ctx context.Context, in *pb.DeviceIdentifier) (*pb.ErasureReport, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Identifier")
+	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.Devices)
+	if err != nil {
+		return nil, err
+	}
+
+	dev, err := h.handler.devices.Get(in.AppId, in.DevId)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.handler.forgetDevice(dev)
+}
+
 func (h *handlerManager) GetDevicesForApplication(ctx context.Context, in *pb.ApplicationIdentifier) (*pb.DeviceList, error) {
 	if err := in.Validate(); err != nil {
 		return nil, errors.Wrap(err, "Invalid Application Identifier")
@@ -319,12 +543,10 @@ func (h *handlerManager) GetDevicesForApplication(ctx context.Context, in *pb.Ap
 		return nil, errors.Wrap(err, "Application not registered to this Handler")
 	}
 
-	limit, offset, err := api.LimitAndOffsetFromContext(ctx)
+	opts, err := deviceListOptions(ctx)
 	if err != nil {
 		return nil, err
 	}
-
-	opts := &storage.ListOptions{Limit: limit, Offset: offset}
 	devices, err := h.handler.devices.ListForApp(in.AppId, opts)
 	if err != nil {
 		return nil, err
@@ -334,23 +556,41 @@ func (h *handlerManager) GetDevicesForApplication(ctx context.Context, in *pb.Ap
 		if dev == nil {
 			continue
 		}
+		var lastSeen int64
+		if !dev.LastSeen.IsZero() {
+			lastSeen = dev.LastSeen.UnixNano()
+		}
 		res.Devices = append(res.Devices, &pb.Device{
 			AppId:       dev.AppID,
 			DevId:       dev.DevID,
 			Description: dev.Description,
 			Device: &pb.Device_LorawanDevice{LorawanDevice: &pb_lorawan.Device{
-				AppId:   dev.AppID,
-				AppEui:  &dev.AppEUI,
-				DevId:   dev.DevID,
-				DevEui:  &dev.DevEUI,
-				DevAddr: &dev.DevAddr,
-				NwkSKey: &dev.NwkSKey,
-				AppSKey: &dev.AppSKey,
-				AppKey:  &dev.AppKey,
+				AppId:                 dev.AppID,
+				AppEui:                &dev.AppEUI,
+				DevId:                 dev.DevID,
+				DevEui:                &dev.DevEUI,
+				DevAddr:               &dev.DevAddr,
+				NwkSKey:               &dev.NwkSKey,
+				AppSKey:               &dev.AppSKey,
+				AppKey:                &dev.AppKey,
+				DisableFCntCheck:      dev.Options.DisableFCntCheck,
+				Uses32BitFCnt:         dev.Options.Uses32BitFCnt,
+				ResetsFCnt:            dev.Options.ResetsFCnt,
+				RxWindow:              dev.Options.RxWindow,
+				Relay:                 dev.Options.Relay,
+				RegionalParameters:    dev.Options.RegionalParameters,
+				ActivationConstraints: dev.Options.ActivationConstraints,
+				LastSeen:              lastSeen,
 			}},
 			Latitude:  dev.Latitude,
 			Longitude: dev.Longitude,
 			Altitude:  dev.Altitude,
+			Revision:  dev.Revision,
+			Profile:   dev.Profile.ToPb(),
+
+			PayloadFormat: dev.PayloadFormat,
+			Codec:         dev.Codec,
+			GoCodec:       dev.GoCodec,
 		})
 	}
 
@@ -359,11 +599,129 @@ func (h *handlerManager) GetDevicesForApplication(ctx context.Context, in *pb.Ap
 		"total", strconv.FormatUint(total, 10),
 		"selected", strconv.FormatUint(selected, 10),
 	)
+	if cursor := opts.NextCursor(); cursor != "" {
+		header = metadata.Join(header, metadata.Pairs("next-cursor", cursor))
+	}
 	grpc.SendHeader(ctx, header)
 
 	return res, nil
 }
 
+// The fields that devices can be sorted by, and the stored fields they map to
+var deviceSortFields = map[string]string{
+	"dev_id":     "",
+	"created_at": "created_at",
+	"last_seen":  "last_seen",
+}
+
+// The fields that devices can be filtered on, and how filter values are converted to the stored format
+var deviceFilterFields = map[string]func(value string) (string, error){
+	"app_eui": func(value string) (string, error) {
+		eui, err := types.ParseAppEUI(value)
+		return eui.String(), err
+	},
+	"dev_eui": func(value string) (string, error) {
+		eui, err := types.ParseDevEUI(value)
+		return eui.String(), err
+	},
+	"dev_addr": func(value string) (string, error) {
+		addr, err := types.ParseDevAddr(value)
+		return addr.String(), err
+	},
+}
+
+// deviceListOptions returns the list options for devices from the limit, offset, cursor, sort and filter metadata
+func deviceListOptions(ctx context.Context) (*storage.ListOptions, error) {
+	limit, offset, err := api.LimitAndOffsetFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	md := api.MetadataFromContext(ctx)
+	opts := &storage.ListOptions{Limit: limit, Offset: offset, Cursor: api.CursorFromMetadata(md)}
+
+	sortBy, descending := api.SortFromMetadata(md)
+	if sortBy != "" {
+		field, ok := deviceSortFields[sortBy]
+		if !ok {
+			return nil, errors.NewErrInvalidArgument("Sort", fmt.Sprintf("can not sort devices by %s", sortBy))
+		}
+		opts.SortBy, opts.Descending = field, descending
+	}
+
+	filters, err := api.FiltersFromMetadata(md)
+	if err != nil {
+		return nil, err
+	}
+	for field, value := range filters {
+		convert, ok := deviceFilterFields[field]
+		if !ok {
+			return nil, errors.NewErrInvalidArgument("Filter", fmt.Sprintf("can not filter devices on %s", field))
+		}
+		if filters[field], err = convert(value); err != nil {
+			return nil, errors.NewErrInvalidArgument("Filter", err.Error())
+		}
+	}
+	if len(filters) > 0 {
+		opts.Filters = filters
+	}
+
+	return opts, nil
+}
+
+func (h *handlerManager) GetDeviceUplinks(ctx context.Context, in *pb.DeviceIdentifier) (*pb.DeviceUplinkList, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Identifier")
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.Devices)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := h.handler.devices.Get(in.AppId, in.DevId); err != nil {
+		return nil, err
+	}
+
+	limit, offset, err := api.LimitAndOffsetFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := h.handler.devices.UplinkHistory(in.AppId, in.DevId)
+	if err != nil {
+		return nil, err
+	}
+	uplinks, err := history.Get()
+	if err != nil {
+		return nil, err
+	}
+	if app, _ := h.handler.applications.Get(in.AppId); app != nil {
+		if cutoff, ok := retentionCutoff(app, time.Now()); ok {
+			uplinks = withinRetention(uplinks, cutoff)
+		}
+	}
+
+	total := uint64(len(uplinks))
+	if offset > total {
+		offset = total
+	}
+	uplinks = uplinks[offset:]
+	if limit > 0 && limit < uint64(len(uplinks)) {
+		uplinks = uplinks[:limit]
+	}
+
+	header := metadata.Pairs(
+		"total", strconv.FormatUint(total, 10),
+		"selected", strconv.Itoa(len(uplinks)),
+	)
+	grpc.SendHeader(ctx, header)
+
+	return &pb.DeviceUplinkList{Uplinks: uplinks}, nil
+}
+
 func (h *handlerManager) GetApplication(ctx context.Context, in *pb.ApplicationIdentifier) (*pb.Application, error) {
 	if err := in.Validate(); err != nil {
 		return nil, errors.NewErrInvalidArgument("Application Identifier", err.Error())
@@ -381,19 +739,102 @@ func (h *handlerManager) GetApplication(ctx context.Context, in *pb.ApplicationI
 		return nil, err
 	}
 
-	return &pb.Application{
+	pbApp := &pb.Application{
 		AppId:     app.AppID,
 		Decoder:   app.Decoder,
 		Converter: app.Converter,
 		Validator: app.Validator,
 		Encoder:   app.Encoder,
-	}, nil
+		JoinHook:  app.JoinHook,
+
+		DownlinkDecoder:         app.DownlinkDecoder,
+		PayloadFunctionsVersion: app.PayloadFunctionsVersion,
+		IntegrationFormat:       app.IntegrationFormat,
+		DevAddrAllocation:       app.DevAddrAllocation,
+		RetentionDays:           app.RetentionDays,
+		SensitiveFields:         app.SensitiveFields,
+		PayloadFormat:           app.PayloadFormat,
+		MaintenanceWindows:      app.MaintenanceWindows,
+		FunctionTimeout:         uint32(app.FunctionTimeout / time.Millisecond),
+		MaxOutputFields:         app.MaxOutputFields,
+		MaxOutputDepth:          app.MaxOutputDepth,
+		MaxOutputSize:           app.MaxOutputSize,
+		WasmModule:              app.WASMModule,
+		FunctionsLanguage:       app.FunctionsLanguage,
+		ProtobufDescriptor:      app.ProtobufDescriptor,
+		ProtobufMessage:         app.ProtobufMessage,
+		GoCodec:                 app.GoCodec,
+		DataResidency:           app.DataResidency,
+		StatefulDecoding:        app.StatefulDecoding,
+		Normalizer:              app.Normalizer,
+		FunctionsRevision:       app.FunctionsRevision,
+		Codec:                   app.Codec,
+		Revision:                app.Revision,
+	}
+
+	if provisioning := app.ProvisioningDownlink; provisioning != nil {
+		pbApp.ProvisioningDownlink = &pb.ProvisioningDownlink{
+			Port:      uint32(provisioning.FPort),
+			Confirmed: provisioning.Confirmed,
+			Payload:   provisioning.PayloadRaw,
+			Fields:    provisioning.PayloadFields,
+		}
+	}
+
+	if anomalyDetection := app.AnomalyDetection; anomalyDetection != nil {
+		pbApp.AnomalyDetection = &pb.AnomalyDetection{
+			Sensitivity: anomalyDetection.Sensitivity,
+		}
+	}
+
+	for _, functions := range app.PortFunctions {
+		pbApp.PortFunctions = append(pbApp.PortFunctions, &pb.PortFunctions{
+			MinPort:   uint32(functions.MinPort),
+			MaxPort:   uint32(functions.MaxPort),
+			Decoder:   functions.Decoder,
+			Converter: functions.Converter,
+			Validator: functions.Validator,
+			Encoder:   functions.Encoder,
+		})
+	}
+
+	pbApp.BinaryFields = binaryFieldsToPb(app.BinaryFields)
+
+	for _, field := range app.ComputedFields {
+		pbApp.ComputedFields = append(pbApp.ComputedFields, &pb.ComputedField{
+			Name:       field.Name,
+			Expression: field.Expression,
+		})
+	}
+
+	if outputPolicy := app.OutputPolicy; outputPolicy != nil {
+		pbApp.OutputPolicy = &pb.OutputPolicy{
+			Round:           outputPolicy.Round,
+			Decimals:        uint32(outputPolicy.Decimals),
+			RoundingMode:    outputPolicy.RoundingMode,
+			SpecialValues:   outputPolicy.SpecialValues,
+			FieldCasing:     outputPolicy.FieldCasing,
+			Replacement:     outputPolicy.Replacement,
+			ProtectReserved: outputPolicy.ProtectReserved,
+		}
+	}
+
+	if aggregation := app.Aggregation; aggregation != nil {
+		pbApp.Aggregation = &pb.Aggregation{
+			Window: uint32(aggregation.Window / time.Second),
+		}
+	}
+
+	return pbApp, nil
 }
 
 func (h *handlerManager) RegisterApplication(ctx context.Context, in *pb.ApplicationIdentifier) (*empty.Empty, error) {
 	if err := in.Validate(); err != nil {
 		return nil, errors.Wrap(err, "Invalid Application Identifier")
 	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
 	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
 	if err != nil {
 		return nil, err
@@ -417,28 +858,44 @@ func (h *handlerManager) RegisterApplication(ctx context.Context, in *pb.Applica
 		return nil, err
 	}
 
+	h.announceApplication(ctx, in.AppId)
+
+	return &empty.Empty{}, nil
+
+}
+
+// announceApplication registers the application with the Discovery server and the Broker, so that the traffic of the
+// application is routed to this Handler
+func (h *handlerManager) announceApplication(ctx context.Context, appID string) {
 	token, _ := api.TokenFromContext(ctx)
-	err = h.handler.Discovery.AddAppID(in.AppId, token)
+	err := h.handler.Discovery.AddAppID(appID, token)
 	if err != nil {
-		h.handler.Ctx.WithField("AppID", in.AppId).WithError(err).Warn("Could not register Application with Discovery")
+		h.handler.Ctx.WithField("AppID", appID).WithError(err).Warn("Could not register Application with Discovery")
 	}
 
 	_, err = h.handler.ttnBrokerManager.RegisterApplicationHandler(ctx, &pb_broker.ApplicationHandlerRegistration{
-		AppId:     in.AppId,
+		AppId:     appID,
 		HandlerId: h.handler.Identity.Id,
 	})
 	if err != nil {
-		h.handler.Ctx.WithField("AppID", in.AppId).WithError(err).Warn("Could not register Application with Broker")
+		h.handler.Ctx.WithField("AppID", appID).WithError(err).Warn("Could not register Application with Broker")
 	}
-
-	return &empty.Empty{}, nil
-
 }
 
-func (h *handlerManager) SetApplication(ctx context.Context, in *pb.Application) (*empty.Empty, error) {
+func (h *handlerManager) SetApplication(ctx context.Context, in *pb.Application) (*pb.MutationResult, error) {
+	if len(in.UpdateMask) > 0 {
+		merged, err := h.mergeApplication(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		in = merged
+	}
 	if err := in.Validate(); err != nil {
 		return nil, errors.Wrap(err, "Invalid Application")
 	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
 	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
 	if err != nil {
 		return nil, err
@@ -452,25 +909,144 @@ func (h *handlerManager) SetApplication(ctx context.Context, in *pb.Application)
 		return nil, err
 	}
 
+	if in.Revision != app.Revision {
+		return nil, errors.NewErrConflict(fmt.Sprintf("Application (revision %d, expected %d)", app.Revision, in.Revision))
+	}
+
 	app.StartUpdate()
 
+	// Payload functions that are changed here are no longer the version that was set in bulk
+	if app.Decoder != in.Decoder || app.Converter != in.Converter || app.Validator != in.Validator || app.Encoder != in.Encoder {
+		app.PayloadFunctionsVersion = ""
+	}
+
 	app.Decoder = in.Decoder
 	app.Converter = in.Converter
 	app.Validator = in.Validator
 	app.Encoder = in.Encoder
+	app.JoinHook = in.JoinHook
+	app.PortFunctions = portFunctionsFromPb(in.PortFunctions)
+	app.DownlinkDecoder = in.DownlinkDecoder
+	app.Normalizer = in.Normalizer
+
+	app.ProvisioningDownlink = nil
+	if provisioning := in.ProvisioningDownlink; provisioning != nil {
+		app.ProvisioningDownlink = &application.ProvisioningDownlink{
+			FPort:         uint8(provisioning.Port),
+			Confirmed:     provisioning.Confirmed,
+			PayloadRaw:    provisioning.Payload,
+			PayloadFields: provisioning.Fields,
+		}
+	}
+
+	app.AnomalyDetection = nil
+	if anomalyDetection := in.AnomalyDetection; anomalyDetection != nil {
+		app.AnomalyDetection = &application.AnomalyDetection{
+			Sensitivity: anomalyDetection.Sensitivity,
+		}
+	}
+
+	app.ComputedFields = nil
+	for _, field := range in.ComputedFields {
+		app.ComputedFields = append(app.ComputedFields, application.ComputedField{
+			Name:       field.Name,
+			Expression: field.Expression,
+		})
+	}
+
+	app.OutputPolicy = nil
+	if outputPolicy := in.OutputPolicy; outputPolicy != nil {
+		app.OutputPolicy = &application.OutputPolicy{
+			Round:           outputPolicy.Round,
+			Decimals:        uint8(outputPolicy.Decimals),
+			RoundingMode:    outputPolicy.RoundingMode,
+			SpecialValues:   outputPolicy.SpecialValues,
+			FieldCasing:     outputPolicy.FieldCasing,
+			Replacement:     outputPolicy.Replacement,
+			ProtectReserved: outputPolicy.ProtectReserved,
+		}
+	}
+
+	app.Aggregation = nil
+	if aggregation := in.Aggregation; aggregation != nil {
+		app.Aggregation = &application.Aggregation{
+			Window: time.Duration(aggregation.Window) * time.Second,
+		}
+	}
 
-	err = h.handler.applications.Set(app)
+	app.IntegrationFormat = in.IntegrationFormat
+	app.DevAddrAllocation = in.DevAddrAllocation
+	app.RetentionDays = in.RetentionDays
+	app.SensitiveFields = in.SensitiveFields
+	app.PayloadFormat = in.PayloadFormat
+	app.MaintenanceWindows = in.MaintenanceWindows
+	app.WASMModule = in.WasmModule
+	app.BinaryFields = binaryFieldsFromPb(in.BinaryFields)
+	app.FunctionsLanguage = in.FunctionsLanguage
+	app.ProtobufDescriptor = in.ProtobufDescriptor
+	app.ProtobufMessage = in.ProtobufMessage
+	if in.GoCodec != app.GoCodec && in.GoCodec != "" {
+		if _, ok := gocodec.Get(in.GoCodec); !ok {
+			return nil, errors.NewErrNotFound(fmt.Sprintf("Go codec %s on this Handler", in.GoCodec))
+		}
+	}
+	app.GoCodec = in.GoCodec
+	dataResidencyChanged := in.DataResidency != app.DataResidency
+	if dataResidencyChanged {
+		if err := h.handler.checkDataResidency(in.DataResidency); err != nil {
+			return nil, err
+		}
+	}
+	app.DataResidency = in.DataResidency
+	app.StatefulDecoding = in.StatefulDecoding
+	app.FunctionTimeout = time.Duration(in.FunctionTimeout) * time.Millisecond
+	if max := h.handler.functionTimeout(app.FunctionTimeout); max < app.FunctionTimeout {
+		return nil, errors.NewErrInvalidArgument("FunctionTimeout", fmt.Sprintf("can not be longer than %s", max))
+	}
+	app.MaxOutputFields = in.MaxOutputFields
+	app.MaxOutputDepth = in.MaxOutputDepth
+	app.MaxOutputSize = in.MaxOutputSize
+	if err := outputLimits(app).Validate(); err != nil {
+		return nil, err
+	}
+	if in.Codec != app.Codec && in.Codec != "" {
+		if _, err := h.handler.codecs.Get(in.Codec); err != nil {
+			return nil, errors.Wrap(err, "Codec not in the codec library of this Handler")
+		}
+	}
+	app.Codec = in.Codec
+
+	result := &pb.MutationResult{
+		DryRun:   in.DryRun,
+		Changed:  app.ChangedFields(),
+		Revision: in.Revision + 1,
+	}
+	if in.DryRun {
+		if err := checkFunctionsSyntax(app); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	err = h.handler.applications.SetIfRevision(app, in.Revision)
 	if err != nil {
 		return nil, err
 	}
+	functions.Invalidate(in.AppId)
+	if dataResidencyChanged {
+		h.handler.logDataResidency(in.AppId, "", app.DataResidency, "set data residency")
+	}
 
-	return &empty.Empty{}, nil
+	return result, nil
 }
 
 func (h *handlerManager) DeleteApplication(ctx context.Context, in *pb.ApplicationIdentifier) (*empty.Empty, error) {
 	if err := in.Validate(); err != nil {
 		return nil, errors.Wrap(err, "Invalid Application Identifier")
 	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
 	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
 	if err != nil {
 		return nil, err
@@ -480,29 +1056,43 @@ func (h *handlerManager) DeleteApplication(ctx context.Context, in *pb.Applicati
 		return nil, err
 	}
 
-	_, err = h.handler.applications.Get(in.AppId)
+	app, err := h.handler.applications.Get(in.AppId)
 	if err != nil {
 		return nil, err
 	}
 
+	// The devices that are deleted together with the application get the same DeletedAt, so that they are restored
+	// together with it
+	deletedAt := time.Now()
+
 	// Get and delete all devices for this application
 	devices, err := h.handler.devices.ListForApp(in.AppId, nil)
 	if err != nil {
 		return nil, err
 	}
 	for _, dev := range devices {
-		_, err = h.deviceManager.DeleteDevice(ctx, &pb_lorawan.DeviceIdentifier{AppEui: &dev.AppEUI, DevEui: &dev.DevEUI})
-		if err != nil {
-			return nil, errors.Wrap(errors.FromGRPCError(err), "Broker did not delete device")
-		}
-		err = h.handler.devices.Delete(dev.AppID, dev.DevID)
+		err = h.deleteDevice(ctx, dev, deletedAt)
 		if err != nil {
 			return nil, err
 		}
+		h.handler.recordDeviceChange(dev.AppID, dev.DevID, types.DeleteEvent)
 	}
 
 	// Delete the Application
-	err = h.handler.applications.Delete(in.AppId)
+	err = h.handler.deleteApplication(app, deletedAt)
+	if err != nil {
+		return nil, err
+	}
+	functions.Invalidate(in.AppId)
+
+	// Delete the Webhooks
+	err = h.handler.deleteWebhooks(in.AppId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Delete the MQTT credentials of the collaborators
+	_, _, err = h.handler.syncMQTTCredentials(in.AppId, "", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -532,15 +1122,67 @@ func (h *handlerManager) GetDevAddr(ctx context.Context, in *pb_lorawan.DevAddrR
 	return res, nil
 }
 
+// portFunctionsFromPb converts the PortFunctions of an Application message
+func portFunctionsFromPb(in []*pb.PortFunctions) (out []application.PortFunctions) {
+	for _, functions := range in {
+		out = append(out, application.PortFunctions{
+			MinPort:   uint8(functions.MinPort),
+			MaxPort:   uint8(functions.MaxPort),
+			Decoder:   functions.Decoder,
+			Converter: functions.Converter,
+			Validator: functions.Validator,
+			Encoder:   functions.Encoder,
+		})
+	}
+	return
+}
+
+// binaryFieldsFromPb converts the BinaryFields of an Application message
+func binaryFieldsFromPb(in []*pb.BinaryField) (out []application.BinaryField) {
+	for _, field := range in {
+		out = append(out, application.BinaryField{
+			Name:         field.Name,
+			Offset:       int(field.Offset),
+			Type:         field.Type,
+			LittleEndian: field.LittleEndian,
+			Scale:        field.Scale,
+		})
+	}
+	return
+}
+
+// binaryFieldsToPb converts BinaryFields to their Application message
+func binaryFieldsToPb(in []application.BinaryField) (out []*pb.BinaryField) {
+	for _, field := range in {
+		out = append(out, &pb.BinaryField{
+			Name:         field.Name,
+			Offset:       uint32(field.Offset),
+			Type:         field.Type,
+			LittleEndian: field.LittleEndian,
+			Scale:        field.Scale,
+		})
+	}
+	return
+}
+
+// validateComponentAccess checks if the context grants access to the HandlerManager of this Handler
+func (h *handlerManager) validateComponentAccess(ctx context.Context) error {
+	if h.handler.Identity.Id == "dev" {
+		return nil
+	}
+	claims, err := h.handler.ValidateTTNAuthContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, "No access")
+	}
+	if !claims.ComponentAccess(h.handler.Identity.Id) {
+		return errors.NewErrPermissionDenied(fmt.Sprintf("Claims do not grant access to %s", h.handler.Identity.Id))
+	}
+	return nil
+}
+
 func (h *handlerManager) GetStatus(ctx context.Context, in *pb.StatusRequest) (*pb.Status, error) {
-	if h.handler.Identity.Id != "dev" {
-		claims, err := h.handler.ValidateTTNAuthContext(ctx)
-		if err != nil {
-			return nil, errors.Wrap(err, "No access")
-		}
-		if !claims.ComponentAccess(h.handler.Identity.Id) {
-			return nil, errors.NewErrPermissionDenied(fmt.Sprintf("Claims do not grant access to %s", h.handler.Identity.Id))
-		}
+	if err := h.validateComponentAccess(ctx); err != nil {
+		return nil, err
 	}
 	status := h.handler.GetStatus()
 	if status == nil {
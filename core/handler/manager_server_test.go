@@ -0,0 +1,109 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"bytes"
+	"testing"
+
+	ttnapex "github.com/TheThingsNetwork/go-utils/log/apex"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	apexlog "github.com/apex/log"
+	jsonHandler "github.com/apex/log/handlers/json"
+	"github.com/golang/protobuf/ptypes/empty"
+	. "github.com/smartystreets/assertions"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+	"google.golang.org/grpc"
+)
+
+type testDeviceManager struct {
+	devices []*pb_lorawan.Device
+}
+
+func (m *testDeviceManager) GetDevice(ctx context.Context, in *pb_lorawan.DeviceIdentifier, opts ...grpc.CallOption) (*pb_lorawan.Device, error) {
+	return nil, nil
+}
+
+func (m *testDeviceManager) SetDevice(ctx context.Context, in *pb_lorawan.Device, opts ...grpc.CallOption) (*empty.Empty, error) {
+	m.devices = append(m.devices, in)
+	return &empty.Empty{}, nil
+}
+
+func (m *testDeviceManager) DeleteDevice(ctx context.Context, in *pb_lorawan.DeviceIdentifier, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return &empty.Empty{}, nil
+}
+
+func TestForceRejoin(t *testing.T) {
+	a := New(t)
+	appID, devID := "app1", "dev1"
+
+	var logs bytes.Buffer
+	deviceManager := &testDeviceManager{}
+	h := &handlerManager{
+		handler: &handler{
+			Component: &component.Component{Ctx: ttnapex.Wrap(&apexlog.Logger{
+				Handler: jsonHandler.New(&logs),
+				Level:   apexlog.InfoLevel,
+			})},
+			applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-force-rejoin"),
+			devices:      device.NewRedisDeviceStore(GetRedisClient(), "handler-test-force-rejoin"),
+			mqttEvent:    make(chan *types.DeviceEvent, 10),
+		},
+		deviceManager: deviceManager,
+	}
+	h.handler.applications.Set(&application.Application{AppID: appID})
+	defer h.handler.applications.Delete(appID)
+
+	devAddr := types.DevAddr{1, 2, 3, 4}
+	h.handler.devices.Set(&device.Device{
+		AppID:   appID,
+		DevID:   devID,
+		DevAddr: devAddr,
+		NwkSKey: types.NwkSKey{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+		AppSKey: types.AppSKey{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+		FCntUp:  42,
+	})
+	defer h.handler.devices.Delete(appID, devID)
+
+	// Devices without AppKey can not join again
+	err := h.forceRejoin(context.Background(), appID, devID)
+	a.So(err, ShouldNotBeNil)
+	a.So(deviceManager.devices, ShouldBeEmpty)
+
+	dev, _ := h.handler.devices.Get(appID, devID)
+	dev.StartUpdate()
+	dev.AppKey = types.AppKey{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+	h.handler.devices.Set(dev)
+
+	err = h.forceRejoin(context.Background(), appID, devID)
+	a.So(err, ShouldBeNil)
+
+	// The session keys and DevAddr are cleared
+	dev, _ = h.handler.devices.Get(appID, devID)
+	a.So(dev.DevAddr.IsEmpty(), ShouldBeTrue)
+	a.So(dev.NwkSKey.IsEmpty(), ShouldBeTrue)
+	a.So(dev.AppSKey.IsEmpty(), ShouldBeTrue)
+	a.So(dev.FCntUp, ShouldEqual, 0)
+
+	// The session is cleared in the Broker
+	a.So(deviceManager.devices, ShouldHaveLength, 1)
+	nsDev := deviceManager.devices[0]
+	a.So(nsDev.AppId, ShouldEqual, appID)
+	a.So(nsDev.DevId, ShouldEqual, devID)
+	a.So(nsDev.DevAddr.IsEmpty(), ShouldBeTrue)
+	a.So(nsDev.NwkSKey.IsEmpty(), ShouldBeTrue)
+
+	// The session change is logged for auditing
+	a.So(logs.String(), ShouldContainSubstring, `"message":"Device session changed"`)
+	a.So(logs.String(), ShouldContainSubstring, `"Reason":"forced rejoin"`)
+	a.So(logs.String(), ShouldContainSubstring, `"PreviousDevAddr":"01020304"`)
+
+	event := <-h.handler.mqttEvent
+	a.So(event.Event, ShouldEqual, types.UpdateEvent)
+}
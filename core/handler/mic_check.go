@@ -0,0 +1,306 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/fcnt"
+	"github.com/brocaar/lorawan"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// Causes of a failed MIC check
+const (
+	MICMismatchDevAddr      = "dev_addr"
+	MICMismatchNwkSKey      = "nwk_s_key"
+	MICMismatchKeysSwapped  = "keys_swapped"
+	MICMismatchFCntWidth    = "f_cnt_width"
+	MICMismatchFCntRollover = "f_cnt_rollover"
+	MICMismatchFCntTooLow   = "f_cnt_too_low"
+	MICMismatchFCntTooHigh  = "f_cnt_too_high"
+	MICMismatchAppKey       = "app_key"
+	MICMismatchAppEUI       = "app_eui"
+	MICMismatchDevEUI       = "dev_eui"
+	MICMismatchNoSession    = "no_session"
+)
+
+// maxFCntGap is the maximum difference between the frame counter of an uplink and the last frame counter of the
+// device that is accepted by the Broker
+const maxFCntGap = 16384
+
+func (h *handlerManager) CheckMIC(ctx context.Context, in *pb.MICCheckRequest) (*pb.MICCheckResult, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid MIC Check Request")
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.Devices)
+	if err != nil {
+		return nil, err
+	}
+
+	dev, err := h.handler.devices.Get(in.AppId, in.DevId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The NetworkServer has the current frame counters of the device
+	nsDev, err := h.deviceManager.GetDevice(ctx, &pb_lorawan.DeviceIdentifier{
+		AppEui: &dev.AppEUI,
+		DevEui: &dev.DevEUI,
+	})
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "Broker did not return device")
+	}
+
+	return checkMIC(in.Payload, dev, nsDev.FCntUp, nsDev.FCntDown)
+}
+
+// micWithKey computes the MIC of the frame with the given key
+func micWithKey(phy lorawan.PHYPayload, key lorawan.AES128Key) (lorawan.MIC, error) {
+	if err := phy.SetMIC(key); err != nil {
+		return lorawan.MIC{}, err
+	}
+	return phy.MIC, nil
+}
+
+// checkMIC recomputes the MIC of the frame with the keys of the device, and finds the key or frame counter that
+// caused a mismatch
+func checkMIC(payload []byte, dev *device.Device, fCntUp, fCntDown uint32) (*pb.MICCheckResult, error) {
+	var phy lorawan.PHYPayload
+	if err := phy.UnmarshalBinary(payload); err != nil {
+		return nil, errors.NewErrInvalidArgument("Payload", err.Error())
+	}
+
+	res := &pb.MICCheckResult{
+		MessageType: pb_lorawan.MType(phy.MHDR.MType).String(),
+		ReceivedMic: fmt.Sprintf("%X", phy.MIC[:]),
+	}
+
+	var err error
+	switch phy.MHDR.MType {
+	case lorawan.JoinRequest:
+		err = checkJoinRequestMIC(phy, dev, res)
+	case lorawan.JoinAccept:
+		err = checkJoinAcceptMIC(phy, dev, res)
+	case lorawan.UnconfirmedDataUp, lorawan.ConfirmedDataUp:
+		err = checkDataMIC(phy, dev, fCntUp, true, res)
+	case lorawan.UnconfirmedDataDown, lorawan.ConfirmedDataDown:
+		err = checkDataMIC(phy, dev, fCntDown, false, res)
+	default:
+		return nil, errors.NewErrInvalidArgument("Payload", "unsupported message type")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Valid {
+		res.Description = "The MIC is valid"
+	}
+	return res, nil
+}
+
+func checkJoinRequestMIC(phy lorawan.PHYPayload, dev *device.Device, res *pb.MICCheckResult) error {
+	joinRequest, ok := phy.MACPayload.(*lorawan.JoinRequestPayload)
+	if !ok {
+		return errors.NewErrInvalidArgument("Payload", "does not contain a join request payload")
+	}
+	if dev.AppKey.IsEmpty() {
+		res.Mismatch = MICMismatchAppKey
+		res.Description = "The device has no AppKey, so it can not join"
+		return nil
+	}
+
+	expected, err := micWithKey(phy, lorawan.AES128Key(dev.AppKey))
+	if err != nil {
+		return err
+	}
+	res.ExpectedMic = fmt.Sprintf("%X", expected[:])
+
+	appEUI, devEUI := types.AppEUI(joinRequest.AppEUI), types.DevEUI(joinRequest.DevEUI)
+	switch {
+	case appEUI != dev.AppEUI:
+		res.Mismatch = MICMismatchAppEUI
+		res.Description = fmt.Sprintf("The AppEUI %s of the join request does not match the AppEUI %s of the device", appEUI, dev.AppEUI)
+	case devEUI != dev.DevEUI:
+		res.Mismatch = MICMismatchDevEUI
+		res.Description = fmt.Sprintf("The DevEUI %s of the join request does not match the DevEUI %s of the device", devEUI, dev.DevEUI)
+	case expected != phy.MIC:
+		res.Mismatch = MICMismatchAppKey
+		res.Description = "The MIC is not valid for the AppKey of the device. Check the AppKey that is configured on the device"
+	default:
+		res.Valid = true
+	}
+	return nil
+}
+
+func checkJoinAcceptMIC(phy lorawan.PHYPayload, dev *device.Device, res *pb.MICCheckResult) error {
+	if dev.AppKey.IsEmpty() {
+		res.Mismatch = MICMismatchAppKey
+		res.Description = "The device has no AppKey, so the join accept can not be decrypted"
+		return nil
+	}
+	appKey := lorawan.AES128Key(dev.AppKey)
+
+	// The MIC of a join accept is encrypted together with the payload
+	if err := phy.DecryptJoinAcceptPayload(appKey); err != nil {
+		return errors.NewErrInvalidArgument("Payload", err.Error())
+	}
+	res.ReceivedMic = fmt.Sprintf("%X", phy.MIC[:])
+	if joinAccept, ok := phy.MACPayload.(*lorawan.JoinAcceptPayload); ok {
+		res.DevAddr = types.DevAddr(joinAccept.DevAddr).String()
+	}
+
+	expected, err := micWithKey(phy, appKey)
+	if err != nil {
+		return err
+	}
+	res.ExpectedMic = fmt.Sprintf("%X", expected[:])
+
+	if expected != phy.MIC {
+		res.Mismatch = MICMismatchAppKey
+		res.Description = "The MIC is not valid for the AppKey of the device, so the join accept was not encrypted with this AppKey"
+		return nil
+	}
+	res.Valid = true
+	return nil
+}
+
+// pastFCnt returns the full frame counter of a frame that was sent before the frame counter of the device
+func pastFCnt(full uint32, lsb uint16) uint32 {
+	fCnt := full&^0xFFFF | uint32(lsb)
+	if fCnt > full && fCnt >= 1<<16 {
+		fCnt -= 1 << 16
+	}
+	return fCnt
+}
+
+func checkDataMIC(phy lorawan.PHYPayload, dev *device.Device, deviceFCnt uint32, uplink bool, res *pb.MICCheckResult) error {
+	macPayload, ok := phy.MACPayload.(*lorawan.MACPayload)
+	if !ok {
+		return errors.NewErrInvalidArgument("Payload", "does not contain a MAC payload")
+	}
+	devAddr := types.DevAddr(macPayload.FHDR.DevAddr)
+	res.DevAddr = devAddr.String()
+	res.DeviceFCnt = deviceFCnt
+
+	if dev.NwkSKey.IsEmpty() {
+		res.Mismatch = MICMismatchNoSession
+		res.Description = "The device is not activated"
+		return nil
+	}
+	nwkSKey := lorawan.AES128Key(dev.NwkSKey)
+
+	// The frame only contains the 16 least significant bits of the frame counter
+	lsb := uint16(macPayload.FHDR.FCnt)
+	fCnt := uint32(lsb)
+	switch {
+	case !uplink:
+		fCnt = pastFCnt(deviceFCnt, lsb)
+	case dev.Options.Uses32BitFCnt:
+		fCnt = fcnt.GetFull(deviceFCnt, lsb)
+	}
+
+	micWithFCnt := func(fCnt uint32, key lorawan.AES128Key) (lorawan.MIC, error) {
+		macPayload.FHDR.FCnt = fCnt
+		return micWithKey(phy, key)
+	}
+
+	res.FCnt = fCnt
+	expected, err := micWithFCnt(fCnt, nwkSKey)
+	if err != nil {
+		return err
+	}
+	res.ExpectedMic = fmt.Sprintf("%X", expected[:])
+
+	if expected == phy.MIC {
+		switch {
+		case devAddr != dev.DevAddr:
+			res.Mismatch = MICMismatchDevAddr
+			res.Description = fmt.Sprintf("The MIC is valid, but the DevAddr %s of the frame does not match the DevAddr %s of the current session of the device. The device uses an old session", devAddr, dev.DevAddr)
+		case !uplink,
+			fCnt > deviceFCnt && fCnt-deviceFCnt <= maxFCntGap,
+			dev.Options.DisableFCntCheck,
+			deviceFCnt == 0,
+			dev.Options.ResetsFCnt && fCnt == 0,
+			fCnt == deviceFCnt && phy.MHDR.MType == lorawan.ConfirmedDataUp:
+			res.Valid = true
+		case fCnt <= deviceFCnt:
+			res.Mismatch = MICMismatchFCntTooLow
+			res.Description = fmt.Sprintf("The MIC is valid, but the frame counter %d is not higher than the last frame counter %d of the device. The device may have reset its frame counter; reset the frame counters or disable the frame counter check", fCnt, deviceFCnt)
+		default:
+			res.Mismatch = MICMismatchFCntTooHigh
+			res.Description = fmt.Sprintf("The MIC is valid, but the frame counter %d is more than %d higher than the last frame counter %d of the device", fCnt, maxFCntGap, deviceFCnt)
+		}
+		return nil
+	}
+
+	// Find the frame counter that the device used
+	type candidate struct {
+		fCnt        uint32
+		mismatch    string
+		description string
+	}
+	var candidates []candidate
+	if uplink {
+		if dev.Options.Uses32BitFCnt && fCnt != uint32(lsb) {
+			candidates = append(candidates, candidate{uint32(lsb), MICMismatchFCntWidth, "The MIC is valid for frame counter %d instead of %d. The device uses 16 bit frame counters or has reset its frame counter"})
+		}
+		if !dev.Options.Uses32BitFCnt {
+			if full := fcnt.GetFull(deviceFCnt, lsb); full != fCnt {
+				candidates = append(candidates, candidate{full, MICMismatchFCntWidth, "The MIC is valid for 32 bit frame counter %d instead of %d. Enable 32 bit frame counters for the device"})
+			}
+		}
+	}
+	// Without 32 bit frame counters, the 16 most significant bits of uplink frame counters are not used
+	if !uplink || dev.Options.Uses32BitFCnt {
+		candidates = append(candidates, candidate{fCnt + 1<<16, MICMismatchFCntRollover, "The MIC is valid for frame counter %d instead of %d. The frame counter of the device is more than 65536 ahead"})
+		if fCnt >= 1<<16 {
+			candidates = append(candidates, candidate{fCnt - 1<<16, MICMismatchFCntRollover, "The MIC is valid for frame counter %d instead of %d. The frame counter of the device is more than 65536 behind"})
+		}
+	}
+	for _, candidate := range candidates {
+		mic, err := micWithFCnt(candidate.fCnt, nwkSKey)
+		if err != nil {
+			return err
+		}
+		if mic == phy.MIC {
+			res.Mismatch = candidate.mismatch
+			res.Description = fmt.Sprintf(candidate.description, candidate.fCnt, fCnt)
+			return nil
+		}
+	}
+
+	// Check if the keys were swapped
+	if !dev.AppSKey.IsEmpty() {
+		mic, err := micWithFCnt(fCnt, lorawan.AES128Key(dev.AppSKey))
+		if err != nil {
+			return err
+		}
+		if mic == phy.MIC {
+			res.Mismatch = MICMismatchKeysSwapped
+			res.Description = "The MIC is valid for the AppSKey of the device. The NwkSKey and AppSKey are swapped on the device"
+			return nil
+		}
+	}
+
+	if devAddr != dev.DevAddr {
+		res.Mismatch = MICMismatchDevAddr
+		res.Description = fmt.Sprintf("The DevAddr %s of the frame does not match the DevAddr %s of the device", devAddr, dev.DevAddr)
+		return nil
+	}
+
+	res.Mismatch = MICMismatchNwkSKey
+	res.Description = "The MIC is not valid for the NwkSKey of the device. Check the NwkSKey that is configured on the device, or the frame is corrupted"
+	return nil
+}
@@ -0,0 +1,175 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/brocaar/lorawan"
+	. "github.com/smartystreets/assertions"
+)
+
+func buildDataFrame(mType lorawan.MType, devAddr types.DevAddr, fCnt uint32, key [16]byte) []byte {
+	phy := lorawan.PHYPayload{
+		MHDR: lorawan.MHDR{
+			MType: mType,
+			Major: lorawan.LoRaWANR1,
+		},
+		MACPayload: &lorawan.MACPayload{
+			FHDR: lorawan.FHDR{
+				DevAddr: lorawan.DevAddr(devAddr),
+				FCnt:    fCnt,
+			},
+		},
+	}
+	phy.SetMIC(lorawan.AES128Key(key))
+	bytes, _ := phy.MarshalBinary()
+	return bytes
+}
+
+func TestPastFCnt(t *testing.T) {
+	a := New(t)
+	a.So(pastFCnt(10, 5), ShouldEqual, 5)
+	a.So(pastFCnt(10, 10), ShouldEqual, 10)
+	a.So(pastFCnt(10, 65535), ShouldEqual, 65535)
+	a.So(pastFCnt(65546, 65535), ShouldEqual, 65535)
+	a.So(pastFCnt(65546, 5), ShouldEqual, 65541)
+}
+
+func TestCheckDataMIC(t *testing.T) {
+	a := New(t)
+
+	nwkSKey := types.NwkSKey{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+	appSKey := types.AppSKey{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1}
+	devAddr := types.DevAddr{1, 2, 3, 4}
+	dev := &device.Device{
+		DevAddr: devAddr,
+		NwkSKey: nwkSKey,
+		AppSKey: appSKey,
+	}
+
+	// Valid uplink
+	res, err := checkMIC(buildDataFrame(lorawan.UnconfirmedDataUp, devAddr, 11, nwkSKey), dev, 10, 0)
+	a.So(err, ShouldBeNil)
+	a.So(res.MessageType, ShouldEqual, pb_lorawan.MType_UNCONFIRMED_UP.String())
+	a.So(res.Valid, ShouldBeTrue)
+	a.So(res.ExpectedMic, ShouldEqual, res.ReceivedMic)
+	a.So(res.FCnt, ShouldEqual, 11)
+	a.So(res.DeviceFCnt, ShouldEqual, 10)
+	a.So(res.DevAddr, ShouldEqual, devAddr.String())
+
+	// Wrong NwkSKey
+	res, err = checkMIC(buildDataFrame(lorawan.UnconfirmedDataUp, devAddr, 11, [16]byte{}), dev, 10, 0)
+	a.So(err, ShouldBeNil)
+	a.So(res.Valid, ShouldBeFalse)
+	a.So(res.Mismatch, ShouldEqual, MICMismatchNwkSKey)
+	a.So(res.ExpectedMic, ShouldNotEqual, res.ReceivedMic)
+
+	// Swapped keys
+	res, err = checkMIC(buildDataFrame(lorawan.UnconfirmedDataUp, devAddr, 11, appSKey), dev, 10, 0)
+	a.So(err, ShouldBeNil)
+	a.So(res.Mismatch, ShouldEqual, MICMismatchKeysSwapped)
+
+	// Other DevAddr
+	res, err = checkMIC(buildDataFrame(lorawan.UnconfirmedDataUp, types.DevAddr{4, 3, 2, 1}, 11, [16]byte{}), dev, 10, 0)
+	a.So(err, ShouldBeNil)
+	a.So(res.Mismatch, ShouldEqual, MICMismatchDevAddr)
+
+	// Frame counter too low
+	res, err = checkMIC(buildDataFrame(lorawan.UnconfirmedDataUp, devAddr, 5, nwkSKey), dev, 10, 0)
+	a.So(err, ShouldBeNil)
+	a.So(res.Valid, ShouldBeFalse)
+	a.So(res.Mismatch, ShouldEqual, MICMismatchFCntTooLow)
+
+	// Unless the frame counter check is disabled
+	dev.Options.DisableFCntCheck = true
+	res, err = checkMIC(buildDataFrame(lorawan.UnconfirmedDataUp, devAddr, 5, nwkSKey), dev, 10, 0)
+	a.So(err, ShouldBeNil)
+	a.So(res.Valid, ShouldBeTrue)
+	dev.Options.DisableFCntCheck = false
+
+	// Frame counter too high
+	res, err = checkMIC(buildDataFrame(lorawan.UnconfirmedDataUp, devAddr, 20000, nwkSKey), dev, 10, 0)
+	a.So(err, ShouldBeNil)
+	a.So(res.Mismatch, ShouldEqual, MICMismatchFCntTooHigh)
+
+	// Device uses 32 bit frame counters, but 16 bit frame counters are configured
+	res, err = checkMIC(buildDataFrame(lorawan.UnconfirmedDataUp, devAddr, 70000, nwkSKey), dev, 69999, 0)
+	a.So(err, ShouldBeNil)
+	a.So(res.Mismatch, ShouldEqual, MICMismatchFCntWidth)
+
+	// With 32 bit frame counters
+	dev.Options.Uses32BitFCnt = true
+	res, err = checkMIC(buildDataFrame(lorawan.UnconfirmedDataUp, devAddr, 70000, nwkSKey), dev, 69999, 0)
+	a.So(err, ShouldBeNil)
+	a.So(res.Valid, ShouldBeTrue)
+	a.So(res.FCnt, ShouldEqual, 70000)
+
+	// Device has reset its frame counter
+	res, err = checkMIC(buildDataFrame(lorawan.UnconfirmedDataUp, devAddr, 3, nwkSKey), dev, 69999, 0)
+	a.So(err, ShouldBeNil)
+	a.So(res.Mismatch, ShouldEqual, MICMismatchFCntWidth)
+
+	// Device is more than 65536 frames ahead
+	res, err = checkMIC(buildDataFrame(lorawan.UnconfirmedDataUp, devAddr, 70000+1<<16, nwkSKey), dev, 69999, 0)
+	a.So(err, ShouldBeNil)
+	a.So(res.Mismatch, ShouldEqual, MICMismatchFCntRollover)
+
+	// Downlink that was sent before the current downlink frame counter
+	res, err = checkMIC(buildDataFrame(lorawan.UnconfirmedDataDown, devAddr, 65540, nwkSKey), dev, 0, 65542)
+	a.So(err, ShouldBeNil)
+	a.So(res.Valid, ShouldBeTrue)
+	a.So(res.FCnt, ShouldEqual, 65540)
+
+	// Device is not activated
+	res, err = checkMIC(buildDataFrame(lorawan.UnconfirmedDataUp, devAddr, 11, nwkSKey), &device.Device{}, 10, 0)
+	a.So(err, ShouldBeNil)
+	a.So(res.Mismatch, ShouldEqual, MICMismatchNoSession)
+
+	// Invalid frame
+	_, err = checkMIC([]byte{0x40, 0x01}, dev, 10, 0)
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestCheckJoinRequestMIC(t *testing.T) {
+	a := New(t)
+
+	appEUI := types.AppEUI{1, 2, 3, 4, 5, 6, 7, 8}
+	devEUI := types.DevEUI{8, 7, 6, 5, 4, 3, 2, 1}
+	appKey := types.AppKey{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+	dev := &device.Device{AppEUI: appEUI, DevEUI: devEUI, AppKey: appKey}
+
+	joinRequest := func(appEUI types.AppEUI, key types.AppKey) []byte {
+		phy := lorawan.PHYPayload{
+			MHDR: lorawan.MHDR{
+				MType: lorawan.JoinRequest,
+				Major: lorawan.LoRaWANR1,
+			},
+			MACPayload: &lorawan.JoinRequestPayload{
+				AppEUI:   lorawan.EUI64(appEUI),
+				DevEUI:   lorawan.EUI64(devEUI),
+				DevNonce: [2]byte{1, 2},
+			},
+		}
+		phy.SetMIC(lorawan.AES128Key(key))
+		bytes, _ := phy.MarshalBinary()
+		return bytes
+	}
+
+	res, err := checkMIC(joinRequest(appEUI, appKey), dev, 0, 0)
+	a.So(err, ShouldBeNil)
+	a.So(res.MessageType, ShouldEqual, pb_lorawan.MType_JOIN_REQUEST.String())
+	a.So(res.Valid, ShouldBeTrue)
+
+	res, err = checkMIC(joinRequest(appEUI, types.AppKey{}), dev, 0, 0)
+	a.So(err, ShouldBeNil)
+	a.So(res.Mismatch, ShouldEqual, MICMismatchAppKey)
+
+	res, err = checkMIC(joinRequest(types.AppEUI{1}, appKey), dev, 0, 0)
+	a.So(err, ShouldBeNil)
+	a.So(res.Mismatch, ShouldEqual, MICMismatchAppEUI)
+}
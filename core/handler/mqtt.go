@@ -9,6 +9,7 @@ import (
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/mqtt"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
 )
 
 // MQTTTimeout indicates how long we should wait for an MQTT publish
@@ -48,15 +49,17 @@ func (h *handler) HandleMQTT(username, password string, mqttBrokers ...string) e
 				"AppID": up.AppID,
 			}).Debug("Publish Uplink")
 			upToken := h.mqttClient.PublishUplink(*up)
-			go func(ctx ttnlog.Interface) {
+			go func(ctx ttnlog.Interface, appID, devID string) {
 				if upToken.WaitTimeout(MQTTTimeout) {
 					if upToken.Error() != nil {
 						ctx.WithError(upToken.Error()).Warn("Could not publish Uplink")
 					}
+					h.captureDelivery(appID, devID, "mqtt", upToken.Error())
 				} else {
 					ctx.Warn("Uplink publish timeout")
+					h.captureDelivery(appID, devID, "mqtt", errors.New("Uplink publish timeout"))
 				}
-			}(ctx)
+			}(ctx, up.AppID, up.DevID)
 			if len(up.PayloadFields) > 0 {
 				fieldsToken := h.mqttClient.PublishUplinkFields(up.AppID, up.DevID, up.PayloadFields)
 				go func(ctx ttnlog.Interface) {
@@ -79,6 +82,7 @@ func (h *handler) HandleMQTT(username, password string, mqttBrokers ...string) e
 				"AppID": event.AppID,
 				"Event": event.Event,
 			}).Debug("Publish Event")
+			h.webhookEvent(event)
 			var token mqtt.Token
 			if event.DevID == "" {
 				token = h.mqttClient.PublishAppEvent(event.AppID, event.Event, event.Data)
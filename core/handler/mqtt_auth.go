@@ -0,0 +1,53 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"net/http"
+)
+
+// MQTT access types of ACL checks
+const (
+	mqttAccessRead      = "1"
+	mqttAccessWrite     = "2"
+	mqttAccessSubscribe = "4"
+)
+
+// MQTTAuth returns an HTTP authentication backend for the MQTT broker (compatible with the HTTP backend of
+// mosquitto-auth-plug). The broker sends the username and password (for /user) or the username, topic and access
+// type (for /acl) as form values. The backend responds with 200 OK to allow and 403 Forbidden to deny.
+func (h *handler) MQTTAuth() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		credentials, err := h.mqttCredentials.Get(r.FormValue("username"))
+		if err != nil || !credentials.CheckPassword(r.FormValue("password")) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/superuser", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	mux.HandleFunc("/acl", func(w http.ResponseWriter, r *http.Request) {
+		credentials, err := h.mqttCredentials.Get(r.FormValue("username"))
+		if err != nil {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		var allowed []string
+		switch r.FormValue("acc") {
+		case mqttAccessRead, mqttAccessSubscribe:
+			allowed = credentials.Read
+		case mqttAccessWrite:
+			allowed = credentials.Write
+		}
+		if !mqttTopicAllowed(allowed, r.FormValue("topic")) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	return mux
+}
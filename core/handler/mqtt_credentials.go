@@ -0,0 +1,247 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TheThingsNetwork/go-account-lib/account"
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	"github.com/TheThingsNetwork/ttn/api"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// MQTTCredentialsSyncInterval indicates how often the MQTT credentials of applications are synchronized with the
+// collaborators on the account server, so that collaborators that were removed or lost their rights lose their access
+var MQTTCredentialsSyncInterval = 10 * time.Minute
+
+// mqttACL returns the topics that a collaborator with the given rights can subscribe and publish to
+func mqttACL(appID string, collaboratorRights []types.Right) (read, write []string) {
+	for _, right := range collaboratorRights {
+		switch right {
+		case rights.ReadUplink:
+			read = append(read,
+				fmt.Sprintf("%s/devices/+/up", appID),
+				fmt.Sprintf("%s/devices/+/up/#", appID),
+				fmt.Sprintf("%s/devices/+/events/#", appID),
+				fmt.Sprintf("%s/events/#", appID),
+			)
+		case rights.WriteDownlink:
+			write = append(write,
+				fmt.Sprintf("%s/devices/+/down", appID),
+			)
+		}
+	}
+	return
+}
+
+// mqttTopicMatches returns true if the topic matches the topic filter, which can contain + and # wildcards
+func mqttTopicMatches(filter, topic string) bool {
+	filterParts, topicParts := strings.Split(filter, "/"), strings.Split(topic, "/")
+	for i, filterPart := range filterParts {
+		if filterPart == "#" {
+			return true
+		}
+		if i >= len(topicParts) {
+			return false
+		}
+		if filterPart != "+" && filterPart != topicParts[i] {
+			return false
+		}
+	}
+	return len(filterParts) == len(topicParts)
+}
+
+// mqttTopicAllowed returns true if the topic (or topic filter when subscribing) is covered by one of the allowed
+// topic filters
+func mqttTopicAllowed(allowed []string, topic string) bool {
+	for _, filter := range allowed {
+		if mqttTopicMatches(filter, topic) {
+			return true
+		}
+	}
+	return false
+}
+
+func generateMQTTSecret(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// syncMQTTCredentials creates, updates and deletes the MQTT credentials of the application, so that each collaborator
+// that has access to uplink or downlink messages has credentials with the topics that their rights allow. The issuer
+// is the auth server that the collaborators are from. It returns the credentials and the passwords of the credentials
+// that were created.
+func (h *handler) syncMQTTCredentials(appID, issuer string, collaborators map[string][]types.Right) ([]*application.MQTTCredentials, map[string]string, error) {
+	existing, err := h.mqttCredentials.List(appID)
+	if err != nil {
+		return nil, nil, err
+	}
+	byCollaborator := make(map[string]*application.MQTTCredentials, len(existing))
+	for _, credentials := range existing {
+		byCollaborator[credentials.Collaborator] = credentials
+	}
+
+	var synced []*application.MQTTCredentials
+	passwords := make(map[string]string)
+	for collaborator, collaboratorRights := range collaborators {
+		read, write := mqttACL(appID, collaboratorRights)
+		if len(read) == 0 && len(write) == 0 {
+			continue
+		}
+		credentials, ok := byCollaborator[collaborator]
+		delete(byCollaborator, collaborator)
+		if !ok {
+			credentials = &application.MQTTCredentials{
+				Username:     application.MQTTCredentialsUsername(appID, collaborator),
+				AppID:        appID,
+				Collaborator: collaborator,
+			}
+			password, err := generateMQTTSecret(24)
+			if err != nil {
+				return nil, nil, err
+			}
+			salt, err := generateMQTTSecret(16)
+			if err != nil {
+				return nil, nil, err
+			}
+			credentials.SetPassword(salt, password)
+			passwords[credentials.Username] = password
+		}
+		credentials.Issuer, credentials.Read, credentials.Write = issuer, read, write
+		if err := h.mqttCredentials.Set(credentials); err != nil {
+			return nil, nil, err
+		}
+		synced = append(synced, credentials)
+	}
+
+	// Delete the credentials of collaborators that were removed or lost their rights
+	for _, credentials := range byCollaborator {
+		if err := h.mqttCredentials.Delete(credentials.Username); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return synced, passwords, nil
+}
+
+// getCollaborators gets the collaborators of the application and their rights from the account server
+func getCollaborators(account *account.Account, appID string) (map[string][]types.Right, error) {
+	app, err := account.FindApplication(appID)
+	if err != nil {
+		return nil, errors.Wrap(err, "Could not get collaborators from account server")
+	}
+	collaborators := make(map[string][]types.Right, len(app.Collaborators))
+	for _, collaborator := range app.Collaborators {
+		collaborators[collaborator.Username] = collaborator.Rights
+	}
+	return collaborators, nil
+}
+
+// getCollaboratorsWithComponentAuth gets the collaborators of the application from the auth server with the given ID,
+// authenticating as the Handler
+func (h *handler) getCollaboratorsWithComponentAuth(issuer, appID string) (map[string][]types.Right, error) {
+	account, err := h.Component.ComponentAccountServer(issuer)
+	if err != nil {
+		return nil, err
+	}
+	return getCollaborators(account, appID)
+}
+
+// resyncMQTTCredentials synchronizes the MQTT credentials of all applications that have MQTT credentials with the
+// collaborators that collaboratorsOf returns
+func (h *handler) resyncMQTTCredentials(collaboratorsOf func(issuer, appID string) (map[string][]types.Right, error)) {
+	apps, err := h.applications.List(nil)
+	if err != nil {
+		h.Ctx.WithError(err).Warn("Could not list applications for MQTT credentials")
+		return
+	}
+	for _, app := range apps {
+		ctx := h.Ctx.WithField("AppID", app.AppID)
+		existing, err := h.mqttCredentials.List(app.AppID)
+		if err != nil {
+			ctx.WithError(err).Warn("Could not list MQTT credentials")
+			continue
+		}
+		if len(existing) == 0 || existing[0].Issuer == "" {
+			continue
+		}
+		collaborators, err := collaboratorsOf(existing[0].Issuer, app.AppID)
+		if err != nil {
+			ctx.WithError(err).Warn("Could not get collaborators for MQTT credentials")
+			continue
+		}
+		if _, _, err := h.syncMQTTCredentials(app.AppID, existing[0].Issuer, collaborators); err != nil {
+			ctx.WithError(err).Warn("Could not synchronize MQTT credentials")
+		}
+	}
+}
+
+func (h *handler) runMQTTCredentialsSync() {
+	for range time.Tick(MQTTCredentialsSyncInterval) {
+		h.resyncMQTTCredentials(h.getCollaboratorsWithComponentAuth)
+	}
+}
+
+// SyncMQTTCredentials gets the collaborators of the application from the account server and generates MQTT
+// credentials for them, with access to the topics that their rights allow. The Handler keeps the credentials in sync
+// with the collaborators every MQTTCredentialsSyncInterval.
+func (h *handlerManager) SyncMQTTCredentials(ctx context.Context, in *pb.ApplicationIdentifier) (*pb.MQTTCredentialsList, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Identifier")
+	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.AppCollaborators)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := h.handler.applications.Get(in.AppId); err != nil {
+		return nil, err
+	}
+
+	token, _ := api.TokenFromContext(ctx)
+	account, err := h.handler.Component.AccountServer(claims.Issuer, token)
+	if err != nil {
+		return nil, err
+	}
+	collaborators, err := getCollaborators(account, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+
+	synced, passwords, err := h.handler.syncMQTTCredentials(in.AppId, claims.Issuer, collaborators)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.MQTTCredentialsList{Credentials: make([]*pb.MQTTCredentials, 0, len(synced))}
+	for _, credentials := range synced {
+		res.Credentials = append(res.Credentials, &pb.MQTTCredentials{
+			Username:     credentials.Username,
+			Password:     passwords[credentials.Username],
+			Collaborator: credentials.Collaborator,
+			Read:         credentials.Read,
+			Write:        credentials.Write,
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,162 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestMQTTTopicMatches(t *testing.T) {
+	a := New(t)
+	a.So(mqttTopicMatches("app/devices/+/up", "app/devices/dev/up"), ShouldBeTrue)
+	a.So(mqttTopicMatches("app/devices/+/up", "app/devices/+/up"), ShouldBeTrue)
+	a.So(mqttTopicMatches("app/devices/+/up", "app/devices/dev/up/temperature"), ShouldBeFalse)
+	a.So(mqttTopicMatches("app/devices/+/up", "app/devices/dev"), ShouldBeFalse)
+	a.So(mqttTopicMatches("app/devices/+/up/#", "app/devices/dev/up/gps/lat"), ShouldBeTrue)
+	a.So(mqttTopicMatches("app/devices/+/up/#", "app/devices/#"), ShouldBeFalse)
+	a.So(mqttTopicMatches("app/devices/+/down", "other/devices/dev/down"), ShouldBeFalse)
+}
+
+func TestMQTTACL(t *testing.T) {
+	a := New(t)
+
+	read, write := mqttACL("app", []types.Right{rights.ReadUplink})
+	a.So(read, ShouldContain, "app/devices/+/up")
+	a.So(read, ShouldContain, "app/devices/+/events/#")
+	a.So(write, ShouldBeEmpty)
+
+	read, write = mqttACL("app", []types.Right{rights.WriteDownlink})
+	a.So(read, ShouldBeEmpty)
+	a.So(write, ShouldResemble, []string{"app/devices/+/down"})
+
+	read, write = mqttACL("app", []types.Right{rights.AppSettings})
+	a.So(read, ShouldBeEmpty)
+	a.So(write, ShouldBeEmpty)
+}
+
+func TestSyncMQTTCredentials(t *testing.T) {
+	a := New(t)
+	appID := "AppID-1"
+
+	h := &handler{
+		mqttCredentials: application.NewRedisMQTTCredentialsStore(GetRedisClient(), "handler-test-mqtt-credentials"),
+	}
+	defer h.syncMQTTCredentials(appID, "", nil)
+
+	// Credentials are created for collaborators with access to messages
+	synced, passwords, err := h.syncMQTTCredentials(appID, "ttn-account-v2", map[string][]types.Right{
+		"alice": {rights.ReadUplink, rights.WriteDownlink},
+		"bob":   {rights.ReadUplink},
+		"carol": {rights.AppSettings},
+	})
+	a.So(err, ShouldBeNil)
+	a.So(synced, ShouldHaveLength, 2)
+	a.So(passwords, ShouldHaveLength, 2)
+
+	alice, err := h.mqttCredentials.Get("AppID-1.alice")
+	a.So(err, ShouldBeNil)
+	a.So(alice.CheckPassword(passwords["AppID-1.alice"]), ShouldBeTrue)
+	a.So(alice.CheckPassword("wrong"), ShouldBeFalse)
+	a.So(alice.Write, ShouldResemble, []string{"AppID-1/devices/+/down"})
+
+	_, err = h.mqttCredentials.Get("AppID-1.carol")
+	a.So(err, ShouldNotBeNil)
+
+	// Existing credentials keep their password and get updated topics, removed collaborators lose their credentials
+	synced, passwords, err = h.syncMQTTCredentials(appID, "ttn-account-v2", map[string][]types.Right{
+		"alice": {rights.ReadUplink},
+	})
+	a.So(err, ShouldBeNil)
+	a.So(synced, ShouldHaveLength, 1)
+	a.So(passwords, ShouldBeEmpty)
+
+	alice, err = h.mqttCredentials.Get("AppID-1.alice")
+	a.So(err, ShouldBeNil)
+	a.So(alice.Write, ShouldBeEmpty)
+
+	_, err = h.mqttCredentials.Get("AppID-1.bob")
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestMQTTAuth(t *testing.T) {
+	a := New(t)
+	appID := "AppID-1"
+
+	h := &handler{
+		mqttCredentials: application.NewRedisMQTTCredentialsStore(GetRedisClient(), "handler-test-mqtt-auth"),
+	}
+	defer h.syncMQTTCredentials(appID, "", nil)
+
+	_, passwords, err := h.syncMQTTCredentials(appID, "ttn-account-v2", map[string][]types.Right{
+		"alice": {rights.ReadUplink},
+	})
+	a.So(err, ShouldBeNil)
+
+	auth := h.MQTTAuth()
+	do := func(path string, form url.Values) int {
+		rec := httptest.NewRecorder()
+		auth.ServeHTTP(rec, httptest.NewRequest("GET", path+"?"+form.Encode(), nil))
+		return rec.Code
+	}
+
+	a.So(do("/user", url.Values{"username": {"AppID-1.alice"}, "password": {passwords["AppID-1.alice"]}}), ShouldEqual, http.StatusOK)
+	a.So(do("/user", url.Values{"username": {"AppID-1.alice"}, "password": {"wrong"}}), ShouldEqual, http.StatusForbidden)
+	a.So(do("/user", url.Values{"username": {"AppID-1.bob"}, "password": {"wrong"}}), ShouldEqual, http.StatusForbidden)
+	a.So(do("/superuser", url.Values{"username": {"AppID-1.alice"}}), ShouldEqual, http.StatusForbidden)
+
+	a.So(do("/acl", url.Values{"username": {"AppID-1.alice"}, "topic": {"AppID-1/devices/dev/up"}, "acc": {"1"}}), ShouldEqual, http.StatusOK)
+	a.So(do("/acl", url.Values{"username": {"AppID-1.alice"}, "topic": {"AppID-1/devices/+/up"}, "acc": {"4"}}), ShouldEqual, http.StatusOK)
+	a.So(do("/acl", url.Values{"username": {"AppID-1.alice"}, "topic": {"AppID-2/devices/dev/up"}, "acc": {"1"}}), ShouldEqual, http.StatusForbidden)
+	a.So(do("/acl", url.Values{"username": {"AppID-1.alice"}, "topic": {"AppID-1/devices/dev/down"}, "acc": {"2"}}), ShouldEqual, http.StatusForbidden)
+}
+
+func TestResyncMQTTCredentials(t *testing.T) {
+	a := New(t)
+	appID := "AppID-1"
+
+	h := &handler{
+		Component:       &component.Component{Ctx: GetLogger(t, "TestResyncMQTTCredentials")},
+		applications:    application.NewRedisApplicationStore(GetRedisClient(), "handler-test-resync-mqtt-credentials"),
+		mqttCredentials: application.NewRedisMQTTCredentialsStore(GetRedisClient(), "handler-test-resync-mqtt-credentials"),
+	}
+	a.So(h.applications.Set(&application.Application{AppID: appID}), ShouldBeNil)
+	defer func() {
+		h.applications.Delete(appID)
+		h.syncMQTTCredentials(appID, "", nil)
+	}()
+
+	_, passwords, err := h.syncMQTTCredentials(appID, "ttn-account-v2", map[string][]types.Right{
+		"alice": {rights.ReadUplink},
+		"bob":   {rights.ReadUplink},
+	})
+	a.So(err, ShouldBeNil)
+
+	auth := h.MQTTAuth()
+	do := func(path string, form url.Values) int {
+		rec := httptest.NewRecorder()
+		auth.ServeHTTP(rec, httptest.NewRequest("GET", path+"?"+form.Encode(), nil))
+		return rec.Code
+	}
+	a.So(do("/acl", url.Values{"username": {"AppID-1.bob"}, "topic": {"AppID-1/devices/dev/up"}, "acc": {"1"}}), ShouldEqual, http.StatusOK)
+
+	// Bob is no longer a collaborator of the application
+	h.resyncMQTTCredentials(func(issuer, appID string) (map[string][]types.Right, error) {
+		a.So(issuer, ShouldEqual, "ttn-account-v2")
+		return map[string][]types.Right{"alice": {rights.ReadUplink}}, nil
+	})
+
+	a.So(do("/user", url.Values{"username": {"AppID-1.bob"}, "password": {passwords["AppID-1.bob"]}}), ShouldEqual, http.StatusForbidden)
+	a.So(do("/acl", url.Values{"username": {"AppID-1.bob"}, "topic": {"AppID-1/devices/dev/up"}, "acc": {"1"}}), ShouldEqual, http.StatusForbidden)
+	a.So(do("/acl", url.Values{"username": {"AppID-1.alice"}, "topic": {"AppID-1/devices/dev/up"}, "acc": {"1"}}), ShouldEqual, http.StatusOK)
+}
@@ -0,0 +1,147 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/handler/functions"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// NormalizePayload maps the payload fields to the normalized payload with the Normalizer of the application. If the
+// Normalizer fails, the error is published and the uplink message is published without normalized payload.
+func (h *handler) NormalizePayload(ctx ttnlog.Interface, _ *pb_broker.DeduplicatedUplinkMessage, appUp *types.UplinkMessage, dev *device.Device) error {
+	if appUp.PayloadFields == nil {
+		return nil
+	}
+
+	app, err := h.applications.Get(appUp.AppID)
+	if err != nil || app.Normalizer == "" {
+		return nil
+	}
+
+	logger := h.functionLogger(appUp.AppID, appUp.DevID)
+	normalized, err := normalize(app.AppID, app.Normalizer, appUp.PayloadFields, appUp.FPort, functionMetadata(appUp, dev), h.functionTimeout(app.FunctionTimeout), logger)
+	h.captureFunctionLogs(appUp.AppID, appUp.DevID, logger, err)
+	if err != nil {
+		ctx.WithError(err).Warn("Could not normalize payload")
+		h.publishFunctionError(appUp, &FunctionError{Function: "Normalizer", Err: err})
+		return nil
+	}
+	appUp.NormalizedPayload = normalized
+
+	return nil
+}
+
+// normalize runs the Normalizer function with the payload fields. It returns nil if the Normalizer returns no
+// measurements.
+func normalize(appID, normalizer string, fields map[string]interface{}, port uint8, metadata map[string]interface{}, timeout time.Duration, logger functions.Logger) (*types.NormalizedPayload, error) {
+	env := map[string]interface{}{
+		"fields":   fields,
+		"port":     port,
+		"metadata": metadata,
+	}
+	code := fmt.Sprintf(`
+		%s;
+		Normalizer(fields, port, metadata)
+	`, normalizer)
+
+	value, err := functions.RunCachedCode(appID, "Normalizer", code, env, timeout, logger)
+	if err != nil {
+		return nil, err
+	}
+	if !value.IsObject() {
+		return nil, errors.NewErrInvalidArgument("Normalizer", "does not return an object")
+	}
+	v, _ := value.Export()
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, errors.NewErrInvalidArgument("Normalizer", "does not return an object")
+	}
+	return normalizedPayload(m)
+}
+
+// normalizedPayload converts the result of the Normalizer to a normalized payload. Measurements that are null or
+// undefined are left out.
+func normalizedPayload(m map[string]interface{}) (*types.NormalizedPayload, error) {
+	normalized := new(types.NormalizedPayload)
+	empty := true
+	for key, value := range m {
+		if value == nil {
+			continue
+		}
+		var err error
+		switch key {
+		case "temperature":
+			normalized.Temperature, err = normalizedNumber(key, value, -273.15, math.Inf(1))
+		case "humidity":
+			normalized.Humidity, err = normalizedNumber(key, value, 0, 100)
+		case "battery":
+			normalized.Battery, err = normalizedNumber(key, value, 0, 100)
+		case "location":
+			normalized.Location, err = normalizedLocation(value)
+		default:
+			err = errors.NewErrInvalidArgument("Normalizer", fmt.Sprintf("returns unknown measurement %s", key))
+		}
+		if err != nil {
+			return nil, err
+		}
+		empty = false
+	}
+	if empty {
+		return nil, nil
+	}
+	return normalized, nil
+}
+
+// normalizedNumber returns the value if it is a number between min and max
+func normalizedNumber(name string, value interface{}, min, max float64) (*float64, error) {
+	if _, ok := value.(bool); ok {
+		return nil, errors.NewErrInvalidArgument("Normalizer", fmt.Sprintf("returns %s that is not a number", name))
+	}
+	f, ok := toFloat(value)
+	if !ok || math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, errors.NewErrInvalidArgument("Normalizer", fmt.Sprintf("returns %s that is not a number", name))
+	}
+	if f < min || f > max {
+		return nil, errors.NewErrInvalidArgument("Normalizer", fmt.Sprintf("returns %s %v that is out of range", name, f))
+	}
+	return &f, nil
+}
+
+// normalizedLocation returns the value if it is an object with a valid latitude and longitude, and optionally an
+// altitude
+func normalizedLocation(value interface{}) (*types.LocationMetadata, error) {
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, errors.NewErrInvalidArgument("Normalizer", "returns location that is not an object")
+	}
+	latitude, err := normalizedNumber("location.latitude", m["latitude"], -90, 90)
+	if err != nil {
+		return nil, err
+	}
+	longitude, err := normalizedNumber("location.longitude", m["longitude"], -180, 180)
+	if err != nil {
+		return nil, err
+	}
+	location := &types.LocationMetadata{
+		Latitude:  float32(*latitude),
+		Longitude: float32(*longitude),
+	}
+	if m["altitude"] != nil {
+		altitude, err := normalizedNumber("location.altitude", m["altitude"], math.MinInt32, math.MaxInt32)
+		if err != nil {
+			return nil, err
+		}
+		location.Altitude = int32(*altitude)
+	}
+	return location, nil
+}
@@ -0,0 +1,119 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestNormalizePayload(t *testing.T) {
+	a := New(t)
+	appID := "AppID-1"
+
+	h := &handler{
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-normalize-payload"),
+		mqttEvent:    make(chan *types.DeviceEvent, 10),
+	}
+	ctx := GetLogger(t, "TestNormalizePayload")
+	dev := &device.Device{AppID: appID, DevID: "DevID-1"}
+
+	uplink := func(fields map[string]interface{}) *types.UplinkMessage {
+		return &types.UplinkMessage{
+			AppID:         appID,
+			DevID:         "DevID-1",
+			FPort:         1,
+			PayloadFields: fields,
+		}
+	}
+
+	app := &application.Application{
+		AppID: appID,
+		Normalizer: `function Normalizer(fields, port, metadata) {
+			if (fields.invalid) return { humidity: 120 };
+			return {
+				temperature: fields.temp,
+				battery: fields.vbat && (fields.vbat - 2.5) / 1.1 * 100,
+				location: fields.lat && { latitude: fields.lat, longitude: fields.lon },
+			};
+		}`,
+	}
+	a.So(h.applications.Set(app), ShouldBeNil)
+	defer func() {
+		h.applications.Delete(appID)
+	}()
+
+	appUp := uplink(map[string]interface{}{"temp": 21.5, "vbat": 3.05, "lat": 52.1, "lon": 5.2})
+	err := h.NormalizePayload(ctx, nil, appUp, dev)
+	a.So(err, ShouldBeNil)
+	a.So(appUp.NormalizedPayload, ShouldNotBeNil)
+	a.So(*appUp.NormalizedPayload.Temperature, ShouldEqual, 21.5)
+	a.So(*appUp.NormalizedPayload.Battery, ShouldAlmostEqual, 50)
+	a.So(appUp.NormalizedPayload.Humidity, ShouldBeNil)
+	a.So(appUp.NormalizedPayload.Location, ShouldResemble, &types.LocationMetadata{Latitude: 52.1, Longitude: 5.2})
+	a.So(appUp.PayloadFields, ShouldContainKey, "temp")
+
+	// A temperature of 0 is a measurement
+	appUp = uplink(map[string]interface{}{"temp": 0})
+	err = h.NormalizePayload(ctx, nil, appUp, dev)
+	a.So(err, ShouldBeNil)
+	a.So(*appUp.NormalizedPayload.Temperature, ShouldEqual, 0)
+
+	// No measurements
+	appUp = uplink(map[string]interface{}{"led": true})
+	err = h.NormalizePayload(ctx, nil, appUp, dev)
+	a.So(err, ShouldBeNil)
+	a.So(appUp.NormalizedPayload, ShouldBeNil)
+
+	// Invalid measurements are published as error
+	appUp = uplink(map[string]interface{}{"invalid": true})
+	err = h.NormalizePayload(ctx, nil, appUp, dev)
+	a.So(err, ShouldBeNil)
+	a.So(appUp.NormalizedPayload, ShouldBeNil)
+	a.So(h.mqttEvent, ShouldHaveLength, 1)
+	event := <-h.mqttEvent
+	a.So(event.Event, ShouldEqual, types.UplinkErrorEvent)
+	a.So(event.Data.(types.FunctionErrorEventData).Function, ShouldEqual, "Normalizer")
+
+	// No payload fields
+	appUp = uplink(nil)
+	err = h.NormalizePayload(ctx, nil, appUp, dev)
+	a.So(err, ShouldBeNil)
+	a.So(appUp.NormalizedPayload, ShouldBeNil)
+}
+
+func TestNormalizedPayload(t *testing.T) {
+	a := New(t)
+
+	normalized, err := normalizedPayload(map[string]interface{}{
+		"temperature": int64(-5),
+		"humidity":    45.5,
+		"battery":     nil,
+		"location":    map[string]interface{}{"latitude": 52.1, "longitude": 5.2, "altitude": int64(10)},
+	})
+	a.So(err, ShouldBeNil)
+	a.So(*normalized.Temperature, ShouldEqual, -5)
+	a.So(*normalized.Humidity, ShouldEqual, 45.5)
+	a.So(normalized.Battery, ShouldBeNil)
+	a.So(normalized.Location.Altitude, ShouldEqual, 10)
+
+	for _, invalid := range []map[string]interface{}{
+		{"temperature": -300},
+		{"temperature": "20"},
+		{"humidity": true},
+		{"battery": 101},
+		{"location": 52.1},
+		{"location": map[string]interface{}{"latitude": 52.1}},
+		{"location": map[string]interface{}{"latitude": 91, "longitude": 5.2}},
+		{"pressure": 1013},
+	} {
+		_, err := normalizedPayload(invalid)
+		a.So(err, ShouldNotBeNil)
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+)
+
+// roundFloat rounds the value to the given number of decimals
+func roundFloat(value float64, decimals uint8, mode string) float64 {
+	pow := math.Pow(10, float64(decimals))
+	scaled := value * pow
+	if math.IsInf(scaled, 0) || math.Abs(scaled) >= 1<<52 {
+		// The value does not have more decimals than can be represented
+		return value
+	}
+	switch mode {
+	case pb.RoundTruncate:
+		scaled = math.Trunc(scaled)
+	case pb.RoundHalfEven:
+		rounded := math.Floor(scaled)
+		if diff := scaled - rounded; diff > 0.5 || (diff == 0.5 && math.Mod(rounded, 2) != 0) {
+			rounded++
+		}
+		scaled = rounded
+	default:
+		scaled = math.Copysign(math.Floor(math.Abs(scaled)+0.5), scaled)
+	}
+	return scaled / pow
+}
+
+// specialValue returns the representation of NaN and infinite values. The second return value is false if the value
+// should be omitted
+func specialValue(value float64, representation string) (interface{}, bool) {
+	switch representation {
+	case pb.SpecialValuesString:
+		switch {
+		case math.IsNaN(value):
+			return "NaN", true
+		case math.IsInf(value, 1):
+			return "Infinity", true
+		default:
+			return "-Infinity", true
+		}
+	case pb.SpecialValuesOmit:
+		return nil, false
+	default:
+		return nil, true
+	}
+}
+
+// applyOutputPolicy returns the value after applying the output policy. The second return value is false if the
+// value should be omitted
+func applyOutputPolicy(policy *application.OutputPolicy, value interface{}) (interface{}, bool) {
+	switch value := value.(type) {
+	case float32:
+		return applyOutputPolicy(policy, float64(value))
+	case float64:
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return specialValue(value, policy.SpecialValues)
+		}
+		if policy.Round {
+			return roundFloat(value, policy.Decimals, policy.RoundingMode), true
+		}
+		return value, true
+	case map[string]interface{}:
+		for key, field := range value {
+			if field, ok := applyOutputPolicy(policy, field); ok {
+				value[key] = field
+			} else {
+				delete(value, key)
+			}
+		}
+		return value, true
+	case []interface{}:
+		for i, element := range value {
+			// Omitted elements become null, so that the indices of the other elements do not change
+			value[i], _ = applyOutputPolicy(policy, element)
+		}
+		return value, true
+	default:
+		return value, true
+	}
+}
+
+// Reasons of field name violations
+const (
+	fieldNameInvalid  = "invalid characters"
+	fieldNameReserved = "reserved"
+)
+
+// reservedFieldNames are the names of the fields of uplink messages
+var reservedFieldNames = func() map[string]bool {
+	names := make(map[string]bool)
+	t := reflect.TypeOf(types.UplinkMessage{})
+	for i := 0; i < t.NumField(); i++ {
+		if name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]; name != "" {
+			names[name] = true
+		}
+	}
+	return names
+}()
+
+// validFieldNameRune returns whether the rune is allowed in field names
+func validFieldNameRune(r rune) bool {
+	return r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'
+}
+
+// snakeCase converts the name to snake_case. Words start at an upper case letter that follows a lower case letter or
+// digit, or at the last upper case letter of an abbreviation
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b bytes.Buffer
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 && runes[i-1] != '_' {
+			prev := runes[i-1]
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// camelCase converts the name to camelCase. Words are separated by underscores
+func camelCase(name string) string {
+	var b bytes.Buffer
+	for i, word := range strings.Split(name, "_") {
+		if word == "" {
+			continue
+		}
+		runes := []rune(word)
+		if i == 0 || b.Len() == 0 {
+			runes[0] = unicode.ToLower(runes[0])
+		} else {
+			runes[0] = unicode.ToUpper(runes[0])
+		}
+		b.WriteString(string(runes))
+	}
+	if b.Len() == 0 {
+		return name
+	}
+	return b.String()
+}
+
+// fieldName returns the name of the field after replacing invalid characters and converting the casing. The second
+// return value is true if invalid characters were replaced
+func fieldName(policy *application.OutputPolicy, name string) (string, bool) {
+	var invalid bool
+	if policy.Replacement != "" {
+		var b bytes.Buffer
+		for _, r := range name {
+			if validFieldNameRune(r) {
+				b.WriteRune(r)
+			} else {
+				b.WriteString(policy.Replacement)
+				invalid = true
+			}
+		}
+		name = b.String()
+	}
+	switch policy.FieldCasing {
+	case pb.FieldCasingSnake:
+		name = snakeCase(name)
+	case pb.FieldCasingCamel:
+		name = camelCase(name)
+	}
+	return name, invalid
+}
+
+// normalizeFieldNames renames the fields and the fields of nested objects according to the output policy, and returns
+// the fields that were renamed because their name contained invalid characters or was reserved. Fields are not
+// renamed if a field with the new name already exists.
+func normalizeFieldNames(policy *application.OutputPolicy, fields map[string]interface{}, prefix string) (violations []types.FieldNameViolation) {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := fields[key]
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		switch value := value.(type) {
+		case map[string]interface{}:
+			violations = append(violations, normalizeFieldNames(policy, value, path)...)
+		case []interface{}:
+			for _, element := range value {
+				if element, ok := element.(map[string]interface{}); ok {
+					violations = append(violations, normalizeFieldNames(policy, element, path)...)
+				}
+			}
+		}
+		name, invalid := fieldName(policy, key)
+		reserved := policy.ProtectReserved && prefix == "" && reservedFieldNames[name]
+		if reserved {
+			name = "payload_" + name
+		}
+		if name == key {
+			continue
+		}
+		if _, exists := fields[name]; exists {
+			continue
+		}
+		delete(fields, key)
+		fields[name] = value
+		switch {
+		case reserved:
+			violations = append(violations, types.FieldNameViolation{Field: path, Renamed: name, Reason: fieldNameReserved})
+		case invalid:
+			violations = append(violations, types.FieldNameViolation{Field: path, Renamed: name, Reason: fieldNameInvalid})
+		}
+	}
+	return violations
+}
+
+// ApplyOutputPolicy rounds the numeric payload fields, replaces NaN and infinite values and renames the payload fields
+// according to the output policy of the application
+func (h *handler) ApplyOutputPolicy(ctx ttnlog.Interface, _ *pb_broker.DeduplicatedUplinkMessage, appUp *types.UplinkMessage, _ *device.Device) error {
+	if len(appUp.PayloadFields) == 0 {
+		return nil
+	}
+
+	app, err := h.applications.Get(appUp.AppID)
+	if err != nil || app.OutputPolicy == nil {
+		return nil
+	}
+
+	applyOutputPolicy(app.OutputPolicy, appUp.PayloadFields)
+
+	policy := app.OutputPolicy
+	if (policy.FieldCasing == "" || policy.FieldCasing == pb.FieldCasingKeep) && policy.Replacement == "" && !policy.ProtectReserved {
+		return nil
+	}
+	violations := normalizeFieldNames(policy, appUp.PayloadFields, "")
+	if len(violations) == 0 {
+		return nil
+	}
+	ctx.WithField("NumViolations", len(violations)).Debug("Renamed payload fields")
+
+	h.mqttEvent <- &types.DeviceEvent{
+		AppID: appUp.AppID,
+		DevID: appUp.DevID,
+		Event: types.FieldNamesEvent,
+		Data: types.FieldNamesEventData{
+			FCnt:       appUp.FCnt,
+			Violations: violations,
+		},
+	}
+
+	return nil
+}
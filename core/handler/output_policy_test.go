@@ -0,0 +1,180 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"math"
+	"testing"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestRoundFloat(t *testing.T) {
+	a := New(t)
+	a.So(roundFloat(21.456, 2, ""), ShouldEqual, 21.46)
+	a.So(roundFloat(-21.455, 1, pb.RoundHalfUp), ShouldEqual, -21.5)
+	a.So(roundFloat(2.5, 0, pb.RoundHalfUp), ShouldEqual, 3)
+	a.So(roundFloat(2.5, 0, pb.RoundHalfEven), ShouldEqual, 2)
+	a.So(roundFloat(3.5, 0, pb.RoundHalfEven), ShouldEqual, 4)
+	a.So(roundFloat(21.459, 2, pb.RoundTruncate), ShouldEqual, 21.45)
+	a.So(roundFloat(-21.459, 2, pb.RoundTruncate), ShouldEqual, -21.45)
+	a.So(roundFloat(1e300, 2, ""), ShouldEqual, 1e300)
+}
+
+func TestApplyOutputPolicy(t *testing.T) {
+	a := New(t)
+	appID := "AppID-1"
+
+	h := &handler{
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-apply-output-policy"),
+	}
+	ctx := GetLogger(t, "TestApplyOutputPolicy")
+
+	uplink := func() *types.UplinkMessage {
+		return &types.UplinkMessage{
+			AppID: appID,
+			DevID: "DevID-1",
+			PayloadFields: map[string]interface{}{
+				"temperature": 21.456,
+				"humidity":    math.NaN(),
+				"count":       int64(3),
+				"label":       "living room",
+				"position": map[string]interface{}{
+					"x": float32(1.25),
+					"y": math.Inf(-1),
+				},
+				"history": []interface{}{20.01, math.Inf(1)},
+			},
+		}
+	}
+
+	// Nothing happens without an output policy
+	app := &application.Application{AppID: appID}
+	a.So(h.applications.Set(app), ShouldBeNil)
+	defer func() {
+		h.applications.Delete(appID)
+	}()
+	appUp := uplink()
+	err := h.ApplyOutputPolicy(ctx, nil, appUp, nil)
+	a.So(err, ShouldBeNil)
+	a.So(appUp.PayloadFields["temperature"], ShouldEqual, 21.456)
+
+	// Special values are null by default
+	app.OutputPolicy = &application.OutputPolicy{Round: true, Decimals: 1}
+	a.So(h.applications.Set(app), ShouldBeNil)
+	appUp = uplink()
+	err = h.ApplyOutputPolicy(ctx, nil, appUp, nil)
+	a.So(err, ShouldBeNil)
+	a.So(appUp.PayloadFields, ShouldResemble, map[string]interface{}{
+		"temperature": 21.5,
+		"humidity":    nil,
+		"count":       int64(3),
+		"label":       "living room",
+		"position": map[string]interface{}{
+			"x": 1.3,
+			"y": nil,
+		},
+		"history": []interface{}{20.0, nil},
+	})
+
+	app.OutputPolicy = &application.OutputPolicy{SpecialValues: pb.SpecialValuesString}
+	a.So(h.applications.Set(app), ShouldBeNil)
+	appUp = uplink()
+	err = h.ApplyOutputPolicy(ctx, nil, appUp, nil)
+	a.So(err, ShouldBeNil)
+	a.So(appUp.PayloadFields["temperature"], ShouldEqual, 21.456)
+	a.So(appUp.PayloadFields["humidity"], ShouldEqual, "NaN")
+	a.So(appUp.PayloadFields["position"].(map[string]interface{})["y"], ShouldEqual, "-Infinity")
+	a.So(appUp.PayloadFields["history"].([]interface{})[1], ShouldEqual, "Infinity")
+
+	app.OutputPolicy = &application.OutputPolicy{SpecialValues: pb.SpecialValuesOmit}
+	a.So(h.applications.Set(app), ShouldBeNil)
+	appUp = uplink()
+	err = h.ApplyOutputPolicy(ctx, nil, appUp, nil)
+	a.So(err, ShouldBeNil)
+	a.So(appUp.PayloadFields, ShouldNotContainKey, "humidity")
+	a.So(appUp.PayloadFields["position"], ShouldNotContainKey, "y")
+	a.So(appUp.PayloadFields["history"], ShouldResemble, []interface{}{20.01, nil})
+}
+
+func TestFieldNames(t *testing.T) {
+	a := New(t)
+
+	a.So(snakeCase("temperatureC"), ShouldEqual, "temperature_c")
+	a.So(snakeCase("HTTPStatus"), ShouldEqual, "http_status")
+	a.So(snakeCase("battery_V2"), ShouldEqual, "battery_v2")
+	a.So(camelCase("battery_level_2"), ShouldEqual, "batteryLevel2")
+	a.So(camelCase("Temperature"), ShouldEqual, "temperature")
+	a.So(camelCase("_"), ShouldEqual, "_")
+
+	name, invalid := fieldName(&application.OutputPolicy{Replacement: "_", FieldCasing: pb.FieldCasingSnake}, "Temp °C")
+	a.So(name, ShouldEqual, "temp__c")
+	a.So(invalid, ShouldBeTrue)
+	name, invalid = fieldName(&application.OutputPolicy{FieldCasing: pb.FieldCasingCamel}, "battery_level")
+	a.So(name, ShouldEqual, "batteryLevel")
+	a.So(invalid, ShouldBeFalse)
+
+	a.So(reservedFieldNames, ShouldContainKey, "dev_id")
+	a.So(reservedFieldNames, ShouldContainKey, "payload_fields")
+}
+
+func TestApplyOutputPolicyFieldNames(t *testing.T) {
+	a := New(t)
+	appID := "AppID-1"
+
+	h := &handler{
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-apply-output-policy-field-names"),
+		mqttEvent:    make(chan *types.DeviceEvent, 1),
+	}
+	ctx := GetLogger(t, "TestApplyOutputPolicyFieldNames")
+
+	app := &application.Application{
+		AppID: appID,
+		OutputPolicy: &application.OutputPolicy{
+			FieldCasing:     pb.FieldCasingSnake,
+			Replacement:     "_",
+			ProtectReserved: true,
+		},
+	}
+	a.So(h.applications.Set(app), ShouldBeNil)
+	defer func() {
+		h.applications.Delete(appID)
+	}()
+
+	appUp := &types.UplinkMessage{
+		AppID: appID,
+		DevID: "DevID-1",
+		FCnt:  42,
+		PayloadFields: map[string]interface{}{
+			"batteryLevel": 90,
+			"devID":        "sensor-1",
+			"metadata":     "v1",
+			"gps-fix":      map[string]interface{}{"numSatellites": 7},
+		},
+	}
+	err := h.ApplyOutputPolicy(ctx, nil, appUp, nil)
+	a.So(err, ShouldBeNil)
+	a.So(appUp.PayloadFields, ShouldResemble, map[string]interface{}{
+		"battery_level":    90,
+		"payload_dev_id":   "sensor-1",
+		"payload_metadata": "v1",
+		"gps_fix":          map[string]interface{}{"num_satellites": 7},
+	})
+
+	a.So(len(h.mqttEvent), ShouldEqual, 1)
+	evt := <-h.mqttEvent
+	a.So(evt.Event, ShouldEqual, types.FieldNamesEvent)
+	data, ok := evt.Data.(types.FieldNamesEventData)
+	a.So(ok, ShouldBeTrue)
+	a.So(data.FCnt, ShouldEqual, 42)
+	a.So(data.Violations, ShouldResemble, []types.FieldNameViolation{
+		{Field: "devID", Renamed: "payload_dev_id", Reason: "reserved"},
+		{Field: "gps-fix", Renamed: "gps_fix", Reason: "invalid characters"},
+		{Field: "metadata", Renamed: "payload_metadata", Reason: "reserved"},
+	})
+}
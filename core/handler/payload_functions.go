@@ -0,0 +1,149 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/functions"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+func (h *handlerManager) SetPayloadFunctions(ctx context.Context, in *pb.BulkPayloadFunctionsRequest) (*pb.BulkPayloadFunctionsResponse, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Bulk Payload Functions Request")
+	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
+	if err := h.validateComponentAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	res := &pb.BulkPayloadFunctionsResponse{
+		Results: make([]*pb.BulkPayloadFunctionsResult, 0, len(in.AppIds)),
+	}
+
+	var updated int
+	for _, appID := range in.AppIds {
+		result := &pb.BulkPayloadFunctionsResult{AppId: appID}
+		previousVersion, changed, err := h.handler.setPayloadFunctions(appID, in)
+		result.PreviousVersion = previousVersion
+		result.Changed = changed
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			result.Success = true
+			updated++
+		}
+		res.Results = append(res.Results, result)
+	}
+
+	h.handler.Ctx.WithFields(ttnlog.Fields{
+		"Version":         in.Version,
+		"NumApplications": len(in.AppIds),
+		"NumUpdated":      updated,
+		"DryRun":          in.DryRun,
+	}).Info("Set payload functions")
+
+	return res, nil
+}
+
+// setPayloadFunctions sets the payload functions of the application and returns the previous version and the changed
+// fields. If the request is a dry run, the payload functions are only validated.
+func (h *handler) setPayloadFunctions(appID string, in *pb.BulkPayloadFunctionsRequest) (previousVersion string, changed []string, err error) {
+	app, err := h.applications.Get(appID)
+	if err != nil {
+		return "", nil, err
+	}
+	previousVersion = app.PayloadFunctionsVersion
+
+	if in.IfVersion != "" && previousVersion != in.IfVersion {
+		return previousVersion, nil, errors.NewErrInvalidArgument("IfVersion", fmt.Sprintf(`application has version "%s"`, previousVersion))
+	}
+
+	app.StartUpdate()
+
+	if in.Decoder != "" {
+		app.Decoder = in.Decoder
+	}
+	if in.Converter != "" {
+		app.Converter = in.Converter
+	}
+	if in.Validator != "" {
+		app.Validator = in.Validator
+	}
+	if in.Encoder != "" {
+		app.Encoder = in.Encoder
+	}
+	app.PayloadFunctionsVersion = in.Version
+	changed = app.ChangedFields()
+
+	if in.DryRun {
+		return previousVersion, changed, checkFunctionsSyntax(app)
+	}
+
+	// Reject the update if the application was modified by someone else in the meantime
+	if err := h.applications.SetIfRevision(app, app.Revision); err != nil {
+		return previousVersion, nil, err
+	}
+	functions.Invalidate(app.AppID)
+	return previousVersion, changed, nil
+}
+
+// checkFunctionsSyntax returns an error if one of the payload functions of the application contains a syntax error.
+// The Decoder, Converter, Validator and Encoder are checked as Lua if that is the functions language of the application.
+func checkFunctionsSyntax(app *application.Application) error {
+	names := []string{"Decoder", "Converter", "Validator", "Encoder"}
+	code := []string{app.Decoder, app.Converter, app.Validator, app.Encoder}
+	for _, functions := range app.PortFunctions {
+		port := fmt.Sprintf("port %d", functions.MinPort)
+		if functions.MaxPort > functions.MinPort {
+			port = fmt.Sprintf("ports %d-%d", functions.MinPort, functions.MaxPort)
+		}
+		names = append(names, "Decoder for "+port, "Converter for "+port, "Validator for "+port, "Encoder for "+port)
+		code = append(code, functions.Decoder, functions.Converter, functions.Validator, functions.Encoder)
+	}
+	checkSyntax := functions.CheckSyntax
+	if app.FunctionsLanguage == pb.FunctionsLanguageLua {
+		checkSyntax = functions.CheckLuaSyntax
+	}
+	for i := range code {
+		if code[i] == "" {
+			continue
+		}
+		if err := checkSyntax(names[i], code[i]); err != nil {
+			return err
+		}
+	}
+
+	// The DownlinkDecoder, JoinHook, Normalizer and computed fields are always JavaScript
+	names = []string{"DownlinkDecoder", "JoinHook", "Normalizer"}
+	code = []string{app.DownlinkDecoder, app.JoinHook, app.Normalizer}
+	for _, field := range app.ComputedFields {
+		names = append(names, fmt.Sprintf("computed field %s", field.Name))
+		code = append(code, field.Expression)
+	}
+	for i := range code {
+		if code[i] == "" {
+			continue
+		}
+		if err := functions.CheckSyntax(names[i], code[i]); err != nil {
+			return err
+		}
+	}
+	if len(app.ProtobufDescriptor) > 0 {
+		if _, err := protobufMessageDescriptor(app.ProtobufDescriptor, app.ProtobufMessage); err != nil {
+			return err
+		}
+	}
+	if len(app.WASMModule) > 0 {
+		return functions.CheckWASM(app.WASMModule)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	pb_discovery "github.com/TheThingsNetwork/ttn/api/discovery"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+	"golang.org/x/net/context"
+)
+
+func TestSetPayloadFunctions(t *testing.T) {
+	a := New(t)
+	h := &handlerManager{
+		handler: &handler{
+			Component: &component.Component{
+				Ctx:      GetLogger(t, "TestSetPayloadFunctions"),
+				Identity: &pb_discovery.Announcement{Id: "dev"},
+			},
+			applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-set-payload-functions"),
+		},
+	}
+	h.handler.applications.Set(&application.Application{
+		AppID:   "app-1",
+		Decoder: "function Decoder(bytes) { return {}; }",
+		Encoder: "function Encoder(object) { return []; }",
+	})
+	h.handler.applications.Set(&application.Application{
+		AppID:                   "app-2",
+		PayloadFunctionsVersion: "vendor-1.0",
+	})
+	defer func() {
+		h.handler.applications.Delete("app-1")
+		h.handler.applications.Delete("app-2")
+	}()
+
+	decoder := "function Decoder(bytes) { return { value: bytes[0] }; }"
+
+	_, err := h.SetPayloadFunctions(context.Background(), &pb.BulkPayloadFunctionsRequest{
+		AppIds: []string{"app-1"},
+	})
+	a.So(err, ShouldNotBeNil)
+
+	res, err := h.SetPayloadFunctions(context.Background(), &pb.BulkPayloadFunctionsRequest{
+		AppIds:  []string{"app-1", "app-2", "app-3"},
+		Decoder: decoder,
+		Version: "vendor-1.1",
+	})
+	a.So(err, ShouldBeNil)
+	a.So(res.Results, ShouldHaveLength, 3)
+	a.So(res.Results[0].Success, ShouldBeTrue)
+	a.So(res.Results[0].PreviousVersion, ShouldBeEmpty)
+	a.So(res.Results[1].Success, ShouldBeTrue)
+	a.So(res.Results[1].PreviousVersion, ShouldEqual, "vendor-1.0")
+	a.So(res.Results[2].Success, ShouldBeFalse)
+	a.So(res.Results[2].Error, ShouldNotBeEmpty)
+
+	app, _ := h.handler.applications.Get("app-1")
+	a.So(app.Decoder, ShouldEqual, decoder)
+	a.So(app.Encoder, ShouldEqual, "function Encoder(object) { return []; }")
+	a.So(app.PayloadFunctionsVersion, ShouldEqual, "vendor-1.1")
+
+	res, err = h.SetPayloadFunctions(context.Background(), &pb.BulkPayloadFunctionsRequest{
+		AppIds:    []string{"app-1"},
+		Converter: "function Converter(decoded) { return decoded; }",
+		Version:   "vendor-1.2",
+		IfVersion: "vendor-1.0",
+	})
+	a.So(err, ShouldBeNil)
+	a.So(res.Results[0].Success, ShouldBeFalse)
+	a.So(res.Results[0].PreviousVersion, ShouldEqual, "vendor-1.1")
+
+	app, _ = h.handler.applications.Get("app-1")
+	a.So(app.Converter, ShouldBeEmpty)
+	a.So(app.PayloadFunctionsVersion, ShouldEqual, "vendor-1.1")
+
+	// Dry run
+	res, err = h.SetPayloadFunctions(context.Background(), &pb.BulkPayloadFunctionsRequest{
+		AppIds:    []string{"app-1"},
+		Converter: "function Converter(decoded) { return decoded; }",
+		Version:   "vendor-1.2",
+		DryRun:    true,
+	})
+	a.So(err, ShouldBeNil)
+	a.So(res.Results[0].Success, ShouldBeTrue)
+	a.So(res.Results[0].Changed, ShouldResemble, []string{"Converter", "PayloadFunctionsVersion"})
+
+	app, _ = h.handler.applications.Get("app-1")
+	a.So(app.Converter, ShouldBeEmpty)
+	a.So(app.PayloadFunctionsVersion, ShouldEqual, "vendor-1.1")
+
+	// Syntax errors are reported in a dry run
+	res, err = h.SetPayloadFunctions(context.Background(), &pb.BulkPayloadFunctionsRequest{
+		AppIds:    []string{"app-1"},
+		Converter: "function Converter(decoded) { return decoded; ",
+		DryRun:    true,
+	})
+	a.So(err, ShouldBeNil)
+	a.So(res.Results[0].Success, ShouldBeFalse)
+	a.So(res.Results[0].Error, ShouldNotBeEmpty)
+}
+
+func TestCheckFunctionsSyntax(t *testing.T) {
+	a := New(t)
+
+	a.So(checkFunctionsSyntax(&application.Application{}), ShouldBeNil)
+	a.So(checkFunctionsSyntax(&application.Application{
+		Decoder: "function Decoder(bytes, port) { return {}; }",
+		ComputedFields: []application.ComputedField{
+			{Name: "double", Expression: "value * 2"},
+		},
+	}), ShouldBeNil)
+	a.So(checkFunctionsSyntax(&application.Application{
+		Encoder: "function Encoder(object, port) { return [; }",
+	}), ShouldNotBeNil)
+	a.So(checkFunctionsSyntax(&application.Application{
+		ComputedFields: []application.ComputedField{
+			{Name: "double", Expression: "value *"},
+		},
+	}), ShouldNotBeNil)
+	a.So(checkFunctionsSyntax(&application.Application{
+		FunctionsLanguage: pb.FunctionsLanguageLua,
+		Decoder:           "function Decoder(bytes, port) return {} end",
+		DownlinkDecoder:   "function DownlinkDecoder(bytes, port) { return {}; }",
+	}), ShouldBeNil)
+	a.So(checkFunctionsSyntax(&application.Application{
+		FunctionsLanguage: pb.FunctionsLanguageLua,
+		Decoder:           "function Decoder(bytes, port) { return {}; }",
+	}), ShouldNotBeNil)
+}
@@ -0,0 +1,49 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// maxPayloadSize returns the maximum size of the application payload of a downlink to the device
+// and the data rate that it applies to, based on the last uplink of the device
+func (h *handler) maxPayloadSize(dev *device.Device) (size int, dataRate string, err error) {
+	_, lorawan, err := h.lastUplink(dev)
+	if err != nil {
+		return 0, "", err
+	}
+	fp, err := band.Get(lorawan.FrequencyPlan.String())
+	if err != nil {
+		return 0, "", err
+	}
+	dataRate, err = downlinkDataRate(fp, dev, lorawan)
+	if err != nil {
+		return 0, "", err
+	}
+	size, err = fp.GetMaxPayloadSizeFor(dataRate)
+	if err != nil {
+		return 0, "", err
+	}
+	return size, dataRate, nil
+}
+
+// checkPayloadSize returns an error if the payload of the downlink is larger than the maximum payload size
+// at the current data rate of the device. Downlinks to devices of which the data rate is not known yet are allowed.
+// The fields of the downlink should already be encoded into its payload.
+func (h *handler) checkPayloadSize(dev *device.Device, appDownlink *types.DownlinkMessage) error {
+	maxSize, dataRate, err := h.maxPayloadSize(dev)
+	if err != nil {
+		return nil
+	}
+	if size := len(appDownlink.PayloadRaw); size > maxSize {
+		return errors.NewErrInvalidArgument("Payload", fmt.Sprintf("size of %d bytes exceeds the maximum of %d bytes at data rate %s", size, maxSize, dataRate))
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+	"testing"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	pb_protocol "github.com/TheThingsNetwork/ttn/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestCheckPayloadSize(t *testing.T) {
+	a := New(t)
+	h := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestCheckPayloadSize")},
+		devices:      device.NewRedisDeviceStore(GetRedisClient(), "handler-test-check-payload-size"),
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-check-payload-size"),
+	}
+	h.applications.Set(&application.Application{
+		AppID: "appid",
+		Encoder: `function Encoder (object) {
+			var bytes = [];
+			for (var i = 0; i < object.length; i++) {
+				bytes.push(i);
+			}
+			return bytes;
+		}`,
+	})
+	dev := &device.Device{
+		AppID: "appid",
+		DevID: "devid",
+	}
+	h.devices.Set(dev)
+	defer func() {
+		h.applications.Delete("appid")
+		h.devices.Delete("appid", "devid")
+	}()
+
+	// The data rate is not known before the first uplink
+	err := h.checkPayloadSize(dev, &types.DownlinkMessage{PayloadRaw: make([]byte, 100)})
+	a.So(err, ShouldBeNil)
+
+	history, _ := h.devices.UplinkHistory("appid", "devid")
+	history.Push(&pb.DeviceUplink{
+		ProtocolMetadata: &pb_protocol.RxMetadata{Protocol: &pb_protocol.RxMetadata_Lorawan{Lorawan: &pb_lorawan.Metadata{
+			Modulation:    pb_lorawan.Modulation_LORA,
+			DataRate:      "SF12BW125",
+			FrequencyPlan: pb_lorawan.FrequencyPlan_EU_863_870,
+		}}},
+	})
+
+	size, dataRate, err := h.maxPayloadSize(dev)
+	a.So(err, ShouldBeNil)
+	a.So(size, ShouldEqual, 51)
+	a.So(dataRate, ShouldEqual, "SF12BW125")
+
+	err = h.checkPayloadSize(dev, &types.DownlinkMessage{PayloadRaw: make([]byte, 51)})
+	a.So(err, ShouldBeNil)
+
+	err = h.checkPayloadSize(dev, &types.DownlinkMessage{PayloadRaw: make([]byte, 52)})
+	a.So(err, ShouldNotBeNil)
+	a.So(err.Error(), ShouldContainSubstring, "51 bytes")
+	a.So(err.Error(), ShouldContainSubstring, "SF12BW125")
+
+	// Fields are encoded before the size of the payload is checked
+	checkFields := func(fields map[string]interface{}) error {
+		down := &types.DownlinkMessage{AppID: "appid", DevID: "devid", FPort: 1, PayloadFields: fields}
+		if err := h.ConvertFieldsDown(GetLogger(t, "TestCheckPayloadSize"), down, nil, dev); err != nil {
+			return err
+		}
+		return h.checkPayloadSize(dev, down)
+	}
+
+	err = checkFields(map[string]interface{}{"length": 60})
+	a.So(err, ShouldNotBeNil)
+
+	// The payload format of the device overrides the Encoder of the application
+	fields := make(map[string]interface{})
+	for i := 1; i <= 18; i++ {
+		fields[fmt.Sprintf("digital_out_%d", i)] = 1
+	}
+	err = checkFields(fields)
+	a.So(err, ShouldBeNil)
+	dev.PayloadFormat = pb.PayloadFormatCayenneLPP
+	err = checkFields(fields)
+	a.So(err, ShouldNotBeNil)
+	a.So(err.Error(), ShouldContainSubstring, "54 bytes")
+	dev.PayloadFormat = ""
+
+	// Devices that use RX2 get the data rate of RX2
+	dev.Options.RxWindow = pb_lorawan.RxWindow_RX2
+	size, dataRate, err = h.maxPayloadSize(dev)
+	a.So(err, ShouldBeNil)
+	a.So(size, ShouldEqual, 115)
+	a.So(dataRate, ShouldEqual, "SF9BW125")
+}
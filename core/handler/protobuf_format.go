@@ -0,0 +1,85 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// protobufMessageDescriptor returns the descriptor of the message with the full name in the serialized
+// FileDescriptorSet
+func protobufMessageDescriptor(descriptor []byte, name string) (protoreflect.MessageDescriptor, error) {
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(descriptor, &set); err != nil {
+		return nil, errors.NewErrInvalidArgument("Protobuf Descriptor", "not a FileDescriptorSet")
+	}
+	files, err := protodesc.NewFiles(&set)
+	if err != nil {
+		return nil, errors.NewErrInvalidArgument("Protobuf Descriptor", err.Error())
+	}
+	found, err := files.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, errors.NewErrInvalidArgument("Protobuf Message", fmt.Sprintf("%s is not in the descriptor", name))
+	}
+	message, ok := found.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, errors.NewErrInvalidArgument("Protobuf Message", fmt.Sprintf("%s is not a message", name))
+	}
+	return message, nil
+}
+
+// decodeProtobuf decodes the payload as the ProtobufMessage. The fields are named and represented as in the JSON
+// mapping of protobuf, with the original field names; fields that are not in the payload get their default value.
+func (f *UplinkFunctions) decodeProtobuf(payload []byte) (map[string]interface{}, error) {
+	if len(f.ProtobufDescriptor) == 0 {
+		return nil, nil
+	}
+	descriptor, err := protobufMessageDescriptor(f.ProtobufDescriptor, f.ProtobufMessage)
+	if err != nil {
+		return nil, err
+	}
+	message := dynamicpb.NewMessage(descriptor)
+	if err := proto.Unmarshal(payload, message); err != nil {
+		return nil, errors.NewErrInvalidArgument("Protobuf Payload", err.Error())
+	}
+	data, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(message)
+	if err != nil {
+		return nil, errors.NewErrInternal(fmt.Sprintf("Could not convert protobuf payload to fields: %s", err))
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, errors.NewErrInternal(fmt.Sprintf("Could not convert protobuf payload to fields: %s", err))
+	}
+	return fields, nil
+}
+
+// encodeProtobuf encodes the fields as the ProtobufMessage. The fields must be in the JSON mapping of protobuf;
+// fields that are not in the message are rejected.
+func (f *DownlinkFunctions) encodeProtobuf(fields map[string]interface{}) ([]byte, error) {
+	if len(f.ProtobufDescriptor) == 0 {
+		return nil, errors.NewErrInvalidArgument("Downlink Payload", "fields supplied, but no protobuf descriptor set")
+	}
+	descriptor, err := protobufMessageDescriptor(f.ProtobufDescriptor, f.ProtobufMessage)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, errors.NewErrInvalidArgument("Fields", err.Error())
+	}
+	message := dynamicpb.NewMessage(descriptor)
+	if err := protojson.Unmarshal(data, message); err != nil {
+		return nil, errors.NewErrInvalidArgument("Protobuf Payload", err.Error())
+	}
+	return proto.Marshal(message)
+}
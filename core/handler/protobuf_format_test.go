@@ -0,0 +1,96 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// testProtobufDescriptor is a FileDescriptorSet with the message
+// sensors.Reading { float temperature = 1; uint32 battery = 2; bool alarm = 3; }
+func testProtobufDescriptor() []byte {
+	field := func(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     typ.Enum(),
+		}
+	}
+	descriptor, _ := proto.Marshal(&descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{{
+			Name:    proto.String("sensors.proto"),
+			Package: proto.String("sensors"),
+			Syntax:  proto.String("proto3"),
+			MessageType: []*descriptorpb.DescriptorProto{{
+				Name: proto.String("Reading"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("temperature", 1, descriptorpb.FieldDescriptorProto_TYPE_FLOAT),
+					field("battery", 2, descriptorpb.FieldDescriptorProto_TYPE_UINT32),
+					field("alarm", 3, descriptorpb.FieldDescriptorProto_TYPE_BOOL),
+				},
+			}},
+		}},
+	})
+	return descriptor
+}
+
+func TestProtobufPayloadFormat(t *testing.T) {
+	a := New(t)
+
+	descriptor := testProtobufDescriptor()
+	payload := []byte{0x0d, 0x00, 0x00, 0xac, 0x41, 0x10, 0xfa, 0x01, 0x18, 0x01}
+
+	up := &UplinkFunctions{
+		PayloadFormat:      pb.PayloadFormatProtobuf,
+		ProtobufDescriptor: descriptor,
+		ProtobufMessage:    "sensors.Reading",
+	}
+	fields, valid, err := up.Process(payload, 1)
+	a.So(err, ShouldBeNil)
+	a.So(valid, ShouldBeTrue)
+	a.So(fields, ShouldResemble, map[string]interface{}{
+		"temperature": 21.5,
+		"battery":     250.0,
+		"alarm":       true,
+	})
+
+	// Fields that are not in the payload get their default value
+	fields, _, err = up.Process([]byte{0x10, 0x01}, 1)
+	a.So(err, ShouldBeNil)
+	a.So(fields["alarm"], ShouldEqual, false)
+
+	_, _, err = up.Process([]byte{0x0d, 0x00}, 1)
+	a.So(err, ShouldNotBeNil)
+
+	down := &DownlinkFunctions{
+		PayloadFormat:      pb.PayloadFormatProtobuf,
+		ProtobufDescriptor: descriptor,
+		ProtobufMessage:    "sensors.Reading",
+	}
+	encoded, _, err := down.Process(map[string]interface{}{"temperature": 21.5, "battery": 250, "alarm": true}, 1)
+	a.So(err, ShouldBeNil)
+	fields, _, err = up.Process(encoded, 1)
+	a.So(err, ShouldBeNil)
+	a.So(fields["battery"], ShouldEqual, 250)
+
+	_, _, err = down.Process(map[string]interface{}{"humidity": 50}, 1)
+	a.So(err, ShouldNotBeNil)
+
+	down.ProtobufMessage = "sensors.Unknown"
+	_, _, err = down.Process(map[string]interface{}{"battery": 250}, 1)
+	a.So(err, ShouldNotBeNil)
+
+	a.So(checkFunctionsSyntax(&application.Application{ProtobufDescriptor: descriptor, ProtobufMessage: "sensors.Reading"}), ShouldBeNil)
+	a.So(checkFunctionsSyntax(&application.Application{ProtobufDescriptor: descriptor, ProtobufMessage: "sensors"}), ShouldNotBeNil)
+	a.So(checkFunctionsSyntax(&application.Application{ProtobufDescriptor: []byte("message Reading {}"), ProtobufMessage: "Reading"}), ShouldNotBeNil)
+}
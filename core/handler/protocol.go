@@ -0,0 +1,15 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"github.com/TheThingsNetwork/ttn/api"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+)
+
+// checkBrokerCapabilities returns an error if the Broker is known to drop settings of the device, because it does
+// not support them yet. This happens during rolling upgrades, when the Handler is upgraded before the Broker.
+func (h *handler) checkBrokerCapabilities(dev *pb_lorawan.Device) error {
+	return api.RequirePeerCapabilities(h.ttnBrokerConn, "Broker", dev.RequiredCapabilities()...)
+}
@@ -0,0 +1,82 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"text/template"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// renderAttributes replaces references to attributes ({{.key}}) in the string values of fields
+func renderAttributes(fields map[string]interface{}, attributes map[string]string) error {
+	for key, value := range fields {
+		switch value := value.(type) {
+		case string:
+			if !strings.Contains(value, "{{") {
+				continue
+			}
+			tmpl, err := template.New(key).Option("missingkey=error").Parse(value)
+			if err != nil {
+				return errors.NewErrInvalidArgument("Fields", err.Error())
+			}
+			var rendered bytes.Buffer
+			if err := tmpl.Execute(&rendered, attributes); err != nil {
+				return errors.NewErrInvalidArgument("Fields", err.Error())
+			}
+			fields[key] = rendered.String()
+		case map[string]interface{}:
+			if err := renderAttributes(value, attributes); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// buildProvisioningDownlink builds the provisioning downlink of the application for the device
+func buildProvisioningDownlink(provisioning *application.ProvisioningDownlink, dev *device.Device) (*types.DownlinkMessage, error) {
+	downlink := &types.DownlinkMessage{
+		AppID:      dev.AppID,
+		DevID:      dev.DevID,
+		FPort:      provisioning.FPort,
+		Confirmed:  provisioning.Confirmed,
+		Schedule:   types.ScheduleLast,
+		PayloadRaw: provisioning.PayloadRaw,
+	}
+	if provisioning.PayloadFields != "" {
+		if err := json.Unmarshal([]byte(provisioning.PayloadFields), &downlink.PayloadFields); err != nil {
+			return nil, errors.NewErrInvalidArgument("Fields", err.Error())
+		}
+		attributes := dev.Attributes
+		if attributes == nil {
+			attributes = map[string]string{}
+		}
+		if err := renderAttributes(downlink.PayloadFields, attributes); err != nil {
+			return nil, err
+		}
+	}
+	return downlink, nil
+}
+
+// queueProvisioningDownlink enqueues the provisioning downlink of the application for the device
+func (h *handler) queueProvisioningDownlink(ctx ttnlog.Interface, dev *device.Device) {
+	app, err := h.applications.Get(dev.AppID)
+	if err != nil || app.ProvisioningDownlink == nil {
+		return
+	}
+	downlink, err := buildProvisioningDownlink(app.ProvisioningDownlink, dev)
+	if err != nil {
+		ctx.WithError(err).Warn("Could not build provisioning downlink")
+		return
+	}
+	h.EnqueueDownlink(downlink) // Errors are logged and published as downlink error event
+}
@@ -0,0 +1,57 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestBuildProvisioningDownlink(t *testing.T) {
+	a := New(t)
+
+	dev := &device.Device{
+		AppID:      "app",
+		DevID:      "dev",
+		Attributes: map[string]string{"interval": "600", "site": "north"},
+	}
+
+	downlink, err := buildProvisioningDownlink(&application.ProvisioningDownlink{
+		FPort:      2,
+		Confirmed:  true,
+		PayloadRaw: []byte{0x01, 0x02},
+	}, dev)
+	a.So(err, ShouldBeNil)
+	a.So(downlink.AppID, ShouldEqual, "app")
+	a.So(downlink.DevID, ShouldEqual, "dev")
+	a.So(downlink.FPort, ShouldEqual, 2)
+	a.So(downlink.Confirmed, ShouldBeTrue)
+	a.So(downlink.Schedule, ShouldEqual, types.ScheduleLast)
+	a.So(downlink.PayloadRaw, ShouldResemble, []byte{0x01, 0x02})
+
+	downlink, err = buildProvisioningDownlink(&application.ProvisioningDownlink{
+		FPort:         3,
+		PayloadFields: `{"interval":"{{.interval}}","config":{"site":"{{.site}}"},"enabled":true}`,
+	}, dev)
+	a.So(err, ShouldBeNil)
+	a.So(downlink.PayloadFields["interval"], ShouldEqual, "600")
+	a.So(downlink.PayloadFields["config"], ShouldResemble, map[string]interface{}{"site": "north"})
+	a.So(downlink.PayloadFields["enabled"], ShouldEqual, true)
+
+	_, err = buildProvisioningDownlink(&application.ProvisioningDownlink{
+		FPort:         3,
+		PayloadFields: `{"key":"{{.unknown}}"}`,
+	}, dev)
+	a.So(err, ShouldNotBeNil)
+
+	_, err = buildProvisioningDownlink(&application.ProvisioningDownlink{
+		FPort:         3,
+		PayloadFields: `{"key":`,
+	}, dev)
+	a.So(err, ShouldNotBeNil)
+}
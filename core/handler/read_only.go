@@ -0,0 +1,39 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+var errReadOnly = grpc.Errorf(codes.FailedPrecondition, "This Handler is a read-only replica")
+
+// initReadOnly initializes a read-only replica of the Handler. A read-only replica is not announced
+// to the Discovery server, does not connect to MQTT or AMQP and does not process traffic from the
+// Broker. It only serves the ApplicationManager from a (replica of the) database.
+func (h *handler) initReadOnly() error {
+	h.mqttEnabled = false
+	h.amqpEnabled = false
+	h.amqpShadowEnabled = false
+
+	// The Broker is still needed for the LoRaWAN fields of devices
+	err := h.connectBroker()
+	if err != nil {
+		return err
+	}
+
+	h.Component.SetStatus(component.StatusHealthy)
+
+	return nil
+}
+
+// checkWritable returns an error if the Handler is a read-only replica
+func (h *handlerManager) checkWritable() error {
+	if h.handler.readOnly {
+		return errReadOnly
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestReadOnly(t *testing.T) {
+	a := New(t)
+	h := &handlerManager{
+		handler: &handler{
+			Component: &component.Component{Ctx: GetLogger(t, "TestReadOnly")},
+			readOnly:  true,
+		},
+	}
+
+	_, err := h.SetApplication(context.Background(), &pb.Application{AppId: "appid"})
+	a.So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+
+	_, err = h.DeleteDevice(context.Background(), &pb.DeviceIdentifier{AppId: "appid", DevId: "devid"})
+	a.So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+
+	h.handler.readOnly = false
+	a.So(h.checkWritable(), ShouldBeNil)
+}
@@ -0,0 +1,55 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import "github.com/TheThingsNetwork/ttn/core/types"
+
+// ReadingsField is the payload field that contains the readings that a device buffered and sent in a single uplink
+// message. If the Decoder returns an array instead of an object, the array is used as readings.
+const ReadingsField = "readings"
+
+// readingsOf returns the readings in the payload field. The second return value is false if the value is not an
+// array of objects
+func readingsOf(value interface{}) ([]map[string]interface{}, bool) {
+	switch value := value.(type) {
+	case []map[string]interface{}:
+		return value, true
+	case []interface{}:
+		readings := make([]map[string]interface{}, 0, len(value))
+		for _, reading := range value {
+			reading, ok := reading.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			readings = append(readings, reading)
+		}
+		return readings, true
+	}
+	return nil, false
+}
+
+// expandReadings expands an uplink message with buffered readings to one uplink message per reading. The other
+// payload fields are added to the fields of each reading. Uplink messages without readings are returned as-is.
+func expandReadings(appUp *types.UplinkMessage) []*types.UplinkMessage {
+	readings, ok := readingsOf(appUp.PayloadFields[ReadingsField])
+	if !ok || len(readings) == 0 {
+		return []*types.UplinkMessage{appUp}
+	}
+
+	messages := make([]*types.UplinkMessage, 0, len(readings))
+	for _, reading := range readings {
+		message := *appUp
+		message.PayloadFields = make(map[string]interface{}, len(appUp.PayloadFields)+len(reading)-1)
+		for key, value := range appUp.PayloadFields {
+			if key != ReadingsField {
+				message.PayloadFields[key] = value
+			}
+		}
+		for key, value := range reading {
+			message.PayloadFields[key] = value
+		}
+		messages = append(messages, &message)
+	}
+	return messages
+}
@@ -0,0 +1,74 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestDecodeReadings(t *testing.T) {
+	a := New(t)
+
+	functions := &UplinkFunctions{
+		Decoder: `function Decoder (payload, port) {
+  var readings = [];
+  for (var i = 0; i < payload.length; i++) {
+    readings.push({ temperature: payload[i] });
+  }
+  return readings;
+}`,
+	}
+
+	m, err := functions.Decode([]byte{20, 21}, 1)
+	a.So(err, ShouldBeNil)
+	readings, ok := readingsOf(m[ReadingsField])
+	a.So(ok, ShouldBeTrue)
+	a.So(readings, ShouldHaveLength, 2)
+	a.So(readings[1]["temperature"], ShouldEqual, 21)
+}
+
+func TestExpandReadings(t *testing.T) {
+	a := New(t)
+
+	// Uplinks without readings are not expanded
+	appUp := &types.UplinkMessage{FCnt: 42, PayloadFields: map[string]interface{}{"temperature": 20}}
+	a.So(expandReadings(appUp), ShouldResemble, []*types.UplinkMessage{appUp})
+
+	appUp.PayloadFields = map[string]interface{}{ReadingsField: []interface{}{20, 21}}
+	a.So(expandReadings(appUp), ShouldResemble, []*types.UplinkMessage{appUp})
+
+	// Each reading becomes an uplink with the other payload fields
+	appUp.PayloadFields = map[string]interface{}{
+		"battery": 3.3,
+		ReadingsField: []interface{}{
+			map[string]interface{}{"temperature": 20, "time": "2017-06-01T11:00:00Z"},
+			map[string]interface{}{"temperature": 21, "time": "2017-06-01T12:00:00Z"},
+		},
+	}
+	expanded := expandReadings(appUp)
+	a.So(expanded, ShouldHaveLength, 2)
+	for _, message := range expanded {
+		a.So(message.FCnt, ShouldEqual, 42)
+		a.So(message.PayloadFields["battery"], ShouldEqual, 3.3)
+		a.So(message.PayloadFields, ShouldNotContainKey, ReadingsField)
+	}
+	a.So(expanded[0].PayloadFields["temperature"], ShouldEqual, 20)
+	a.So(expanded[1].PayloadFields["temperature"], ShouldEqual, 21)
+
+	// Each reading gets its own device time
+	h := &handler{mqttEvent: make(chan *types.DeviceEvent, 10)}
+	ctx := GetLogger(t, "TestExpandReadings")
+	networkTime := time.Date(2017, 6, 1, 12, 0, 5, 0, time.UTC)
+	for _, message := range expanded {
+		message.Metadata.Time = types.JSONTime(networkTime)
+		a.So(h.ConvertDeviceTime(ctx, nil, message, nil), ShouldBeNil)
+	}
+	a.So(time.Time(expanded[0].Metadata.DeviceTime).Equal(networkTime.Add(-time.Hour-5*time.Second)), ShouldBeTrue)
+	a.So(time.Time(expanded[1].Metadata.DeviceTime).Equal(networkTime.Add(-5*time.Second)), ShouldBeTrue)
+}
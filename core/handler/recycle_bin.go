@@ -0,0 +1,294 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/handler/functions"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// WithRecycleBin keeps deleted applications and devices in the recycle bin for the retention period, so that they can
+// be restored. Without recycle bin, they are deleted immediately.
+func (h *handler) WithRecycleBin(retention time.Duration) Handler {
+	h.recycleBinRetention = retention
+	return h
+}
+
+// deleteDevice deletes the device from the Broker (NetworkServer) and from the store. With recycle bin, the device is
+// moved to the recycle bin with the frame counters of the NetworkServer, and its root keys stay in the Join Server
+// until it is purged.
+func (h *handlerManager) deleteDevice(ctx context.Context, dev *device.Device, deletedAt time.Time) error {
+	devIdentifier := &pb_lorawan.DeviceIdentifier{AppEui: &dev.AppEUI, DevEui: &dev.DevEUI}
+	recycle := h.handler.recycleBinRetention > 0
+	if recycle {
+		dev.StartUpdate()
+		dev.DeletedAt = deletedAt
+		nsDev, err := h.deviceManager.GetDevice(ctx, devIdentifier)
+		if err == nil {
+			dev.FCntUp = nsDev.FCntUp
+			dev.DeletedFCntDown = nsDev.FCntDown
+		} else if errors.GetErrType(errors.FromGRPCError(err)) != errors.NotFound {
+			return errors.Wrap(errors.FromGRPCError(err), "Broker did not return device")
+		}
+	}
+	_, err := h.deviceManager.DeleteDevice(ctx, devIdentifier)
+	if err != nil && errors.GetErrType(errors.FromGRPCError(err)) != errors.NotFound {
+		return errors.Wrap(errors.FromGRPCError(err), "Broker did not delete device")
+	}
+	if recycle {
+		return h.handler.devices.SoftDelete(dev)
+	}
+	if h.handler.usesJoinServer() {
+		if err := h.handler.deleteDeviceKeys(dev.AppEUI, dev.DevEUI); err != nil {
+			return err
+		}
+	}
+	return h.handler.devices.Delete(dev.AppID, dev.DevID)
+}
+
+// deleteApplication deletes the application from the store, or moves it to the recycle bin
+func (h *handler) deleteApplication(app *application.Application, deletedAt time.Time) error {
+	if h.recycleBinRetention == 0 {
+		return h.applications.Delete(app.AppID)
+	}
+	app.StartUpdate()
+	app.DeletedAt = deletedAt
+	return h.applications.SoftDelete(app)
+}
+
+// restoreDevice registers the deleted device in the Broker (NetworkServer) with the frame counters that it had when it
+// was deleted, and moves it from the recycle bin back to the store
+func (h *handlerManager) restoreDevice(ctx context.Context, app *application.Application, devID string) error {
+	dev, err := h.handler.devices.GetDeleted(app.AppID, devID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := h.handler.devices.Get(dev.AppID, dev.DevID); err == nil {
+		return errors.NewErrAlreadyExists(fmt.Sprintf("Device %s", dev.DevID))
+	} else if errors.GetErrType(err) != errors.NotFound {
+		return err
+	}
+	devices, err := h.handler.devices.ListForApp(dev.AppID, nil)
+	if err != nil {
+		return err
+	}
+	for _, existing := range devices {
+		if existing != nil && existing.AppEUI == dev.AppEUI && existing.DevEUI == dev.DevEUI {
+			return errors.NewErrAlreadyExists(fmt.Sprintf("Device with DevEUI %s", dev.DevEUI))
+		}
+	}
+
+	nsDev := dev.GetLoRaWAN()
+	nsDev.FCntUp = dev.FCntUp
+	nsDev.FCntDown = dev.DeletedFCntDown
+	nsDev.DevAddrAllocation = h.handler.devAddrAllocation(app)
+	if err := h.handler.checkBrokerCapabilities(nsDev); err != nil {
+		return err
+	}
+	_, err = h.deviceManager.SetDevice(ctx, nsDev)
+	if err != nil {
+		return errors.Wrap(errors.FromGRPCError(err), "Broker did not set device")
+	}
+
+	if _, err := h.handler.devices.Restore(dev.AppID, dev.DevID); err != nil {
+		return err
+	}
+
+	h.handler.recordDeviceChange(dev.AppID, dev.DevID, types.CreateEvent)
+	h.handler.mqttEvent <- &types.DeviceEvent{
+		AppID: dev.AppID,
+		DevID: dev.DevID,
+		Event: types.CreateEvent,
+	}
+	return nil
+}
+
+// purgeRecycleBin deletes the applications and devices that are in the recycle bin for longer than the retention
+// period
+func (h *handler) purgeRecycleBin() {
+	if h.recycleBinRetention == 0 {
+		return
+	}
+	cutoff := time.Now().Add(-1 * h.recycleBinRetention)
+
+	devices, err := h.devices.ListDeleted("")
+	if err != nil {
+		h.Ctx.WithError(err).Warn("Could not list deleted devices")
+		return
+	}
+	for _, dev := range devices {
+		if dev == nil || dev.DeletedAt.After(cutoff) {
+			continue
+		}
+		ctx := h.Ctx.WithFields(ttnlog.Fields{"AppID": dev.AppID, "DevID": dev.DevID})
+		if h.usesJoinServer() && !h.devEUIRegistered(dev) {
+			if err := h.deleteDeviceKeys(dev.AppEUI, dev.DevEUI); err != nil {
+				ctx.WithError(err).Warn("Could not delete keys of deleted device")
+				continue
+			}
+		}
+		if err := h.devices.Purge(dev.AppID, dev.DevID); err != nil {
+			ctx.WithError(err).Warn("Could not purge deleted device")
+			continue
+		}
+		ctx.Info("Purged deleted device")
+	}
+
+	apps, err := h.applications.ListDeleted()
+	if err != nil {
+		h.Ctx.WithError(err).Warn("Could not list deleted applications")
+		return
+	}
+	for _, app := range apps {
+		if app == nil || app.DeletedAt.After(cutoff) {
+			continue
+		}
+		if err := h.applications.Purge(app.AppID); err != nil {
+			h.Ctx.WithError(err).WithField("AppID", app.AppID).Warn("Could not purge deleted application")
+			continue
+		}
+		h.Ctx.WithField("AppID", app.AppID).Info("Purged deleted application")
+	}
+}
+
+// devEUIRegistered returns whether a device of the application is registered with the same AppEUI and DevEUI as the
+// deleted device, so that its root keys in the Join Server are in use
+func (h *handler) devEUIRegistered(deleted *device.Device) bool {
+	devices, err := h.devices.ListForApp(deleted.AppID, nil)
+	if err != nil {
+		return true
+	}
+	for _, dev := range devices {
+		if dev != nil && dev.AppEUI == deleted.AppEUI && dev.DevEUI == deleted.DevEUI {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *handlerManager) GetDeletedDevices(ctx context.Context, in *pb.ApplicationIdentifier) (*pb.DeletedDeviceList, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Identifier")
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.Devices)
+	if err != nil {
+		return nil, err
+	}
+
+	devices, err := h.handler.devices.ListDeleted(in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.DeletedDeviceList{Devices: make([]*pb.DeletedDevice, 0, len(devices))}
+	for _, dev := range devices {
+		if dev == nil {
+			continue
+		}
+		res.Devices = append(res.Devices, &pb.DeletedDevice{
+			AppId:     dev.AppID,
+			DevId:     dev.DevID,
+			DeletedAt: dev.DeletedAt.UnixNano(),
+			PurgeAt:   dev.DeletedAt.Add(h.handler.recycleBinRetention).UnixNano(),
+		})
+	}
+	return res, nil
+}
+
+func (h *handlerManager) RestoreDevice(ctx context.Context, in *pb.DeviceIdentifier) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Identifier")
+	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.Devices)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := h.handler.applications.Get(in.AppId)
+	if err != nil {
+		return nil, errors.Wrap(err, "Application not registered to this Handler")
+	}
+
+	if err := h.restoreDevice(ctx, app, in.DevId); err != nil {
+		return nil, err
+	}
+
+	h.handler.Ctx.WithFields(ttnlog.Fields{"AppID": in.AppId, "DevID": in.DevId}).Info("Restored deleted device")
+
+	return &empty.Empty{}, nil
+}
+
+func (h *handlerManager) RestoreApplication(ctx context.Context, in *pb.ApplicationIdentifier) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Identifier")
+	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.AppSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := h.handler.applications.GetDeleted(in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	app, err := h.handler.applications.Restore(in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	functions.Invalidate(in.AppId)
+
+	// Restore the devices that were deleted together with the application. Devices that can not be restored stay in
+	// the recycle bin, and can be restored separately.
+	devices, err := h.handler.devices.ListDeleted(in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	var restored int
+	for _, dev := range devices {
+		if dev == nil || !dev.DeletedAt.Equal(deleted.DeletedAt) {
+			continue
+		}
+		if err := h.restoreDevice(ctx, app, dev.DevID); err != nil {
+			h.handler.Ctx.WithFields(ttnlog.Fields{"AppID": in.AppId, "DevID": dev.DevID}).WithError(err).Warn("Could not restore deleted device")
+			continue
+		}
+		restored++
+	}
+
+	h.announceApplication(ctx, in.AppId)
+
+	h.handler.Ctx.WithFields(ttnlog.Fields{"AppID": in.AppId, "NumDevices": restored}).Info("Restored deleted application")
+
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,65 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestPurgeRecycleBin(t *testing.T) {
+	a := New(t)
+
+	h := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestPurgeRecycleBin")},
+		devices:      device.NewRedisDeviceStore(GetRedisClient(), "handler-test-purge-recycle-bin"),
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-purge-recycle-bin"),
+	}
+	h.WithRecycleBin(time.Hour)
+
+	now := time.Now()
+	for appID, deletedAt := range map[string]time.Time{
+		"expired": now.Add(-2 * time.Hour),
+		"recent":  now.Add(-1 * time.Minute),
+	} {
+		app := &application.Application{AppID: appID}
+		a.So(h.applications.Set(app), ShouldBeNil)
+		a.So(h.deleteApplication(app, deletedAt), ShouldBeNil)
+		dev := &device.Device{AppID: appID, DevID: "dev"}
+		a.So(h.devices.Set(dev), ShouldBeNil)
+		dev.StartUpdate()
+		dev.DeletedAt = deletedAt
+		a.So(h.devices.SoftDelete(dev), ShouldBeNil)
+		defer func(appID string) {
+			h.applications.Purge(appID)
+			h.devices.Purge(appID, "dev")
+		}(appID)
+	}
+
+	h.purgeRecycleBin()
+
+	apps, err := h.applications.ListDeleted()
+	a.So(err, ShouldBeNil)
+	a.So(apps, ShouldHaveLength, 1)
+	a.So(apps[0].AppID, ShouldEqual, "recent")
+
+	devices, err := h.devices.ListDeleted("")
+	a.So(err, ShouldBeNil)
+	a.So(devices, ShouldHaveLength, 1)
+	a.So(devices[0].AppID, ShouldEqual, "recent")
+
+	// Without recycle bin, applications are deleted immediately
+	h.WithRecycleBin(0)
+	app := &application.Application{AppID: "no-recycle-bin"}
+	a.So(h.applications.Set(app), ShouldBeNil)
+	a.So(h.deleteApplication(app, now), ShouldBeNil)
+	_, err = h.applications.GetDeleted("no-recycle-bin")
+	a.So(err, ShouldNotBeNil)
+}
@@ -0,0 +1,87 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"encoding/json"
+
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// redecodeUplinks runs the current payload functions of the application on the historical uplink messages, so that
+// data can be reprocessed after a bug in the payload functions is fixed. If devID is not empty, the payload format of
+// the device is used. Errors of the payload functions are returned per message. Nothing is stored or published.
+func (h *handler) redecodeUplinks(appID, devID string, uplinks []*pb.RawUplink) ([]*pb.RedecodedUplink, error) {
+	app, err := h.applications.Get(appID)
+	if err != nil {
+		return nil, err
+	}
+	var dev *device.Device
+	if devID != "" {
+		dev, err = h.devices.Get(appID, devID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	applyDevicePayloadFormat(app, dev)
+	if err := h.resolveCodec(app); err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.RedecodedUplink, 0, len(uplinks))
+	for _, uplink := range uplinks {
+		// Codecs of the device repository are optional, so the payload functions of the application are used if the
+		// codec can not be retrieved
+		portFunctions, _ := h.deviceFunctions(app, dev, uint8(uplink.Port))
+		appUp := &types.UplinkMessage{AppID: appID, DevID: devID, FPort: uint8(uplink.Port), PayloadRaw: uplink.Payload}
+		functions := h.uplinkFunctions(app, portFunctions, nil)
+		functions.Metadata = functionMetadata(appUp, dev)
+
+		redecoded := &pb.RedecodedUplink{
+			Port: uplink.Port,
+			Time: uplink.Time,
+		}
+		res = append(res, redecoded)
+
+		fields, valid, err := functions.Process(uplink.Payload, uint8(uplink.Port))
+		if err != nil {
+			redecoded.Error = err.Error()
+			continue
+		}
+		redecoded.Valid = valid
+		if fields != nil {
+			marshalled, err := json.Marshal(fields)
+			if err != nil {
+				return nil, err
+			}
+			redecoded.Fields = string(marshalled)
+		}
+	}
+
+	return res, nil
+}
+
+func (h *handlerManager) RedecodeUplinks(ctx context.Context, in *pb.RedecodeUplinksRequest) (*pb.RedecodeUplinksResult, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Redecode Uplinks Request")
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.AppSettings)
+	if err != nil {
+		return nil, err
+	}
+	uplinks, err := h.handler.redecodeUplinks(in.AppId, in.DevId, in.Uplinks)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RedecodeUplinksResult{Uplinks: uplinks}, nil
+}
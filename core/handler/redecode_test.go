@@ -0,0 +1,73 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestRedecodeUplinks(t *testing.T) {
+	a := New(t)
+	appID, devID := "AppID-1", "DevID-1"
+
+	h := &handler{
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-redecode-uplinks"),
+		devices:      device.NewRedisDeviceStore(GetRedisClient(), "handler-test-redecode-uplinks"),
+	}
+
+	_, err := h.redecodeUplinks(appID, "", []*pb.RawUplink{{Port: 1, Payload: []byte{0x08, 0x70}}})
+	a.So(err, ShouldNotBeNil)
+
+	app := &application.Application{
+		AppID:        appID,
+		Decoder:      `function Decoder (data) { return { temperature: ((data[0] << 8) | data[1]) / 100 }; }`,
+		BinaryFields: []application.BinaryField{{Name: "raw", Type: "uint16"}},
+	}
+	a.So(h.applications.Set(app), ShouldBeNil)
+	defer func() {
+		h.applications.Delete(appID)
+	}()
+
+	uplinks := []*pb.RawUplink{
+		{Port: 1, Payload: []byte{0x08, 0x70}, Time: 1496318400000000000},
+		{Port: 1, Payload: []byte{0x08}, Time: 1496318460000000000},
+	}
+	res, err := h.redecodeUplinks(appID, "", uplinks)
+	a.So(err, ShouldBeNil)
+	a.So(res, ShouldHaveLength, 2)
+	a.So(res[0].Fields, ShouldEqual, `{"temperature":21.6}`)
+	a.So(res[0].Valid, ShouldBeTrue)
+	a.So(res[0].Time, ShouldEqual, 1496318400000000000)
+	a.So(res[1].Error, ShouldBeEmpty)
+	a.So(res[1].Time, ShouldEqual, 1496318460000000000)
+
+	// Errors of the payload functions are returned per message
+	app.StartUpdate()
+	app.Decoder = `function Decoder (data) { if (data.length < 2) { throw new Error("too short"); } return { temperature: ((data[0] << 8) | data[1]) / 100 }; }`
+	a.So(h.applications.Set(app), ShouldBeNil)
+	res, err = h.redecodeUplinks(appID, "", uplinks)
+	a.So(err, ShouldBeNil)
+	a.So(res[0].Fields, ShouldEqual, `{"temperature":21.6}`)
+	a.So(res[1].Error, ShouldNotBeEmpty)
+	a.So(res[1].Valid, ShouldBeFalse)
+
+	// The payload format of the device is used
+	dev := &device.Device{AppID: appID, DevID: devID, PayloadFormat: pb.PayloadFormatBinary}
+	a.So(h.devices.Set(dev), ShouldBeNil)
+	defer func() {
+		h.devices.Delete(appID, devID)
+	}()
+	res, err = h.redecodeUplinks(appID, devID, uplinks[:1])
+	a.So(err, ShouldBeNil)
+	a.So(res[0].Fields, ShouldEqual, `{"raw":2160}`)
+
+	_, err = h.redecodeUplinks(appID, "DevID-2", uplinks)
+	a.So(err, ShouldNotBeNil)
+}
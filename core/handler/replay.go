@@ -0,0 +1,120 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"time"
+
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+func (h *handlerManager) ReplayUplinks(ctx context.Context, in *pb.ReplayUplinksRequest) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Replay Request")
+	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.Devices)
+	if err != nil {
+		return nil, err
+	}
+
+	dev, err := h.handler.devices.Get(in.AppId, in.DevId)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := h.handler.devices.UplinkHistory(in.AppId, in.DevId)
+	if err != nil {
+		return nil, err
+	}
+	uplinks, err := history.Get()
+	if err != nil {
+		return nil, err
+	}
+	if app, _ := h.handler.applications.Get(in.AppId); app != nil {
+		if cutoff, ok := retentionCutoff(app, time.Now()); ok {
+			uplinks = withinRetention(uplinks, cutoff)
+		}
+	}
+
+	log := h.handler.Ctx.WithFields(ttnlog.Fields{
+		"AppID": in.AppId,
+		"DevID": in.DevId,
+	})
+
+	// The history is newest first, replay the oldest message first
+	var replayed int
+	for i := len(uplinks) - 1; i >= 0; i-- {
+		uplink := uplinks[i]
+		if uplink.ServerTime < in.Since || (in.Until != 0 && uplink.ServerTime >= in.Until) {
+			continue
+		}
+		if err := h.handler.replayUplink(log, dev, uplink); err != nil {
+			log.WithError(err).WithField("FCnt", uplink.Counter).Warn("Could not replay uplink")
+			continue
+		}
+		replayed++
+	}
+
+	log.WithField("NumUplinks", replayed).Info("Replayed uplinks")
+
+	return new(empty.Empty), nil
+}
+
+// replayUplink processes a stored uplink with the current payload functions and publishes it to the application
+func (h *handler) replayUplink(ctx ttnlog.Interface, dev *device.Device, stored *pb.DeviceUplink) error {
+	ttnUp := &pb_broker.DeduplicatedUplinkMessage{
+		AppId:            dev.AppID,
+		DevId:            dev.DevID,
+		ProtocolMetadata: stored.ProtocolMetadata,
+		GatewayMetadata:  stored.GatewayMetadata,
+		ServerTime:       stored.ServerTime,
+	}
+	appUplink := &types.UplinkMessage{
+		AppID:          dev.AppID,
+		DevID:          dev.DevID,
+		HardwareSerial: dev.DevEUI.String(),
+		FPort:          uint8(stored.Port),
+		FCnt:           stored.Counter,
+		Confirmed:      stored.Confirmed,
+		IsReplay:       true,
+		PayloadRaw:     stored.PayloadRaw,
+	}
+
+	processors := []UplinkProcessor{
+		h.ConvertMetadata,
+		h.ConvertFieldsUp,
+	}
+	for _, processor := range processors {
+		if err := processor(ctx, ttnUp, appUplink, dev); err != nil {
+			return err
+		}
+	}
+
+	for _, appUplink := range expandReadings(appUplink) {
+		if err := h.ConvertDeviceTime(ctx, ttnUp, appUplink, dev); err != nil {
+			return err
+		}
+		h.mqttUp <- appUplink
+		if h.amqpEnabled {
+			h.amqpUp <- appUplink
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestReplayUplink(t *testing.T) {
+	a := New(t)
+	h := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestReplayUplink")},
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-replay-uplink"),
+	}
+	h.applications.Set(&application.Application{
+		AppID: "appid",
+		Decoder: `function Decoder (bytes) {
+			return { temperature: bytes[0] / 2 };
+		}`,
+	})
+	defer func() {
+		h.applications.Delete("appid")
+	}()
+	h.mqttUp = make(chan *types.UplinkMessage, 1)
+	h.mqttEvent = make(chan *types.DeviceEvent, 10)
+
+	dev := &device.Device{
+		AppID:  "appid",
+		DevID:  "devid",
+		DevEUI: types.DevEUI([8]byte{1, 2, 3, 4, 5, 6, 7, 8}),
+	}
+
+	err := h.replayUplink(GetLogger(t, "TestReplayUplink"), dev, &pb.DeviceUplink{
+		ServerTime: 1498119242000000000,
+		Port:       1,
+		Counter:    42,
+		PayloadRaw: []byte{21},
+		GatewayMetadata: []*pb_gateway.RxMetadata{
+			{GatewayId: "gtw-1", Rssi: -100},
+			{GatewayId: "gtw-2", Rssi: -110},
+		},
+	})
+	a.So(err, ShouldBeNil)
+
+	uplink := <-h.mqttUp
+	a.So(uplink.IsReplay, ShouldBeTrue)
+	a.So(uplink.FCnt, ShouldEqual, 42)
+	a.So(uplink.HardwareSerial, ShouldEqual, "0102030405060708")
+	a.So(uplink.PayloadFields, ShouldResemble, map[string]interface{}{"temperature": 10.5})
+	a.So(uplink.Metadata.Gateways, ShouldHaveLength, 2)
+}
@@ -0,0 +1,652 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/random"
+	errs "github.com/pkg/errors"
+)
+
+// The replicas of a Handler share the same Redis database, but they also pull the recently changed state of devices,
+// downlink queues and applications from each other. A replica serves reads from this state before it reads from Redis,
+// and keeps the changes that it could not write to Redis until Redis is available again. The keys of devices are not
+// exchanged, so devices that were changed by another replica are read from Redis.
+var (
+	// ReplicaSyncInterval is the interval at which a replica pulls the changes of the other replicas
+	ReplicaSyncInterval = time.Second
+	// ReplicaLogSize is the number of recent changes that a replica keeps for the other replicas
+	ReplicaLogSize = 10000
+	// ReplicaStateTTL is the time that the replicated state of a device, downlink queue or application is kept
+	ReplicaStateTTL = 10 * time.Minute
+)
+
+// Kinds of replicated state
+const (
+	replicaDevice        = "device"
+	replicaDownlinkQueue = "downlink_queue"
+	replicaApplication   = "application"
+)
+
+// replicaChange is a change of the state of a device, downlink queue or application
+type replicaChange struct {
+	Seq   uint64          `json:"seq"`
+	Kind  string          `json:"kind"`
+	AppID string          `json:"app_id"`
+	DevID string          `json:"dev_id,omitempty"`
+	Time  int64           `json:"time"`
+	Data  json.RawMessage `json:"data,omitempty"` // Empty if the device or application was deleted
+
+	// pending is true if the change still has to be written to Redis, optionally only the given properties
+	pending    bool
+	properties []string
+
+	// withoutKeys is true if the data is a device that was received from another replica without its keys
+	withoutKeys bool
+}
+
+func (c *replicaChange) key() string {
+	return fmt.Sprintf("%s:%s:%s", c.Kind, c.AppID, c.DevID)
+}
+
+// replicaChanges are the changes of a replica since the sequence number that the other replica requested. The epoch
+// changes when the replica restarts.
+type replicaChanges struct {
+	Epoch   string           `json:"epoch"`
+	Seq     uint64           `json:"seq"`
+	Changes []*replicaChange `json:"changes"`
+}
+
+type replicaCursor struct {
+	epoch string
+	seq   uint64
+}
+
+type replicas struct {
+	// The stores in Redis
+	devices      device.Store
+	applications application.Store
+
+	peers  []string
+	key    string
+	client *http.Client
+
+	mu      sync.Mutex
+	epoch   string
+	seq     uint64
+	log     []*replicaChange
+	state   map[string]*replicaChange
+	cursors map[string]replicaCursor
+}
+
+func newReplicas(devices device.Store, applications application.Store, peers []string, key string) *replicas {
+	return &replicas{
+		devices:      devices,
+		applications: applications,
+		peers:        peers,
+		key:          key,
+		client:       &http.Client{Timeout: 5 * time.Second},
+		epoch:        random.String(16),
+		state:        make(map[string]*replicaChange),
+		cursors:      make(map[string]replicaCursor),
+	}
+}
+
+// WithReplicas makes the Handler exchange the recently changed state with its replicas. The peers are the HTTP
+// addresses of the other replicas (for example http://handler-2:8084), the key authenticates the replicas.
+func (h *handler) WithReplicas(peers []string, key string) Handler {
+	h.replicas = newReplicas(h.devices, h.applications, peers, key)
+	h.devices = &replicatedDeviceStore{Store: h.devices, replicas: h.replicas}
+	h.applications = &replicatedApplicationStore{Store: h.applications, replicas: h.replicas}
+	return h
+}
+
+// ReplicaSync returns the HTTP handler that serves the changes of this replica to the other replicas on /changes
+func (h *handler) ReplicaSync() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/changes", func(w http.ResponseWriter, r *http.Request) {
+		// Replicas without a key do not serve their state, as it contains the state of the devices and applications
+		if h.replicas == nil || h.replicas.key == "" || subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Key "+h.replicas.key)) != 1 {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		since, _ := strconv.ParseUint(r.FormValue("since"), 10, 64)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(h.replicas.since(r.FormValue("epoch"), since))
+	})
+	return mux
+}
+
+// runReplicas pulls the changes of the other replicas, writes the pending changes to Redis and removes expired state
+func (h *handler) runReplicas() {
+	for range time.Tick(ReplicaSyncInterval) {
+		for _, peer := range h.replicas.peers {
+			if err := h.replicas.pull(peer); err != nil {
+				h.Ctx.WithField("Replica", peer).WithError(err).Debug("Could not pull changes from replica")
+			}
+		}
+		if err := h.replicas.flush(); err != nil {
+			h.Ctx.WithError(err).Warn("Could not write pending changes to Redis")
+		}
+		h.replicas.expire(time.Now().Add(-1 * ReplicaStateTTL))
+	}
+}
+
+// record records a local change. If the change is pending, it is written to Redis when Redis is available again. The
+// other replicas get the logged change, which is the same change without the keys of devices.
+func (r *replicas) record(change, logged *replicaChange, pending bool, properties []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.seq++
+	change.Seq, logged.Seq = r.seq, r.seq
+	change.Time = time.Now().UnixNano()
+	logged.Time = change.Time
+	change.pending, change.properties = pending, properties
+	if existing, ok := r.state[change.key()]; ok && existing.pending {
+		// The properties of earlier changes are not yet written
+		change.properties = mergeProperties(existing.properties, properties, pending)
+		change.pending = true
+	}
+	r.state[change.key()] = change
+	r.log = append(r.log, logged)
+	if len(r.log) > ReplicaLogSize {
+		r.log = r.log[len(r.log)-ReplicaLogSize:]
+	}
+}
+
+// mergeProperties returns the properties that have to be written for two changes. No properties means all properties.
+func mergeProperties(pending, properties []string, bothPending bool) []string {
+	if !bothPending {
+		return pending
+	}
+	if len(pending) == 0 || len(properties) == 0 {
+		return nil
+	}
+	merged := append([]string{}, pending...)
+	for _, property := range properties {
+		found := false
+		for _, existing := range merged {
+			if existing == property {
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, property)
+		}
+	}
+	return merged
+}
+
+// get returns the replicated state. The data is empty if the device or application was deleted.
+func (r *replicas) get(kind, appID, devID string) (data json.RawMessage, found bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	change, ok := r.state[(&replicaChange{Kind: kind, AppID: appID, DevID: devID}).key()]
+	if !ok {
+		return nil, false
+	}
+	return change.Data, true
+}
+
+// getDevice returns the replicated state of a device, unless the device was received without its keys
+func (r *replicas) getDevice(appID, devID string) (data json.RawMessage, found bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	change, ok := r.state[(&replicaChange{Kind: replicaDevice, AppID: appID, DevID: devID}).key()]
+	if !ok || (change.withoutKeys && len(change.Data) != 0) {
+		return nil, false
+	}
+	return change.Data, true
+}
+
+// since returns the local changes since the sequence number in the epoch
+func (r *replicas) since(epoch string, seq uint64) *replicaChanges {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if epoch != r.epoch {
+		seq = 0
+	}
+	i := sort.Search(len(r.log), func(i int) bool { return r.log[i].Seq > seq })
+	return &replicaChanges{
+		Epoch:   r.epoch,
+		Seq:     r.seq,
+		Changes: append([]*replicaChange{}, r.log[i:]...),
+	}
+}
+
+// pull pulls the changes of the replica with the given address
+func (r *replicas) pull(peer string) error {
+	r.mu.Lock()
+	cursor := r.cursors[peer]
+	r.mu.Unlock()
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/replicas/changes?epoch=%s&since=%d", peer, url.QueryEscape(cursor.epoch), cursor.seq), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Key "+r.key)
+	res, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("replica returned %s", res.Status)
+	}
+	var changes replicaChanges
+	if err := json.NewDecoder(res.Body).Decode(&changes); err != nil {
+		return err
+	}
+	r.merge(peer, &changes)
+	return nil
+}
+
+// merge merges the changes of the replica with the given address. Newer changes replace older ones.
+func (r *replicas) merge(peer string, changes *replicaChanges) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, change := range changes.Changes {
+		if existing, ok := r.state[change.key()]; ok && existing.Time >= change.Time {
+			continue
+		}
+		r.state[change.key()] = &replicaChange{
+			Kind:        change.Kind,
+			AppID:       change.AppID,
+			DevID:       change.DevID,
+			Time:        change.Time,
+			Data:        change.Data,
+			withoutKeys: change.Kind == replicaDevice,
+		}
+	}
+	r.cursors[peer] = replicaCursor{epoch: changes.Epoch, seq: changes.Seq}
+}
+
+// flush writes the pending changes to Redis. It stops at the first error, as Redis is then probably still not
+// available.
+func (r *replicas) flush() error {
+	r.mu.Lock()
+	var pending []*replicaChange
+	for _, change := range r.state {
+		if change.pending {
+			pending = append(pending, change)
+		}
+	}
+	r.mu.Unlock()
+
+	sort.Sort(bySeq(pending))
+	for _, change := range pending {
+		if err := r.write(change); err != nil {
+			return err
+		}
+		r.mu.Lock()
+		if r.state[change.key()] == change {
+			change.pending = false
+		}
+		r.mu.Unlock()
+	}
+	return nil
+}
+
+// write writes a pending change to Redis
+func (r *replicas) write(change *replicaChange) error {
+	switch change.Kind {
+	case replicaDevice:
+		if len(change.Data) == 0 {
+			if err := r.devices.Delete(change.AppID, change.DevID); err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+			return nil
+		}
+		dev := new(device.Device)
+		if err := json.Unmarshal(change.Data, dev); err != nil {
+			return err
+		}
+		return r.devices.Set(dev, change.properties...)
+	case replicaDownlinkQueue:
+		var msgs []*types.DownlinkMessage
+		if err := json.Unmarshal(change.Data, &msgs); err != nil {
+			return err
+		}
+		queue, err := r.devices.DownlinkQueue(change.AppID, change.DevID)
+		if err != nil {
+			return err
+		}
+		if _, err := queue.Clear(); err != nil {
+			return err
+		}
+		for _, msg := range msgs {
+			if err := queue.PushLast(msg); err != nil {
+				return err
+			}
+		}
+		return nil
+	case replicaApplication:
+		if len(change.Data) == 0 {
+			if err := r.applications.Delete(change.AppID); err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+			return nil
+		}
+		app := new(application.Application)
+		if err := json.Unmarshal(change.Data, app); err != nil {
+			return err
+		}
+		return r.applications.Set(app, change.properties...)
+	}
+	return nil
+}
+
+// expire removes the state that changed before the given time and that is not pending
+func (r *replicas) expire(before time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for key, change := range r.state {
+		if !change.pending && change.Time < before.UnixNano() {
+			delete(r.state, key)
+		}
+	}
+}
+
+type bySeq []*replicaChange
+
+func (a bySeq) Len() int           { return len(a) }
+func (a bySeq) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a bySeq) Less(i, j int) bool { return a[i].Seq < a[j].Seq }
+
+// isUnavailable returns whether the error indicates that Redis is not available
+func isUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	cause := errs.Cause(err)
+	if _, ok := cause.(net.Error); ok {
+		return true
+	}
+	return cause == io.EOF || cause == io.ErrUnexpectedEOF
+}
+
+// deviceKeys are the fields of a device that are not exchanged with the other replicas
+var deviceKeys = []string{"AppKey", "NwkSKey", "AppSKey"}
+
+// recordJSON records the JSON of the state as a change
+func (r *replicas) recordJSON(kind, appID, devID string, state interface{}, pending bool, properties []string) error {
+	var data, logged json.RawMessage
+	if state != nil {
+		var err error
+		if data, err = json.Marshal(state); err != nil {
+			return err
+		}
+		logged = data
+		if kind == replicaDevice {
+			var fields map[string]json.RawMessage
+			if err := json.Unmarshal(data, &fields); err != nil {
+				return err
+			}
+			for _, key := range deviceKeys {
+				delete(fields, key)
+			}
+			if logged, err = json.Marshal(fields); err != nil {
+				return err
+			}
+		}
+	}
+	r.record(
+		&replicaChange{Kind: kind, AppID: appID, DevID: devID, Data: data},
+		&replicaChange{Kind: kind, AppID: appID, DevID: devID, Data: logged},
+		pending, properties,
+	)
+	return nil
+}
+
+// replicatedDeviceStore records the changes of devices and downlink queues for the replicas, and serves the replicated
+// state before it reads from Redis
+type replicatedDeviceStore struct {
+	device.Store
+	replicas *replicas
+}
+
+func (s *replicatedDeviceStore) Get(appID, devID string) (*device.Device, error) {
+	data, found := s.replicas.getDevice(appID, devID)
+	if !found {
+		return s.Store.Get(appID, devID)
+	}
+	if len(data) == 0 {
+		return nil, errors.NewErrNotFound(fmt.Sprintf("%s:%s", appID, devID))
+	}
+	dev := new(device.Device)
+	if err := json.Unmarshal(data, dev); err != nil {
+		return nil, err
+	}
+	return dev, nil
+}
+
+func (s *replicatedDeviceStore) Set(new *device.Device, properties ...string) error {
+	err := s.Store.Set(new, properties...)
+	if err != nil && !isUnavailable(err) {
+		return err
+	}
+	if err != nil && len(properties) == 0 {
+		properties = new.ChangedFields()
+	}
+	return s.replicas.recordJSON(replicaDevice, new.AppID, new.DevID, new, err != nil, properties)
+}
+
+func (s *replicatedDeviceStore) SetIfRevision(new *device.Device, revision uint64) error {
+	if err := s.Store.SetIfRevision(new, revision); err != nil {
+		return err
+	}
+	return s.replicas.recordJSON(replicaDevice, new.AppID, new.DevID, new, false, nil)
+}
+
+func (s *replicatedDeviceStore) Delete(appID, devID string) error {
+	err := s.Store.Delete(appID, devID)
+	if err != nil && !isUnavailable(err) {
+		return err
+	}
+	s.replicas.recordJSON(replicaDevice, appID, devID, nil, err != nil, nil)
+	return nil
+}
+
+func (s *replicatedDeviceStore) SoftDelete(dev *device.Device) error {
+	if err := s.Store.SoftDelete(dev); err != nil {
+		return err
+	}
+	return s.replicas.recordJSON(replicaDevice, dev.AppID, dev.DevID, nil, false, nil)
+}
+
+func (s *replicatedDeviceStore) Restore(appID, devID string) (*device.Device, error) {
+	dev, err := s.Store.Restore(appID, devID)
+	if err != nil {
+		return nil, err
+	}
+	return dev, s.replicas.recordJSON(replicaDevice, appID, devID, dev, false, nil)
+}
+
+func (s *replicatedDeviceStore) DownlinkQueue(appID, devID string) (device.DownlinkQueue, error) {
+	queue, err := s.Store.DownlinkQueue(appID, devID)
+	if err != nil {
+		return nil, err
+	}
+	return &replicatedDownlinkQueue{DownlinkQueue: queue, replicas: s.replicas, appID: appID, devID: devID}, nil
+}
+
+// replicatedDownlinkQueue applies the changes of the downlink queue to the replicated contents, and serves the
+// replicated contents before it reads from Redis
+type replicatedDownlinkQueue struct {
+	device.DownlinkQueue
+	replicas     *replicas
+	appID, devID string
+}
+
+// replicated returns the replicated contents of the queue
+func (q *replicatedDownlinkQueue) replicated() (msgs []*types.DownlinkMessage, found bool) {
+	data, found := q.replicas.get(replicaDownlinkQueue, q.appID, q.devID)
+	if !found {
+		return nil, false
+	}
+	if err := json.Unmarshal(data, &msgs); err != nil {
+		return nil, false
+	}
+	return msgs, true
+}
+
+// update applies a change of the queue in Redis to the replicated contents. If Redis is not available, the change is
+// recorded as pending. Queues without replicated contents are only changed in Redis.
+func (q *replicatedDownlinkQueue) update(err error, change func(msgs []*types.DownlinkMessage) []*types.DownlinkMessage) error {
+	if err != nil && !isUnavailable(err) {
+		return err
+	}
+	msgs, found := q.replicated()
+	if !found {
+		return err
+	}
+	return q.replicas.recordJSON(replicaDownlinkQueue, q.appID, q.devID, change(msgs), err != nil, nil)
+}
+
+func (q *replicatedDownlinkQueue) Length() (int, error) {
+	if msgs, found := q.replicated(); found {
+		return len(msgs), nil
+	}
+	return q.DownlinkQueue.Length()
+}
+
+func (q *replicatedDownlinkQueue) All() ([]*types.DownlinkMessage, error) {
+	if msgs, found := q.replicated(); found {
+		return msgs, nil
+	}
+	return q.DownlinkQueue.All()
+}
+
+func (q *replicatedDownlinkQueue) Next() (*types.DownlinkMessage, error) {
+	msg, err := q.DownlinkQueue.Next()
+	if err == nil && msg == nil {
+		// The queue is empty
+		return nil, nil
+	}
+	if isUnavailable(err) {
+		if msgs, found := q.replicated(); found && len(msgs) > 0 {
+			msg = msgs[0]
+		}
+	}
+	return msg, q.update(err, func(msgs []*types.DownlinkMessage) []*types.DownlinkMessage {
+		if len(msgs) == 0 {
+			return msgs
+		}
+		return msgs[1:]
+	})
+}
+
+func (q *replicatedDownlinkQueue) Replace(msg *types.DownlinkMessage) error {
+	err := q.DownlinkQueue.Replace(msg)
+	if err != nil && !isUnavailable(err) {
+		return err
+	}
+	return q.replicas.recordJSON(replicaDownlinkQueue, q.appID, q.devID, []*types.DownlinkMessage{msg}, err != nil, nil)
+}
+
+func (q *replicatedDownlinkQueue) PushFirst(msg *types.DownlinkMessage) error {
+	return q.update(q.DownlinkQueue.PushFirst(msg), func(msgs []*types.DownlinkMessage) []*types.DownlinkMessage {
+		return append([]*types.DownlinkMessage{msg}, msgs...)
+	})
+}
+
+func (q *replicatedDownlinkQueue) PushLast(msg *types.DownlinkMessage) error {
+	return q.update(q.DownlinkQueue.PushLast(msg), func(msgs []*types.DownlinkMessage) []*types.DownlinkMessage {
+		return append(msgs, msg)
+	})
+}
+
+func (q *replicatedDownlinkQueue) Clear() (int, error) {
+	cleared, err := q.DownlinkQueue.Clear()
+	if isUnavailable(err) {
+		msgs, found := q.replicated()
+		if !found {
+			return 0, err
+		}
+		cleared = len(msgs)
+	} else if err != nil {
+		return 0, err
+	}
+	return cleared, q.replicas.recordJSON(replicaDownlinkQueue, q.appID, q.devID, []*types.DownlinkMessage{}, err != nil, nil)
+}
+
+// replicatedApplicationStore records the changes of applications for the replicas, and serves the replicated state
+// before it reads from Redis
+type replicatedApplicationStore struct {
+	application.Store
+	replicas *replicas
+}
+
+func (s *replicatedApplicationStore) Get(appID string) (*application.Application, error) {
+	data, found := s.replicas.get(replicaApplication, appID, "")
+	if !found {
+		return s.Store.Get(appID)
+	}
+	if len(data) == 0 {
+		return nil, errors.NewErrNotFound(appID)
+	}
+	app := new(application.Application)
+	if err := json.Unmarshal(data, app); err != nil {
+		return nil, err
+	}
+	return app, nil
+}
+
+func (s *replicatedApplicationStore) Set(new *application.Application, properties ...string) error {
+	err := s.Store.Set(new, properties...)
+	if err != nil && !isUnavailable(err) {
+		return err
+	}
+	if err != nil && len(properties) == 0 {
+		properties = new.ChangedFields()
+	}
+	return s.replicas.recordJSON(replicaApplication, new.AppID, "", new, err != nil, properties)
+}
+
+func (s *replicatedApplicationStore) SetIfRevision(new *application.Application, revision uint64) error {
+	if err := s.Store.SetIfRevision(new, revision); err != nil {
+		return err
+	}
+	return s.replicas.recordJSON(replicaApplication, new.AppID, "", new, false, nil)
+}
+
+func (s *replicatedApplicationStore) Delete(appID string) error {
+	err := s.Store.Delete(appID)
+	if err != nil && !isUnavailable(err) {
+		return err
+	}
+	s.replicas.recordJSON(replicaApplication, appID, "", nil, err != nil, nil)
+	return nil
+}
+
+func (s *replicatedApplicationStore) SoftDelete(app *application.Application) error {
+	if err := s.Store.SoftDelete(app); err != nil {
+		return err
+	}
+	return s.replicas.recordJSON(replicaApplication, app.AppID, "", nil, false, nil)
+}
+
+func (s *replicatedApplicationStore) Restore(appID string) (*application.Application, error) {
+	app, err := s.Store.Restore(appID)
+	if err != nil {
+		return nil, err
+	}
+	return app, s.replicas.recordJSON(replicaApplication, appID, "", app, false, nil)
+}
@@ -0,0 +1,192 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+	"gopkg.in/redis.v5"
+)
+
+// getUnavailableRedisClient returns a client for a Redis server that is not available
+func getUnavailableRedisClient() *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:        "localhost:1",
+		DialTimeout: 100 * time.Millisecond,
+	})
+}
+
+func TestReplicaChanges(t *testing.T) {
+	a := New(t)
+	r := newReplicas(nil, nil, nil, "key")
+
+	r.recordJSON(replicaDevice, "app", "dev-1", map[string]string{"dev_id": "dev-1"}, false, nil)
+	r.recordJSON(replicaDevice, "app", "dev-2", map[string]string{"dev_id": "dev-2"}, true, []string{"FCntUp"})
+	r.recordJSON(replicaDevice, "app", "dev-2", map[string]string{"dev_id": "dev-2"}, true, []string{"FCntDown"})
+	r.recordJSON(replicaDevice, "app", "dev-2", nil, false, nil)
+
+	changes := r.since("", 0)
+	a.So(changes.Seq, ShouldEqual, 4)
+	a.So(changes.Changes, ShouldHaveLength, 4)
+	a.So(r.since(changes.Epoch, 2).Changes, ShouldHaveLength, 2)
+	a.So(r.since("other", 2).Changes, ShouldHaveLength, 4)
+
+	// The properties of the pending changes are merged
+	data, found := r.get(replicaDevice, "app", "dev-2")
+	a.So(found, ShouldBeTrue)
+	a.So(data, ShouldBeEmpty)
+	pending := r.state[(&replicaChange{Kind: replicaDevice, AppID: "app", DevID: "dev-2"}).key()]
+	a.So(pending.pending, ShouldBeTrue)
+	a.So(pending.properties, ShouldResemble, []string{"FCntUp", "FCntDown"})
+
+	// Newer changes of other replicas replace older ones
+	other := newReplicas(nil, nil, nil, "key")
+	other.merge("peer", &replicaChanges{Epoch: "epoch", Seq: 2, Changes: []*replicaChange{
+		{Seq: 1, Kind: replicaDevice, AppID: "app", DevID: "dev-1", Time: 10, Data: []byte(`{"dev_id":"old"}`)},
+		{Seq: 2, Kind: replicaDevice, AppID: "app", DevID: "dev-1", Time: 20, Data: []byte(`{"dev_id":"new"}`)},
+	}})
+	other.merge("peer", &replicaChanges{Epoch: "epoch", Seq: 2, Changes: []*replicaChange{
+		{Seq: 1, Kind: replicaDevice, AppID: "app", DevID: "dev-1", Time: 10, Data: []byte(`{"dev_id":"old"}`)},
+	}})
+	data, _ = other.get(replicaDevice, "app", "dev-1")
+	a.So(string(data), ShouldEqual, `{"dev_id":"new"}`)
+	a.So(other.cursors["peer"], ShouldResemble, replicaCursor{epoch: "epoch", seq: 2})
+	a.So(other.since("", 0).Changes, ShouldBeEmpty)
+
+	// The keys of devices are not exchanged, so devices of other replicas are read from Redis
+	r.recordJSON(replicaDevice, "app", "dev-3", &device.Device{AppID: "app", DevID: "dev-3", NwkSKey: types.NwkSKey{1}}, false, nil)
+	data, _ = r.getDevice("app", "dev-3")
+	a.So(string(data), ShouldContainSubstring, `"NwkSKey"`)
+	logged := r.since(changes.Epoch, 4).Changes
+	a.So(logged, ShouldHaveLength, 1)
+	a.So(string(logged[0].Data), ShouldNotContainSubstring, `"NwkSKey"`)
+	_, found = other.getDevice("app", "dev-1")
+	a.So(found, ShouldBeFalse)
+
+	// State that is not pending expires
+	r.expire(time.Now().Add(time.Second))
+	_, found = r.get(replicaDevice, "app", "dev-1")
+	a.So(found, ShouldBeFalse)
+	_, found = r.get(replicaDevice, "app", "dev-2")
+	a.So(found, ShouldBeTrue)
+}
+
+func TestReplicaSync(t *testing.T) {
+	a := New(t)
+	appID, devID := "AppID-1", "DevID-1"
+
+	source := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestReplicaSync")},
+		devices:      device.NewRedisDeviceStore(GetRedisClient(), "handler-test-replica-sync"),
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-replica-sync"),
+	}
+	source.WithReplicas(nil, "key")
+	defer func() {
+		source.devices.Delete(appID, devID)
+	}()
+
+	mux := http.NewServeMux()
+	mux.Handle("/replicas/", http.StripPrefix("/replicas", source.ReplicaSync()))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	a.So(source.devices.Set(&device.Device{AppID: appID, DevID: devID, FCntUp: 42}), ShouldBeNil)
+
+	target := newReplicas(nil, nil, []string{server.URL}, "wrong")
+	a.So(target.pull(server.URL), ShouldNotBeNil)
+
+	target.key = "key"
+	a.So(target.pull(server.URL), ShouldBeNil)
+	data, found := target.get(replicaDevice, appID, devID)
+	a.So(found, ShouldBeTrue)
+	a.So(string(data), ShouldContainSubstring, `"FCntUp":42`)
+
+	// Only the changes since the last pull are returned
+	a.So(source.devices.Set(&device.Device{AppID: appID, DevID: devID, FCntUp: 43}), ShouldBeNil)
+	a.So(target.pull(server.URL), ShouldBeNil)
+	a.So(target.cursors[server.URL].seq, ShouldEqual, 2)
+	data, _ = target.get(replicaDevice, appID, devID)
+	a.So(string(data), ShouldContainSubstring, `"FCntUp":43`)
+
+	// The replicated state is served before Redis
+	source.replicas.recordJSON(replicaApplication, appID, "", &application.Application{AppID: appID, Decoder: "replicated"}, false, nil)
+	app, err := source.applications.Get(appID)
+	a.So(err, ShouldBeNil)
+	a.So(app.Decoder, ShouldEqual, "replicated")
+
+	// Replicas without a key do not serve their state
+	source.replicas.key = ""
+	target.key = ""
+	a.So(target.pull(server.URL), ShouldNotBeNil)
+}
+
+func TestReplicatedStoresUnavailable(t *testing.T) {
+	a := New(t)
+	appID, devID := "AppID-1", "DevID-1"
+
+	h := &handler{
+		devices:      device.NewRedisDeviceStore(getUnavailableRedisClient(), "handler-test-replica-unavailable"),
+		applications: application.NewRedisApplicationStore(getUnavailableRedisClient(), "handler-test-replica-unavailable"),
+	}
+	h.WithReplicas(nil, "key")
+
+	// Without replicated state, the errors of Redis are returned
+	_, err := h.devices.Get(appID, devID)
+	a.So(err, ShouldNotBeNil)
+	queue, err := h.devices.DownlinkQueue(appID, devID)
+	a.So(err, ShouldBeNil)
+	a.So(queue.PushLast(&types.DownlinkMessage{AppID: appID, DevID: devID}), ShouldNotBeNil)
+
+	// The state of another replica is served
+	h.replicas.merge("peer", &replicaChanges{Changes: []*replicaChange{
+		{Kind: replicaApplication, AppID: appID, Time: 10, Data: []byte(`{"AppID":"AppID-1","Decoder":"function Decoder() {}"}`)},
+		{Kind: replicaDownlinkQueue, AppID: appID, DevID: devID, Time: 10, Data: []byte(`[]`)},
+	}})
+	app, err := h.applications.Get(appID)
+	a.So(err, ShouldBeNil)
+	a.So(app.Decoder, ShouldEqual, "function Decoder() {}")
+
+	// Changes are kept until Redis is available
+	a.So(h.devices.Set(&device.Device{AppID: appID, DevID: devID, FCntUp: 42}), ShouldBeNil)
+	dev, err := h.devices.Get(appID, devID)
+	a.So(err, ShouldBeNil)
+	a.So(dev.FCntUp, ShouldEqual, 42)
+
+	a.So(queue.PushLast(&types.DownlinkMessage{AppID: appID, DevID: devID, PayloadRaw: []byte{0x01}}), ShouldBeNil)
+	a.So(queue.PushFirst(&types.DownlinkMessage{AppID: appID, DevID: devID, PayloadRaw: []byte{0x02}}), ShouldBeNil)
+	length, err := queue.Length()
+	a.So(err, ShouldBeNil)
+	a.So(length, ShouldEqual, 2)
+	next, err := queue.Next()
+	a.So(err, ShouldBeNil)
+	a.So(next.PayloadRaw, ShouldResemble, []byte{0x02})
+
+	a.So(h.replicas.flush(), ShouldNotBeNil)
+
+	// Redis is available again
+	h.replicas.devices = device.NewRedisDeviceStore(GetRedisClient(), "handler-test-replica-unavailable")
+	stored, _ := h.replicas.devices.DownlinkQueue(appID, devID)
+	defer func() {
+		stored.Clear()
+		h.replicas.devices.Delete(appID, devID)
+	}()
+	a.So(h.replicas.flush(), ShouldBeNil)
+	dev, err = h.replicas.devices.Get(appID, devID)
+	a.So(err, ShouldBeNil)
+	a.So(dev.FCntUp, ShouldEqual, 42)
+	msgs, err := stored.All()
+	a.So(err, ShouldBeNil)
+	a.So(msgs, ShouldHaveLength, 1)
+	a.So(msgs[0].PayloadRaw, ShouldResemble, []byte{0x01})
+	a.So(h.replicas.flush(), ShouldBeNil)
+}
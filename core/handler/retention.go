@@ -0,0 +1,156 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"time"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+)
+
+// RetentionInterval indicates how often the retention policies of applications are enforced
+var RetentionInterval = time.Hour
+
+// retentionCutoff returns the time before which stored uplink messages of the application should be deleted
+func retentionCutoff(app *application.Application, now time.Time) (time.Time, bool) {
+	if app == nil || app.RetentionDays == 0 {
+		return time.Time{}, false
+	}
+	return now.Add(-1 * time.Duration(app.RetentionDays) * 24 * time.Hour), true
+}
+
+// withinRetention filters out the uplink messages that were received before the cutoff
+func withinRetention(uplinks []*pb.DeviceUplink, cutoff time.Time) []*pb.DeviceUplink {
+	res := make([]*pb.DeviceUplink, 0, len(uplinks))
+	for _, uplink := range uplinks {
+		if !time.Unix(0, uplink.ServerTime).Before(cutoff) {
+			res = append(res, uplink)
+		}
+	}
+	return res
+}
+
+// enforceRetention deletes the stored uplink messages that are older than the retention of their application
+func (h *handler) enforceRetention() {
+	apps, err := h.applications.List(nil)
+	if err != nil {
+		h.Ctx.WithError(err).Warn("Could not list applications for retention")
+		return
+	}
+	now := time.Now()
+	for _, app := range apps {
+		cutoff, ok := retentionCutoff(app, now)
+		if !ok {
+			continue
+		}
+		devices, err := h.devices.ListForApp(app.AppID, nil)
+		if err != nil {
+			h.Ctx.WithError(err).WithField("AppID", app.AppID).Warn("Could not list devices for retention")
+			continue
+		}
+		var pruned int
+		for _, dev := range devices {
+			history, err := h.devices.UplinkHistory(dev.AppID, dev.DevID)
+			if err != nil {
+				continue
+			}
+			n, err := history.Prune(cutoff)
+			if err != nil {
+				h.Ctx.WithError(err).WithField("AppID", dev.AppID).WithField("DevID", dev.DevID).Warn("Could not prune uplink history")
+				continue
+			}
+			pruned += n
+		}
+		if pruned > 0 {
+			h.Ctx.WithField("AppID", app.AppID).WithField("Uplinks", pruned).Info("Deleted uplinks after retention period")
+		}
+	}
+}
+
+func (h *handler) runRetention() {
+	for range time.Tick(RetentionInterval) {
+		h.enforceRetention()
+		h.purgeRecycleBin()
+	}
+}
+
+// Kinds of stored data that are erased when forgetting a device
+const (
+	ErasedUplinkHistory   = "uplink_history"
+	ErasedDownlinkQueue   = "downlink_queue"
+	ErasedCurrentDownlink = "current_downlink"
+	ErasedComputedFields  = "computed_fields"
+	ErasedFieldStatistics = "field_statistics"
+	ErasedAggregation     = "aggregation"
+)
+
+// forgetDevice erases the stored payloads and payload fields of the device. The Handler does not store logs or
+// traces of devices, so these are not included.
+func (h *handler) forgetDevice(dev *device.Device) (*pb.ErasureReport, error) {
+	report := &pb.ErasureReport{
+		AppId:  dev.AppID,
+		DevId:  dev.DevID,
+		DevEui: dev.DevEUI.String(),
+	}
+
+	history, err := h.devices.UplinkHistory(dev.AppID, dev.DevID)
+	if err != nil {
+		return nil, err
+	}
+	uplinks, err := history.Clear()
+	if err != nil {
+		return nil, err
+	}
+	report.Uplinks = uint32(uplinks)
+	if uplinks > 0 {
+		report.Erased = append(report.Erased, ErasedUplinkHistory)
+	}
+
+	queue, err := h.devices.DownlinkQueue(dev.AppID, dev.DevID)
+	if err != nil {
+		return nil, err
+	}
+	downlinks, err := queue.Clear()
+	if err != nil {
+		return nil, err
+	}
+	report.Downlinks = uint32(downlinks)
+	if downlinks > 0 {
+		report.Erased = append(report.Erased, ErasedDownlinkQueue)
+	}
+
+	dev.StartUpdate()
+	if dev.CurrentDownlink != nil {
+		dev.CurrentDownlink = nil
+		report.Erased = append(report.Erased, ErasedCurrentDownlink)
+	}
+	if dev.ComputedFields != nil {
+		dev.ComputedFields = nil
+		report.Erased = append(report.Erased, ErasedComputedFields)
+	}
+	if dev.FieldStatistics != nil {
+		dev.FieldStatistics = nil
+		report.Erased = append(report.Erased, ErasedFieldStatistics)
+	}
+	if dev.Aggregation != nil {
+		dev.Aggregation = nil
+		report.Erased = append(report.Erased, ErasedAggregation)
+	}
+	if err := h.devices.Set(dev); err != nil {
+		return nil, err
+	}
+
+	report.ErasedAt = time.Now().UnixNano()
+
+	h.Ctx.WithField("AppID", dev.AppID).
+		WithField("DevID", dev.DevID).
+		WithField("Uplinks", report.Uplinks).
+		WithField("Downlinks", report.Downlinks).
+		WithField("Erased", report.Erased).
+		Info("Forgot device")
+
+	return report, nil
+}
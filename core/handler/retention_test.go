@@ -0,0 +1,123 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestRetentionCutoff(t *testing.T) {
+	a := New(t)
+	now := time.Date(2017, 6, 30, 12, 0, 0, 0, time.UTC)
+
+	_, ok := retentionCutoff(&application.Application{}, now)
+	a.So(ok, ShouldBeFalse)
+
+	cutoff, ok := retentionCutoff(&application.Application{RetentionDays: 30}, now)
+	a.So(ok, ShouldBeTrue)
+	a.So(cutoff, ShouldResemble, time.Date(2017, 5, 31, 12, 0, 0, 0, time.UTC))
+
+	uplinks := withinRetention([]*pb.DeviceUplink{
+		{Counter: 2, ServerTime: now.UnixNano()},
+		{Counter: 1, ServerTime: cutoff.UnixNano()},
+		{Counter: 0, ServerTime: cutoff.Add(-1 * time.Second).UnixNano()},
+	}, cutoff)
+	a.So(uplinks, ShouldHaveLength, 2)
+	a.So(uplinks[1].Counter, ShouldEqual, 1)
+}
+
+func TestEnforceRetention(t *testing.T) {
+	a := New(t)
+
+	h := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestEnforceRetention")},
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-enforce-retention"),
+		devices:      device.NewRedisDeviceStore(GetRedisClient(), "handler-test-enforce-retention"),
+	}
+
+	h.applications.Set(&application.Application{AppID: "with-retention", RetentionDays: 7})
+	h.applications.Set(&application.Application{AppID: "without-retention"})
+	defer func() {
+		h.applications.Delete("with-retention")
+		h.applications.Delete("without-retention")
+	}()
+
+	now := time.Now()
+	for _, appID := range []string{"with-retention", "without-retention"} {
+		h.devices.Set(&device.Device{AppID: appID, DevID: "dev"})
+		defer h.devices.Delete(appID, "dev")
+		history, _ := h.devices.UplinkHistory(appID, "dev")
+		history.Push(&pb.DeviceUplink{Counter: 1, ServerTime: now.Add(-10 * 24 * time.Hour).UnixNano()})
+		history.Push(&pb.DeviceUplink{Counter: 2, ServerTime: now.UnixNano()})
+	}
+
+	h.enforceRetention()
+
+	history, _ := h.devices.UplinkHistory("with-retention", "dev")
+	uplinks, _ := history.Get()
+	a.So(uplinks, ShouldHaveLength, 1)
+	a.So(uplinks[0].Counter, ShouldEqual, 2)
+
+	history, _ = h.devices.UplinkHistory("without-retention", "dev")
+	uplinks, _ = history.Get()
+	a.So(uplinks, ShouldHaveLength, 2)
+}
+
+func TestForgetDevice(t *testing.T) {
+	a := New(t)
+
+	h := &handler{
+		Component: &component.Component{Ctx: GetLogger(t, "TestForgetDevice")},
+		devices:   device.NewRedisDeviceStore(GetRedisClient(), "handler-test-forget-device"),
+	}
+
+	dev := &device.Device{
+		AppID:           "app",
+		DevID:           "dev",
+		DevEUI:          types.DevEUI{1, 2, 3, 4, 5, 6, 7, 8},
+		ComputedFields:  &device.ComputedFields{Values: map[string]interface{}{"total": 42}},
+		CurrentDownlink: &types.DownlinkMessage{PayloadRaw: []byte{0x01}},
+	}
+	a.So(h.devices.Set(dev), ShouldBeNil)
+	defer h.devices.Delete("app", "dev")
+
+	history, _ := h.devices.UplinkHistory("app", "dev")
+	history.Push(&pb.DeviceUplink{Counter: 1, PayloadRaw: []byte{0x01}})
+	history.Push(&pb.DeviceUplink{Counter: 2, PayloadRaw: []byte{0x02}})
+	queue, _ := h.devices.DownlinkQueue("app", "dev")
+	queue.PushLast(&types.DownlinkMessage{PayloadRaw: []byte{0x03}})
+
+	dev, _ = h.devices.Get("app", "dev")
+	report, err := h.forgetDevice(dev)
+	a.So(err, ShouldBeNil)
+	a.So(report.DevEui, ShouldEqual, "0102030405060708")
+	a.So(report.Uplinks, ShouldEqual, 2)
+	a.So(report.Downlinks, ShouldEqual, 1)
+	a.So(report.Erased, ShouldResemble, []string{ErasedUplinkHistory, ErasedDownlinkQueue, ErasedCurrentDownlink, ErasedComputedFields})
+	a.So(report.ErasedAt, ShouldBeGreaterThan, 0)
+
+	uplinks, _ := history.Get()
+	a.So(uplinks, ShouldBeEmpty)
+	length, _ := queue.Length()
+	a.So(length, ShouldEqual, 0)
+
+	dev, err = h.devices.Get("app", "dev")
+	a.So(err, ShouldBeNil)
+	a.So(dev.ComputedFields, ShouldBeNil)
+	a.So(dev.CurrentDownlink, ShouldBeNil)
+
+	// Forgetting again erases nothing
+	report, err = h.forgetDevice(dev)
+	a.So(err, ShouldBeNil)
+	a.So(report.Erased, ShouldBeEmpty)
+}
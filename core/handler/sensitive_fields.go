@@ -0,0 +1,29 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import "strings"
+
+// RedactedValue replaces the values of sensitive payload fields in logs
+const RedactedValue = "[redacted]"
+
+// isSensitiveField returns true if the field, or a field that it is nested in, is marked as sensitive
+func isSensitiveField(sensitive []string, field string) bool {
+	for _, s := range sensitive {
+		if field == s || strings.HasPrefix(field, s+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// sensitiveFieldsOf returns the sensitive payload fields of the application. Sensitive fields are delivered to
+// integrations, but are redacted from logs and events.
+func (h *handler) sensitiveFieldsOf(appID string) []string {
+	app, err := h.applications.Get(appID)
+	if err != nil {
+		return nil
+	}
+	return app.SensitiveFields
+}
@@ -0,0 +1,20 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestIsSensitiveField(t *testing.T) {
+	a := New(t)
+	sensitive := []string{"name", "location"}
+	a.So(isSensitiveField(sensitive, "name"), ShouldBeTrue)
+	a.So(isSensitiveField(sensitive, "location.lat"), ShouldBeTrue)
+	a.So(isSensitiveField(sensitive, "names"), ShouldBeFalse)
+	a.So(isSensitiveField(sensitive, "temperature"), ShouldBeFalse)
+	a.So(isSensitiveField(nil, "name"), ShouldBeFalse)
+}
@@ -47,6 +47,9 @@ func (h *handlerRPC) Activate(ctx context.Context, activation *pb_broker.Dedupli
 
 // RegisterRPC registers this handler as a HandlerServer (github.com/TheThingsNetwork/ttn/api/handler)
 func (h *handler) RegisterRPC(s *grpc.Server) {
+	if h.readOnly {
+		return
+	}
 	server := &handlerRPC{h}
 	pb.RegisterHandlerServer(s, server)
 }
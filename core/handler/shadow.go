@@ -0,0 +1,88 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/amqp"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/types"
+)
+
+// ShadowBufferSize is the number of uplink messages that are buffered for the shadow integration. Messages that do
+// not fit in the buffer are dropped.
+var ShadowBufferSize = 1000
+
+// WithShadowAMQP adds an AMQP integration in shadow mode. The shadow integration receives a copy of the uplink
+// traffic, but it is never used for downlink and it can not affect the other integrations: if it is unavailable or
+// too slow, messages are dropped without retries, delivery tracking or alerts.
+func (h *handler) WithShadowAMQP(username, password, host, exchange string) Handler {
+	h.amqpShadowUsername = username
+	h.amqpShadowPassword = password
+	h.amqpShadowHost = host
+	h.amqpShadowExchange = exchange
+	h.amqpShadowEnabled = true
+	return h
+}
+
+// HandleShadowAMQP connects to the shadow AMQP server and starts publishing the copied uplink messages. Errors are
+// logged, but never returned, so that the shadow integration does not prevent the Handler from starting.
+func (h *handler) HandleShadowAMQP(username, password, host, exchange string) {
+	ctx := h.Ctx.WithFields(ttnlog.Fields{
+		"Protocol": "AMQP",
+		"Shadow":   host,
+	})
+
+	h.amqpShadowUp = make(chan *types.UplinkMessage, ShadowBufferSize)
+	h.amqpShadowClient = amqp.NewClient(ctx, username, password, host)
+
+	go func() {
+		if err := h.publishShadowAMQP(ctx, exchange); err != nil {
+			ctx.WithError(err).Warn("Could not start shadow integration")
+		}
+		// Keep draining the buffer, so that a failed shadow integration never blocks the Handler
+		for range h.amqpShadowUp {
+		}
+	}()
+}
+
+func (h *handler) publishShadowAMQP(ctx ttnlog.Interface, exchange string) error {
+	if err := h.amqpShadowClient.Connect(); err != nil {
+		return err
+	}
+	publisher := h.amqpShadowClient.NewPublisher(exchange)
+	if err := publisher.Open(); err != nil {
+		return err
+	}
+	defer publisher.Close()
+
+	for up := range h.amqpShadowUp {
+		var err error
+		if app, _ := h.applications.Get(up.AppID); app != nil && app.IntegrationFormat == pb.IntegrationFormatRaw {
+			err = publisher.PublishRawUplink(*up)
+		} else {
+			err = publisher.PublishUplink(*up)
+		}
+		if err != nil {
+			ctx.WithError(err).Debug("Could not publish Uplink to shadow integration")
+		}
+	}
+	return nil
+}
+
+// shadowUplink copies an uplink message to the shadow integration (if any). It never blocks: if the buffer of the
+// shadow integration is full, the message is dropped.
+func (h *handler) shadowUplink(up *types.UplinkMessage) {
+	if !h.amqpShadowEnabled || h.amqpShadowUp == nil {
+		return
+	}
+	select {
+	case h.amqpShadowUp <- up:
+	default:
+		h.Ctx.WithFields(ttnlog.Fields{
+			"AppID": up.AppID,
+			"DevID": up.DevID,
+		}).Debug("Shadow integration buffer full, dropping Uplink")
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestShadowUplink(t *testing.T) {
+	a := New(t)
+
+	h := &handler{
+		Component: &component.Component{Ctx: GetLogger(t, "TestShadowUplink")},
+	}
+
+	// Without shadow integration
+	h.shadowUplink(&types.UplinkMessage{AppID: "test", DevID: "test"})
+
+	h.WithShadowAMQP("guest", "guest", "localhost:5672", "amq.topic")
+	h.amqpShadowUp = make(chan *types.UplinkMessage, 2)
+
+	// Never blocks, even if the buffer is full
+	for i := 0; i < 5; i++ {
+		h.shadowUplink(&types.UplinkMessage{AppID: "test", DevID: "test"})
+	}
+	a.So(h.amqpShadowUp, ShouldHaveLength, 2)
+}
@@ -0,0 +1,89 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"sync"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/core/types"
+)
+
+// Work that the handler sheds when it is overloaded, in the order in which it is shed. Uplinks are always published
+// to MQTT.
+const (
+	ShedDebugStreams = "debug_streams"
+	ShedStorage      = "storage"
+	ShedIntegrations = "integrations"
+)
+
+// SheddingThresholds are the loads of the handler (relative to its capacity) above which work is shed
+var SheddingThresholds = []struct {
+	Work string
+	Load float64
+}{
+	{ShedDebugStreams, 1},
+	{ShedStorage, 1.25},
+	{ShedIntegrations, 1.5},
+}
+
+// shedWork is the work that is shed while handling an uplink
+type shedWork []string
+
+// Includes returns true if the work is shed
+func (s shedWork) Includes(work string) bool {
+	for _, shed := range s {
+		if shed == work {
+			return true
+		}
+	}
+	return false
+}
+
+type shedding struct {
+	sync.Mutex
+	level int
+	apps  map[string]int
+}
+
+// shed determines the work to shed at the current load of the handler. A shedding event is published for the
+// application if it differs from the work that was shed for the previous uplink of the application.
+func (h *handler) shed(ctx ttnlog.Interface, appID string) (shed shedWork) {
+	if h.Component == nil {
+		return nil
+	}
+	load := h.Component.GetLoad().Load
+	for _, threshold := range SheddingThresholds {
+		if load > threshold.Load {
+			shed = append(shed, threshold.Work)
+		}
+	}
+
+	h.shedding.Lock()
+	defer h.shedding.Unlock()
+	if h.shedding.apps == nil {
+		h.shedding.apps = make(map[string]int)
+	}
+	if len(shed) != h.shedding.level {
+		h.shedding.level = len(shed)
+		if len(shed) > 0 {
+			ctx.WithFields(ttnlog.Fields{"Load": load, "Shed": shed}).Warn("Handler overloaded, shedding work")
+		} else {
+			ctx.WithField("Load", load).Info("Handler no longer overloaded")
+		}
+	}
+	if len(shed) != h.shedding.apps[appID] {
+		if len(shed) > 0 {
+			h.shedding.apps[appID] = len(shed)
+		} else {
+			delete(h.shedding.apps, appID)
+		}
+		h.mqttEvent <- &types.DeviceEvent{
+			AppID: appID,
+			Event: types.SheddingEvent,
+			Data:  types.SheddingEventData{Load: load, Shed: append([]string{}, shed...)},
+		}
+	}
+	return shed
+}
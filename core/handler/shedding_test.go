@@ -0,0 +1,60 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestShed(t *testing.T) {
+	a := New(t)
+
+	load := 0.5
+	h := &handler{
+		Component: &component.Component{Ctx: GetLogger(t, "TestShed")},
+		mqttEvent: make(chan *types.DeviceEvent, 10),
+	}
+	h.Component.RegisterLoadSignal("test", func() float64 { return load })
+
+	a.So(h.shed(h.Ctx, "app"), ShouldBeEmpty)
+	a.So(h.mqttEvent, ShouldBeEmpty)
+
+	load = 1.1
+	shed := h.shed(h.Ctx, "app")
+	a.So(shed, ShouldResemble, shedWork{ShedDebugStreams})
+	a.So(shed.Includes(ShedDebugStreams), ShouldBeTrue)
+	a.So(shed.Includes(ShedStorage), ShouldBeFalse)
+	a.So(h.mqttEvent, ShouldHaveLength, 1)
+	event := <-h.mqttEvent
+	a.So(event.AppID, ShouldEqual, "app")
+	a.So(event.DevID, ShouldBeEmpty)
+	a.So(event.Event, ShouldEqual, types.SheddingEvent)
+	a.So(event.Data.(types.SheddingEventData).Shed, ShouldResemble, []string{ShedDebugStreams})
+
+	// No new event if the shed work does not change
+	h.shed(h.Ctx, "app")
+	a.So(h.mqttEvent, ShouldBeEmpty)
+
+	load = 2
+	shed = h.shed(h.Ctx, "app")
+	a.So(shed, ShouldResemble, shedWork{ShedDebugStreams, ShedStorage, ShedIntegrations})
+	a.So(h.mqttEvent, ShouldHaveLength, 1)
+	<-h.mqttEvent
+
+	// Other applications get their own event
+	h.shed(h.Ctx, "other-app")
+	a.So(h.mqttEvent, ShouldHaveLength, 1)
+	<-h.mqttEvent
+
+	load = 0.9
+	a.So(h.shed(h.Ctx, "app"), ShouldBeEmpty)
+	event = <-h.mqttEvent
+	a.So(event.Data.(types.SheddingEventData).Shed, ShouldBeEmpty)
+	a.So(h.mqttEvent, ShouldBeEmpty)
+}
@@ -19,6 +19,9 @@ func (h *handlerManager) SimulateUplink(ctx context.Context, in *pb.SimulatedUpl
 	if err := in.Validate(); err != nil {
 		return nil, errors.Wrap(err, "Invalid Uplink")
 	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
 
 	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
 	if err != nil {
@@ -46,7 +49,8 @@ func (h *handlerManager) SimulateUplink(ctx context.Context, in *pb.SimulatedUpl
 		FPort:          uint8(in.Port),
 		PayloadRaw:     in.Payload,
 		Metadata: types.Metadata{
-			Time: types.JSONTime(time.Now().UTC()),
+			Time:      types.JSONTime(time.Now().UTC()),
+			Simulated: true,
 			LocationMetadata: types.LocationMetadata{
 				Latitude:  dev.Latitude,
 				Longitude: dev.Longitude,
@@ -60,9 +64,11 @@ func (h *handlerManager) SimulateUplink(ctx context.Context, in *pb.SimulatedUpl
 		return nil, err
 	}
 
-	h.handler.mqttUp <- uplink
-	if h.handler.amqpEnabled {
-		h.handler.amqpUp <- uplink
+	for _, uplink := range expandReadings(uplink) {
+		h.handler.mqttUp <- uplink
+		if h.handler.amqpEnabled {
+			h.handler.amqpUp <- uplink
+		}
 	}
 
 	return new(empty.Empty), nil
@@ -0,0 +1,41 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+)
+
+// addDecoderState adds the decoded fields and the frame counter of the previous uplink message of the device to the
+// metadata of the payload functions as previous, so that the Decoder can reconstruct absolute values from
+// delta-encoded payloads. The metadata does not contain previous if the device did not send a decoded uplink yet.
+func addDecoderState(metadata map[string]interface{}, dev *device.Device, fCnt uint32) {
+	if dev == nil {
+		return
+	}
+	previous := dev.DecoderState.PreviousFor(fCnt)
+	if previous == nil {
+		return
+	}
+	metadata["previous"] = map[string]interface{}{
+		"f_cnt":  previous.FCnt,
+		"fields": previous.Fields,
+	}
+}
+
+// updateDecoderState stores the decoded fields of the uplink message in the device, or removes the stored fields if
+// the application does not use stateful decoding. The device is saved after the uplink is handled.
+func updateDecoderState(dev *device.Device, stateful bool, fCnt uint32, decoded map[string]interface{}) {
+	if dev == nil {
+		return
+	}
+	if !stateful {
+		dev.DecoderState = nil
+		return
+	}
+	if dev.DecoderState == nil {
+		dev.DecoderState = new(device.DecoderState)
+	}
+	dev.DecoderState.Add(fCnt, decoded)
+}
@@ -4,9 +4,12 @@
 package handler
 
 import (
+	"runtime"
+
 	"github.com/TheThingsNetwork/ttn/api"
 	pb "github.com/TheThingsNetwork/ttn/api/handler"
 	"github.com/TheThingsNetwork/ttn/api/stats"
+	"github.com/TheThingsNetwork/ttn/core/handler/functions"
 	"github.com/rcrowley/go-metrics"
 )
 
@@ -51,3 +54,19 @@ func (h *handler) GetStatus() *pb.Status {
 	}
 	return status
 }
+
+func (h *handler) registerLoadSignals() {
+	h.Component.RegisterRateLoadSignal("uplink", h.status.uplink)
+	h.Component.RegisterQueueLoadSignal("mqtt_uplink_queue",
+		func() int { return len(h.mqttUp) },
+		func() int { return cap(h.mqttUp) },
+	)
+	h.Component.RegisterQueueLoadSignal("mqtt_event_queue",
+		func() int { return len(h.mqttEvent) },
+		func() int { return cap(h.mqttEvent) },
+	)
+	// Payload functions are CPU-bound, so they saturate when there is one running for every CPU
+	h.Component.RegisterLoadSignal("payload_functions", func() float64 {
+		return float64(functions.Running()) / float64(runtime.NumCPU())
+	})
+}
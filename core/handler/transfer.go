@@ -0,0 +1,432 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api"
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/handler/functions"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// Transfer states of applications
+const (
+	// TransferIncoming is the state of an application that was imported from another Handler, that still processes its
+	// traffic until the cutover
+	TransferIncoming = "incoming"
+	// TransferOutgoing is the state of an application that was exported to another Handler with a cutover
+	TransferOutgoing = "outgoing"
+)
+
+// TransferBufferSize is the number of uplink messages of an incoming application that are buffered until the
+// application is activated. The oldest messages are dropped when the buffer is full.
+var TransferBufferSize = 1000
+
+var errTransferring = grpc.Errorf(codes.FailedPrecondition, "The application is being transferred to another Handler")
+
+// transfers keeps track of the uplink messages of applications that are transferred between Handlers. During a
+// transfer, the Broker routes the uplink messages of the application to both Handlers. The exporting Handler processes
+// them until the cutover. The importing Handler buffers them, and processes the messages that the exporting Handler did
+// not process when it is activated, so that no messages are lost or processed twice.
+type transfers struct {
+	sync.Mutex
+	lastUplink map[string]int64 // ServerTime of the last processed uplink message of each application
+	outgoing   map[string]bool
+	finished   map[string]bool
+	buffered   map[string][]*pb_broker.DeduplicatedUplinkMessage
+}
+
+// process returns whether the uplink message of the application should be processed, and records its ServerTime
+func (t *transfers) process(appID string, serverTime int64) bool {
+	t.Lock()
+	defer t.Unlock()
+	if t.outgoing[appID] || t.finished[appID] {
+		return false
+	}
+	if t.lastUplink == nil {
+		t.lastUplink = make(map[string]int64)
+	}
+	if serverTime > t.lastUplink[appID] {
+		t.lastUplink[appID] = serverTime
+	}
+	return true
+}
+
+// cutover stops the processing of the uplink messages of the application, and returns the ServerTime of the last
+// processed uplink message, or the current time if no uplink messages were processed
+func (t *transfers) cutover(appID string) int64 {
+	t.Lock()
+	defer t.Unlock()
+	if t.outgoing == nil {
+		t.outgoing = make(map[string]bool)
+	}
+	t.outgoing[appID] = true
+	if last, ok := t.lastUplink[appID]; ok {
+		return last
+	}
+	return time.Now().UnixNano()
+}
+
+// finish marks the application as transferred, so that the uplink messages that the Broker still routes to this
+// Handler are dropped
+func (t *transfers) finish(appID string) {
+	t.Lock()
+	defer t.Unlock()
+	if t.finished == nil {
+		t.finished = make(map[string]bool)
+	}
+	t.finished[appID] = true
+	delete(t.outgoing, appID)
+	delete(t.lastUplink, appID)
+}
+
+// isFinished returns whether the application was transferred to another Handler
+func (t *transfers) isFinished(appID string) bool {
+	t.Lock()
+	defer t.Unlock()
+	return t.finished[appID]
+}
+
+// buffer buffers the uplink message of an incoming application
+func (t *transfers) buffer(uplink *pb_broker.DeduplicatedUplinkMessage) {
+	t.Lock()
+	defer t.Unlock()
+	if t.buffered == nil {
+		t.buffered = make(map[string][]*pb_broker.DeduplicatedUplinkMessage)
+	}
+	buffered := append(t.buffered[uplink.AppId], uplink)
+	if len(buffered) > TransferBufferSize {
+		buffered = buffered[len(buffered)-TransferBufferSize:]
+	}
+	t.buffered[uplink.AppId] = buffered
+}
+
+// activate returns the buffered uplink messages of the application that were received after the cutover, and clears
+// the buffer
+func (t *transfers) activate(appID string, cutover int64) (uplinks []*pb_broker.DeduplicatedUplinkMessage) {
+	t.Lock()
+	defer t.Unlock()
+	for _, uplink := range t.buffered[appID] {
+		if uplink.ServerTime > cutover {
+			uplinks = append(uplinks, uplink)
+		}
+	}
+	delete(t.buffered, appID)
+	delete(t.finished, appID)
+	return uplinks
+}
+
+// acceptTransferUplink returns whether the uplink message should be processed by this Handler. Uplink messages of
+// incoming applications are buffered, and uplink messages of outgoing applications are dropped.
+func (h *handler) acceptTransferUplink(ctx ttnlog.Interface, uplink *pb_broker.DeduplicatedUplinkMessage) bool {
+	app, err := h.applications.Get(uplink.AppId)
+	if err != nil {
+		// Uplink messages of unknown applications fail when the device is retrieved
+		return !h.transfers.isFinished(uplink.AppId)
+	}
+	switch app.Transfer {
+	case TransferIncoming:
+		ctx.Debug("Buffered uplink of incoming application")
+		h.transfers.buffer(uplink)
+		return false
+	case TransferOutgoing:
+		ctx.Debug("Dropped uplink of outgoing application")
+		return false
+	}
+	return h.transfers.process(uplink.AppId, uplink.ServerTime)
+}
+
+// checkTransfer returns an error if the application is being transferred, so that no activations or downlink messages
+// are accepted that would be lost in the transfer
+func (h *handler) checkTransfer(appID string) error {
+	app, err := h.applications.Get(appID)
+	if err != nil {
+		return nil
+	}
+	if app.Transfer != "" {
+		return errTransferring
+	}
+	return nil
+}
+
+// exportApplication exports the application with its devices and downlink queues. With cutover, the Handler stops
+// processing the traffic of the application, and the export contains the state after the last processed message.
+func (h *handler) exportApplication(appID string, cutover bool) (*pb.ApplicationTransfer, error) {
+	app, err := h.applications.Get(appID)
+	if err != nil {
+		return nil, err
+	}
+	if app.Transfer == TransferIncoming {
+		return nil, errTransferring
+	}
+
+	transfer := &pb.ApplicationTransfer{AppId: appID}
+	if cutover {
+		transfer.Cutover = h.transfers.cutover(appID)
+		if app.Transfer != TransferOutgoing {
+			app.StartUpdate()
+			app.Transfer = TransferOutgoing
+			if err := h.applications.Set(app); err != nil {
+				return nil, err
+			}
+			h.Ctx.WithField("AppID", appID).Info("Cutover of application transfer")
+		}
+	}
+
+	exported := *app
+	exported.Transfer = ""
+	transfer.Application, err = json.Marshal(exported)
+	if err != nil {
+		return nil, err
+	}
+
+	devices, err := h.devices.ListForApp(appID, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, dev := range devices {
+		deviceTransfer := &pb.DeviceTransfer{DevId: dev.DevID}
+		if deviceTransfer.Device, err = json.Marshal(dev); err != nil {
+			return nil, err
+		}
+		queue, err := h.devices.DownlinkQueue(appID, dev.DevID)
+		if err != nil {
+			return nil, err
+		}
+		downlinks, err := queue.All()
+		if err != nil {
+			return nil, err
+		}
+		if len(downlinks) > 0 {
+			if deviceTransfer.DownlinkQueue, err = json.Marshal(downlinks); err != nil {
+				return nil, err
+			}
+		}
+		transfer.Devices = append(transfer.Devices, deviceTransfer)
+	}
+
+	return transfer, nil
+}
+
+// importApplication stores the application with its devices and downlink queues that were exported by another
+// Handler, replacing the state of a previous import. Devices of a previous import that are not in the transfer are
+// deleted. It returns whether the application was new on this Handler. Until the application is activated, its
+// traffic is buffered. When activated, the buffered uplink messages that arrived after the cutover are processed.
+func (h *handler) importApplication(transfer *pb.ApplicationTransfer) (created bool, err error) {
+	existing, err := h.applications.Get(transfer.AppId)
+	if err != nil && errors.GetErrType(err) != errors.NotFound {
+		return false, err
+	}
+	if existing != nil && existing.Transfer != TransferIncoming {
+		return false, errors.NewErrAlreadyExists("Application")
+	}
+
+	app := new(application.Application)
+	if err := json.Unmarshal(transfer.Application, app); err != nil {
+		return false, errors.NewErrInvalidArgument("Application", err.Error())
+	}
+	if app.AppID != transfer.AppId {
+		return false, errors.NewErrInvalidArgument("Application", "does not match the AppId")
+	}
+
+	devices := make(map[string]*device.Device, len(transfer.Devices))
+	downlinks := make(map[string][]*types.DownlinkMessage, len(transfer.Devices))
+	for _, deviceTransfer := range transfer.Devices {
+		dev := new(device.Device)
+		if err := json.Unmarshal(deviceTransfer.Device, dev); err != nil {
+			return false, errors.NewErrInvalidArgument("Device", err.Error())
+		}
+		if dev.AppID != transfer.AppId || dev.DevID != deviceTransfer.DevId {
+			return false, errors.NewErrInvalidArgument("Device", "does not match the AppId and DevId")
+		}
+		if len(deviceTransfer.DownlinkQueue) > 0 {
+			var queue []*types.DownlinkMessage
+			if err := json.Unmarshal(deviceTransfer.DownlinkQueue, &queue); err != nil {
+				return false, errors.NewErrInvalidArgument("DownlinkQueue", err.Error())
+			}
+			downlinks[dev.DevID] = queue
+		}
+		devices[dev.DevID] = dev
+	}
+
+	if existing != nil {
+		stale, err := h.devices.ListForApp(transfer.AppId, nil)
+		if err != nil {
+			return false, err
+		}
+		for _, dev := range stale {
+			if _, ok := devices[dev.DevID]; ok {
+				continue
+			}
+			if err := h.devices.Delete(dev.AppID, dev.DevID); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	for devID, dev := range devices {
+		if err := h.devices.Set(dev); err != nil {
+			return false, err
+		}
+		queue, err := h.devices.DownlinkQueue(transfer.AppId, devID)
+		if err != nil {
+			return false, err
+		}
+		if _, err := queue.Clear(); err != nil {
+			return false, err
+		}
+		for _, downlink := range downlinks[devID] {
+			if err := queue.PushLast(downlink); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	app.Transfer = TransferIncoming
+	if transfer.Activate {
+		app.Transfer = ""
+	}
+	if err := h.applications.Set(app); err != nil {
+		return false, err
+	}
+	functions.Invalidate(transfer.AppId)
+
+	ctx := h.Ctx.WithFields(ttnlog.Fields{"AppID": transfer.AppId, "NumDevices": len(devices)})
+	if !transfer.Activate {
+		ctx.Info("Imported application transfer")
+		return existing == nil, nil
+	}
+
+	uplinks := h.transfers.activate(transfer.AppId, transfer.Cutover)
+	ctx.WithField("NumUplinks", len(uplinks)).Info("Activated application transfer")
+	go func() {
+		for _, uplink := range uplinks {
+			h.HandleUplink(uplink)
+		}
+	}()
+
+	return existing == nil, nil
+}
+
+// finishApplicationTransfer removes an outgoing application and its devices from the stores of this Handler. The
+// devices are not deleted from the Broker and NetworkServer, as they are now handled by the importing Handler.
+func (h *handler) finishApplicationTransfer(appID string) error {
+	app, err := h.applications.Get(appID)
+	if err != nil {
+		return err
+	}
+	if app.Transfer != TransferOutgoing {
+		return grpc.Errorf(codes.FailedPrecondition, "The application was not exported with a cutover")
+	}
+	devices, err := h.devices.ListForApp(appID, nil)
+	if err != nil {
+		return err
+	}
+	for _, dev := range devices {
+		if err := h.devices.Delete(dev.AppID, dev.DevID); err != nil {
+			return err
+		}
+	}
+	if err := h.applications.Delete(appID); err != nil {
+		return err
+	}
+	functions.Invalidate(appID)
+	h.transfers.finish(appID)
+	h.Ctx.WithField("AppID", appID).Info("Finished application transfer")
+	return nil
+}
+
+func (h *handlerManager) ExportApplication(ctx context.Context, in *pb.ExportApplicationRequest) (*pb.ApplicationTransfer, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Export Application Request")
+	}
+	if in.Cutover {
+		if err := h.checkWritable(); err != nil {
+			return nil, err
+		}
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.AppSettings)
+	if err != nil {
+		return nil, err
+	}
+	return h.handler.exportApplication(in.AppId, in.Cutover)
+}
+
+func (h *handlerManager) ImportApplication(ctx context.Context, in *pb.ApplicationTransfer) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Transfer")
+	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.AppSettings)
+	if err != nil {
+		return nil, err
+	}
+	created, err := h.handler.importApplication(in)
+	if err != nil {
+		return nil, err
+	}
+	// The Broker routes the traffic of the application to both Handlers as soon as this Handler is announced
+	if created {
+		h.announceApplication(ctx, in.AppId)
+	}
+	return &empty.Empty{}, nil
+}
+
+func (h *handlerManager) FinishApplicationTransfer(ctx context.Context, in *pb.ApplicationIdentifier) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Identifier")
+	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.AppSettings)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.handler.finishApplicationTransfer(in.AppId); err != nil {
+		return nil, err
+	}
+
+	// Delete the MQTT credentials of the collaborators, they are created again on the importing Handler
+	_, _, err = h.handler.syncMQTTCredentials(in.AppId, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	token, _ := api.TokenFromContext(ctx)
+	err = h.handler.Discovery.RemoveAppID(in.AppId, token)
+	if err != nil {
+		h.handler.Ctx.WithField("AppID", in.AppId).WithError(errors.FromGRPCError(err)).Warn("Could not unregister Application from Discovery")
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,149 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestTransfers(t *testing.T) {
+	a := New(t)
+	var transfers transfers
+
+	a.So(transfers.process("app", 10), ShouldBeTrue)
+	a.So(transfers.process("app", 30), ShouldBeTrue)
+	a.So(transfers.process("app", 20), ShouldBeTrue)
+	a.So(transfers.cutover("app"), ShouldEqual, 30)
+	a.So(transfers.process("app", 40), ShouldBeFalse)
+	a.So(transfers.cutover("other"), ShouldBeGreaterThan, 0)
+
+	TransferBufferSize = 3
+	defer func() {
+		TransferBufferSize = 1000
+	}()
+	for _, serverTime := range []int64{10, 20, 30, 40, 50} {
+		transfers.buffer(&pb_broker.DeduplicatedUplinkMessage{AppId: "incoming", ServerTime: serverTime})
+	}
+	uplinks := transfers.activate("incoming", 35)
+	a.So(uplinks, ShouldHaveLength, 2)
+	a.So(uplinks[0].ServerTime, ShouldEqual, 40)
+	a.So(uplinks[1].ServerTime, ShouldEqual, 50)
+	a.So(transfers.activate("incoming", 35), ShouldBeEmpty)
+
+	transfers.finish("app")
+	a.So(transfers.isFinished("app"), ShouldBeTrue)
+	a.So(transfers.process("app", 50), ShouldBeFalse)
+}
+
+func TestApplicationTransfer(t *testing.T) {
+	a := New(t)
+	appID, devID := "AppID-1", "DevID-1"
+
+	source := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestApplicationTransfer")},
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-transfer-source"),
+		devices:      device.NewRedisDeviceStore(GetRedisClient(), "handler-test-transfer-source"),
+	}
+	target := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestApplicationTransfer")},
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-transfer-target"),
+		devices:      device.NewRedisDeviceStore(GetRedisClient(), "handler-test-transfer-target"),
+	}
+	defer func() {
+		for _, h := range []*handler{source, target} {
+			h.devices.Delete(appID, devID)
+			h.devices.Delete(appID, "DevID-2")
+			h.applications.Delete(appID)
+		}
+	}()
+
+	_, err := source.exportApplication(appID, false)
+	a.So(err, ShouldNotBeNil)
+
+	a.So(source.applications.Set(&application.Application{
+		AppID:   appID,
+		Decoder: `function Decoder (data) { return { temperature: data[0] }; }`,
+	}), ShouldBeNil)
+	a.So(source.devices.Set(&device.Device{
+		AppID:   appID,
+		DevID:   devID,
+		DevAddr: types.DevAddr{0x26, 0x01, 0x23, 0x45},
+		AppSKey: types.AppSKey{0x01, 0x02},
+		FCntUp:  42,
+	}), ShouldBeNil)
+	queue, _ := source.devices.DownlinkQueue(appID, devID)
+	a.So(queue.PushLast(&types.DownlinkMessage{AppID: appID, DevID: devID, PayloadRaw: []byte{0xaa}}), ShouldBeNil)
+
+	// Snapshot without cutover
+	transfer, err := source.exportApplication(appID, false)
+	a.So(err, ShouldBeNil)
+	a.So(transfer.Devices, ShouldHaveLength, 1)
+	a.So(transfer.Cutover, ShouldEqual, 0)
+	a.So(source.checkTransfer(appID), ShouldBeNil)
+
+	created, err := target.importApplication(transfer)
+	a.So(err, ShouldBeNil)
+	a.So(created, ShouldBeTrue)
+	app, _ := target.applications.Get(appID)
+	a.So(app.Transfer, ShouldEqual, TransferIncoming)
+	a.So(app.Decoder, ShouldContainSubstring, "temperature")
+	dev, _ := target.devices.Get(appID, devID)
+	a.So(dev.DevAddr, ShouldResemble, types.DevAddr{0x26, 0x01, 0x23, 0x45})
+	a.So(dev.AppSKey, ShouldResemble, types.AppSKey{0x01, 0x02})
+	a.So(dev.FCntUp, ShouldEqual, 42)
+	targetQueue, _ := target.devices.DownlinkQueue(appID, devID)
+	length, _ := targetQueue.Length()
+	a.So(length, ShouldEqual, 1)
+	a.So(target.checkTransfer(appID), ShouldEqual, errTransferring)
+
+	// The source processes the traffic until the cutover, the target buffers it
+	a.So(source.acceptTransferUplink(source.Ctx, &pb_broker.DeduplicatedUplinkMessage{AppId: appID, ServerTime: 10}), ShouldBeTrue)
+
+	// Changes on the source after the snapshot
+	a.So(source.devices.Set(&device.Device{AppID: appID, DevID: "DevID-2"}), ShouldBeNil)
+	queue.PushLast(&types.DownlinkMessage{AppID: appID, DevID: devID, PayloadRaw: []byte{0xbb}})
+
+	// Cutover
+	transfer, err = source.exportApplication(appID, true)
+	a.So(err, ShouldBeNil)
+	a.So(transfer.Cutover, ShouldEqual, 10)
+	a.So(transfer.Devices, ShouldHaveLength, 2)
+	app, _ = source.applications.Get(appID)
+	a.So(app.Transfer, ShouldEqual, TransferOutgoing)
+	a.So(source.acceptTransferUplink(source.Ctx, &pb_broker.DeduplicatedUplinkMessage{AppId: appID, ServerTime: 20}), ShouldBeFalse)
+	a.So(source.checkTransfer(appID), ShouldEqual, errTransferring)
+
+	transfer.Activate = true
+	created, err = target.importApplication(transfer)
+	a.So(err, ShouldBeNil)
+	a.So(created, ShouldBeFalse)
+	app, _ = target.applications.Get(appID)
+	a.So(app.Transfer, ShouldBeEmpty)
+	_, err = target.devices.Get(appID, "DevID-2")
+	a.So(err, ShouldBeNil)
+	length, _ = targetQueue.Length()
+	a.So(length, ShouldEqual, 2)
+	a.So(target.checkTransfer(appID), ShouldBeNil)
+
+	// An active application can not be replaced by an import
+	_, err = target.importApplication(transfer)
+	a.So(err, ShouldNotBeNil)
+
+	// Finish
+	a.So(target.finishApplicationTransfer(appID), ShouldNotBeNil)
+	a.So(source.finishApplicationTransfer(appID), ShouldBeNil)
+	_, err = source.applications.Get(appID)
+	a.So(err, ShouldNotBeNil)
+	_, err = source.devices.Get(appID, devID)
+	a.So(err, ShouldNotBeNil)
+	a.So(source.acceptTransferUplink(source.Ctx, &pb_broker.DeduplicatedUplinkMessage{AppId: appID, ServerTime: 30}), ShouldBeFalse)
+}
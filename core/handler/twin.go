@@ -0,0 +1,173 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// TwinPushInterval is the number of uplink messages after which the pending fields of a twin are sent again if the
+// device did not report them
+var TwinPushInterval uint32 = 3
+
+// ReportTwin updates the reported fields in the twin of the device with the payload fields of the uplink message
+func (h *handler) ReportTwin(ctx ttnlog.Interface, _ *pb_broker.DeduplicatedUplinkMessage, appUp *types.UplinkMessage, dev *device.Device) error {
+	if dev.Twin == nil || appUp.PayloadFields == nil || appUp.IsRetry {
+		return nil
+	}
+	twin := dev.Twin.Copy()
+	if twin.Report(appUp.PayloadFields, time.Now()) {
+		dev.Twin = twin
+	}
+	return nil
+}
+
+// pushTwin enqueues a downlink with the pending fields in the twin of the device when the desired fields changed, or
+// when the device did not report them within TwinPushInterval uplink messages after they were sent
+func (h *handler) pushTwin(ctx ttnlog.Interface, dev *device.Device) {
+	if dev.Twin == nil {
+		return
+	}
+	pending := dev.Twin.PendingFields()
+	if len(pending) == 0 {
+		return
+	}
+	twin := dev.Twin.Copy()
+	dev.Twin = twin
+	if !twin.PushedAt.Before(twin.DesiredAt) && twin.UplinksSincePush < TwinPushInterval {
+		twin.UplinksSincePush++
+		return
+	}
+	err := h.EnqueueDownlink(&types.DownlinkMessage{
+		AppID:         dev.AppID,
+		DevID:         dev.DevID,
+		FPort:         twin.FPort,
+		Schedule:      types.ScheduleLast,
+		PayloadFields: pending,
+	})
+	if err != nil {
+		ctx.WithError(err).Warn("Could not enqueue twin downlink")
+		return
+	}
+	twin.PushedAt = time.Now()
+	twin.UplinksSincePush = 0
+}
+
+func (h *handlerManager) SetDeviceTwin(ctx context.Context, in *pb.DeviceTwinRequest) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Twin Request")
+	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.Devices)
+	if err != nil {
+		return nil, err
+	}
+
+	dev, err := h.handler.devices.Get(in.AppId, in.DevId)
+	if err != nil {
+		return nil, err
+	}
+	dev.StartUpdate()
+
+	var desired map[string]interface{}
+	if in.DesiredFields != "" {
+		if err := json.Unmarshal([]byte(in.DesiredFields), &desired); err != nil {
+			return nil, errors.NewErrInvalidArgument("DesiredFields", err.Error())
+		}
+	}
+
+	if len(desired) == 0 {
+		dev.Twin = nil
+	} else {
+		attributes := dev.Attributes
+		if attributes == nil {
+			attributes = map[string]string{}
+		}
+		if err := renderAttributes(desired, attributes); err != nil {
+			return nil, err
+		}
+		twin := &device.Twin{}
+		if dev.Twin != nil {
+			twin = dev.Twin.Copy()
+		}
+		twin.FPort = uint8(in.FPort)
+		twin.Desired = desired
+		twin.DesiredAt = time.Now()
+		twin.UplinksSincePush = 0
+		dev.Twin = twin
+	}
+
+	if err := h.handler.devices.Set(dev); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (h *handlerManager) GetDeviceTwin(ctx context.Context, in *pb.DeviceIdentifier) (*pb.DeviceTwin, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Identifier")
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.Devices)
+	if err != nil {
+		return nil, err
+	}
+
+	dev, err := h.handler.devices.Get(in.AppId, in.DevId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.DeviceTwin{
+		AppId:  in.AppId,
+		DevId:  in.DevId,
+		InSync: true,
+	}
+	if twin := dev.Twin; twin != nil {
+		desired, err := json.Marshal(twin.Desired)
+		if err != nil {
+			return nil, err
+		}
+		res.FPort = uint32(twin.FPort)
+		res.DesiredFields = string(desired)
+		if len(twin.Reported) > 0 {
+			reported, err := json.Marshal(twin.Reported)
+			if err != nil {
+				return nil, err
+			}
+			res.ReportedFields = string(reported)
+		}
+		res.Pending = twin.Pending()
+		res.InSync = len(res.Pending) == 0
+		res.DesiredAt = twin.DesiredAt.UnixNano()
+		if !twin.ReportedAt.IsZero() {
+			res.ReportedAt = twin.ReportedAt.UnixNano()
+		}
+		if !twin.PushedAt.IsZero() {
+			res.PushedAt = twin.PushedAt.UnixNano()
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,75 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestTwin(t *testing.T) {
+	a := New(t)
+	appID := "app1"
+	devID := "dev1"
+	h := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestTwin")},
+		devices:      device.NewRedisDeviceStore(GetRedisClient(), "handler-test-twin"),
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-twin"),
+		mqttEvent:    make(chan *types.DeviceEvent, 10),
+	}
+	dev := &device.Device{
+		AppID: appID,
+		DevID: devID,
+		Twin: &device.Twin{
+			FPort:     2,
+			Desired:   map[string]interface{}{"interval": 60.0},
+			DesiredAt: time.Now(),
+		},
+	}
+	h.devices.Set(dev)
+	defer func() {
+		h.devices.Delete(appID, devID)
+	}()
+	queue, _ := h.devices.DownlinkQueue(appID, devID)
+
+	// The desired fields are sent after they changed
+	h.pushTwin(GetLogger(t, "TestTwin"), dev)
+	qLen, _ := queue.Length()
+	a.So(qLen, ShouldEqual, 1)
+	a.So(dev.Twin.PushedAt.IsZero(), ShouldBeFalse)
+	next, _ := queue.Next()
+	a.So(next.FPort, ShouldEqual, 2)
+	a.So(next.PayloadFields, ShouldResemble, map[string]interface{}{"interval": 60.0})
+
+	// The device did not report the desired fields yet
+	err := h.ReportTwin(GetLogger(t, "TestTwin"), nil, &types.UplinkMessage{PayloadFields: map[string]interface{}{"interval": 300}}, dev)
+	a.So(err, ShouldBeNil)
+	a.So(dev.Twin.Pending(), ShouldResemble, []string{"interval"})
+
+	// The pending fields are sent again after TwinPushInterval uplinks
+	for i := uint32(0); i < TwinPushInterval; i++ {
+		h.pushTwin(GetLogger(t, "TestTwin"), dev)
+	}
+	qLen, _ = queue.Length()
+	a.So(qLen, ShouldEqual, 0)
+	h.pushTwin(GetLogger(t, "TestTwin"), dev)
+	qLen, _ = queue.Length()
+	a.So(qLen, ShouldEqual, 1)
+
+	// Retries do not report fields
+	err = h.ReportTwin(GetLogger(t, "TestTwin"), nil, &types.UplinkMessage{IsRetry: true, PayloadFields: map[string]interface{}{"interval": 60}}, dev)
+	a.So(err, ShouldBeNil)
+	a.So(dev.Twin.InSync(), ShouldBeFalse)
+
+	err = h.ReportTwin(GetLogger(t, "TestTwin"), nil, &types.UplinkMessage{PayloadFields: map[string]interface{}{"interval": 60}}, dev)
+	a.So(err, ShouldBeNil)
+	a.So(dev.Twin.InSync(), ShouldBeTrue)
+}
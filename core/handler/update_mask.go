@@ -0,0 +1,216 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"strings"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// mergeApplication returns the stored application, updated with the fields of in that are in its update mask
+func (h *handlerManager) mergeApplication(ctx context.Context, in *pb.Application) (*pb.Application, error) {
+	app, err := h.GetApplication(ctx, &pb.ApplicationIdentifier{AppId: in.AppId})
+	if err != nil {
+		return nil, err
+	}
+	if err := applyApplicationUpdateMask(app, in, in.UpdateMask); err != nil {
+		return nil, err
+	}
+	// Partial updates only have to supply a revision if they depend on the current settings
+	if in.Revision != 0 {
+		app.Revision = in.Revision
+	}
+	app.DryRun = in.DryRun
+	return app, nil
+}
+
+// mergeDevice returns the stored device, updated with the fields of in that are in its update mask
+func (h *handlerManager) mergeDevice(ctx context.Context, in *pb.Device) (*pb.Device, error) {
+	dev, err := h.GetDevice(ctx, &pb.DeviceIdentifier{AppId: in.AppId, DevId: in.DevId})
+	if err != nil {
+		return nil, err
+	}
+	if err := applyDeviceUpdateMask(dev, in, in.UpdateMask); err != nil {
+		return nil, err
+	}
+	// Partial updates only have to supply a revision if they depend on the current settings
+	if in.Revision != 0 {
+		dev.Revision = in.Revision
+	}
+	dev.DryRun = in.DryRun
+	return dev, nil
+}
+
+// applyApplicationUpdateMask copies the fields in paths from src to dst
+func applyApplicationUpdateMask(dst, src *pb.Application, paths []string) error {
+	for _, path := range paths {
+		switch path {
+		case "decoder":
+			dst.Decoder = src.Decoder
+		case "converter":
+			dst.Converter = src.Converter
+		case "validator":
+			dst.Validator = src.Validator
+		case "encoder":
+			dst.Encoder = src.Encoder
+		case "port_functions":
+			dst.PortFunctions = src.PortFunctions
+		case "downlink_decoder":
+			dst.DownlinkDecoder = src.DownlinkDecoder
+		case "normalizer":
+			dst.Normalizer = src.Normalizer
+		case "join_hook":
+			dst.JoinHook = src.JoinHook
+		case "provisioning_downlink":
+			dst.ProvisioningDownlink = src.ProvisioningDownlink
+		case "anomaly_detection":
+			dst.AnomalyDetection = src.AnomalyDetection
+		case "computed_fields":
+			dst.ComputedFields = src.ComputedFields
+		case "output_policy":
+			dst.OutputPolicy = src.OutputPolicy
+		case "aggregation":
+			dst.Aggregation = src.Aggregation
+		case "integration_format":
+			dst.IntegrationFormat = src.IntegrationFormat
+		case "dev_addr_allocation":
+			dst.DevAddrAllocation = src.DevAddrAllocation
+		case "retention_days":
+			dst.RetentionDays = src.RetentionDays
+		case "sensitive_fields":
+			dst.SensitiveFields = src.SensitiveFields
+		case "payload_format":
+			dst.PayloadFormat = src.PayloadFormat
+		case "maintenance_windows":
+			dst.MaintenanceWindows = src.MaintenanceWindows
+		case "function_timeout":
+			dst.FunctionTimeout = src.FunctionTimeout
+		case "max_output_fields":
+			dst.MaxOutputFields = src.MaxOutputFields
+		case "max_output_depth":
+			dst.MaxOutputDepth = src.MaxOutputDepth
+		case "max_output_size":
+			dst.MaxOutputSize = src.MaxOutputSize
+		case "wasm_module":
+			dst.WasmModule = src.WasmModule
+		case "binary_fields":
+			dst.BinaryFields = src.BinaryFields
+		case "functions_language":
+			dst.FunctionsLanguage = src.FunctionsLanguage
+		case "protobuf_descriptor":
+			dst.ProtobufDescriptor = src.ProtobufDescriptor
+		case "protobuf_message":
+			dst.ProtobufMessage = src.ProtobufMessage
+		case "go_codec":
+			dst.GoCodec = src.GoCodec
+		case "data_residency":
+			dst.DataResidency = src.DataResidency
+		case "stateful_decoding":
+			dst.StatefulDecoding = src.StatefulDecoding
+		case "codec":
+			dst.Codec = src.Codec
+		default:
+			return errors.NewErrInvalidArgument("UpdateMask", "unknown field "+path)
+		}
+	}
+	return nil
+}
+
+// applyDeviceUpdateMask copies the fields in paths from src to dst. Single attributes can be updated with the
+// attributes.<key> path; the attribute is removed if src does not have it.
+func applyDeviceUpdateMask(dst, src *pb.Device, paths []string) error {
+	for _, path := range paths {
+		if strings.HasPrefix(path, "attributes.") {
+			key := strings.TrimPrefix(path, "attributes.")
+			if value, ok := src.Attributes[key]; ok {
+				if dst.Attributes == nil {
+					dst.Attributes = make(map[string]string)
+				}
+				dst.Attributes[key] = value
+			} else {
+				delete(dst.Attributes, key)
+			}
+			continue
+		}
+		if strings.HasPrefix(path, "lorawan_device.") {
+			if err := applyLoRaWANDeviceUpdateMask(dst.GetLorawanDevice(), src.GetLorawanDevice(), strings.TrimPrefix(path, "lorawan_device.")); err != nil {
+				return err
+			}
+			continue
+		}
+		switch path {
+		case "description":
+			dst.Description = src.Description
+		case "attributes":
+			dst.Attributes = src.Attributes
+		case "latitude":
+			dst.Latitude = src.Latitude
+		case "longitude":
+			dst.Longitude = src.Longitude
+		case "altitude":
+			dst.Altitude = src.Altitude
+		case "profile":
+			dst.Profile = src.Profile
+		case "payload_format":
+			dst.PayloadFormat = src.PayloadFormat
+		case "codec":
+			dst.Codec = src.Codec
+		case "go_codec":
+			dst.GoCodec = src.GoCodec
+		case "lorawan_device":
+			if src.GetLorawanDevice() == nil {
+				return errors.NewErrInvalidArgument("Device", "No LoRaWAN Device")
+			}
+			dst.Device = src.Device
+		default:
+			return errors.NewErrInvalidArgument("UpdateMask", "unknown field "+path)
+		}
+	}
+	return nil
+}
+
+func applyLoRaWANDeviceUpdateMask(dst, src *pb_lorawan.Device, path string) error {
+	if dst == nil || src == nil {
+		return errors.NewErrInvalidArgument("Device", "No LoRaWAN Device")
+	}
+	switch path {
+	case "app_eui":
+		dst.AppEui = src.AppEui
+	case "dev_eui":
+		dst.DevEui = src.DevEui
+	case "dev_addr":
+		dst.DevAddr = src.DevAddr
+	case "nwk_s_key":
+		dst.NwkSKey = src.NwkSKey
+	case "app_s_key":
+		dst.AppSKey = src.AppSKey
+	case "app_key":
+		dst.AppKey = src.AppKey
+	case "f_cnt_up":
+		dst.FCntUp = src.FCntUp
+	case "f_cnt_down":
+		dst.FCntDown = src.FCntDown
+	case "disable_f_cnt_check":
+		dst.DisableFCntCheck = src.DisableFCntCheck
+	case "uses32_bit_f_cnt":
+		dst.Uses32BitFCnt = src.Uses32BitFCnt
+	case "activation_constraints":
+		dst.ActivationConstraints = src.ActivationConstraints
+	case "resets_f_cnt":
+		dst.ResetsFCnt = src.ResetsFCnt
+	case "rx_window":
+		dst.RxWindow = src.RxWindow
+	case "relay":
+		dst.Relay = src.Relay
+	case "regional_parameters":
+		dst.RegionalParameters = src.RegionalParameters
+	default:
+		return errors.NewErrInvalidArgument("UpdateMask", "unknown field lorawan_device."+path)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestApplyApplicationUpdateMask(t *testing.T) {
+	a := New(t)
+
+	dst := &pb.Application{
+		AppId:         "appid",
+		Decoder:       "decoder",
+		Encoder:       "encoder",
+		RetentionDays: 30,
+	}
+	src := &pb.Application{
+		AppId:   "appid",
+		Decoder: "new decoder",
+	}
+
+	err := applyApplicationUpdateMask(dst, src, []string{"decoder", "retention_days"})
+	a.So(err, ShouldBeNil)
+	a.So(dst.Decoder, ShouldEqual, "new decoder")
+	a.So(dst.Encoder, ShouldEqual, "encoder")
+	a.So(dst.RetentionDays, ShouldEqual, 0)
+
+	err = applyApplicationUpdateMask(dst, src, []string{"unknown"})
+	a.So(errors.GetErrType(err), ShouldEqual, errors.InvalidArgument)
+}
+
+func TestApplyDeviceUpdateMask(t *testing.T) {
+	a := New(t)
+
+	appKey := types.AppKey{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+	newAppKey := types.AppKey{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1}
+
+	dst := &pb.Device{
+		AppId:       "appid",
+		DevId:       "devid",
+		Description: "description",
+		Attributes:  map[string]string{"floor": "1", "room": "101"},
+		Device: &pb.Device_LorawanDevice{LorawanDevice: &pb_lorawan.Device{
+			AppKey: &appKey,
+			FCntUp: 42,
+		}},
+	}
+	src := &pb.Device{
+		AppId:      "appid",
+		DevId:      "devid",
+		Attributes: map[string]string{"floor": "2"},
+		Device: &pb.Device_LorawanDevice{LorawanDevice: &pb_lorawan.Device{
+			AppKey: &newAppKey,
+		}},
+	}
+
+	// Single attributes
+	err := applyDeviceUpdateMask(dst, src, []string{"attributes.floor", "attributes.room"})
+	a.So(err, ShouldBeNil)
+	a.So(dst.Attributes, ShouldResemble, map[string]string{"floor": "2"})
+	a.So(dst.Description, ShouldEqual, "description")
+
+	// LoRaWAN fields
+	err = applyDeviceUpdateMask(dst, src, []string{"lorawan_device.app_key"})
+	a.So(err, ShouldBeNil)
+	a.So(*dst.GetLorawanDevice().AppKey, ShouldEqual, newAppKey)
+	a.So(dst.GetLorawanDevice().FCntUp, ShouldEqual, 42)
+
+	// LoRaWAN fields without LoRaWAN device
+	err = applyDeviceUpdateMask(dst, &pb.Device{}, []string{"lorawan_device.app_key"})
+	a.So(errors.GetErrType(err), ShouldEqual, errors.InvalidArgument)
+
+	err = applyDeviceUpdateMask(dst, src, []string{"lorawan_device.unknown"})
+	a.So(errors.GetErrType(err), ShouldEqual, errors.InvalidArgument)
+
+	err = applyDeviceUpdateMask(dst, src, []string{"description"})
+	a.So(err, ShouldBeNil)
+	a.So(dst.Description, ShouldBeEmpty)
+}
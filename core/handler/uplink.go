@@ -19,32 +19,48 @@ func (h *handler) HandleUplink(uplink *pb_broker.DeduplicatedUplinkMessage) (err
 	appID, devID := uplink.AppId, uplink.DevId
 	ctx := h.Ctx.WithFields(fields.Get(uplink))
 	start := time.Now()
+	var shed shedWork
 	defer func() {
 		if err != nil {
-			h.mqttEvent <- &types.DeviceEvent{
-				AppID: appID,
-				DevID: devID,
-				Event: types.UplinkErrorEvent,
-				Data:  types.ErrorEventData{Error: err.Error()},
+			// Errors of the payload functions are already published with the payload
+			if _, published := err.(*FunctionError); !published {
+				h.mqttEvent <- &types.DeviceEvent{
+					AppID: appID,
+					DevID: devID,
+					Event: types.UplinkErrorEvent,
+					Data:  types.ErrorEventData{Error: err.Error()},
+				}
 			}
 			ctx.WithError(err).Warn("Could not handle uplink")
 			uplink.Trace = uplink.Trace.WithEvent(trace.DropEvent, "reason", err)
 		} else {
 			ctx.WithField("Duration", time.Now().Sub(start)).Info("Handled uplink")
 		}
-		if uplink != nil && h.monitorStream != nil {
+		if uplink != nil {
+			h.captureDebugTrace(appID, devID, uplink.Trace)
+		}
+		if uplink != nil && h.monitorStream != nil && !shed.Includes(ShedDebugStreams) {
 			h.monitorStream.Send(uplink)
 		}
 	}()
 	h.status.uplink.Mark(1)
 
+	// Shed low-priority work if the handler is overloaded
+	shed = h.shed(ctx, appID)
+
 	uplink.Trace = uplink.Trace.WithEvent(trace.ReceiveEvent)
 
+	// During a transfer of the application, the uplink is processed by only one of the Handlers
+	if !h.acceptTransferUplink(ctx, uplink) {
+		return nil
+	}
+
 	dev, err := h.devices.Get(appID, devID)
 	if err != nil {
 		return err
 	}
 	dev.StartUpdate()
+	h.debugging.set(appID, devID, dev.DebugUntil)
 
 	// Build AppUplink
 	appUplink := &types.UplinkMessage{
@@ -54,12 +70,23 @@ func (h *handler) HandleUplink(uplink *pb_broker.DeduplicatedUplinkMessage) (err
 
 	// Get Uplink Processors
 	processors := []UplinkProcessor{
+		h.EnforceDataResidency,
 		h.ConvertFromLoRaWAN,
 		h.ConvertMetadata,
 		h.ConvertFieldsUp,
 	}
 
-	ctx.WithField("NumProcessors", len(processors)).Debug("Running Uplink Processors")
+	// Get Reading Processors, that run for each reading if the uplink contains buffered readings
+	readingProcessors := []UplinkProcessor{
+		h.ConvertDeviceTime,
+		h.ComputeFields,
+		h.NormalizePayload,
+		h.DetectAnomalies,
+		h.ReportTwin,
+		h.ApplyOutputPolicy,
+	}
+
+	ctx.WithField("NumProcessors", len(processors)+len(readingProcessors)).Debug("Running Uplink Processors")
 	uplink.Trace = uplink.Trace.WithEvent("process uplink")
 
 	// Run Uplink Processors
@@ -73,16 +100,60 @@ func (h *handler) HandleUplink(uplink *pb_broker.DeduplicatedUplinkMessage) (err
 		}
 	}
 
+	// Run Reading Processors
+	appUplinks := expandReadings(appUplink)
+	for _, appUplink := range appUplinks {
+		for _, processor := range readingProcessors {
+			err = processor(ctx, uplink, appUplink, dev)
+			if err == ErrNotNeeded {
+				err = nil
+				return nil
+			} else if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Aggregate Uplinks (if enabled for the application)
+	appUplinks = h.aggregateUplinks(ctx, dev, appUplinks)
+
+	// Queue the provisioning downlink after the first uplink after joining
+	if dev.PendingProvisioning {
+		dev.PendingProvisioning = false
+		h.queueProvisioningDownlink(ctx, dev)
+	}
+
+	// Send the pending fields of the device twin
+	h.pushTwin(ctx, dev)
+
+	dev.LastSeen = time.Now()
 	err = h.devices.Set(dev)
 	if err != nil {
 		return err
 	}
 	dev.StartUpdate()
 
-	// Publish Uplink
-	h.mqttUp <- appUplink
-	if h.amqpEnabled {
-		h.amqpUp <- appUplink
+	// Store Uplink
+	if shed.Includes(ShedStorage) {
+		ctx.Debug("Shed uplink storage")
+	} else if err := h.storeUplink(uplink, appUplink); err != nil {
+		ctx.WithError(err).Warn("Could not store uplink")
+	}
+
+	// Archive Uplink (also if the handler is overloaded, as the archive is used for disaster recovery)
+	h.archiveUplink(ctx, uplink, appUplink)
+
+	// Publish Uplinks (to MQTT even if the handler is overloaded)
+	for _, appUplink := range appUplinks {
+		h.mqttUp <- appUplink
+		if h.amqpEnabled && !shed.Includes(ShedIntegrations) {
+			h.amqpUp <- appUplink
+		}
+		if !shed.Includes(ShedIntegrations) {
+			h.shadowUplink(appUplink)
+			h.webhookUplink(appUplink)
+		}
+		h.commands.notify(appUplink)
 	}
 
 	noDownlinkErrEvent := &types.DeviceEvent{
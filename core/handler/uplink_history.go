@@ -0,0 +1,33 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/types"
+)
+
+// storeUplink stores the uplink in the uplink history of the device, including
+// the metadata of all gateways that received it
+func (h *handler) storeUplink(uplink *pb_broker.DeduplicatedUplinkMessage, appUplink *types.UplinkMessage) error {
+	history, err := h.devices.UplinkHistory(appUplink.AppID, appUplink.DevID)
+	if err != nil {
+		return err
+	}
+	return history.Push(deviceUplink(uplink, appUplink))
+}
+
+// deviceUplink returns the uplink as it is stored in the uplink history and the archive
+func deviceUplink(uplink *pb_broker.DeduplicatedUplinkMessage, appUplink *types.UplinkMessage) *pb.DeviceUplink {
+	return &pb.DeviceUplink{
+		ServerTime:       uplink.ServerTime,
+		Port:             uint32(appUplink.FPort),
+		Counter:          appUplink.FCnt,
+		Confirmed:        appUplink.Confirmed,
+		PayloadRaw:       appUplink.PayloadRaw,
+		ProtocolMetadata: uplink.ProtocolMetadata,
+		GatewayMetadata:  uplink.GatewayMetadata,
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+	"strings"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+)
+
+// StateReport contains the inconsistencies that were found in the stored state of the Handler
+type StateReport struct {
+	// Devices (<AppID>:<DevID>) of applications that do not exist
+	OrphanedDevices []string
+	// Downlink queues and uplink histories (<AppID>:<DevID>) of devices that do not exist
+	OrphanedQueues []string
+	// Repaired is true if the inconsistencies were repaired
+	Repaired bool
+}
+
+// Consistent returns true if no inconsistencies were found
+func (r *StateReport) Consistent() bool {
+	return len(r.OrphanedDevices) == 0 && len(r.OrphanedQueues) == 0
+}
+
+// verifyState verifies the referential integrity of the stored state. If repair is true, the applications of orphaned
+// devices are re-created and orphaned downlink queues and uplink histories are deleted. The re-created applications
+// have empty settings: their payload functions and other settings are lost and have to be configured again.
+func (h *handler) verifyState(repair bool) (*StateReport, error) {
+	report := new(StateReport)
+
+	apps, err := h.applications.List(nil)
+	if err != nil {
+		return nil, err
+	}
+	appIDs := make(map[string]bool)
+	for _, app := range apps {
+		appIDs[app.AppID] = true
+	}
+
+	devs, err := h.devices.List(nil)
+	if err != nil {
+		return nil, err
+	}
+	devIDs := make(map[string]bool)
+	missingApps := make(map[string]bool)
+	for _, dev := range devs {
+		key := fmt.Sprintf("%s:%s", dev.AppID, dev.DevID)
+		devIDs[key] = true
+		if !appIDs[dev.AppID] {
+			report.OrphanedDevices = append(report.OrphanedDevices, key)
+			missingApps[dev.AppID] = true
+		}
+	}
+
+	queues, err := h.devices.ListQueues()
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range queues {
+		if !devIDs[key] {
+			report.OrphanedQueues = append(report.OrphanedQueues, key)
+		}
+	}
+
+	if !repair || report.Consistent() {
+		return report, nil
+	}
+
+	for appID := range missingApps {
+		if err := h.applications.Set(&application.Application{AppID: appID}); err != nil {
+			return report, err
+		}
+	}
+	for _, key := range report.OrphanedQueues {
+		ids := strings.SplitN(key, ":", 2)
+		if len(ids) != 2 {
+			continue
+		}
+		if err := h.devices.Delete(ids[0], ids[1]); err != nil {
+			return report, err
+		}
+	}
+	report.Repaired = true
+
+	return report, nil
+}
+
+// verifyStateOnStart verifies the stored state and logs the inconsistencies that were found
+func (h *handler) verifyStateOnStart() {
+	ctx := h.Ctx.WithField("Repair", h.stateRepair)
+	report, err := h.verifyState(h.stateRepair && !h.readOnly)
+	if err != nil {
+		ctx.WithError(err).Warn("Could not verify stored state")
+		return
+	}
+	if report.Consistent() {
+		ctx.Info("Verified stored state")
+		return
+	}
+	for _, key := range report.OrphanedDevices {
+		ctx.WithField("Device", key).Warn("Device of application that does not exist")
+	}
+	for _, key := range report.OrphanedQueues {
+		ctx.WithField("Device", key).Warn("Downlink queue or uplink history of device that does not exist")
+	}
+	ctx = ctx.WithFields(ttnlog.Fields{
+		"OrphanedDevices": len(report.OrphanedDevices),
+		"OrphanedQueues":  len(report.OrphanedQueues),
+	})
+	if report.Repaired {
+		ctx.Warn("Repaired stored state, re-created applications have empty settings")
+	} else {
+		ctx.Warn("Stored state is inconsistent, run with --repair-state to repair")
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestVerifyState(t *testing.T) {
+	a := New(t)
+
+	h := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestVerifyState")},
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-verify-state"),
+		devices:      device.NewRedisDeviceStore(GetRedisClient(), "handler-test-verify-state"),
+	}
+
+	h.applications.Set(&application.Application{AppID: "app"})
+	h.devices.Set(&device.Device{AppID: "app", DevID: "dev"})
+	h.devices.Set(&device.Device{AppID: "deleted-app", DevID: "dev"})
+	defer func() {
+		h.applications.Delete("app")
+		h.applications.Delete("deleted-app")
+		h.devices.Delete("app", "dev")
+		h.devices.Delete("deleted-app", "dev")
+	}()
+
+	queue, _ := h.devices.DownlinkQueue("app", "dev")
+	queue.PushLast(&types.DownlinkMessage{PayloadRaw: []byte{0x01}})
+	queue, _ = h.devices.DownlinkQueue("app", "deleted-dev")
+	queue.PushLast(&types.DownlinkMessage{PayloadRaw: []byte{0x01}})
+	defer h.devices.Delete("app", "deleted-dev")
+
+	report, err := h.verifyState(false)
+	a.So(err, ShouldBeNil)
+	a.So(report.Consistent(), ShouldBeFalse)
+	a.So(report.OrphanedDevices, ShouldResemble, []string{"deleted-app:dev"})
+	a.So(report.OrphanedQueues, ShouldResemble, []string{"app:deleted-dev"})
+	a.So(report.Repaired, ShouldBeFalse)
+
+	// Nothing is changed without repair
+	report, err = h.verifyState(false)
+	a.So(err, ShouldBeNil)
+	a.So(report.OrphanedDevices, ShouldHaveLength, 1)
+	a.So(report.OrphanedQueues, ShouldHaveLength, 1)
+
+	report, err = h.verifyState(true)
+	a.So(err, ShouldBeNil)
+	a.So(report.Repaired, ShouldBeTrue)
+
+	app, err := h.applications.Get("deleted-app")
+	a.So(err, ShouldBeNil)
+	a.So(app.AppID, ShouldEqual, "deleted-app")
+
+	queue, _ = h.devices.DownlinkQueue("app", "dev")
+	length, _ := queue.Length()
+	a.So(length, ShouldEqual, 1)
+
+	report, err = h.verifyState(true)
+	a.So(err, ShouldBeNil)
+	a.So(report.Consistent(), ShouldBeTrue)
+	a.So(report.Repaired, ShouldBeFalse)
+}
@@ -0,0 +1,51 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"encoding/json"
+
+	"github.com/TheThingsNetwork/ttn/core/handler/functions"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// decodeWASM decodes the payload with the decode function of the WebAssembly module, which returns the fields as a
+// JSON object, or buffered readings as a JSON array of objects
+func (f *UplinkFunctions) decodeWASM(payload []byte, port uint8) (map[string]interface{}, error) {
+	if len(f.WASMModule) == 0 {
+		return nil, nil
+	}
+
+	out, err := functions.RunWASM(f.AppID, f.WASMModule, "decode", payload, port, orDefaultTimeout(f.Timeout), f.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(out, &v); err != nil {
+		return nil, errors.NewErrInvalidArgument("decode", "does not return JSON")
+	}
+	if m, ok := v.(map[string]interface{}); ok {
+		return m, nil
+	}
+	if _, ok := readingsOf(v); ok {
+		return map[string]interface{}{ReadingsField: v}, nil
+	}
+	return nil, errors.NewErrInvalidArgument("decode", "does not return an object or an array of objects")
+}
+
+// encodeWASM encodes the fields with the encode function of the WebAssembly module, which gets the fields as a JSON
+// object and returns the payload
+func (f *DownlinkFunctions) encodeWASM(fields map[string]interface{}, port uint8) ([]byte, error) {
+	if len(f.WASMModule) == 0 {
+		return nil, errors.NewErrInvalidArgument("Downlink Payload", "fields supplied, but no WebAssembly module set")
+	}
+
+	in, err := json.Marshal(fields)
+	if err != nil {
+		return nil, errors.NewErrInvalidArgument("Fields", err.Error())
+	}
+
+	return functions.RunWASM(f.AppID, f.WASMModule, "encode", in, port, orDefaultTimeout(f.Timeout), f.Logger)
+}
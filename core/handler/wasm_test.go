@@ -0,0 +1,34 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestWASMPayloadFormat(t *testing.T) {
+	a := New(t)
+
+	// Without a module, the payload is not decoded, but the validator still runs
+	up := &UplinkFunctions{
+		PayloadFormat: pb.PayloadFormatWASM,
+		Decoder:       `function Decoder(bytes) { return { ignored: true }; }`,
+		Validator:     `function Validator(fields) { return true; }`,
+	}
+	fields, valid, err := up.Process([]byte{0x01}, 1)
+	a.So(err, ShouldBeNil)
+	a.So(valid, ShouldBeTrue)
+	a.So(fields, ShouldBeNil)
+
+	down := &DownlinkFunctions{
+		PayloadFormat: pb.PayloadFormatWASM,
+		Encoder:       `function Encoder(fields) { return [1]; }`,
+	}
+	_, _, err = down.Process(map[string]interface{}{"led": true}, 1)
+	a.So(err, ShouldNotBeNil)
+}
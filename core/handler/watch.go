@@ -0,0 +1,110 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"time"
+
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/random"
+)
+
+// WatchPollInterval indicates how often the device registry changes are checked for watchers
+var WatchPollInterval = time.Second
+
+// recordDeviceChange adds a change to the device registry change log of the application
+func (h *handler) recordDeviceChange(appID, devID string, eventType types.EventType) {
+	changeLog, err := h.devices.ChangeLog(appID)
+	if err == nil {
+		err = changeLog.Add(&device.Change{
+			ID:    random.String(16),
+			Type:  eventType,
+			DevID: devID,
+			Time:  time.Now(),
+		})
+	}
+	if err != nil {
+		h.Ctx.WithFields(ttnlog.Fields{
+			"AppID": appID,
+			"DevID": devID,
+		}).WithError(err).Warn("Could not record device change")
+	}
+}
+
+func (h *handlerManager) WatchDevices(in *pb.WatchDevicesRequest, stream pb.ApplicationManager_WatchDevicesServer) error {
+	if err := in.Validate(); err != nil {
+		return errors.Wrap(err, "Invalid Watch Devices Request")
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(stream.Context(), in.AppId)
+	if err != nil {
+		return err
+	}
+	err = checkAppRights(claims, in.AppId, rights.Devices)
+	if err != nil {
+		return err
+	}
+
+	if _, err := h.handler.applications.Get(in.AppId); err != nil {
+		return errors.Wrap(err, "Application not registered to this Handler")
+	}
+
+	changeLog, err := h.handler.devices.ChangeLog(in.AppId)
+	if err != nil {
+		return err
+	}
+
+	last := in.ResumeToken
+	if last == "" {
+		changes, err := changeLog.Get()
+		if err != nil {
+			return err
+		}
+		if len(changes) > 0 {
+			last = changes[0].ID
+		}
+	}
+
+	ticker := time.NewTicker(WatchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		changes, err := changeLog.Since(last)
+		if errors.GetErrType(err) == errors.NotFound {
+			return errors.NewErrInvalidArgument("ResumeToken", "expired, list all devices to start a new watch")
+		}
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			pbChange := &pb.DeviceChange{
+				Type:        string(change.Type),
+				AppId:       in.AppId,
+				DevId:       change.DevID,
+				Time:        change.Time.UnixNano(),
+				ResumeToken: change.ID,
+			}
+			if change.Type != types.DeleteEvent {
+				dev, err := h.GetDevice(ctx, &pb.DeviceIdentifier{AppId: in.AppId, DevId: change.DevID})
+				if err != nil && errors.GetErrType(err) != errors.NotFound {
+					return err
+				}
+				pbChange.Device = dev
+			}
+			if err := stream.Send(pbChange); err != nil {
+				return err
+			}
+			last = change.ID
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
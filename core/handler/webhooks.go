@@ -0,0 +1,385 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/secrets"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// WebhookTimeout is the time that a webhook has to respond to a request
+var WebhookTimeout = 5 * time.Second
+
+// WebhookBufferSize is the number of messages that are buffered for the webhooks. Messages that do not fit in the
+// buffer are dropped.
+var WebhookBufferSize = 1000
+
+// WebhookWorkers is the number of requests to webhooks that are made in parallel
+var WebhookWorkers = 10
+
+// WebhookCacheTTL is the time that the webhooks of an application are cached
+var WebhookCacheTTL = 30 * time.Second
+
+// WebhookMessageTypeHeader is the header of the requests that contains the type of the message
+const WebhookMessageTypeHeader = "X-TTN-Message-Type"
+
+// WebhookRedactedHeader replaces the values of the headers of webhooks in the ApplicationManager API. Setting a header
+// to this value keeps the value that it already has.
+const WebhookRedactedHeader = "<redacted>"
+
+// webhookEvent is the body of the requests for events
+type webhookEvent struct {
+	AppID string          `json:"app_id"`
+	DevID string          `json:"dev_id,omitempty"`
+	Event types.EventType `json:"event"`
+	Data  interface{}     `json:"data,omitempty"`
+}
+
+type webhookRequest struct {
+	webhook     *application.Webhook
+	messageType string
+	devID       string
+	body        []byte
+}
+
+type webhookCacheEntry struct {
+	webhooks []*application.Webhook
+	expires  time.Time
+}
+
+// webhookCache caches the webhooks of applications, so that the store is not listed for every message
+type webhookCache struct {
+	sync.Mutex
+	entries map[string]webhookCacheEntry
+}
+
+func (c *webhookCache) get(appID string) ([]*application.Webhook, bool) {
+	c.Lock()
+	defer c.Unlock()
+	entry, ok := c.entries[appID]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.webhooks, true
+}
+
+func (c *webhookCache) set(appID string, webhooks []*application.Webhook) {
+	c.Lock()
+	defer c.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[string]webhookCacheEntry)
+	}
+	c.entries[appID] = webhookCacheEntry{webhooks: webhooks, expires: time.Now().Add(WebhookCacheTTL)}
+}
+
+func (c *webhookCache) invalidate(appID string) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.entries, appID)
+}
+
+// WithSecrets sets the secrets backend that resolves the header values of webhooks that reference a secret, for
+// example "secret:my-webhook-token"
+func (h *handler) WithSecrets(backend secrets.Backend) Handler {
+	h.secrets = backend
+	return h
+}
+
+// HandleWebhooks starts the workers that POST the messages to the webhooks
+func (h *handler) HandleWebhooks() {
+	h.webhookQueue = make(chan *webhookRequest, WebhookBufferSize)
+	h.webhookClient = &http.Client{Timeout: WebhookTimeout}
+	if h.webhookTLS != nil {
+		h.webhookClient.Transport = &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: h.webhookTLS,
+		}
+	}
+	for i := 0; i < WebhookWorkers; i++ {
+		go func() {
+			for req := range h.webhookQueue {
+				err := h.postWebhook(req)
+				if err != nil {
+					h.Ctx.WithFields(ttnlog.Fields{
+						"AppID":     req.webhook.AppID,
+						"WebhookID": req.webhook.WebhookID,
+					}).WithError(err).Warn("Could not POST to webhook")
+				}
+				if req.messageType == pb.WebhookMessageUplink {
+					h.captureDelivery(req.webhook.AppID, req.devID, "webhook "+req.webhook.WebhookID, err)
+				}
+			}
+		}()
+	}
+}
+
+func (h *handler) postWebhook(req *webhookRequest) error {
+	httpReq, err := http.NewRequest("POST", req.webhook.URL, bytes.NewReader(req.body))
+	if err != nil {
+		return err
+	}
+	for name, value := range req.webhook.Headers {
+		resolved, err := secrets.Resolve(h.secrets, value)
+		if err != nil {
+			return errors.Wrapf(err, "Could not resolve header %s", name)
+		}
+		httpReq.Header.Set(name, resolved)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set(WebhookMessageTypeHeader, req.messageType)
+	res, err := h.webhookClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("webhook returned %s", res.Status)
+	}
+	return nil
+}
+
+// webhooksFor returns the webhooks of the application
+func (h *handler) webhooksFor(appID string) ([]*application.Webhook, error) {
+	if webhooks, ok := h.webhookCache.get(appID); ok {
+		return webhooks, nil
+	}
+	webhooks, err := h.webhooks.List(appID)
+	if err != nil {
+		return nil, err
+	}
+	h.webhookCache.set(appID, webhooks)
+	return webhooks, nil
+}
+
+// webhookMessageType returns the type of webhook message of the event, or an empty string if the event is not
+// POSTed to webhooks
+func webhookMessageType(event types.EventType) string {
+	switch {
+	case event == types.ActivationEvent:
+		return pb.WebhookMessageActivation
+	case strings.HasPrefix(string(event), "down/"):
+		return pb.WebhookMessageDownlink
+	}
+	return ""
+}
+
+// webhookUplink queues the uplink message for the webhooks of the application
+func (h *handler) webhookUplink(up *types.UplinkMessage) {
+	h.queueWebhooks(up.AppID, up.DevID, pb.WebhookMessageUplink, up)
+}
+
+// webhookEvent queues the event for the webhooks of the application, if it is an activation or downlink event
+func (h *handler) webhookEvent(event *types.DeviceEvent) {
+	messageType := webhookMessageType(event.Event)
+	if messageType == "" {
+		return
+	}
+	h.queueWebhooks(event.AppID, event.DevID, messageType, webhookEvent{
+		AppID: event.AppID,
+		DevID: event.DevID,
+		Event: event.Event,
+		Data:  event.Data,
+	})
+}
+
+// queueWebhooks queues the message for the webhooks of the application that POST messages of the type. It never
+// blocks: if the buffer is full, the message is dropped. During maintenance windows of the application, messages are not
+// POSTed at all.
+func (h *handler) queueWebhooks(appID, devID, messageType string, msg interface{}) {
+	if h.webhookQueue == nil {
+		return
+	}
+	ctx := h.Ctx.WithFields(ttnlog.Fields{
+		"AppID": appID,
+		"DevID": devID,
+	})
+	webhooks, err := h.webhooksFor(appID)
+	if err != nil {
+		ctx.WithError(err).Warn("Could not get webhooks")
+		return
+	}
+	if len(webhooks) == 0 {
+		return
+	}
+	if app, err := h.applications.Get(appID); err == nil && api.InMaintenance(app.MaintenanceWindows, time.Now()) {
+		ctx.Debug("Application in maintenance, not posting to webhooks")
+		return
+	}
+	var body []byte
+	for _, webhook := range webhooks {
+		if !webhook.Sends(messageType) {
+			continue
+		}
+		if body == nil {
+			if body, err = json.Marshal(msg); err != nil {
+				ctx.WithError(err).Warn("Could not marshal message for webhooks")
+				return
+			}
+		}
+		select {
+		case h.webhookQueue <- &webhookRequest{webhook: webhook, messageType: messageType, devID: devID, body: body}:
+		default:
+			ctx.WithField("WebhookID", webhook.WebhookID).Warn("Webhook buffer full, dropping message")
+		}
+	}
+}
+
+// webhookToPb converts the webhook to the API. The header values are redacted, except for references to secrets.
+func webhookToPb(webhook *application.Webhook) *pb.Webhook {
+	var headers map[string]string
+	if len(webhook.Headers) > 0 {
+		headers = make(map[string]string, len(webhook.Headers))
+		for name, value := range webhook.Headers {
+			if !secrets.IsReference(value) {
+				value = WebhookRedactedHeader
+			}
+			headers[name] = value
+		}
+	}
+	return &pb.Webhook{
+		AppId:        webhook.AppID,
+		WebhookId:    webhook.WebhookID,
+		Url:          webhook.URL,
+		Headers:      headers,
+		MessageTypes: webhook.MessageTypes,
+	}
+}
+
+// updateWebhookHeaders returns the new headers of a webhook, keeping the current value of the headers that are set to
+// WebhookRedactedHeader
+func updateWebhookHeaders(current, headers map[string]string) map[string]string {
+	if len(headers) == 0 {
+		return nil
+	}
+	updated := make(map[string]string, len(headers))
+	for name, value := range headers {
+		if value == WebhookRedactedHeader {
+			value = current[name]
+		}
+		updated[name] = value
+	}
+	return updated
+}
+
+func (h *handlerManager) GetWebhooks(ctx context.Context, in *pb.ApplicationIdentifier) (*pb.WebhookList, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Identifier")
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.AppSettings)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := h.handler.applications.Get(in.AppId); err != nil {
+		return nil, err
+	}
+
+	webhooks, err := h.handler.webhooks.List(in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.WebhookList{Webhooks: make([]*pb.Webhook, 0, len(webhooks))}
+	for _, webhook := range webhooks {
+		res.Webhooks = append(res.Webhooks, webhookToPb(webhook))
+	}
+	return res, nil
+}
+
+func (h *handlerManager) SetWebhook(ctx context.Context, in *pb.Webhook) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Webhook")
+	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.AppSettings)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := h.handler.applications.Get(in.AppId); err != nil {
+		return nil, err
+	}
+
+	webhook, err := h.handler.webhooks.Get(in.AppId, in.WebhookId)
+	if errors.GetErrType(err) == errors.NotFound {
+		webhook, err = &application.Webhook{AppID: in.AppId, WebhookID: in.WebhookId}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	webhook.URL = in.Url
+	webhook.Headers = updateWebhookHeaders(webhook.Headers, in.Headers)
+	webhook.MessageTypes = in.MessageTypes
+	if err := h.handler.webhooks.Set(webhook); err != nil {
+		return nil, err
+	}
+	h.handler.webhookCache.invalidate(in.AppId)
+
+	return &empty.Empty{}, nil
+}
+
+func (h *handlerManager) DeleteWebhook(ctx context.Context, in *pb.WebhookIdentifier) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Webhook Identifier")
+	}
+	if err := h.checkWritable(); err != nil {
+		return nil, err
+	}
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, in.AppId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkAppRights(claims, in.AppId, rights.AppSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := h.handler.webhooks.Get(in.AppId, in.WebhookId); err != nil {
+		return nil, err
+	}
+	if err := h.handler.webhooks.Delete(in.AppId, in.WebhookId); err != nil {
+		return nil, err
+	}
+	h.handler.webhookCache.invalidate(in.AppId)
+
+	return &empty.Empty{}, nil
+}
+
+// deleteWebhooks deletes the webhooks of the application
+func (h *handler) deleteWebhooks(appID string) error {
+	webhooks, err := h.webhooks.List(appID)
+	if err != nil {
+		return err
+	}
+	for _, webhook := range webhooks {
+		if err := h.webhooks.Delete(appID, webhook.WebhookID); err != nil {
+			return err
+		}
+	}
+	h.webhookCache.invalidate(appID)
+	return nil
+}
@@ -0,0 +1,230 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/api"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/security"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+type webhookTestRequest struct {
+	header http.Header
+	body   []byte
+}
+
+func TestWebhooks(t *testing.T) {
+	a := New(t)
+	appID := "AppID-1"
+
+	requests := make(chan webhookTestRequest, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests <- webhookTestRequest{header: r.Header, body: body}
+	}))
+	defer server.Close()
+
+	h := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestWebhooks")},
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-webhooks"),
+		webhooks:     application.NewRedisWebhookStore(GetRedisClient(), "handler-test-webhooks"),
+	}
+	h.applications.Set(&application.Application{AppID: appID})
+	defer h.applications.Delete(appID)
+
+	// Without workers, messages are not queued
+	h.webhookUplink(&types.UplinkMessage{AppID: appID, DevID: "DevID-1"})
+
+	h.HandleWebhooks()
+
+	a.So(h.webhooks.Set(&application.Webhook{
+		AppID:     appID,
+		WebhookID: "all",
+		URL:       server.URL,
+		Headers:   map[string]string{"Authorization": "key secret"},
+	}), ShouldBeNil)
+	a.So(h.webhooks.Set(&application.Webhook{
+		AppID:        appID,
+		WebhookID:    "activations",
+		URL:          server.URL,
+		MessageTypes: []string{pb.WebhookMessageActivation},
+	}), ShouldBeNil)
+	defer h.deleteWebhooks(appID)
+
+	wait := func() (req webhookTestRequest, ok bool) {
+		select {
+		case req = <-requests:
+			return req, true
+		case <-time.After(time.Second):
+			return req, false
+		}
+	}
+
+	h.webhookUplink(&types.UplinkMessage{AppID: appID, DevID: "DevID-1", FPort: 1})
+	req, ok := wait()
+	a.So(ok, ShouldBeTrue)
+	a.So(req.header.Get("Content-Type"), ShouldEqual, "application/json")
+	a.So(req.header.Get("Authorization"), ShouldEqual, "key secret")
+	a.So(req.header.Get(WebhookMessageTypeHeader), ShouldEqual, pb.WebhookMessageUplink)
+	var up types.UplinkMessage
+	a.So(json.Unmarshal(req.body, &up), ShouldBeNil)
+	a.So(up.DevID, ShouldEqual, "DevID-1")
+	a.So(up.FPort, ShouldEqual, 1)
+	_, ok = wait()
+	a.So(ok, ShouldBeFalse)
+
+	h.webhookEvent(&types.DeviceEvent{AppID: appID, DevID: "DevID-1", Event: types.ActivationEvent})
+	for i := 0; i < 2; i++ {
+		req, ok = wait()
+		a.So(ok, ShouldBeTrue)
+		a.So(req.header.Get(WebhookMessageTypeHeader), ShouldEqual, pb.WebhookMessageActivation)
+		var event webhookEvent
+		a.So(json.Unmarshal(req.body, &event), ShouldBeNil)
+		a.So(event.AppID, ShouldEqual, appID)
+		a.So(event.Event, ShouldEqual, types.ActivationEvent)
+	}
+
+	// Other events are not POSTed
+	h.webhookEvent(&types.DeviceEvent{AppID: appID, DevID: "DevID-1", Event: types.UplinkErrorEvent})
+	_, ok = wait()
+	a.So(ok, ShouldBeFalse)
+
+	// Messages are not POSTed during maintenance windows
+	app, _ := h.applications.Get(appID)
+	app.StartUpdate()
+	app.MaintenanceWindows = []*api.MaintenanceWindow{{Start: time.Now().Add(-time.Minute).UnixNano(), Duration: 3600}}
+	a.So(h.applications.Set(app), ShouldBeNil)
+	h.webhookUplink(&types.UplinkMessage{AppID: appID, DevID: "DevID-1"})
+	_, ok = wait()
+	a.So(ok, ShouldBeFalse)
+
+	// Deleted webhooks are not used anymore
+	a.So(h.deleteWebhooks(appID), ShouldBeNil)
+	h.webhookUplink(&types.UplinkMessage{AppID: appID, DevID: "DevID-1"})
+	_, ok = wait()
+	a.So(ok, ShouldBeFalse)
+}
+
+type webhookSecrets map[string]string
+
+func (s webhookSecrets) Get(name string) (string, error) {
+	value, ok := s[name]
+	if !ok {
+		return "", errors.NewErrNotFound("Secret " + name)
+	}
+	return value, nil
+}
+
+func TestWebhookHeaders(t *testing.T) {
+	a := New(t)
+
+	requests := make(chan webhookTestRequest, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests <- webhookTestRequest{header: r.Header}
+	}))
+	defer server.Close()
+
+	webhook := &application.Webhook{
+		AppID:     "AppID-1",
+		WebhookID: "secret",
+		URL:       server.URL,
+		Headers:   map[string]string{"Authorization": "secret:webhook-token", "X-Plain": "plain"},
+	}
+	req := &webhookRequest{webhook: webhook, body: []byte("{}")}
+
+	// Without secrets backend, the header can not be resolved
+	h := &handler{Component: &component.Component{Ctx: GetLogger(t, "TestWebhookHeaders")}}
+	h.HandleWebhooks()
+	a.So(h.postWebhook(req), ShouldNotBeNil)
+
+	h.WithSecrets(webhookSecrets{"webhook-token": "key secret"})
+	a.So(h.postWebhook(req), ShouldBeNil)
+	res := <-requests
+	a.So(res.header.Get("Authorization"), ShouldEqual, "key secret")
+	a.So(res.header.Get("X-Plain"), ShouldEqual, "plain")
+
+	// Header values are redacted, except for references to secrets
+	a.So(webhookToPb(webhook).Headers, ShouldResemble, map[string]string{
+		"Authorization": "secret:webhook-token",
+		"X-Plain":       WebhookRedactedHeader,
+	})
+
+	// Redacted header values keep their value
+	a.So(updateWebhookHeaders(webhook.Headers, map[string]string{
+		"X-Plain": WebhookRedactedHeader,
+		"X-New":   "new",
+	}), ShouldResemble, map[string]string{
+		"X-Plain": "plain",
+		"X-New":   "new",
+	})
+	a.So(updateWebhookHeaders(webhook.Headers, nil), ShouldBeNil)
+}
+
+func TestWebhookTLS(t *testing.T) {
+	a := New(t)
+
+	dir, err := ioutil.TempDir("", "ttn-handler-webhook-tls")
+	a.So(err, ShouldBeNil)
+	defer os.RemoveAll(dir)
+	a.So(security.GenerateCA(dir, "Test CA"), ShouldBeNil)
+	ca, err := security.LoadCA(dir)
+	a.So(err, ShouldBeNil)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.Cert)
+
+	serverCert, serverKey, _, err := ca.IssueCert("webhook", time.Hour, "127.0.0.1")
+	a.So(err, ShouldBeNil)
+	serverPair, err := tls.X509KeyPair(serverCert, serverKey)
+	a.So(err, ShouldBeNil)
+	clientCert, clientKey, _, err := ca.IssueCert("handler", time.Hour)
+	a.So(err, ShouldBeNil)
+	clientPair, err := tls.X509KeyPair(clientCert, clientKey)
+	a.So(err, ShouldBeNil)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{serverPair},
+		ClientCAs:    roots,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	req := &webhookRequest{webhook: &application.Webhook{URL: server.URL}, body: []byte("{}")}
+
+	// The webhook requires a client certificate
+	h := &handler{Component: &component.Component{Ctx: GetLogger(t, "TestWebhookTLS")}}
+	h.WithWebhookTLS(&tls.Config{RootCAs: roots})
+	h.HandleWebhooks()
+	a.So(h.postWebhook(req), ShouldNotBeNil)
+
+	h = &handler{Component: &component.Component{Ctx: GetLogger(t, "TestWebhookTLS")}}
+	h.WithWebhookTLS(&tls.Config{RootCAs: roots, Certificates: []tls.Certificate{clientPair}})
+	h.HandleWebhooks()
+	a.So(h.postWebhook(req), ShouldBeNil)
+}
+
+func TestWebhookMessageType(t *testing.T) {
+	a := New(t)
+	a.So(webhookMessageType(types.ActivationEvent), ShouldEqual, pb.WebhookMessageActivation)
+	a.So(webhookMessageType(types.DownlinkScheduledEvent), ShouldEqual, pb.WebhookMessageDownlink)
+	a.So(webhookMessageType(types.DownlinkAckEvent), ShouldEqual, pb.WebhookMessageDownlink)
+	a.So(webhookMessageType(types.UplinkErrorEvent), ShouldEqual, "")
+}
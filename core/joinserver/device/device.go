@@ -0,0 +1,33 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+)
+
+const currentDBVersion = "2.4.1"
+
+type DevNonce [2]byte
+type AppNonce [3]byte
+
+// Device contains the root keys of a device and the nonces that were used in its joins
+type Device struct {
+	AppEUI types.AppEUI `redis:"app_eui"`
+	DevEUI types.DevEUI `redis:"dev_eui"`
+
+	AppKey        types.AppKey `redis:"app_key"`
+	UsedDevNonces []DevNonce   `redis:"used_dev_nonces"`
+	UsedAppNonces []AppNonce   `redis:"used_app_nonces"`
+
+	CreatedAt time.Time `redis:"created_at"`
+	UpdatedAt time.Time `redis:"updated_at"`
+}
+
+// DBVersion of the model
+func (d *Device) DBVersion() string {
+	return currentDBVersion
+}
@@ -0,0 +1,70 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/storage"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"gopkg.in/redis.v5"
+)
+
+// Store interface for Devices
+type Store interface {
+	Get(appEUI types.AppEUI, devEUI types.DevEUI) (*Device, error)
+	Set(new *Device, properties ...string) (err error)
+	Delete(appEUI types.AppEUI, devEUI types.DevEUI) error
+}
+
+const defaultRedisPrefix = "js"
+
+const redisDevicePrefix = "device"
+
+// NewRedisDeviceStore creates a new Redis-based device store
+func NewRedisDeviceStore(client *redis.Client, prefix string) Store {
+	if prefix == "" {
+		prefix = defaultRedisPrefix
+	}
+	store := storage.NewRedisMapStore(client, prefix+":"+redisDevicePrefix)
+	store.SetBase(Device{}, "")
+	return &RedisDeviceStore{
+		store: store,
+	}
+}
+
+// RedisDeviceStore stores Devices in Redis.
+// - Devices are stored as a Hash
+type RedisDeviceStore struct {
+	store *storage.RedisMapStore
+}
+
+// Get a specific Device
+func (s *RedisDeviceStore) Get(appEUI types.AppEUI, devEUI types.DevEUI) (*Device, error) {
+	deviceI, err := s.store.Get(fmt.Sprintf("%s:%s", appEUI, devEUI))
+	if err != nil {
+		return nil, err
+	}
+	if device, ok := deviceI.(Device); ok {
+		return &device, nil
+	}
+	return nil, errors.New("Database did not return a Device")
+}
+
+// Set a new Device or update an existing one
+func (s *RedisDeviceStore) Set(new *Device, properties ...string) (err error) {
+	now := time.Now()
+	new.UpdatedAt = now
+	if new.CreatedAt.IsZero() {
+		new.CreatedAt = now
+	}
+	return s.store.Set(fmt.Sprintf("%s:%s", new.AppEUI, new.DevEUI), *new, properties...)
+}
+
+// Delete a Device
+func (s *RedisDeviceStore) Delete(appEUI types.AppEUI, devEUI types.DevEUI) error {
+	return s.store.Delete(fmt.Sprintf("%s:%s", appEUI, devEUI))
+}
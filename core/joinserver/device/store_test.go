@@ -0,0 +1,66 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestDeviceStore(t *testing.T) {
+	a := New(t)
+
+	NewRedisDeviceStore(GetRedisClient(), "")
+
+	s := NewRedisDeviceStore(GetRedisClient(), "joinserver-test-device-store")
+
+	appEUI := types.AppEUI{0, 0, 0, 0, 0, 0, 0, 1}
+	devEUI := types.DevEUI{0, 0, 0, 0, 0, 0, 0, 1}
+
+	// Non-existing Device
+	dev, err := s.Get(appEUI, devEUI)
+	a.So(err, ShouldNotBeNil)
+	a.So(dev, ShouldBeNil)
+
+	// New Device
+	err = s.Set(&Device{
+		AppEUI:        appEUI,
+		DevEUI:        devEUI,
+		AppKey:        types.AppKey{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+		UsedDevNonces: []DevNonce{{0, 1}},
+		UsedAppNonces: []AppNonce{{0, 0, 1}},
+	})
+	a.So(err, ShouldBeNil)
+
+	defer func() {
+		s.Delete(appEUI, devEUI)
+	}()
+
+	dev, err = s.Get(appEUI, devEUI)
+	a.So(err, ShouldBeNil)
+	a.So(dev.AppKey, ShouldEqual, types.AppKey{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1})
+	a.So(dev.UsedDevNonces, ShouldResemble, []DevNonce{{0, 1}})
+	a.So(dev.UsedAppNonces, ShouldResemble, []AppNonce{{0, 0, 1}})
+	a.So(dev.CreatedAt.IsZero(), ShouldBeFalse)
+
+	// Existing Device
+	dev.UsedDevNonces = append(dev.UsedDevNonces, DevNonce{0, 2})
+	err = s.Set(dev, "used_dev_nonces")
+	a.So(err, ShouldBeNil)
+
+	dev, err = s.Get(appEUI, devEUI)
+	a.So(err, ShouldBeNil)
+	a.So(dev.UsedDevNonces, ShouldHaveLength, 2)
+
+	// Delete
+	err = s.Delete(appEUI, devEUI)
+	a.So(err, ShouldBeNil)
+
+	dev, err = s.Get(appEUI, devEUI)
+	a.So(err, ShouldNotBeNil)
+	a.So(dev, ShouldBeNil)
+}
@@ -0,0 +1,122 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package joinserver
+
+import (
+	"github.com/TheThingsNetwork/go-utils/random"
+	pb "github.com/TheThingsNetwork/ttn/api/joinserver"
+	"github.com/TheThingsNetwork/ttn/core/joinserver/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/otaa"
+	"github.com/brocaar/lorawan"
+)
+
+// JoinRequestEUIs returns the AppEUI and DevEUI of a join request message
+func JoinRequestEUIs(payload []byte) (types.AppEUI, types.DevEUI, error) {
+	var reqPHY lorawan.PHYPayload
+	if err := reqPHY.UnmarshalBinary(payload); err != nil {
+		return types.AppEUI{}, types.DevEUI{}, err
+	}
+	reqMAC, ok := reqPHY.MACPayload.(*lorawan.JoinRequestPayload)
+	if !ok {
+		return types.AppEUI{}, types.DevEUI{}, errors.NewErrInvalidArgument("Join Request", "does not contain a JoinRequestPayload")
+	}
+	return types.AppEUI(reqMAC.AppEUI), types.DevEUI(reqMAC.DevEUI), nil
+}
+
+// Join validates the join request of the device, derives the session keys and encrypts the join accept message that
+// is prepared from the template. The used nonces of the device are updated, but the device is not stored.
+func Join(dev *device.Device, req *pb.JoinRequest) (*pb.JoinResponse, error) {
+	if dev.AppKey.IsEmpty() {
+		return nil, errors.NewErrNotFound("AppKey for device")
+	}
+
+	// Unmarshal LoRaWAN
+	var reqPHY lorawan.PHYPayload
+	if err := reqPHY.UnmarshalBinary(req.Payload); err != nil {
+		return nil, err
+	}
+	reqMAC, ok := reqPHY.MACPayload.(*lorawan.JoinRequestPayload)
+	if !ok {
+		return nil, errors.NewErrInvalidArgument("Join Request", "does not contain a JoinRequestPayload")
+	}
+	if types.AppEUI(reqMAC.AppEUI) != dev.AppEUI || types.DevEUI(reqMAC.DevEUI) != dev.DevEUI {
+		return nil, errors.NewErrInvalidArgument("Join Request", "was sent by a different device")
+	}
+
+	// Validate MIC
+	if ok, err := reqPHY.ValidateMIC(lorawan.AES128Key(dev.AppKey)); err != nil || !ok {
+		return nil, errors.NewErrNotFound("MIC does not match device")
+	}
+
+	// Validate DevNonce
+	for _, usedNonce := range dev.UsedDevNonces {
+		if usedNonce == device.DevNonce(reqMAC.DevNonce) {
+			return nil, errors.NewErrInvalidArgument("Join Request DevNonce", "already used")
+		}
+	}
+
+	// Prepare Join Accept
+	var resPHY lorawan.PHYPayload
+	if err := resPHY.UnmarshalBinary(req.AcceptTemplate); err != nil {
+		return nil, err
+	}
+	resMAC, ok := resPHY.MACPayload.(*lorawan.DataPayload)
+	if !ok {
+		return nil, errors.NewErrInvalidArgument("Join Accept Template", "MACPayload must be a *DataPayload")
+	}
+	joinAccept := &lorawan.JoinAcceptPayload{}
+	if err := joinAccept.UnmarshalBinary(false, resMAC.Bytes); err != nil {
+		return nil, err
+	}
+	resPHY.MACPayload = joinAccept
+
+	// Generate random AppNonce
+	var appNonce device.AppNonce
+	for {
+		// NOTE: As DevNonces are only 2 bytes, we will start rejecting those before we run out of AppNonces.
+		// It might just take some time to get one we didn't use yet...
+		alreadyUsed := false
+		random.FillBytes(appNonce[:])
+		for _, usedNonce := range dev.UsedAppNonces {
+			if usedNonce == appNonce {
+				alreadyUsed = true
+				break
+			}
+		}
+		if !alreadyUsed {
+			break
+		}
+	}
+	joinAccept.AppNonce = appNonce
+
+	// Calculate session keys
+	appSKey, nwkSKey, err := otaa.CalculateSessionKeys(dev.AppKey, joinAccept.AppNonce, joinAccept.NetID, reqMAC.DevNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = resPHY.SetMIC(lorawan.AES128Key(dev.AppKey)); err != nil {
+		return nil, err
+	}
+	if err = resPHY.EncryptJoinAcceptPayload(lorawan.AES128Key(dev.AppKey)); err != nil {
+		return nil, err
+	}
+	resBytes, err := resPHY.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	dev.UsedAppNonces = append(dev.UsedAppNonces, appNonce)
+	dev.UsedDevNonces = append(dev.UsedDevNonces, reqMAC.DevNonce)
+
+	devAddr := types.DevAddr(joinAccept.DevAddr)
+	return &pb.JoinResponse{
+		Payload: resBytes,
+		DevAddr: &devAddr,
+		NwkSKey: &nwkSKey,
+		AppSKey: &appSKey,
+	}, nil
+}
@@ -0,0 +1,114 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package joinserver
+
+import (
+	"testing"
+
+	pb "github.com/TheThingsNetwork/ttn/api/joinserver"
+	"github.com/TheThingsNetwork/ttn/core/joinserver/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/otaa"
+	"github.com/brocaar/lorawan"
+	. "github.com/smartystreets/assertions"
+)
+
+func buildJoinRequest(appEUI types.AppEUI, devEUI types.DevEUI, devNonce [2]byte, appKey types.AppKey) *pb.JoinRequest {
+	requestPHY := lorawan.PHYPayload{
+		MHDR: lorawan.MHDR{
+			MType: lorawan.JoinRequest,
+			Major: lorawan.LoRaWANR1,
+		},
+		MACPayload: &lorawan.JoinRequestPayload{
+			AppEUI:   lorawan.EUI64(appEUI),
+			DevEUI:   lorawan.EUI64(devEUI),
+			DevNonce: devNonce,
+		},
+	}
+	requestPHY.SetMIC(lorawan.AES128Key(appKey))
+	requestBytes, _ := requestPHY.MarshalBinary()
+
+	templatePHY := lorawan.PHYPayload{
+		MHDR: lorawan.MHDR{
+			MType: lorawan.JoinAccept,
+			Major: lorawan.LoRaWANR1,
+		},
+		MACPayload: &lorawan.JoinAcceptPayload{
+			NetID:   lorawan.NetID{0, 0, 0x13},
+			DevAddr: lorawan.DevAddr{0x26, 1, 2, 3},
+		},
+	}
+	templateBytes, _ := templatePHY.MarshalBinary()
+
+	return &pb.JoinRequest{
+		Payload:        requestBytes,
+		AcceptTemplate: templateBytes,
+	}
+}
+
+func TestJoinRequestEUIs(t *testing.T) {
+	a := New(t)
+
+	appEUI := types.AppEUI{1, 2, 3, 4, 5, 6, 7, 8}
+	devEUI := types.DevEUI{8, 7, 6, 5, 4, 3, 2, 1}
+	req := buildJoinRequest(appEUI, devEUI, [2]byte{1, 2}, types.AppKey{})
+
+	reqAppEUI, reqDevEUI, err := JoinRequestEUIs(req.Payload)
+	a.So(err, ShouldBeNil)
+	a.So(reqAppEUI, ShouldEqual, appEUI)
+	a.So(reqDevEUI, ShouldEqual, devEUI)
+
+	_, _, err = JoinRequestEUIs(req.AcceptTemplate)
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestJoin(t *testing.T) {
+	a := New(t)
+
+	appEUI := types.AppEUI{1, 2, 3, 4, 5, 6, 7, 8}
+	devEUI := types.DevEUI{8, 7, 6, 5, 4, 3, 2, 1}
+	appKey := types.AppKey{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+
+	dev := &device.Device{
+		AppEUI: appEUI,
+		DevEUI: devEUI,
+	}
+
+	// No AppKey
+	_, err := Join(dev, buildJoinRequest(appEUI, devEUI, [2]byte{1, 2}, appKey))
+	a.So(err, ShouldNotBeNil)
+
+	dev.AppKey = appKey
+
+	// Other device
+	_, err = Join(dev, buildJoinRequest(appEUI, types.DevEUI{1}, [2]byte{1, 2}, appKey))
+	a.So(err, ShouldNotBeNil)
+
+	// Wrong AppKey
+	_, err = Join(dev, buildJoinRequest(appEUI, devEUI, [2]byte{1, 2}, types.AppKey{}))
+	a.So(err, ShouldNotBeNil)
+
+	// Valid
+	res, err := Join(dev, buildJoinRequest(appEUI, devEUI, [2]byte{1, 2}, appKey))
+	a.So(err, ShouldBeNil)
+	a.So(*res.DevAddr, ShouldEqual, types.DevAddr{0x26, 1, 2, 3})
+	a.So(dev.UsedDevNonces, ShouldResemble, []device.DevNonce{{1, 2}})
+	a.So(dev.UsedAppNonces, ShouldHaveLength, 1)
+
+	appSKey, nwkSKey, _ := otaa.CalculateSessionKeys(appKey, dev.UsedAppNonces[0], [3]byte{0, 0, 0x13}, [2]byte{1, 2})
+	a.So(*res.AppSKey, ShouldEqual, appSKey)
+	a.So(*res.NwkSKey, ShouldEqual, nwkSKey)
+
+	// The join accept can be decrypted by the device
+	var resPHY lorawan.PHYPayload
+	a.So(resPHY.UnmarshalBinary(res.Payload), ShouldBeNil)
+	a.So(resPHY.DecryptJoinAcceptPayload(lorawan.AES128Key(appKey)), ShouldBeNil)
+	ok, err := resPHY.ValidateMIC(lorawan.AES128Key(appKey))
+	a.So(err, ShouldBeNil)
+	a.So(ok, ShouldBeTrue)
+
+	// Same DevNonce used twice
+	_, err = Join(dev, buildJoinRequest(appEUI, devEUI, [2]byte{1, 2}, appKey))
+	a.So(err, ShouldNotBeNil)
+}
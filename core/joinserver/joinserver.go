@@ -0,0 +1,94 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package joinserver
+
+import (
+	pb "github.com/TheThingsNetwork/ttn/api/joinserver"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/joinserver/device"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"gopkg.in/redis.v5"
+)
+
+// JoinServer handles the OTAA joins of devices and stores their root keys, so that the root keys do not have to be
+// stored by the Handler
+type JoinServer interface {
+	component.Interface
+
+	HandleJoin(*pb.JoinRequest) (*pb.JoinResponse, error)
+	SetDeviceKeys(*pb.DeviceKeys) error
+	DeleteDeviceKeys(*pb.DeviceIdentifier) error
+}
+
+// NewRedisJoinServer creates a new Redis-backed JoinServer
+func NewRedisJoinServer(client *redis.Client) JoinServer {
+	return &joinServer{
+		devices: device.NewRedisDeviceStore(client, "js"),
+	}
+}
+
+type joinServer struct {
+	*component.Component
+	devices device.Store
+}
+
+func (j *joinServer) Init(c *component.Component) error {
+	j.Component = c
+	err := j.Component.UpdateTokenKey()
+	if err != nil {
+		return err
+	}
+	j.Component.SetStatus(component.StatusHealthy)
+	return nil
+}
+
+func (j *joinServer) Shutdown() {}
+
+func (j *joinServer) HandleJoin(req *pb.JoinRequest) (*pb.JoinResponse, error) {
+	appEUI, devEUI, err := JoinRequestEUIs(req.Payload)
+	if err != nil {
+		return nil, err
+	}
+	dev, err := j.devices.Get(appEUI, devEUI)
+	if err != nil {
+		return nil, err
+	}
+	res, err := Join(dev, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := j.devices.Set(dev, "used_dev_nonces", "used_app_nonces"); err != nil {
+		return nil, err
+	}
+	j.Ctx.WithField("AppEUI", appEUI).WithField("DevEUI", devEUI).Debug("Accepted Join Request")
+	return res, nil
+}
+
+func (j *joinServer) SetDeviceKeys(in *pb.DeviceKeys) error {
+	dev, err := j.devices.Get(*in.AppEui, *in.DevEui)
+	if err != nil && errors.GetErrType(err) != errors.NotFound {
+		return err
+	}
+	if dev != nil && dev.AppKey == *in.AppKey {
+		return nil
+	}
+	if dev != nil {
+		// The nonces that were used with the previous AppKey can be used again
+		if err := j.devices.Delete(dev.AppEUI, dev.DevEUI); err != nil {
+			return err
+		}
+	}
+	return j.devices.Set(&device.Device{
+		AppEUI: *in.AppEui,
+		DevEUI: *in.DevEui,
+		AppKey: *in.AppKey,
+	})
+}
+
+func (j *joinServer) DeleteDeviceKeys(in *pb.DeviceIdentifier) error {
+	if _, err := j.devices.Get(*in.AppEui, *in.DevEui); err != nil {
+		return err
+	}
+	return j.devices.Delete(*in.AppEui, *in.DevEui)
+}
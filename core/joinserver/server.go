@@ -0,0 +1,96 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package joinserver
+
+import (
+	pb "github.com/TheThingsNetwork/ttn/api/joinserver"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/security"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type joinServerRPC struct {
+	joinServer JoinServer
+}
+
+// ValidateContext validates the token that was issued to a Handler with "ttn joinserver authorize"
+func (s *joinServerRPC) ValidateContext(ctx context.Context) error {
+	md, ok := metadata.FromContext(ctx)
+	if !ok {
+		return errors.NewErrInternal("Could not get metadata from context")
+	}
+	var id, token string
+	if ids, ok := md["id"]; ok && len(ids) == 1 {
+		id = ids[0]
+	}
+	if id == "" {
+		return errors.NewErrInvalidArgument("Metadata", "id missing")
+	}
+	if tokens, ok := md["token"]; ok && len(tokens) == 1 {
+		token = tokens[0]
+	}
+	if token == "" {
+		return errors.NewErrInvalidArgument("Metadata", "token missing")
+	}
+	var claims *jwt.StandardClaims
+	claims, err := security.ValidateJWT(token, []byte(s.joinServer.(*joinServer).Identity.PublicKey))
+	if err != nil {
+		return err
+	}
+	if claims.Subject != id {
+		return errors.NewErrInvalidArgument("Metadata", "token was issued for a different component id")
+	}
+	return nil
+}
+
+func (s *joinServerRPC) Join(ctx context.Context, req *pb.JoinRequest) (*pb.JoinResponse, error) {
+	if err := s.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Join Request")
+	}
+	res, err := s.joinServer.HandleJoin(req)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *joinServerRPC) SetDeviceKeys(ctx context.Context, in *pb.DeviceKeys) (*empty.Empty, error) {
+	if err := s.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Keys")
+	}
+	if err := s.joinServer.SetDeviceKeys(in); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *joinServerRPC) DeleteDeviceKeys(ctx context.Context, in *pb.DeviceIdentifier) (*empty.Empty, error) {
+	if err := s.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Identifier")
+	}
+	if err := s.joinServer.DeleteDeviceKeys(in); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// RegisterRPC registers this joinserver as a JoinServerServer and JoinServerManagerServer (github.com/TheThingsNetwork/ttn/api/joinserver)
+func (j *joinServer) RegisterRPC(s *grpc.Server) {
+	server := &joinServerRPC{j}
+	pb.RegisterJoinServerServer(s, server)
+	pb.RegisterJoinServerManagerServer(s, server)
+}
@@ -4,13 +4,16 @@
 package networkserver
 
 import (
+	"encoding/binary"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/TheThingsNetwork/go-utils/log"
 	"github.com/TheThingsNetwork/go-utils/pseudorandom"
 	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
 	pb_handler "github.com/TheThingsNetwork/ttn/api/handler"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/api/trace"
 	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
 	"github.com/TheThingsNetwork/ttn/core/types"
@@ -18,26 +21,93 @@ import (
 	"github.com/brocaar/lorawan"
 )
 
-func (n *networkServer) getDevAddr(constraints ...string) (types.DevAddr, error) {
-	// Generate random DevAddr bytes
-	var devAddr types.DevAddr
-	pseudorandom.FillBytes(devAddr[:])
+// DevAddrAttempts is the number of DevAddrs that are generated before a DevAddr that is already in use is allocated
+var DevAddrAttempts = 16
 
-	// Get a random prefix that matches the constraints
+// getDevAddr allocates a DevAddr with a prefix that matches the constraints. If dev is not nil, its DevAddr allocation
+// strategy is used, and its own DevAddr is not considered to be in use.
+func (n *networkServer) getDevAddr(dev *device.Device, constraints ...string) (types.DevAddr, error) {
+	// Get the prefixes that match the constraints
 	prefixes := n.GetPrefixesFor(constraints...)
 	if len(prefixes) == 0 {
 		return types.DevAddr{}, errors.NewErrNotFound(fmt.Sprintf("DevAddr prefix with constraints %v", constraints))
 	}
 
-	// Select a prefix
-	prefix := prefixes[pseudorandom.Intn(len(prefixes))]
+	var strategy string
+	if dev != nil {
+		strategy = dev.Options.DevAddrAllocation
+	}
 
-	// Apply the prefix
-	devAddr = devAddr.WithPrefix(prefix)
+	// Re-use the DevAddr of the device if it still matches the constraints
+	if strategy == pb_lorawan.DevAddrAllocationSticky && !dev.DevAddr.IsEmpty() {
+		for _, prefix := range prefixes {
+			if dev.DevAddr.HasPrefix(prefix) {
+				return dev.DevAddr, nil
+			}
+		}
+	}
+
+	var devAddr types.DevAddr
+	for attempt := 0; attempt < DevAddrAttempts; attempt++ {
+		// Select a prefix
+		prefix := prefixes[pseudorandom.Intn(len(prefixes))]
+
+		var err error
+		devAddr, err = n.generateDevAddr(prefix, strategy)
+		if err != nil {
+			return types.DevAddr{}, err
+		}
+
+		inUse, err := n.devAddrInUse(devAddr, dev)
+		if err != nil {
+			return types.DevAddr{}, err
+		}
+		if !inUse {
+			return devAddr, nil
+		}
+	}
+
+	n.Ctx.WithFields(log.Fields{
+		"DevAddr":  devAddr,
+		"Attempts": DevAddrAttempts,
+		"Strategy": strategy,
+	}).Warn("Could not allocate a DevAddr that is not in use")
 
 	return devAddr, nil
 }
 
+// generateDevAddr generates a DevAddr with the prefix. The sequential strategy takes the next value of the counter of
+// the prefix, the other strategies generate random DevAddr bytes.
+func (n *networkServer) generateDevAddr(prefix types.DevAddrPrefix, strategy string) (devAddr types.DevAddr, err error) {
+	if strategy == pb_lorawan.DevAddrAllocationSequential {
+		counter, err := n.devAddrCounters.Increment(prefix.String())
+		if err != nil {
+			return types.DevAddr{}, err
+		}
+		binary.BigEndian.PutUint32(devAddr[:], uint32(counter))
+	} else {
+		pseudorandom.FillBytes(devAddr[:])
+	}
+
+	// Apply the prefix
+	return devAddr.WithPrefix(prefix), nil
+}
+
+// devAddrInUse returns true if the DevAddr is used by another device than dev
+func (n *networkServer) devAddrInUse(devAddr types.DevAddr, dev *device.Device) (bool, error) {
+	devices, err := n.devices.ListForAddress(devAddr)
+	if err != nil {
+		return false, err
+	}
+	for _, other := range devices {
+		if dev != nil && other.AppEUI == dev.AppEUI && other.DevEUI == dev.DevEUI {
+			continue
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
 func (n *networkServer) HandlePrepareActivation(activation *pb_broker.DeduplicatedDeviceActivationRequest) (*pb_broker.DeduplicatedDeviceActivationRequest, error) {
 	if activation.AppEui == nil || activation.DevEui == nil {
 		return nil, errors.NewErrInvalidArgument("Activation", "missing AppEUI or DevEUI")
@@ -69,7 +139,7 @@ func (n *networkServer) HandlePrepareActivation(activation *pb_broker.Deduplicat
 
 	// Allocate a  device address
 	activation.Trace = activation.Trace.WithEvent("allocate devaddr")
-	devAddr, err := n.getDevAddr(activationConstraints...)
+	devAddr, err := n.getDevAddr(dev, activationConstraints...)
 	if err != nil {
 		return nil, err
 	}
@@ -10,7 +10,9 @@ import (
 	pb_handler "github.com/TheThingsNetwork/ttn/api/handler"
 	pb_protocol "github.com/TheThingsNetwork/ttn/api/protocol"
 	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/component"
 	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/storage"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	. "github.com/TheThingsNetwork/ttn/utils/testing"
 	"github.com/brocaar/lorawan"
@@ -96,6 +98,72 @@ func TestHandlePrepareActivation(t *testing.T) {
 	a.So(*joinAccept.CFList, ShouldEqual, lorawan.CFList{867100000, 867300000, 867500000, 867700000, 867900000})
 }
 
+func TestGetDevAddr(t *testing.T) {
+	a := New(t)
+	prefix := types.DevAddrPrefix{DevAddr: [4]byte{0x26, 0x00, 0x00, 0x00}, Length: 7}
+	ns := &networkServer{
+		Component: &component.Component{
+			Ctx: GetLogger(t, "TestGetDevAddr"),
+		},
+		netID: [3]byte{0x00, 0x00, 0x13},
+		prefixes: map[types.DevAddrPrefix][]string{
+			prefix: []string{"otaa"},
+		},
+		devices:         device.NewRedisDeviceStore(GetRedisClient(), "test-get-dev-addr"),
+		devAddrCounters: storage.NewRedisKVStore(GetRedisClient(), "test-get-dev-addr-counter"),
+	}
+	ns.devAddrCounters.Delete(prefix.String())
+	defer ns.devAddrCounters.Delete(prefix.String())
+
+	dev := &device.Device{
+		AppEUI: types.AppEUI(getEUI(0, 0, 0, 0, 0, 0, 4, 1)),
+		DevEUI: types.DevEUI(getEUI(0, 0, 0, 0, 0, 0, 4, 1)),
+	}
+
+	// No prefix for the constraints
+	_, err := ns.getDevAddr(dev, "otaa", "private")
+	a.So(err, ShouldNotBeNil)
+
+	// Random
+	devAddr, err := ns.getDevAddr(dev, "otaa")
+	a.So(err, ShouldBeNil)
+	a.So(devAddr.HasPrefix(prefix), ShouldBeTrue)
+
+	// Sequential
+	dev.Options.DevAddrAllocation = pb_lorawan.DevAddrAllocationSequential
+	devAddr, err = ns.getDevAddr(dev, "otaa")
+	a.So(err, ShouldBeNil)
+	a.So(devAddr, ShouldEqual, getDevAddr(0x26, 0x00, 0x00, 0x01))
+	devAddr, err = ns.getDevAddr(dev, "otaa")
+	a.So(err, ShouldBeNil)
+	a.So(devAddr, ShouldEqual, getDevAddr(0x26, 0x00, 0x00, 0x02))
+
+	// Sequential, skipping a DevAddr that is used by another device
+	other := &device.Device{
+		AppEUI:  types.AppEUI(getEUI(0, 0, 0, 0, 0, 0, 4, 2)),
+		DevEUI:  types.DevEUI(getEUI(0, 0, 0, 0, 0, 0, 4, 2)),
+		DevAddr: getDevAddr(0x26, 0x00, 0x00, 0x03),
+	}
+	a.So(ns.devices.Set(other), ShouldBeNil)
+	defer ns.devices.Delete(other.AppEUI, other.DevEUI)
+	devAddr, err = ns.getDevAddr(dev, "otaa")
+	a.So(err, ShouldBeNil)
+	a.So(devAddr, ShouldEqual, getDevAddr(0x26, 0x00, 0x00, 0x04))
+
+	// Sticky, re-using the DevAddr of the device
+	dev.Options.DevAddrAllocation = pb_lorawan.DevAddrAllocationSticky
+	dev.DevAddr = getDevAddr(0x26, 0x00, 0x00, 0x42)
+	devAddr, err = ns.getDevAddr(dev, "otaa")
+	a.So(err, ShouldBeNil)
+	a.So(devAddr, ShouldEqual, dev.DevAddr)
+
+	// Sticky, but the DevAddr of the device does not match the prefix
+	dev.DevAddr = getDevAddr(0x01, 0x02, 0x03, 0x04)
+	devAddr, err = ns.getDevAddr(dev, "otaa")
+	a.So(err, ShouldBeNil)
+	a.So(devAddr.HasPrefix(prefix), ShouldBeTrue)
+}
+
 func TestHandleActivate(t *testing.T) {
 	a := New(t)
 	ns := &networkServer{
@@ -16,6 +16,12 @@ import (
 // DefaultADRMargin is the default SNR margin for ADR
 var DefaultADRMargin = 15
 
+// Reasons for changing the ADR settings of a device
+const (
+	ADRReasonSNRMargin  = "snr-margin"
+	ADRReasonPacketLoss = "packet-loss"
+)
+
 func maxSNR(frames []*device.Frame) float32 {
 	if len(frames) == 0 {
 		return 0
@@ -112,7 +118,7 @@ func (n *networkServer) handleDownlinkADR(message *pb_broker.DownlinkMessage, de
 	if dev.ADR.Band == "" {
 		return nil
 	}
-	fp, err := band.Get(dev.ADR.Band)
+	fp, err := band.GetForRegionalParameters(dev.ADR.Band, dev.Options.RegionalParameters)
 	if err != nil {
 		return err
 	}
@@ -137,7 +143,12 @@ func (n *networkServer) handleDownlinkADR(message *pb_broker.DownlinkMessage, de
 	}
 	powerIdx, err := fp.GetTxPowerIndexFor(txPower)
 	if err != nil {
-		powerIdx, _ = fp.GetTxPowerIndexFor(fp.DefaultTXPower)
+		// The tx-power tables of the revisions of the Regional Parameters have different steps
+		powerIdx, err = fp.GetClosestTxPowerIndexFor(txPower)
+		if err != nil {
+			return err
+		}
+		txPower = fp.TXPower[powerIdx]
 	}
 
 	var nbTrans = dev.ADR.NbTrans
@@ -164,7 +175,21 @@ func (n *networkServer) handleDownlinkADR(message *pb_broker.DownlinkMessage, de
 	if dev.ADR.DataRate == dataRate && dev.ADR.TxPower == txPower && dev.ADR.NbTrans == nbTrans {
 		return nil
 	}
+	change := &pb_lorawan.ADRChange{
+		OldDataRate: dev.ADR.DataRate,
+		NewDataRate: dataRate,
+		OldTxPower:  int32(dev.ADR.TxPower),
+		NewTxPower:  int32(txPower),
+		OldNbTrans:  uint32(dev.ADR.NbTrans),
+		NewNbTrans:  uint32(nbTrans),
+		Reason:      ADRReasonSNRMargin,
+		SnrMargin:   linkMargin(dev.ADR.DataRate, maxSNR(frames)) - float32(dev.ADR.Margin),
+	}
+	if dev.ADR.DataRate == dataRate && dev.ADR.TxPower == txPower {
+		change.Reason = ADRReasonPacketLoss
+	}
 	dev.ADR.DataRate, dev.ADR.TxPower, dev.ADR.NbTrans = dataRate, txPower, nbTrans
+	dev.ADR.Change = change
 
 	// Set MAC command
 	lorawanDownlinkMac := message.GetMessage().GetLorawan().GetMacPayload()
@@ -217,6 +217,10 @@ func TestHandleDownlinkADR(t *testing.T) {
 		a.So(payload.ChMask[i], ShouldBeTrue)
 	}
 	a.So(payload.ChMask[8], ShouldBeFalse) // 9th channel (FSK) disabled
+	a.So(dev.ADR.Change, ShouldNotBeNil)
+	a.So(dev.ADR.Change.OldDataRate, ShouldEqual, "SF8BW125")
+	a.So(dev.ADR.Change.NewDataRate, ShouldEqual, "SF7BW125")
+	a.So(dev.ADR.Change.Reason, ShouldEqual, ADRReasonSNRMargin)
 
 	shouldHaveNbTrans := func(nbTrans int) {
 		a := New(t)
@@ -231,6 +235,8 @@ func TestHandleDownlinkADR(t *testing.T) {
 		a.So(payload.DataRate, ShouldEqual, 5) // SF7BW125
 		a.So(payload.TXPower, ShouldEqual, 1)  // 14
 		a.So(payload.Redundancy.NbRep, ShouldEqual, nbTrans)
+		a.So(dev.ADR.Change.NewNbTrans, ShouldEqual, nbTrans)
+		a.So(dev.ADR.Change.Reason, ShouldEqual, ADRReasonPacketLoss)
 		if a.Failed() {
 			_, file, line, _ := runtime.Caller(1)
 			t.Errorf("\n%s:%d", file, line)
@@ -0,0 +1,178 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"math"
+	"time"
+
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/api/trace"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/utils/toa"
+	"github.com/brocaar/lorawan"
+)
+
+// DevStatusInterval is the minimum time between two DevStatusReq commands to the same device
+var DevStatusInterval = 24 * time.Hour
+
+// Battery levels in the DevStatusAns (0 means external power, 255 means unknown)
+const (
+	batteryMin = 1
+	batteryMax = 254
+)
+
+// uplinkEnergy returns the energy (mJ) that the device spent on transmitting the uplink
+func uplinkEnergy(message *pb_broker.DeduplicatedUplinkMessage, txPower int) float64 {
+	lorawanMetadata := message.GetProtocolMetadata().GetLorawan()
+	if lorawanMetadata == nil || lorawanMetadata.Modulation != pb_lorawan.Modulation_LORA {
+		return 0
+	}
+	airtime, err := toa.ComputeLoRa(uint(len(message.Payload)), lorawanMetadata.DataRate, lorawanMetadata.CodingRate)
+	if err != nil {
+		return 0
+	}
+	return airtime.Seconds() * math.Pow(10, float64(txPower)/10)
+}
+
+func (n *networkServer) handleUplinkDevStatus(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device) error {
+	txPower := dev.ADR.TxPower
+	if txPower == 0 {
+		if fp, err := band.Get(message.GetProtocolMetadata().GetLorawan().GetFrequencyPlan().String()); err == nil {
+			txPower = fp.DefaultTXPower
+		}
+	}
+	dev.StatusUplinks++
+	dev.StatusEnergy += uplinkEnergy(message, txPower)
+
+	for _, cmd := range message.GetMessage().GetLorawan().GetMacPayload().FOpts {
+		if cmd.Cid != uint32(lorawan.DevStatusAns) {
+			continue
+		}
+		var answer lorawan.DevStatusAnsPayload
+		if err := answer.UnmarshalBinary(cmd.Payload); err != nil {
+			continue
+		}
+		message.Trace = message.Trace.WithEvent(trace.HandleMACEvent, macCMD, "dev-status",
+			"battery", answer.Battery,
+			"margin", answer.Margin,
+		)
+		history, err := n.devices.Battery(dev.AppEUI, dev.DevEUI)
+		if err != nil {
+			return err
+		}
+		if err := history.Push(&device.BatteryReport{
+			Time:    time.Now(),
+			Battery: answer.Battery,
+			Margin:  answer.Margin,
+			Uplinks: dev.StatusUplinks,
+			Energy:  dev.StatusEnergy,
+		}); err != nil {
+			return err
+		}
+		dev.StatusUplinks, dev.StatusEnergy = 0, 0
+	}
+
+	return nil
+}
+
+func (n *networkServer) handleDownlinkDevStatus(message *pb_broker.DownlinkMessage, dev *device.Device) error {
+	if time.Since(dev.LastStatusReq) < DevStatusInterval {
+		return nil
+	}
+	lorawanDownlinkMac := message.GetMessage().GetLorawan().GetMacPayload()
+	for _, existing := range lorawanDownlinkMac.FOpts {
+		if existing.Cid == uint32(lorawan.DevStatusReq) {
+			return nil
+		}
+	}
+	lorawanDownlinkMac.FOpts = append(lorawanDownlinkMac.FOpts, pb_lorawan.MACCommand{
+		Cid: uint32(lorawan.DevStatusReq),
+	})
+	dev.LastStatusReq = time.Now()
+	return nil
+}
+
+// estimateBattery estimates the battery life of a device from its battery reports (newest first).
+//
+// The reports since the last time that the battery was charged or replaced are used to calculate how many battery
+// levels the device consumes per mJ of uplink energy. The remaining battery life is then estimated with the energy per
+// uplink and the uplink interval of the most recent period, so that changes of the data rate or transmit power (ADR)
+// are taken into account.
+func estimateBattery(reports []*device.BatteryReport) *pb_lorawan.BatteryStatus {
+	if len(reports) == 0 {
+		return nil
+	}
+	status := &pb_lorawan.BatteryStatus{
+		Battery: uint32(reports[0].Battery),
+		History: make([]*pb_lorawan.BatteryReport, 0, len(reports)),
+	}
+	for _, report := range reports {
+		status.History = append(status.History, &pb_lorawan.BatteryReport{
+			Time:    report.Time.UnixNano(),
+			Battery: uint32(report.Battery),
+			Margin:  int32(report.Margin),
+		})
+	}
+
+	// Find the reports since the battery was last charged or replaced
+	discharge := reports[:1]
+	for i := 1; i < len(reports); i++ {
+		if reports[i].Battery < batteryMin || reports[i].Battery > batteryMax || reports[i].Battery < reports[i-1].Battery {
+			break
+		}
+		discharge = reports[:i+1]
+	}
+	newest, oldest := discharge[0], discharge[len(discharge)-1]
+	if newest.Battery < batteryMin || newest.Battery > batteryMax || newest.Battery == oldest.Battery {
+		return status
+	}
+
+	drop := float64(oldest.Battery - newest.Battery)
+	duration := newest.Time.Sub(oldest.Time)
+	if duration <= 0 {
+		return status
+	}
+	status.Trend = float32(-drop / duration.Hours() * 24)
+	remaining := float64(newest.Battery - batteryMin)
+
+	var energy float64
+	var uplinks uint32
+	for _, report := range discharge[:len(discharge)-1] {
+		energy += report.Energy
+		uplinks += report.Uplinks
+	}
+	if energy == 0 || uplinks == 0 {
+		// Without uplink energy, extrapolate the battery level over time
+		status.EstimatedLifetime = uint64(remaining / drop * duration.Seconds())
+		return status
+	}
+
+	energyPerUplink := energy / float64(uplinks)
+	if newest.Uplinks > 0 && newest.Energy > 0 {
+		energyPerUplink = newest.Energy / float64(newest.Uplinks)
+	}
+	uplinkInterval := duration.Seconds() / float64(uplinks)
+	if newest.Uplinks > 0 {
+		uplinkInterval = newest.Time.Sub(discharge[1].Time).Seconds() / float64(newest.Uplinks)
+	}
+	remainingUplinks := remaining / (drop / energy) / energyPerUplink
+	status.EstimatedLifetime = uint64(remainingUplinks * uplinkInterval)
+
+	return status
+}
+
+func (n *networkServer) getBatteryStatus(dev *device.Device) (*pb_lorawan.BatteryStatus, error) {
+	history, err := n.devices.Battery(dev.AppEUI, dev.DevEUI)
+	if err != nil {
+		return nil, err
+	}
+	reports, err := history.Get()
+	if err != nil {
+		return nil, err
+	}
+	return estimateBattery(reports), nil
+}
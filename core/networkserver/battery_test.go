@@ -0,0 +1,141 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	"github.com/brocaar/lorawan"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestUplinkEnergy(t *testing.T) {
+	a := New(t)
+	message := adrInitUplinkMessage()
+	message.Payload = make([]byte, 20)
+	message.ProtocolMetadata.GetLorawan().CodingRate = "4/5"
+	sf8 := uplinkEnergy(message, 14)
+	a.So(sf8, ShouldBeGreaterThan, 0)
+	a.So(uplinkEnergy(message, 11), ShouldBeLessThan, sf8)
+	message.ProtocolMetadata.GetLorawan().DataRate = "SF12BW125"
+	a.So(uplinkEnergy(message, 14), ShouldBeGreaterThan, sf8)
+	message.ProtocolMetadata.GetLorawan().DataRate = "INVALID"
+	a.So(uplinkEnergy(message, 14), ShouldEqual, 0)
+}
+
+func TestEstimateBattery(t *testing.T) {
+	a := New(t)
+
+	a.So(estimateBattery(nil), ShouldBeNil)
+
+	start := time.Now().Add(-20 * 24 * time.Hour)
+	day := 24 * time.Hour
+
+	// A single report can not be used for an estimate
+	status := estimateBattery([]*device.BatteryReport{
+		{Time: start, Battery: 250},
+	})
+	a.So(status.Battery, ShouldEqual, 250)
+	a.So(status.History, ShouldHaveLength, 1)
+	a.So(status.EstimatedLifetime, ShouldEqual, 0)
+
+	// Device on external power
+	status = estimateBattery([]*device.BatteryReport{
+		{Time: start.Add(10 * day), Battery: 0},
+		{Time: start, Battery: 250},
+	})
+	a.So(status.Battery, ShouldEqual, 0)
+	a.So(status.EstimatedLifetime, ShouldEqual, 0)
+
+	// Steady consumption of one level per day
+	reports := []*device.BatteryReport{
+		{Time: start.Add(20 * day), Battery: 230, Uplinks: 1000, Energy: 500},
+		{Time: start.Add(10 * day), Battery: 240, Uplinks: 1000, Energy: 500},
+		{Time: start, Battery: 250},
+	}
+	status = estimateBattery(reports)
+	a.So(status.Battery, ShouldEqual, 230)
+	a.So(status.History, ShouldHaveLength, 3)
+	a.So(status.Trend, ShouldAlmostEqual, -1, 0.001)
+	steady := time.Duration(status.EstimatedLifetime) * time.Second
+	a.So(steady.Hours()/24, ShouldAlmostEqual, 229, 0.01)
+
+	// Reports before the battery was replaced are ignored
+	status = estimateBattery(append(reports, &device.BatteryReport{Time: start.Add(-10 * day), Battery: 20}))
+	a.So(status.History, ShouldHaveLength, 4)
+	a.So(time.Duration(status.EstimatedLifetime)*time.Second, ShouldEqual, steady)
+
+	// Less energy per uplink (for example because of ADR) extends the battery life
+	reports[0].Energy = 250
+	status = estimateBattery(reports)
+	a.So(time.Duration(status.EstimatedLifetime)*time.Second, ShouldBeGreaterThan, steady)
+
+	// Without energy information, the battery level is extrapolated
+	status = estimateBattery([]*device.BatteryReport{
+		{Time: start.Add(20 * day), Battery: 230},
+		{Time: start, Battery: 250},
+	})
+	a.So((time.Duration(status.EstimatedLifetime)*time.Second).Hours()/24, ShouldAlmostEqual, 229, 0.01)
+}
+
+func TestHandleDevStatus(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{
+		devices: device.NewRedisDeviceStore(GetRedisClient(), "ns-test-handle-dev-status"),
+	}
+
+	appEUI := types.AppEUI([8]byte{1})
+	devEUI := types.DevEUI([8]byte{1})
+	history, _ := ns.devices.Battery(appEUI, devEUI)
+	defer history.Clear()
+	dev := &device.Device{AppEUI: appEUI, DevEUI: devEUI}
+
+	// A DevStatusReq is added to the downlink once per interval
+	message := adrInitDownlinkMessage()
+	err := ns.handleDownlinkDevStatus(message, dev)
+	a.So(err, ShouldBeNil)
+	fOpts := message.Message.GetLorawan().GetMacPayload().FOpts
+	a.So(fOpts, ShouldHaveLength, 2)
+	a.So(fOpts[1].Cid, ShouldEqual, lorawan.DevStatusReq)
+	a.So(dev.LastStatusReq.IsZero(), ShouldBeFalse)
+
+	message = adrInitDownlinkMessage()
+	err = ns.handleDownlinkDevStatus(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(message.Message.GetLorawan().GetMacPayload().FOpts, ShouldHaveLength, 1)
+
+	// Uplinks are counted
+	uplink := adrInitUplinkMessage()
+	uplink.Payload = make([]byte, 20)
+	uplink.ProtocolMetadata.GetLorawan().CodingRate = "4/5"
+	dev.ADR.TxPower = 14
+	err = ns.handleUplinkDevStatus(uplink, dev)
+	a.So(err, ShouldBeNil)
+	a.So(dev.StatusUplinks, ShouldEqual, 1)
+	a.So(dev.StatusEnergy, ShouldBeGreaterThan, 0)
+
+	// The DevStatusAns is stored in the history
+	answer := &lorawan.DevStatusAnsPayload{Battery: 200, Margin: 10}
+	answerPayload, _ := answer.MarshalBinary()
+	uplink.Message.GetLorawan().GetMacPayload().FOpts = []pb_lorawan.MACCommand{
+		pb_lorawan.MACCommand{Cid: uint32(lorawan.DevStatusAns), Payload: answerPayload},
+	}
+	err = ns.handleUplinkDevStatus(uplink, dev)
+	a.So(err, ShouldBeNil)
+	a.So(dev.StatusUplinks, ShouldEqual, 0)
+	a.So(dev.StatusEnergy, ShouldEqual, 0)
+
+	reports, err := history.Get()
+	a.So(err, ShouldBeNil)
+	a.So(reports, ShouldHaveLength, 1)
+	a.So(reports[0].Battery, ShouldEqual, 200)
+	a.So(reports[0].Margin, ShouldEqual, 10)
+	a.So(reports[0].Uplinks, ShouldEqual, 2)
+	a.So(reports[0].Energy, ShouldBeGreaterThan, 0)
+}
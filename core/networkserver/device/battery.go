@@ -0,0 +1,78 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/storage"
+	"github.com/TheThingsNetwork/ttn/core/types"
+)
+
+// BatteryHistory for a device
+type BatteryHistory interface {
+	Push(report *BatteryReport) error
+	Get() ([]*BatteryReport, error)
+	Clear() error
+}
+
+// RedisBatteryHistory implements the battery history in Redis
+type RedisBatteryHistory struct {
+	appEUI types.AppEUI
+	devEUI types.DevEUI
+	store  *storage.RedisQueueStore
+}
+
+// BatteryHistorySize for battery life estimation
+const BatteryHistorySize = 30
+
+// BatteryReport collected from a DevStatusAns
+type BatteryReport struct {
+	Time    time.Time `json:"time"`
+	Battery uint8     `json:"battery"`
+	Margin  int8      `json:"margin"`
+	Uplinks uint32    `json:"uplinks"` // number of uplinks since the previous report
+	Energy  float64   `json:"energy"`  // energy (mJ) spent on uplinks since the previous report
+}
+
+func (s *RedisBatteryHistory) key() string {
+	return fmt.Sprintf("%s:%s", s.appEUI, s.devEUI)
+}
+
+// Push a BatteryReport to the device's history
+func (s *RedisBatteryHistory) Push(report *BatteryReport) error {
+	reportBytes, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	if err := s.store.AddFront(s.key(), string(reportBytes)); err != nil {
+		return err
+	}
+	return s.Trim()
+}
+
+// Get the last reports from the device's history
+func (s *RedisBatteryHistory) Get() (out []*BatteryReport, err error) {
+	reports, err := s.store.GetFront(s.key(), BatteryHistorySize)
+	for _, reportStr := range reports {
+		report := new(BatteryReport)
+		if err := json.Unmarshal([]byte(reportStr), report); err != nil {
+			return nil, err
+		}
+		out = append(out, report)
+	}
+	return
+}
+
+// Trim reports in the device's history
+func (s *RedisBatteryHistory) Trim() error {
+	return s.store.Trim(s.key(), BatteryHistorySize)
+}
+
+// Clear reports in the device's history
+func (s *RedisBatteryHistory) Clear() error {
+	return s.store.Delete(s.key())
+}
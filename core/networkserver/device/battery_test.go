@@ -0,0 +1,65 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestBatteryStore(t *testing.T) {
+	a := New(t)
+	store := NewRedisDeviceStore(GetRedisClient(), "networkserver-test-battery-store")
+
+	appEUI := types.AppEUI{0, 0, 0, 0, 0, 0, 0, 1}
+	devEUI := types.DevEUI{0, 0, 0, 0, 0, 0, 0, 1}
+
+	s, err := store.Battery(appEUI, devEUI)
+	a.So(err, ShouldBeNil)
+
+	defer s.Clear()
+
+	{
+		err := s.Push(&BatteryReport{
+			Battery: 200,
+			Margin:  -3,
+			Uplinks: 10,
+			Energy:  1.5,
+		})
+		a.So(err, ShouldBeNil)
+	}
+
+	{
+		reports, err := s.Get()
+		a.So(err, ShouldBeNil)
+		a.So(reports, ShouldHaveLength, 1)
+		a.So(reports[0].Battery, ShouldEqual, 200)
+		a.So(reports[0].Margin, ShouldEqual, -3)
+		a.So(reports[0].Uplinks, ShouldEqual, 10)
+		a.So(reports[0].Energy, ShouldEqual, 1.5)
+	}
+
+	{
+		for i := 0; i < 35; i++ {
+			s.Push(&BatteryReport{
+				Battery: uint8(i + 1),
+			})
+		}
+		reports, err := s.Get()
+		a.So(err, ShouldBeNil)
+		a.So(reports, ShouldHaveLength, BatteryHistorySize)
+		a.So(reports[0].Battery, ShouldEqual, 35)
+	}
+
+	{
+		err := s.Clear()
+		a.So(err, ShouldBeNil)
+		reports, err := s.Get()
+		a.So(err, ShouldBeNil)
+		a.So(reports, ShouldBeEmpty)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"time"
 
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/fatih/structs"
 )
@@ -15,9 +16,14 @@ const currentDBVersion = "2.4.1"
 
 // Options for the specified device
 type Options struct {
-	ActivationConstraints string `json:"activation_constraints,omitempty"` // Activation Constraints (public/local/private)
-	DisableFCntCheck      bool   `json:"disable_fcnt_check,omitemtpy"`     // Disable Frame counter check (insecure)
-	Uses32BitFCnt         bool   `json:"uses_32_bit_fcnt,omitemtpy"`       // Use 32-bit Frame counters
+	ActivationConstraints string              `json:"activation_constraints,omitempty"` // Activation Constraints (public/local/private)
+	DisableFCntCheck      bool                `json:"disable_fcnt_check,omitemtpy"`     // Disable Frame counter check (insecure)
+	Uses32BitFCnt         bool                `json:"uses_32_bit_fcnt,omitemtpy"`       // Use 32-bit Frame counters
+	ResetsFCnt            bool                `json:"resets_fcnt,omitempty"`            // Accept Frame counter resets (insecure)
+	RxWindow              pb_lorawan.RxWindow `json:"rx_window,omitempty"`              // Receive window for downlink
+	Relay                 bool                `json:"relay,omitempty"`                  // Device is a relay for other devices
+	RegionalParameters    string              `json:"regional_parameters,omitempty"`    // Revision of the Regional Parameters
+	DevAddrAllocation     string              `json:"dev_addr_allocation,omitempty"`    // Strategy for the allocation of a DevAddr on join
 }
 
 // Device contains the state of a device
@@ -36,6 +42,16 @@ type Device struct {
 	Options  Options       `redis:"options"`
 	ADR      ADRSettings   `redis:"adr,include"`
 
+	// The relay that forwarded the last uplink of the device, and the FCnt of that uplink
+	RelayAppEUI types.AppEUI `redis:"relay_app_eui,omitempty"`
+	RelayDevEUI types.DevEUI `redis:"relay_dev_eui,omitempty"`
+	RelayFCnt   uint32       `redis:"relay_f_cnt,omitempty"`
+
+	// Uplinks and the energy (mJ) spent on them since the last DevStatusAns, and when the last DevStatusReq was sent
+	StatusUplinks uint32    `redis:"status_uplinks"`
+	StatusEnergy  float64   `redis:"status_energy"`
+	LastStatusReq time.Time `redis:"last_status_req"`
+
 	CreatedAt time.Time `redis:"created_at"`
 	UpdatedAt time.Time `redis:"updated_at"`
 }
@@ -53,6 +69,9 @@ type ADRSettings struct {
 	DataRate string `redis:"data_rate,omitempty"`
 	TxPower  int    `redis:"tx_power,omitempty"`
 	NbTrans  int    `redis:"nb_trans,omitempty"`
+
+	// The change that was sent in the last LinkADRReq; it is reported with the next uplink
+	Change *pb_lorawan.ADRChange `redis:"change"`
 }
 
 // StartUpdate stores the state of the device
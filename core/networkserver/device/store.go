@@ -22,6 +22,7 @@ type Store interface {
 	Set(new *Device, properties ...string) (err error)
 	Delete(appEUI types.AppEUI, devEUI types.DevEUI) error
 	Frames(appEUI types.AppEUI, devEUI types.DevEUI) (FrameHistory, error)
+	Battery(appEUI types.AppEUI, devEUI types.DevEUI) (BatteryHistory, error)
 }
 
 const defaultRedisPrefix = "ns"
@@ -29,6 +30,7 @@ const defaultRedisPrefix = "ns"
 const redisDevicePrefix = "device"
 const redisDevAddrPrefix = "dev_addr"
 const redisFramesPrefix = "frames"
+const redisBatteryPrefix = "battery"
 
 // NewRedisDeviceStore creates a new Redis-based status store
 func NewRedisDeviceStore(client *redis.Client, prefix string) Store {
@@ -41,11 +43,13 @@ func NewRedisDeviceStore(client *redis.Client, prefix string) Store {
 		store.AddMigration(v, f)
 	}
 	frameStore := storage.NewRedisQueueStore(client, prefix+":"+redisFramesPrefix)
+	batteryStore := storage.NewRedisQueueStore(client, prefix+":"+redisBatteryPrefix)
 	return &RedisDeviceStore{
 		client:       client,
 		prefix:       prefix,
 		store:        store,
 		frameStore:   frameStore,
+		batteryStore: batteryStore,
 		devAddrIndex: storage.NewRedisSetStore(client, prefix+":"+redisDevAddrPrefix),
 	}
 }
@@ -58,6 +62,7 @@ type RedisDeviceStore struct {
 	prefix       string
 	store        *storage.RedisMapStore
 	frameStore   *storage.RedisQueueStore
+	batteryStore *storage.RedisQueueStore
 	devAddrIndex *storage.RedisSetStore
 }
 
@@ -175,3 +180,12 @@ func (s *RedisDeviceStore) Frames(appEUI types.AppEUI, devEUI types.DevEUI) (Fra
 		store:  s.frameStore,
 	}, nil
 }
+
+// Battery history for a specific Device
+func (s *RedisDeviceStore) Battery(appEUI types.AppEUI, devEUI types.DevEUI) (BatteryHistory, error) {
+	return &RedisBatteryHistory{
+		appEUI: appEUI,
+		devEUI: devEUI,
+		store:  s.batteryStore,
+	}, nil
+}
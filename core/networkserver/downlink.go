@@ -65,5 +65,14 @@ func (n *networkServer) HandleDownlink(message *pb_broker.DownlinkMessage) (*pb_
 	}
 	message.Payload = bytes
 
+	// Send the downlink through the relay that forwarded the last uplink
+	if !dev.RelayDevEUI.IsEmpty() {
+		message.Trace = message.Trace.WithEvent("wrap relayed downlink", "relay", dev.RelayDevEUI)
+		message.Payload, err = n.wrapRelayDownlink(dev, bytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return message, nil
 }
@@ -35,11 +35,18 @@ func (n *networkServer) HandleGetDevices(req *pb.DevicesRequest) (*pb.DevicesRes
 			FCntUp:           device.FCntUp,
 			Uses32BitFCnt:    device.Options.Uses32BitFCnt,
 			DisableFCntCheck: device.Options.DisableFCntCheck,
+			ResetsFCnt:       device.Options.ResetsFCnt,
+			RxWindow:         device.Options.RxWindow,
+			Relay:            device.Options.Relay,
 		}
 		if device.Options.DisableFCntCheck {
 			res.Results = append(res.Results, dev)
 			continue
 		}
+		if device.Options.ResetsFCnt && req.FCnt == 0 {
+			res.Results = append(res.Results, dev)
+			continue
+		}
 		if device.FCntUp <= req.FCnt {
 			res.Results = append(res.Results, dev)
 			continue
@@ -132,4 +132,34 @@ func TestHandleGetDevices(t *testing.T) {
 	a.So(err, ShouldBeNil)
 	a.So(res.Results, ShouldHaveLength, 1)
 
+	// Frame Counter Reset
+	ns.devices.Set(&device.Device{
+		DevAddr: getDevAddr(3, 2, 3, 4),
+		AppEUI:  types.AppEUI(getEUI(3, 2, 3, 4, 5, 6, 7, 8)),
+		DevEUI:  types.DevEUI(getEUI(3, 2, 3, 4, 5, 6, 7, 8)),
+		NwkSKey: nwkSKey,
+		FCntUp:  42,
+		Options: device.Options{
+			ResetsFCnt: true,
+		},
+	})
+	defer func() {
+		ns.devices.Delete(types.AppEUI(getEUI(3, 2, 3, 4, 5, 6, 7, 8)), types.DevEUI(getEUI(3, 2, 3, 4, 5, 6, 7, 8)))
+	}()
+	devAddr5 := getDevAddr(3, 2, 3, 4)
+	res, err = ns.HandleGetDevices(&pb.DevicesRequest{
+		DevAddr: &devAddr5,
+		FCnt:    0,
+	})
+	a.So(err, ShouldBeNil)
+	a.So(res.Results, ShouldHaveLength, 1)
+	a.So(res.Results[0].ResetsFCnt, ShouldBeTrue)
+
+	res, err = ns.HandleGetDevices(&pb.DevicesRequest{
+		DevAddr: &devAddr5,
+		FCnt:    41,
+	})
+	a.So(err, ShouldBeNil)
+	a.So(res.Results, ShouldHaveLength, 0)
+
 }
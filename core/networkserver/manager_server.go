@@ -66,18 +66,30 @@ func (n *networkServerManager) GetDevice(ctx context.Context, in *pb_lorawan.Dev
 		lastSeen = dev.LastSeen
 	}
 
+	battery, err := n.networkServer.getBatteryStatus(dev)
+	if err != nil {
+		return nil, err
+	}
+
 	return &pb_lorawan.Device{
-		AppId:            dev.AppID,
-		AppEui:           &dev.AppEUI,
-		DevId:            dev.DevID,
-		DevEui:           &dev.DevEUI,
-		DevAddr:          &dev.DevAddr,
-		NwkSKey:          &dev.NwkSKey,
-		FCntUp:           dev.FCntUp,
-		FCntDown:         dev.FCntDown,
-		DisableFCntCheck: dev.Options.DisableFCntCheck,
-		Uses32BitFCnt:    dev.Options.Uses32BitFCnt,
-		LastSeen:         lastSeen.UnixNano(),
+		AppId:                 dev.AppID,
+		AppEui:                &dev.AppEUI,
+		DevId:                 dev.DevID,
+		DevEui:                &dev.DevEUI,
+		DevAddr:               &dev.DevAddr,
+		NwkSKey:               &dev.NwkSKey,
+		FCntUp:                dev.FCntUp,
+		FCntDown:              dev.FCntDown,
+		DisableFCntCheck:      dev.Options.DisableFCntCheck,
+		Uses32BitFCnt:         dev.Options.Uses32BitFCnt,
+		ResetsFCnt:            dev.Options.ResetsFCnt,
+		RxWindow:              dev.Options.RxWindow,
+		Relay:                 dev.Options.Relay,
+		RegionalParameters:    dev.Options.RegionalParameters,
+		DevAddrAllocation:     dev.Options.DevAddrAllocation,
+		ActivationConstraints: dev.Options.ActivationConstraints,
+		LastSeen:              lastSeen.UnixNano(),
+		Battery:               battery,
 	}, nil
 }
 
@@ -117,6 +129,11 @@ func (n *networkServerManager) SetDevice(ctx context.Context, in *pb_lorawan.Dev
 	dev.Options = device.Options{
 		DisableFCntCheck:      in.DisableFCntCheck,
 		Uses32BitFCnt:         in.Uses32BitFCnt,
+		ResetsFCnt:            in.ResetsFCnt,
+		RxWindow:              in.RxWindow,
+		Relay:                 in.Relay,
+		RegionalParameters:    in.RegionalParameters,
+		DevAddrAllocation:     in.DevAddrAllocation,
 		ActivationConstraints: in.ActivationConstraints,
 	}
 
@@ -168,7 +185,7 @@ func (n *networkServerManager) GetPrefixes(ctx context.Context, in *pb_lorawan.P
 }
 
 func (n *networkServerManager) GetDevAddr(ctx context.Context, in *pb_lorawan.DevAddrRequest) (*pb_lorawan.DevAddrResponse, error) {
-	devAddr, err := n.networkServer.getDevAddr(in.Usage...)
+	devAddr, err := n.networkServer.getDevAddr(nil, in.Usage...)
 	if err != nil {
 		return nil, err
 	}
@@ -9,6 +9,7 @@ import (
 	pb "github.com/TheThingsNetwork/ttn/api/networkserver"
 	"github.com/TheThingsNetwork/ttn/core/component"
 	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/storage"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 	"gopkg.in/redis.v5"
@@ -32,8 +33,9 @@ type NetworkServer interface {
 // NewRedisNetworkServer creates a new Redis-backed NetworkServer
 func NewRedisNetworkServer(client *redis.Client, netID int) NetworkServer {
 	ns := &networkServer{
-		devices:  device.NewRedisDeviceStore(client, "ns"),
-		prefixes: map[types.DevAddrPrefix][]string{},
+		devices:         device.NewRedisDeviceStore(client, "ns"),
+		devAddrCounters: storage.NewRedisKVStore(client, "ns:dev_addr_counter"),
+		prefixes:        map[types.DevAddrPrefix][]string{},
 	}
 	ns.netID = [3]byte{byte(netID >> 16), byte(netID >> 8), byte(netID)}
 	return ns
@@ -41,10 +43,11 @@ func NewRedisNetworkServer(client *redis.Client, netID int) NetworkServer {
 
 type networkServer struct {
 	*component.Component
-	devices  device.Store
-	netID    [3]byte
-	prefixes map[types.DevAddrPrefix][]string
-	status   *status
+	devices         device.Store
+	devAddrCounters *storage.RedisKVStore
+	netID           [3]byte
+	prefixes        map[types.DevAddrPrefix][]string
+	status          *status
 }
 
 func (n *networkServer) UsePrefix(prefix types.DevAddrPrefix, usage []string) error {
@@ -79,6 +82,7 @@ func (n *networkServer) GetPrefixesFor(requiredUsages ...string) []types.DevAddr
 func (n *networkServer) Init(c *component.Component) error {
 	n.Component = c
 	n.InitStatus()
+	n.Component.RegisterRateLoadSignal("uplink", n.status.uplink)
 	err := n.Component.UpdateTokenKey()
 	if err != nil {
 		return err
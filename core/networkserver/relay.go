@@ -0,0 +1,104 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/fcnt"
+	"github.com/brocaar/lorawan"
+)
+
+// handleRelayUplink unwraps the uplink that a relay forwarded, and stores the
+// relay on the end device, so that downlink can be sent through the relay
+func (n *networkServer) handleRelayUplink(message *pb_broker.DeduplicatedUplinkMessage, relay *device.Device) error {
+	// Decrypt a copy, as decryption happens in place
+	lorawanUplinkMsg := *message.GetMessage().GetLorawan()
+	lorawanUplinkMac := *lorawanUplinkMsg.GetMacPayload()
+	lorawanUplinkMac.FrmPayload = append([]byte{}, lorawanUplinkMac.FrmPayload...)
+	lorawanUplinkMsg.Payload = &pb_lorawan.Message_MacPayload{MacPayload: &lorawanUplinkMac}
+	if err := lorawanUplinkMsg.DecryptFRMPayload(types.AppSKey(relay.NwkSKey)); err != nil {
+		return err
+	}
+
+	var relayed pb_lorawan.RelayUplink
+	if err := relayed.UnmarshalBinary(lorawanUplinkMsg.GetMacPayload().GetFrmPayload()); err != nil {
+		return err
+	}
+
+	var phyPayload lorawan.PHYPayload
+	if err := phyPayload.UnmarshalBinary(relayed.Payload); err != nil {
+		return err
+	}
+	macPayload, ok := phyPayload.MACPayload.(*lorawan.MACPayload)
+	if !ok {
+		return errors.NewErrInvalidArgument("Relayed uplink", "does not contain a MAC payload")
+	}
+
+	devices, err := n.devices.ListForAddress(types.DevAddr(macPayload.FHDR.DevAddr))
+	if err != nil {
+		return err
+	}
+	originalFCnt := macPayload.FHDR.FCnt
+	for _, dev := range devices {
+		if dev == nil {
+			continue
+		}
+		macPayload.FHDR.FCnt = originalFCnt
+		if dev.Options.Uses32BitFCnt {
+			macPayload.FHDR.FCnt = fcnt.GetFull(dev.FCntUp, uint16(originalFCnt))
+		}
+		ok, err := phyPayload.ValidateMIC(lorawan.AES128Key(dev.NwkSKey))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		dev.StartUpdate()
+		dev.RelayAppEUI = relay.AppEUI
+		dev.RelayDevEUI = relay.DevEUI
+		dev.RelayFCnt = macPayload.FHDR.FCnt
+		return n.devices.Set(dev)
+	}
+
+	return errors.NewErrNotFound("device that validates MIC of relayed uplink")
+}
+
+// wrapRelayDownlink wraps the downlink PHYPayload of an end device in a downlink
+// to the relay that forwarded the last uplink of the end device
+func (n *networkServer) wrapRelayDownlink(dev *device.Device, payload []byte) ([]byte, error) {
+	relay, err := n.devices.Get(dev.RelayAppEUI, dev.RelayDevEUI)
+	if err != nil {
+		return nil, err
+	}
+
+	relay.StartUpdate()
+
+	var msg pb_lorawan.Message
+	mac := msg.InitDownlink()
+	mac.DevAddr = relay.DevAddr
+	mac.FCnt = relay.FCntDown
+	mac.FPort = pb_lorawan.RelayFPort
+	mac.FrmPayload = payload
+	if err := msg.EncryptFRMPayload(types.AppSKey(relay.NwkSKey)); err != nil {
+		return nil, err
+	}
+	phyPayload := msg.PHYPayload()
+	phyPayload.SetMIC(lorawan.AES128Key(relay.NwkSKey))
+	wrapped, err := phyPayload.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	relay.FCntDown++
+	if err := n.devices.Set(relay); err != nil {
+		return nil, err
+	}
+
+	return wrapped, nil
+}
@@ -0,0 +1,104 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"testing"
+
+	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_protocol "github.com/TheThingsNetwork/ttn/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	"github.com/brocaar/lorawan"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestHandleRelay(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{
+		Component: &component.Component{
+			Ctx: GetLogger(t, "TestHandleRelay"),
+		},
+		devices: device.NewRedisDeviceStore(GetRedisClient(), "ns-test-handle-relay"),
+	}
+
+	appEUI := types.AppEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 8))
+	relayEUI := types.DevEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 1))
+	devEUI := types.DevEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 2))
+	relayKey := types.NwkSKey{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+	devKey := types.NwkSKey{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}
+
+	relay := &device.Device{
+		AppEUI:   appEUI,
+		DevEUI:   relayEUI,
+		DevAddr:  getDevAddr(1, 2, 3, 1),
+		NwkSKey:  relayKey,
+		FCntDown: 5,
+		Options:  device.Options{Relay: true},
+	}
+	ns.devices.Set(relay)
+	ns.devices.Set(&device.Device{
+		AppEUI:  appEUI,
+		DevEUI:  devEUI,
+		DevAddr: getDevAddr(1, 2, 3, 2),
+		NwkSKey: devKey,
+	})
+	defer func() {
+		ns.devices.Delete(appEUI, relayEUI)
+		ns.devices.Delete(appEUI, devEUI)
+	}()
+
+	// Uplink of the end device
+	phy := lorawan.PHYPayload{
+		MHDR: lorawan.MHDR{
+			MType: lorawan.UnconfirmedDataUp,
+			Major: lorawan.LoRaWANR1,
+		},
+		MACPayload: &lorawan.MACPayload{
+			FHDR: lorawan.FHDR{
+				DevAddr: lorawan.DevAddr([4]byte{1, 2, 3, 2}),
+				FCnt:    42,
+			},
+		},
+	}
+	phy.SetMIC(lorawan.AES128Key(devKey))
+	devPayload, _ := phy.MarshalBinary()
+
+	// Forwarded by the relay
+	forwarded, _ := pb_lorawan.RelayUplink{DataRate: 5, Frequency: 868100000, Payload: devPayload}.MarshalBinary()
+	message := &pb_broker.DeduplicatedUplinkMessage{Message: new(pb_protocol.Message)}
+	msg := message.Message.InitLoRaWAN()
+	mac := msg.InitUplink()
+	mac.DevAddr = relay.DevAddr
+	mac.FPort = pb_lorawan.RelayFPort
+	mac.FrmPayload = forwarded
+	msg.EncryptFRMPayload(types.AppSKey(relayKey))
+
+	err := ns.handleRelayUplink(message, relay)
+	a.So(err, ShouldBeNil)
+
+	dev, _ := ns.devices.Get(appEUI, devEUI)
+	a.So(dev.RelayDevEUI, ShouldEqual, relayEUI)
+	a.So(dev.RelayFCnt, ShouldEqual, 42)
+
+	// Downlink to the end device is wrapped
+	wrapped, err := ns.wrapRelayDownlink(dev, []byte{1, 2, 3, 4})
+	a.So(err, ShouldBeNil)
+
+	var wrappedPHY lorawan.PHYPayload
+	a.So(wrappedPHY.UnmarshalBinary(wrapped), ShouldBeNil)
+	ok, _ := wrappedPHY.ValidateMIC(lorawan.AES128Key(relayKey))
+	a.So(ok, ShouldBeTrue)
+	wrappedPHY.DecryptFRMPayload(lorawan.AES128Key(relayKey))
+	wrappedMAC := wrappedPHY.MACPayload.(*lorawan.MACPayload)
+	a.So(*wrappedMAC.FPort, ShouldEqual, pb_lorawan.RelayFPort)
+	a.So(wrappedMAC.FHDR.FCnt, ShouldEqual, 5)
+	a.So(wrappedMAC.FRMPayload[0].(*lorawan.DataPayload).Bytes, ShouldResemble, []byte{1, 2, 3, 4})
+
+	relay, _ = ns.devices.Get(appEUI, relayEUI)
+	a.So(relay.FCntDown, ShouldEqual, 6)
+}
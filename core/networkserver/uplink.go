@@ -6,8 +6,11 @@ package networkserver
 import (
 	"time"
 
+	"github.com/TheThingsNetwork/go-utils/log"
 	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/api/trace"
+	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 )
 
@@ -42,11 +45,50 @@ func (n *networkServer) HandleUplink(message *pb_broker.DeduplicatedUplinkMessag
 		}
 	}()
 
+	if dev.Options.ResetsFCnt && lorawanUplinkMac.FCnt == 0 && dev.FCntUp != 0 {
+		// The device restarted and reset its frame counters
+		n.Ctx.WithFields(log.Fields{
+			"AppID":  dev.AppID,
+			"DevID":  dev.DevID,
+			"FCntUp": dev.FCntUp,
+		}).Warn("Device reset its frame counters")
+		dev.FCntDown = 0
+		frames, err := n.devices.Frames(dev.AppEUI, dev.DevEUI)
+		if err != nil {
+			return nil, err
+		}
+		if err := frames.Clear(); err != nil {
+			return nil, err
+		}
+	}
+
 	dev.FCntUp = lorawanUplinkMac.FCnt
 	dev.LastSeen = time.Now()
 
+	// Forget the relay if the device was heard directly
+	if !dev.RelayDevEUI.IsEmpty() && uint16(dev.RelayFCnt) != uint16(lorawanUplinkMac.FCnt) {
+		dev.RelayAppEUI = types.AppEUI{}
+		dev.RelayDevEUI = types.DevEUI{}
+		dev.RelayFCnt = 0
+	}
+
+	if dev.Options.Relay && lorawanUplinkMac.FPort == pb_lorawan.RelayFPort && len(lorawanUplinkMac.FrmPayload) > 0 {
+		message.Trace = message.Trace.WithEvent("unwrap relayed uplink")
+		if err := n.handleRelayUplink(message, dev); err != nil {
+			return nil, err
+		}
+	}
+
 	// Prepare Downlink
 	message.InitResponseTemplate()
+
+	// Only use the receive window that is configured for the device
+	if rxWindow := dev.Options.RxWindow; rxWindow != pb_lorawan.RxWindow_RX_AUTO {
+		if option := message.ResponseTemplate.DownlinkOption; option != nil && option.RxWindow != rxWindow {
+			message.ResponseTemplate.DownlinkOption = nil
+		}
+		message.ResponseTemplate.DiversityDownlinkOption = nil
+	}
 	lorawanDownlinkMsg := message.ResponseTemplate.Message.InitLoRaWAN()
 	lorawanDownlinkMac := lorawanDownlinkMsg.InitDownlink()
 	lorawanDownlinkMac.FPort = lorawanUplinkMac.FPort
@@ -55,6 +97,9 @@ func (n *networkServer) HandleUplink(message *pb_broker.DeduplicatedUplinkMessag
 	if lorawan := message.ResponseTemplate.GetDownlinkOption().GetProtocolConfig().GetLorawan(); lorawan != nil {
 		lorawan.FCnt = dev.FCntDown
 	}
+	if lorawan := message.ResponseTemplate.GetDiversityDownlinkOption().GetProtocolConfig().GetLorawan(); lorawan != nil {
+		lorawan.FCnt = dev.FCntDown
+	}
 
 	err = n.handleUplinkMAC(message, dev)
 	if err != nil {
@@ -33,11 +33,31 @@ func (n *networkServer) handleUplinkMAC(message *pb_broker.DeduplicatedUplinkMes
 		lorawanDownlinkMac.Ack = true
 	}
 
+	// Report the ADR change that was sent in the last downlink
+	if change := dev.ADR.Change; change != nil {
+		for _, cmd := range lorawanUplinkMac.FOpts {
+			if cmd.Cid != uint32(lorawan.LinkADRAns) {
+				continue
+			}
+			var answer lorawan.LinkADRAnsPayload
+			if err := answer.UnmarshalBinary(cmd.Payload); err == nil {
+				change.Accepted = answer.DataRateACK && answer.PowerACK && answer.ChannelMaskACK
+			}
+		}
+		message.AdrChange = change
+		dev.ADR.Change = nil
+	}
+
 	// Adaptive DataRate
 	if err := n.handleUplinkADR(message, dev); err != nil {
 		return err
 	}
 
+	// Device Status
+	if err := n.handleUplinkDevStatus(message, dev); err != nil {
+		return err
+	}
+
 	// MAC Commands
 	for _, cmd := range lorawanUplinkMac.FOpts {
 		switch cmd.Cid {
@@ -117,4 +117,46 @@ func TestHandleUplink(t *testing.T) {
 	dev, _ := ns.devices.Get(appEUI, devEUI)
 	a.So(dev.FCntUp, ShouldEqual, 1)
 	a.So(time.Now().Sub(dev.LastSeen), ShouldBeLessThan, 1*time.Second)
+
+	// Frame Counter Reset
+	dev.StartUpdate()
+	dev.FCntDown = 3
+	dev.Options.ResetsFCnt = true
+	ns.devices.Set(dev)
+
+	phy.MACPayload.(*lorawan.MACPayload).FHDR.FCnt = 0
+	bytes, _ = phy.MarshalBinary()
+	message.Payload = bytes
+	message.Message = nil
+	message.ResponseTemplate = &pb_broker.DownlinkMessage{DownlinkOption: &pb_broker.DownlinkOption{}}
+	_, err = ns.HandleUplink(message)
+	a.So(err, ShouldBeNil)
+
+	dev, _ = ns.devices.Get(appEUI, devEUI)
+	a.So(dev.FCntUp, ShouldEqual, 0)
+	a.So(dev.FCntDown, ShouldEqual, 0)
+
+	// Receive window that is not allowed for the device
+	dev.StartUpdate()
+	dev.Options.RxWindow = pb_lorawan.RxWindow_RX2
+	ns.devices.Set(dev)
+
+	phy.MACPayload.(*lorawan.MACPayload).FHDR.FCnt = 1
+	bytes, _ = phy.MarshalBinary()
+	message.Payload = bytes
+	message.Message = nil
+	message.ResponseTemplate = &pb_broker.DownlinkMessage{DownlinkOption: &pb_broker.DownlinkOption{RxWindow: pb_lorawan.RxWindow_RX1}}
+	res, err = ns.HandleUplink(message)
+	a.So(err, ShouldBeNil)
+	a.So(res.ResponseTemplate, ShouldBeNil)
+
+	// Allowed receive window
+	phy.MACPayload.(*lorawan.MACPayload).FHDR.FCnt = 2
+	bytes, _ = phy.MarshalBinary()
+	message.Payload = bytes
+	message.Message = nil
+	message.ResponseTemplate = &pb_broker.DownlinkMessage{DownlinkOption: &pb_broker.DownlinkOption{RxWindow: pb_lorawan.RxWindow_RX2}}
+	res, err = ns.HandleUplink(message)
+	a.So(err, ShouldBeNil)
+	a.So(res.ResponseTemplate, ShouldNotBeNil)
 }
@@ -78,10 +78,22 @@ func (h *paginatedHandler) ServeHTTP(res http.ResponseWriter, req *http.Request)
 		}
 	}
 
+	if cursor := req.URL.Query().Get("cursor"); cursor != "" {
+		req.Header.Set("Grpc-Metadata-Cursor", cursor)
+	}
+
+	if sort := req.URL.Query().Get("sort"); sort != "" {
+		req.Header.Set("Grpc-Metadata-Sort", sort)
+	}
+
+	for _, filter := range req.URL.Query()["filter"] {
+		req.Header.Add("Grpc-Metadata-Filter", filter)
+	}
+
 	h.handler.ServeHTTP(res, req)
 }
 
-// WithPagination wraps the handler so that each request gets the Limit and Offset values attached
+// WithPagination wraps the handler so that each request gets the Limit, Offset, Cursor, Sort and Filter values attached
 func WithPagination(h http.Handler) http.Handler {
 	return &paginatedHandler{h}
 }
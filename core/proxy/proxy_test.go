@@ -100,6 +100,16 @@ func TestPaginatedProxier(t *testing.T) {
 	a.So(hdl.req.Header.Get("Grpc-Metadata-Limit"), ShouldEqual, "42")
 	a.So(w.Code, ShouldEqual, http.StatusOK)
 
+	hdl = &testHandler{}
+	p = WithPagination(hdl)
+	req = httptest.NewRequest("GET", "/uri?limit=42&cursor=abc&sort=-last_seen&filter=dev_eui%3D0102030405060708&filter=dev_addr%3D01020304", nil)
+	w = httptest.NewRecorder()
+	p.ServeHTTP(w, req)
+	a.So(hdl.req.Header.Get("Grpc-Metadata-Cursor"), ShouldEqual, "abc")
+	a.So(hdl.req.Header.Get("Grpc-Metadata-Sort"), ShouldEqual, "-last_seen")
+	a.So(hdl.req.Header["Grpc-Metadata-Filter"], ShouldResemble, []string{"dev_eui=0102030405060708", "dev_addr=01020304"})
+	a.So(w.Code, ShouldEqual, http.StatusOK)
+
 	hdl = &testHandler{}
 	p = WithPagination(hdl)
 	req = httptest.NewRequest("GET", "/uri?offset=test", nil)
@@ -60,6 +60,7 @@ func (r *router) HandleActivation(gatewayID string, activation *pb.DeviceActivat
 		return nil, errors.NewErrInternal(fmt.Sprintf("Gateway %s not available for downlink", gatewayID))
 	}
 
+	r.refreshGatewayState(gateway)
 	downlinkOptions := r.buildDownlinkOptions(uplink, true, gateway)
 	activation.Trace = uplink.Trace.WithEvent(trace.BuildDownlinkEvent,
 		"options", len(downlinkOptions),
@@ -23,10 +23,11 @@ func TestHandleActivation(t *testing.T) {
 		Component: &component.Component{
 			Ctx: GetLogger(t, "TestHandleActivation"),
 		},
-		gateways: map[string]*gateway.Gateway{
-			gtwID: newReferenceGateway(t, "EU_863_870"),
-		},
+		gateways: newGatewayStore(),
 	}
+	r.gateways.GetOrCreate(gtwID, func() *gateway.Gateway {
+		return newReferenceGateway(t, "EU_863_870")
+	})
 	r.InitStatus()
 
 	appEUI := types.AppEUI{0, 1, 2, 3, 4, 5, 6, 7}
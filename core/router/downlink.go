@@ -85,7 +85,13 @@ func (r *router) HandleDownlink(downlink *pb_broker.DownlinkMessage) (err error)
 	}
 
 	gateway = r.getGateway(downlink.DownlinkOption.GatewayId)
-	return gateway.HandleDownlink(identifier, downlinkMessage)
+	if err = gateway.HandleDownlink(identifier, downlink.AppId, downlinkMessage); err != nil {
+		return err
+	}
+
+	// Share the scheduled transmission with other Router instances
+	r.saveGatewayState(gateway)
+	return nil
 }
 
 // buildDownlinkOption builds a DownlinkOption with default values
@@ -146,6 +152,7 @@ func (r *router) buildDownlinkOptions(uplink *pb.UplinkMessage, isActivation boo
 			option.GatewayConfig.Timestamp = uplink.GatewayMetadata.Timestamp + uint32(band.ReceiveDelay2/1000)
 		}
 		option.ProtocolConfig.GetLorawan().CodingRate = lorawanMetadata.CodingRate
+		option.RxWindow = pb_lorawan.RxWindow_RX2
 		return option, nil
 	}
 
@@ -162,6 +169,7 @@ func (r *router) buildDownlinkOptions(uplink *pb.UplinkMessage, isActivation boo
 			option.GatewayConfig.Timestamp = uplink.GatewayMetadata.Timestamp + uint32(band.ReceiveDelay1/1000)
 		}
 		option.ProtocolConfig.GetLorawan().CodingRate = lorawanMetadata.CodingRate
+		option.RxWindow = pb_lorawan.RxWindow_RX1
 
 		freq, err := band.GetRX1Frequency(int(uplink.GatewayMetadata.Frequency))
 		if err != nil {
@@ -278,19 +286,8 @@ func computeDownlinkScores(gateway *gateway.Gateway, uplink *pb.UplinkMessage, o
 
 			// European Duty Cycle
 			if frequencyPlan == "EU_863_870" {
-				var duty float64
-				switch {
-				case freq >= 863000000 && freq < 868000000:
-					duty = 0.01 // g 863.0 – 868.0 MHz 1%
-				case freq >= 868000000 && freq < 868600000:
-					duty = 0.01 // g1 868.0 – 868.6 MHz 1%
-				case freq >= 868700000 && freq < 869200000:
-					duty = 0.001 // g2 868.7 – 869.2 MHz 0.1%
-				case freq >= 869400000 && freq < 869650000:
-					duty = 0.1 // g3 869.4 – 869.65 MHz 10%
-				case freq >= 869700000 && freq < 870000000:
-					duty = 0.01 // g4 869.7 – 870.0 MHz 1%
-				default:
+				duty, err := band.DutyCycle(frequencyPlan, freq)
+				if err != nil {
 					utilizationScore += 100 // Transmissions on this frequency are forbidden
 				}
 				if channelTx > duty {
@@ -313,6 +310,11 @@ func computeDownlinkScores(gateway *gateway.Gateway, uplink *pb.UplinkMessage, o
 			}
 		}
 
-		option.Score = uint32((timeScore + signalScore + utilizationScore + scheduleScore) * 10)
+		healthScore := 0.0 // 0, or 20 if the gateway is gray-listed because it often fails to transmit
+		if gateway.TxHealth.GrayListed() {
+			healthScore += 20
+		}
+
+		option.Score = uint32((timeScore + signalScore + utilizationScore + scheduleScore + healthScore) * 10)
 	}
 }
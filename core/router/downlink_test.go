@@ -46,7 +46,7 @@ func TestHandleDownlink(t *testing.T) {
 			Ctx:     logger,
 			Monitor: monitor.NewClient(monitor.DefaultClientConfig),
 		},
-		gateways: map[string]*gateway.Gateway{},
+		gateways: newGatewayStore(),
 	}
 	r.InitStatus()
 
@@ -74,7 +74,7 @@ func TestSubscribeUnsubscribeDownlink(t *testing.T) {
 			Ctx:     logger,
 			Monitor: monitor.NewClient(monitor.DefaultClientConfig),
 		},
-		gateways: map[string]*gateway.Gateway{},
+		gateways: newGatewayStore(),
 	}
 	r.InitStatus()
 
@@ -389,6 +389,32 @@ func TestUplinkBuildDownlinkOptionsDataRate(t *testing.T) {
 		a.So(options[0].GatewayConfig.Frequency, ShouldEqual, 921900000)
 	}
 
+	gtw = newReferenceGateway(t, "ISM_2400")
+
+	// Supported datarates use RX1 (on the same datarate) for downlink
+	ttnISM2400DataRates := []string{
+		"SF5BW812",
+		"SF6BW812",
+		"SF7BW812",
+		"SF8BW812",
+		"SF9BW812",
+		"SF10BW812",
+		"SF11BW812",
+		"SF12BW812",
+	}
+	for _, dr := range ttnISM2400DataRates {
+		up := newReferenceUplink()
+		up.GatewayMetadata.Frequency = 2425000000
+		up.ProtocolMetadata.GetLorawan().DataRate = dr
+		options := r.buildDownlinkOptions(up, false, gtw)
+		a.So(options, ShouldHaveLength, 2)
+		a.So(options[1].ProtocolConfig.GetLorawan().DataRate, ShouldEqual, dr)
+		a.So(options[1].GatewayConfig.Frequency, ShouldEqual, 2425000000)
+		a.So(options[0].ProtocolConfig.GetLorawan().DataRate, ShouldEqual, "SF12BW812")
+		a.So(options[0].GatewayConfig.Frequency, ShouldEqual, 2423000000)
+		a.So(options[0].GatewayConfig.Power, ShouldEqual, 10)
+	}
+
 }
 
 // Note: This test uses r.buildDownlinkOptions which in turn calls computeDownlinkScores
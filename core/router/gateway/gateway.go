@@ -8,6 +8,7 @@ import (
 	"time"
 
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api"
 	"github.com/TheThingsNetwork/ttn/api/fields"
 	pb "github.com/TheThingsNetwork/ttn/api/gateway"
 	pb_monitor "github.com/TheThingsNetwork/ttn/api/monitor"
@@ -22,6 +23,7 @@ func NewGateway(ctx ttnlog.Interface, id string) *Gateway {
 		ID:          id,
 		Status:      NewStatusStore(),
 		Utilization: NewUtilization(),
+		TxHealth:    NewTxHealth(),
 		Schedule:    NewSchedule(ctx),
 		Ctx:         ctx,
 	}
@@ -34,12 +36,16 @@ type Gateway struct {
 	ID          string
 	Status      StatusStore
 	Utilization Utilization
+	TxHealth    TxHealth
 	Schedule    Schedule
 	LastSeen    time.Time
 
-	mu            sync.RWMutex // Protect token and authenticated
-	token         string
-	authenticated bool
+	mu             sync.RWMutex // Protect token, authenticated, maintenance, stateUpdatedAt and stateCheckedAt
+	token          string
+	authenticated  bool
+	maintenance    []*api.MaintenanceWindow
+	stateUpdatedAt time.Time
+	stateCheckedAt time.Time
 
 	Monitor       *pb_monitor.Client
 	MonitorStream pb_monitor.GenericStream
@@ -65,6 +71,52 @@ func (g *Gateway) SetAuth(token string, authenticated bool) {
 	}
 }
 
+// SetMaintenanceWindows sets the maintenance windows of the gateway
+func (g *Gateway) SetMaintenanceWindows(windows []*api.MaintenanceWindow) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.maintenance = windows
+}
+
+// MaintenanceWindows returns the maintenance windows of the gateway
+func (g *Gateway) MaintenanceWindows() []*api.MaintenanceWindow {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.maintenance
+}
+
+// InMaintenance returns whether the time is within one of the maintenance windows of the gateway
+func (g *Gateway) InMaintenance(t time.Time) bool {
+	return api.InMaintenance(g.MaintenanceWindows(), t)
+}
+
+// StateUpdatedAt returns the time at which the shared state of the gateway was last stored or restored
+func (g *Gateway) StateUpdatedAt() time.Time {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.stateUpdatedAt
+}
+
+// SetStateUpdatedAt sets the time at which the shared state of the gateway was last stored or restored
+func (g *Gateway) SetStateUpdatedAt(t time.Time) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.stateUpdatedAt = t
+}
+
+// CheckState returns true if the shared state of the gateway was not checked in the last interval, and marks it as
+// checked
+func (g *Gateway) CheckState(interval time.Duration) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	now := time.Now()
+	if now.Sub(g.stateCheckedAt) < interval {
+		return false
+	}
+	g.stateCheckedAt = now
+	return true
+}
+
 func (g *Gateway) updateLastSeen() {
 	g.LastSeen = time.Now()
 }
@@ -76,6 +128,7 @@ func (g *Gateway) HandleStatus(status *pb.Status) (err error) {
 	if err = g.Status.Update(status); err != nil {
 		return err
 	}
+	g.TxHealth.Update(status.TxIn, status.TxOk)
 	g.updateLastSeen()
 	return nil
 }
@@ -93,6 +146,18 @@ func (g *Gateway) HandleUplink(uplink *pb_router.UplinkMessage) (err error) {
 		if uplink.GatewayMetadata.Gps == nil {
 			uplink.GatewayMetadata.Gps = status.GetGps()
 		}
+		// Inject Antenna gain and location
+		for _, antenna := range uplink.GatewayMetadata.Antennas {
+			for _, configuration := range status.Antennas {
+				if configuration.Antenna != antenna.Antenna {
+					continue
+				}
+				antenna.Gain = configuration.Gain
+				if antenna.Gps == nil {
+					antenna.Gps = configuration.Gps
+				}
+			}
+		}
 		// Inject Gateway frequency plan
 		if frequencyPlan, ok := pb_lorawan.FrequencyPlan_value[status.FrequencyPlan]; ok {
 			if lorawan := uplink.GetProtocolMetadata().GetLorawan(); lorawan != nil {
@@ -109,9 +174,12 @@ func (g *Gateway) HandleUplink(uplink *pb_router.UplinkMessage) (err error) {
 	return nil
 }
 
-func (g *Gateway) HandleDownlink(identifier string, downlink *pb_router.DownlinkMessage) (err error) {
+func (g *Gateway) HandleDownlink(identifier string, appID string, downlink *pb_router.DownlinkMessage) (err error) {
 	ctx := g.Ctx.WithField("Identifier", identifier).WithFields(fields.Get(downlink))
-	if err = g.Schedule.Schedule(identifier, downlink); err != nil {
+	if appID != "" {
+		ctx = ctx.WithField("AppID", appID)
+	}
+	if err = g.Schedule.Schedule(identifier, appID, downlink); err != nil {
 		ctx.WithError(err).Warn("Could not schedule downlink")
 		return err
 	}
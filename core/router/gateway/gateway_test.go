@@ -6,6 +6,7 @@ package gateway
 import (
 	"testing"
 
+	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
 	. "github.com/TheThingsNetwork/ttn/utils/testing"
 	. "github.com/smartystreets/assertions"
 )
@@ -15,3 +16,31 @@ func TestNewGateway(t *testing.T) {
 	gtw := NewGateway(GetLogger(t, "TestNewGateway"), "eui-0102030405060708")
 	a.So(gtw, ShouldNotBeNil)
 }
+
+func TestHandleUplinkAntennas(t *testing.T) {
+	a := New(t)
+	gtw := NewGateway(GetLogger(t, "TestHandleUplinkAntennas"), "eui-0102030405060708")
+
+	sector := &pb_gateway.GPSMetadata{Latitude: 52.37, Longitude: 4.89, Altitude: 30}
+	gtw.HandleStatus(&pb_gateway.Status{Antennas: []*pb_gateway.AntennaConfiguration{
+		{Antenna: 0, Gain: 3},
+		{Antenna: 1, Gain: 8, Gps: sector},
+	}})
+
+	uplink := buildUplink(868100000)
+	uplink.GatewayMetadata.Antennas = []*pb_gateway.RxMetadata_Antenna{
+		{Antenna: 0, Rssi: -100, Snr: 5},
+		{Antenna: 1, Rssi: -90, Snr: 7},
+		{Antenna: 2, Rssi: -110, Snr: -2},
+	}
+	a.So(gtw.HandleUplink(uplink), ShouldBeNil)
+
+	antennas := uplink.GatewayMetadata.Antennas
+	a.So(antennas, ShouldHaveLength, 3)
+	a.So(antennas[0].Gain, ShouldEqual, 3)
+	a.So(antennas[0].Gps, ShouldBeNil)
+	a.So(antennas[1].Gain, ShouldEqual, 8)
+	a.So(antennas[1].Gps, ShouldEqual, sector)
+	a.So(antennas[1].Rssi, ShouldEqual, -90)
+	a.So(antennas[2].Gain, ShouldEqual, 0)
+}
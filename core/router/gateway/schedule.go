@@ -5,6 +5,7 @@ package gateway
 
 import (
 	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -15,6 +16,8 @@ import (
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 	"github.com/TheThingsNetwork/ttn/utils/random"
 	"github.com/TheThingsNetwork/ttn/utils/toa"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
 // Schedule is used to schedule downlink transmissions
@@ -24,14 +27,35 @@ type Schedule interface {
 	Sync(timestamp uint32)
 	// Get an "option" on a transmission slot at timestamp for the maximum duration of length (both in microseconds)
 	GetOption(timestamp uint32, length uint32) (id string, score uint)
-	// Schedule a transmission on a slot
-	Schedule(id string, downlink *router_pb.DownlinkMessage) error
+	// Schedule a transmission of a downlink of an application on a slot
+	Schedule(id string, appID string, downlink *router_pb.DownlinkMessage) error
+	// Get the number of transmissions that are waiting to be sent, in total and per application
+	Queued() (total int, perApplication map[string]int)
 	// Subscribe to downlink messages
 	Subscribe(subscriptionID string) <-chan *router_pb.DownlinkMessage
 	// Whether the gateway has active downlink
 	IsActive() bool
 	// Stop the subscription
 	Stop(subscriptionID string)
+	// Snapshot returns the transmissions that are waiting to be sent, so that they can be restored in another Schedule
+	Snapshot() ScheduleSnapshot
+	// Restore the transmissions of a snapshot that are still waiting to be sent. Restored transmissions conflict with
+	// new transmissions, but are not sent by this Schedule. They replace the transmissions of previous snapshots.
+	Restore(snapshot ScheduleSnapshot)
+}
+
+// ScheduleSnapshot contains the transmissions that are waiting to be sent by a Schedule
+type ScheduleSnapshot struct {
+	Transmissions []ScheduledTransmission `json:"transmissions,omitempty"`
+}
+
+// ScheduledTransmission is a transmission slot of a downlink that is waiting to be sent
+type ScheduledTransmission struct {
+	ID         string    `json:"id"`
+	AppID      string    `json:"app_id,omitempty"`
+	DeadlineAt time.Time `json:"deadline_at"`
+	Timestamp  uint32    `json:"timestamp"`
+	Length     uint32    `json:"length"`
 }
 
 // NewSchedule creates a new Schedule
@@ -64,17 +88,25 @@ func NewSchedule(ctx ttnlog.Interface) Schedule {
 
 type scheduledItem struct {
 	id         string
+	appID      string
 	deadlineAt time.Time
 	timestamp  uint32
 	length     uint32
 	score      uint
 	payload    *router_pb.DownlinkMessage
+	restored   bool // The transmission is sent by another Schedule
 }
 
 type schedule struct {
 	sync.RWMutex
 	ctx                       ttnlog.Interface
 	offset                    int64
+	syncLock                  sync.RWMutex // Protect the fields below
+	lastTimestamp             uint32
+	driftTimestamp            uint32
+	driftAt                   time.Time
+	drift                     float64
+	driftKnown                bool
 	items                     map[string]*scheduledItem
 	downlink                  chan *router_pb.DownlinkMessage
 	downlinkSubscriptionsLock sync.RWMutex
@@ -95,6 +127,10 @@ func (s *schedule) GoString() (str string) {
 // TODO: Make configurable
 var Deadline = 800 * time.Millisecond
 
+// MaxQueuedDownlinksPerApplication is the maximum number of transmissions of a single application that can be queued
+// for a gateway, so that one application can not take up all downlink capacity of the gateway. Zero means no limit.
+var MaxQueuedDownlinksPerApplication = 8
+
 const uintmax = 1 << 32
 
 // getConflicts walks over the schedule and returns the number of conflicts.
@@ -116,7 +152,7 @@ func (s *schedule) getConflicts(timestamp uint32, length uint32) (conflicts uint
 			continue
 		}
 
-		if item.payload == nil {
+		if item.payload == nil && !item.restored {
 			conflicts++
 		} else {
 			conflicts += 100
@@ -125,10 +161,28 @@ func (s *schedule) getConflicts(timestamp uint32, length uint32) (conflicts uint
 	return
 }
 
+// The clock drift of a gateway is measured over intervals of driftInterval. Shorter
+// intervals are too sensitive to variations in the latency of uplink messages.
+const driftInterval = 5 * time.Minute
+
+// Measurements where the timestamps drift more than maxDrift from the wall clock
+// are discarded. This happens when a gateway restarts or when messages are delayed.
+const maxDrift = 1e-3
+
+// driftAlpha is the weight of a new measurement in the moving average of the drift
+const driftAlpha = 0.2
+
 // realtime gets the synchronized time for a timestamp (in microseconds). Time
 // should first be syncronized using func Sync()
 func (s *schedule) realtime(timestamp uint32) (t time.Time) {
 	offset := atomic.LoadInt64(&s.offset)
+	s.syncLock.RLock()
+	if s.driftKnown {
+		// Compensate for the clock drift since the last sync
+		sinceSync := float64(int32(timestamp-s.lastTimestamp)) * 1000
+		offset += int64(sinceSync * s.drift)
+	}
+	s.syncLock.RUnlock()
 	t = time.Unix(0, 0)
 	t = t.Add(time.Duration(int64(timestamp)*1000 + offset))
 	if t.Before(time.Now()) {
@@ -139,7 +193,36 @@ func (s *schedule) realtime(timestamp uint32) (t time.Time) {
 
 // see interface
 func (s *schedule) Sync(timestamp uint32) {
-	atomic.StoreInt64(&s.offset, time.Now().UnixNano()-int64(timestamp)*1000)
+	now := time.Now()
+	atomic.StoreInt64(&s.offset, now.UnixNano()-int64(timestamp)*1000)
+
+	s.syncLock.Lock()
+	defer s.syncLock.Unlock()
+	s.lastTimestamp = timestamp
+	if s.driftAt.IsZero() {
+		s.driftTimestamp, s.driftAt = timestamp, now
+		return
+	}
+	elapsed := now.Sub(s.driftAt)
+	if elapsed < driftInterval {
+		return
+	}
+	counted := time.Duration(timestamp-s.driftTimestamp) * time.Microsecond
+	s.driftTimestamp, s.driftAt = timestamp, now
+	if elapsed > 4*driftInterval || counted == 0 {
+		// The timestamp counter (32 bit microseconds) could have wrapped
+		return
+	}
+	drift := float64(elapsed-counted) / float64(counted)
+	if math.Abs(drift) > maxDrift {
+		return
+	}
+	if s.driftKnown {
+		s.drift += driftAlpha * (drift - s.drift)
+	} else {
+		s.drift, s.driftKnown = drift, true
+	}
+	s.ctx.WithField("Drift", fmt.Sprintf("%.1fppm", s.drift*1e6)).Debug("Updated clock drift")
 }
 
 // see interface
@@ -159,13 +242,50 @@ func (s *schedule) GetOption(timestamp uint32, length uint32) (id string, score
 	return id, score
 }
 
+// queued returns whether the item is waiting to be sent to the gateway
+func (i *scheduledItem) queued(now time.Time) bool {
+	return (i.payload != nil || i.restored) && now.Before(i.deadlineAt)
+}
+
+// see interface
+func (s *schedule) Queued() (total int, perApplication map[string]int) {
+	now := time.Now()
+	perApplication = make(map[string]int)
+	s.RLock()
+	defer s.RUnlock()
+	for _, item := range s.items {
+		if !item.queued(now) {
+			continue
+		}
+		total++
+		if item.appID != "" {
+			perApplication[item.appID]++
+		}
+	}
+	return
+}
+
 // see interface
-func (s *schedule) Schedule(id string, downlink *router_pb.DownlinkMessage) error {
+func (s *schedule) Schedule(id string, appID string, downlink *router_pb.DownlinkMessage) error {
 	ctx := s.ctx.WithField("Identifier", id)
 
 	s.Lock()
 	defer s.Unlock()
-	if item, ok := s.items[id]; ok {
+	if item, ok := s.items[id]; ok && !item.restored {
+		if appID != "" && MaxQueuedDownlinksPerApplication > 0 {
+			var queued int
+			now := time.Now()
+			for _, other := range s.items {
+				if other.id != id && other.appID == appID && other.queued(now) {
+					queued++
+				}
+			}
+			if queued >= MaxQueuedDownlinksPerApplication {
+				return grpc.Errorf(codes.ResourceExhausted, "Application %s reached the limit of %d queued downlinks for this gateway", appID, MaxQueuedDownlinksPerApplication)
+			}
+		}
+
+		item.appID = appID
 		item.payload = downlink
 
 		if lorawan := downlink.GetProtocolConfiguration().GetLorawan(); lorawan != nil {
@@ -274,6 +394,51 @@ func (s *schedule) Subscribe(subscriptionID string) <-chan *router_pb.DownlinkMe
 	return sub
 }
 
+// see interface
+func (s *schedule) Snapshot() (snapshot ScheduleSnapshot) {
+	now := time.Now()
+	s.RLock()
+	defer s.RUnlock()
+	for _, item := range s.items {
+		if !item.queued(now) {
+			continue
+		}
+		snapshot.Transmissions = append(snapshot.Transmissions, ScheduledTransmission{
+			ID:         item.id,
+			AppID:      item.appID,
+			DeadlineAt: item.deadlineAt,
+			Timestamp:  item.timestamp,
+			Length:     item.length,
+		})
+	}
+	return
+}
+
+// see interface
+func (s *schedule) Restore(snapshot ScheduleSnapshot) {
+	now := time.Now()
+	s.Lock()
+	defer s.Unlock()
+	for id, item := range s.items {
+		if item.restored {
+			delete(s.items, id)
+		}
+	}
+	for _, transmission := range snapshot.Transmissions {
+		if _, ok := s.items[transmission.ID]; ok || !now.Before(transmission.DeadlineAt) {
+			continue
+		}
+		s.items[transmission.ID] = &scheduledItem{
+			id:         transmission.ID,
+			appID:      transmission.AppID,
+			deadlineAt: transmission.DeadlineAt,
+			timestamp:  transmission.Timestamp,
+			length:     transmission.Length,
+			restored:   true,
+		}
+	}
+}
+
 func (s *schedule) IsActive() bool {
 	s.RLock()
 	defer s.RUnlock()
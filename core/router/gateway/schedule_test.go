@@ -42,6 +42,27 @@ func TestScheduleRealtime(t *testing.T) {
 	a.So(tm.UnixNano(), ShouldAlmostEqual, time.Now().UnixNano()+9*1000, almostEqual)
 }
 
+func TestScheduleDrift(t *testing.T) {
+	a := New(t)
+	s := &schedule{ctx: GetLogger(t, "TestScheduleDrift")}
+	s.Sync(0)
+
+	// Gateway clock runs 100ppm slow: 10 minutes on the wall clock are 599.94 seconds on the gateway
+	s.driftAt = s.driftAt.Add(-10 * time.Minute)
+	s.Sync(599940000)
+	a.So(s.driftKnown, ShouldBeTrue)
+	a.So(s.drift, ShouldAlmostEqual, 100e-6, 1e-6)
+
+	// 1000 seconds after the sync, the gateway is 100ms behind
+	tm := s.realtime(599940000 + 1000000000)
+	a.So(tm.UnixNano(), ShouldAlmostEqual, time.Now().Add(1000*time.Second+100*time.Millisecond).UnixNano(), almostEqual)
+
+	// Measurements that drift too much are discarded
+	s.driftAt = s.driftAt.Add(-10 * time.Minute)
+	s.Sync(599940000 + 300000000)
+	a.So(s.drift, ShouldAlmostEqual, 100e-6, 1e-6)
+}
+
 func buildItems(items ...*scheduledItem) map[string]*scheduledItem {
 	m := make(map[string]*scheduledItem)
 	for idx, item := range items {
@@ -107,17 +128,93 @@ func TestScheduleSchedule(t *testing.T) {
 
 	s.Sync(0)
 
-	err := s.Schedule("random", &router_pb.DownlinkMessage{})
+	err := s.Schedule("random", "", &router_pb.DownlinkMessage{})
 	a.So(err, ShouldNotBeNil)
 
 	id, conflicts := s.GetOption(100, 100)
-	err = s.Schedule(id, &router_pb.DownlinkMessage{})
+	err = s.Schedule(id, "", &router_pb.DownlinkMessage{})
 	a.So(err, ShouldBeNil)
 
 	_, conflicts = s.GetOption(50, 100)
 	a.So(conflicts, ShouldEqual, 100)
 }
 
+func TestScheduleQueued(t *testing.T) {
+	a := New(t)
+	s := NewSchedule(GetLogger(t, "TestScheduleQueued")).(*schedule)
+	s.Sync(0)
+
+	defer func(max int) { MaxQueuedDownlinksPerApplication = max }(MaxQueuedDownlinksPerApplication)
+	MaxQueuedDownlinksPerApplication = 2
+
+	total, perApplication := s.Queued()
+	a.So(total, ShouldEqual, 0)
+	a.So(perApplication, ShouldBeEmpty)
+
+	// Options without a downlink are not queued
+	s.GetOption(10000000, 100)
+
+	for i := 0; i < 2; i++ {
+		id, _ := s.GetOption(uint32(20000000+i*1000000), 100)
+		a.So(s.Schedule(id, "app", &router_pb.DownlinkMessage{}), ShouldBeNil)
+	}
+
+	// The application reached its limit, other applications can still use the gateway
+	id, _ := s.GetOption(30000000, 100)
+	err := s.Schedule(id, "app", &router_pb.DownlinkMessage{})
+	a.So(err, ShouldNotBeNil)
+	a.So(s.Schedule(id, "other-app", &router_pb.DownlinkMessage{}), ShouldBeNil)
+
+	total, perApplication = s.Queued()
+	a.So(total, ShouldEqual, 3)
+	a.So(perApplication, ShouldResemble, map[string]int{"app": 2, "other-app": 1})
+
+	// Downlinks that passed their deadline are no longer queued
+	item := &scheduledItem{deadlineAt: time.Now(), payload: &router_pb.DownlinkMessage{}}
+	a.So(item.queued(time.Now().Add(-1*time.Second)), ShouldBeTrue)
+	a.So(item.queued(time.Now().Add(time.Second)), ShouldBeFalse)
+}
+
+func TestScheduleSnapshot(t *testing.T) {
+	a := New(t)
+	s := NewSchedule(GetLogger(t, "TestScheduleSnapshot")).(*schedule)
+	s.Sync(0)
+
+	// Options without a downlink are not in the snapshot
+	s.GetOption(10000000, 100)
+	id, _ := s.GetOption(20000000, 100)
+	a.So(s.Schedule(id, "app", &router_pb.DownlinkMessage{}), ShouldBeNil)
+
+	snapshot := s.Snapshot()
+	a.So(snapshot.Transmissions, ShouldHaveLength, 1)
+	a.So(snapshot.Transmissions[0].ID, ShouldEqual, id)
+	a.So(snapshot.Transmissions[0].AppID, ShouldEqual, "app")
+
+	other := NewSchedule(GetLogger(t, "TestScheduleSnapshot")).(*schedule)
+	other.Sync(0)
+	other.Restore(snapshot)
+
+	// Restored transmissions conflict with new transmissions and count as queued
+	_, conflicts := other.GetOption(20000050, 100)
+	a.So(conflicts, ShouldEqual, 100)
+	total, perApplication := other.Queued()
+	a.So(total, ShouldEqual, 1)
+	a.So(perApplication, ShouldResemble, map[string]int{"app": 1})
+
+	// Restored transmissions are not sent by the other schedule
+	a.So(other.Schedule(id, "app", &router_pb.DownlinkMessage{}), ShouldNotBeNil)
+
+	// Restoring a snapshot replaces the previously restored transmissions
+	other.Restore(ScheduleSnapshot{})
+	total, _ = other.Queued()
+	a.So(total, ShouldEqual, 0)
+
+	// Transmissions that passed their deadline are not restored
+	other.Restore(ScheduleSnapshot{Transmissions: []ScheduledTransmission{{ID: "late", DeadlineAt: time.Now().Add(-1 * time.Second)}}})
+	total, _ = other.Queued()
+	a.So(total, ShouldEqual, 0)
+}
+
 func TestScheduleSubscribe(t *testing.T) {
 	a := New(t)
 	s := NewSchedule(GetLogger(t, "TestScheduleSubscribe")).(*schedule)
@@ -144,11 +241,11 @@ func TestScheduleSubscribe(t *testing.T) {
 	}()
 
 	id, _ := s.GetOption(30000, 50)
-	s.Schedule(id, downlink1)
+	s.Schedule(id, "", downlink1)
 	id, _ = s.GetOption(20000, 50)
-	s.Schedule(id, downlink2)
+	s.Schedule(id, "", downlink2)
 	id, _ = s.GetOption(40000, 50)
-	s.Schedule(id, downlink3)
+	s.Schedule(id, "", downlink3)
 
 	go func() {
 		<-time.After(400 * time.Millisecond)
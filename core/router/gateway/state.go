@@ -0,0 +1,118 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package gateway
+
+import (
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/api"
+	pb "github.com/TheThingsNetwork/ttn/api/gateway"
+	"github.com/TheThingsNetwork/ttn/core/storage"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	redis "gopkg.in/redis.v5"
+)
+
+// State is the part of the gateway state that is shared between Router instances
+type State struct {
+	GatewayID   string              `redis:"gateway_id"`
+	RouterID    string              `redis:"router_id"` // The Router instance that last served the gateway
+	LastSeen    time.Time           `redis:"last_seen"`
+	Status      *pb.Status          `redis:"status"`
+	Utilization UtilizationSnapshot `redis:"utilization"`
+	TxHealth    TxHealthSnapshot    `redis:"tx_health"`
+	Schedule    ScheduleSnapshot    `redis:"schedule"`
+
+	MaintenanceWindows []*api.MaintenanceWindow `redis:"maintenance_windows"`
+
+	UpdatedAt time.Time `redis:"updated_at"`
+}
+
+// StateStore stores the shared state of gateways
+type StateStore interface {
+	Get(gatewayID string) (*State, error)
+	Set(state *State) error
+	Delete(gatewayID string) error
+}
+
+const defaultRedisPrefix = "router"
+const redisStatePrefix = "gateway"
+
+// NewRedisStateStore creates a new Redis-based gateway state store
+// if an empty prefix is passed, a default prefix will be used.
+func NewRedisStateStore(client *redis.Client, prefix string) StateStore {
+	if prefix == "" {
+		prefix = defaultRedisPrefix
+	}
+	store := storage.NewRedisMapStore(client, prefix+":"+redisStatePrefix)
+	store.SetBase(State{}, "")
+	return &RedisStateStore{
+		store: store,
+	}
+}
+
+// RedisStateStore stores gateway state in Redis.
+// - States are stored as a Hash
+type RedisStateStore struct {
+	store *storage.RedisMapStore
+}
+
+// Get the state of a specific gateway
+func (s *RedisStateStore) Get(gatewayID string) (*State, error) {
+	stateI, err := s.store.Get(gatewayID)
+	if err != nil {
+		return nil, err
+	}
+	if state, ok := stateI.(State); ok {
+		return &state, nil
+	}
+	return nil, errors.New("Database did not return a gateway State")
+}
+
+// Set the state of a gateway
+func (s *RedisStateStore) Set(state *State) error {
+	state.UpdatedAt = time.Now()
+	return s.store.Set(state.GatewayID, *state)
+}
+
+// Delete the state of a gateway
+func (s *RedisStateStore) Delete(gatewayID string) error {
+	return s.store.Delete(gatewayID)
+}
+
+// GetState returns the state of the gateway that is shared with other Router instances
+func (g *Gateway) GetState() *State {
+	state := &State{
+		GatewayID:   g.ID,
+		LastSeen:    g.LastSeen,
+		Utilization: g.Utilization.Snapshot(),
+		TxHealth:    g.TxHealth.Snapshot(),
+		Schedule:    g.Schedule.Snapshot(),
+
+		MaintenanceWindows: g.MaintenanceWindows(),
+	}
+	if status, err := g.Status.Get(); err == nil {
+		state.Status = status
+	}
+	return state
+}
+
+// RestoreState restores a new gateway from the state that was stored by another Router instance. It should be called
+// before the gateway is used.
+func (g *Gateway) RestoreState(state *State) {
+	g.LastSeen = state.LastSeen
+	g.RefreshState(state)
+}
+
+// RefreshState restores the state that was stored by another Router instance into a gateway that is in use. The
+// LastSeen time is kept, as this Router instance just received a message from the gateway.
+func (g *Gateway) RefreshState(state *State) {
+	if state.Status != nil {
+		g.Status.Update(state.Status)
+	}
+	g.Utilization.Restore(state.Utilization)
+	g.TxHealth.Restore(state.TxHealth)
+	g.Schedule.Restore(state.Schedule)
+	g.SetMaintenanceWindows(state.MaintenanceWindows)
+	g.SetStateUpdatedAt(state.UpdatedAt)
+}
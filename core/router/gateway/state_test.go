@@ -0,0 +1,92 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/api"
+	pb "github.com/TheThingsNetwork/ttn/api/gateway"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestRedisStateStore(t *testing.T) {
+	a := New(t)
+	s := NewRedisStateStore(GetRedisClient(), "router-test-state-store")
+
+	_, err := s.Get("eui-0102030405060708")
+	a.So(err, ShouldNotBeNil)
+	a.So(errors.GetErrType(err), ShouldEqual, errors.NotFound)
+
+	lastSeen := time.Now().Round(time.Millisecond)
+	err = s.Set(&State{
+		GatewayID: "eui-0102030405060708",
+		RouterID:  "router-1",
+		LastSeen:  lastSeen,
+		Status:    &pb.Status{Description: "Test Gateway"},
+		Utilization: UtilizationSnapshot{
+			OverallRx: 12.5,
+			ChannelRx: map[uint64]float64{868100000: 12.5},
+		},
+		Schedule: ScheduleSnapshot{
+			Transmissions: []ScheduledTransmission{{ID: "id", AppID: "app", DeadlineAt: lastSeen, Timestamp: 20000000, Length: 100}},
+		},
+	})
+	a.So(err, ShouldBeNil)
+	defer s.Delete("eui-0102030405060708")
+
+	state, err := s.Get("eui-0102030405060708")
+	a.So(err, ShouldBeNil)
+	a.So(state.RouterID, ShouldEqual, "router-1")
+	a.So(state.LastSeen.Equal(lastSeen), ShouldBeTrue)
+	a.So(state.Status.Description, ShouldEqual, "Test Gateway")
+	a.So(state.Utilization.ChannelRx[868100000], ShouldEqual, 12.5)
+	a.So(state.Schedule.Transmissions, ShouldHaveLength, 1)
+	a.So(state.Schedule.Transmissions[0].Timestamp, ShouldEqual, 20000000)
+}
+
+func TestGatewayState(t *testing.T) {
+	a := New(t)
+	ctx := GetLogger(t, "TestGatewayState")
+
+	gtw := NewGateway(ctx, "eui-0102030405060708")
+	gtw.HandleStatus(&pb.Status{Description: "Test Gateway", TxIn: 20, TxOk: 5})
+	gtw.Utilization.AddRx(buildUplink(8680000000))
+	gtw.Utilization.AddTx(buildDownlink(8680000000))
+	gtw.Utilization.Tick()
+	gtw.SetMaintenanceWindows([]*api.MaintenanceWindow{{Start: time.Now().UnixNano(), Duration: 3600, Recurrence: api.RecurrenceWeekly}})
+	gtw.Schedule.Sync(0)
+	id, _ := gtw.Schedule.GetOption(20000000, 100)
+	gtw.Schedule.Schedule(id, "app", buildDownlink(8680000000))
+
+	state := gtw.GetState()
+	a.So(state.GatewayID, ShouldEqual, "eui-0102030405060708")
+	a.So(state.Status.Description, ShouldEqual, "Test Gateway")
+
+	other := NewGateway(ctx, "eui-0102030405060708")
+	other.RestoreState(state)
+	status, _ := other.Status.Get()
+	a.So(status.Description, ShouldEqual, "Test Gateway")
+	a.So(other.LastSeen, ShouldResemble, gtw.LastSeen)
+
+	rx, tx := gtw.Utilization.Get()
+	restoredRx, restoredTx := other.Utilization.Get()
+	a.So(restoredRx, ShouldAlmostEqual, rx)
+	a.So(restoredTx, ShouldAlmostEqual, tx)
+
+	rx, tx = gtw.Utilization.GetChannel(8680000000)
+	restoredRx, restoredTx = other.Utilization.GetChannel(8680000000)
+	a.So(restoredRx, ShouldAlmostEqual, rx)
+	a.So(restoredTx, ShouldAlmostEqual, tx)
+
+	a.So(other.TxHealth.GrayListed(), ShouldBeTrue)
+	a.So(other.MaintenanceWindows(), ShouldResemble, gtw.MaintenanceWindows())
+
+	total, perApplication := other.Schedule.Queued()
+	a.So(total, ShouldEqual, 1)
+	a.So(perApplication, ShouldResemble, map[string]int{"app": 1})
+}
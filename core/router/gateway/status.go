@@ -30,10 +30,39 @@ type statusStore struct {
 func (s *statusStore) Update(status *pb_gateway.Status) error {
 	s.Lock()
 	defer s.Unlock()
+	if s.lastStatus != nil {
+		keepVersions(s.lastStatus, status)
+		// The antenna configuration is only sent when it changes
+		if len(status.Antennas) == 0 {
+			status.Antennas = s.lastStatus.Antennas
+		}
+	}
 	s.lastStatus = status
 	return nil
 }
 
+// keepVersions copies the software versions of the last status to the new status
+// if the gateway left them out. A version is reset when the gateway sends "-".
+func keepVersions(last, status *pb_gateway.Status) {
+	keep := func(last string, version *string) {
+		switch *version {
+		case "":
+			*version = last
+		case "-":
+			*version = ""
+		}
+	}
+	keep(last.Platform, &status.Platform)
+	keep(last.PacketForwarder, &status.PacketForwarder)
+	keep(last.Hal, &status.Hal)
+	if status.Fpga == 0 {
+		status.Fpga = last.Fpga
+	}
+	if status.Dsp == 0 {
+		status.Dsp = last.Dsp
+	}
+}
+
 func (s *statusStore) Get() (*pb_gateway.Status, error) {
 	s.RLock()
 	defer s.RUnlock()
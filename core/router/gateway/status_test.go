@@ -37,3 +37,39 @@ func TestStatusGetUpsert(t *testing.T) {
 	a.So(status, ShouldNotBeNil)
 	a.So(*status, ShouldResemble, *statusMessage)
 }
+
+func TestStatusKeepVersions(t *testing.T) {
+	a := New(t)
+	store := NewStatusStore()
+
+	store.Update(&pb_gateway.Status{Platform: "Kerlink", PacketForwarder: "TTN Packet Forwarder 2.0.0", Hal: "4.1.0", Fpga: 31})
+
+	// Versions are left out -> expect last versions
+	store.Update(&pb_gateway.Status{RxIn: 1})
+	status, _ := store.Get()
+	a.So(status.RxIn, ShouldEqual, 1)
+	a.So(status.Platform, ShouldEqual, "Kerlink")
+	a.So(status.PacketForwarder, ShouldEqual, "TTN Packet Forwarder 2.0.0")
+	a.So(status.Hal, ShouldEqual, "4.1.0")
+	a.So(status.Fpga, ShouldEqual, 31)
+
+	// Versions are reset or changed -> expect new versions
+	store.Update(&pb_gateway.Status{Platform: "-", Hal: "5.0.1"})
+	status, _ = store.Get()
+	a.So(status.Platform, ShouldBeEmpty)
+	a.So(status.PacketForwarder, ShouldEqual, "TTN Packet Forwarder 2.0.0")
+	a.So(status.Hal, ShouldEqual, "5.0.1")
+}
+
+func TestStatusKeepAntennas(t *testing.T) {
+	a := New(t)
+	store := NewStatusStore()
+
+	store.Update(&pb_gateway.Status{Antennas: []*pb_gateway.AntennaConfiguration{{Antenna: 0, Gain: 3}}})
+
+	// Antennas are left out -> expect last antennas
+	store.Update(&pb_gateway.Status{RxIn: 1})
+	status, _ := store.Get()
+	a.So(status.Antennas, ShouldHaveLength, 1)
+	a.So(status.Antennas[0].Gain, ShouldEqual, 3)
+}
@@ -0,0 +1,118 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package gateway
+
+import (
+	"sync"
+
+	pb_router "github.com/TheThingsNetwork/ttn/api/router"
+)
+
+// TxHealthDecay is the weight of the previous counts when the counts of a new status message are added. Gateways
+// send a status message every 30 seconds, so the counts mostly reflect the last hour.
+var TxHealthDecay = 0.99
+
+// MinTxHealthSamples is the number of downlink messages that must be counted before a gateway can be gray-listed
+var MinTxHealthSamples = 10.0
+
+// GrayListThreshold is the fraction of downlink messages that a gateway must transmit to not be gray-listed
+var GrayListThreshold = 0.5
+
+// TxHealth tracks the fraction of downlink messages that a gateway transmits, as reported in its status messages.
+// Gateways that often fail to transmit are gray-listed, so that downlink through them is avoided.
+type TxHealth interface {
+	// Update the counts with the number of downlink messages that the gateway received (TxIn) and transmitted (TxOk)
+	// since its previous status message
+	Update(txIn, txOk uint32)
+	// SuccessRate returns the fraction of downlink messages that the gateway transmits, and whether enough downlink
+	// messages were counted to know it
+	SuccessRate() (rate float64, known bool)
+	// GrayListed returns whether downlink through the gateway should be avoided
+	GrayListed() bool
+	// Override returns the override of the gray-listing by the network operator
+	Override() string
+	// SetOverride overrides the gray-listing: pb_router.GrayListNever, pb_router.GrayListAlways or empty
+	SetOverride(override string)
+	// Snapshot returns the counts and the override, so that they can be restored in another TxHealth
+	Snapshot() TxHealthSnapshot
+	// Restore the counts and the override from a snapshot
+	Restore(snapshot TxHealthSnapshot)
+}
+
+// TxHealthSnapshot contains the counts and the override of a TxHealth
+type TxHealthSnapshot struct {
+	TxIn     float64 `json:"tx_in,omitempty"`
+	TxOk     float64 `json:"tx_ok,omitempty"`
+	Override string  `json:"override,omitempty"`
+}
+
+// NewTxHealth creates a new TxHealth
+func NewTxHealth() TxHealth {
+	return &txHealth{}
+}
+
+type txHealth struct {
+	mu       sync.RWMutex
+	txIn     float64
+	txOk     float64
+	override string
+}
+
+func (h *txHealth) Update(txIn, txOk uint32) {
+	if txOk > txIn {
+		return // Some packet forwarders report counters that can not be trusted
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.txIn = h.txIn*TxHealthDecay + float64(txIn)
+	h.txOk = h.txOk*TxHealthDecay + float64(txOk)
+}
+
+func (h *txHealth) SuccessRate() (rate float64, known bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if h.txIn == 0 {
+		return 0, false
+	}
+	return h.txOk / h.txIn, h.txIn >= MinTxHealthSamples
+}
+
+func (h *txHealth) GrayListed() bool {
+	switch h.Override() {
+	case pb_router.GrayListNever:
+		return false
+	case pb_router.GrayListAlways:
+		return true
+	}
+	rate, known := h.SuccessRate()
+	return known && rate < GrayListThreshold
+}
+
+func (h *txHealth) Override() string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.override
+}
+
+func (h *txHealth) SetOverride(override string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.override = override
+}
+
+func (h *txHealth) Snapshot() TxHealthSnapshot {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return TxHealthSnapshot{
+		TxIn:     h.txIn,
+		TxOk:     h.txOk,
+		Override: h.override,
+	}
+}
+
+func (h *txHealth) Restore(snapshot TxHealthSnapshot) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.txIn, h.txOk, h.override = snapshot.TxIn, snapshot.TxOk, snapshot.Override
+}
@@ -0,0 +1,57 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package gateway
+
+import (
+	"testing"
+
+	pb_router "github.com/TheThingsNetwork/ttn/api/router"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestTxHealth(t *testing.T) {
+	a := New(t)
+	h := NewTxHealth()
+
+	_, known := h.SuccessRate()
+	a.So(known, ShouldBeFalse)
+	a.So(h.GrayListed(), ShouldBeFalse)
+
+	// Not enough samples
+	h.Update(4, 1)
+	rate, known := h.SuccessRate()
+	a.So(rate, ShouldAlmostEqual, 0.25)
+	a.So(known, ShouldBeFalse)
+	a.So(h.GrayListed(), ShouldBeFalse)
+
+	// Untrusted counters are ignored
+	h.Update(1, 100)
+	rate, _ = h.SuccessRate()
+	a.So(rate, ShouldAlmostEqual, 0.25)
+
+	h.Update(16, 4)
+	rate, known = h.SuccessRate()
+	a.So(rate, ShouldAlmostEqual, 0.25, 0.01)
+	a.So(known, ShouldBeTrue)
+	a.So(h.GrayListed(), ShouldBeTrue)
+
+	h.SetOverride(pb_router.GrayListNever)
+	a.So(h.Override(), ShouldEqual, pb_router.GrayListNever)
+	a.So(h.GrayListed(), ShouldBeFalse)
+
+	// Recovery
+	h.SetOverride("")
+	for i := 0; i < 10; i++ {
+		h.Update(10, 10)
+	}
+	a.So(h.GrayListed(), ShouldBeFalse)
+
+	h.SetOverride(pb_router.GrayListAlways)
+	a.So(h.GrayListed(), ShouldBeTrue)
+
+	other := NewTxHealth()
+	other.Restore(h.Snapshot())
+	a.So(other.Snapshot(), ShouldResemble, h.Snapshot())
+	a.So(other.GrayListed(), ShouldBeTrue)
+}
@@ -28,6 +28,18 @@ type Utilization interface {
 	GetChannel(frequency uint64) (rx float64, tx float64)
 	// Tick the clock to update the moving average. It should be called every 5 seconds
 	Tick()
+	// Snapshot returns the current moving averages, so that they can be restored in another Utilization
+	Snapshot() UtilizationSnapshot
+	// Restore the moving averages from a snapshot
+	Restore(snapshot UtilizationSnapshot)
+}
+
+// UtilizationSnapshot contains the moving averages of a Utilization
+type UtilizationSnapshot struct {
+	OverallRx float64            `json:"overall_rx,omitempty"`
+	ChannelRx map[uint64]float64 `json:"channel_rx,omitempty"`
+	OverallTx float64            `json:"overall_tx,omitempty"`
+	ChannelTx map[uint64]float64 `json:"channel_tx,omitempty"`
 }
 
 // NewUtilization creates a new Utilization
@@ -41,24 +53,28 @@ func NewUtilization() Utilization {
 }
 
 type utilization struct {
-	overallRx     metrics.EWMA
-	channelRx     map[uint64]metrics.EWMA
-	channelRxLock sync.RWMutex
-	overallTx     metrics.EWMA
-	channelTx     map[uint64]metrics.EWMA
-	channelTxLock sync.RWMutex
+	overallRx metrics.EWMA
+	channelRx map[uint64]metrics.EWMA
+	rxLock    sync.RWMutex // Protects overallRx and channelRx, which are replaced by Restore
+	overallTx metrics.EWMA
+	channelTx map[uint64]metrics.EWMA
+	txLock    sync.RWMutex // Protects overallTx and channelTx, which are replaced by Restore
 }
 
 func (u *utilization) GoString() (str string) {
+	u.rxLock.RLock()
 	str += fmt.Sprintf("Rx %5.2f ", u.overallRx.Rate()/1000)
 	for ch, r := range u.channelRx {
 		str += fmt.Sprintf("(%d:%5.2f) ", ch, r.Rate()/1000)
 	}
+	u.rxLock.RUnlock()
 	str += "\n"
+	u.txLock.RLock()
 	str += fmt.Sprintf("Tx %5.2f ", u.overallTx.Rate()/1000)
 	for ch, r := range u.channelTx {
 		str += fmt.Sprintf("(%d:%5.2f) ", ch, r.Rate()/1000)
 	}
+	u.txLock.RUnlock()
 	str += "\n"
 	return
 }
@@ -83,10 +99,10 @@ func (u *utilization) AddRx(uplink *pb_router.UplinkMessage) error {
 	if t == 0 {
 		return nil
 	}
-	u.overallRx.Update(int64(t) / 1000)
 	frequency := uplink.GatewayMetadata.Frequency
-	u.channelRxLock.Lock()
-	defer u.channelRxLock.Unlock()
+	u.rxLock.Lock()
+	defer u.rxLock.Unlock()
+	u.overallRx.Update(int64(t) / 1000)
 	if _, ok := u.channelRx[frequency]; !ok {
 		u.channelRx[frequency] = metrics.NewEWMA1()
 	}
@@ -114,10 +130,10 @@ func (u *utilization) AddTx(downlink *pb_router.DownlinkMessage) error {
 	if t == 0 {
 		return nil
 	}
-	u.overallTx.Update(int64(t) / 1000)
 	frequency := downlink.GatewayConfiguration.Frequency
-	u.channelTxLock.Lock()
-	defer u.channelTxLock.Unlock()
+	u.txLock.Lock()
+	defer u.txLock.Unlock()
+	u.overallTx.Update(int64(t) / 1000)
 	if _, ok := u.channelTx[frequency]; !ok {
 		u.channelTx[frequency] = metrics.NewEWMA1()
 	}
@@ -126,34 +142,84 @@ func (u *utilization) AddTx(downlink *pb_router.DownlinkMessage) error {
 }
 
 func (u *utilization) Tick() {
+	u.rxLock.RLock()
 	u.overallRx.Tick()
-	u.channelRxLock.RLock()
 	for _, ch := range u.channelRx {
 		ch.Tick()
 	}
-	u.channelRxLock.RUnlock()
+	u.rxLock.RUnlock()
+	u.txLock.RLock()
 	u.overallTx.Tick()
-	u.channelTxLock.RLock()
 	for _, ch := range u.channelTx {
 		ch.Tick()
 	}
-	u.channelTxLock.RUnlock()
+	u.txLock.RUnlock()
 }
 
-func (u *utilization) Get() (float64, float64) {
-	return u.overallRx.Snapshot().Rate() * 1000.0 / float64(time.Second), u.overallTx.Snapshot().Rate() * 1000.0 / float64(time.Second)
+func (u *utilization) Get() (rx float64, tx float64) {
+	u.rxLock.RLock()
+	rx = u.overallRx.Snapshot().Rate() * 1000.0 / float64(time.Second)
+	u.rxLock.RUnlock()
+	u.txLock.RLock()
+	tx = u.overallTx.Snapshot().Rate() * 1000.0 / float64(time.Second)
+	u.txLock.RUnlock()
+	return
 }
 
 func (u *utilization) GetChannel(frequency uint64) (rx float64, tx float64) {
-	u.channelRxLock.RLock()
+	u.rxLock.RLock()
 	if channel, ok := u.channelRx[frequency]; ok {
 		rx = channel.Snapshot().Rate() * 1000.0 / float64(time.Second)
 	}
-	u.channelRxLock.RUnlock()
-	u.channelTxLock.RLock()
+	u.rxLock.RUnlock()
+	u.txLock.RLock()
 	if channel, ok := u.channelTx[frequency]; ok {
 		tx = channel.Snapshot().Rate() * 1000.0 / float64(time.Second)
 	}
-	u.channelTxLock.RUnlock()
+	u.txLock.RUnlock()
+	return
+}
+
+func (u *utilization) Snapshot() (snapshot UtilizationSnapshot) {
+	snapshot.ChannelRx = make(map[uint64]float64)
+	u.rxLock.RLock()
+	snapshot.OverallRx = u.overallRx.Rate()
+	for frequency, channel := range u.channelRx {
+		snapshot.ChannelRx[frequency] = channel.Rate()
+	}
+	u.rxLock.RUnlock()
+	snapshot.ChannelTx = make(map[uint64]float64)
+	u.txLock.RLock()
+	snapshot.OverallTx = u.overallTx.Rate()
+	for frequency, channel := range u.channelTx {
+		snapshot.ChannelTx[frequency] = channel.Rate()
+	}
+	u.txLock.RUnlock()
 	return
 }
+
+// newEWMA1WithRate creates a one-minute EWMA that starts at the given rate (per second)
+func newEWMA1WithRate(rate float64) metrics.EWMA {
+	ewma := metrics.NewEWMA1()
+	// The first tick sets the rate to the number of events in the last 5 seconds
+	ewma.Update(int64(rate*5 + 0.5))
+	ewma.Tick()
+	return ewma
+}
+
+func (u *utilization) Restore(snapshot UtilizationSnapshot) {
+	overallRx, channelRx := newEWMA1WithRate(snapshot.OverallRx), make(map[uint64]metrics.EWMA)
+	for frequency, rate := range snapshot.ChannelRx {
+		channelRx[frequency] = newEWMA1WithRate(rate)
+	}
+	overallTx, channelTx := newEWMA1WithRate(snapshot.OverallTx), make(map[uint64]metrics.EWMA)
+	for frequency, rate := range snapshot.ChannelTx {
+		channelTx[frequency] = newEWMA1WithRate(rate)
+	}
+	u.rxLock.Lock()
+	u.overallRx, u.channelRx = overallRx, channelRx
+	u.rxLock.Unlock()
+	u.txLock.Lock()
+	u.overallTx, u.channelTx = overallTx, channelTx
+	u.txLock.Unlock()
+}
@@ -0,0 +1,131 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package gateway
+
+import (
+	"strconv"
+	"strings"
+
+	pb "github.com/TheThingsNetwork/ttn/api/gateway"
+	pb_router "github.com/TheThingsNetwork/ttn/api/router"
+)
+
+// Software components of a gateway
+const (
+	ComponentPlatform        = "platform"
+	ComponentPacketForwarder = "packet_forwarder"
+	ComponentHAL             = "hal"
+)
+
+// KnownBadVersion describes software that is known to cause problems
+type KnownBadVersion struct {
+	Component string
+	// Name of the software (case-insensitive). Empty matches any name.
+	Name string
+	// Versions before this version are affected. Empty means that all versions are affected.
+	Before      string
+	Problem     string
+	Remediation string
+}
+
+// KnownBadVersions is the list of gateway software that should be upgraded
+var KnownBadVersions = []KnownBadVersion{
+	{
+		Component:   ComponentPacketForwarder,
+		Name:        "poly_pkt_fwd",
+		Problem:     "End-of-life packet forwarder that uses the unauthenticated Semtech UDP protocol",
+		Remediation: "Upgrade to the TTN Packet Forwarder",
+	},
+	{
+		Component:   ComponentPacketForwarder,
+		Name:        "basic_pkt_fwd",
+		Problem:     "End-of-life packet forwarder that uses the unauthenticated Semtech UDP protocol",
+		Remediation: "Upgrade to the TTN Packet Forwarder",
+	},
+	{
+		Component:   ComponentPacketForwarder,
+		Name:        "gps_pkt_fwd",
+		Problem:     "End-of-life packet forwarder that uses the unauthenticated Semtech UDP protocol",
+		Remediation: "Upgrade to the TTN Packet Forwarder",
+	},
+	{
+		Component:   ComponentPacketForwarder,
+		Name:        "TTN Packet Forwarder",
+		Before:      "2.0.0",
+		Problem:     "Pre-release version of the TTN Packet Forwarder",
+		Remediation: "Upgrade to the latest release of the TTN Packet Forwarder",
+	},
+	{
+		Component:   ComponentHAL,
+		Before:      "4.0.0",
+		Problem:     "Unsupported version of the concentrator HAL",
+		Remediation: "Upgrade the lora_gateway HAL to version 4.0.0 or newer",
+	},
+}
+
+// splitVersion splits "name X.X.X" into the name and the version
+func splitVersion(str string) (name, version string) {
+	str = strings.TrimSpace(str)
+	idx := strings.LastIndex(str, " ")
+	version = strings.TrimPrefix(str[idx+1:], "v")
+	if version == "" || version[0] < '0' || version[0] > '9' {
+		return str, ""
+	}
+	if idx < 0 {
+		return "", version
+	}
+	return strings.TrimSpace(str[:idx]), version
+}
+
+// versionBefore returns true if version a is before version b
+func versionBefore(a, b string) bool {
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aPart, bPart int
+		if i < len(aParts) {
+			aPart, _ = strconv.Atoi(strings.TrimFunc(aParts[i], func(r rune) bool { return r < '0' || r > '9' }))
+		}
+		if i < len(bParts) {
+			bPart, _ = strconv.Atoi(bParts[i])
+		}
+		if aPart != bPart {
+			return aPart < bPart
+		}
+	}
+	return false
+}
+
+func (v KnownBadVersion) matches(software string) bool {
+	if software == "" {
+		return false
+	}
+	name, version := splitVersion(software)
+	if v.Name != "" && !strings.EqualFold(name, v.Name) {
+		return false
+	}
+	if v.Before != "" {
+		return version != "" && versionBefore(version, v.Before)
+	}
+	return true
+}
+
+// CheckVersions returns warnings for the software versions in the status that are known to be bad
+func CheckVersions(status *pb.Status) (warnings []*pb_router.VersionWarning) {
+	versions := map[string]string{
+		ComponentPlatform:        status.GetPlatform(),
+		ComponentPacketForwarder: status.GetPacketForwarder(),
+		ComponentHAL:             status.GetHal(),
+	}
+	for _, known := range KnownBadVersions {
+		if version := versions[known.Component]; known.matches(version) {
+			warnings = append(warnings, &pb_router.VersionWarning{
+				Component:   known.Component,
+				Version:     version,
+				Problem:     known.Problem,
+				Remediation: known.Remediation,
+			})
+		}
+	}
+	return
+}
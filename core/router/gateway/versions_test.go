@@ -0,0 +1,50 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package gateway
+
+import (
+	"testing"
+
+	pb "github.com/TheThingsNetwork/ttn/api/gateway"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestSplitVersion(t *testing.T) {
+	a := New(t)
+
+	name, version := splitVersion("TTN Packet Forwarder 2.0.1")
+	a.So(name, ShouldEqual, "TTN Packet Forwarder")
+	a.So(version, ShouldEqual, "2.0.1")
+
+	name, version = splitVersion("4.1.3")
+	a.So(name, ShouldBeEmpty)
+	a.So(version, ShouldEqual, "4.1.3")
+
+	name, version = splitVersion("poly_pkt_fwd")
+	a.So(name, ShouldEqual, "poly_pkt_fwd")
+	a.So(version, ShouldBeEmpty)
+}
+
+func TestVersionBefore(t *testing.T) {
+	a := New(t)
+	a.So(versionBefore("1.9.9", "2.0.0"), ShouldBeTrue)
+	a.So(versionBefore("2.0", "2.0.1"), ShouldBeTrue)
+	a.So(versionBefore("2.0.0-rc1", "2.0.0"), ShouldBeFalse)
+	a.So(versionBefore("2.0.0", "2.0.0"), ShouldBeFalse)
+	a.So(versionBefore("10.0.0", "2.0.0"), ShouldBeFalse)
+}
+
+func TestCheckVersions(t *testing.T) {
+	a := New(t)
+
+	a.So(CheckVersions(&pb.Status{}), ShouldBeEmpty)
+	a.So(CheckVersions(&pb.Status{PacketForwarder: "TTN Packet Forwarder 2.0.1", Hal: "4.1.0"}), ShouldBeEmpty)
+
+	warnings := CheckVersions(&pb.Status{PacketForwarder: "poly_pkt_fwd 2.1.0", Hal: "3.2.1"})
+	a.So(warnings, ShouldHaveLength, 2)
+	a.So(warnings[0].Component, ShouldEqual, ComponentPacketForwarder)
+	a.So(warnings[0].Version, ShouldEqual, "poly_pkt_fwd 2.1.0")
+	a.So(warnings[0].Remediation, ShouldNotBeEmpty)
+	a.So(warnings[1].Component, ShouldEqual, ComponentHAL)
+}
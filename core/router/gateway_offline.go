@@ -0,0 +1,46 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package router
+
+import (
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/router/gateway"
+	"github.com/TheThingsNetwork/ttn/utils/snmp"
+)
+
+// GatewayOfflineTimeout is the time after which a gateway that sent no messages is considered offline
+var GatewayOfflineTimeout = 5 * time.Minute
+
+// checkGatewaysOffline sends an SNMP trap for gateways that went offline since the last check, and for gateways that
+// came back online. Gateways in maintenance are skipped, so that a gateway that is still offline after its maintenance
+// window is reported then.
+func (r *router) checkGatewaysOffline() {
+	now := time.Now()
+	r.offlineLock.Lock()
+	defer r.offlineLock.Unlock()
+	if r.offline == nil {
+		r.offline = make(map[string]bool)
+	}
+	r.gateways.Range(func(gtw *gateway.Gateway) {
+		if gtw.LastSeen.IsZero() || gtw.InMaintenance(now) {
+			return
+		}
+		offline := now.Sub(gtw.LastSeen) > GatewayOfflineTimeout
+		if offline == r.offline[gtw.ID] {
+			return
+		}
+		gatewayID := snmp.Variable{OID: component.TrapVarGatewayID, Value: gtw.ID}
+		if offline {
+			r.offline[gtw.ID] = true
+			gtw.Ctx.WithField("LastSeen", gtw.LastSeen).Warn("Gateway is offline")
+			r.SendTrap(component.TrapGatewayOffline, gatewayID)
+		} else {
+			delete(r.offline, gtw.ID)
+			gtw.Ctx.Info("Gateway is online again")
+			r.SendTrap(component.TrapGatewayOnline, gatewayID)
+		}
+	})
+}
@@ -0,0 +1,44 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package router
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/api"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestCheckGatewaysOffline(t *testing.T) {
+	a := New(t)
+	r := getTestRouter(t)
+
+	// Gateways that were never seen are not reported
+	gtw := r.getGateway("eui-0102030405060708")
+	r.checkGatewaysOffline()
+	a.So(r.offline, ShouldBeEmpty)
+
+	gtw.LastSeen = time.Now()
+	r.checkGatewaysOffline()
+	a.So(r.offline, ShouldBeEmpty)
+
+	gtw.LastSeen = time.Now().Add(-2 * GatewayOfflineTimeout)
+	r.checkGatewaysOffline()
+	a.So(r.offline["eui-0102030405060708"], ShouldBeTrue)
+
+	gtw.LastSeen = time.Now()
+	r.checkGatewaysOffline()
+	a.So(r.offline, ShouldBeEmpty)
+
+	// Gateways in maintenance are not reported
+	gtw.SetMaintenanceWindows([]*api.MaintenanceWindow{{Start: time.Now().Add(-time.Minute).UnixNano(), Duration: 3600}})
+	gtw.LastSeen = time.Now().Add(-2 * GatewayOfflineTimeout)
+	r.checkGatewaysOffline()
+	a.So(r.offline, ShouldBeEmpty)
+
+	gtw.SetMaintenanceWindows(nil)
+	r.checkGatewaysOffline()
+	a.So(r.offline["eui-0102030405060708"], ShouldBeTrue)
+}
@@ -0,0 +1,78 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package router
+
+import (
+	"time"
+
+	"github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/core/router/gateway"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// GatewayStateRefreshInterval is the minimum time between two checks of the shared state of a gateway that is served
+// by this Router instance
+var GatewayStateRefreshInterval = 10 * time.Second
+
+// loadGatewayState loads the state of a gateway that was previously served by a Router instance, or returns nil
+func (r *router) loadGatewayState(id string) *gateway.State {
+	if r.gatewayStates == nil {
+		return nil
+	}
+	state, err := r.gatewayStates.Get(id)
+	if err != nil {
+		if errors.GetErrType(err) != errors.NotFound {
+			r.Ctx.WithField("GatewayID", id).WithError(err).Warn("Could not load gateway state")
+		}
+		return nil
+	}
+	return state
+}
+
+// restoreGatewayState restores the loaded state into a new gateway
+func (r *router) restoreGatewayState(gtw *gateway.Gateway, state *gateway.State) {
+	gtw.RestoreState(state)
+	if state.RouterID != r.Identity.Id {
+		gtw.Ctx.WithFields(log.Fields{
+			"PreviousRouterID": state.RouterID,
+			"LastSeen":         state.LastSeen,
+		}).Info("Gateway moved from another Router")
+	}
+}
+
+// saveGatewayState stores the state of a gateway so that other Router instances can take over the gateway
+func (r *router) saveGatewayState(gtw *gateway.Gateway) {
+	if r.gatewayStates == nil {
+		return
+	}
+	state := gtw.GetState()
+	state.RouterID = r.Identity.Id
+	if err := r.gatewayStates.Set(state); err != nil {
+		gtw.Ctx.WithError(err).Warn("Could not save gateway state")
+		return
+	}
+	gtw.SetStateUpdatedAt(state.UpdatedAt)
+}
+
+// refreshGatewayState restores the state of a gateway if another Router instance took it over and stored it after it
+// was last stored or restored by this Router instance. This makes sure that the downlink options of a gateway that
+// moved between Router instances take the scheduled transmissions and the duty-cycle of the other Router instance into
+// account. The shared state is checked at most once per GatewayStateRefreshInterval.
+func (r *router) refreshGatewayState(gtw *gateway.Gateway) {
+	if r.gatewayStates == nil || !gtw.CheckState(GatewayStateRefreshInterval) {
+		return
+	}
+	state, err := r.gatewayStates.Get(gtw.ID)
+	if err != nil {
+		if errors.GetErrType(err) != errors.NotFound {
+			gtw.Ctx.WithError(err).Warn("Could not refresh gateway state")
+		}
+		return
+	}
+	if state.RouterID == r.Identity.Id || !state.UpdatedAt.After(gtw.StateUpdatedAt()) {
+		return
+	}
+	gtw.RefreshState(state)
+	gtw.Ctx.WithField("PreviousRouterID", state.RouterID).Debug("Refreshed gateway state")
+}
@@ -0,0 +1,86 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package router
+
+import (
+	"testing"
+	"time"
+
+	pb_discovery "github.com/TheThingsNetwork/ttn/api/discovery"
+	pb "github.com/TheThingsNetwork/ttn/api/router"
+	"github.com/TheThingsNetwork/ttn/core/router/gateway"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestRefreshGatewayState(t *testing.T) {
+	a := New(t)
+	defer func(interval time.Duration) { GatewayStateRefreshInterval = interval }(GatewayStateRefreshInterval)
+	GatewayStateRefreshInterval = 0
+
+	states := gateway.NewRedisStateStore(GetRedisClient(), "router-test-refresh-gateway-state")
+	defer states.Delete("eui-0102030405060708")
+
+	r := getTestRouter(t)
+	r.Identity = &pb_discovery.Announcement{Id: "router-1"}
+	r.gatewayStates = states
+	other := getTestRouter(t)
+	other.Identity = &pb_discovery.Announcement{Id: "router-2"}
+	other.gatewayStates = states
+
+	gtw := r.getGateway("eui-0102030405060708")
+	gtw.LastSeen = time.Now().Add(-1 * time.Minute)
+	r.saveGatewayState(gtw)
+
+	// The state that this Router stored is not restored
+	r.refreshGatewayState(gtw)
+	a.So(gtw.Schedule.Snapshot().Transmissions, ShouldBeEmpty)
+
+	// The gateway moved to the other Router, which scheduled a transmission
+	otherGtw := other.getGateway("eui-0102030405060708")
+	otherGtw.Schedule.Sync(0)
+	id, _ := otherGtw.Schedule.GetOption(20000000, 100)
+	a.So(otherGtw.Schedule.Schedule(id, "app", &pb.DownlinkMessage{}), ShouldBeNil)
+	otherGtw.LastSeen = time.Now()
+	time.Sleep(time.Millisecond)
+	other.saveGatewayState(otherGtw)
+
+	lastSeen := gtw.LastSeen
+	r.refreshGatewayState(gtw)
+	a.So(gtw.LastSeen, ShouldResemble, lastSeen)
+	total, _ := gtw.Schedule.Queued()
+	a.So(total, ShouldEqual, 1)
+	_, conflicts := gtw.Schedule.GetOption(20000050, 100)
+	a.So(conflicts, ShouldEqual, 100)
+}
+
+func TestRefreshGatewayStateInterval(t *testing.T) {
+	a := New(t)
+	defer func(interval time.Duration) { GatewayStateRefreshInterval = interval }(GatewayStateRefreshInterval)
+	GatewayStateRefreshInterval = time.Hour
+
+	states := gateway.NewRedisStateStore(GetRedisClient(), "router-test-refresh-gateway-state-interval")
+	defer states.Delete("eui-0102030405060708")
+
+	r := getTestRouter(t)
+	r.Identity = &pb_discovery.Announcement{Id: "router-1"}
+	r.gatewayStates = states
+	other := getTestRouter(t)
+	other.Identity = &pb_discovery.Announcement{Id: "router-2"}
+	other.gatewayStates = states
+
+	gtw := r.getGateway("eui-0102030405060708")
+	r.refreshGatewayState(gtw)
+
+	otherGtw := other.getGateway("eui-0102030405060708")
+	otherGtw.Schedule.Sync(0)
+	id, _ := otherGtw.Schedule.GetOption(20000000, 100)
+	a.So(otherGtw.Schedule.Schedule(id, "app", &pb.DownlinkMessage{}), ShouldBeNil)
+	other.saveGatewayState(otherGtw)
+
+	// The state was checked less than an interval ago
+	r.refreshGatewayState(gtw)
+	total, _ := gtw.Schedule.Queued()
+	a.So(total, ShouldEqual, 0)
+}
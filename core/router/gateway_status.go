@@ -6,6 +6,7 @@ package router
 import (
 	"time"
 
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
 	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
 	"github.com/TheThingsNetwork/ttn/core/router/gateway"
 )
@@ -27,5 +28,25 @@ func (r *router) HandleGatewayStatus(gatewayID string, status *pb_gateway.Status
 	r.status.gatewayStatus.Mark(1)
 	status.Router = r.Identity.Id
 	gateway = r.getGateway(gatewayID)
-	return gateway.HandleStatus(status)
+	versionsReported := status.Platform != "" || status.PacketForwarder != "" || status.Hal != ""
+	if err = gateway.HandleStatus(status); err != nil {
+		return err
+	}
+	if versionsReported {
+		warnVersions(ctx, status)
+	}
+	r.saveGatewayState(gateway)
+	return nil
+}
+
+// warnVersions logs a warning for each software version of the gateway that is known to be bad
+func warnVersions(ctx ttnlog.Interface, status *pb_gateway.Status) {
+	for _, warning := range gateway.CheckVersions(status) {
+		ctx.WithFields(ttnlog.Fields{
+			"Component":   warning.Component,
+			"Version":     warning.Version,
+			"Problem":     warning.Problem,
+			"Remediation": warning.Remediation,
+		}).Warn("Gateway runs known-bad software version")
+	}
 }
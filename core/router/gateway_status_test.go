@@ -10,7 +10,6 @@ import (
 	pb_gateway "github.com/TheThingsNetwork/ttn/api/gateway"
 	"github.com/TheThingsNetwork/ttn/api/monitor"
 	"github.com/TheThingsNetwork/ttn/core/component"
-	"github.com/TheThingsNetwork/ttn/core/router/gateway"
 	. "github.com/TheThingsNetwork/ttn/utils/testing"
 	. "github.com/smartystreets/assertions"
 )
@@ -26,7 +25,7 @@ func TestHandleGatewayStatus(t *testing.T) {
 			Identity: &pb_discovery.Announcement{},
 			Monitor:  monitor.NewClient(monitor.DefaultClientConfig),
 		},
-		gateways: map[string]*gateway.Gateway{},
+		gateways: newGatewayStore(),
 	}
 	router.InitStatus()
 
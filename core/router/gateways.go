@@ -0,0 +1,110 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package router
+
+import (
+	"hash/fnv"
+	"sync"
+
+	"github.com/TheThingsNetwork/ttn/core/router/gateway"
+)
+
+// gatewayShards is the number of shards that the gateway state is divided over.
+// Every shard has its own lock, so that gateways in different shards don't
+// contend with each other when a router handles a large number of gateways.
+const gatewayShards = 64
+
+type gatewayShard struct {
+	sync.RWMutex
+	gateways map[string]*gateway.Gateway
+}
+
+// gatewayStore keeps the state of the gateways that are connected to the router
+type gatewayStore struct {
+	shards [gatewayShards]*gatewayShard
+}
+
+func newGatewayStore() *gatewayStore {
+	s := new(gatewayStore)
+	for i := range s.shards {
+		s.shards[i] = &gatewayShard{
+			gateways: make(map[string]*gateway.Gateway),
+		}
+	}
+	return s
+}
+
+func (s *gatewayStore) shard(id string) *gatewayShard {
+	h := fnv.New32a()
+	h.Write([]byte(id))
+	return s.shards[h.Sum32()%gatewayShards]
+}
+
+// Get returns the gateway with the given ID if it exists
+func (s *gatewayStore) Get(id string) (*gateway.Gateway, bool) {
+	shard := s.shard(id)
+	shard.RLock()
+	defer shard.RUnlock()
+	gtw, ok := shard.gateways[id]
+	return gtw, ok
+}
+
+// GetOrCreate returns the gateway with the given ID, or creates it with the given function if it doesn't exist
+func (s *gatewayStore) GetOrCreate(id string, create func() *gateway.Gateway) *gateway.Gateway {
+	shard := s.shard(id)
+
+	// We're going to be optimistic and guess that the gateway is already active
+	shard.RLock()
+	gtw, ok := shard.gateways[id]
+	shard.RUnlock()
+	if ok {
+		return gtw
+	}
+
+	// If it doesn't we still have to lock
+	shard.Lock()
+	defer shard.Unlock()
+	gtw, ok = shard.gateways[id]
+	if !ok {
+		gtw = create()
+		shard.gateways[id] = gtw
+	}
+	return gtw
+}
+
+// Range calls the given function for all gateways. Only one shard is locked at a time.
+func (s *gatewayStore) Range(fn func(gtw *gateway.Gateway)) {
+	if s == nil {
+		return
+	}
+	for _, shard := range s.shards {
+		shard.RLock()
+		for _, gtw := range shard.gateways {
+			fn(gtw)
+		}
+		shard.RUnlock()
+	}
+}
+
+// Count returns the number of gateways in each shard
+func (s *gatewayStore) Count() (counts []int) {
+	if s == nil {
+		return nil
+	}
+	counts = make([]int, gatewayShards)
+	for i, shard := range s.shards {
+		shard.RLock()
+		counts[i] = len(shard.gateways)
+		shard.RUnlock()
+	}
+	return
+}
+
+// Len returns the total number of gateways
+func (s *gatewayStore) Len() (total int) {
+	for _, count := range s.Count() {
+		total += count
+	}
+	return
+}
@@ -0,0 +1,59 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package router
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/router/gateway"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestGatewayStore(t *testing.T) {
+	a := New(t)
+	ctx := GetLogger(t, "TestGatewayStore")
+
+	s := newGatewayStore()
+
+	_, ok := s.Get("eui-0102030405060708")
+	a.So(ok, ShouldBeFalse)
+
+	var created int
+	create := func(id string) func() *gateway.Gateway {
+		return func() *gateway.Gateway {
+			created++
+			return gateway.NewGateway(ctx, id)
+		}
+	}
+
+	gtw := s.GetOrCreate("eui-0102030405060708", create("eui-0102030405060708"))
+	a.So(gtw, ShouldNotBeNil)
+	a.So(created, ShouldEqual, 1)
+
+	again := s.GetOrCreate("eui-0102030405060708", create("eui-0102030405060708"))
+	a.So(again, ShouldEqual, gtw)
+	a.So(created, ShouldEqual, 1)
+
+	found, ok := s.Get("eui-0102030405060708")
+	a.So(ok, ShouldBeTrue)
+	a.So(found, ShouldEqual, gtw)
+
+	for i := 0; i < 1000; i++ {
+		id := fmt.Sprintf("gateway-%d", i)
+		s.GetOrCreate(id, create(id))
+	}
+	a.So(s.Len(), ShouldEqual, 1001)
+
+	counts := s.Count()
+	a.So(counts, ShouldHaveLength, gatewayShards)
+	for _, count := range counts {
+		a.So(count, ShouldBeGreaterThan, 0)
+	}
+
+	var ranged int
+	s.Range(func(gtw *gateway.Gateway) { ranged++ })
+	a.So(ranged, ShouldEqual, 1001)
+}
@@ -7,7 +7,9 @@ import (
 	"fmt"
 
 	pb "github.com/TheThingsNetwork/ttn/api/router"
+	"github.com/TheThingsNetwork/ttn/core/router/gateway"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/golang/protobuf/ptypes/empty"
 	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
 	"google.golang.org/grpc"
 )
@@ -24,9 +26,7 @@ func (r *routerManager) GatewayStatus(ctx context.Context, in *pb.GatewayStatusR
 	if err != nil {
 		return nil, errors.NewErrPermissionDenied("No access")
 	}
-	r.router.gatewaysLock.RLock()
-	gtw, ok := r.router.gateways[in.GatewayId]
-	r.router.gatewaysLock.RUnlock()
+	gtw, ok := r.router.gateways.Get(in.GatewayId)
 	if !ok {
 		return nil, errors.NewErrNotFound(fmt.Sprintf("Gateway %s", in.GatewayId))
 	}
@@ -34,12 +34,59 @@ func (r *routerManager) GatewayStatus(ctx context.Context, in *pb.GatewayStatusR
 	if err != nil {
 		return nil, err
 	}
+	queued, _ := gtw.Schedule.Queued()
+	txSuccessRate, _ := gtw.TxHealth.SuccessRate()
 	return &pb.GatewayStatusResponse{
-		LastSeen: gtw.LastSeen.UnixNano(),
-		Status:   status,
+		LastSeen:           gtw.LastSeen.UnixNano(),
+		Status:             status,
+		QueuedDownlinks:    uint32(queued),
+		TxSuccessRate:      float32(txSuccessRate),
+		GrayListed:         gtw.TxHealth.GrayListed(),
+		GrayListOverride:   gtw.TxHealth.Override(),
+		MaintenanceWindows: gtw.MaintenanceWindows(),
 	}, nil
 }
 
+func (r *routerManager) SetGatewayGrayList(ctx context.Context, in *pb.GatewayGrayListRequest) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Gateway Gray List Request")
+	}
+	if r.router.Identity.Id != "dev" {
+		claims, err := r.router.ValidateTTNAuthContext(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "No access")
+		}
+		if !claims.ComponentAccess(r.router.Identity.Id) {
+			return nil, errors.NewErrPermissionDenied(fmt.Sprintf("Claims do not grant access to %s", r.router.Identity.Id))
+		}
+	}
+	gtw := r.router.getGateway(in.GatewayId)
+	gtw.TxHealth.SetOverride(in.Override)
+	r.router.saveGatewayState(gtw)
+	gtw.Ctx.WithField("Override", in.Override).Info("Overrode gray-listing for downlink")
+	return &empty.Empty{}, nil
+}
+
+func (r *routerManager) SetGatewayMaintenance(ctx context.Context, in *pb.GatewayMaintenanceRequest) (*empty.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Gateway Maintenance Request")
+	}
+	if r.router.Identity.Id != "dev" {
+		claims, err := r.router.ValidateTTNAuthContext(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "No access")
+		}
+		if !claims.ComponentAccess(r.router.Identity.Id) {
+			return nil, errors.NewErrPermissionDenied(fmt.Sprintf("Claims do not grant access to %s", r.router.Identity.Id))
+		}
+	}
+	gtw := r.router.getGateway(in.GatewayId)
+	gtw.SetMaintenanceWindows(in.MaintenanceWindows)
+	r.router.saveGatewayState(gtw)
+	gtw.Ctx.WithField("MaintenanceWindows", len(in.MaintenanceWindows)).Info("Set maintenance windows")
+	return &empty.Empty{}, nil
+}
+
 func (r *routerManager) GetStatus(ctx context.Context, in *pb.StatusRequest) (*pb.Status, error) {
 	if r.router.Identity.Id != "dev" {
 		claims, err := r.router.ValidateTTNAuthContext(ctx)
@@ -57,6 +104,36 @@ func (r *routerManager) GetStatus(ctx context.Context, in *pb.StatusRequest) (*p
 	return status, nil
 }
 
+func (r *routerManager) GatewayInventory(ctx context.Context, in *pb.GatewayInventoryRequest) (*pb.GatewayInventoryResponse, error) {
+	if r.router.Identity.Id != "dev" {
+		claims, err := r.router.ValidateTTNAuthContext(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "No access")
+		}
+		if !claims.ComponentAccess(r.router.Identity.Id) {
+			return nil, errors.NewErrPermissionDenied(fmt.Sprintf("Claims do not grant access to %s", r.router.Identity.Id))
+		}
+	}
+	res := new(pb.GatewayInventoryResponse)
+	r.router.gateways.Range(func(gtw *gateway.Gateway) {
+		status, err := gtw.Status.Get()
+		if err != nil {
+			return
+		}
+		res.Gateways = append(res.Gateways, &pb.GatewayVersions{
+			GatewayId:       gtw.ID,
+			LastSeen:        gtw.LastSeen.UnixNano(),
+			Platform:        status.Platform,
+			PacketForwarder: status.PacketForwarder,
+			Hal:             status.Hal,
+			Fpga:            status.Fpga,
+			Dsp:             status.Dsp,
+			Warnings:        gateway.CheckVersions(status),
+		})
+	})
+	return res, nil
+}
+
 // RegisterManager registers this router as a RouterManagerServer (github.com/TheThingsNetwork/ttn/api/router)
 func (r *router) RegisterManager(s *grpc.Server) {
 	server := &routerManager{r}
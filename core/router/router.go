@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	redis "gopkg.in/redis.v5"
 
 	pb_broker "github.com/TheThingsNetwork/ttn/api/broker"
 	pb_discovery "github.com/TheThingsNetwork/ttn/api/discovery"
@@ -49,31 +50,42 @@ type broker struct {
 // NewRouter creates a new Router
 func NewRouter() Router {
 	return &router{
-		gateways: make(map[string]*gateway.Gateway),
+		gateways: newGatewayStore(),
 		brokers:  make(map[string]*broker),
 	}
 }
 
+// NewRedisRouter creates a new Router that shares gateway state with other Router instances through Redis
+func NewRedisRouter(client *redis.Client) Router {
+	return &router{
+		gateways:      newGatewayStore(),
+		gatewayStates: gateway.NewRedisStateStore(client, "router"),
+		brokers:       make(map[string]*broker),
+	}
+}
+
 type router struct {
 	*component.Component
-	gateways     map[string]*gateway.Gateway
-	gatewaysLock sync.RWMutex
-	brokers      map[string]*broker
-	brokersLock  sync.RWMutex
-	status       *status
+	gateways      *gatewayStore
+	gatewayStates gateway.StateStore
+	brokers       map[string]*broker
+	brokersLock   sync.RWMutex
+	status        *status
+
+	offline     map[string]bool // Gateways that were reported offline
+	offlineLock sync.Mutex
 }
 
 func (r *router) tickGateways() {
-	r.gatewaysLock.RLock()
-	defer r.gatewaysLock.RUnlock()
-	for _, gtw := range r.gateways {
+	r.gateways.Range(func(gtw *gateway.Gateway) {
 		gtw.Utilization.Tick()
-	}
+	})
 }
 
 func (r *router) Init(c *component.Component) error {
 	r.Component = c
 	r.InitStatus()
+	r.Component.RegisterRateLoadSignal("uplink", r.status.uplink)
 	err := r.Component.UpdateTokenKey()
 	if err != nil {
 		return err
@@ -87,6 +99,7 @@ func (r *router) Init(c *component.Component) error {
 	go func() {
 		for range time.Tick(5 * time.Second) {
 			r.tickGateways()
+			r.checkGatewaysOffline()
 		}
 	}()
 	r.Component.SetStatus(component.StatusHealthy)
@@ -94,6 +107,7 @@ func (r *router) Init(c *component.Component) error {
 }
 
 func (r *router) Shutdown() {
+	r.gateways.Range(r.saveGatewayState)
 	r.brokersLock.Lock()
 	defer r.brokersLock.Unlock()
 	for _, broker := range r.brokers {
@@ -104,26 +118,19 @@ func (r *router) Shutdown() {
 
 // getGateway gets or creates a Gateway
 func (r *router) getGateway(id string) *gateway.Gateway {
-	// We're going to be optimistic and guess that the gateway is already active
-	r.gatewaysLock.RLock()
-	gtw, ok := r.gateways[id]
-	r.gatewaysLock.RUnlock()
-	if ok {
+	if gtw, ok := r.gateways.Get(id); ok {
 		return gtw
 	}
-	// If it doesn't we still have to lock
-	r.gatewaysLock.Lock()
-	defer r.gatewaysLock.Unlock()
-
-	gtw, ok = r.gateways[id]
-	if !ok {
-		gtw = gateway.NewGateway(r.Ctx, id)
+	// The shared state is loaded before the store is locked for creating the gateway
+	state := r.loadGatewayState(id)
+	return r.gateways.GetOrCreate(id, func() *gateway.Gateway {
+		gtw := gateway.NewGateway(r.Ctx, id)
 		gtw.Monitor = r.Component.Monitor
-
-		r.gateways[id] = gtw
-	}
-
-	return gtw
+		if state != nil {
+			r.restoreGatewayState(gtw, state)
+		}
+		return gtw
+	})
 }
 
 // getBroker gets or creates a broker association and returns the broker
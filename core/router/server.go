@@ -19,7 +19,6 @@ import (
 	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 )
 
 type routerRPC struct {
@@ -30,7 +29,10 @@ type routerRPC struct {
 	statusRate *ratelimit.Registry
 }
 
-func (r *routerRPC) gatewayFromMetadata(md metadata.MD) (gtw *gateway.Gateway, err error) {
+// gatewayFromContext gets the gateway from the context. The gateway is authenticated by its token, or by a client
+// certificate that was issued to the gateway by the CA of the network.
+func (r *routerRPC) gatewayFromContext(ctx context.Context) (gtw *gateway.Gateway, err error) {
+	md := api.MetadataFromContext(ctx)
 	gatewayID, err := api.IDFromMetadata(md)
 	if err != nil {
 		return nil, err
@@ -40,7 +42,13 @@ func (r *routerRPC) gatewayFromMetadata(md metadata.MD) (gtw *gateway.Gateway, e
 	authenticated := false
 	token, _ := api.TokenFromMetadata(md)
 
-	if token != "" {
+	if commonName, err := api.ClientCertificateFromContext(ctx); err == nil {
+		if commonName != gatewayID {
+			return nil, errors.NewErrPermissionDenied(fmt.Sprintf("Client certificate of \"%s\" not consistent with gateway ID \"%s\"", commonName, gatewayID))
+		}
+		authErr = nil
+		authenticated = true
+	} else if token != "" {
 		if r.router.TokenKeyProvider == nil {
 			return nil, errors.NewErrInternal("No token provider configured")
 		}
@@ -67,13 +75,8 @@ func (r *routerRPC) gatewayFromMetadata(md metadata.MD) (gtw *gateway.Gateway, e
 	return gtw, nil
 }
 
-func (r *routerRPC) gatewayFromContext(ctx context.Context) (gtw *gateway.Gateway, err error) {
-	md := api.MetadataFromContext(ctx)
-	return r.gatewayFromMetadata(md)
-}
-
-func (r *routerRPC) getUplink(md metadata.MD) (ch chan *pb.UplinkMessage, err error) {
-	gateway, err := r.gatewayFromMetadata(md)
+func (r *routerRPC) getUplink(ctx context.Context) (ch chan *pb.UplinkMessage, err error) {
+	gateway, err := r.gatewayFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -91,8 +94,8 @@ func (r *routerRPC) getUplink(md metadata.MD) (ch chan *pb.UplinkMessage, err er
 	return
 }
 
-func (r *routerRPC) getGatewayStatus(md metadata.MD) (ch chan *pb_gateway.Status, err error) {
-	gateway, err := r.gatewayFromMetadata(md)
+func (r *routerRPC) getGatewayStatus(ctx context.Context) (ch chan *pb_gateway.Status, err error) {
+	gateway, err := r.gatewayFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -109,8 +112,8 @@ func (r *routerRPC) getGatewayStatus(md metadata.MD) (ch chan *pb_gateway.Status
 	return
 }
 
-func (r *routerRPC) getDownlink(md metadata.MD) (ch <-chan *pb.DownlinkMessage, cancel func(), err error) {
-	gateway, err := r.gatewayFromMetadata(md)
+func (r *routerRPC) getDownlink(ctx context.Context) (ch <-chan *pb.DownlinkMessage, cancel func(), err error) {
+	gateway, err := r.gatewayFromContext(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/TheThingsNetwork/ttn/api"
 	pb "github.com/TheThingsNetwork/ttn/api/router"
 	"github.com/TheThingsNetwork/ttn/api/stats"
+	"github.com/TheThingsNetwork/ttn/core/router/gateway"
 	"github.com/rcrowley/go-metrics"
 )
 
@@ -17,6 +18,7 @@ type status struct {
 	gatewayStatus     metrics.Meter
 	connectedGateways metrics.Gauge
 	connectedBrokers  metrics.Gauge
+	queuedDownlinks   metrics.Gauge
 }
 
 func (r *router) InitStatus() {
@@ -26,15 +28,20 @@ func (r *router) InitStatus() {
 		activations:   metrics.NewMeter(),
 		gatewayStatus: metrics.NewMeter(),
 		connectedGateways: metrics.NewFunctionalGauge(func() int64 {
-			r.gatewaysLock.RLock()
-			defer r.gatewaysLock.RUnlock()
-			return int64(len(r.gateways))
+			return int64(r.gateways.Len())
 		}),
 		connectedBrokers: metrics.NewFunctionalGauge(func() int64 {
 			r.brokersLock.RLock()
 			defer r.brokersLock.RUnlock()
 			return int64(len(r.brokers))
 		}),
+		queuedDownlinks: metrics.NewFunctionalGauge(func() (queued int64) {
+			r.gateways.Range(func(gtw *gateway.Gateway) {
+				total, _ := gtw.Schedule.Queued()
+				queued += int64(total)
+			})
+			return
+		}),
 	}
 }
 
@@ -71,5 +78,9 @@ func (r *router) GetStatus() *pb.Status {
 	}
 	status.ConnectedGateways = uint32(r.status.connectedGateways.Snapshot().Value())
 	status.ConnectedBrokers = uint32(r.status.connectedBrokers.Snapshot().Value())
+	status.QueuedDownlinks = uint32(r.status.queuedDownlinks.Snapshot().Value())
+	for _, count := range r.gateways.Count() {
+		status.ConnectedGatewaysPerShard = append(status.ConnectedGatewaysPerShard, uint32(count))
+	}
 	return status
 }
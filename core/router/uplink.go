@@ -105,6 +105,7 @@ func (r *router) HandleUplink(gatewayID string, uplink *pb.UplinkMessage) (err e
 
 	var downlinkOptions []*pb_broker.DownlinkOption
 	if gateway.Schedule.IsActive() {
+		r.refreshGatewayState(gateway)
 		downlinkOptions = r.buildDownlinkOptions(uplink, false, gateway)
 		uplink.Trace = uplink.Trace.WithEvent(trace.BuildDownlinkEvent,
 			"options", len(downlinkOptions),
@@ -9,7 +9,6 @@ import (
 	"github.com/TheThingsNetwork/ttn/api/discovery"
 	"github.com/TheThingsNetwork/ttn/api/monitor"
 	"github.com/TheThingsNetwork/ttn/core/component"
-	"github.com/TheThingsNetwork/ttn/core/router/gateway"
 	. "github.com/TheThingsNetwork/ttn/utils/testing"
 	"github.com/golang/mock/gomock"
 )
@@ -31,7 +30,7 @@ func getTestRouter(t *testing.T) *testRouter {
 				Ctx:       logger,
 				Monitor:   monitor.NewClient(monitor.DefaultClientConfig),
 			},
-			gateways: map[string]*gateway.Gateway{},
+			gateways: newGatewayStore(),
 		},
 		ctrl:      ctrl,
 		discovery: discovery,
@@ -6,6 +6,7 @@ package storage
 import (
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 	"gopkg.in/redis.v5"
@@ -40,7 +41,10 @@ func (s *RedisKVStore) GetAll(keys []string, options *ListOptions) (map[string]s
 
 	sort.Strings(keys)
 
-	selectedKeys := selectKeys(keys, options)
+	selectedKeys, err := selectKeys(keys, options)
+	if err != nil {
+		return nil, err
+	}
 	if len(selectedKeys) == 0 {
 		return map[string]string{}, nil
 	}
@@ -133,6 +137,40 @@ func (s *RedisKVStore) Create(key string, value string) error {
 	return nil
 }
 
+// GetOrCreate creates a new record that expires after the given duration (0 for no expiration) if the record does
+// not exist yet, prepending the prefix to the key if necessary. It returns the current value of the record and whether
+// the record was created.
+func (s *RedisKVStore) GetOrCreate(key string, value string, expiration time.Duration) (current string, created bool, err error) {
+	if !strings.HasPrefix(key, s.prefix) {
+		key = s.prefix + key
+	}
+	created, err = s.client.SetNX(key, value, expiration).Result()
+	if err != nil {
+		return "", false, err
+	}
+	if created {
+		return value, true, nil
+	}
+	current, err = s.client.Get(key).Result()
+	if err == redis.Nil {
+		// The record expired in the meantime
+		return s.GetOrCreate(key, value, expiration)
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return current, false, nil
+}
+
+// Increment the counter in a record and return the new value, prepending the prefix to the key if necessary. A record
+// that does not exist yet is created with the value 1.
+func (s *RedisKVStore) Increment(key string) (int64, error) {
+	if !strings.HasPrefix(key, s.prefix) {
+		key = s.prefix + key
+	}
+	return s.client.Incr(key).Result()
+}
+
 // Update an existing record, prepending the prefix to the key if necessary
 // This function returns an error if the record does not exist
 func (s *RedisKVStore) Update(key string, value string) error {
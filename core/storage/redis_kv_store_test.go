@@ -6,6 +6,7 @@ package storage
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 	. "github.com/smartystreets/assertions"
@@ -43,6 +44,26 @@ func TestRedisKVStore(t *testing.T) {
 		a.So(err, ShouldNotBeNil)
 	}
 
+	// GetOrCreate
+	{
+		defer func() {
+			c.Del("test-redis-kv-store:idempotent").Result()
+		}()
+		current, created, err := s.GetOrCreate("idempotent", "first", time.Minute)
+		a.So(err, ShouldBeNil)
+		a.So(created, ShouldBeTrue)
+		a.So(current, ShouldEqual, "first")
+
+		current, created, err = s.GetOrCreate("idempotent", "second", time.Minute)
+		a.So(err, ShouldBeNil)
+		a.So(created, ShouldBeFalse)
+		a.So(current, ShouldEqual, "first")
+
+		ttl, err := c.TTL("test-redis-kv-store:idempotent").Result()
+		a.So(err, ShouldBeNil)
+		a.So(ttl, ShouldBeGreaterThan, 0)
+	}
+
 	// Get
 	{
 		res, err := s.Get("test")
@@ -135,4 +156,18 @@ func TestRedisKVStore(t *testing.T) {
 		a.So(exists, ShouldBeFalse)
 	}
 
+	// Increment
+	{
+		defer func() {
+			c.Del("test-redis-kv-store:counter").Result()
+		}()
+		value, err := s.Increment("counter")
+		a.So(err, ShouldBeNil)
+		a.So(value, ShouldEqual, 1)
+
+		value, err = s.Increment("counter")
+		a.So(err, ShouldBeNil)
+		a.So(value, ShouldEqual, 2)
+	}
+
 }
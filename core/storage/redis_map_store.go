@@ -4,7 +4,9 @@
 package storage
 
 import (
+	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/TheThingsNetwork/go-utils/encoding"
@@ -69,7 +71,19 @@ func (s *RedisMapStore) GetAll(keys []string, options *ListOptions) ([]interface
 
 	sort.Strings(keys)
 
-	selectedKeys := selectKeys(keys, options)
+	var values []string
+	if options != nil && (options.SortBy != "" || options.Descending || len(options.Filters) > 0) {
+		var err error
+		keys, values, err = s.sortAndFilter(keys, options)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	selectedKeys, err := selectSortedKeys(keys, values, options)
+	if err != nil {
+		return nil, err
+	}
 	if len(selectedKeys) == 0 {
 		return []interface{}{}, nil
 	}
@@ -84,7 +98,7 @@ func (s *RedisMapStore) GetAll(keys []string, options *ListOptions) ([]interface
 	}
 
 	// Execute pipeline
-	_, err := pipe.Exec()
+	_, err = pipe.Exec()
 	if err != nil {
 		return nil, err
 	}
@@ -103,6 +117,63 @@ func (s *RedisMapStore) GetAll(keys []string, options *ListOptions) ([]interface
 	return results, nil
 }
 
+// sortAndFilter returns the keys of the items that match the filters of the options, sorted by the SortBy field of
+// the options, along with the values of that field
+func (s *RedisMapStore) sortAndFilter(keys []string, options *ListOptions) (filteredKeys, values []string, err error) {
+	fields := make([]string, 0, len(options.Filters)+1)
+	fields = append(fields, options.SortBy)
+	filterFields := make([]string, 0, len(options.Filters))
+	for field := range options.Filters {
+		filterFields = append(filterFields, field)
+	}
+	fields = append(fields, filterFields...)
+
+	pipe := s.client.Pipeline()
+	defer pipe.Close()
+
+	cmds := make([]*redis.SliceCmd, len(keys))
+	for i, key := range keys {
+		cmds[i] = pipe.HMGet(key, fields...)
+	}
+
+	_, err = pipe.Exec()
+	if err != nil && err != redis.Nil {
+		return nil, nil, err
+	}
+
+	items := sortedKeys{descending: options.Descending}
+	for i, key := range keys {
+		result, err := cmds[i].Result()
+		if err != nil {
+			continue
+		}
+		value := func(j int) string {
+			if j < len(result) {
+				if str, ok := result[j].(string); ok {
+					return str
+				}
+			}
+			return ""
+		}
+		matches := true
+		for j, field := range filterFields {
+			if value(j+1) != options.Filters[field] {
+				matches = false
+				break
+			}
+		}
+		if !matches {
+			continue
+		}
+		items.keys = append(items.keys, key)
+		items.values = append(items.values, value(0))
+	}
+
+	sort.Sort(items)
+
+	return items.keys, items.values, nil
+}
+
 // List all results matching the selector, prepending the prefix to the selector if necessary
 func (s *RedisMapStore) List(selector string, options *ListOptions) ([]interface{}, error) {
 	allKeys, err := s.Keys(selector)
@@ -119,12 +190,12 @@ func (s *RedisMapStore) Get(key string) (interface{}, error) {
 		key = s.prefix + key
 	}
 	result, err := s.client.HGetAll(key).Result()
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
 	if err == redis.Nil || len(result) == 0 {
 		return nil, errors.NewErrNotFound(key)
 	}
-	if err != nil {
-		return nil, err
-	}
 	result, _ = s.migrate(key, result)
 	i, err := s.decoder(result)
 	if err != nil {
@@ -201,6 +272,52 @@ func (s *RedisMapStore) Set(key string, value interface{}, properties ...string)
 	return s.client.HMSet(key, vmap).Err()
 }
 
+// SetIf sets a record if the condition holds for the current value of the given field, prepending the prefix to the key
+// if necessary, optionally setting only the given properties. The current value is empty if the record or the field
+// does not exist. The condition is checked atomically with the update.
+func (s *RedisMapStore) SetIf(key string, value interface{}, field string, condition func(current string) error, properties ...string) error {
+	key, vmap, err := s.prepare(key, value, properties...)
+	if err != nil {
+		return err
+	}
+	if len(vmap) == 0 {
+		return nil
+	}
+	return s.client.Watch(func(tx *redis.Tx) error {
+		current, err := tx.HGet(key, field).Result()
+		if err != nil && err != redis.Nil {
+			return err
+		}
+		if err := condition(current); err != nil {
+			return err
+		}
+		_, err = tx.Pipelined(func(pipe *redis.Pipeline) error {
+			pipe.HMSet(key, vmap)
+			return nil
+		})
+		return err
+	}, key)
+}
+
+// ExpectRevision returns a condition for SetIf that returns a conflict error if the current revision of the entity is
+// not the expected revision. An empty revision is treated as revision 0.
+func ExpectRevision(entity string, expected uint64) func(current string) error {
+	return func(current string) error {
+		var revision uint64
+		if current != "" {
+			var err error
+			revision, err = strconv.ParseUint(current, 10, 64)
+			if err != nil {
+				return err
+			}
+		}
+		if revision != expected {
+			return errors.NewErrConflict(fmt.Sprintf("%s (revision %d, expected %d)", entity, revision, expected))
+		}
+		return nil
+	}
+}
+
 // Create a new record, prepending the prefix to the key if necessary, optionally setting only the given properties
 // This function returns an error if the record already exists
 func (s *RedisMapStore) Create(key string, value interface{}, properties ...string) error {
@@ -203,3 +203,107 @@ func TestRedisMapStore(t *testing.T) {
 	}
 
 }
+
+type testRevisionStruct struct {
+	Name     string `redis:"name"`
+	Revision uint64 `redis:"revision"`
+}
+
+func TestRedisMapStoreSetIf(t *testing.T) {
+	a := New(t)
+	c := getRedisClient()
+	s := NewRedisMapStore(c, "test-redis-map-store-set-if")
+	s.SetBase(testRevisionStruct{}, "")
+	defer c.Del("test-redis-map-store-set-if:test").Result()
+
+	// Create
+	err := s.SetIf("test", testRevisionStruct{Name: "first", Revision: 1}, "revision", ExpectRevision("Test", 0))
+	a.So(err, ShouldBeNil)
+
+	// Outdated revision
+	err = s.SetIf("test", testRevisionStruct{Name: "second", Revision: 1}, "revision", ExpectRevision("Test", 0))
+	a.So(err, ShouldNotBeNil)
+	a.So(errors.IsConflict(err), ShouldBeTrue)
+
+	res, _ := s.Get("test")
+	a.So(res.(testRevisionStruct).Name, ShouldEqual, "first")
+
+	// Current revision
+	err = s.SetIf("test", testRevisionStruct{Name: "second", Revision: 2}, "revision", ExpectRevision("Test", 1))
+	a.So(err, ShouldBeNil)
+
+	res, _ = s.Get("test")
+	a.So(res.(testRevisionStruct).Name, ShouldEqual, "second")
+	a.So(res.(testRevisionStruct).Revision, ShouldEqual, 2)
+}
+
+type testListStruct struct {
+	Name  string `redis:"name"`
+	Group string `redis:"group"`
+	Seen  Time   `redis:"seen"`
+}
+
+func TestRedisMapStoreListOptions(t *testing.T) {
+	a := New(t)
+	c := getRedisClient()
+	s := NewRedisMapStore(c, "test-redis-map-store-list-options")
+	s.SetBase(testListStruct{}, "")
+
+	now := time.Now()
+	for i, name := range []string{"a", "b", "c", "d", "e"} {
+		group := "odd"
+		if i%2 == 1 {
+			group = "even"
+		}
+		s.Set(name, testListStruct{Name: name, Group: group, Seen: Time{now.Add(time.Duration(-i) * time.Second)}})
+		defer c.Del("test-redis-map-store-list-options:" + name).Result()
+	}
+
+	names := func(res []interface{}) (names []string) {
+		for _, item := range res {
+			names = append(names, item.(testListStruct).Name)
+		}
+		return
+	}
+
+	// Pages with a cursor
+	opts := &ListOptions{Limit: 2}
+	res, err := s.List("*", opts)
+	a.So(err, ShouldBeNil)
+	a.So(names(res), ShouldResemble, []string{"a", "b"})
+	a.So(opts.NextCursor(), ShouldNotBeEmpty)
+
+	opts = &ListOptions{Limit: 2, Cursor: opts.NextCursor()}
+	res, _ = s.List("*", opts)
+	a.So(names(res), ShouldResemble, []string{"c", "d"})
+
+	opts = &ListOptions{Limit: 2, Cursor: opts.NextCursor()}
+	res, _ = s.List("*", opts)
+	a.So(names(res), ShouldResemble, []string{"e"})
+	a.So(opts.NextCursor(), ShouldBeEmpty)
+
+	// Sorted
+	opts = &ListOptions{SortBy: "seen"}
+	res, _ = s.List("*", opts)
+	a.So(names(res), ShouldResemble, []string{"e", "d", "c", "b", "a"})
+
+	opts = &ListOptions{SortBy: "seen", Descending: true, Limit: 3}
+	res, _ = s.List("*", opts)
+	a.So(names(res), ShouldResemble, []string{"a", "b", "c"})
+
+	opts = &ListOptions{SortBy: "seen", Descending: true, Limit: 3, Cursor: opts.NextCursor()}
+	res, _ = s.List("*", opts)
+	a.So(names(res), ShouldResemble, []string{"d", "e"})
+
+	// Filtered
+	opts = &ListOptions{Filters: map[string]string{"group": "even"}}
+	res, _ = s.List("*", opts)
+	a.So(names(res), ShouldResemble, []string{"b", "d"})
+	total, selected := opts.GetTotalAndSelected()
+	a.So(total, ShouldEqual, 2)
+	a.So(selected, ShouldEqual, 2)
+
+	// Invalid cursor
+	_, err = s.List("*", &ListOptions{Cursor: "invalid!"})
+	a.So(err, ShouldNotBeNil)
+}
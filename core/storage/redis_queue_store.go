@@ -39,7 +39,10 @@ func (s *RedisQueueStore) GetAll(keys []string, options *ListOptions) (map[strin
 
 	sort.Strings(keys)
 
-	selectedKeys := selectKeys(keys, options)
+	selectedKeys, err := selectKeys(keys, options)
+	if err != nil {
+		return nil, err
+	}
 	if len(selectedKeys) == 0 {
 		return map[string][]string{}, nil
 	}
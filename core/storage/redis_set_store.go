@@ -41,7 +41,10 @@ func (s *RedisSetStore) GetAll(keys []string, options *ListOptions) (map[string]
 
 	sort.Strings(keys)
 
-	selectedKeys := selectKeys(keys, options)
+	selectedKeys, err := selectKeys(keys, options)
+	if err != nil {
+		return nil, err
+	}
 	if len(selectedKeys) == 0 {
 		return map[string][]string{}, nil
 	}
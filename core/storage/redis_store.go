@@ -66,3 +66,19 @@ func (s *RedisStore) Delete(key string) error {
 	}
 	return s.client.Del(key).Err()
 }
+
+// Rename an existing record to a key in the other store, prepending the prefixes to the keys if necessary. An existing
+// record with the new key is overwritten. Renaming a record that does not exist is a no-op.
+func (s *RedisStore) Rename(key string, other *RedisStore, newKey string) error {
+	if !strings.HasPrefix(key, s.prefix) {
+		key = s.prefix + key
+	}
+	if !strings.HasPrefix(newKey, other.prefix) {
+		newKey = other.prefix + newKey
+	}
+	err := s.client.Rename(key, newKey).Err()
+	if err != nil && err.Error() == "ERR no such key" {
+		return nil
+	}
+	return err
+}
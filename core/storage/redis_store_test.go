@@ -0,0 +1,39 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestRedisStoreRename(t *testing.T) {
+	a := New(t)
+	c := getRedisClient()
+	from := NewRedisQueueStore(c, "test-redis-store-rename-from")
+	to := NewRedisQueueStore(c, "test-redis-store-rename-to")
+
+	defer func() {
+		c.Del("test-redis-store-rename-from:test", "test-redis-store-rename-to:test").Result()
+	}()
+
+	// Renaming a record that does not exist
+	err := from.Rename("test", to.RedisStore, "test")
+	a.So(err, ShouldBeNil)
+
+	a.So(from.AddEnd("test", "value1", "value2"), ShouldBeNil)
+	a.So(to.AddEnd("test", "old"), ShouldBeNil)
+
+	err = from.Rename("test", to.RedisStore, "test")
+	a.So(err, ShouldBeNil)
+
+	res, err := from.Get("test")
+	a.So(err, ShouldBeNil)
+	a.So(res, ShouldBeEmpty)
+
+	res, err = to.Get("test")
+	a.So(err, ShouldBeNil)
+	a.So(res, ShouldResemble, []string{"value1", "value2"})
+}
@@ -3,13 +3,33 @@
 
 package storage
 
+import (
+	"encoding/base64"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
 // ListOptions are options for all list commands
 type ListOptions struct {
 	Limit  uint64
 	Offset uint64
 
-	total    uint64
-	selected uint64
+	// Cursor selects the items after the last item of a previous page (see NextCursor)
+	Cursor string
+	// SortBy is the field to sort by. If empty, items are sorted by key.
+	SortBy string
+	// Descending sorts the items in descending order
+	Descending bool
+	// Filters selects only the items of which the fields have the given values
+	Filters map[string]string
+
+	total      uint64
+	selected   uint64
+	nextCursor string
 }
 
 // GetTotalAndSelected returns the total number of items, along with the number of selected items
@@ -17,24 +37,125 @@ func (o ListOptions) GetTotalAndSelected() (total, selected uint64) {
 	return o.total, o.selected
 }
 
-func selectKeys(keys []string, options *ListOptions) []string {
-	var start uint64
-	end := uint64(len(keys))
-	if options != nil {
-		options.total = end
-		if options.Offset >= options.total {
-			return []string{}
+// NextCursor returns the cursor for the next page, or an empty string if there are no more items
+func (o ListOptions) NextCursor() string {
+	return o.nextCursor
+}
+
+// encodeCursor encodes the sort value and key of an item into an opaque cursor
+func encodeCursor(value, key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(value + "\x00" + key))
+}
+
+func decodeCursor(cursor string) (value, key string, err error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", "", errors.NewErrInvalidArgument("Cursor", "invalid cursor")
+	}
+	parts := strings.SplitN(string(decoded), "\x00", 2)
+	if len(parts) != 2 {
+		return "", "", errors.NewErrInvalidArgument("Cursor", "invalid cursor")
+	}
+	return parts[0], parts[1], nil
+}
+
+// compareValues compares two field values as times or numbers if possible, or as strings otherwise
+func compareValues(a, b string) int {
+	if ta, err := time.Parse(time.RFC3339Nano, a); err == nil {
+		if tb, err := time.Parse(time.RFC3339Nano, b); err == nil {
+			switch {
+			case ta.Before(tb):
+				return -1
+			case ta.After(tb):
+				return 1
+			}
+			return 0
 		}
-		start = options.Offset
-		if options.Limit > 0 {
-			if options.Offset+options.Limit > options.total {
-				options.Limit = options.total - options.Offset
+	}
+	if fa, err := strconv.ParseFloat(a, 64); err == nil {
+		if fb, err := strconv.ParseFloat(b, 64); err == nil {
+			switch {
+			case fa < fb:
+				return -1
+			case fa > fb:
+				return 1
 			}
-			end = options.Offset + options.Limit
+			return 0
 		}
-		options.selected = end - start
 	}
-	return keys[start:end]
+	return strings.Compare(a, b)
+}
+
+// compareItems compares two items by their sort value, and then by their key
+func compareItems(aValue, aKey, bValue, bKey string, descending bool) int {
+	c := compareValues(aValue, bValue)
+	if c == 0 {
+		c = strings.Compare(aKey, bKey)
+	}
+	if descending {
+		return -c
+	}
+	return c
+}
+
+// sortedKeys sorts keys by their values
+type sortedKeys struct {
+	keys       []string
+	values     []string
+	descending bool
+}
+
+func (s sortedKeys) Len() int { return len(s.keys) }
+
+func (s sortedKeys) Less(i, j int) bool {
+	return compareItems(s.values[i], s.keys[i], s.values[j], s.keys[j], s.descending) < 0
+}
+
+func (s sortedKeys) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	s.values[i], s.values[j] = s.values[j], s.values[i]
+}
+
+func selectKeys(keys []string, options *ListOptions) ([]string, error) {
+	return selectSortedKeys(keys, nil, options)
+}
+
+// selectSortedKeys selects the keys according to the cursor, offset and limit of the options. The values are the
+// values that the keys are sorted by, or nil if the keys are sorted by key.
+func selectSortedKeys(keys, values []string, options *ListOptions) ([]string, error) {
+	if options == nil {
+		return keys, nil
+	}
+	valueOf := func(i int) string {
+		if values == nil {
+			return ""
+		}
+		return values[i]
+	}
+	options.total = uint64(len(keys))
+	options.nextCursor = ""
+	var start uint64
+	if options.Cursor != "" {
+		cursorValue, cursorKey, err := decodeCursor(options.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		start = uint64(sort.Search(len(keys), func(i int) bool {
+			return compareItems(cursorValue, cursorKey, valueOf(i), keys[i], options.Descending) < 0
+		}))
+	}
+	start += options.Offset
+	if start >= options.total {
+		options.selected = 0
+		return []string{}, nil
+	}
+	end := options.total
+	if options.Limit > 0 && start+options.Limit < options.total {
+		end = start + options.Limit
+		options.nextCursor = encodeCursor(valueOf(int(end-1)), keys[end-1])
+	}
+	options.selected = end - start
+	return keys[start:end], nil
 }
 
 func stringInSlice(search string, slice []string) bool {
@@ -20,7 +20,7 @@ type DataRate struct {
 
 // ParseDataRate parses a 32-bit hex-encoded string to a Devdatr
 func ParseDataRate(input string) (datr *DataRate, err error) {
-	re := regexp.MustCompile("SF(7|8|9|10|11|12)BW(125|250|500)")
+	re := regexp.MustCompile("SF(5|6|7|8|9|10|11|12)BW(125|250|500|812)")
 	matches := re.FindStringSubmatch(input)
 	if len(matches) != 3 {
 		return nil, errors.New("ttn/core: Invalid DataRate")
@@ -15,11 +15,14 @@ const (
 
 // DownlinkMessage represents an application-layer downlink message
 type DownlinkMessage struct {
-	AppID         string                 `json:"app_id,omitempty"`
-	DevID         string                 `json:"dev_id,omitempty"`
-	FPort         uint8                  `json:"port"`
-	Confirmed     bool                   `json:"confirmed,omitempty"`
-	Schedule      ScheduleType           `json:"schedule,omitempty"` // allowed values: "replace" (default), "first", "last"
-	PayloadRaw    []byte                 `json:"payload_raw,omitempty"`
-	PayloadFields map[string]interface{} `json:"payload_fields,omitempty"`
+	ID             string                 `json:"id,omitempty"`              // assigned by the handler
+	IdempotencyKey string                 `json:"idempotency_key,omitempty"` // downlinks with the same key are only scheduled once
+	AppID          string                 `json:"app_id,omitempty"`
+	DevID          string                 `json:"dev_id,omitempty"`
+	FPort          uint8                  `json:"port"`
+	Confirmed      bool                   `json:"confirmed,omitempty"`
+	Schedule       ScheduleType           `json:"schedule,omitempty"` // allowed values: "replace" (default), "first", "last"
+	Critical       bool                   `json:"critical,omitempty"` // critical downlinks are transmitted by two gateways if possible
+	PayloadRaw     []byte                 `json:"payload_raw,omitempty"`
+	PayloadFields  map[string]interface{} `json:"payload_fields,omitempty"`
 }
@@ -14,10 +14,23 @@ const (
 	DownlinkSentEvent      EventType = "down/sent"
 	DownlinkErrorEvent     EventType = "down/errors"
 	DownlinkAckEvent       EventType = "down/acks"
+	DownlinkMissedEvent    EventType = "down/missed"
 
 	ActivationEvent      EventType = "activations"
 	ActivationErrorEvent EventType = "activations/errors"
 
+	ADREvent EventType = "adr"
+
+	AnomalyEvent EventType = "anomalies"
+
+	FieldNamesEvent EventType = "up/fields"
+
+	FunctionLogEvent EventType = "debug/functions"
+
+	ClockSkewEvent EventType = "clock-skew"
+
+	SheddingEvent EventType = "shedding"
+
 	CreateEvent EventType = "create"
 	UpdateEvent EventType = "update"
 	DeleteEvent EventType = "delete"
@@ -36,6 +49,17 @@ type ErrorEventData struct {
 	Error string `json:"error,omitempty"`
 }
 
+// FunctionErrorEventData is added to uplink error events when the payload functions fail or the validator rejects
+// the payload. The type is the type of the error (for example invalid argument or resource limit).
+type FunctionErrorEventData struct {
+	ErrorEventData
+	Function   string `json:"function"`
+	Type       string `json:"type"`
+	FPort      uint8  `json:"port"`
+	FCnt       uint32 `json:"counter"`
+	PayloadRaw []byte `json:"payload_raw"`
+}
+
 // ActivationEventData is added to activation events
 type ActivationEventData struct {
 	ErrorEventData
@@ -62,4 +86,83 @@ type DownlinkEventData struct {
 	Message   *DownlinkMessage        `json:"message,omitempty"`
 	GatewayID string                  `json:"gateway_id,omitempty"`
 	Config    DownlinkEventConfigInfo `json:"config,omitempty"`
+	Duplicate bool                    `json:"duplicate,omitempty"`
+}
+
+// DownlinkMissedEventData is added to missed downlink events
+type DownlinkMissedEventData struct {
+	Message           *DownlinkMessage `json:"message,omitempty"`
+	ConsecutiveMissed uint32           `json:"consecutive_missed"`
+	DeliveryRate      float64          `json:"delivery_rate"`
+}
+
+// ADREventData is added to ADR events
+type ADREventData struct {
+	OldDataRate string  `json:"old_data_rate"`
+	NewDataRate string  `json:"new_data_rate"`
+	OldTxPower  int     `json:"old_tx_power"`
+	NewTxPower  int     `json:"new_tx_power"`
+	OldNbTrans  uint    `json:"old_nb_trans"`
+	NewNbTrans  uint    `json:"new_nb_trans"`
+	Reason      string  `json:"reason,omitempty"`
+	SNRMargin   float32 `json:"snr_margin"`
+	Accepted    bool    `json:"accepted"`
+}
+
+// FieldAnomaly is an outlier in a numeric payload field. For sensitive fields, the value and statistics are redacted.
+type FieldAnomaly struct {
+	Field    string  `json:"field"`
+	Value    float64 `json:"value"`
+	Mean     float64 `json:"mean"`
+	StdDev   float64 `json:"std_dev"`
+	Score    float64 `json:"score"`
+	Redacted bool    `json:"redacted,omitempty"`
+}
+
+// AnomalyEventData is added to anomaly events
+type AnomalyEventData struct {
+	FCnt      uint32         `json:"counter"`
+	Anomalies []FieldAnomaly `json:"anomalies"`
+}
+
+// FieldNameViolation is a payload field that was renamed because its name contained invalid characters or was
+// reserved. Fields in nested objects are separated with dots.
+type FieldNameViolation struct {
+	Field   string `json:"field"`
+	Renamed string `json:"renamed"`
+	Reason  string `json:"reason"`
+}
+
+// FieldNamesEventData is added to field name events
+type FieldNamesEventData struct {
+	FCnt       uint32               `json:"counter"`
+	Violations []FieldNameViolation `json:"violations"`
+}
+
+// FunctionLogEntry is a line that a payload function logged
+type FunctionLogEntry struct {
+	Function string   `json:"function"`
+	Fields   []string `json:"fields"`
+}
+
+// FunctionLogEventData is added to function log events, that are published for devices in debug mode
+type FunctionLogEventData struct {
+	ErrorEventData
+	Logs []FunctionLogEntry `json:"logs"`
+}
+
+// ClockSkewEventData is added to clock skew events. If the device time is a sensitive field, the device time and
+// skew are redacted.
+type ClockSkewEventData struct {
+	FCnt        uint32   `json:"counter"`
+	DeviceTime  JSONTime `json:"device_time"`
+	NetworkTime JSONTime `json:"network_time"`
+	Skew        float64  `json:"skew"`
+	Redacted    bool     `json:"redacted,omitempty"`
+}
+
+// SheddingEventData is added to shedding events. The load is relative to the capacity of the handler.
+type SheddingEventData struct {
+	Load float64  `json:"load"`
+	Shed []string `json:"shed"`
 }
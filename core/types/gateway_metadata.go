@@ -13,5 +13,17 @@ type GatewayMetadata struct {
 	RSSI       float32  `json:"rssi,omitempty"`
 	SNR        float32  `json:"snr,omitempty"`
 	RFChain    uint32   `json:"rf_chain,omitempty"`
+	// Antennas contains the metadata of each antenna of the gateway that received the message
+	Antennas []AntennaMetadata `json:"antennas,omitempty"`
+	LocationMetadata
+}
+
+// AntennaMetadata contains metadata for each antenna of a gateway that received a message
+type AntennaMetadata struct {
+	Antenna uint32  `json:"antenna"`
+	Channel uint32  `json:"channel"`
+	RSSI    float32 `json:"rssi,omitempty"`
+	SNR     float32 `json:"snr,omitempty"`
+	Gain    float32 `json:"gain,omitempty"`
 	LocationMetadata
 }
@@ -6,11 +6,14 @@ package types
 // Metadata contains metadata of a message
 type Metadata struct {
 	Time       JSONTime          `json:"time,omitempty,omitempty"`
+	DeviceTime JSONTime          `json:"device_time,omitempty"`
 	Frequency  float32           `json:"frequency,omitempty"`
 	Modulation string            `json:"modulation,omitempty"`
 	DataRate   string            `json:"data_rate,omitempty"`
 	Bitrate    uint32            `json:"bit_rate,omitempty"`
 	CodingRate string            `json:"coding_rate,omitempty"`
 	Gateways   []GatewayMetadata `json:"gateways,omitempty"`
+	Simulated  bool              `json:"simulated,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
 	LocationMetadata
 }
@@ -5,14 +5,33 @@ package types
 
 // UplinkMessage represents an application-layer uplink message
 type UplinkMessage struct {
-	AppID          string                 `json:"app_id,omitempty"`
-	DevID          string                 `json:"dev_id,omitempty"`
-	HardwareSerial string                 `json:"hardware_serial,omitempty"`
-	FPort          uint8                  `json:"port"`
-	FCnt           uint32                 `json:"counter"`
-	Confirmed      bool                   `json:"confirmed,omitempty"`
-	IsRetry        bool                   `json:"is_retry,omitempty"`
-	PayloadRaw     []byte                 `json:"payload_raw"`
-	PayloadFields  map[string]interface{} `json:"payload_fields,omitempty"`
-	Metadata       Metadata               `json:"metadata,omitempty"`
+	AppID             string                 `json:"app_id,omitempty"`
+	DevID             string                 `json:"dev_id,omitempty"`
+	HardwareSerial    string                 `json:"hardware_serial,omitempty"`
+	FPort             uint8                  `json:"port"`
+	FCnt              uint32                 `json:"counter"`
+	Confirmed         bool                   `json:"confirmed,omitempty"`
+	IsRetry           bool                   `json:"is_retry,omitempty"`
+	IsReplay          bool                   `json:"is_replay,omitempty"`
+	PayloadRaw        []byte                 `json:"payload_raw"`
+	PayloadFields     map[string]interface{} `json:"payload_fields,omitempty"`
+	NormalizedPayload *NormalizedPayload     `json:"normalized_payload,omitempty"`
+	Metadata          Metadata               `json:"metadata,omitempty"`
+	Aggregate         *AggregateMetadata     `json:"aggregate,omitempty"`
+}
+
+// AggregateMetadata is added to uplink messages that contain the average of the uplink messages in a window
+type AggregateMetadata struct {
+	Start JSONTime `json:"start"`
+	End   JSONTime `json:"end"`
+	Count uint32   `json:"count"`
+}
+
+// NormalizedPayload contains the measurements of an uplink message in a standard schema, so that they can be used
+// independent of the payload format of the device. Measurements that the device does not report are left out.
+type NormalizedPayload struct {
+	Temperature *float64          `json:"temperature,omitempty"` // Temperature in degrees Celsius
+	Humidity    *float64          `json:"humidity,omitempty"`    // Relative humidity in percent
+	Battery     *float64          `json:"battery,omitempty"`     // Battery level in percent
+	Location    *LocationMetadata `json:"location,omitempty"`    // Location of the device
 }
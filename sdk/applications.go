@@ -0,0 +1,51 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// RegisterApplication registers an application to the Handler
+func (c *Client) RegisterApplication(appID string) error {
+	return c.call(func(ctx context.Context) error {
+		_, err := c.applicationManager.RegisterApplication(ctx, &pb.ApplicationIdentifier{AppId: appID})
+		return err
+	})
+}
+
+// GetApplication gets the settings of an application from the Handler
+func (c *Client) GetApplication(appID string) (app *Application, err error) {
+	err = c.call(func(ctx context.Context) error {
+		res, err := c.applicationManager.GetApplication(ctx, &pb.ApplicationIdentifier{AppId: appID})
+		if err != nil {
+			return err
+		}
+		app = applicationFromPB(res)
+		return nil
+	})
+	return
+}
+
+// SetApplication updates the settings of an application on the Handler. The application must be registered first.
+// The Revision of app is updated. If the settings were changed since app was retrieved, a Conflict error is returned.
+func (c *Client) SetApplication(app *Application) error {
+	return c.call(func(ctx context.Context) error {
+		res, err := c.applicationManager.SetApplication(ctx, app.toPB())
+		if err != nil {
+			return err
+		}
+		app.Revision = res.Revision
+		return nil
+	})
+}
+
+// DeleteApplication deletes an application and all its devices from the Handler
+func (c *Client) DeleteApplication(appID string) error {
+	return c.call(func(ctx context.Context) error {
+		_, err := c.applicationManager.DeleteApplication(ctx, &pb.ApplicationIdentifier{AppId: appID})
+		return err
+	})
+}
@@ -0,0 +1,169 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+// Package sdk is a client for the management and data APIs of the Handler
+package sdk
+
+import (
+	"os"
+	"os/user"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/api"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// DefaultRetries is the number of times that a request is retried if the Handler is unavailable
+var DefaultRetries = 3
+
+// DefaultRetryBackoff is the time to wait before the first retry. The time doubles for every next retry.
+var DefaultRetryBackoff = 500 * time.Millisecond
+
+// DefaultTimeout is the timeout of requests to the Handler
+var DefaultTimeout = 10 * time.Second
+
+// ClientConfig contains the configuration of a Client
+type ClientConfig struct {
+	// ClientName identifies the client to the Handler (default: client-<user>@<hostname>)
+	ClientName string
+
+	// HandlerAddress is the address of the gRPC API of the Handler
+	HandlerAddress string
+	// HandlerCert is the PEM-encoded TLS certificate of the Handler (optional)
+	HandlerCert string
+	// MQTTAddress is the address of the MQTT broker of the Handler, used by the data API (for example
+	// tcp://eu.thethings.network:1883)
+	MQTTAddress string
+
+	// AccessKey is an access key of the application, used for the management and data APIs
+	AccessKey string
+	// TokenSource provides access tokens for the management API, if no AccessKey is set. Use an oauth2.ReuseTokenSource
+	// to refresh tokens when they expire.
+	TokenSource oauth2.TokenSource
+
+	// Retries is the number of times that a request is retried if the Handler is unavailable (default DefaultRetries)
+	Retries int
+	// RetryBackoff is the time to wait before the first retry (default DefaultRetryBackoff)
+	RetryBackoff time.Duration
+	// Timeout is the timeout of requests (default DefaultTimeout)
+	Timeout time.Duration
+}
+
+// Client for the management and data APIs of the Handler
+type Client struct {
+	config             ClientConfig
+	conn               *grpc.ClientConn
+	applicationManager pb.ApplicationManagerClient
+	devAddrManager     pb_lorawan.DevAddrManagerClient
+}
+
+// NewClient connects to the Handler with the given config
+func NewClient(config ClientConfig) (*Client, error) {
+	if config.HandlerAddress == "" {
+		return nil, errors.NewErrInvalidArgument("HandlerAddress", "can not be empty")
+	}
+	if config.AccessKey == "" && config.TokenSource == nil {
+		return nil, errors.NewErrInvalidArgument("ClientConfig", "needs an AccessKey or TokenSource")
+	}
+	if config.ClientName == "" {
+		config.ClientName = "client"
+		if user, err := user.Current(); err == nil {
+			config.ClientName += "-" + user.Username
+		}
+		if hostname, err := os.Hostname(); err == nil {
+			config.ClientName += "@" + hostname
+		}
+	}
+	if config.Retries == 0 {
+		config.Retries = DefaultRetries
+	}
+	if config.RetryBackoff == 0 {
+		config.RetryBackoff = DefaultRetryBackoff
+	}
+	if config.Timeout == 0 {
+		config.Timeout = DefaultTimeout
+	}
+
+	var conn *grpc.ClientConn
+	var err error
+	if config.HandlerCert != "" {
+		conn, err = api.DialWithCert(config.HandlerAddress, config.HandlerCert)
+	} else {
+		conn, err = api.Dial(config.HandlerAddress)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "Could not connect to Handler")
+	}
+
+	return &Client{
+		config:             config,
+		conn:               conn,
+		applicationManager: pb.NewApplicationManagerClient(conn),
+		devAddrManager:     pb_lorawan.NewDevAddrManagerClient(conn),
+	}, nil
+}
+
+// authContext returns a new context with authentication
+func (c *Client) authContext(ctx context.Context) (context.Context, error) {
+	ctx = api.ContextWithID(ctx, c.config.ClientName)
+	if c.config.AccessKey != "" {
+		return api.ContextWithKey(ctx, c.config.AccessKey), nil
+	}
+	token, err := c.config.TokenSource.Token()
+	if err != nil {
+		return nil, errors.Wrap(err, "Could not get access token")
+	}
+	return api.ContextWithToken(ctx, token.AccessToken), nil
+}
+
+// context returns a new context with authentication and a timeout
+func (c *Client) context() (context.Context, context.CancelFunc, error) {
+	ctx, err := c.authContext(context.Background())
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	return ctx, cancel, nil
+}
+
+// retryable returns whether a request that failed with the given error can be retried
+func retryable(err error) bool {
+	switch grpc.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+// call the Handler, and retry if the Handler is unavailable or rate limits the client. The access token is requested
+// from the token source for every attempt, so that expired tokens are refreshed.
+func (c *Client) call(request func(ctx context.Context) error) error {
+	backoff := c.config.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		ctx, cancel, err := c.context()
+		if err != nil {
+			return err
+		}
+		err = request(ctx)
+		cancel()
+		if err == nil {
+			return nil
+		}
+		if !retryable(err) || attempt >= c.config.Retries {
+			return errors.FromGRPCError(err)
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// Close the connection to the Handler
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,113 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	. "github.com/smartystreets/assertions"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestNewClient(t *testing.T) {
+	a := New(t)
+
+	_, err := NewClient(ClientConfig{})
+	a.So(errors.GetErrType(err), ShouldEqual, errors.InvalidArgument)
+
+	_, err = NewClient(ClientConfig{HandlerAddress: "localhost:1904"})
+	a.So(errors.GetErrType(err), ShouldEqual, errors.InvalidArgument)
+}
+
+func TestClientCall(t *testing.T) {
+	a := New(t)
+
+	c := &Client{config: ClientConfig{
+		ClientName:   "test",
+		AccessKey:    "ttn-account-v2.key",
+		Retries:      2,
+		RetryBackoff: time.Millisecond,
+		Timeout:      time.Second,
+	}}
+
+	// Authentication
+	err := c.call(func(ctx context.Context) error {
+		key, err := api.KeyFromContext(ctx)
+		a.So(err, ShouldBeNil)
+		a.So(key, ShouldEqual, "ttn-account-v2.key")
+		id, err := api.IDFromContext(ctx)
+		a.So(err, ShouldBeNil)
+		a.So(id, ShouldEqual, "test")
+		return nil
+	})
+	a.So(err, ShouldBeNil)
+
+	// Retry when unavailable
+	var attempts int
+	err = c.call(func(ctx context.Context) error {
+		attempts++
+		if attempts < 3 {
+			return grpc.Errorf(codes.Unavailable, "unavailable")
+		}
+		return nil
+	})
+	a.So(err, ShouldBeNil)
+	a.So(attempts, ShouldEqual, 3)
+
+	// Give up after the configured number of retries
+	attempts = 0
+	err = c.call(func(ctx context.Context) error {
+		attempts++
+		return grpc.Errorf(codes.ResourceExhausted, "rate limit reached")
+	})
+	a.So(err, ShouldNotBeNil)
+	a.So(attempts, ShouldEqual, 3)
+
+	// Do not retry other errors
+	attempts = 0
+	err = c.call(func(ctx context.Context) error {
+		attempts++
+		return grpc.Errorf(codes.NotFound, "device not found")
+	})
+	a.So(errors.GetErrType(err), ShouldEqual, errors.NotFound)
+	a.So(attempts, ShouldEqual, 1)
+}
+
+type countingTokenSource struct {
+	count int
+}
+
+func (s *countingTokenSource) Token() (*oauth2.Token, error) {
+	s.count++
+	return &oauth2.Token{AccessToken: "token"}, nil
+}
+
+func TestClientCallTokenSource(t *testing.T) {
+	a := New(t)
+
+	tokens := &countingTokenSource{}
+	c := &Client{config: ClientConfig{
+		TokenSource:  tokens,
+		Retries:      1,
+		RetryBackoff: time.Millisecond,
+		Timeout:      time.Second,
+	}}
+
+	err := c.call(func(ctx context.Context) error {
+		token, err := api.TokenFromContext(ctx)
+		a.So(err, ShouldBeNil)
+		a.So(token, ShouldEqual, "token")
+		return grpc.Errorf(codes.Unavailable, "unavailable")
+	})
+	a.So(err, ShouldNotBeNil)
+
+	// A token is requested for every attempt
+	a.So(tokens.count, ShouldEqual, 2)
+}
@@ -0,0 +1,26 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"github.com/TheThingsNetwork/ttn/mqtt"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// Data connects to the MQTT broker of the Handler, to subscribe to the uplink messages and events of an application
+// and to publish downlink messages. The client must be configured with an AccessKey of the application. Disconnect
+// the returned client when it is no longer needed.
+func (c *Client) Data(appID string) (mqtt.Client, error) {
+	if c.config.MQTTAddress == "" {
+		return nil, errors.NewErrInvalidArgument("MQTTAddress", "can not be empty")
+	}
+	if c.config.AccessKey == "" {
+		return nil, errors.NewErrInvalidArgument("AccessKey", "is required for the data API")
+	}
+	client := mqtt.NewClient(nil, c.config.ClientName, appID, c.config.AccessKey, c.config.MQTTAddress)
+	if err := client.Connect(); err != nil {
+		return nil, errors.Wrap(err, "Could not connect to MQTT")
+	}
+	return client, nil
+}
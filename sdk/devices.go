@@ -0,0 +1,202 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"io"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/api"
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// GetDevice gets the registration of a device from the Handler
+func (c *Client) GetDevice(appID, devID string) (dev *Device, err error) {
+	err = c.call(func(ctx context.Context) error {
+		res, err := c.applicationManager.GetDevice(ctx, &pb.DeviceIdentifier{AppId: appID, DevId: devID})
+		if err != nil {
+			return err
+		}
+		dev = deviceFromPB(res)
+		return nil
+	})
+	return
+}
+
+// SetDevice creates or updates the registration of a device on the Handler. The Revision of dev is updated. If the
+// registration was changed since dev was retrieved, or if a new device already exists, a Conflict error is returned.
+func (c *Client) SetDevice(dev *Device) error {
+	return c.call(func(ctx context.Context) error {
+		res, err := c.applicationManager.SetDevice(ctx, dev.toPB())
+		if err != nil {
+			return err
+		}
+		dev.Revision = res.Revision
+		return nil
+	})
+}
+
+// DeleteDevice deletes the registration of a device from the Handler
+func (c *Client) DeleteDevice(appID, devID string) error {
+	return c.call(func(ctx context.Context) error {
+		_, err := c.applicationManager.DeleteDevice(ctx, &pb.DeviceIdentifier{AppId: appID, DevId: devID})
+		return err
+	})
+}
+
+// GetDeviceUplinks gets the uplink messages of a device that are stored on the Handler, newest first
+func (c *Client) GetDeviceUplinks(appID, devID string) (uplinks []*Uplink, err error) {
+	err = c.call(func(ctx context.Context) error {
+		res, err := c.applicationManager.GetDeviceUplinks(ctx, &pb.DeviceIdentifier{AppId: appID, DevId: devID})
+		if err != nil {
+			return err
+		}
+		uplinks = make([]*Uplink, 0, len(res.Uplinks))
+		for _, uplink := range res.Uplinks {
+			uplinks = append(uplinks, uplinkFromPB(uplink))
+		}
+		return nil
+	})
+	return
+}
+
+// GetDevAddr requests a device address with the given constraints for a device that is activated by personalization
+func (c *Client) GetDevAddr(constraints ...string) (devAddr types.DevAddr, err error) {
+	err = c.call(func(ctx context.Context) error {
+		res, err := c.devAddrManager.GetDevAddr(ctx, &pb_lorawan.DevAddrRequest{Usage: constraints})
+		if err != nil {
+			return err
+		}
+		devAddr = *res.DevAddr
+		return nil
+	})
+	return
+}
+
+// WatchDevices calls handle for every change in the device registry of an application, starting after the given
+// resume token (empty to only watch new changes). It reconnects when the connection with the Handler is lost, and
+// returns when ctx is done or when handle returns an error.
+func (c *Client) WatchDevices(ctx context.Context, appID, resumeToken string, handle func(*DeviceChange) error) error {
+	backoff := c.config.RetryBackoff
+	for {
+		streamCtx, err := c.authContext(ctx)
+		if err != nil {
+			return err
+		}
+		streamCtx, cancel := context.WithCancel(streamCtx)
+		stream, err := c.applicationManager.WatchDevices(streamCtx, &pb.WatchDevicesRequest{AppId: appID, ResumeToken: resumeToken})
+		for err == nil {
+			var change *pb.DeviceChange
+			change, err = stream.Recv()
+			if err != nil {
+				break
+			}
+			backoff = c.config.RetryBackoff
+			if err := handle(deviceChangeFromPB(change)); err != nil {
+				cancel()
+				return err
+			}
+			resumeToken = change.ResumeToken
+		}
+		cancel()
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != io.EOF && !retryable(err) {
+			return errors.FromGRPCError(err)
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// DefaultPageSize is the number of devices that is requested at once by a DeviceIterator
+var DefaultPageSize = 100
+
+// DeviceListOptions are the options for listing devices
+type DeviceListOptions struct {
+	// PageSize is the number of devices that is requested at once (default DefaultPageSize)
+	PageSize int
+	// Sort is the field to sort on: dev_id (default), created_at or last_seen, with a - prefix for descending order
+	Sort string
+	// Filters select devices by app_eui, dev_eui or dev_addr
+	Filters map[string]string
+}
+
+// ListDevices returns an iterator over the devices of an application
+func (c *Client) ListDevices(appID string, options DeviceListOptions) *DeviceIterator {
+	if options.PageSize == 0 {
+		options.PageSize = DefaultPageSize
+	}
+	return &DeviceIterator{
+		client:  c,
+		appID:   appID,
+		options: options,
+	}
+}
+
+// DeviceIterator iterates over the devices of an application, requesting them from the Handler page by page
+type DeviceIterator struct {
+	client  *Client
+	appID   string
+	options DeviceListOptions
+
+	page    []*Device
+	cursor  string
+	done    bool
+	current *Device
+	err     error
+}
+
+// Next advances the iterator to the next device. It returns false when there are no more devices, or if an error
+// occurred.
+func (it *DeviceIterator) Next() bool {
+	for len(it.page) == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+		it.err = it.fetch()
+	}
+	it.current, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// Device returns the current device
+func (it *DeviceIterator) Device() *Device {
+	return it.current
+}
+
+// Err returns the error that stopped the iterator, if any
+func (it *DeviceIterator) Err() error {
+	return it.err
+}
+
+// fetch the next page of devices
+func (it *DeviceIterator) fetch() error {
+	return it.client.call(func(ctx context.Context) error {
+		ctx = api.ContextWithLimitAndOffset(ctx, uint64(it.options.PageSize), 0)
+		ctx = api.ContextWithListOptions(ctx, it.cursor, it.options.Sort, it.options.Filters)
+		var header metadata.MD
+		res, err := it.client.applicationManager.GetDevicesForApplication(ctx, &pb.ApplicationIdentifier{AppId: it.appID}, grpc.Header(&header))
+		if err != nil {
+			return err
+		}
+		it.page = make([]*Device, 0, len(res.Devices))
+		for _, dev := range res.Devices {
+			it.page = append(it.page, deviceFromPB(dev))
+		}
+		it.cursor = ""
+		if cursor, ok := header["next-cursor"]; ok && len(cursor) > 0 {
+			it.cursor = cursor[0]
+		}
+		it.done = it.cursor == ""
+		return nil
+	})
+}
@@ -0,0 +1,50 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+// Client for the management API (gRPC) of the Handler
+
+const os = require('os')
+const grpc = require('grpc')
+const handlerServices = require('./ttn/api/handler/handler_grpc_pb')
+const lorawanServices = require('./ttn/api/protocol/lorawan/device_address_grpc_pb')
+
+// HandlerClient connects to the management API of the Handler. Requests are
+// authenticated with an access key of the application, or with an access token:
+//
+//   const client = new HandlerClient('eu.thethings.network:1904', { accessKey: 'ttn-account-v2.someAccessKey' })
+//   const req = new handler.ApplicationIdentifier()
+//   req.setAppId('my-app-id')
+//   client.applicationManager.getApplication(req, client.metadata(), (err, app) => { ... })
+class HandlerClient {
+  constructor (address, { accessKey, token, cert, insecure, clientName } = {}) {
+    if (!accessKey && !token) {
+      throw new Error('HandlerClient needs an accessKey or token')
+    }
+    this.accessKey = accessKey
+    this.token = token
+    this.clientName = clientName || `client-${os.userInfo().username}@${os.hostname()}`
+    const credentials = insecure ? grpc.credentials.createInsecure() : grpc.credentials.createSsl(cert)
+    this.applicationManager = new handlerServices.ApplicationManagerClient(address, credentials)
+    this.devAddrManager = new lorawanServices.DevAddrManagerClient(address, credentials)
+  }
+
+  // metadata returns the metadata that authenticates a request
+  metadata () {
+    const metadata = new grpc.Metadata()
+    metadata.add('id', this.clientName)
+    if (this.accessKey) {
+      metadata.add('key', this.accessKey)
+    } else {
+      metadata.add('token', this.token)
+    }
+    return metadata
+  }
+
+  // close the connections to the Handler
+  close () {
+    grpc.closeClient(this.applicationManager)
+    grpc.closeClient(this.devAddrManager)
+  }
+}
+
+module.exports = { HandlerClient }
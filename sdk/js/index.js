@@ -0,0 +1,14 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+// Client for the management and data APIs of The Things Network Handler.
+// The gRPC messages and services in ttn/api are generated from the protobuf
+// definitions with `make sdk-js`.
+
+module.exports = {
+  HandlerClient: require('./client').HandlerClient,
+  MQTTClient: require('./mqtt').MQTTClient,
+  topics: require('./mqtt').topics,
+  handler: require('./ttn/api/handler/handler_pb'),
+  lorawan: require('./ttn/api/protocol/lorawan/device_address_pb'),
+}
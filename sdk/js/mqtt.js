@@ -0,0 +1,138 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+// Client for the data API (MQTT) of the Handler. The topics are the same as in
+// the Go client (github.com/TheThingsNetwork/ttn/mqtt):
+//
+//   <AppID>/devices/<DevID>/up[/<field>]   uplink messages (or a single field)
+//   <AppID>/devices/<DevID>/down           downlink messages
+//   <AppID>/devices/<DevID>/events/<type>  device events, such as activations
+//   <AppID>/events/<type>                  application events
+
+const mqtt = require('mqtt')
+
+const simpleWildcard = '+'
+const wildcard = '#'
+
+const DEVICE_UPLINK = 'up'
+const DEVICE_DOWNLINK = 'down'
+const DEVICE_EVENTS = 'events'
+const APP_EVENTS = 'events'
+
+// deviceTopic returns the topic of a device. An empty appID or devID matches all.
+function deviceTopic (appID, devID, type = DEVICE_UPLINK, field) {
+  if (type === DEVICE_EVENTS && !field) {
+    field = simpleWildcard
+  }
+  let topic = `${appID || simpleWildcard}/devices/${devID || simpleWildcard}/${type}`
+  if ((type === DEVICE_UPLINK || type === DEVICE_EVENTS) && field) {
+    topic += `/${field}`
+  }
+  return topic
+}
+
+// appTopic returns the topic of an application. An empty appID matches all.
+function appTopic (appID, type = APP_EVENTS, field) {
+  if (type === APP_EVENTS && !field) {
+    field = wildcard
+  }
+  let topic = `${appID || simpleWildcard}/${type}`
+  if (type === APP_EVENTS && field) {
+    topic += `/${field}`
+  }
+  return topic
+}
+
+// parseDeviceTopic returns the appID, devID, type and field of a device topic
+function parseDeviceTopic (topic) {
+  const parts = topic.split('/')
+  if (parts.length < 4 || parts[1] !== 'devices') {
+    throw new Error('Invalid topic format')
+  }
+  return { appID: parts[0], devID: parts[2], type: parts[3], field: parts.slice(4).join('/') || undefined }
+}
+
+// matches returns whether the topic matches the subscription
+function matches (subscription, topic) {
+  const sub = subscription.split('/')
+  const parts = topic.split('/')
+  for (let i = 0; i < sub.length; i++) {
+    if (sub[i] === wildcard) {
+      return true
+    }
+    if (i >= parts.length || (sub[i] !== simpleWildcard && sub[i] !== parts[i])) {
+      return false
+    }
+  }
+  return sub.length === parts.length
+}
+
+// MQTTClient connects to the MQTT broker of the Handler with an access key of
+// the application. Handlers are called with the appID, the devID and the
+// decoded JSON message.
+class MQTTClient {
+  constructor (appID, accessKey, url, options = {}) {
+    this.appID = appID
+    this.handlers = {}
+    this.client = mqtt.connect(url, Object.assign({ username: appID, password: accessKey }, options))
+    this.client.on('message', (topic, message) => this._onMessage(topic, message))
+  }
+
+  _onMessage (topic, message) {
+    for (const subscription of Object.keys(this.handlers)) {
+      if (!matches(subscription, topic)) {
+        continue
+      }
+      const parts = topic.split('/')
+      const devID = parts[1] === 'devices' ? parts[2] : undefined
+      this.handlers[subscription](parts[0], devID, message.length ? JSON.parse(message.toString()) : undefined)
+    }
+  }
+
+  // end disconnects from the broker
+  end (cb) {
+    this.client.end(false, cb)
+  }
+
+  subscribe (topic, handler, cb) {
+    this.handlers[topic] = handler
+    this.client.subscribe(topic, cb)
+  }
+
+  unsubscribe (topic, cb) {
+    delete this.handlers[topic]
+    this.client.unsubscribe(topic, cb)
+  }
+
+  // subscribeUplink subscribes to uplink messages of a device, or all devices if no devID is given
+  subscribeUplink (handler, devID, field, cb) {
+    this.subscribe(deviceTopic(this.appID, devID, DEVICE_UPLINK, field), handler, cb)
+  }
+
+  // subscribeDeviceEvents subscribes to events of a device, or all devices if no devID is given
+  subscribeDeviceEvents (handler, devID, event, cb) {
+    this.subscribe(deviceTopic(this.appID, devID, DEVICE_EVENTS, event), handler, cb)
+  }
+
+  // subscribeActivations subscribes to activations of a device, or all devices if no devID is given
+  subscribeActivations (handler, devID, cb) {
+    this.subscribeDeviceEvents(handler, devID, 'activations', cb)
+  }
+
+  // subscribeAppEvents subscribes to events of the application
+  subscribeAppEvents (handler, event, cb) {
+    this.subscribe(appTopic(this.appID, APP_EVENTS, event), handler, cb)
+  }
+
+  // publishDownlink publishes a downlink message for a device. The message
+  // contains the port and the payload_raw (Base64) or payload_fields, and
+  // optionally confirmed, schedule and idempotency_key.
+  publishDownlink (devID, message, cb) {
+    this.client.publish(deviceTopic(this.appID, devID, DEVICE_DOWNLINK), JSON.stringify(message), cb)
+  }
+}
+
+module.exports = {
+  MQTTClient,
+  topics: { deviceTopic, appTopic, parseDeviceTopic, matches },
+}
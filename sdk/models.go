@@ -0,0 +1,243 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"time"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/types"
+)
+
+// Application contains the settings of an application on the Handler
+type Application struct {
+	AppID string
+
+	// Payload functions (JavaScript)
+	Decoder   string
+	Converter string
+	Validator string
+	Encoder   string
+
+	// PayloadFormat is the format of the payload: custom (default) to use the payload functions, cayennelpp or wasm
+	PayloadFormat string
+	// WASMModule is the compiled WebAssembly module that decodes and encodes the payload if the PayloadFormat is wasm
+	WASMModule []byte
+	// FunctionTimeout is the maximum time that each payload function is allowed to run (0 for the default)
+	FunctionTimeout time.Duration
+
+	// IntegrationFormat is the format of published uplink messages (empty for the default format)
+	IntegrationFormat string
+	// RetentionDays is the number of days that stored uplink messages are kept (0 to keep them until the uplink
+	// history is full)
+	RetentionDays uint32
+
+	// Revision of the settings on the Handler, used to detect concurrent changes (read-only)
+	Revision uint64
+}
+
+// applicationFields are the fields of the Application that are updated by SetApplication
+var applicationFields = []string{"decoder", "converter", "validator", "encoder", "payload_format", "wasm_module", "function_timeout", "integration_format", "retention_days"}
+
+func applicationFromPB(in *pb.Application) *Application {
+	return &Application{
+		AppID:             in.AppId,
+		Decoder:           in.Decoder,
+		Converter:         in.Converter,
+		Validator:         in.Validator,
+		Encoder:           in.Encoder,
+		PayloadFormat:     in.PayloadFormat,
+		WASMModule:        in.WasmModule,
+		FunctionTimeout:   time.Duration(in.FunctionTimeout) * time.Millisecond,
+		IntegrationFormat: in.IntegrationFormat,
+		RetentionDays:     in.RetentionDays,
+		Revision:          in.Revision,
+	}
+}
+
+func (a *Application) toPB() *pb.Application {
+	return &pb.Application{
+		AppId:             a.AppID,
+		Decoder:           a.Decoder,
+		Converter:         a.Converter,
+		Validator:         a.Validator,
+		Encoder:           a.Encoder,
+		PayloadFormat:     a.PayloadFormat,
+		WasmModule:        a.WASMModule,
+		FunctionTimeout:   uint32(a.FunctionTimeout / time.Millisecond),
+		IntegrationFormat: a.IntegrationFormat,
+		RetentionDays:     a.RetentionDays,
+		Revision:          a.Revision,
+		UpdateMask:        applicationFields,
+	}
+}
+
+// Device contains the registration of a device on the Handler
+type Device struct {
+	AppID       string
+	DevID       string
+	Description string
+	Attributes  map[string]string
+
+	// Location of the device
+	Latitude  float32
+	Longitude float32
+	Altitude  int32
+
+	// LoRaWAN identifiers and session
+	AppEUI  types.AppEUI
+	DevEUI  types.DevEUI
+	DevAddr types.DevAddr
+	NwkSKey types.NwkSKey
+	AppSKey types.AppSKey
+	AppKey  types.AppKey
+
+	// LoRaWAN frame counters and options
+	FCntUp                uint32
+	FCntDown              uint32
+	DisableFCntCheck      bool
+	Uses32BitFCnt         bool
+	ResetsFCnt            bool
+	ActivationConstraints string
+	RxWindow              pb_lorawan.RxWindow
+	Relay                 bool
+
+	// LastSeen is the time when the last uplink message of the device was received (read-only)
+	LastSeen time.Time
+	// Revision of the registration on the Handler, used to detect concurrent changes (read-only, 0 for new devices)
+	Revision uint64
+}
+
+func deviceFromPB(in *pb.Device) *Device {
+	dev := &Device{
+		AppID:       in.AppId,
+		DevID:       in.DevId,
+		Description: in.Description,
+		Attributes:  in.Attributes,
+		Latitude:    in.Latitude,
+		Longitude:   in.Longitude,
+		Altitude:    in.Altitude,
+		Revision:    in.Revision,
+	}
+	lorawan := in.GetLorawanDevice()
+	if lorawan == nil {
+		return dev
+	}
+	if lorawan.AppEui != nil {
+		dev.AppEUI = *lorawan.AppEui
+	}
+	if lorawan.DevEui != nil {
+		dev.DevEUI = *lorawan.DevEui
+	}
+	if lorawan.DevAddr != nil {
+		dev.DevAddr = *lorawan.DevAddr
+	}
+	if lorawan.NwkSKey != nil {
+		dev.NwkSKey = *lorawan.NwkSKey
+	}
+	if lorawan.AppSKey != nil {
+		dev.AppSKey = *lorawan.AppSKey
+	}
+	if lorawan.AppKey != nil {
+		dev.AppKey = *lorawan.AppKey
+	}
+	dev.FCntUp = lorawan.FCntUp
+	dev.FCntDown = lorawan.FCntDown
+	dev.DisableFCntCheck = lorawan.DisableFCntCheck
+	dev.Uses32BitFCnt = lorawan.Uses32BitFCnt
+	dev.ResetsFCnt = lorawan.ResetsFCnt
+	dev.ActivationConstraints = lorawan.ActivationConstraints
+	dev.RxWindow = lorawan.RxWindow
+	dev.Relay = lorawan.Relay
+	if lorawan.LastSeen != 0 {
+		dev.LastSeen = time.Unix(0, lorawan.LastSeen)
+	}
+	return dev
+}
+
+func (d *Device) toPB() *pb.Device {
+	appEUI, devEUI := d.AppEUI, d.DevEUI
+	devAddr, nwkSKey, appSKey, appKey := d.DevAddr, d.NwkSKey, d.AppSKey, d.AppKey
+	return &pb.Device{
+		AppId:       d.AppID,
+		DevId:       d.DevID,
+		Description: d.Description,
+		Attributes:  d.Attributes,
+		Latitude:    d.Latitude,
+		Longitude:   d.Longitude,
+		Altitude:    d.Altitude,
+		Revision:    d.Revision,
+		Device: &pb.Device_LorawanDevice{LorawanDevice: &pb_lorawan.Device{
+			AppId:                 d.AppID,
+			DevId:                 d.DevID,
+			AppEui:                &appEUI,
+			DevEui:                &devEUI,
+			DevAddr:               &devAddr,
+			NwkSKey:               &nwkSKey,
+			AppSKey:               &appSKey,
+			AppKey:                &appKey,
+			FCntUp:                d.FCntUp,
+			FCntDown:              d.FCntDown,
+			DisableFCntCheck:      d.DisableFCntCheck,
+			Uses32BitFCnt:         d.Uses32BitFCnt,
+			ResetsFCnt:            d.ResetsFCnt,
+			ActivationConstraints: d.ActivationConstraints,
+			RxWindow:              d.RxWindow,
+			Relay:                 d.Relay,
+		}},
+	}
+}
+
+// Uplink is an uplink message of a device that is stored on the Handler
+type Uplink struct {
+	ReceivedAt time.Time
+	Port       uint8
+	Counter    uint32
+	Confirmed  bool
+	Payload    []byte
+	// Gateways that received the uplink message
+	Gateways []string
+}
+
+func uplinkFromPB(in *pb.DeviceUplink) *Uplink {
+	uplink := &Uplink{
+		ReceivedAt: time.Unix(0, in.ServerTime),
+		Port:       uint8(in.Port),
+		Counter:    in.Counter,
+		Confirmed:  in.Confirmed,
+		Payload:    in.PayloadRaw,
+	}
+	for _, gateway := range in.GatewayMetadata {
+		uplink.Gateways = append(uplink.Gateways, gateway.GatewayId)
+	}
+	return uplink
+}
+
+// DeviceChange is a change in the device registry of an application
+type DeviceChange struct {
+	// Type of the change: create, update or delete
+	Type  types.EventType
+	AppID string
+	DevID string
+	Time  time.Time
+	// Device after the change (nil if the device was deleted)
+	Device *Device
+	// ResumeToken can be passed to WatchDevices to continue watching after this change
+	ResumeToken string
+}
+
+func deviceChangeFromPB(in *pb.DeviceChange) *DeviceChange {
+	change := &DeviceChange{
+		Type:        types.EventType(in.Type),
+		AppID:       in.AppId,
+		DevID:       in.DevId,
+		Time:        time.Unix(0, in.Time),
+		ResumeToken: in.ResumeToken,
+	}
+	if in.Device != nil {
+		change.Device = deviceFromPB(in.Device)
+	}
+	return change
+}
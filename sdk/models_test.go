@@ -0,0 +1,80 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/TheThingsNetwork/ttn/api/handler"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestApplicationConversion(t *testing.T) {
+	a := New(t)
+
+	app := &Application{
+		AppID:         "test",
+		Decoder:       "function Decoder(bytes) { return {}; }",
+		RetentionDays: 7,
+		Revision:      3,
+	}
+	in := app.toPB()
+	a.So(in.UpdateMask, ShouldContain, "decoder")
+	a.So(in.UpdateMask, ShouldContain, "retention_days")
+	a.So(applicationFromPB(in), ShouldResemble, app)
+}
+
+func TestDeviceConversion(t *testing.T) {
+	a := New(t)
+
+	dev := &Device{
+		AppID:                 "test",
+		DevID:                 "test",
+		Description:           "Test device",
+		Attributes:            map[string]string{"floor": "1"},
+		Latitude:              52.37,
+		Longitude:             4.89,
+		AppEUI:                types.AppEUI{1, 2, 3, 4, 5, 6, 7, 8},
+		DevEUI:                types.DevEUI{8, 7, 6, 5, 4, 3, 2, 1},
+		DevAddr:               types.DevAddr{1, 2, 3, 4},
+		AppKey:                types.AppKey{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+		FCntUp:                42,
+		Uses32BitFCnt:         true,
+		ActivationConstraints: "local",
+		RxWindow:              pb_lorawan.RxWindow_RX2,
+		Revision:              2,
+	}
+	in := dev.toPB()
+	a.So(*in.GetLorawanDevice().AppEui, ShouldEqual, dev.AppEUI)
+	a.So(deviceFromPB(in), ShouldResemble, dev)
+
+	// Changing the device does not change the converted device
+	dev.AppEUI = types.AppEUI{}
+	a.So(*in.GetLorawanDevice().AppEui, ShouldNotEqual, dev.AppEUI)
+
+	lastSeen := time.Unix(0, time.Now().UnixNano())
+	in.GetLorawanDevice().LastSeen = lastSeen.UnixNano()
+	a.So(deviceFromPB(in).LastSeen, ShouldResemble, lastSeen)
+
+	// Without LoRaWAN device
+	a.So(deviceFromPB(&pb.Device{AppId: "test", DevId: "test"}).AppEUI.IsEmpty(), ShouldBeTrue)
+}
+
+func TestDeviceChangeConversion(t *testing.T) {
+	a := New(t)
+
+	change := deviceChangeFromPB(&pb.DeviceChange{
+		Type:        "delete",
+		AppId:       "test",
+		DevId:       "test",
+		Time:        time.Now().UnixNano(),
+		ResumeToken: "token",
+	})
+	a.So(change.Type, ShouldEqual, types.DeleteEvent)
+	a.So(change.Device, ShouldBeNil)
+	a.So(change.ResumeToken, ShouldEqual, "token")
+}
@@ -0,0 +1,21 @@
+# Copyright © 2017 The Things Network
+# Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+import os
+
+from setuptools import find_packages, setup
+
+setup(
+    name='ttn',
+    version=os.environ.get('TTN_SDK_VERSION', '0.0.0.dev0'),
+    description='Client for the management and data APIs of The Things Network Handler',
+    url='https://github.com/TheThingsNetwork/ttn',
+    license='MIT',
+    packages=find_packages(),
+    install_requires=[
+        'grpcio',
+        'protobuf',
+        'googleapis-common-protos',
+        'paho-mqtt',
+    ],
+)
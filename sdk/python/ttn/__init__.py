@@ -0,0 +1,11 @@
+# Copyright © 2017 The Things Network
+# Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+"""Client for the management and data APIs of The Things Network Handler.
+
+The gRPC messages and stubs in ttn.api are generated from the protobuf
+definitions with `make sdk-python`.
+"""
+
+from ttn.client import HandlerClient
+from ttn.mqtt import MQTTClient
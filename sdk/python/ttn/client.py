@@ -0,0 +1,51 @@
+# Copyright © 2017 The Things Network
+# Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+"""Client for the management API (gRPC) of the Handler"""
+
+import getpass
+import socket
+
+import grpc
+
+from ttn.api.handler import handler_pb2_grpc
+from ttn.api.protocol.lorawan import device_address_pb2_grpc
+
+
+class HandlerClient(object):
+    """HandlerClient connects to the management API of the Handler.
+
+    Requests are authenticated with an access key of the application, or with
+    an access token:
+
+        client = HandlerClient('eu.thethings.network:1904', access_key='ttn-account-v2.someAccessKey')
+        app = client.application_manager.GetApplication(
+            handler_pb2.ApplicationIdentifier(app_id='my-app-id'),
+            metadata=client.metadata())
+    """
+
+    def __init__(self, address, access_key=None, token=None, cert=None, insecure=False, client_name=None):
+        if not access_key and not token:
+            raise ValueError('HandlerClient needs an access_key or token')
+        self.access_key = access_key
+        self.token = token
+        self.client_name = client_name or 'client-%s@%s' % (getpass.getuser(), socket.gethostname())
+        if insecure:
+            self.channel = grpc.insecure_channel(address)
+        else:
+            self.channel = grpc.secure_channel(address, grpc.ssl_channel_credentials(root_certificates=cert))
+        self.application_manager = handler_pb2_grpc.ApplicationManagerStub(self.channel)
+        self.dev_addr_manager = device_address_pb2_grpc.DevAddrManagerStub(self.channel)
+
+    def metadata(self):
+        """Returns the metadata that authenticates a request"""
+        metadata = [('id', self.client_name)]
+        if self.access_key:
+            metadata.append(('key', self.access_key))
+        else:
+            metadata.append(('token', self.token))
+        return metadata
+
+    def close(self):
+        """Closes the connection to the Handler"""
+        self.channel.close()
@@ -0,0 +1,144 @@
+# Copyright © 2017 The Things Network
+# Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+"""Client for the data API (MQTT) of the Handler.
+
+The topics are the same as in the Go client (github.com/TheThingsNetwork/ttn/mqtt):
+
+    <AppID>/devices/<DevID>/up[/<field>]   uplink messages (or a single field)
+    <AppID>/devices/<DevID>/down           downlink messages
+    <AppID>/devices/<DevID>/events/<type>  device events, such as activations
+    <AppID>/events/<type>                  application events
+"""
+
+import json
+
+import paho.mqtt.client as paho
+
+SIMPLE_WILDCARD = '+'
+WILDCARD = '#'
+
+DEVICE_UPLINK = 'up'
+DEVICE_DOWNLINK = 'down'
+DEVICE_EVENTS = 'events'
+APP_EVENTS = 'events'
+
+
+def device_topic(app_id=None, dev_id=None, topic_type=DEVICE_UPLINK, field=None):
+    """Returns the topic of a device. An empty app_id or dev_id matches all."""
+    if topic_type == DEVICE_EVENTS and not field:
+        field = SIMPLE_WILDCARD
+    topic = '%s/devices/%s/%s' % (app_id or SIMPLE_WILDCARD, dev_id or SIMPLE_WILDCARD, topic_type)
+    if topic_type in (DEVICE_UPLINK, DEVICE_EVENTS) and field:
+        topic += '/' + field
+    return topic
+
+
+def app_topic(app_id=None, topic_type=APP_EVENTS, field=None):
+    """Returns the topic of an application. An empty app_id matches all."""
+    if topic_type == APP_EVENTS and not field:
+        field = WILDCARD
+    topic = '%s/%s' % (app_id or SIMPLE_WILDCARD, topic_type)
+    if topic_type == APP_EVENTS and field:
+        topic += '/' + field
+    return topic
+
+
+def parse_device_topic(topic):
+    """Returns the app_id, dev_id, topic type and field of a device topic"""
+    parts = topic.split('/', 4)
+    if len(parts) < 4 or parts[1] != 'devices':
+        raise ValueError('Invalid topic format')
+    field = parts[4] if len(parts) > 4 else None
+    return parts[0], parts[2], parts[3], field
+
+
+class MQTTClient(object):
+    """MQTTClient connects to the MQTT broker of the Handler with an access key of the application.
+
+    Handlers are called with the app_id, the dev_id and the decoded JSON message.
+    """
+
+    def __init__(self, app_id, access_key, host, port=1883, ca_certs=None, client_id=''):
+        self.app_id = app_id
+        self.client = paho.Client(client_id=client_id)
+        self.client.username_pw_set(app_id, access_key)
+        if ca_certs:
+            self.client.tls_set(ca_certs=ca_certs)
+        self.host = host
+        self.port = port
+        self.handlers = {}
+        self.client.on_connect = self._on_connect
+        self.client.on_message = self._on_message
+
+    def connect(self):
+        """Connects to the broker and starts handling messages in the background"""
+        self.client.connect(self.host, self.port)
+        self.client.loop_start()
+
+    def disconnect(self):
+        """Disconnects from the broker"""
+        self.client.loop_stop()
+        self.client.disconnect()
+
+    def _on_connect(self, client, userdata, flags, rc):
+        # Subscriptions are not kept by the broker after reconnecting
+        for topic in self.handlers:
+            self.client.subscribe(topic)
+
+    def _on_message(self, client, userdata, message):
+        for topic, handler in self.handlers.items():
+            if paho.topic_matches_sub(topic, message.topic):
+                if message.topic.split('/')[1] == 'devices':
+                    app_id, dev_id, _, _ = parse_device_topic(message.topic)
+                else:
+                    app_id, dev_id = message.topic.split('/')[0], None
+                payload = json.loads(message.payload.decode('utf-8')) if message.payload else None
+                handler(app_id, dev_id, payload)
+
+    def subscribe(self, topic, handler):
+        """Subscribes to a topic"""
+        self.handlers[topic] = handler
+        return self.client.subscribe(topic)
+
+    def unsubscribe(self, topic):
+        """Unsubscribes from a topic"""
+        self.handlers.pop(topic, None)
+        return self.client.unsubscribe(topic)
+
+    def subscribe_uplink(self, handler, dev_id=None, field=None):
+        """Subscribes to uplink messages of a device, or all devices if no dev_id is given"""
+        return self.subscribe(device_topic(self.app_id, dev_id, DEVICE_UPLINK, field), handler)
+
+    def subscribe_device_events(self, handler, dev_id=None, event=None):
+        """Subscribes to events of a device, or all devices if no dev_id is given"""
+        return self.subscribe(device_topic(self.app_id, dev_id, DEVICE_EVENTS, event), handler)
+
+    def subscribe_activations(self, handler, dev_id=None):
+        """Subscribes to activations of a device, or all devices if no dev_id is given"""
+        return self.subscribe_device_events(handler, dev_id, 'activations')
+
+    def subscribe_app_events(self, handler, event=None):
+        """Subscribes to events of the application"""
+        return self.subscribe(app_topic(self.app_id, APP_EVENTS, event), handler)
+
+    def publish_downlink(self, dev_id, port, payload_raw=None, payload_fields=None, confirmed=False,
+                         schedule=None, idempotency_key=None):
+        """Publishes a downlink message for a device.
+
+        payload_raw is the Base64 encoded payload, payload_fields are encoded by
+        the Encoder payload function of the application.
+        """
+        message = {'port': port}
+        if payload_raw is not None:
+            message['payload_raw'] = payload_raw
+        if payload_fields is not None:
+            message['payload_fields'] = payload_fields
+        if confirmed:
+            message['confirmed'] = True
+        if schedule:
+            message['schedule'] = schedule
+        if idempotency_key:
+            message['idempotency_key'] = idempotency_key
+        topic = device_topic(self.app_id, dev_id, DEVICE_DOWNLINK)
+        return self.client.publish(topic, json.dumps(message))
@@ -0,0 +1,62 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"io/ioutil"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/mqtt"
+	. "github.com/smartystreets/assertions"
+)
+
+// topicTypes returns the topic type constants (such as DEVICE_UPLINK = 'up') that are defined in the source file
+func topicTypes(t *testing.T, file string) map[string]string {
+	source, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	constants := make(map[string]string)
+	for _, match := range regexp.MustCompile(`(?m)^(?:const )?((?:DEVICE|APP)_[A-Z]+) = '([a-z]+)'$`).FindAllStringSubmatch(string(source), -1) {
+		constants[match[1]] = match[2]
+	}
+	return constants
+}
+
+func TestTopicTypes(t *testing.T) {
+	a := New(t)
+	expected := map[string]string{
+		"DEVICE_UPLINK":   string(mqtt.DeviceUplink),
+		"DEVICE_DOWNLINK": string(mqtt.DeviceDownlink),
+		"DEVICE_EVENTS":   string(mqtt.DeviceEvents),
+		"APP_EVENTS":      string(mqtt.AppEvents),
+	}
+	a.So(topicTypes(t, "js/mqtt.js"), ShouldResemble, expected)
+	a.So(topicTypes(t, "python/ttn/mqtt.py"), ShouldResemble, expected)
+}
+
+func TestDownlinkFields(t *testing.T) {
+	a := New(t)
+
+	fields := make(map[string]bool)
+	messageType := reflect.TypeOf(types.DownlinkMessage{})
+	for i := 0; i < messageType.NumField(); i++ {
+		name := strings.Split(messageType.Field(i).Tag.Get("json"), ",")[0]
+		fields[name] = true
+	}
+
+	source, err := ioutil.ReadFile("python/ttn/mqtt.py")
+	if err != nil {
+		t.Fatal(err)
+	}
+	matches := regexp.MustCompile(`message(?:\['| = \{')([a-z_]+)'`).FindAllStringSubmatch(string(source), -1)
+	a.So(matches, ShouldNotBeEmpty)
+	for _, match := range matches {
+		a.So(fields, ShouldContainKey, match[1])
+	}
+}
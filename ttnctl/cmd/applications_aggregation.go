@@ -0,0 +1,84 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var applicationsAggregationCmd = &cobra.Command{
+	Use:   "aggregation",
+	Short: "Show or set uplink aggregation",
+	Long: `ttnctl applications aggregation shows, enables or disables uplink aggregation.
+
+If aggregation is enabled, the Handler does not publish every uplink message.
+Instead, it publishes one uplink message per device per window, that contains
+the average of each numeric field in the decoded payload. The aggregated
+message is published when the first uplink message of the next window arrives.`,
+	Example: `$ ttnctl applications aggregation --window 15m
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Enabled aggregation                      AppID=test Window=15m0s
+
+$ ttnctl applications aggregation
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Aggregation is enabled                   AppID=test Window=15m0s
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 0, 0)
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		app, err := manager.GetApplication(appID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not get application.")
+		}
+
+		if disable, _ := cmd.Flags().GetBool("disable"); disable {
+			app.Aggregation = nil
+			if err := manager.SetApplication(app); err != nil {
+				ctx.WithError(err).Fatal("Could not update application")
+			}
+			ctx.WithField("AppID", appID).Info("Disabled aggregation")
+			return
+		}
+
+		if !cmd.Flags().Changed("window") {
+			if app.Aggregation == nil {
+				ctx.WithField("AppID", appID).Info("Aggregation is disabled")
+				return
+			}
+			window := time.Duration(app.Aggregation.Window) * time.Second
+			ctx.WithField("AppID", appID).WithField("Window", window).Info("Aggregation is enabled")
+			return
+		}
+
+		window, _ := cmd.Flags().GetDuration("window")
+		aggregation := &handler.Aggregation{Window: uint32(window / time.Second)}
+		if err := aggregation.Validate(); err != nil {
+			ctx.WithError(err).Fatal("Invalid aggregation settings")
+		}
+
+		app.Aggregation = aggregation
+		if err := manager.SetApplication(app); err != nil {
+			ctx.WithError(err).Fatal("Could not update application")
+		}
+
+		ctx.WithField("AppID", appID).WithField("Window", window).Info("Enabled aggregation")
+	},
+}
+
+func init() {
+	applicationsCmd.AddCommand(applicationsAggregationCmd)
+	applicationsAggregationCmd.Flags().Duration("window", 0, "Length of the aggregation window (for example 15m)")
+	applicationsAggregationCmd.Flags().Bool("disable", false, "Disable aggregation")
+}
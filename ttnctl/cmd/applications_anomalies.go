@@ -0,0 +1,82 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var applicationsAnomaliesCmd = &cobra.Command{
+	Use:   "anomalies",
+	Short: "Show or set anomaly detection",
+	Long: `ttnctl applications anomalies shows, enables or disables anomaly detection.
+
+The Handler keeps a moving average and standard deviation of each numeric field
+in the decoded payload of each device. If a value differs more than the given
+sensitivity (in standard deviations) from the moving average, the Handler
+publishes an anomalies event for the device.`,
+	Example: `$ ttnctl applications anomalies --enable --sensitivity 4
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Enabled anomaly detection                AppID=test Sensitivity=4
+
+$ ttnctl applications anomalies
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Anomaly detection is enabled             AppID=test Sensitivity=4
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 0, 0)
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		app, err := manager.GetApplication(appID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not get application.")
+		}
+
+		if disable, _ := cmd.Flags().GetBool("disable"); disable {
+			app.AnomalyDetection = nil
+			if err := manager.SetApplication(app); err != nil {
+				ctx.WithError(err).Fatal("Could not update application")
+			}
+			ctx.WithField("AppID", appID).Info("Disabled anomaly detection")
+			return
+		}
+
+		if enable, _ := cmd.Flags().GetBool("enable"); !enable {
+			if app.AnomalyDetection == nil {
+				ctx.WithField("AppID", appID).Info("Anomaly detection is disabled")
+				return
+			}
+			ctx.WithField("AppID", appID).WithField("Sensitivity", app.AnomalyDetection.Sensitivity).Info("Anomaly detection is enabled")
+			return
+		}
+
+		anomalyDetection := new(handler.AnomalyDetection)
+		anomalyDetection.Sensitivity, _ = cmd.Flags().GetFloat32("sensitivity")
+		if err := anomalyDetection.Validate(); err != nil {
+			ctx.WithError(err).Fatal("Invalid anomaly detection settings")
+		}
+
+		app.AnomalyDetection = anomalyDetection
+		if err := manager.SetApplication(app); err != nil {
+			ctx.WithError(err).Fatal("Could not update application")
+		}
+
+		ctx.WithField("AppID", appID).WithField("Sensitivity", anomalyDetection.Sensitivity).Info("Enabled anomaly detection")
+	},
+}
+
+func init() {
+	applicationsCmd.AddCommand(applicationsAnomaliesCmd)
+	applicationsAnomaliesCmd.Flags().Bool("enable", false, "Enable anomaly detection")
+	applicationsAnomaliesCmd.Flags().Bool("disable", false, "Disable anomaly detection")
+	applicationsAnomaliesCmd.Flags().Float32("sensitivity", 3, "Number of standard deviations from the moving average for a value to be an anomaly")
+}
@@ -0,0 +1,69 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/gosuri/uitable"
+	"github.com/spf13/cobra"
+)
+
+var applicationsComplianceCmd = &cobra.Command{
+	Use:   "compliance",
+	Short: "Summarize the devices of an application by LoRaWAN version",
+	Long: `ttnctl applications compliance summarizes the devices of an application by
+the LoRaWAN version and Regional Parameters revision that they implement, and by
+the frequency plan of their last uplink message. This helps to plan the
+transition of devices to newer LoRaWAN versions.`,
+	Example: `$ ttnctl applications compliance
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Found 3 devices                          AppID=test
+
+ 	LoRaWAN	Regional	Frequency Plan	Devices	Certified
+1	1.0.2  	1.0.2-b 	EU_863_870    	2      	1
+2	unknown	unknown 	unknown       	1      	0
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 0, 0)
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		report, err := manager.GetComplianceReport(appID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not get compliance report")
+		}
+
+		ctx.WithField("AppID", appID).Infof("Found %d devices", report.Devices)
+		if len(report.Groups) == 0 {
+			return
+		}
+
+		orUnknown := func(value string) string {
+			if value == "" {
+				return "unknown"
+			}
+			return value
+		}
+
+		table := uitable.New()
+		table.AddRow("", "LoRaWAN", "Regional", "Frequency Plan", "Devices", "Certified")
+		for i, group := range report.Groups {
+			table.AddRow(i+1, orUnknown(group.LorawanVersion), orUnknown(group.RegionalParameters), orUnknown(group.FrequencyPlan), group.Devices, group.Certified)
+		}
+
+		fmt.Println()
+		fmt.Println(table)
+		fmt.Println()
+	},
+}
+
+func init() {
+	applicationsCmd.AddCommand(applicationsComplianceCmd)
+}
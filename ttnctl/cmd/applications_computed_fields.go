@@ -0,0 +1,99 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var applicationsComputedFieldsCmd = &cobra.Command{
+	Use:   "computed-fields [Name] [Expression]",
+	Short: "Show, set or remove computed fields",
+	Long: `ttnctl applications computed-fields shows, sets or removes the computed fields
+of the application.
+
+Computed fields are JavaScript expressions that are evaluated after the
+converter. The payload fields are available as variables, and the value of the
+computed field in the previous uplink message is available as previous (null for
+the first uplink message).`,
+	Example: `$ ttnctl applications computed-fields flow "(previous || 0) + pulses * 0.5"
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Set computed field                       AppID=test Name=flow
+
+$ ttnctl applications computed-fields
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Found computed field                     AppID=test Expression=(previous || 0) + pulses * 0.5 Name=flow
+
+$ ttnctl applications computed-fields flow --remove
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Removed computed field                   AppID=test Name=flow
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 0, 2)
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		app, err := manager.GetApplication(appID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not get application.")
+		}
+
+		if len(args) == 0 {
+			if len(app.ComputedFields) == 0 {
+				ctx.WithField("AppID", appID).Info("No computed fields")
+			}
+			for _, field := range app.ComputedFields {
+				ctx.WithField("AppID", appID).WithField("Name", field.Name).WithField("Expression", field.Expression).Info("Found computed field")
+			}
+			return
+		}
+
+		name := args[0]
+		fields := make([]*handler.ComputedField, 0, len(app.ComputedFields)+1)
+		for _, field := range app.ComputedFields {
+			if field.Name != name {
+				fields = append(fields, field)
+			}
+		}
+
+		if remove, _ := cmd.Flags().GetBool("remove"); remove {
+			if len(fields) == len(app.ComputedFields) {
+				ctx.WithField("Name", name).Fatal("Computed field not found")
+			}
+			app.ComputedFields = fields
+			if err := manager.SetApplication(app); err != nil {
+				ctx.WithError(err).Fatal("Could not update application")
+			}
+			ctx.WithField("AppID", appID).WithField("Name", name).Info("Removed computed field")
+			return
+		}
+
+		assertArgsLength(cmd, args, 2, 2)
+
+		field := &handler.ComputedField{Name: name, Expression: args[1]}
+		if err := field.Validate(); err != nil {
+			ctx.WithError(err).Fatal("Invalid computed field")
+		}
+
+		app.ComputedFields = append(fields, field)
+		if err := manager.SetApplication(app); err != nil {
+			ctx.WithError(err).Fatal("Could not update application")
+		}
+
+		ctx.WithField("AppID", appID).WithField("Name", name).Info("Set computed field")
+	},
+}
+
+func init() {
+	applicationsCmd.AddCommand(applicationsComputedFieldsCmd)
+	applicationsComputedFieldsCmd.Flags().Bool("remove", false, "Remove the computed field")
+}
@@ -0,0 +1,64 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var applicationsDataResidencyCmd = &cobra.Command{
+	Use:   "data-residency [region]",
+	Short: "Show or set the data residency of the application",
+	Long: `ttnctl applications data-residency shows or sets the region that the data of
+the application must stay in. Handlers that are deployed in another region
+refuse the application and drop its traffic. Use --clear to allow all regions.`,
+	Example: `$ ttnctl applications data-residency eu
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Updated data residency                   AppID=test DataResidency=eu
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 0, 1)
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		app, err := manager.GetApplication(appID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not get application.")
+		}
+
+		clear, _ := cmd.Flags().GetBool("clear")
+		if len(args) == 0 && !clear {
+			dataResidency := app.DataResidency
+			if dataResidency == "" {
+				dataResidency = "any region"
+			}
+			ctx.WithField("AppID", appID).WithField("DataResidency", dataResidency).Info("Data residency")
+			return
+		}
+
+		app.DataResidency = ""
+		if !clear {
+			if !api.ValidID(args[0]) {
+				ctx.Fatal("Invalid region")
+			}
+			app.DataResidency = args[0]
+		}
+		if err := manager.SetApplication(app); err != nil {
+			ctx.WithError(err).Fatal("Could not update application")
+		}
+
+		ctx.WithField("AppID", appID).WithField("DataResidency", app.DataResidency).Info("Updated data residency")
+	},
+}
+
+func init() {
+	applicationsCmd.AddCommand(applicationsDataResidencyCmd)
+	applicationsDataResidencyCmd.Flags().Bool("clear", false, "Remove the data residency")
+}
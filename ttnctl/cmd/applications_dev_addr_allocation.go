@@ -0,0 +1,65 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var applicationsDevAddrAllocationCmd = &cobra.Command{
+	Use:   "dev-addr-allocation [random|sequential|sticky]",
+	Short: "Show or set the DevAddr allocation strategy of the application",
+	Long: `ttnctl applications dev-addr-allocation shows or sets the strategy that is used
+to allocate a DevAddr when devices of the application join.
+
+In the random strategy, devices get a random address within a prefix.
+In the sequential strategy, devices get the next address within a prefix.
+In the sticky strategy, devices keep their previous address when they join again.
+
+Addresses that are already in use by other devices are avoided. The strategy
+applies to devices after they are updated, and an empty strategy selects the
+default of the Handler.`,
+	Example: `$ ttnctl applications dev-addr-allocation sticky
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Updated DevAddr allocation strategy      AppID=test Strategy=sticky
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 0, 1)
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		app, err := manager.GetApplication(appID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not get application.")
+		}
+
+		if len(args) == 0 {
+			strategy := app.DevAddrAllocation
+			if strategy == "" {
+				strategy = "default of the Handler"
+			}
+			ctx.WithField("AppID", appID).WithField("Strategy", strategy).Info("DevAddr allocation strategy")
+			return
+		}
+
+		app.DevAddrAllocation = args[0]
+		if err := app.Validate(); err != nil {
+			ctx.WithError(err).Fatal("Invalid DevAddr allocation strategy")
+		}
+		if err := manager.SetApplication(app); err != nil {
+			ctx.WithError(err).Fatal("Could not update application")
+		}
+
+		ctx.WithField("AppID", appID).WithField("Strategy", app.DevAddrAllocation).Info("Updated DevAddr allocation strategy")
+	},
+}
+
+func init() {
+	applicationsCmd.AddCommand(applicationsDevAddrAllocationCmd)
+}
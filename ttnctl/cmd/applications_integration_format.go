@@ -0,0 +1,62 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var applicationsIntegrationFormatCmd = &cobra.Command{
+	Use:   "integration-format [json|raw]",
+	Short: "Show or set the format of uplink messages for integrations",
+	Long: `ttnctl applications integration-format shows or sets the format of uplink
+messages that are delivered to integrations (AMQP).
+
+In the json format (default), the message body is the same JSON object as on MQTT.
+In the raw format, the message body is the binary payload with content type
+application/octet-stream, and the other fields are sent as headers.`,
+	Example: `$ ttnctl applications integration-format raw
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Updated integration format               AppID=test Format=raw
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 0, 1)
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		app, err := manager.GetApplication(appID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not get application.")
+		}
+
+		if len(args) == 0 {
+			format := app.IntegrationFormat
+			if format == "" {
+				format = handler.IntegrationFormatJSON
+			}
+			ctx.WithField("AppID", appID).WithField("Format", format).Info("Integration format")
+			return
+		}
+
+		app.IntegrationFormat = args[0]
+		if err := app.Validate(); err != nil {
+			ctx.WithError(err).Fatal("Invalid integration format")
+		}
+		if err := manager.SetApplication(app); err != nil {
+			ctx.WithError(err).Fatal("Could not update application")
+		}
+
+		ctx.WithField("AppID", appID).WithField("Format", app.IntegrationFormat).Info("Updated integration format")
+	},
+}
+
+func init() {
+	applicationsCmd.AddCommand(applicationsIntegrationFormatCmd)
+}
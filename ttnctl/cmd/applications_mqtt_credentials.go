@@ -0,0 +1,73 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/gosuri/uitable"
+	"github.com/spf13/cobra"
+)
+
+var applicationsMQTTCredentialsCmd = &cobra.Command{
+	Use:   "mqtt-credentials",
+	Short: "Generate MQTT credentials for the collaborators of the application",
+	Long: `ttnctl applications mqtt-credentials generates MQTT credentials for each
+collaborator of the application. Collaborators with the messages:up:r right can
+subscribe to uplink messages and events, collaborators with the messages:down:w
+right can publish downlink messages.
+
+Credentials of collaborators that were removed are deleted, and the topics of
+existing credentials are updated to the current rights. The Handler repeats
+this periodically, so that removed collaborators lose their access. Passwords
+are only shown when the credentials are created.`,
+	Example: `$ ttnctl applications mqtt-credentials
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Synchronized MQTT credentials            AppID=test Credentials=1
+
+ 	Username  	Collaborator	Password              	Read                                        	Write
+1	test.alice	alice       	U2VjcmV0UGFzc3dvcmQ...	test/devices/+/up, test/devices/+/up/#, ...	test/devices/+/down
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 0, 0)
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		credentials, err := manager.SyncMQTTCredentials(appID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not synchronize MQTT credentials")
+		}
+
+		ctx.WithField("AppID", appID).WithField("Credentials", len(credentials)).Info("Synchronized MQTT credentials")
+
+		if len(credentials) == 0 {
+			return
+		}
+
+		table := uitable.New()
+		table.MaxColWidth = 70
+		table.AddRow("", "Username", "Collaborator", "Password", "Read", "Write")
+		for i, c := range credentials {
+			password := c.Password
+			if password == "" {
+				password = "(unchanged)"
+			}
+			table.AddRow(i+1, c.Username, c.Collaborator, password, strings.Join(c.Read, ", "), strings.Join(c.Write, ", "))
+		}
+
+		fmt.Println()
+		fmt.Println(table)
+		fmt.Println()
+	},
+}
+
+func init() {
+	applicationsCmd.AddCommand(applicationsMQTTCredentialsCmd)
+}
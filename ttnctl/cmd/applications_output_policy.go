@@ -0,0 +1,148 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var applicationsOutputPolicyCmd = &cobra.Command{
+	Use:   "output-policy",
+	Short: "Show or set the output policy",
+	Long: `ttnctl applications output-policy shows or sets the output policy.
+
+The output policy controls how the Handler represents numeric fields in the
+decoded payload of uplink messages. Floating point values can be rounded to a
+number of decimals, and NaN and infinite values (that are not valid JSON) can
+be published as null, as a string or be omitted.
+
+The output policy also controls the names of payload fields. They can be
+converted to snake_case or camelCase, and characters other than letters, digits
+and underscores can be replaced. With --protect-reserved, payload fields with
+the name of a field of the uplink message (such as dev_id or metadata) are
+prefixed with payload_. Renamed fields are reported in up/fields events.`,
+	Example: `$ ttnctl applications output-policy --decimals 2 --special-values omit
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Updated output policy                    AppID=test Decimals=2 Round=true RoundingMode=half-up SpecialValues=omit
+
+$ ttnctl applications output-policy --field-casing snake --replacement _ --protect-reserved
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Updated output policy                    AppID=test Decimals=2 FieldCasing=snake ProtectReserved=true Replacement=_ Round=true RoundingMode=half-up SpecialValues=omit
+
+$ ttnctl applications output-policy
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Output policy                            AppID=test Decimals=2 FieldCasing=snake ProtectReserved=true Replacement=_ Round=true RoundingMode=half-up SpecialValues=omit
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 0, 0)
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		app, err := manager.GetApplication(appID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not get application.")
+		}
+
+		if clear, _ := cmd.Flags().GetBool("clear"); clear {
+			app.OutputPolicy = nil
+			if err := manager.SetApplication(app); err != nil {
+				ctx.WithError(err).Fatal("Could not update application")
+			}
+			ctx.WithField("AppID", appID).Info("Cleared output policy")
+			return
+		}
+
+		outputPolicy := app.OutputPolicy
+		if outputPolicy == nil {
+			outputPolicy = new(handler.OutputPolicy)
+		}
+
+		var changed bool
+		if cmd.Flags().Changed("decimals") {
+			decimals, _ := cmd.Flags().GetUint32("decimals")
+			outputPolicy.Round, outputPolicy.Decimals = true, decimals
+			changed = true
+		}
+		if cmd.Flags().Changed("no-rounding") {
+			outputPolicy.Round, outputPolicy.Decimals = false, 0
+			changed = true
+		}
+		if cmd.Flags().Changed("rounding-mode") {
+			outputPolicy.RoundingMode, _ = cmd.Flags().GetString("rounding-mode")
+			changed = true
+		}
+		if cmd.Flags().Changed("special-values") {
+			outputPolicy.SpecialValues, _ = cmd.Flags().GetString("special-values")
+			changed = true
+		}
+		if cmd.Flags().Changed("field-casing") {
+			outputPolicy.FieldCasing, _ = cmd.Flags().GetString("field-casing")
+			changed = true
+		}
+		if cmd.Flags().Changed("replacement") {
+			outputPolicy.Replacement, _ = cmd.Flags().GetString("replacement")
+			changed = true
+		}
+		if cmd.Flags().Changed("protect-reserved") {
+			outputPolicy.ProtectReserved, _ = cmd.Flags().GetBool("protect-reserved")
+			changed = true
+		}
+
+		if !changed {
+			if app.OutputPolicy == nil {
+				ctx.WithField("AppID", appID).Info("No output policy")
+				return
+			}
+			ctx.WithField("AppID", appID).
+				WithField("Round", outputPolicy.Round).
+				WithField("Decimals", outputPolicy.Decimals).
+				WithField("RoundingMode", outputPolicy.RoundingMode).
+				WithField("SpecialValues", outputPolicy.SpecialValues).
+				WithField("FieldCasing", outputPolicy.FieldCasing).
+				WithField("Replacement", outputPolicy.Replacement).
+				WithField("ProtectReserved", outputPolicy.ProtectReserved).
+				Info("Output policy")
+			return
+		}
+
+		if err := outputPolicy.Validate(); err != nil {
+			ctx.WithError(err).Fatal("Invalid output policy")
+		}
+
+		app.OutputPolicy = outputPolicy
+		if err := manager.SetApplication(app); err != nil {
+			ctx.WithError(err).Fatal("Could not update application")
+		}
+
+		ctx.WithField("AppID", appID).
+			WithField("Round", outputPolicy.Round).
+			WithField("Decimals", outputPolicy.Decimals).
+			WithField("RoundingMode", outputPolicy.RoundingMode).
+			WithField("SpecialValues", outputPolicy.SpecialValues).
+			WithField("FieldCasing", outputPolicy.FieldCasing).
+			WithField("Replacement", outputPolicy.Replacement).
+			WithField("ProtectReserved", outputPolicy.ProtectReserved).
+			Info("Updated output policy")
+	},
+}
+
+func init() {
+	applicationsCmd.AddCommand(applicationsOutputPolicyCmd)
+	applicationsOutputPolicyCmd.Flags().Uint32("decimals", 0, "Round numeric payload fields to this number of decimals")
+	applicationsOutputPolicyCmd.Flags().Bool("no-rounding", false, "Do not round numeric payload fields")
+	applicationsOutputPolicyCmd.Flags().String("rounding-mode", "", "Rounding mode (half-up, half-even or truncate)")
+	applicationsOutputPolicyCmd.Flags().String("special-values", "", "Representation of NaN and infinite values (null, string or omit)")
+	applicationsOutputPolicyCmd.Flags().String("field-casing", "", "Casing of the names of payload fields (keep, snake or camel)")
+	applicationsOutputPolicyCmd.Flags().String("replacement", "", "Replace invalid characters in the names of payload fields with this string")
+	applicationsOutputPolicyCmd.Flags().Bool("protect-reserved", false, "Prefix payload fields with the name of a field of the uplink message with payload_")
+	applicationsOutputPolicyCmd.Flags().Bool("clear", false, "Remove the output policy")
+}
@@ -0,0 +1,166 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var applicationsPayloadFormatCmd = &cobra.Command{
+	Use:   "payload-format [custom|cayennelpp|wasm|binary|protobuf|cbor|go]",
+	Short: "Show or set the payload format of the application",
+	Long: `ttnctl applications payload-format shows or sets the format of the payload
+of uplink and downlink messages.
+
+In the custom format (default), the payload is decoded and encoded with the
+payload functions of the application. In the cayennelpp format, the payload is
+decoded and encoded as Cayenne Low Power Payload by the Handler. The fields are
+named after the data type and the channel, for example temperature_1 or gps_2.
+The converter and validator functions are still applied to uplink messages.
+
+In the wasm format, the payload is decoded and encoded with a compiled
+WebAssembly module that is uploaded with --module. The module exports its
+memory, an alloc(size) function that allocates memory for the input, and
+decode(ptr, len, port) and encode(ptr, len, port) functions that return the
+pointer to their output in the upper 32 bits and its length in the lower 32
+bits. The decode function gets the payload and returns the fields as JSON, the
+encode function gets the fields as JSON and returns the payload.
+
+In the binary format, the Handler decodes and encodes fields at fixed positions
+in the payload, without payload functions. The layout is uploaded with --fields
+as a JSON array of fields with a name, offset, type (uint8, int8, uint16, int16,
+uint24, int24, uint32, int32, float32, float64 or bool), little_endian and scale,
+for example:
+
+  [
+    {"name": "temperature", "offset": 0, "type": "int16", "scale": 0.01},
+    {"name": "battery", "offset": 2, "type": "uint8"}
+  ]
+
+In the protobuf format, the Handler decodes and encodes the payload as a
+protobuf message. The FileDescriptorSet that contains the message is uploaded
+with --descriptor (for example generated with protoc --include_imports
+--descriptor_set_out) and the full name of the message is set with --message.
+The fields are named and represented as in the JSON mapping of protobuf.
+
+In the cbor format, the Handler decodes and encodes the payload as CBOR. The
+payload contains a map of fields, or an array of maps that is decoded to
+buffered readings, such as a SenML pack. The integer labels of SenML records
+are decoded to their names (for example 2 to v).
+
+In the go format, the payload is decoded and encoded by a compiled Go codec of
+the Handler, that is selected with --go-codec. Codecs are built into the
+Handler or loaded from Go plugins by the Handler operator.`,
+	Example: `$ ttnctl applications payload-format cayennelpp
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Updated payload format                   AppID=test Format=cayennelpp
+
+$ ttnctl applications payload-format wasm --module codec.wasm
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Updated payload format                   AppID=test Format=wasm
+
+$ ttnctl applications payload-format binary --fields layout.json
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Updated payload format                   AppID=test Format=binary
+
+$ ttnctl applications payload-format protobuf --descriptor sensors.pb --message sensors.Reading
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Updated payload format                   AppID=test Format=protobuf
+
+$ ttnctl applications payload-format go --go-codec my-sensor
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Updated payload format                   AppID=test Format=go
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 0, 1)
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		app, err := manager.GetApplication(appID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not get application.")
+		}
+
+		if len(args) == 0 {
+			format := app.PayloadFormat
+			if format == "" {
+				format = handler.PayloadFormatCustom
+			}
+			ctx.WithField("AppID", appID).WithField("Format", format).Info("Payload format")
+			return
+		}
+
+		app.PayloadFormat = args[0]
+		if module, _ := cmd.Flags().GetString("module"); module != "" {
+			app.WasmModule, err = ioutil.ReadFile(module)
+			if err != nil {
+				ctx.WithError(err).Fatal("Could not read WebAssembly module")
+			}
+		}
+		if app.PayloadFormat == handler.PayloadFormatWASM && len(app.WasmModule) == 0 {
+			ctx.Fatal("The wasm format needs a WebAssembly module (use --module)")
+		}
+		if fields, _ := cmd.Flags().GetString("fields"); fields != "" {
+			data, err := ioutil.ReadFile(fields)
+			if err != nil {
+				ctx.WithError(err).Fatal("Could not read binary fields")
+			}
+			app.BinaryFields = nil
+			if err := json.Unmarshal(data, &app.BinaryFields); err != nil {
+				ctx.WithError(err).Fatal("Could not parse binary fields")
+			}
+		}
+		if app.PayloadFormat == handler.PayloadFormatBinary && len(app.BinaryFields) == 0 {
+			ctx.Fatal("The binary format needs binary fields (use --fields)")
+		}
+		if descriptor, _ := cmd.Flags().GetString("descriptor"); descriptor != "" {
+			app.ProtobufDescriptor, err = ioutil.ReadFile(descriptor)
+			if err != nil {
+				ctx.WithError(err).Fatal("Could not read protobuf descriptor")
+			}
+		}
+		if message, _ := cmd.Flags().GetString("message"); message != "" {
+			app.ProtobufMessage = message
+		}
+		if app.PayloadFormat == handler.PayloadFormatProtobuf && (len(app.ProtobufDescriptor) == 0 || app.ProtobufMessage == "") {
+			ctx.Fatal("The protobuf format needs a protobuf descriptor and message (use --descriptor and --message)")
+		}
+		if goCodec, _ := cmd.Flags().GetString("go-codec"); goCodec != "" {
+			app.GoCodec = goCodec
+		}
+		if app.PayloadFormat == handler.PayloadFormatGo && app.GoCodec == "" {
+			ctx.Fatal("The go format needs a Go codec (use --go-codec)")
+		}
+		if err := app.Validate(); err != nil {
+			ctx.WithError(err).Fatal("Invalid payload format")
+		}
+		if err := manager.SetApplication(app); err != nil {
+			ctx.WithError(err).Fatal("Could not update application")
+		}
+
+		ctx.WithField("AppID", appID).WithField("Format", app.PayloadFormat).Info("Updated payload format")
+	},
+}
+
+func init() {
+	applicationsCmd.AddCommand(applicationsPayloadFormatCmd)
+	applicationsPayloadFormatCmd.Flags().String("module", "", "Compiled WebAssembly module for the wasm format")
+	applicationsPayloadFormatCmd.Flags().String("fields", "", "JSON file with the binary fields for the binary format")
+	applicationsPayloadFormatCmd.Flags().String("descriptor", "", "FileDescriptorSet file with the message for the protobuf format")
+	applicationsPayloadFormatCmd.Flags().String("message", "", "Full name of the message for the protobuf format")
+	applicationsPayloadFormatCmd.Flags().String("go-codec", "", "Name of the Go codec of the Handler for the go format")
+}
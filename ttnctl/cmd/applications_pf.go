@@ -30,6 +30,7 @@ function Decoder(bytes, port) {
   INFO No converter function
   INFO No validator function
   INFO No encoder function
+  INFO No join hook
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 		assertArgsLength(cmd, args, 0, 0)
@@ -73,6 +74,41 @@ function Decoder(bytes, port) {
 		} else {
 			ctx.Info("No encoder function")
 		}
+
+		if app.DownlinkDecoder != "" {
+			ctx.Info("Downlink decoder function")
+			fmt.Println(app.DownlinkDecoder)
+		}
+
+		if app.Normalizer != "" {
+			ctx.Info("Normalizer function")
+			fmt.Println(app.Normalizer)
+		}
+
+		if app.JoinHook != "" {
+			ctx.Info("Join hook")
+			fmt.Println(app.JoinHook)
+		} else {
+			ctx.Info("No join hook")
+		}
+
+		for _, functions := range app.PortFunctions {
+			fctx := ctx.WithField("MinPort", functions.MinPort)
+			if functions.MaxPort != 0 {
+				fctx = fctx.WithField("MaxPort", functions.MaxPort)
+			}
+			for _, function := range []struct{ name, code string }{
+				{"Decoder", functions.Decoder},
+				{"Converter", functions.Converter},
+				{"Validator", functions.Validator},
+				{"Encoder", functions.Encoder},
+			} {
+				if function.code != "" {
+					fctx.Infof("%s function for ports", function.name)
+					fmt.Println(function.code)
+				}
+			}
+		}
 	},
 }
 
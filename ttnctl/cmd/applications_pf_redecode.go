@@ -0,0 +1,101 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+// redecodeUplink is an uplink message in the input file of the redecode command
+type redecodeUplink struct {
+	Port    uint32    `json:"port"`
+	Payload string    `json:"payload"`
+	Time    time.Time `json:"time"`
+}
+
+var applicationsPayloadFunctionsRedecodeCmd = &cobra.Command{
+	Use:   "redecode [File]",
+	Short: "Decode historical uplink payloads with the current payload functions",
+	Long: `ttnctl applications pf redecode runs the current decoder, converter and validator
+of the application on the uplink messages in the file, and prints the decoded fields
+of each message as a line of JSON. The file contains a JSON array of messages with
+the port, the hex-encoded payload and the time of the message. The results are not
+stored or published.`,
+	Example: `$ cat uplinks.json
+[{"port": 1, "payload": "0870", "time": "2017-06-01T12:00:00Z"}]
+$ ttnctl applications pf redecode uplinks.json
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+{"port":1,"time":"2017-06-01T12:00:00Z","fields":{"temperature":21.6},"valid":true}
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		appID := util.GetAppID(ctx)
+		devID, _ := cmd.Flags().GetString("dev-id")
+
+		content, err := ioutil.ReadFile(args[0])
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not read file")
+		}
+		var in []redecodeUplink
+		if err := json.Unmarshal(content, &in); err != nil {
+			ctx.WithError(err).Fatal("Invalid file")
+		}
+
+		uplinks := make([]*handler.RawUplink, 0, len(in))
+		for _, uplink := range in {
+			payload, err := types.ParseHEX(uplink.Payload, len(uplink.Payload)/2)
+			if err != nil {
+				ctx.WithError(err).Fatalf("Invalid payload %s", uplink.Payload)
+			}
+			raw := &handler.RawUplink{Port: uplink.Port, Payload: payload}
+			if !uplink.Time.IsZero() {
+				raw.Time = uplink.Time.UnixNano()
+			}
+			uplinks = append(uplinks, raw)
+		}
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		for start := 0; start < len(uplinks); start += handler.MaxRedecodeUplinks {
+			end := start + handler.MaxRedecodeUplinks
+			if end > len(uplinks) {
+				end = len(uplinks)
+			}
+			redecoded, err := manager.RedecodeUplinks(appID, devID, uplinks[start:end])
+			if err != nil {
+				ctx.WithError(err).Fatal("Could not redecode uplinks")
+			}
+			for _, uplink := range redecoded {
+				out := map[string]interface{}{"port": uplink.Port, "valid": uplink.Valid}
+				if uplink.Time != 0 {
+					out["time"] = time.Unix(0, uplink.Time).UTC()
+				}
+				if uplink.Fields != "" {
+					out["fields"] = json.RawMessage(uplink.Fields)
+				}
+				if uplink.Error != "" {
+					out["error"] = uplink.Error
+				}
+				line, _ := json.Marshal(out)
+				fmt.Println(string(line))
+			}
+		}
+	},
+}
+
+func init() {
+	applicationsPayloadFunctionsCmd.AddCommand(applicationsPayloadFunctionsRedecodeCmd)
+	applicationsPayloadFunctionsRedecodeCmd.Flags().String("dev-id", "", "Use the payload format of this device")
+}
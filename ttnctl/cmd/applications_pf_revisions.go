@@ -0,0 +1,116 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/gosuri/uitable"
+	"github.com/spf13/cobra"
+)
+
+var applicationsPayloadFunctionsRevisionsCmd = &cobra.Command{
+	Use:   "revisions",
+	Short: "List the stored revisions of the payload functions",
+	Long: `ttnctl applications pf revisions lists the stored revisions of the payload
+functions, newest first. The active revision is marked with a *.`,
+	Example: `$ ttnctl applications pf revisions
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Found 2 revisions                        Active=3 AppID=test
+
+ 	Revision	Time                     	Version
+*	3       	2017-10-19T13:49:43+02:00	
+ 	1       	2017-10-18T09:12:05+02:00	vendor-1.0
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 0, 0)
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		list, err := manager.ListPayloadFunctionsRevisions(appID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not get payload functions revisions")
+		}
+
+		ctx.WithFields(log.Fields{
+			"AppID":  appID,
+			"Active": list.ActiveRevision,
+		}).Infof("Found %d revisions", len(list.Revisions))
+		if len(list.Revisions) == 0 {
+			return
+		}
+
+		table := uitable.New()
+		table.AddRow("", "Revision", "Time", "Version")
+		for _, revision := range list.Revisions {
+			var active string
+			if revision.Revision == list.ActiveRevision {
+				active = "*"
+			}
+			table.AddRow(active, revision.Revision, time.Unix(0, revision.Time).Format(time.RFC3339), revision.PayloadFunctionsVersion)
+		}
+
+		fmt.Println()
+		fmt.Println(table)
+		fmt.Println()
+	},
+}
+
+var applicationsPayloadFunctionsRollbackCmd = &cobra.Command{
+	Use:   "rollback [Revision]",
+	Short: "Restore the payload functions of a stored revision",
+	Long: `ttnctl applications pf rollback restores the payload functions of a stored
+revision. The restored payload functions are stored as a new revision, so that
+the rollback can be undone as well.`,
+	Example: `$ ttnctl applications pf rollback 1
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Rolled back payload functions            AppID=test Changed=Decoder Revision=1
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		revision, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			ctx.WithError(err).Fatal("Invalid revision")
+		}
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		res, err := manager.RollbackPayloadFunctions(appID, revision, dryRun)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not roll back payload functions")
+		}
+
+		ctx := ctx.WithFields(log.Fields{
+			"AppID":    appID,
+			"Revision": revision,
+			"Changed":  strings.Join(res.Changed, ", "),
+		})
+		if dryRun {
+			ctx.Info("Payload functions can be rolled back (dry run, nothing was changed)")
+			return
+		}
+		ctx.Info("Rolled back payload functions")
+	},
+}
+
+func init() {
+	applicationsPayloadFunctionsCmd.AddCommand(applicationsPayloadFunctionsRevisionsCmd)
+	applicationsPayloadFunctionsCmd.AddCommand(applicationsPayloadFunctionsRollbackCmd)
+	applicationsPayloadFunctionsRollbackCmd.Flags().Bool("dry-run", false, "validate the stored revision and show the changed fields, without updating the application")
+}
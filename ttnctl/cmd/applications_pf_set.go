@@ -16,10 +16,31 @@ import (
 )
 
 var applicationsPayloadFunctionsSetCmd = &cobra.Command{
-	Use:   "set [decoder/converter/validator/encoder] [file.js]",
+	Use:   "set [decoder/converter/validator/encoder/downlink_decoder/normalizer/join_hook] [file.js]",
 	Short: "Set payload functions of an application",
 	Long: `ttnctl pf set can be used to get or set payload functions of an application.
-The functions are read from the supplied file or from STDIN.`,
+The functions are read from the supplied file or from STDIN.
+
+With --ports, the decoder, converter, validator or encoder is set only for
+messages on a port or range of ports (for example 10 or 10-20). Messages on
+other ports are handled by the default functions of the application.
+
+The downlink_decoder decodes the payload of downlink messages that are
+scheduled as bytes, so that the downlink events contain the decoded fields.
+
+The normalizer maps the payload fields to the temperature (degrees Celsius),
+humidity (percent), battery (percent) and location (latitude, longitude and
+altitude) of the normalized_payload of uplink messages.
+
+With --language lua, the decoder, converter, validator and encoder of the
+application are Lua functions with the same names and arguments. The bytes
+of the payload start at index 1. The downlink_decoder, normalizer and
+join_hook are always JavaScript.
+
+The functions can use the following helpers to read the bytes of a payload:
+int8, uint16BE, uint16LE, int16BE, int16LE, uint32BE, uint32LE, int32BE,
+int32LE (bytes, offset), bytesToFloat32(bytes, offset, littleEndian),
+bcd(bytes, offset, length), toHex(bytes) and fromHex(hex).`,
 	Example: `$ ttnctl applications pf set decoder
   INFO Discovering Handler...
   INFO Connecting with Handler...
@@ -72,6 +93,7 @@ Port: 1
 
 		function := args[0]
 
+		var code string
 		if len(args) == 2 {
 			content, err := ioutil.ReadFile(args[1])
 			if err != nil {
@@ -83,18 +105,7 @@ Function read from %s:
 %s
 `, args[1], string(content)))
 
-			switch function {
-			case "decoder":
-				app.Decoder = string(content)
-			case "converter":
-				app.Converter = string(content)
-			case "validator":
-				app.Validator = string(content)
-			case "encoder":
-				app.Encoder = string(content)
-			default:
-				ctx.Fatalf("Function %s does not exist", function)
-			}
+			code = string(content)
 		} else {
 			switch function {
 			case "decoder":
@@ -110,7 +121,7 @@ Function read from %s:
   return decoded;
 }
 ########## Write your Decoder here and end with Ctrl+D (EOF):`)
-				app.Decoder = readFunction(ctx)
+				code = readFunction(ctx)
 			case "converter":
 				fmt.Println(`function Converter(decoded, port) {
   // Merge, split or otherwise
@@ -124,7 +135,7 @@ Function read from %s:
   return converted;
 }
 ########## Write your Converter here and end with Ctrl+D (EOF):`)
-				app.Converter = readFunction(ctx)
+				code = readFunction(ctx)
 			case "validator":
 				fmt.Println(`function Validator(converted, port) {
   // Return false if the decoded, converted
@@ -137,7 +148,7 @@ Function read from %s:
   return true;
 }
 ########## Write your Validator here and end with Ctrl+D (EOF):`)
-				app.Validator = readFunction(ctx)
+				code = readFunction(ctx)
 			case "encoder":
 				fmt.Println(`function Encoder(object, port) {
   // Encode downlink messages sent as
@@ -148,16 +159,70 @@ Function read from %s:
   //   bytes[0] = object.led ? 1 : 0;
   // }
 
+  // To also choose the port and confirmation:
+  // return { bytes: bytes, fPort: 2, confirmed: true };
+
   return bytes;
 }
 ########## Write your Encoder here and end with Ctrl+D (EOF):`)
-				app.Encoder = readFunction(ctx)
+				code = readFunction(ctx)
+			case "downlink_decoder":
+				fmt.Println(`function DownlinkDecoder(bytes, port) {
+  // Decode a scheduled downlink message from
+  // a buffer (array) of bytes to an object of
+  // fields for the downlink events.
+  var decoded = {};
+
+  // if (port === 1) {
+  //   decoded.led = bytes[0] === 1;
+  // }
+
+  return decoded;
+}
+########## Write your DownlinkDecoder here and end with Ctrl+D (EOF):`)
+				code = readFunction(ctx)
+			case "normalizer":
+				fmt.Println(`function Normalizer(fields, port, metadata) {
+  // Map the payload fields to the normalized
+  // payload. Leave out the measurements that
+  // the device does not report.
+  var normalized = {};
+
+  // normalized.temperature = fields.temperature;
+  // normalized.battery = fields.battery_percent;
+  // normalized.location = { latitude: fields.lat, longitude: fields.lon };
+
+  return normalized;
+}
+########## Write your Normalizer here and end with Ctrl+D (EOF):`)
+				code = readFunction(ctx)
+			case "join_hook":
+				fmt.Println(`function JoinHook(device, metadata) {
+  // Set attributes of the device and/or
+  // queue a downlink message when it joins.
+  var result = {};
+
+  // result.attributes = { joined_at: metadata.time };
+  // result.downlink = { port: 1, payload: [0x01] };
+
+  return result;
+}
+########## Write your JoinHook here and end with Ctrl+D (EOF):`)
+				code = readFunction(ctx)
 			default:
 				ctx.Fatalf("Function %s does not exist", function)
 			}
 		}
 
-		if skipTest, _ := cmd.Flags().GetBool("skip-test"); !skipTest {
+		ports, _ := cmd.Flags().GetString("ports")
+		if err := setPayloadFunction(app, function, ports, code); err != nil {
+			ctx.WithError(err).Fatal("Could not set the payload function")
+		}
+		if language, _ := cmd.Flags().GetString("language"); language != "" {
+			app.FunctionsLanguage = language
+		}
+
+		if skipTest, _ := cmd.Flags().GetBool("skip-test"); !skipTest && function != "join_hook" && function != "downlink_decoder" && function != "normalizer" {
 			fmt.Printf("\nDo you want to test the payload functions? (Y/n)\n")
 			var response string
 			fmt.Scanln(&response)
@@ -206,6 +271,18 @@ Function read from %s:
 			}
 		}
 
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+			res, err := manager.DryRunSetApplication(app)
+			if err != nil {
+				ctx.WithError(err).Fatal("Invalid payload function")
+			}
+			ctx.WithFields(log.Fields{
+				"AppID":   appID,
+				"Changed": strings.Join(res.Changed, ", "),
+			}).Info("Payload function is valid (dry run, nothing was changed)")
+			return
+		}
+
 		err = manager.SetApplication(app)
 		if err != nil {
 			ctx.WithError(err).Fatal("Could not update application")
@@ -217,8 +294,68 @@ Function read from %s:
 	},
 }
 
+// setPayloadFunction sets the function of the application, or of the PortFunctions for the ports if they are not empty
+func setPayloadFunction(app *handler.Application, function, ports, code string) error {
+	if ports == "" {
+		switch function {
+		case "decoder":
+			app.Decoder = code
+		case "converter":
+			app.Converter = code
+		case "validator":
+			app.Validator = code
+		case "encoder":
+			app.Encoder = code
+		case "downlink_decoder":
+			app.DownlinkDecoder = code
+		case "normalizer":
+			app.Normalizer = code
+		case "join_hook":
+			app.JoinHook = code
+		default:
+			return fmt.Errorf("Function %s does not exist", function)
+		}
+		return nil
+	}
+
+	var min, max uint32
+	if _, err := fmt.Sscanf(ports, "%d-%d", &min, &max); err != nil {
+		if _, err := fmt.Sscanf(ports, "%d", &min); err != nil {
+			return fmt.Errorf("Invalid ports %s", ports)
+		}
+	}
+
+	var functions *handler.PortFunctions
+	for _, existing := range app.PortFunctions {
+		if existing.MinPort == min && existing.MaxPort == max {
+			functions = existing
+		}
+	}
+	if functions == nil {
+		functions = &handler.PortFunctions{MinPort: min, MaxPort: max}
+		app.PortFunctions = append(app.PortFunctions, functions)
+	}
+
+	switch function {
+	case "decoder":
+		functions.Decoder = code
+	case "converter":
+		functions.Converter = code
+	case "validator":
+		functions.Validator = code
+	case "encoder":
+		functions.Encoder = code
+	default:
+		return fmt.Errorf("Function %s can not be set per port", function)
+	}
+	return nil
+}
+
 func init() {
 	applicationsPayloadFunctionsSetCmd.Flags().Bool("skip-test", false, "skip payload function test")
+	applicationsPayloadFunctionsSetCmd.Flags().String("ports", "", "set the function for a port or range of ports (for example 10 or 10-20)")
+	applicationsPayloadFunctionsSetCmd.Flags().String("language", "", "set the language of the decoder, converter, validator and encoder (javascript or lua)")
+	applicationsPayloadFunctionsSetCmd.Flags().Bool("dry-run", false, "validate the payload function and show the changed fields, without updating the application")
 	applicationsPayloadFunctionsCmd.AddCommand(applicationsPayloadFunctionsSetCmd)
 }
 
@@ -0,0 +1,68 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var applicationsPayloadFunctionsTestUplinkCmd = &cobra.Command{
+	Use:   "test-uplink [Payload]",
+	Short: "Test the payload functions of an application with an uplink payload",
+	Long: `ttnctl applications pf test-uplink runs the stored decoder, converter and validator
+of the application on the payload, and shows the resulting fields and logs. The result
+is not published.`,
+	Example: `$ ttnctl applications pf test-uplink 0870 --port 1
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Tested payload functions                 AppID=test Valid=true
+{"temperature":21.6}
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		appID := util.GetAppID(ctx)
+
+		port, err := cmd.Flags().GetUint32("port")
+		if err != nil {
+			ctx.WithError(err).Fatal("Failed to read port flag")
+		}
+
+		payload, err := types.ParseHEX(args[0], len(args[0])/2)
+		if err != nil {
+			ctx.WithError(err).Fatal("Invalid Payload")
+		}
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		res, err := manager.TestUplink(appID, payload, port)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not test payload functions")
+		}
+
+		for _, log := range res.Logs {
+			ctx.WithField("Function", log.Function).Info(strings.Join(log.Fields, " "))
+		}
+
+		if res.Error != "" {
+			ctx.WithField("AppID", appID).Fatalf("Payload functions failed: %s", res.Error)
+		}
+
+		ctx.WithField("AppID", appID).WithField("Valid", res.Valid).Info("Tested payload functions")
+		if res.Fields != "" {
+			fmt.Println(res.Fields)
+		}
+	},
+}
+
+func init() {
+	applicationsPayloadFunctionsCmd.AddCommand(applicationsPayloadFunctionsTestUplinkCmd)
+	applicationsPayloadFunctionsTestUplinkCmd.Flags().Uint32("port", 1, "Port number")
+}
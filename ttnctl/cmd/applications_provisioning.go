@@ -0,0 +1,114 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var applicationsProvisioningCmd = &cobra.Command{
+	Use:   "provisioning",
+	Short: "Show or set the provisioning downlink",
+	Long: `ttnctl applications provisioning shows or sets the downlink message that the
+Handler sends to devices after their first uplink message after joining.
+
+String values in the fields can refer to attributes of the device as {{.key}}.`,
+	Example: `$ ttnctl applications provisioning --port 2 --fields '{"interval":"{{.interval}}"}'
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Updated provisioning downlink            AppID=test
+
+$ ttnctl applications provisioning
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Found provisioning downlink              AppID=test Confirmed=false Fields={"interval":"{{.interval}}"} Port=2
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 0, 0)
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		app, err := manager.GetApplication(appID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not get application.")
+		}
+
+		if clear, _ := cmd.Flags().GetBool("clear"); clear {
+			app.ProvisioningDownlink = nil
+			if err := manager.SetApplication(app); err != nil {
+				ctx.WithError(err).Fatal("Could not update application")
+			}
+			ctx.WithField("AppID", appID).Info("Cleared provisioning downlink")
+			return
+		}
+
+		if !cmd.Flags().Changed("payload") && !cmd.Flags().Changed("fields") {
+			if app.ProvisioningDownlink == nil {
+				ctx.WithField("AppID", appID).Info("No provisioning downlink")
+				return
+			}
+			provisioningCtx := ctx.WithFields(log.Fields{
+				"AppID":     appID,
+				"Port":      app.ProvisioningDownlink.Port,
+				"Confirmed": app.ProvisioningDownlink.Confirmed,
+			})
+			if app.ProvisioningDownlink.Fields != "" {
+				provisioningCtx = provisioningCtx.WithField("Fields", app.ProvisioningDownlink.Fields)
+			} else {
+				provisioningCtx = provisioningCtx.WithField("Payload", fmt.Sprintf("%X", app.ProvisioningDownlink.Payload))
+			}
+			provisioningCtx.Info("Found provisioning downlink")
+			return
+		}
+
+		provisioning := new(handler.ProvisioningDownlink)
+		provisioning.Port, _ = cmd.Flags().GetUint32("port")
+		provisioning.Confirmed, _ = cmd.Flags().GetBool("confirmed")
+
+		if in, _ := cmd.Flags().GetString("payload"); in != "" {
+			provisioning.Payload, err = types.ParseHEX(in, len(in)/2)
+			if err != nil {
+				ctx.WithError(err).Fatal("Invalid payload")
+			}
+		}
+
+		if in, _ := cmd.Flags().GetString("fields"); in != "" {
+			var fields map[string]interface{}
+			if err := json.Unmarshal([]byte(in), &fields); err != nil {
+				ctx.WithError(err).Fatal("Invalid fields")
+			}
+			provisioning.Fields = in
+		}
+
+		if err := provisioning.Validate(); err != nil {
+			ctx.WithError(err).Fatal("Invalid provisioning downlink")
+		}
+
+		app.ProvisioningDownlink = provisioning
+		if err := manager.SetApplication(app); err != nil {
+			ctx.WithError(err).Fatal("Could not update application")
+		}
+
+		ctx.WithField("AppID", appID).Info("Updated provisioning downlink")
+	},
+}
+
+func init() {
+	applicationsCmd.AddCommand(applicationsProvisioningCmd)
+	applicationsProvisioningCmd.Flags().Uint32("port", 1, "Port number of the downlink")
+	applicationsProvisioningCmd.Flags().Bool("confirmed", false, "Send as confirmed downlink")
+	applicationsProvisioningCmd.Flags().String("payload", "", "Hex-encoded payload of the downlink")
+	applicationsProvisioningCmd.Flags().String("fields", "", "JSON-encoded fields of the downlink")
+	applicationsProvisioningCmd.Flags().Bool("clear", false, "Remove the provisioning downlink")
+}
@@ -0,0 +1,44 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var applicationsRestoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Restore this application on the handler",
+	Long: `ttnctl applications restore restores an application that was unregistered from
+the Handler from its recycle bin, together with the devices that were deleted
+with it. Webhooks and MQTT credentials are not restored.`,
+	Example: `$ ttnctl applications restore
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Restored application                     AppID=test
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 0, 0)
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		err := manager.RestoreApplication(appID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not restore application")
+		}
+
+		ctx.WithFields(ttnlog.Fields{
+			"AppID": appID,
+		}).Info("Restored application")
+	},
+}
+
+func init() {
+	applicationsCmd.AddCommand(applicationsRestoreCmd)
+}
@@ -0,0 +1,63 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var applicationsRetentionCmd = &cobra.Command{
+	Use:   "retention [days]",
+	Short: "Show or set the retention of stored uplink messages",
+	Long: `ttnctl applications retention shows or sets the number of days that the
+Handler keeps the uplink messages of devices. Older uplink messages are deleted.
+Set the retention to 0 to keep uplink messages until the uplink history of the
+device is full.`,
+	Example: `$ ttnctl applications retention 30
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Updated retention                        AppID=test Days=30
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 0, 1)
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		app, err := manager.GetApplication(appID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not get application.")
+		}
+
+		if len(args) == 0 {
+			if app.RetentionDays == 0 {
+				ctx.WithField("AppID", appID).Info("No retention configured")
+				return
+			}
+			ctx.WithField("AppID", appID).WithField("Days", app.RetentionDays).Info("Retention")
+			return
+		}
+
+		days, err := strconv.ParseUint(args[0], 10, 32)
+		if err != nil {
+			ctx.WithError(err).Fatal("Invalid number of days")
+		}
+
+		app.RetentionDays = uint32(days)
+		if err := manager.SetApplication(app); err != nil {
+			ctx.WithError(err).Fatal("Could not update application")
+		}
+
+		ctx.WithField("AppID", appID).WithField("Days", app.RetentionDays).Info("Updated retention")
+	},
+}
+
+func init() {
+	applicationsCmd.AddCommand(applicationsRetentionCmd)
+}
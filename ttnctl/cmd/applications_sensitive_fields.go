@@ -0,0 +1,57 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var applicationsSensitiveFieldsCmd = &cobra.Command{
+	Use:   "sensitive-fields [field ...]",
+	Short: "Show or set the sensitive payload fields of the application",
+	Long: `ttnctl applications sensitive-fields shows or sets the payload fields that
+contain personal data. The Handler redacts these fields from logs and events,
+but still delivers them to integrations. Nested fields are separated by a dot
+(for example location.lat).`,
+	Example: `$ ttnctl applications sensitive-fields name location
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Updated sensitive fields                 AppID=test Fields=[name location]
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		app, err := manager.GetApplication(appID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not get application.")
+		}
+
+		clear, _ := cmd.Flags().GetBool("clear")
+
+		if len(args) == 0 && !clear {
+			if len(app.SensitiveFields) == 0 {
+				ctx.WithField("AppID", appID).Info("No sensitive fields configured")
+				return
+			}
+			ctx.WithField("AppID", appID).WithField("Fields", app.SensitiveFields).Info("Sensitive fields")
+			return
+		}
+
+		app.SensitiveFields = args
+		if err := manager.SetApplication(app); err != nil {
+			ctx.WithError(err).Fatal("Could not update application")
+		}
+
+		ctx.WithField("AppID", appID).WithField("Fields", app.SensitiveFields).Info("Updated sensitive fields")
+	},
+}
+
+func init() {
+	applicationsCmd.AddCommand(applicationsSensitiveFieldsCmd)
+	applicationsSensitiveFieldsCmd.Flags().Bool("clear", false, "Clear the sensitive fields")
+}
@@ -0,0 +1,69 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var applicationsStatefulDecodingCmd = &cobra.Command{
+	Use:   "stateful-decoding [on|off]",
+	Short: "Show or set stateful decoding of the application",
+	Long: `ttnctl applications stateful-decoding shows or sets stateful decoding of the
+application.
+
+With stateful decoding, the Decoder receives the decoded fields and the frame counter
+of the previous uplink message of the device in metadata.previous. This allows the
+Decoder to reconstruct absolute values from delta-encoded payloads:
+
+  function Decoder(bytes, port, metadata) {
+    var total = bytes[0];
+    if (metadata.previous) total += metadata.previous.fields.total;
+    return { total: total };
+  }
+
+A retransmission with the same frame counter receives the same previous fields.`,
+	Example: `$ ttnctl applications stateful-decoding on
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Updated stateful decoding                AppID=test StatefulDecoding=true
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 0, 1)
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		app, err := manager.GetApplication(appID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not get application.")
+		}
+
+		if len(args) == 0 {
+			ctx.WithField("AppID", appID).WithField("StatefulDecoding", app.StatefulDecoding).Info("Stateful decoding")
+			return
+		}
+
+		switch args[0] {
+		case "on":
+			app.StatefulDecoding = true
+		case "off":
+			app.StatefulDecoding = false
+		default:
+			ctx.Fatalf("Invalid argument %s, expected on or off", args[0])
+		}
+		if err := manager.SetApplication(app); err != nil {
+			ctx.WithError(err).Fatal("Could not update application")
+		}
+
+		ctx.WithField("AppID", appID).WithField("StatefulDecoding", app.StatefulDecoding).Info("Updated stateful decoding")
+	},
+}
+
+func init() {
+	applicationsCmd.AddCommand(applicationsStatefulDecodingCmd)
+}
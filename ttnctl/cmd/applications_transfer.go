@@ -0,0 +1,92 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var applicationsTransferCmd = &cobra.Command{
+	Use:   "transfer [Handler ID]",
+	Short: "Transfer the application to another Handler",
+	Long: `ttnctl applications transfer moves the application with its devices, sessions and
+downlink queues to another Handler, without downtime and without rejoins of devices.
+
+The application is first imported on the other Handler, which announces it, so that
+the Broker routes the traffic of the application to both Handlers. After --wait, the
+current Handler stops processing the traffic (the cutover) and the other Handler is
+activated with the final state. It processes the messages that arrived after the
+cutover. Finally, the application is removed from the current Handler.
+
+After the transfer, MQTT clients of the application should connect to the other
+Handler, and the handler-id of ttnctl should be changed to the other Handler.`,
+	Example: `$ ttnctl applications transfer ttn-handler-eu-2
+  INFO Discovering Handler...                   Handler=ttn-handler-eu
+  INFO Connecting with Handler...
+  INFO Discovering Handler...                   Handler=ttn-handler-eu-2
+  INFO Connecting with Handler...
+  INFO Imported application                     AppID=test Handler=ttn-handler-eu-2 NumDevices=2
+  INFO Waiting for Brokers to route traffic to both Handlers... Wait=1m0s
+  INFO Activated application                    AppID=test Handler=ttn-handler-eu-2
+  INFO Transferred application                  AppID=test Handler=ttn-handler-eu-2
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		appID := util.GetAppID(ctx)
+		targetID := args[0]
+		if targetID == viper.GetString("handler-id") {
+			ctx.Fatal("The application is already on this Handler")
+		}
+		wait, _ := cmd.Flags().GetDuration("wait")
+
+		sourceConn, source := util.GetHandlerManager(ctx, appID)
+		defer sourceConn.Close()
+
+		targetConn, target := util.GetHandlerManagerByID(ctx, appID, targetID)
+		defer targetConn.Close()
+
+		ctx = ctx.WithFields(ttnlog.Fields{
+			"AppID":   appID,
+			"Handler": targetID,
+		})
+
+		transfer, err := source.ExportApplication(appID, false)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not export application")
+		}
+		if err := target.ImportApplication(transfer); err != nil {
+			ctx.WithError(err).Fatal("Could not import application")
+		}
+		ctx.WithField("NumDevices", len(transfer.Devices)).Info("Imported application")
+
+		ctx.WithField("Wait", wait).Info("Waiting for Brokers to route traffic to both Handlers...")
+		time.Sleep(wait)
+
+		transfer, err = source.ExportApplication(appID, true)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not export application for the cutover")
+		}
+		transfer.Activate = true
+		if err := target.ImportApplication(transfer); err != nil {
+			ctx.WithError(err).Fatal("Could not activate application, the traffic of the application is not processed until the import is retried")
+		}
+		ctx.Info("Activated application")
+
+		if err := source.FinishApplicationTransfer(appID); err != nil {
+			ctx.WithError(err).Fatal("Could not remove application from the previous Handler")
+		}
+		ctx.Info("Transferred application")
+	},
+}
+
+func init() {
+	applicationsCmd.AddCommand(applicationsTransferCmd)
+	applicationsTransferCmd.Flags().Duration("wait", time.Minute, "Time between the import and the cutover")
+}
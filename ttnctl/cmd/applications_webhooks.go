@@ -0,0 +1,118 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"strings"
+
+	"github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var applicationsWebhooksCmd = &cobra.Command{
+	Use:   "webhooks [WebhookID] [URL]",
+	Short: "Show, set or remove webhooks",
+	Long: `ttnctl applications webhooks shows, sets or removes the webhooks of the
+application.
+
+A webhook POSTs the uplink messages, activations and downlink events of the
+application as JSON to a URL. The type of the message is in the X-TTN-Message-Type
+header. By default, all types of messages are POSTed.
+
+Header values can reference a secret of the Handler as secret:<name>, so that
+credentials are not stored in the database of the Handler. Header values that
+are not references to secrets are shown as <redacted>.`,
+	Example: `$ ttnctl applications webhooks my-webhook https://example.com/ttn --header "Authorization=key secret" --message-types uplink
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Set webhook                              AppID=test WebhookID=my-webhook
+
+$ ttnctl applications webhooks
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Found webhook                            AppID=test MessageTypes=[uplink] URL=https://example.com/ttn WebhookID=my-webhook
+
+$ ttnctl applications webhooks my-webhook --remove
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Removed webhook                          AppID=test WebhookID=my-webhook
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 0, 2)
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		if len(args) == 0 {
+			webhooks, err := manager.GetWebhooks(appID)
+			if err != nil {
+				ctx.WithError(err).Fatal("Could not get webhooks")
+			}
+			if len(webhooks) == 0 {
+				ctx.WithField("AppID", appID).Info("No webhooks")
+			}
+			for _, webhook := range webhooks {
+				ctx.WithField("AppID", appID).
+					WithField("WebhookID", webhook.WebhookId).
+					WithField("URL", webhook.Url).
+					WithField("MessageTypes", webhook.MessageTypes).
+					Info("Found webhook")
+			}
+			return
+		}
+
+		webhookID := args[0]
+
+		if remove, _ := cmd.Flags().GetBool("remove"); remove {
+			if err := manager.DeleteWebhook(appID, webhookID); err != nil {
+				ctx.WithError(err).Fatal("Could not remove webhook")
+			}
+			ctx.WithField("AppID", appID).WithField("WebhookID", webhookID).Info("Removed webhook")
+			return
+		}
+
+		assertArgsLength(cmd, args, 2, 2)
+
+		webhook := &handler.Webhook{
+			AppId:     appID,
+			WebhookId: webhookID,
+			Url:       args[1],
+		}
+
+		if in, err := cmd.Flags().GetStringSlice("header"); err == nil && len(in) > 0 {
+			webhook.Headers = make(map[string]string)
+			for _, header := range in {
+				parts := strings.SplitN(header, "=", 2)
+				if len(parts) != 2 {
+					ctx.Fatalf("Invalid header %s, use name=value", header)
+				}
+				webhook.Headers[parts[0]] = parts[1]
+			}
+		}
+
+		if in, err := cmd.Flags().GetStringSlice("message-types"); err == nil && len(in) > 0 {
+			webhook.MessageTypes = in
+		}
+
+		if err := webhook.Validate(); err != nil {
+			ctx.WithError(err).Fatal("Invalid webhook")
+		}
+
+		if err := manager.SetWebhook(webhook); err != nil {
+			ctx.WithError(err).Fatal("Could not set webhook")
+		}
+
+		ctx.WithField("AppID", appID).WithField("WebhookID", webhookID).Info("Set webhook")
+	},
+}
+
+func init() {
+	applicationsCmd.AddCommand(applicationsWebhooksCmd)
+	applicationsWebhooksCmd.Flags().StringSlice("header", []string{}, "Add a header to the requests (name=value)")
+	applicationsWebhooksCmd.Flags().StringSlice("message-types", []string{}, "Types of messages to POST (uplink, activation, downlink)")
+	applicationsWebhooksCmd.Flags().Bool("remove", false, "Remove the webhook")
+}
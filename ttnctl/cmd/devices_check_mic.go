@@ -0,0 +1,77 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var devicesCheckMICCmd = &cobra.Command{
+	Use:   "check-mic [Device ID] [Frame]",
+	Short: "Check the MIC of a raw LoRaWAN frame",
+	Long: `ttnctl devices check-mic recomputes the MIC of a raw LoRaWAN frame (in hex)
+with the keys and frame counters of the device that are stored on the network.
+If the MIC is not valid, it reports the key or frame counter that caused the
+mismatch. This helps to find out why messages of a device are dropped.`,
+	Example: `$ ttnctl devices check-mic test 40040302012001000A4655964292F2
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  WARN MIC check failed                         DevAddr=01020304 DeviceFCnt=1 ExpectedMIC=8A1EB62C FCnt=1 MessageType=UNCONFIRMED_UP Mismatch=keys_swapped ReceivedMIC=964292F2
+  WARN The MIC is valid for the AppSKey of the device. The NwkSKey and AppSKey are swapped on the device
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 2, 2)
+
+		devID := args[0]
+		if !api.ValidID(devID) {
+			ctx.Fatalf("Invalid Device ID") // TODO: Add link to wiki explaining device IDs
+		}
+
+		frame, err := types.ParseHEX(args[1], len(args[1])/2)
+		if err != nil {
+			ctx.WithError(err).Fatal("Invalid frame")
+		}
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		res, err := manager.CheckMIC(appID, devID, frame)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not check MIC")
+		}
+
+		fields := ttnlog.Fields{
+			"MessageType": res.MessageType,
+			"ReceivedMIC": res.ReceivedMic,
+			"ExpectedMIC": res.ExpectedMic,
+		}
+		if res.DevAddr != "" {
+			fields["DevAddr"] = res.DevAddr
+		}
+		if res.FCnt != 0 || res.DeviceFCnt != 0 {
+			fields["FCnt"] = res.FCnt
+			fields["DeviceFCnt"] = res.DeviceFCnt
+		}
+
+		if res.Valid {
+			ctx.WithFields(fields).Info(res.Description)
+			return
+		}
+
+		fields["Mismatch"] = res.Mismatch
+		ctx.WithFields(fields).Warn("MIC check failed")
+		ctx.Warn(res.Description)
+	},
+}
+
+func init() {
+	devicesCmd.AddCommand(devicesCheckMICCmd)
+}
@@ -0,0 +1,107 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/api/handler"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var devicesCommandCmd = &cobra.Command{
+	Use:   "command [Device ID] [Payload]",
+	Short: "Send a command to a device and wait for the response",
+	Long: `ttnctl devices command sends a downlink message to a device and waits for the
+uplink message that the device sends in response.
+
+The response is the first uplink message on the response port (if given). If
+a correlation field is given, a random correlation ID is added to the fields
+of the downlink under that name, and the response is the first uplink message
+with the same value in the decoded field.`,
+	Example: `$ ttnctl devices command test --json '{"cmd":"status"}' --correlation-field id --response-port 3
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Received response                        AppID=test CorrelationID=ZfoTyjpE3VzRsqXx Counter=42 DevID=test Port=3
+
+  Payload: 01 02 03 04
+   Fields: {"battery":3.1,"id":"ZfoTyjpE3VzRsqXx","status":"ok"}
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 2, 2)
+
+		devID := args[0]
+		if !api.ValidID(devID) {
+			ctx.Fatalf("Invalid Device ID") // TODO: Add link to wiki explaining device IDs
+		}
+
+		appID := util.GetAppID(ctx)
+
+		req := &handler.CommandRequest{
+			AppId: appID,
+			DevId: devID,
+		}
+		req.Port, _ = cmd.Flags().GetUint32("fport")
+		req.Confirmed, _ = cmd.Flags().GetBool("confirmed")
+		req.ResponsePort, _ = cmd.Flags().GetUint32("response-port")
+		req.CorrelationField, _ = cmd.Flags().GetString("correlation-field")
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+		req.Timeout = uint32(timeout.Seconds())
+
+		if jsonflag, _ := cmd.Flags().GetBool("json"); jsonflag {
+			req.Fields = args[1]
+		} else {
+			payload, err := types.ParseHEX(args[1], len(args[1])/2)
+			if err != nil {
+				ctx.WithError(err).Fatal("Invalid Payload")
+			}
+			req.Payload = payload
+		}
+
+		if err := req.Validate(); err != nil {
+			ctx.WithError(err).Fatal("Invalid command")
+		}
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		res, err := manager.SendCommand(req)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not send command to device.")
+		}
+
+		responseCtx := ctx.WithFields(ttnlog.Fields{
+			"AppID":   appID,
+			"DevID":   devID,
+			"Port":    res.Port,
+			"Counter": res.Counter,
+		})
+		if res.CorrelationId != "" {
+			responseCtx = responseCtx.WithField("CorrelationID", res.CorrelationId)
+		}
+		responseCtx.Info("Received response")
+
+		fmt.Println()
+		fmt.Printf("  Payload: % X\n", res.PayloadRaw)
+		if res.Fields != "" {
+			fmt.Printf("   Fields: %s\n", res.Fields)
+		}
+	},
+}
+
+func init() {
+	devicesCmd.AddCommand(devicesCommandCmd)
+	devicesCommandCmd.Flags().Uint32("fport", 1, "FPort of the command")
+	devicesCommandCmd.Flags().Bool("confirmed", false, "Send the command as confirmed downlink")
+	devicesCommandCmd.Flags().Bool("json", false, "Send the command as JSON fields that are encoded by the Encoder")
+	devicesCommandCmd.Flags().Uint32("response-port", 0, "FPort of the response (0 for any port)")
+	devicesCommandCmd.Flags().String("correlation-field", "", "Field that correlates the response with the command (requires --json)")
+	devicesCommandCmd.Flags().Duration("timeout", 5*time.Minute, "How long to wait for the response (at most 1h)")
+}
@@ -0,0 +1,216 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/mqtt"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/gosuri/uitable"
+	"github.com/spf13/cobra"
+)
+
+var devicesDebugCmd = &cobra.Command{
+	Use:   "debug [Device ID]",
+	Short: "Enable or disable the debug mode of a device",
+	Long: `ttnctl devices debug enables the debug mode of a device for a limited time.
+While the debug mode is enabled, the Handler captures verbose traces of the
+device: trace events (including MAC commands), scheduling decisions, logs of
+payload functions and integration deliveries. Get the captured traces with
+ttnctl devices debug-trace.`,
+	Example: `$ ttnctl devices debug test --duration 2h
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Enabled debug mode of device             AppID=test DevID=test Duration=2h0m0s
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		devID := args[0]
+		if !api.ValidID(devID) {
+			ctx.Fatalf("Invalid Device ID") // TODO: Add link to wiki explaining device IDs
+		}
+
+		appID := util.GetAppID(ctx)
+
+		duration, _ := cmd.Flags().GetDuration("duration")
+		if disable, _ := cmd.Flags().GetBool("disable"); disable {
+			duration = 0
+		}
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		err := manager.SetDeviceDebugMode(appID, devID, duration)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not set debug mode of device.")
+		}
+
+		fields := ttnlog.Fields{
+			"AppID": appID,
+			"DevID": devID,
+		}
+		if duration == 0 {
+			ctx.WithFields(fields).Info("Disabled debug mode of device")
+			return
+		}
+		ctx.WithFields(fields).WithField("Duration", duration).Info("Enabled debug mode of device")
+	},
+}
+
+var devicesDebugTraceCmd = &cobra.Command{
+	Use:   "debug-trace [Device ID]",
+	Short: "Get the debug trace of a device",
+	Long: `ttnctl devices debug-trace gets the verbose traces that were captured while
+the device was in debug mode. Use --output to save the trace as JSON file.`,
+	Example: `$ ttnctl devices debug-trace test
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+
+Time                          	Type        	Message                 	Metadata
+2017-06-22 10:14:02 +0200 CEST	integration 	Published uplink        	integration=mqtt
+2017-06-22 10:14:02 +0200 CEST	function_log	"temperature" 21.5      	function=decoder
+2017-06-22 10:14:02 +0200 CEST	trace       	handle mac command      	command=LinkCheckReq service_name=networkserver
+
+  INFO Got debug trace of device                AppID=test DevID=test DebugUntil=2017-06-22T12:00:00Z Entries=3
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		devID := args[0]
+		if !api.ValidID(devID) {
+			ctx.Fatalf("Invalid Device ID") // TODO: Add link to wiki explaining device IDs
+		}
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		trace, err := manager.GetDeviceDebugTrace(appID, devID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not get debug trace of device.")
+		}
+
+		if output, _ := cmd.Flags().GetString("output"); output != "" {
+			data, err := json.MarshalIndent(trace, "", "  ")
+			if err != nil {
+				ctx.WithError(err).Fatal("Could not marshal debug trace")
+			}
+			if err := ioutil.WriteFile(output, data, 0644); err != nil {
+				ctx.WithError(err).Fatal("Could not write debug trace")
+			}
+		} else {
+			table := uitable.New()
+			table.MaxColWidth = 70
+			table.AddRow("Time", "Type", "Message", "Metadata")
+			for _, entry := range trace.Entries {
+				var metadata []string
+				for k, v := range entry.Metadata {
+					metadata = append(metadata, fmt.Sprintf("%s=%s", k, v))
+				}
+				sort.Strings(metadata)
+				table.AddRow(time.Unix(0, entry.Time), entry.Type, entry.Message, strings.Join(metadata, " "))
+			}
+			fmt.Println()
+			fmt.Println(table)
+			fmt.Println()
+		}
+
+		debugUntil := "disabled"
+		if trace.DebugUntil != 0 {
+			debugUntil = time.Unix(0, trace.DebugUntil).UTC().Format(time.RFC3339)
+		}
+		ctx.WithFields(ttnlog.Fields{
+			"AppID":      appID,
+			"DevID":      devID,
+			"DebugUntil": debugUntil,
+			"Entries":    len(trace.Entries),
+		}).Info("Got debug trace of device")
+	},
+}
+
+var devicesDebugLogsCmd = &cobra.Command{
+	Use:   "debug-logs [Device ID]",
+	Short: "Stream the logs of payload functions of a device",
+	Long: `ttnctl devices debug-logs streams the logs of the payload functions (for
+example console.log calls in the decoder) that run for a device in debug mode.
+The logs are published by the Handler on the debug/functions events topic of
+the device. Enable the debug mode with ttnctl devices debug.`,
+	Example: `$ ttnctl devices debug-logs test
+  INFO Using Application                        AppID=test
+  INFO Connecting to MQTT...                    MQTT Broker=tcp://eu.thethings.network:1883 Username=test
+  INFO Subscribed to function logs              AppID=test DevID=test
+  INFO Function log                             DevID=test Function=Decoder Log="temperature" 21.5
+  WARN Payload function failed                  DevID=test Error=Decoder threw error: ReferenceError: x is not defined
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		devID := args[0]
+		if !api.ValidID(devID) {
+			ctx.Fatalf("Invalid Device ID") // TODO: Add link to wiki explaining device IDs
+		}
+
+		appID := util.GetAppID(ctx)
+
+		accessKey, _ := cmd.Flags().GetString("access-key")
+		client := util.GetMQTT(ctx, accessKey)
+		defer client.Disconnect()
+
+		token := client.SubscribeDeviceEvents(appID, devID, types.FunctionLogEvent, func(_ mqtt.Client, appID string, devID string, _ types.EventType, payload []byte) {
+			var data types.FunctionLogEventData
+			if err := json.Unmarshal(payload, &data); err != nil {
+				ctx.WithError(err).Warn("Could not unmarshal function logs")
+				return
+			}
+			for _, entry := range data.Logs {
+				ctx.WithFields(ttnlog.Fields{
+					"DevID":    devID,
+					"Function": entry.Function,
+					"Log":      strings.Join(entry.Fields, " "),
+				}).Info("Function log")
+			}
+			if data.Error != "" {
+				ctx.WithField("DevID", devID).WithField("Error", data.Error).Warn("Payload function failed")
+			}
+		})
+		token.Wait()
+		if err := token.Error(); err != nil {
+			ctx.WithError(err).Fatal("Could not subscribe to function logs")
+		}
+		ctx.WithFields(ttnlog.Fields{
+			"AppID": appID,
+			"DevID": devID,
+		}).Info("Subscribed to function logs")
+
+		sigChan := make(chan os.Signal)
+		signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+		ctx.WithField("signal", <-sigChan).Info("signal received")
+	},
+}
+
+func init() {
+	devicesCmd.AddCommand(devicesDebugCmd)
+	devicesDebugCmd.Flags().Duration("duration", time.Hour, "How long the debug mode stays enabled (at most 24h)")
+	devicesDebugCmd.Flags().Bool("disable", false, "Disable the debug mode")
+	devicesCmd.AddCommand(devicesDebugTraceCmd)
+	devicesDebugTraceCmd.Flags().String("output", "", "Save the debug trace as JSON to this file")
+	devicesCmd.AddCommand(devicesDebugLogsCmd)
+	devicesDebugLogsCmd.Flags().String("access-key", "", "The access key to use")
+}
@@ -0,0 +1,70 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var devicesForgetCmd = &cobra.Command{
+	Use:   "forget [Device ID]",
+	Short: "Erase the stored data of a device",
+	Long: `ttnctl devices forget erases all data of a device that is stored on the
+Handler: the uplink history, queued downlinks and the state of computed fields,
+anomaly detection and aggregation. The registration of the device is kept.
+
+The erasure report that is printed can be kept as proof of the erasure.`,
+	Example: `$ ttnctl devices forget test
+  INFO Using Application                        AppID=test
+Are you sure you want to erase all stored data of device test in application test?
+> yes
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Erased stored data of device             AppID=test DevID=test DevEUI=0001D544B2936FCE Downlinks=0 Erased=uplink_history, computed_fields ErasedAt=2017-06-01T12:00:00Z Uplinks=12
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		devID := args[0]
+		if !api.ValidID(devID) {
+			ctx.Fatalf("Invalid Device ID") // TODO: Add link to wiki explaining device IDs
+		}
+
+		appID := util.GetAppID(ctx)
+
+		if !confirm(fmt.Sprintf("Are you sure you want to erase all stored data of device %s in application %s?", devID, appID)) {
+			ctx.Info("Not doing anything")
+			return
+		}
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		report, err := manager.ForgetDevice(appID, devID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not forget device.")
+		}
+
+		ctx.WithFields(ttnlog.Fields{
+			"AppID":     report.AppId,
+			"DevID":     report.DevId,
+			"DevEUI":    report.DevEui,
+			"ErasedAt":  time.Unix(0, report.ErasedAt).UTC().Format(time.RFC3339),
+			"Uplinks":   report.Uplinks,
+			"Downlinks": report.Downlinks,
+			"Erased":    strings.Join(report.Erased, ", "),
+		}).Info("Erased stored data of device")
+	},
+}
+
+func init() {
+	devicesCmd.AddCommand(devicesForgetCmd)
+}
@@ -5,10 +5,12 @@ package cmd
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/TheThingsNetwork/ttn/api"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/ttnctl/util"
 	"github.com/spf13/cobra"
 )
@@ -70,6 +72,47 @@ var devicesInfoCmd = &cobra.Command{
 			fmt.Printf("     Description: %s\n", dev.Description)
 		}
 
+		if len(dev.Attributes) > 0 {
+			keys := make([]string, 0, len(dev.Attributes))
+			for key := range dev.Attributes {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			fmt.Println("      Attributes:")
+			for _, key := range keys {
+				fmt.Printf("                  %s=%s\n", key, dev.Attributes[key])
+			}
+		}
+
+		if profile := dev.Profile; profile != nil {
+			if profile.Vendor != "" {
+				fmt.Printf("          Vendor: %s\n", profile.Vendor)
+			}
+			if profile.LorawanVersion != "" {
+				fmt.Printf(" LoRaWAN Version: %s\n", profile.LorawanVersion)
+			}
+			if profile.CertificationId != "" {
+				fmt.Printf("   Certification: %s\n", profile.CertificationId)
+			}
+			if profile.ModelId != "" {
+				fmt.Printf("           Model: %s/%s\n", profile.BrandId, profile.ModelId)
+			}
+			if profile.FirmwareVersion != "" {
+				fmt.Printf("        Firmware: %s\n", profile.FirmwareVersion)
+			}
+		}
+
+		if dev.PayloadFormat != "" {
+			payloadFormat := dev.PayloadFormat
+			if dev.GoCodec != "" {
+				payloadFormat += " (" + dev.GoCodec + ")"
+			}
+			fmt.Printf("  Payload Format: %s\n", payloadFormat)
+		}
+		if dev.Codec != "" {
+			fmt.Printf("           Codec: %s\n", dev.Codec)
+		}
+
 		if dev.Latitude != 0 || dev.Longitude != 0 {
 			fmt.Printf("        Location: %f,%f\n", dev.Latitude, dev.Longitude)
 		}
@@ -104,7 +147,48 @@ var devicesInfoCmd = &cobra.Command{
 			} else {
 				options = append(options, "16BitFCnt")
 			}
+			if lorawan.ResetsFCnt {
+				options = append(options, "FCntResets")
+			}
+			if lorawan.RxWindow != pb_lorawan.RxWindow_RX_AUTO {
+				options = append(options, lorawan.RxWindow.String()+"Only")
+			}
+			if lorawan.Relay {
+				options = append(options, "Relay")
+			}
 			fmt.Printf("    Options: %s\n", strings.Join(options, ", "))
+			if lorawan.RegionalParameters != "" {
+				fmt.Printf("   Regional: %s\n", lorawan.RegionalParameters)
+			}
+			if dev.MaxDownlinkPayloadSize > 0 {
+				fmt.Printf(" MaxPayload: %d bytes downlink at %s\n", dev.MaxDownlinkPayloadSize, dev.DownlinkDataRate)
+			}
+			if delivery := dev.DownlinkDelivery; delivery != nil {
+				fmt.Printf("  Confirmed: %d acknowledged, %d missed (%d in a row)\n", delivery.Acked, delivery.Missed, delivery.ConsecutiveMissed)
+			}
+			if battery := lorawan.Battery; battery != nil {
+				switch battery.Battery {
+				case 0:
+					fmt.Println("    Battery: external power")
+				case 255:
+					fmt.Println("    Battery: unknown")
+				default:
+					fmt.Printf("    Battery: %d%%", (battery.Battery-1)*100/253)
+					if battery.EstimatedLifetime > 0 {
+						fmt.Printf(", estimated %.0f days left", (time.Duration(battery.EstimatedLifetime)*time.Second).Hours()/24)
+					}
+					fmt.Println()
+				}
+			}
+
+			if lorawan.DisableFCntCheck {
+				fmt.Println()
+				ctx.Warn("The frame counter check is disabled for this device. This makes it vulnerable to replay attacks")
+			}
+			if lorawan.ResetsFCnt {
+				fmt.Println()
+				ctx.Warn("Frame counter resets are accepted for this device. This makes it vulnerable to replay attacks")
+			}
 		}
 
 	},
@@ -5,8 +5,10 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api/handler"
 	"github.com/TheThingsNetwork/ttn/ttnctl/util"
 	"github.com/gosuri/uitable"
 	"github.com/spf13/cobra"
@@ -35,9 +37,40 @@ test 	70B3D57EF0000024	0001D544B2936FCE	26001ADA
 		conn, manager := util.GetHandlerManager(ctx, appID)
 		defer conn.Close()
 
-		devices, err := manager.GetDevicesForApplication(appID, 0, 0)
-		if err != nil {
-			ctx.WithError(err).Fatal("Could not get devices.")
+		opts := handler.DeviceListOptions{}
+		opts.Limit, _ = cmd.Flags().GetInt("limit")
+		opts.Cursor, _ = cmd.Flags().GetString("cursor")
+		opts.Sort, _ = cmd.Flags().GetString("sort")
+		if in, err := cmd.Flags().GetStringSlice("filter"); err == nil && len(in) > 0 {
+			opts.Filters = make(map[string]string)
+			for _, filter := range in {
+				parts := strings.SplitN(filter, "=", 2)
+				if len(parts) != 2 || parts[0] == "" {
+					ctx.Fatalf("Invalid filter %s, use field=value", filter)
+				}
+				opts.Filters[parts[0]] = parts[1]
+			}
+		}
+
+		// Without a limit, all devices are listed in pages
+		paginate := opts.Limit == 0
+		if paginate {
+			opts.Limit = devicesListPageSize
+		}
+
+		var devices []*handler.Device
+		var nextCursor string
+		for {
+			page, cursor, err := manager.ListDevices(appID, opts)
+			if err != nil {
+				ctx.WithError(err).Fatal("Could not get devices.")
+			}
+			devices = append(devices, page...)
+			nextCursor = cursor
+			if !paginate || nextCursor == "" {
+				break
+			}
+			opts.Cursor = nextCursor
 		}
 
 		table := uitable.New()
@@ -62,9 +95,20 @@ test 	70B3D57EF0000024	0001D544B2936FCE	26001ADA
 		ctx.WithFields(ttnlog.Fields{
 			"AppID": appID,
 		}).Infof("Listed %d devices", len(devices))
+
+		if nextCursor != "" {
+			ctx.WithField("Cursor", nextCursor).Info("More devices available, use --cursor to list the next page")
+		}
 	},
 }
 
+// The number of devices that is requested at once when listing all devices
+const devicesListPageSize = 1000
+
 func init() {
 	devicesCmd.AddCommand(devicesListCmd)
+	devicesListCmd.Flags().Int("limit", 0, "Maximum number of devices to list")
+	devicesListCmd.Flags().String("cursor", "", "Cursor of the page to list")
+	devicesListCmd.Flags().String("sort", "", "Sort by dev_id, created_at or last_seen (prefix with - for descending order)")
+	devicesListCmd.Flags().StringSlice("filter", []string{}, "Filter on app_eui, dev_eui or dev_addr (field=value)")
 }
@@ -0,0 +1,61 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var devicesRejoinCmd = &cobra.Command{
+	Use:   "rejoin [Device ID]",
+	Short: "Force a device to rejoin",
+	Long: `ttnctl devices rejoin can be used to force an OTAA device to rejoin.
+The current session of the device is invalidated, so messages from the device
+are dropped until it has joined again.`,
+	Example: `$ ttnctl devices rejoin test
+  INFO Using Application                        AppID=test
+Are you sure you want to invalidate the session of device test in application test?
+> yes
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Invalidated device session               AppID=test DevID=test
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		devID := args[0]
+		if !api.ValidID(devID) {
+			ctx.Fatalf("Invalid Device ID") // TODO: Add link to wiki explaining device IDs
+		}
+
+		appID := util.GetAppID(ctx)
+
+		if !confirm(fmt.Sprintf("Are you sure you want to invalidate the session of device %s in application %s?", devID, appID)) {
+			ctx.Info("Not doing anything")
+			return
+		}
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		err := manager.ForceRejoin(appID, devID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not force rejoin of device.")
+		}
+
+		ctx.WithFields(ttnlog.Fields{
+			"AppID": appID,
+			"DevID": devID,
+		}).Info("Invalidated device session")
+	},
+}
+
+func init() {
+	devicesCmd.AddCommand(devicesRejoinCmd)
+}
@@ -0,0 +1,71 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var devicesReplayCmd = &cobra.Command{
+	Use:   "replay [Device ID]",
+	Short: "Replay the stored uplink messages of a device",
+	Long: `ttnctl devices replay can be used to replay the stored uplink messages of a device.
+The messages are processed again with the current payload functions and published
+to the application with "is_replay" set. Use this to re-deliver messages after an
+integration outage or after fixing a decoder.`,
+	Example: `$ ttnctl devices replay test --since 2017-06-22T10:00:00Z
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Replayed uplinks                         AppID=test DevID=test
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		devID := args[0]
+		if !api.ValidID(devID) {
+			ctx.Fatalf("Invalid Device ID") // TODO: Add link to wiki explaining device IDs
+		}
+
+		var since, until time.Time
+		if in, _ := cmd.Flags().GetString("since"); in != "" {
+			var err error
+			if since, err = time.Parse(time.RFC3339, in); err != nil {
+				ctx.WithError(err).Fatal("Invalid since")
+			}
+		}
+		if in, _ := cmd.Flags().GetString("until"); in != "" {
+			var err error
+			if until, err = time.Parse(time.RFC3339, in); err != nil {
+				ctx.WithError(err).Fatal("Invalid until")
+			}
+		}
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		err := manager.ReplayUplinks(appID, devID, since, until)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not replay uplinks.")
+		}
+
+		ctx.WithFields(ttnlog.Fields{
+			"AppID": appID,
+			"DevID": devID,
+		}).Info("Replayed uplinks")
+	},
+}
+
+func init() {
+	devicesCmd.AddCommand(devicesReplayCmd)
+	devicesReplayCmd.Flags().String("since", "", "Only replay messages received at or after this time (RFC3339)")
+	devicesReplayCmd.Flags().String("until", "", "Only replay messages received before this time (RFC3339)")
+}
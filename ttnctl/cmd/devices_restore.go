@@ -0,0 +1,100 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/gosuri/uitable"
+	"github.com/spf13/cobra"
+)
+
+var devicesDeletedCmd = &cobra.Command{
+	Use:   "deleted",
+	Short: "List the deleted devices",
+	Long: `ttnctl devices deleted lists the devices in the recycle bin of the Handler.
+Deleted devices can be restored until they are purged.`,
+	Example: `$ ttnctl devices deleted
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Found 1 deleted device(s)                AppID=test
+
+DevID	Deleted                  	Purged
+test 	2017-10-19T13:49:43+02:00	2017-10-26T13:49:43+02:00
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 0, 0)
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		devices, err := manager.GetDeletedDevices(appID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not get deleted devices")
+		}
+
+		ctx.WithField("AppID", appID).Infof("Found %d deleted device(s)", len(devices))
+		if len(devices) == 0 {
+			return
+		}
+
+		table := uitable.New()
+		table.AddRow("DevID", "Deleted", "Purged")
+		for _, dev := range devices {
+			table.AddRow(dev.DevId, time.Unix(0, dev.DeletedAt).Format(time.RFC3339), time.Unix(0, dev.PurgeAt).Format(time.RFC3339))
+		}
+
+		fmt.Println()
+		fmt.Println(table)
+		fmt.Println()
+	},
+}
+
+var devicesRestoreCmd = &cobra.Command{
+	Use:   "restore [Device ID]",
+	Short: "Restore a deleted device",
+	Long: `ttnctl devices restore restores a deleted device from the recycle bin of the
+Handler, with its keys, frame counters, downlink queue and history.`,
+	Example: `$ ttnctl devices restore test
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Restored device                          AppID=test DevID=test
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		devID := args[0]
+		if !api.ValidID(devID) {
+			ctx.Fatalf("Invalid Device ID") // TODO: Add link to wiki explaining device IDs
+		}
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		err := manager.RestoreDevice(appID, devID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not restore device")
+		}
+
+		ctx.WithFields(ttnlog.Fields{
+			"AppID": appID,
+			"DevID": devID,
+		}).Info("Restored device")
+	},
+}
+
+func init() {
+	devicesCmd.AddCommand(devicesDeletedCmd)
+	devicesCmd.AddCommand(devicesRestoreCmd)
+}
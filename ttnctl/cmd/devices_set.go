@@ -5,9 +5,12 @@ package cmd
 
 import (
 	"os"
+	"strings"
 
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
 	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/api/handler"
+	pb_lorawan "github.com/TheThingsNetwork/ttn/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/ttnctl/util"
 	"github.com/spf13/cobra"
@@ -136,6 +139,43 @@ var devicesSetCmd = &cobra.Command{
 			dev.GetLorawanDevice().Uses32BitFCnt = false
 		}
 
+		if in, err := cmd.Flags().GetBool("enable-fcnt-reset"); err == nil && in {
+			ctx.Warn("Accepting frame counter resets makes the device vulnerable to replay attacks")
+			dev.GetLorawanDevice().ResetsFCnt = true
+		}
+
+		if in, err := cmd.Flags().GetBool("disable-fcnt-reset"); err == nil && in {
+			dev.GetLorawanDevice().ResetsFCnt = false
+		}
+
+		if in, err := cmd.Flags().GetBool("enable-relay"); err == nil && in {
+			dev.GetLorawanDevice().Relay = true
+		}
+
+		if in, err := cmd.Flags().GetBool("disable-relay"); err == nil && in {
+			dev.GetLorawanDevice().Relay = false
+		}
+
+		if in, err := cmd.Flags().GetString("rx-window"); err == nil && in != "" {
+			switch strings.ToLower(in) {
+			case "auto":
+				dev.GetLorawanDevice().RxWindow = pb_lorawan.RxWindow_RX_AUTO
+			case "rx1":
+				dev.GetLorawanDevice().RxWindow = pb_lorawan.RxWindow_RX1
+			case "rx2":
+				dev.GetLorawanDevice().RxWindow = pb_lorawan.RxWindow_RX2
+			default:
+				ctx.Fatalf("Invalid receive window: %s", in)
+			}
+		}
+
+		if in, err := cmd.Flags().GetString("regional-parameters"); err == nil && in != "" {
+			if !pb_lorawan.ValidRegionalParameters(in) {
+				ctx.Fatalf("Invalid Regional Parameters revision: %s", in)
+			}
+			dev.GetLorawanDevice().RegionalParameters = in
+		}
+
 		if in, err := cmd.Flags().GetFloat32("latitude"); err == nil && in != 0 {
 			dev.Latitude = in
 		}
@@ -152,6 +192,93 @@ var devicesSetCmd = &cobra.Command{
 			dev.Description = in
 		}
 
+		if in, err := cmd.Flags().GetStringSlice("attr"); err == nil && len(in) > 0 {
+			if dev.Attributes == nil {
+				dev.Attributes = make(map[string]string)
+			}
+			for _, attr := range in {
+				parts := strings.SplitN(attr, "=", 2)
+				if len(parts) != 2 || parts[0] == "" {
+					ctx.Fatalf("Invalid attribute %s, use key=value", attr)
+				}
+				if parts[1] == "" {
+					delete(dev.Attributes, parts[0])
+				} else {
+					dev.Attributes[parts[0]] = parts[1]
+				}
+			}
+		}
+
+		profile := dev.Profile
+		if profile == nil {
+			profile = new(handler.DeviceProfile)
+		}
+
+		if in, err := cmd.Flags().GetString("lorawan-version"); err == nil && in != "" {
+			if !pb_lorawan.ValidLoRaWANVersion(in) {
+				ctx.Fatalf("Invalid LoRaWAN version: %s", in)
+			}
+			profile.LorawanVersion = in
+		}
+
+		if in, err := cmd.Flags().GetString("vendor"); err == nil && in != "" {
+			profile.Vendor = in
+		}
+
+		if in, err := cmd.Flags().GetString("certification-id"); err == nil && in != "" {
+			profile.CertificationId = in
+		}
+
+		if in, err := cmd.Flags().GetString("brand-id"); err == nil && in != "" {
+			profile.BrandId = in
+		}
+
+		if in, err := cmd.Flags().GetString("model-id"); err == nil && in != "" {
+			profile.ModelId = in
+		}
+
+		if in, err := cmd.Flags().GetString("firmware-version"); err == nil && in != "" {
+			profile.FirmwareVersion = in
+		}
+
+		if *profile != (handler.DeviceProfile{}) {
+			dev.Profile = profile
+		}
+
+		if in, err := cmd.Flags().GetString("payload-format"); err == nil && in != "" {
+			if in == "application" {
+				in = ""
+			}
+			dev.PayloadFormat = in
+		}
+
+		if in, err := cmd.Flags().GetString("go-codec"); err == nil && in != "" {
+			dev.GoCodec = in
+		}
+		if dev.PayloadFormat != handler.PayloadFormatGo {
+			dev.GoCodec = ""
+		}
+
+		if in, err := cmd.Flags().GetString("codec"); err == nil && in != "" {
+			if in == "application" {
+				in = ""
+			}
+			dev.Codec = in
+		}
+
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+			res, err := manager.DryRunSetDevice(dev)
+			if err != nil {
+				ctx.WithError(err).Fatal("Invalid Device")
+			}
+			ctx.WithFields(ttnlog.Fields{
+				"AppID":   appID,
+				"DevID":   devID,
+				"Changed": strings.Join(res.Changed, ", "),
+			}).Info("Device is valid (dry run, nothing was changed)")
+			return
+		}
+
 		err = manager.SetDevice(dev)
 		if err != nil {
 			ctx.WithError(err).Fatal("Could not update Device")
@@ -168,6 +295,7 @@ func init() {
 	devicesCmd.AddCommand(devicesSetCmd)
 
 	devicesSetCmd.Flags().Bool("override", false, "Override protection against breaking changes")
+	devicesSetCmd.Flags().Bool("dry-run", false, "Validate the changes and show the changed fields, without updating the device")
 
 	devicesSetCmd.Flags().String("app-eui", "", "Set AppEUI")
 	devicesSetCmd.Flags().String("dev-eui", "", "Set DevEUI")
@@ -183,10 +311,28 @@ func init() {
 	devicesSetCmd.Flags().Bool("enable-fcnt-check", false, "Enable FCnt check (default)")
 	devicesSetCmd.Flags().Bool("32-bit-fcnt", false, "Use 32 bit FCnt (default)")
 	devicesSetCmd.Flags().Bool("16-bit-fcnt", false, "Use 16 bit FCnt")
+	devicesSetCmd.Flags().Bool("enable-fcnt-reset", false, "Accept FCnt resets of the device (ABP)")
+	devicesSetCmd.Flags().Bool("disable-fcnt-reset", false, "Reject FCnt resets of the device (default)")
+	devicesSetCmd.Flags().String("rx-window", "", "Set the receive window for downlink (auto, rx1, rx2)")
+	devicesSetCmd.Flags().Bool("enable-relay", false, "The device is a relay that forwards messages of other devices")
+	devicesSetCmd.Flags().Bool("disable-relay", false, "The device is not a relay (default)")
+	devicesSetCmd.Flags().String("regional-parameters", "", "Set the revision of the Regional Parameters that the device implements (1.0, 1.0.1, 1.0.2, 1.0.2-b, 1.0.3-a)")
 
 	devicesSetCmd.Flags().Float32("latitude", 0, "Set latitude")
 	devicesSetCmd.Flags().Float32("longitude", 0, "Set longitude")
 	devicesSetCmd.Flags().Int32("altitude", 0, "Set altitude")
 
 	devicesSetCmd.Flags().String("description", "", "Set Description")
+	devicesSetCmd.Flags().StringSlice("attr", []string{}, "Set an attribute (key=value), an empty value removes the attribute")
+
+	devicesSetCmd.Flags().String("lorawan-version", "", "Set the version of the LoRaWAN specification that the device implements (1.0, 1.0.1, 1.0.2, 1.0.3, 1.0.4, 1.1)")
+	devicesSetCmd.Flags().String("vendor", "", "Set the vendor of the device")
+	devicesSetCmd.Flags().String("certification-id", "", "Set the ID of the LoRaWAN certification of the device")
+	devicesSetCmd.Flags().String("brand-id", "", "Set the ID of the brand of the device in the device repository")
+	devicesSetCmd.Flags().String("model-id", "", "Set the ID of the model of the device in the device repository")
+	devicesSetCmd.Flags().String("firmware-version", "", "Set the version of the firmware of the device")
+
+	devicesSetCmd.Flags().String("payload-format", "", "Override the payload format of the application (custom, cayennelpp, wasm, binary, protobuf, cbor, go or application to use the one of the application)")
+	devicesSetCmd.Flags().String("go-codec", "", "Set the compiled Go codec of the Handler if the payload format of the device is go")
+	devicesSetCmd.Flags().String("codec", "", "Override the payload functions of the application with a codec of the codec library (or application to use the ones of the application)")
 }
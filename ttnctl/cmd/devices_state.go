@@ -0,0 +1,96 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var devicesStateCmd = &cobra.Command{
+	Use:   "state [Device ID] [Time]",
+	Short: "Get the state of a device at a point in time",
+	Long: `ttnctl devices state reconstructs the settings, session and uplink frame
+counter of a device at a point in time (RFC3339) from the history of changes
+that is kept by the Handler. This is useful to find out what the device looked
+like when an incident happened.`,
+	Example: `$ ttnctl devices state test 2017-06-22T08:00:00Z
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Found device state                       AppID=test Changed=dev_addr,nwk_s_key,app_s_key,f_cnt_up DevID=test Time=2017-06-22T07:58:12Z
+
+     AppEUI: 70B3D57EF0000024
+     DevEUI: 0001D544B2936FCE
+    DevAddr: 26001ADA
+     AppKey: 01020304050607080102030405060708
+    AppSKey: D8DD37B4B709BA76C6FEC62CAD0CCE51
+    NwkSKey: 3382A3066850293421ED8D392B9BF4DF
+     FCntUp: 0
+   Revision: 3
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 2, 2)
+
+		devID := args[0]
+		if !api.ValidID(devID) {
+			ctx.Fatalf("Invalid Device ID") // TODO: Add link to wiki explaining device IDs
+		}
+
+		t, err := time.Parse(time.RFC3339, args[1])
+		if err != nil {
+			ctx.WithError(err).Fatal("Invalid Time")
+		}
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		state, err := manager.GetDeviceState(appID, devID, t)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not get state of device.")
+		}
+
+		byteFormat, _ := cmd.Flags().GetString("format")
+
+		ctx.WithFields(ttnlog.Fields{
+			"AppID":   appID,
+			"DevID":   devID,
+			"Time":    time.Unix(0, state.Time).UTC().Format(time.RFC3339),
+			"Changed": strings.Join(state.Changed, ","),
+		}).Info("Found device state")
+
+		fmt.Println()
+		if dev := state.Device; dev != nil {
+			if dev.Description != "" {
+				fmt.Printf("Description: %s\n", dev.Description)
+			}
+			if lorawan := dev.GetLorawanDevice(); lorawan != nil {
+				fmt.Printf("     AppEUI: %s\n", formatBytes(lorawan.AppEui, byteFormat))
+				fmt.Printf("     DevEUI: %s\n", formatBytes(lorawan.DevEui, byteFormat))
+				fmt.Printf("    DevAddr: %s\n", formatBytes(lorawan.DevAddr, byteFormat))
+				fmt.Printf("     AppKey: %s\n", formatBytes(lorawan.AppKey, byteFormat))
+				fmt.Printf("    AppSKey: %s\n", formatBytes(lorawan.AppSKey, byteFormat))
+				fmt.Printf("    NwkSKey: %s\n", formatBytes(lorawan.NwkSKey, byteFormat))
+				fmt.Printf("     FCntUp: %d\n", lorawan.FCntUp)
+				if lorawan.LastSeen > 0 {
+					fmt.Printf("  Last Seen: %s\n", time.Unix(0, lorawan.LastSeen))
+				}
+			}
+			fmt.Printf("   Revision: %d\n", dev.Revision)
+		}
+	},
+}
+
+func init() {
+	devicesCmd.AddCommand(devicesStateCmd)
+	devicesStateCmd.Flags().String("format", "hex", "Formatting: hex/msb/lsb")
+}
@@ -0,0 +1,106 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/spf13/cobra"
+)
+
+var devicesTwinCmd = &cobra.Command{
+	Use:   "twin [Device ID]",
+	Short: "Show or set the desired state of a device",
+	Long: `ttnctl devices twin shows or sets the desired fields in the twin of a device.
+The Handler encodes the desired fields with the Encoder function of the
+application and sends them to the device until the device reports the same
+values in the decoded fields of its uplink messages.
+
+String values in the fields can refer to attributes of the device as {{.key}}.`,
+	Example: `$ ttnctl devices twin test --port 2 --fields '{"interval":60,"led":true}'
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Updated twin of device                   AppID=test DevID=test
+
+$ ttnctl devices twin test
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Found twin of device                     AppID=test Desired={"interval":60,"led":true} DevID=test InSync=false Pending=interval Port=2 Reported={"interval":300,"led":true}
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		devID := args[0]
+		if !api.ValidID(devID) {
+			ctx.Fatalf("Invalid Device ID") // TODO: Add link to wiki explaining device IDs
+		}
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		fields := ttnlog.Fields{
+			"AppID": appID,
+			"DevID": devID,
+		}
+
+		if clear, _ := cmd.Flags().GetBool("clear"); clear {
+			if err := manager.SetDeviceTwin(appID, devID, 0, ""); err != nil {
+				ctx.WithError(err).Fatal("Could not clear twin of device.")
+			}
+			ctx.WithFields(fields).Info("Cleared twin of device")
+			return
+		}
+
+		if in, _ := cmd.Flags().GetString("fields"); in != "" {
+			var desired map[string]interface{}
+			if err := json.Unmarshal([]byte(in), &desired); err != nil {
+				ctx.WithError(err).Fatal("Invalid fields")
+			}
+			port, _ := cmd.Flags().GetUint8("port")
+			if err := manager.SetDeviceTwin(appID, devID, port, in); err != nil {
+				ctx.WithError(err).Fatal("Could not set twin of device.")
+			}
+			ctx.WithFields(fields).Info("Updated twin of device")
+			return
+		}
+
+		twin, err := manager.GetDeviceTwin(appID, devID)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not get twin of device.")
+		}
+		if twin.DesiredFields == "" {
+			ctx.WithFields(fields).Info("No twin for device")
+			return
+		}
+		twinCtx := ctx.WithFields(fields).WithFields(ttnlog.Fields{
+			"Port":     twin.FPort,
+			"Desired":  twin.DesiredFields,
+			"Reported": twin.ReportedFields,
+			"InSync":   twin.InSync,
+		})
+		if len(twin.Pending) > 0 {
+			twinCtx = twinCtx.WithField("Pending", strings.Join(twin.Pending, ","))
+		}
+		if twin.PushedAt != 0 {
+			twinCtx = twinCtx.WithField("Pushed", time.Unix(0, twin.PushedAt).UTC().Format(time.RFC3339))
+		}
+		twinCtx.Info("Found twin of device")
+	},
+}
+
+func init() {
+	devicesCmd.AddCommand(devicesTwinCmd)
+	devicesTwinCmd.Flags().Uint8("port", 1, "Port number of the downlink with the desired fields")
+	devicesTwinCmd.Flags().String("fields", "", "JSON-encoded desired fields")
+	devicesTwinCmd.Flags().Bool("clear", false, "Remove the twin")
+}
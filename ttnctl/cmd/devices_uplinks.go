@@ -0,0 +1,84 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/gosuri/uitable"
+	"github.com/spf13/cobra"
+)
+
+var devicesUplinksCmd = &cobra.Command{
+	Use:   "uplinks [Device ID]",
+	Short: "List the last uplink messages of a device",
+	Long: `ttnctl devices uplinks can be used to list the last uplink messages of a device,
+including the metadata of all gateways that received them.`,
+	Example: `$ ttnctl devices uplinks test
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+
+Time                         	Counter	Port	Payload	Gateway 	RSSI  	SNR
+2017-06-22 10:14:02 +0200 CEST	42     	1   	0102   	gtw-1   	-98   	7.5
+                             	       	    	       	gtw-2   	-112  	-3.2
+
+  INFO Listed 1 uplinks                         AppID=test DevID=test
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		devID := args[0]
+		if !api.ValidID(devID) {
+			ctx.Fatalf("Invalid Device ID") // TODO: Add link to wiki explaining device IDs
+		}
+
+		appID := util.GetAppID(ctx)
+
+		limit, _ := cmd.Flags().GetInt("limit")
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		uplinks, err := manager.GetDeviceUplinks(appID, devID, limit, 0)
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not get uplinks of device.")
+		}
+
+		table := uitable.New()
+		table.MaxColWidth = 70
+		table.AddRow("Time", "Counter", "Port", "Payload", "Gateway", "RSSI", "SNR")
+		for _, uplink := range uplinks {
+			row := []interface{}{time.Unix(0, uplink.ServerTime), uplink.Counter, uplink.Port, fmt.Sprintf("%X", uplink.PayloadRaw)}
+			if len(uplink.GatewayMetadata) == 0 {
+				table.AddRow(row...)
+				continue
+			}
+			for i, gateway := range uplink.GatewayMetadata {
+				if i > 0 {
+					row = []interface{}{"", "", "", ""}
+				}
+				table.AddRow(append(row, gateway.GatewayId, gateway.Rssi, gateway.Snr)...)
+			}
+		}
+
+		fmt.Println()
+		fmt.Println(table)
+		fmt.Println()
+
+		ctx.WithFields(ttnlog.Fields{
+			"AppID": appID,
+			"DevID": devID,
+		}).Infof("Listed %d uplinks", len(uplinks))
+	},
+}
+
+func init() {
+	devicesCmd.AddCommand(devicesUplinksCmd)
+	devicesUplinksCmd.Flags().Int("limit", 0, "Maximum number of uplinks to list")
+}
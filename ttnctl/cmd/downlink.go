@@ -5,8 +5,11 @@ package cmd
 
 import (
 	"encoding/json"
+	"time"
 
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
 	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/api/handler"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/ttnctl/util"
 	"github.com/spf13/cobra"
@@ -25,6 +28,11 @@ $ ttnctl downlink test --json '{"led":"on"}'
   INFO Connecting to MQTT...
   INFO Connected to MQTT
   INFO Enqueued downlink                        AppID=test DevID=test
+
+$ ttnctl downlink test aabc --preview
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Previewed downlink                       AppID=test Airtime=46.336ms DataRate=SF7BW125 DevID=test DutyCycle=0.01 Frequency=868100000 GatewayID=test-gateway RxWindow=RX1 Wait=0s
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 		assertArgsLength(cmd, args, 2, 2)
@@ -53,19 +61,22 @@ $ ttnctl downlink test --json '{"led":"on"}'
 			ctx.WithError(err).Fatal("Failed to read confirmed flag")
 		}
 
+		critical, err := cmd.Flags().GetBool("critical")
+		if err != nil {
+			ctx.WithError(err).Fatal("Failed to read critical flag")
+		}
+
 		accessKey, err := cmd.Flags().GetString("access-key")
 		if err != nil {
 			ctx.WithError(err).Fatal("Failed to read access-key flag")
 		}
 
-		client := util.GetMQTT(ctx, accessKey)
-		defer client.Disconnect()
-
 		message := types.DownlinkMessage{
 			AppID:     appID,
 			DevID:     devID,
 			FPort:     uint8(fPort),
 			Confirmed: confirmed,
+			Critical:  critical,
 		}
 
 		if args[1] == "" {
@@ -95,6 +106,42 @@ $ ttnctl downlink test --json '{"led":"on"}'
 
 			message.PayloadRaw = payload
 		}
+		preview, err := cmd.Flags().GetBool("preview")
+		if err != nil {
+			ctx.WithError(err).Fatal("Failed to read preview flag")
+		}
+		if preview {
+			conn, manager := util.GetHandlerManager(ctx, appID)
+			defer conn.Close()
+
+			var res *handler.DownlinkPreview
+			if message.PayloadFields != nil {
+				res, err = manager.PreviewDownlinkWithFields(appID, devID, message.PayloadFields, uint32(fPort))
+			} else {
+				res, err = manager.PreviewDownlinkWithPayload(appID, devID, message.PayloadRaw, uint32(fPort))
+			}
+			if err != nil {
+				ctx.WithError(err).Fatal("Could not preview downlink")
+			}
+
+			ctx.WithFields(ttnlog.Fields{
+				"GatewayID": res.GatewayId,
+				"RxWindow":  res.RxWindow,
+				"Frequency": res.Frequency,
+				"DataRate":  res.DataRate,
+				"Airtime":   time.Duration(res.Airtime),
+				"DutyCycle": res.DutyCycle,
+				"Wait":      time.Duration(res.Wait),
+			}).Info("Previewed downlink")
+			if !res.Allowed {
+				ctx.Warn("The duty cycle does not allow the gateway to transmit this downlink now")
+			}
+			return
+		}
+
+		client := util.GetMQTT(ctx, accessKey)
+		defer client.Disconnect()
+
 		token := client.PublishDownlink(message)
 		token.Wait()
 		if token.Error() != nil {
@@ -108,6 +155,8 @@ func init() {
 	RootCmd.AddCommand(downlinkCmd)
 	downlinkCmd.Flags().Int("fport", 1, "FPort for downlink")
 	downlinkCmd.Flags().Bool("confirmed", false, "Confirmed downlink")
+	downlinkCmd.Flags().Bool("critical", false, "Critical downlink (transmit on two gateways if possible)")
 	downlinkCmd.Flags().Bool("json", false, "Provide the payload as JSON")
 	downlinkCmd.Flags().String("access-key", "", "The access key to use")
+	downlinkCmd.Flags().Bool("preview", false, "Estimate the airtime and duty cycle of the downlink without sending it")
 }
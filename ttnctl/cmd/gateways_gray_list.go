@@ -0,0 +1,60 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"github.com/TheThingsNetwork/ttn/api"
+	"github.com/TheThingsNetwork/ttn/api/router"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/spf13/cobra"
+)
+
+var gatewaysGrayListCmd = &cobra.Command{
+	Use:   "gray-list [gatewayID] [never|always|auto]",
+	Short: "Override the gray-listing of a gateway for downlink",
+	Long: `ttnctl gateways gray-list overrides the gray-listing of a gateway. The Router
+avoids sending downlink through gray-listed gateways. Gateways are gray-listed
+automatically when they often fail to transmit the downlink messages that they
+receive. Network operators can set the override to never or always, or back to
+auto to gray-list the gateway automatically again.`,
+	Example: `$ ttnctl gateways gray-list test never
+  INFO Discovering Router...
+  INFO Connecting with Router...
+  INFO Connected to Router
+  INFO Overrode gray-listing                    GatewayID=test Override=never
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 2, 2)
+
+		gtwID := args[0]
+		if !api.ValidID(gtwID) {
+			ctx.Fatal("Invalid Gateway ID")
+		}
+
+		override := args[1]
+		if override == "auto" {
+			override = ""
+		}
+
+		conn, manager := util.GetRouterManager(ctx)
+		defer conn.Close()
+
+		ctx = ctx.WithField("GatewayID", gtwID)
+
+		_, err := manager.SetGatewayGrayList(util.GetContext(ctx), &router.GatewayGrayListRequest{
+			GatewayId: gtwID,
+			Override:  override,
+		})
+		if err != nil {
+			ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not override gray-listing of gateway.")
+		}
+
+		ctx.WithField("Override", args[1]).Info("Overrode gray-listing")
+	},
+}
+
+func init() {
+	gatewaysCmd.AddCommand(gatewaysGrayListCmd)
+}
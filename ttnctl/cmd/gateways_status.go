@@ -62,6 +62,8 @@ var gatewaysStatusCmd = &cobra.Command{
 		}
 		printKV("Description", resp.Status.Description)
 		printKV("Platform", resp.Status.Platform)
+		printKV("Packet forwarder", resp.Status.PacketForwarder)
+		printKV("HAL", resp.Status.Hal)
 		printKV("Contact email", resp.Status.ContactEmail)
 		printKV("Frequency Plan", resp.Status.FrequencyPlan)
 		printKV("Bridge", resp.Status.Bridge)
@@ -72,6 +74,13 @@ var gatewaysStatusCmd = &cobra.Command{
 			}
 			return "not available"
 		}())
+		for _, antenna := range resp.Status.Antennas {
+			description := fmt.Sprintf("(gain: %.1f dBi)", antenna.Gain)
+			if gps := antenna.Gps; gps != nil && !gps.IsZero() {
+				description = fmt.Sprintf("(gain: %.1f dBi; gps: %.6f %.6f)", antenna.Gain, gps.Latitude, gps.Longitude)
+			}
+			printKV(fmt.Sprintf("Antenna %d", antenna.Antenna), description)
+		}
 		printKV("Rtt", func() interface{} {
 			if t := resp.Status.Rtt; t != 0 {
 				return time.Duration(t)
@@ -80,6 +89,14 @@ var gatewaysStatusCmd = &cobra.Command{
 		}())
 		printKV("Rx", fmt.Sprintf("(in: %d; ok: %d)", resp.Status.RxIn, resp.Status.RxOk))
 		printKV("Tx", fmt.Sprintf("(in: %d; ok: %d)", resp.Status.TxIn, resp.Status.TxOk))
+		printKV("Queued downlinks", resp.QueuedDownlinks)
+		printKV("Tx success rate", fmt.Sprintf("%.0f%%", resp.TxSuccessRate*100))
+		printKV("Gray-listed", func() interface{} {
+			if resp.GrayListOverride != "" {
+				return fmt.Sprintf("%v (override: %s)", resp.GrayListed, resp.GrayListOverride)
+			}
+			return resp.GrayListed
+		}())
 		fmt.Println()
 	},
 }
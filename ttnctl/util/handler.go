@@ -15,12 +15,17 @@ import (
 
 // GetHandlerManager gets a new HandlerManager for ttnctl
 func GetHandlerManager(ctx ttnlog.Interface, appID string) (*grpc.ClientConn, *handler.ManagerClient) {
-	ctx.WithField("Handler", viper.GetString("handler-id")).Info("Discovering Handler...")
+	return GetHandlerManagerByID(ctx, appID, viper.GetString("handler-id"))
+}
+
+// GetHandlerManagerByID returns a HandlerManager for the Handler with the given ID
+func GetHandlerManagerByID(ctx ttnlog.Interface, appID, handlerID string) (*grpc.ClientConn, *handler.ManagerClient) {
+	ctx.WithField("Handler", handlerID).Info("Discovering Handler...")
 	dscConn, client := GetDiscovery(ctx)
 	defer dscConn.Close()
 	handlerAnnouncement, err := client.Get(GetContext(ctx), &discovery.GetRequest{
 		ServiceName: "handler",
-		Id:          viper.GetString("handler-id"),
+		Id:          handlerID,
 	})
 	if err != nil {
 		ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not find Handler")
@@ -19,6 +19,7 @@ type ErrType string
 // These constants represent error types
 const (
 	AlreadyExists    ErrType = "already exists"
+	Conflict         ErrType = "conflict"
 	Internal         ErrType = "internal"
 	InvalidArgument  ErrType = "invalid argument"
 	NotFound         ErrType = "not found"
@@ -32,6 +33,8 @@ func GetErrType(err error) ErrType {
 	switch errs.Cause(err).(type) {
 	case *ErrAlreadyExists:
 		return AlreadyExists
+	case *ErrConflict:
+		return Conflict
 	case *ErrInternal:
 		return Internal
 	case *ErrInvalidArgument:
@@ -69,6 +72,11 @@ func IsAlreadyExists(err error) bool {
 	return GetErrType(err) == AlreadyExists
 }
 
+// IsConflict returns whether error type is Conflict
+func IsConflict(err error) bool {
+	return GetErrType(err) == Conflict
+}
+
 // BuildGRPCError returns the error with a GRPC code
 func BuildGRPCError(err error) error {
 	if err == nil {
@@ -81,6 +89,8 @@ func BuildGRPCError(err error) error {
 	switch errs.Cause(err).(type) {
 	case *ErrAlreadyExists:
 		code = codes.AlreadyExists
+	case *ErrConflict:
+		code = codes.Aborted
 	case *ErrInternal:
 		code = codes.Internal
 	case *ErrInvalidArgument:
@@ -109,6 +119,8 @@ func FromGRPCError(err error) error {
 	switch code {
 	case codes.AlreadyExists:
 		return NewErrAlreadyExists(strings.TrimSuffix(desc, " already exists"))
+	case codes.Aborted:
+		return NewErrConflict(strings.TrimSuffix(desc, " was modified concurrently"))
 	case codes.Internal:
 		return NewErrInternal(strings.TrimPrefix(desc, "Internal error: "))
 	case codes.InvalidArgument:
@@ -144,6 +156,21 @@ func (err ErrAlreadyExists) Error() string {
 	return fmt.Sprintf("%s already exists", err.entity)
 }
 
+// NewErrConflict returns a new ErrConflict for the given entity
+func NewErrConflict(entity string) error {
+	return &ErrConflict{entity: entity}
+}
+
+// ErrConflict indicates that an entity was modified concurrently
+type ErrConflict struct {
+	entity string
+}
+
+// Error implements the error interface
+func (err ErrConflict) Error() string {
+	return fmt.Sprintf("%s was modified concurrently", err.entity)
+}
+
 // NewErrInternal returns a new ErrInternal with the given message
 func NewErrInternal(message string) error {
 	return &ErrInternal{message: message}
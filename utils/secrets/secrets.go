@@ -0,0 +1,93 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+// Package secrets resolves credentials that are stored in an external secrets backend and referenced by name
+package secrets
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// ReferencePrefix is the prefix of values that reference a secret by name, for example "secret:amqp-password"
+const ReferencePrefix = "secret:"
+
+// Backend is an external secrets backend
+type Backend interface {
+	// Get returns the current value of the secret with the given name
+	Get(name string) (string, error)
+}
+
+// IsReference returns true if the value references a secret
+func IsReference(value string) bool {
+	return strings.HasPrefix(value, ReferencePrefix)
+}
+
+// Resolve returns the value of the secret if the value references a secret, or the value itself otherwise
+func Resolve(backend Backend, value string) (string, error) {
+	if !IsReference(value) {
+		return value, nil
+	}
+	name := strings.TrimPrefix(value, ReferencePrefix)
+	if name == "" {
+		return "", errors.NewErrInvalidArgument("Secret reference", "name can not be empty")
+	}
+	if backend == nil {
+		return "", errors.NewErrInvalidArgument("Secret "+name, "no secrets backend configured")
+	}
+	return backend.Get(name)
+}
+
+type cachedSecret struct {
+	value   string
+	fetched time.Time
+}
+
+// CachedBackend caches the secrets of a backend. After the TTL, secrets are fetched again, so that rotated secrets
+// are picked up. If the backend is unavailable at that time, the cached value is used until it is available again.
+type CachedBackend struct {
+	backend Backend
+	ttl     time.Duration
+
+	mu      sync.Mutex
+	secrets map[string]cachedSecret
+}
+
+// NewCachedBackend returns a CachedBackend for the given backend
+func NewCachedBackend(backend Backend, ttl time.Duration) *CachedBackend {
+	return &CachedBackend{
+		backend: backend,
+		ttl:     ttl,
+		secrets: make(map[string]cachedSecret),
+	}
+}
+
+// Get implements the Backend interface
+func (c *CachedBackend) Get(name string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cached, ok := c.secrets[name]
+	if ok && time.Since(cached.fetched) < c.ttl {
+		return cached.value, nil
+	}
+	value, err := c.backend.Get(name)
+	if err != nil {
+		if ok && !errors.IsNotFound(err) {
+			return cached.value, nil
+		}
+		return "", err
+	}
+	c.secrets[name] = cachedSecret{value: value, fetched: time.Now()}
+	return value, nil
+}
+
+// Invalidate removes the secret from the cache, so that it is fetched again the next time. This can be used after
+// a secret was rotated.
+func (c *CachedBackend) Invalidate(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.secrets, name)
+}
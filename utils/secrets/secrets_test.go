@@ -0,0 +1,180 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package secrets
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	. "github.com/smartystreets/assertions"
+)
+
+type mapBackend struct {
+	secrets map[string]string
+	calls   int
+	err     error
+}
+
+func (m *mapBackend) Get(name string) (string, error) {
+	m.calls++
+	if m.err != nil {
+		return "", m.err
+	}
+	value, ok := m.secrets[name]
+	if !ok {
+		return "", errors.NewErrNotFound("Secret " + name)
+	}
+	return value, nil
+}
+
+func TestResolve(t *testing.T) {
+	a := New(t)
+	backend := &mapBackend{secrets: map[string]string{"amqp-password": "s3cr3t"}}
+
+	value, err := Resolve(backend, "plaintext")
+	a.So(err, ShouldBeNil)
+	a.So(value, ShouldEqual, "plaintext")
+
+	value, err = Resolve(backend, "secret:amqp-password")
+	a.So(err, ShouldBeNil)
+	a.So(value, ShouldEqual, "s3cr3t")
+
+	_, err = Resolve(backend, "secret:unknown")
+	a.So(errors.IsNotFound(err), ShouldBeTrue)
+
+	_, err = Resolve(backend, "secret:")
+	a.So(err, ShouldNotBeNil)
+
+	_, err = Resolve(nil, "secret:amqp-password")
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestCachedBackend(t *testing.T) {
+	a := New(t)
+	backend := &mapBackend{secrets: map[string]string{"password": "old"}}
+	cached := NewCachedBackend(backend, 20*time.Millisecond)
+
+	value, _ := cached.Get("password")
+	a.So(value, ShouldEqual, "old")
+	value, _ = cached.Get("password")
+	a.So(value, ShouldEqual, "old")
+	a.So(backend.calls, ShouldEqual, 1)
+
+	// Rotated secrets are picked up after the TTL
+	backend.secrets["password"] = "new"
+	value, _ = cached.Get("password")
+	a.So(value, ShouldEqual, "old")
+	time.Sleep(30 * time.Millisecond)
+	value, _ = cached.Get("password")
+	a.So(value, ShouldEqual, "new")
+
+	// Or after invalidating
+	backend.secrets["password"] = "newer"
+	cached.Invalidate("password")
+	value, _ = cached.Get("password")
+	a.So(value, ShouldEqual, "newer")
+
+	// The cached value is used if the backend is unavailable
+	backend.err = fmt.Errorf("unavailable")
+	time.Sleep(30 * time.Millisecond)
+	value, err := cached.Get("password")
+	a.So(err, ShouldBeNil)
+	a.So(value, ShouldEqual, "newer")
+
+	_, err = cached.Get("other")
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestVaultBackend(t *testing.T) {
+	a := New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if r.URL.Path != "/v1/secret/data/ttn/amqp" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"data":{"data":{"value":"s3cr3t","username":"ttn"}}}`))
+	}))
+	defer server.Close()
+
+	vault := NewVaultBackend(server.URL+"/", "token", "")
+
+	value, err := vault.Get("ttn/amqp")
+	a.So(err, ShouldBeNil)
+	a.So(value, ShouldEqual, "s3cr3t")
+
+	value, err = vault.Get("ttn/amqp#username")
+	a.So(err, ShouldBeNil)
+	a.So(value, ShouldEqual, "ttn")
+
+	_, err = vault.Get("ttn/amqp#unknown")
+	a.So(errors.IsNotFound(err), ShouldBeTrue)
+
+	_, err = vault.Get("ttn/unknown")
+	a.So(errors.IsNotFound(err), ShouldBeTrue)
+
+	vault.Token = "wrong"
+	_, err = vault.Get("ttn/amqp")
+	a.So(errors.IsPermissionDenied(err), ShouldBeTrue)
+}
+
+func TestAWSBackend(t *testing.T) {
+	a := New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Amz-Target") != "secretsmanager.GetSecretValue" ||
+			!strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var req struct{ SecretId string }
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.SecretId != "ttn/amqp" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"__type":"ResourceNotFoundException","message":"Secrets Manager can't find the specified secret."}`))
+			return
+		}
+		w.Write([]byte(`{"Name":"ttn/amqp","SecretString":"s3cr3t"}`))
+	}))
+	defer server.Close()
+
+	aws := NewAWSBackend("eu-west-1")
+	aws.Endpoint = server.URL
+	aws.AccessKeyID = "AKID"
+	aws.SecretAccessKey = "secret"
+
+	value, err := aws.Get("ttn/amqp")
+	a.So(err, ShouldBeNil)
+	a.So(value, ShouldEqual, "s3cr3t")
+
+	_, err = aws.Get("ttn/unknown")
+	a.So(errors.IsNotFound(err), ShouldBeTrue)
+}
+
+func TestAWSSignature(t *testing.T) {
+	a := New(t)
+
+	aws := &AWSBackend{Region: "us-east-1", AccessKeyID: "AKID", SecretAccessKey: "secret"}
+	req, _ := http.NewRequest("POST", "https://secretsmanager.us-east-1.amazonaws.com/", nil)
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+	aws.sign(req, []byte(`{"SecretId":"ttn"}`), time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC))
+
+	a.So(req.Header.Get("X-Amz-Date"), ShouldEqual, "20170601T120000Z")
+	a.So(req.Header.Get("Authorization"), ShouldStartWith, "AWS4-HMAC-SHA256 Credential=AKID/20170601/us-east-1/secretsmanager/aws4_request, SignedHeaders=content-type;host;x-amz-date;x-amz-target, Signature=")
+
+	aws.SessionToken = "token"
+	aws.sign(req, []byte(`{"SecretId":"ttn"}`), time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC))
+	a.So(req.Header.Get("Authorization"), ShouldContainSubstring, "SignedHeaders=content-type;host;x-amz-date;x-amz-security-token;x-amz-target")
+}
@@ -0,0 +1,78 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package secrets
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// VaultBackend gets secrets from the KV (version 2) secrets engine of HashiCorp Vault. The name of a secret is the
+// path of the secret, optionally followed by # and the key in the secret (default "value").
+type VaultBackend struct {
+	Address string
+	Token   string
+	Mount   string
+	Client  *http.Client
+}
+
+// NewVaultBackend returns a new VaultBackend for the Vault server at the given address
+func NewVaultBackend(address, token, mount string) *VaultBackend {
+	if mount == "" {
+		mount = "secret"
+	}
+	return &VaultBackend{
+		Address: strings.TrimSuffix(address, "/"),
+		Token:   token,
+		Mount:   mount,
+		Client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Get implements the Backend interface
+func (v *VaultBackend) Get(name string) (string, error) {
+	path, key := name, "value"
+	if i := strings.LastIndex(name, "#"); i >= 0 {
+		path, key = name[:i], name[i+1:]
+	}
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v1/%s/data/%s", v.Address, v.Mount, path), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", v.Token)
+	resp, err := v.Client.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "Could not get secret from Vault")
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return "", errors.NewErrNotFound("Secret " + name)
+	case http.StatusForbidden:
+		return "", errors.NewErrPermissionDenied("Not allowed to get secret " + name + " from Vault")
+	default:
+		return "", fmt.Errorf("Could not get secret from Vault: status %d", resp.StatusCode)
+	}
+
+	var secret struct {
+		Data struct {
+			Data map[string]interface{} `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return "", errors.Wrap(err, "Could not decode secret from Vault")
+	}
+	value, ok := secret.Data.Data[key].(string)
+	if !ok {
+		return "", errors.NewErrNotFound(fmt.Sprintf("Key %s in secret %s", key, path))
+	}
+	return value, nil
+}
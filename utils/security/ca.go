@@ -0,0 +1,307 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package security
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+var (
+	caValidFor  = 10 * 365 * 24 * time.Hour
+	crlValidFor = 30 * 24 * time.Hour
+)
+
+// CA is a certificate authority that issues client certificates for integrations and gateways
+type CA struct {
+	Cert     *x509.Certificate
+	key      *ecdsa.PrivateKey
+	location string
+}
+
+func caPath(location, file string) string {
+	return filepath.Clean(location + "/" + file)
+}
+
+func newSerialNumber() (*big.Int, error) {
+	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
+	return rand.Int(rand.Reader, serialNumberLimit)
+}
+
+// GenerateCA generates the key and self-signed certificate of a new CA in the given location
+func GenerateCA(location, name string) error {
+	if _, err := os.Stat(caPath(location, "ca.key")); err == nil {
+		return fmt.Errorf("CA key already exists in %s", location)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	serialNumber, err := newSerialNumber()
+	if err != nil {
+		return err
+	}
+	notBefore := time.Now()
+	template := x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			Organization: []string{"The Things Network"},
+			CommonName:   name,
+		},
+		IsCA:                  true,
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(caValidFor),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, &template, &template, key.Public(), key)
+	if err != nil {
+		return err
+	}
+
+	privPEM, err := PrivatePEM(key)
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(caPath(location, "ca.key"), privPEM, 0600)
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(caPath(location, "ca.cert"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes}), 0644)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// LoadCACert loads the certificate of the CA in the given location
+func LoadCACert(location string) (*x509.Certificate, error) {
+	certPEM, err := ioutil.ReadFile(caPath(location, "ca.cert"))
+	if err != nil {
+		return nil, err
+	}
+	certBlock, _ := pem.Decode(certPEM)
+	if certBlock == nil {
+		return nil, errors.New("No certificate data found")
+	}
+	return x509.ParseCertificate(certBlock.Bytes)
+}
+
+// LoadCA loads the CA in the given location
+func LoadCA(location string) (*CA, error) {
+	cert, err := LoadCACert(location)
+	if err != nil {
+		return nil, err
+	}
+	priv, err := ioutil.ReadFile(caPath(location, "ca.key"))
+	if err != nil {
+		return nil, err
+	}
+	privBlock, _ := pem.Decode(priv)
+	if privBlock == nil {
+		return nil, errors.New("No private key data found")
+	}
+	key, err := x509.ParseECPrivateKey(privBlock.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	return &CA{Cert: cert, key: key, location: location}, nil
+}
+
+// IssueCert issues a client certificate for the given common name (the ID of the integration or gateway). If
+// hostnames are given, the certificate can also be used as server certificate for these hostnames.
+func (ca *CA) IssueCert(commonName string, validFor time.Duration, hostnames ...string) (certPEM, keyPEM []byte, serialNumber *big.Int, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	serialNumber, err = newSerialNumber()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	notBefore := time.Now()
+	template := x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			Organization: []string{"The Things Network"},
+			CommonName:   commonName,
+		},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(validFor),
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+	if len(hostnames) > 0 {
+		template.ExtKeyUsage = append(template.ExtKeyUsage, x509.ExtKeyUsageServerAuth)
+	}
+	for _, h := range hostnames {
+		if ip := net.ParseIP(h); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, h)
+		}
+	}
+
+	certBytes, err := x509.CreateCertificate(rand.Reader, &template, ca.Cert, key.Public(), ca.key)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	keyPEM, err = PrivatePEM(key)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes})
+	return certPEM, keyPEM, serialNumber, nil
+}
+
+// RevokedCerts returns the certificates that are revoked in the CRL of the CA
+func (ca *CA) RevokedCerts() ([]pkix.RevokedCertificate, error) {
+	crlBytes, err := ioutil.ReadFile(caPath(ca.location, "ca.crl"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	crl, err := x509.ParseCRL(crlBytes)
+	if err != nil {
+		return nil, err
+	}
+	return crl.TBSCertList.RevokedCertificates, nil
+}
+
+// Revoke adds the certificate with the given serial number to the CRL of the CA
+func (ca *CA) Revoke(serialNumber *big.Int) error {
+	revoked, err := ca.RevokedCerts()
+	if err != nil {
+		return err
+	}
+	for _, cert := range revoked {
+		if cert.SerialNumber.Cmp(serialNumber) == 0 {
+			return nil
+		}
+	}
+	revoked = append(revoked, pkix.RevokedCertificate{
+		SerialNumber:   serialNumber,
+		RevocationTime: time.Now().UTC(),
+	})
+	return ca.WriteCRL(revoked)
+}
+
+// UpdateCRL signs the CRL of the CA again, so that it does not expire
+func (ca *CA) UpdateCRL() error {
+	revoked, err := ca.RevokedCerts()
+	if err != nil {
+		return err
+	}
+	return ca.WriteCRL(revoked)
+}
+
+// WriteCRL writes a CRL with the given revoked certificates to the location of the CA
+func (ca *CA) WriteCRL(revoked []pkix.RevokedCertificate) error {
+	now := time.Now().UTC()
+	crlBytes, err := ca.Cert.CreateCRL(rand.Reader, ca.key, revoked, now, now.Add(crlValidFor))
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(caPath(ca.location, "ca.crl"), pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crlBytes}), 0644)
+}
+
+// RevocationList checks certificates against the CRL of a CA. The CRL is reloaded when the file changes, so that
+// servers do not have to be restarted after a certificate is revoked. Without a valid CRL, all certificates are
+// rejected.
+type RevocationList struct {
+	ca   *x509.Certificate
+	path string
+
+	mu      sync.Mutex
+	modTime time.Time
+	crl     *pkix.CertificateList
+	revoked map[string]bool
+}
+
+// NewRevocationList returns a RevocationList for the CRL (ca.crl) of the CA in the given location
+func NewRevocationList(ca *x509.Certificate, location string) *RevocationList {
+	return &RevocationList{
+		ca:   ca,
+		path: caPath(location, "ca.crl"),
+	}
+}
+
+func (l *RevocationList) load() error {
+	info, err := os.Stat(l.path)
+	if os.IsNotExist(err) {
+		l.crl, l.revoked = nil, nil
+		return errors.New("CA has no CRL")
+	}
+	if err != nil {
+		return err
+	}
+	if l.crl != nil && info.ModTime().Equal(l.modTime) {
+		return nil
+	}
+	crlBytes, err := ioutil.ReadFile(l.path)
+	if err != nil {
+		return err
+	}
+	crl, err := x509.ParseCRL(crlBytes)
+	if err != nil {
+		return err
+	}
+	if err := l.ca.CheckCRLSignature(crl); err != nil {
+		return err
+	}
+	revoked := make(map[string]bool, len(crl.TBSCertList.RevokedCertificates))
+	for _, cert := range crl.TBSCertList.RevokedCertificates {
+		revoked[cert.SerialNumber.String()] = true
+	}
+	l.crl, l.revoked, l.modTime = crl, revoked, info.ModTime()
+	return nil
+}
+
+// IsRevoked returns true if the certificate is revoked. An error is returned if the CRL does not exist, can not be
+// loaded or is expired.
+func (l *RevocationList) IsRevoked(cert *x509.Certificate) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.load(); err != nil {
+		return false, err
+	}
+	if l.crl.HasExpired(time.Now()) {
+		return false, errors.New("CRL has expired")
+	}
+	return l.revoked[cert.SerialNumber.String()], nil
+}
+
+// VerifyPeerCertificate can be used in a tls.Config to reject revoked client certificates
+func (l *RevocationList) VerifyPeerCertificate(_ [][]byte, verifiedChains [][]*x509.Certificate) error {
+	for _, chain := range verifiedChains {
+		if len(chain) == 0 {
+			continue
+		}
+		revoked, err := l.IsRevoked(chain[0])
+		if err != nil {
+			return err
+		}
+		if revoked {
+			return fmt.Errorf("Certificate %s of %s has been revoked", chain[0].SerialNumber, chain[0].Subject.CommonName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package security
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestCA(t *testing.T) {
+	a := New(t)
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	location := fmt.Sprintf("%s/%d", os.TempDir(), r.Int63())
+	os.Mkdir(location, 0755)
+	defer os.RemoveAll(location)
+
+	_, err := LoadCA(location)
+	a.So(err, ShouldNotBeNil)
+
+	err = GenerateCA(location, "Test CA")
+	a.So(err, ShouldBeNil)
+
+	// The key of an existing CA is not overwritten
+	err = GenerateCA(location, "Test CA")
+	a.So(err, ShouldNotBeNil)
+
+	ca, err := LoadCA(location)
+	a.So(err, ShouldBeNil)
+	a.So(ca.Cert.IsCA, ShouldBeTrue)
+	a.So(ca.Cert.Subject.CommonName, ShouldEqual, "Test CA")
+
+	certPEM, keyPEM, serialNumber, err := ca.IssueCert("my-gateway", 24*time.Hour)
+	a.So(err, ShouldBeNil)
+	a.So(keyPEM, ShouldNotBeEmpty)
+
+	certBlock, _ := pem.Decode(certPEM)
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	a.So(err, ShouldBeNil)
+	a.So(cert.Subject.CommonName, ShouldEqual, "my-gateway")
+	a.So(cert.SerialNumber.Cmp(serialNumber), ShouldEqual, 0)
+	a.So(cert.ExtKeyUsage, ShouldResemble, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth})
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.Cert)
+	chains, err := cert.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+	a.So(err, ShouldBeNil)
+
+	// Without CRL, all certificates are rejected
+	list := NewRevocationList(ca.Cert, location)
+	_, err = list.IsRevoked(cert)
+	a.So(err, ShouldNotBeNil)
+	a.So(list.VerifyPeerCertificate(nil, chains), ShouldNotBeNil)
+
+	// With an empty CRL, no certificates are revoked
+	a.So(ca.UpdateCRL(), ShouldBeNil)
+	revoked, err := list.IsRevoked(cert)
+	a.So(err, ShouldBeNil)
+	a.So(revoked, ShouldBeFalse)
+	a.So(list.VerifyPeerCertificate(nil, chains), ShouldBeNil)
+
+	// The CRL is reloaded after revoking
+	a.So(ca.Revoke(serialNumber), ShouldBeNil)
+	a.So(ca.Revoke(serialNumber), ShouldBeNil)
+	revokedCerts, err := ca.RevokedCerts()
+	a.So(err, ShouldBeNil)
+	a.So(revokedCerts, ShouldHaveLength, 1)
+
+	revoked, err = list.IsRevoked(cert)
+	a.So(err, ShouldBeNil)
+	a.So(revoked, ShouldBeTrue)
+	a.So(list.VerifyPeerCertificate(nil, chains), ShouldNotBeNil)
+
+	// Other certificates are not revoked
+	certPEM, _, _, err = ca.IssueCert("my-integration", 24*time.Hour, "localhost")
+	a.So(err, ShouldBeNil)
+	certBlock, _ = pem.Decode(certPEM)
+	other, _ := x509.ParseCertificate(certBlock.Bytes)
+	a.So(other.DNSNames, ShouldResemble, []string{"localhost"})
+	revoked, err = list.IsRevoked(other)
+	a.So(err, ShouldBeNil)
+	a.So(revoked, ShouldBeFalse)
+
+	// A CRL that is not signed by the CA is rejected
+	a.So(GenerateCA(location+"/other", "Other CA"), ShouldNotBeNil)
+	os.Mkdir(location+"/other", 0755)
+	a.So(GenerateCA(location+"/other", "Other CA"), ShouldBeNil)
+	otherCA, _ := LoadCA(location + "/other")
+	a.So(otherCA.UpdateCRL(), ShouldBeNil)
+	crl, _ := ioutil.ReadFile(location + "/other/ca.crl")
+	ioutil.WriteFile(location+"/ca.crl", crl, 0644)
+	os.Chtimes(location+"/ca.crl", time.Now(), time.Now().Add(time.Minute))
+	_, err = list.IsRevoked(cert)
+	a.So(err, ShouldNotBeNil)
+}
@@ -0,0 +1,197 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+// Package snmp sends SNMPv2c traps, so that network operation centers can monitor the components without Prometheus
+package snmp
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// Well-known OIDs of the variables that every SNMPv2c trap starts with
+const (
+	SysUpTimeOID = "1.3.6.1.2.1.1.3.0"
+	SnmpTrapOID  = "1.3.6.1.6.3.1.1.4.1.0"
+)
+
+const (
+	defaultPort = "162"
+	version2c   = 1
+)
+
+// BER tags of the encoded types
+const (
+	tagInteger   = 0x02
+	tagOctetStr  = 0x04
+	tagOID       = 0x06
+	tagSequence  = 0x30
+	tagTimeTicks = 0x43
+	tagTrapV2PDU = 0xa7
+)
+
+// Variable is a variable binding of a trap, with a string value
+type Variable struct {
+	OID   string
+	Value string
+}
+
+// Trap is an SNMPv2c trap
+type Trap struct {
+	// OID identifies the trap
+	OID string
+	// Uptime is the time since the sender started
+	Uptime time.Duration
+	// Variables are sent after the uptime and the OID of the trap
+	Variables []Variable
+}
+
+// Marshal returns the SNMPv2c message with the trap
+func Marshal(community string, requestID int32, trap Trap) ([]byte, error) {
+	trapOID, err := encodeOID(trap.OID)
+	if err != nil {
+		return nil, err
+	}
+	uptimeOID, _ := encodeOID(SysUpTimeOID)
+	snmpTrapOID, _ := encodeOID(SnmpTrapOID)
+
+	bindings := [][]byte{
+		encode(tagSequence, uptimeOID, encode(tagTimeTicks, encodeInteger(int64(uint32(trap.Uptime/(10*time.Millisecond)))))),
+		encode(tagSequence, snmpTrapOID, trapOID),
+	}
+	for _, variable := range trap.Variables {
+		oid, err := encodeOID(variable.OID)
+		if err != nil {
+			return nil, err
+		}
+		bindings = append(bindings, encode(tagSequence, oid, encode(tagOctetStr, []byte(variable.Value))))
+	}
+
+	pdu := encode(tagTrapV2PDU,
+		encode(tagInteger, encodeInteger(int64(requestID))),
+		encode(tagInteger, encodeInteger(0)), // error-status
+		encode(tagInteger, encodeInteger(0)), // error-index
+		encode(tagSequence, bindings...),
+	)
+	return encode(tagSequence,
+		encode(tagInteger, encodeInteger(version2c)),
+		encode(tagOctetStr, []byte(community)),
+		pdu,
+	), nil
+}
+
+// Client sends traps to an SNMP manager over UDP
+type Client struct {
+	conn      net.Conn
+	community string
+	started   time.Time
+	requestID int32
+}
+
+// NewClient creates a new Client for the manager at the address. The port defaults to 162.
+func NewClient(address, community string) (*Client, error) {
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, defaultPort)
+	}
+	conn, err := net.Dial("udp", address)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		conn:      conn,
+		community: community,
+		started:   time.Now(),
+	}, nil
+}
+
+// Send a trap with the OID and variables
+func (c *Client) Send(oid string, variables ...Variable) error {
+	msg, err := Marshal(c.community, atomic.AddInt32(&c.requestID, 1), Trap{
+		OID:       oid,
+		Uptime:    time.Since(c.started),
+		Variables: variables,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.conn.Write(msg)
+	return err
+}
+
+// Close the connection
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// encode returns the BER encoding of the tag, the length of the contents and the contents
+func encode(tag byte, contents ...[]byte) []byte {
+	var length int
+	for _, content := range contents {
+		length += len(content)
+	}
+	res := append([]byte{tag}, encodeLength(length)...)
+	for _, content := range contents {
+		res = append(res, content...)
+	}
+	return res
+}
+
+// encodeLength returns the definite length in the short form if possible, or in the long form otherwise
+func encodeLength(length int) []byte {
+	if length < 0x80 {
+		return []byte{byte(length)}
+	}
+	var bytes []byte
+	for ; length > 0; length >>= 8 {
+		bytes = append([]byte{byte(length)}, bytes...)
+	}
+	return append([]byte{0x80 | byte(len(bytes))}, bytes...)
+}
+
+// encodeInteger returns the shortest two's complement encoding of the value
+func encodeInteger(value int64) []byte {
+	bytes := []byte{byte(value)}
+	for value > 0x7f || value < -0x80 {
+		value >>= 8
+		bytes = append([]byte{byte(value)}, bytes...)
+	}
+	return bytes
+}
+
+// encodeOID returns the encoding of the dotted OID, including its tag and length
+func encodeOID(oid string) ([]byte, error) {
+	parts := strings.Split(strings.TrimPrefix(oid, "."), ".")
+	if len(parts) < 2 {
+		return nil, errors.NewErrInvalidArgument("OID "+oid, "less than two arcs")
+	}
+	arcs := make([]uint64, len(parts))
+	for i, part := range parts {
+		arc, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return nil, errors.NewErrInvalidArgument("OID "+oid, "arcs must be numbers")
+		}
+		arcs[i] = arc
+	}
+	if arcs[0] > 2 || arcs[0] < 2 && arcs[1] >= 40 {
+		return nil, errors.NewErrInvalidArgument("OID "+oid, "invalid first arcs")
+	}
+	contents := encodeArc(arcs[0]*40 + arcs[1])
+	for _, arc := range arcs[2:] {
+		contents = append(contents, encodeArc(arc)...)
+	}
+	return encode(tagOID, contents), nil
+}
+
+// encodeArc returns the base-128 encoding of the arc, with the high bit set on all bytes but the last
+func encodeArc(arc uint64) []byte {
+	bytes := []byte{byte(arc & 0x7f)}
+	for arc >>= 7; arc > 0; arc >>= 7 {
+		bytes = append([]byte{byte(arc&0x7f) | 0x80}, bytes...)
+	}
+	return bytes
+}
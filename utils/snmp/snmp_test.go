@@ -0,0 +1,78 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package snmp
+
+import (
+	"encoding/hex"
+	"net"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestEncode(t *testing.T) {
+	a := New(t)
+
+	a.So(encodeLength(5), ShouldResemble, []byte{0x05})
+	a.So(encodeLength(200), ShouldResemble, []byte{0x81, 0xc8})
+	a.So(encodeLength(300), ShouldResemble, []byte{0x82, 0x01, 0x2c})
+
+	a.So(encodeInteger(0), ShouldResemble, []byte{0x00})
+	a.So(encodeInteger(127), ShouldResemble, []byte{0x7f})
+	a.So(encodeInteger(128), ShouldResemble, []byte{0x00, 0x80})
+	a.So(encodeInteger(256), ShouldResemble, []byte{0x01, 0x00})
+	a.So(encodeInteger(-1), ShouldResemble, []byte{0xff})
+	a.So(encodeInteger(-129), ShouldResemble, []byte{0xff, 0x7f})
+
+	oid, err := encodeOID(SysUpTimeOID)
+	a.So(err, ShouldBeNil)
+	a.So(oid, ShouldResemble, []byte{0x06, 0x08, 0x2b, 0x06, 0x01, 0x02, 0x01, 0x01, 0x03, 0x00})
+
+	oid, err = encodeOID(".1.3.6.1.4.1.8072.9999")
+	a.So(err, ShouldBeNil)
+	a.So(oid, ShouldResemble, []byte{0x06, 0x09, 0x2b, 0x06, 0x01, 0x04, 0x01, 0xbf, 0x08, 0xce, 0x0f})
+
+	for _, invalid := range []string{"", "1", "1.3.x", "3.1", "1.40"} {
+		_, err = encodeOID(invalid)
+		a.So(err, ShouldNotBeNil)
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	a := New(t)
+
+	msg, err := Marshal("public", 1, Trap{
+		OID:       "1.3.6.1.4.1.8072.9999",
+		Variables: []Variable{{OID: "1.3.6.1.4.1.8072.9999.2.1", Value: "handler"}},
+	})
+	a.So(err, ShouldBeNil)
+	a.So(hex.EncodeToString(msg), ShouldEqual, "305802010104067075626c6963a74b0201010201000201003040300d06082b0601020101030043010030"+
+		"17060a2b06010603010104010006092b06010401bf08ce0f3016060b2b06010401bf08ce0f0201040768616e646c6572")
+
+	_, err = Marshal("public", 1, Trap{OID: "invalid"})
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestClient(t *testing.T) {
+	a := New(t)
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	a.So(err, ShouldBeNil)
+	defer conn.Close()
+
+	client, err := NewClient(conn.LocalAddr().String(), "public")
+	a.So(err, ShouldBeNil)
+	defer client.Close()
+
+	a.So(client.Send("1.3.6.1.4.1.8072.9999.1.1", Variable{OID: "1.3.6.1.4.1.8072.9999.2.1", Value: "handler"}), ShouldBeNil)
+
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	buf := make([]byte, 1500)
+	n, _, err := conn.ReadFrom(buf)
+	a.So(err, ShouldBeNil)
+	a.So(buf[0], ShouldEqual, byte(tagSequence))
+	a.So(string(buf[:n]), ShouldContainSubstring, "public")
+	a.So(string(buf[:n]), ShouldContainSubstring, "handler")
+}
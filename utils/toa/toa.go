@@ -44,12 +44,23 @@ func ComputeLoRa(payloadSize uint, datr string, codr string) (time.Duration, err
 	pl := float64(payloadSize)
 	sf := float64(dr.SpreadingFactor)
 	bw := float64(dr.Bandwidth)
+	if dr.Bandwidth == 812 {
+		bw = 812.5 // The 2.4GHz band uses a bandwidth of 812.5 kHz
+	}
 	h := 0.0 // 0 means header is enabled
 
 	tSym := math.Pow(2, float64(dr.SpreadingFactor)) / bw
 
-	payloadNb := 8.0 + math.Max(0.0, math.Ceil((8.0*pl-4.0*sf+28.0+16.0-20.0*h)/(4.0*(sf-2.0*de)))*(cr+4.0))
-	timeOnAir := (payloadNb + 12.25) * tSym * 1000000 // in nanoseconds
+	var payloadNb, preambleNb float64
+	if dr.SpreadingFactor < 7 {
+		// SF5 and SF6 (SX1280) use a longer sync word and no extra symbols in the payload
+		payloadNb = 8.0 + math.Max(0.0, math.Ceil((8.0*pl-4.0*sf+20.0+16.0-20.0*h)/(4.0*sf))*(cr+4.0))
+		preambleNb = 14.25
+	} else {
+		payloadNb = 8.0 + math.Max(0.0, math.Ceil((8.0*pl-4.0*sf+28.0+16.0-20.0*h)/(4.0*(sf-2.0*de)))*(cr+4.0))
+		preambleNb = 12.25
+	}
+	timeOnAir := (payloadNb + preambleNb) * tSym * 1000000 // in nanoseconds
 
 	return time.Duration(timeOnAir), nil
 }
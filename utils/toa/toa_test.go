@@ -78,6 +78,18 @@ func TestComputeLoRa(t *testing.T) {
 		a.So(toa, ShouldAlmostEqual, time.Duration(us)*time.Microsecond)
 	}
 
+	// Test 2.4GHz data rates
+	ismTests := map[string]uint{
+		"SF5BW812":  1860923,
+		"SF7BW812":  6340923,
+		"SF12BW812": 152497230,
+	}
+	for dr, ns := range ismTests {
+		toa, err = ComputeLoRa(10, dr, "4/5")
+		a.So(err, ShouldBeNil)
+		a.So(toa, ShouldAlmostEqual, time.Duration(ns))
+	}
+
 }
 
 func TestComputeFSK(t *testing.T) {